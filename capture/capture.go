@@ -0,0 +1,170 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+// Package capture provides an API to use netcap as a library,
+// it decodes packets from pcap and pcapng files or network interfaces and delivers the audit records in memory.
+package capture
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"github.com/dreadl0ck/netcap/collector"
+	"github.com/dreadl0ck/netcap/decoder/config"
+	"github.com/dreadl0ck/netcap/defaults"
+	"github.com/dreadl0ck/netcap/types"
+)
+
+// errNoSource occurs when Process is called without a source.
+var errNoSource = errors.New("no source")
+
+// Options for processing a source.
+type Options struct {
+
+	// Config for the collector, DefaultConfig is used if nil.
+	// The writer settings of the decoder config are ignored, since the audit records are delivered in memory,
+	// and its output directory and source are set by Process.
+	Config *collector.Config
+
+	// Out is the directory for log files and extracted files.
+	// If empty, a temporary directory is used, which is removed with all its contents once the channel has been closed.
+	Out string
+
+	// OnTempDir is called with the path of the temporary directory, if Out is empty.
+	// The files in the directory can be accessed until the channel has been closed.
+	OnTempDir func(path string)
+
+	// BPF filter for live capture
+	BPF string
+
+	// BufferSize is the size of the audit record channel
+	BufferSize int
+
+	// OnError is called when processing fails after it has been started, for example while reading packets.
+	OnError func(err error)
+}
+
+// DefaultConfig returns a collector configuration with TCP and UDP stream reassembly enabled,
+// that can be customized and passed to Process with the Options.
+func DefaultConfig() collector.Config {
+	c := collector.DefaultConfig
+
+	c.ReassembleConnections = true
+	c.PacketBufferSize = defaults.PacketBuffer
+
+	return c
+}
+
+// Process decodes the packets from the source and delivers the audit records through the returned channel.
+// The source can be the path to a pcap or pcapng file, or the name of a network interface for live capture.
+// Processing stops when all packets of a file have been read, or when the context is canceled.
+// Afterwards the remaining packets are processed, the state of the decoders is flushed and the channel is closed.
+// The channel must be read until it is closed, otherwise processing blocks.
+func Process(ctx context.Context, source string, opts *Options) (<-chan types.AuditRecord, error) {
+	if source == "" {
+		return nil, errNoSource
+	}
+
+	if opts == nil {
+		opts = new(Options)
+	}
+
+	// check the file before starting to process it, so that errors can be returned immediately
+	var isFile, isPcap bool
+	if stat, err := os.Stat(source); err == nil && !stat.IsDir() {
+		isFile = true
+
+		if isPcap, err = collector.IsPcap(source); err != nil {
+			return nil, err
+		}
+	}
+
+	out, tmp := opts.Out, false
+	if out == "" {
+		var err error
+		if out, err = ioutil.TempDir("", "netcap"); err != nil {
+			return nil, err
+		}
+
+		tmp = true
+
+		if opts.OnTempDir != nil {
+			opts.OnTempDir(out)
+		}
+	}
+
+	var (
+		records = make(chan types.AuditRecord, opts.BufferSize)
+		conf    = makeConfig(opts, out, source, records)
+		c       = collector.New(conf)
+	)
+
+	go func() {
+		defer func() {
+			// the directory is removed before closing the channel,
+			// so that it is gone once the caller has read all records
+			if tmp {
+				_ = os.RemoveAll(out)
+			}
+
+			close(records)
+		}()
+
+		var err error
+
+		switch {
+		case isFile && isPcap:
+			err = c.CollectPcapContext(source, ctx)
+		case isFile:
+			err = c.CollectPcapNGContext(source, ctx)
+		default:
+			err = c.CollectLive(source, opts.BPF, ctx)
+		}
+
+		if err != nil && opts.OnError != nil {
+			opts.OnError(err)
+		}
+	}()
+
+	return records, nil
+}
+
+// makeConfig returns a copy of the collector config from the options,
+// modified to deliver the audit records into the channel and to run without user interaction.
+// The decoder config is copied, so that multiple sources can be processed at the same time.
+func makeConfig(opts *Options, out string, source string, records chan types.AuditRecord) collector.Config {
+	var c collector.Config
+	if opts.Config != nil {
+		c = *opts.Config
+	} else {
+		c = DefaultConfig()
+	}
+
+	if c.DecoderConfig == nil {
+		c.DecoderConfig = config.DefaultConfig
+	}
+
+	c.DecoderConfig = c.DecoderConfig.Copy()
+	c.DecoderConfig.Records = records
+	c.DecoderConfig.Out = out
+	c.DecoderConfig.Source = source
+	c.DecoderConfig.Quiet = true
+	c.DecoderConfig.PrintProgress = false
+
+	c.NoPrompt = true
+	c.NoSignalHandler = true
+
+	return c
+}
@@ -0,0 +1,171 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package capture
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dreadl0ck/gopacket"
+	"github.com/dreadl0ck/gopacket/layers"
+	"github.com/dreadl0ck/gopacket/pcapgo"
+
+	"github.com/dreadl0ck/netcap/types"
+)
+
+// writeTestPcap writes a pcap file with UDP packets between two hosts.
+func writeTestPcap(t *testing.T, numPackets int) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "test.pcap")
+
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer f.Close()
+
+	w := pcapgo.NewWriter(f)
+	if err = w.WriteFileHeader(65536, layers.LinkTypeEthernet); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		eth = &layers.Ethernet{
+			SrcMAC:       net.HardwareAddr{0x00, 0x11, 0x22, 0x33, 0x44, 0x55},
+			DstMAC:       net.HardwareAddr{0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb},
+			EthernetType: layers.EthernetTypeIPv4,
+		}
+		ip = &layers.IPv4{
+			Version:  4,
+			TTL:      64,
+			Protocol: layers.IPProtocolUDP,
+			SrcIP:    net.IP{10, 0, 0, 1},
+			DstIP:    net.IP{10, 0, 0, 2},
+		}
+		udp = &layers.UDP{
+			SrcPort: 40000,
+			DstPort: 9999,
+		}
+		ts = time.Unix(1600000000, 0)
+	)
+
+	_ = udp.SetNetworkLayerForChecksum(ip)
+
+	for i := 0; i < numPackets; i++ {
+		buf := gopacket.NewSerializeBuffer()
+
+		err = gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}, eth, ip, udp, gopacket.Payload("hello"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data := buf.Bytes()
+
+		err = w.WritePacket(gopacket.CaptureInfo{
+			Timestamp:     ts.Add(time.Duration(i) * time.Millisecond),
+			CaptureLength: len(data),
+			Length:        len(data),
+		}, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return path
+}
+
+func TestProcess(t *testing.T) {
+	var (
+		path = writeTestPcap(t, 10)
+		tmp  string
+	)
+
+	records, err := Process(context.Background(), path, &Options{
+		OnError: func(err error) {
+			t.Error(err)
+		},
+		OnTempDir: func(path string) {
+			tmp = path
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var numUDP int
+	for r := range records {
+		if _, ok := r.(*types.UDP); ok {
+			numUDP++
+		}
+	}
+
+	if numUDP != 10 {
+		t.Error("expected 10 UDP audit records, got", numUDP)
+	}
+
+	if _, err = os.Stat(tmp); tmp == "" || !os.IsNotExist(err) {
+		t.Errorf("expected temporary directory %q to be removed, got %v", tmp, err)
+	}
+
+	if _, err = Process(context.Background(), "", nil); err == nil {
+		t.Error("expected an error for an empty source")
+	}
+}
+
+func TestProcessConcurrently(t *testing.T) {
+	var (
+		numPackets = []int{10, 20}
+		counts     = make([]int, len(numPackets))
+		wg         sync.WaitGroup
+	)
+
+	// start processing all sources before reading the records
+	for i, n := range numPackets {
+		records, err := Process(context.Background(), writeTestPcap(t, n), &Options{
+			OnError: func(err error) {
+				t.Error(err)
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		wg.Add(1)
+
+		go func(i int, records <-chan types.AuditRecord) {
+			defer wg.Done()
+
+			for r := range records {
+				if _, ok := r.(*types.UDP); ok {
+					counts[i]++
+				}
+			}
+		}(i, records)
+	}
+
+	wg.Wait()
+
+	for i, n := range numPackets {
+		if counts[i] != n {
+			t.Error("expected", n, "UDP audit records, got", counts[i])
+		}
+	}
+}
@@ -40,6 +40,8 @@ var (
 	flagChanSize       = fs.Int("chan-size", 1024, "chunk size for internal data channels")
 	flagLogErrors      = fs.Bool("log-errors", false, "enable verbose packet decoding error logging")
 	flagCalcEntropy    = fs.Bool("entropy", false, "enable entropy calculation for Eth,IP,TCP and UDP payloads")
+	flagConnFeatures   = fs.Bool("conn-features", false, "collect packet length, timing and byte distribution features for encrypted traffic analysis on Connection audit records")
+	flagConnPackets    = fs.Int("conn-features-packets", defaults.ConnectionFeaturesPackets, "number of packets with payload whose lengths and inter-arrival times are collected for Connection audit records")
 	flagFileStorage    = fs.String("fileStorage", "", "path to created extracted files (currently only for HTTP)")
 	flagBPF            = fs.String("bpf", "", "supply a BPF filter to use for netcap collection")
 	flagInclude        = fs.String("include", "", "include specific decoders")
@@ -70,6 +72,19 @@ var (
 
 	flagFlushevery           = fs.Int("flushevery", defaults.FlushEvery, "flush assembler every N packets")
 	flagDefragIPv4           = fs.Bool("ip4defrag", defaults.DefragIPv4, "Defragment IPv4 packets")
+	flagDefragIPv6           = fs.Bool("ip6defrag", defaults.DefragIPv6, "Defragment IPv6 packets")
+	flagOverlapPolicy        = fs.String("overlap-policy", "", "TCP overlap policy per destination host or subnet (first, last, bsd, linux, windows), e.g. default=bsd,10.0.0.0/8=windows. Inconsistent retransmissions are only detected for out-of-order data that is still buffered, not for data that has already been reassembled")
+	flagReassemblyPageBudget = fs.Int("reassembly-page-budget", defaults.ReassemblyPageBudget, "limit the memory for out-of-order TCP data buffered by the reassembly and for connection state in bytes, data passed to the stream decoders is not included, 0 disables the limit")
+	flagReassemblyEviction   = fs.String("reassembly-eviction", defaults.ReassemblyEviction, "connections to close first when the reassembly memory budget is exceeded (oldest, largest, lru)")
+	flagIncrementalDecoding  = fs.Bool("incremental-decoding", defaults.IncrementalStreamDecoding, "decode supported protocols while TCP connections are reassembled and emit records per message")
+	flagIncrementalBuffer    = fs.Int("incremental-buffer", defaults.IncrementalStreamBufferSize, "limit for buffered data per connection direction for incremental decoding in bytes")
+	flagUDPIdleTimeout       = fs.Duration("udp-idle-timeout", defaults.UDPStreamIdleTimeout, "process UDP streams that received no packets for this duration, 0 disables the timeout")
+	flagUDPMaxAge            = fs.Duration("udp-max-age", defaults.UDPStreamMaxAge, "process UDP streams older than this duration, 0 disables the limit")
+	flagUDPMemBudget         = fs.Int("udp-mem-budget", defaults.UDPStreamMemoryBudget, "limit the memory for buffered UDP stream data in bytes, 0 disables the limit")
+	flagSCTPIdleTimeout      = fs.Duration("sctp-idle-timeout", defaults.SCTPIdleTimeout, "write SCTP associations that received no packets for this duration, 0 disables the timeout")
+	flagStreamPorts          = fs.String("stream-ports", defaults.StreamDecoderPorts, "ports and port ranges to try each stream decoder on first, e.g. HTTP:80,8080-8090;SMTP:25,587")
+	flagStreamForce          = fs.String("stream-force", defaults.StreamDecoderForce, "use a stream decoder for all conversations with a server IP:port, e.g. 10.0.0.1:8443=HTTP")
+	flagCommunityIDSeed      = fs.Int("community-id-seed", defaults.CommunityIDSeed, "seed for the Community ID flow hashes")
 	flagChecksum             = fs.Bool("checksum", defaults.Checksum, "check TCP checksum")
 	flagNooptcheck           = fs.Bool("nooptcheck", defaults.NoOptCheck, "do not check TCP options (useful to ignore MSS on captures with TSO)")
 	flagIgnorefsmerr         = fs.Bool("ignorefsmerr", defaults.IgnoreFSMErr, "ignore TCP FSM errors")
@@ -85,4 +100,11 @@ var (
 	flagFlowTimeOut          = fs.Duration("flow-timeout", defaults.FlowTimeOut, "closes flows older than flowTimeout")
 	flagClosePendingTimeout  = fs.Duration("close-pending-timeout", defaults.ClosePendingTimeout, "reassembly: close connections that have pending bytes after X")
 	flagCloseInactiveTimeout = fs.Duration("close-inactive-timeout", defaults.CloseInactiveTimeout, "reassembly: close connections that are inactive after X")
+
+	// limits for the IPv6 defragmentation
+	flagDefragIPv6Timeout      = fs.Duration("ip6defrag-timeout", defaults.DefragIPv6Timeout, "discard incomplete IPv6 datagrams after this duration")
+	flagDefragIPv6MaxFragments = fs.Int("ip6defrag-max-fragments", defaults.DefragIPv6MaxFragments, "discard IPv6 datagrams with more fragments")
+	flagDefragIPv6MaxDatagrams = fs.Int("ip6defrag-max-datagrams", defaults.DefragIPv6MaxDatagrams, "limit for the number of IPv6 datagrams reassembled at the same time")
+	flagDefragIPv6Buffer       = fs.Int("ip6defrag-buffer", defaults.DefragIPv6BufferSize, "limit for the fragment data buffered for incomplete IPv6 datagrams in bytes")
+	flagDefragIPv6TinyFragment = fs.Int("ip6defrag-tiny-fragment", defaults.DefragIPv6TinyFragmentSize, "discard IPv6 datagrams with a fragment smaller than this in bytes, except for the last fragment")
 )
@@ -101,37 +101,57 @@ func Run() {
 		SnapLen:             *flagSnapLen,
 		LogErrors:           *flagLogErrors,
 		DecoderConfig: &config.Config{
-			Buffer:               false,
-			Compression:          false,
-			CSV:                  false,
-			Chan:                 true,
-			ChanSize:             *flagChanSize,
-			IncludeDecoders:      *flagInclude,
-			ExcludeDecoders:      *flagExclude,
-			Out:                  "",
-			Source:               *flagInterface,
-			IncludePayloads:      *flagPayload,
-			AddContext:           *flagContext,
-			MemBufferSize:        *flagMemBufferSize,
-			FlushEvery:           *flagFlushevery,
-			DefragIPv4:           *flagDefragIPv4,
-			Checksum:             *flagChecksum,
-			NoOptCheck:           *flagNooptcheck,
-			IgnoreFSMerr:         *flagIgnorefsmerr,
-			AllowMissingInit:     *flagAllowmissinginit,
-			Debug:                *flagDebug,
-			HexDump:              *flagHexdump,
-			WaitForConnections:   *flagWaitForConnections,
-			WriteIncomplete:      *flagWriteincomplete,
-			MemProfile:           *flagMemprofile,
-			ConnFlushInterval:    *flagConnFlushInterval,
-			ConnTimeOut:          *flagConnTimeOut,
-			FlowFlushInterval:    *flagFlowFlushInterval,
-			FlowTimeOut:          *flagFlowTimeOut,
-			CloseInactiveTimeOut: *flagCloseInactiveTimeout,
-			ClosePendingTimeOut:  *flagClosePendingTimeout,
-			FileStorage:          *flagFileStorage,
-			CalculateEntropy:     *flagCalcEntropy,
+			Buffer:                      false,
+			Compression:                 false,
+			CSV:                         false,
+			Chan:                        true,
+			ChanSize:                    *flagChanSize,
+			IncludeDecoders:             *flagInclude,
+			ExcludeDecoders:             *flagExclude,
+			Out:                         "",
+			Source:                      *flagInterface,
+			IncludePayloads:             *flagPayload,
+			AddContext:                  *flagContext,
+			MemBufferSize:               *flagMemBufferSize,
+			FlushEvery:                  *flagFlushevery,
+			DefragIPv4:                  *flagDefragIPv4,
+			DefragIPv6:                  *flagDefragIPv6,
+			DefragIPv6Timeout:           *flagDefragIPv6Timeout,
+			DefragIPv6MaxFragments:      *flagDefragIPv6MaxFragments,
+			DefragIPv6MaxDatagrams:      *flagDefragIPv6MaxDatagrams,
+			DefragIPv6BufferSize:        *flagDefragIPv6Buffer,
+			DefragIPv6TinyFragmentSize:  *flagDefragIPv6TinyFragment,
+			OverlapPolicy:               *flagOverlapPolicy,
+			ReassemblyPageBudget:        *flagReassemblyPageBudget,
+			ReassemblyEviction:          *flagReassemblyEviction,
+			IncrementalStreamDecoding:   *flagIncrementalDecoding,
+			IncrementalStreamBufferSize: *flagIncrementalBuffer,
+			UDPStreamIdleTimeout:        *flagUDPIdleTimeout,
+			UDPStreamMaxAge:             *flagUDPMaxAge,
+			UDPStreamMemoryBudget:       *flagUDPMemBudget,
+			SCTPIdleTimeout:             *flagSCTPIdleTimeout,
+			StreamDecoderPorts:          *flagStreamPorts,
+			StreamDecoderForce:          *flagStreamForce,
+			CommunityIDSeed:             *flagCommunityIDSeed,
+			Checksum:                    *flagChecksum,
+			NoOptCheck:                  *flagNooptcheck,
+			IgnoreFSMerr:                *flagIgnorefsmerr,
+			AllowMissingInit:            *flagAllowmissinginit,
+			Debug:                       *flagDebug,
+			HexDump:                     *flagHexdump,
+			WaitForConnections:          *flagWaitForConnections,
+			WriteIncomplete:             *flagWriteincomplete,
+			MemProfile:                  *flagMemprofile,
+			ConnFlushInterval:           *flagConnFlushInterval,
+			ConnTimeOut:                 *flagConnTimeOut,
+			FlowFlushInterval:           *flagFlowFlushInterval,
+			FlowTimeOut:                 *flagFlowTimeOut,
+			CloseInactiveTimeOut:        *flagCloseInactiveTimeout,
+			ClosePendingTimeOut:         *flagClosePendingTimeout,
+			FileStorage:                 *flagFileStorage,
+			ConnectionFeatures:          *flagConnFeatures,
+			ConnectionFeaturesPackets:   *flagConnPackets,
+			CalculateEntropy:            *flagCalcEntropy,
 		},
 		ResolverConfig: resolvers.Config{
 			ReverseDNS:    *flagReverseDNS,
@@ -107,6 +107,7 @@ var (
 
 	flagFreeOSMemory          = fs.Int("free-os-mem", 0, "free OS memory every X minutes, disabled if set to 0")
 	flagReassembleConnections = fs.Bool("reassemble-connections", true, "reassemble TCP connections")
+	flagDecapsulate           = fs.Bool("decapsulate", defaults.Decapsulate, "decode packets transported in GRE, ERSPAN type II and III, VXLAN, Geneve, Teredo and IP in IP tunnels as separate packets, flow based decoders like Connection only process the inner packets")
 
 	flagTCPDebug  = fs.Bool("tcp-debug", false, "add debug output for TCP connections to debug.log")
 	flagSaveConns = fs.Bool("conns", false, "save raw TCP connections")
@@ -114,9 +115,53 @@ var (
 	flagCalcEntropy = fs.Bool("entropy", false, "enable entropy calculation for Eth,IP,TCP and UDP payloads")
 	flagLogErrors   = fs.Bool("log-errors", false, "enable verbose packet decoding error logging")
 
+	flagConnFeatures        = fs.Bool("conn-features", false, "collect packet length, timing and byte distribution features for encrypted traffic analysis on Connection audit records")
+	flagConnFeaturesPackets = fs.Int("conn-features-packets", defaults.ConnectionFeaturesPackets, "number of packets with payload whose lengths and inter-arrival times are collected for Connection audit records")
+
+	flagOutputs          = fs.String("outputs", "", "write audit records to multiple outputs at once, comma separated list of: proto, csv, json, elastic, unix, parquet, zeek, eve, ipfix, sqlite (overrides the individual output flags)")
+	flagOutputBufferSize = fs.Int("output-buffer", defaults.OutputBufferSize, "number of audit records buffered for each output when writing to multiple outputs, records are dropped for the elastic and unix outputs when their buffer is full")
+
+	flagParquet             = fs.Bool("parquet", false, "output data as apache parquet files")
+	flagParquetRowGroupSize = fs.Int("parquet-rowgroup", defaults.ParquetRowGroupSize, "number of audit records per parquet row group")
+	flagParquetCompression  = fs.String("parquet-compression", defaults.ParquetCompression, "compression codec for parquet files: snappy, gzip, zstd or none")
+
+	flagZeek     = fs.Bool("zeek", false, "output data as zeek logs: conn.log, http.log, dns.log, ssl.log, ssh.log, files.log and software.log")
+	flagZeekJSON = fs.Bool("zeek-json", false, "write the zeek logs as JSON instead of tab separated values")
+
+	flagEve = fs.Bool("eve", false, "output data as suricata EVE JSON events into a single eve.json file")
+
+	flagIPFIX                  = fs.Bool("ipfix", false, "export Connection audit records as IPFIX or NetFlow v9 flow records")
+	flagIPFIXCollector         = fs.String("ipfix-collector", "", "UDP address of the flow collector, e.g. 127.0.0.1:4739, the flow records are written to a file in the output directory if not set")
+	flagIPFIXVersion           = fs.Int("ipfix-version", defaults.IPFIXVersion, "version of the flow records: 10 for IPFIX or 9 for NetFlow v9")
+	flagIPFIXEnterpriseNumber  = fs.Uint("ipfix-pen", defaults.IPFIXEnterpriseNumber, "private enterprise number of the netcap information elements in IPFIX templates")
+	flagIPFIXObservationDomain = fs.Uint("ipfix-domain", 0, "observation domain id of the flow exporter")
+
+	flagSQLite          = fs.Bool("sqlite", false, "insert the audit records into a sqlite database in the output directory, with one table per audit record type")
+	flagSQLiteBatchSize = fs.Int("sqlite-batch", defaults.SQLiteBatchSize, "number of audit records inserted per sqlite transaction")
+
+	flagRotateSize     = fs.Int64("rotate-size", 0, "start a new proto, csv or json audit record file after this many bytes before compression, 0 disables rotation by size")
+	flagRotateInterval = fs.Duration("rotate-interval", 0, "start a new proto, csv or json audit record file on each multiple of this interval, e.g. 1h, 0 disables rotation by time")
+	flagRetentionSize  = fs.Int64("retention-size", 0, "remove the oldest rotated audit record files when their total size in the output directory exceeds this many bytes, 0 disables the retention")
+
+	flagIndex          = fs.Bool("index", true, "write a time index next to each proto audit record file, for reading the audit records of a time range with net dump -start and -stop")
+	flagIndexBlockSize = fs.Int("index-block", defaults.IndexBlockSize, "number of audit records per block of the time index")
+
 	// reassembly.
 	flagFlushevery           = fs.Int("flushevery", defaults.FlushEvery, "flush assembler every N packets")
 	flagDefragIPv4           = fs.Bool("ip4defrag", defaults.DefragIPv4, "Defragment IPv4 packets")
+	flagDefragIPv6           = fs.Bool("ip6defrag", defaults.DefragIPv6, "Defragment IPv6 packets")
+	flagOverlapPolicy        = fs.String("overlap-policy", "", "TCP overlap policy per destination host or subnet (first, last, bsd, linux, windows), e.g. default=bsd,10.0.0.0/8=windows. Inconsistent retransmissions are only detected for out-of-order data that is still buffered, not for data that has already been reassembled")
+	flagReassemblyPageBudget = fs.Int("reassembly-page-budget", defaults.ReassemblyPageBudget, "limit the memory for out-of-order TCP data buffered by the reassembly and for connection state in bytes, data passed to the stream decoders is not included, 0 disables the limit")
+	flagReassemblyEviction   = fs.String("reassembly-eviction", defaults.ReassemblyEviction, "connections to close first when the reassembly memory budget is exceeded (oldest, largest, lru)")
+	flagIncrementalDecoding  = fs.Bool("incremental-decoding", defaults.IncrementalStreamDecoding, "decode supported protocols while TCP connections are reassembled and emit records per message")
+	flagIncrementalBuffer    = fs.Int("incremental-buffer", defaults.IncrementalStreamBufferSize, "limit for buffered data per connection direction for incremental decoding in bytes")
+	flagUDPIdleTimeout       = fs.Duration("udp-idle-timeout", defaults.UDPStreamIdleTimeout, "process UDP streams that received no packets for this duration, 0 disables the timeout")
+	flagUDPMaxAge            = fs.Duration("udp-max-age", defaults.UDPStreamMaxAge, "process UDP streams older than this duration, 0 disables the limit")
+	flagUDPMemBudget         = fs.Int("udp-mem-budget", defaults.UDPStreamMemoryBudget, "limit the memory for buffered UDP stream data in bytes, 0 disables the limit")
+	flagSCTPIdleTimeout      = fs.Duration("sctp-idle-timeout", defaults.SCTPIdleTimeout, "write SCTP associations that received no packets for this duration, 0 disables the timeout")
+	flagStreamPorts          = fs.String("stream-ports", defaults.StreamDecoderPorts, "ports and port ranges to try each stream decoder on first, e.g. HTTP:80,8080-8090;SMTP:25,587")
+	flagStreamForce          = fs.String("stream-force", defaults.StreamDecoderForce, "use a stream decoder for all conversations with a server IP:port, e.g. 10.0.0.1:8443=HTTP")
+	flagCommunityIDSeed      = fs.Int("community-id-seed", defaults.CommunityIDSeed, "seed for the Community ID flow hashes")
 	flagChecksum             = fs.Bool("checksum", defaults.Checksum, "check TCP checksum")
 	flagNooptcheck           = fs.Bool("nooptcheck", defaults.NoOptCheck, "do not check TCP options (useful to ignore MSS on captures with TSO)")
 	flagIgnorefsmerr         = fs.Bool("ignorefsmerr", defaults.IgnoreFSMErr, "ignore TCP FSM errors")
@@ -133,6 +178,7 @@ var (
 
 	flagConnFlushInterval              = fs.Int("conn-flush-interval", defaults.ConnFlushInterval, "flush connections every X flows")
 	flagConnTimeOut                    = fs.Duration("conn-timeout", defaults.ConnTimeOut, "close connections older than X seconds")
+	flagConnExpire                     = fs.Bool("conn-expire", false, "write connections that have been idle longer than the conn-timeout while capturing, checked in the background")
 	flagFlowFlushInterval              = fs.Int("flow-flush-interval", defaults.FlowFlushInterval, "flushes flows every X flows")
 	flagFlowTimeOut                    = fs.Duration("flow-timeout", defaults.FlowTimeOut, "closes flows older than flowTimeout")
 	flagClosePendingTimeout            = fs.Duration("close-pending-timeout", defaults.ClosePendingTimeout, "reassembly: close connections that have pending bytes")
@@ -154,4 +200,12 @@ var (
 
 	flagCompressionBlockSize = fs.Int("compression-block-size", defaults.CompressionBlockSize, "block size used for parallel compression")
 	flagCompressionLevel     = fs.String("compression-level", compressionLevelToString(defaults.CompressionLevel), "level of compression")
+	flagCompressionCodec     = fs.String("compression-codec", defaults.CompressionCodec, "codec for compressed audit record files: gzip or zstd")
+
+	// limits for the IPv6 defragmentation
+	flagDefragIPv6Timeout      = fs.Duration("ip6defrag-timeout", defaults.DefragIPv6Timeout, "discard incomplete IPv6 datagrams after this duration")
+	flagDefragIPv6MaxFragments = fs.Int("ip6defrag-max-fragments", defaults.DefragIPv6MaxFragments, "discard IPv6 datagrams with more fragments")
+	flagDefragIPv6MaxDatagrams = fs.Int("ip6defrag-max-datagrams", defaults.DefragIPv6MaxDatagrams, "limit for the number of IPv6 datagrams reassembled at the same time")
+	flagDefragIPv6Buffer       = fs.Int("ip6defrag-buffer", defaults.DefragIPv6BufferSize, "limit for the fragment data buffered for incomplete IPv6 datagrams in bytes")
+	flagDefragIPv6TinyFragment = fs.Int("ip6defrag-tiny-fragment", defaults.DefragIPv6TinyFragmentSize, "discard IPv6 datagrams with a fragment smaller than this in bytes, except for the last fragment")
 )
@@ -164,7 +164,7 @@ func Run() {
 		os.Exit(1)
 	}
 
-	if strings.HasSuffix(*flagInput, defaults.FileExtensionCompressed) || strings.HasSuffix(*flagInput, defaults.FileExtension) {
+	if strings.HasSuffix(*flagInput, defaults.FileExtensionCompressed) || strings.HasSuffix(*flagInput, defaults.FileExtensionZstd) || strings.HasSuffix(*flagInput, defaults.FileExtension) {
 		printHeader()
 		fmt.Println(ansi.Red + "> the capture tool is used to create audit records from live traffic or a pcap dumpfile" + ansi.Reset)
 		fmt.Println(ansi.Red + "> use the dump tool to read netcap audit records" + ansi.Reset)
@@ -282,6 +282,18 @@ func Run() {
 		}
 	}
 
+	if _, err := io.ParseOutputs(*flagOutputs); err != nil {
+		log.Fatal(err)
+	}
+
+	if _, err := io.ParseParquetCompression(*flagParquetCompression); err != nil {
+		log.Fatal(err)
+	}
+
+	if _, err := io.ParseCompressionCodec(*flagCompressionCodec); err != nil {
+		log.Fatal(err)
+	}
+
 	// init collector
 	c := collector.New(collector.Config{
 		Workers:               *flagWorkers,
@@ -293,6 +305,7 @@ func Run() {
 		DecodeOptions:         utils.GetDecodeOptions(*flagDecodeOptions),
 		DPI:                   *flagDPI,
 		ReassembleConnections: *flagReassembleConnections,
+		Decapsulate:           *flagDecapsulate,
 		FreeOSMem:             *flagFreeOSMemory,
 		LogErrors:             *flagLogErrors,
 		NoPrompt:              *flagNoPrompt,
@@ -333,6 +346,24 @@ func Run() {
 			AddContext:                     *flagContext,
 			FlushEvery:                     *flagFlushevery,
 			DefragIPv4:                     *flagDefragIPv4,
+			DefragIPv6:                     *flagDefragIPv6,
+			DefragIPv6Timeout:              *flagDefragIPv6Timeout,
+			DefragIPv6MaxFragments:         *flagDefragIPv6MaxFragments,
+			DefragIPv6MaxDatagrams:         *flagDefragIPv6MaxDatagrams,
+			DefragIPv6BufferSize:           *flagDefragIPv6Buffer,
+			DefragIPv6TinyFragmentSize:     *flagDefragIPv6TinyFragment,
+			OverlapPolicy:                  *flagOverlapPolicy,
+			ReassemblyPageBudget:           *flagReassemblyPageBudget,
+			ReassemblyEviction:             *flagReassemblyEviction,
+			IncrementalStreamDecoding:      *flagIncrementalDecoding,
+			IncrementalStreamBufferSize:    *flagIncrementalBuffer,
+			UDPStreamIdleTimeout:           *flagUDPIdleTimeout,
+			UDPStreamMaxAge:                *flagUDPMaxAge,
+			UDPStreamMemoryBudget:          *flagUDPMemBudget,
+			SCTPIdleTimeout:                *flagSCTPIdleTimeout,
+			StreamDecoderPorts:             *flagStreamPorts,
+			StreamDecoderForce:             *flagStreamForce,
+			CommunityIDSeed:                *flagCommunityIDSeed,
 			Checksum:                       *flagChecksum,
 			NoOptCheck:                     *flagNooptcheck,
 			IgnoreFSMerr:                   *flagIgnorefsmerr,
@@ -344,11 +375,34 @@ func Run() {
 			MemProfile:                     *flagMemprofile,
 			ConnFlushInterval:              *flagConnFlushInterval,
 			ConnTimeOut:                    *flagConnTimeOut,
+			ExpireConnections:              *flagConnExpire,
 			FlowFlushInterval:              *flagFlowFlushInterval,
 			FlowTimeOut:                    *flagFlowTimeOut,
 			CloseInactiveTimeOut:           *flagCloseInactiveTimeout,
 			ClosePendingTimeOut:            *flagClosePendingTimeout,
 			FileStorage:                    *flagFileStorage,
+			ConnectionFeatures:             *flagConnFeatures,
+			ConnectionFeaturesPackets:      *flagConnFeaturesPackets,
+			Outputs:                        *flagOutputs,
+			OutputBufferSize:               *flagOutputBufferSize,
+			Parquet:                        *flagParquet,
+			ParquetRowGroupSize:            *flagParquetRowGroupSize,
+			ParquetCompression:             *flagParquetCompression,
+			Zeek:                           *flagZeek,
+			ZeekJSON:                       *flagZeekJSON,
+			Eve:                            *flagEve,
+			IPFIX:                          *flagIPFIX,
+			IPFIXCollector:                 *flagIPFIXCollector,
+			IPFIXVersion:                   *flagIPFIXVersion,
+			IPFIXEnterpriseNumber:          uint32(*flagIPFIXEnterpriseNumber),
+			IPFIXObservationDomain:         uint32(*flagIPFIXObservationDomain),
+			SQLite:                         *flagSQLite,
+			SQLiteBatchSize:                *flagSQLiteBatchSize,
+			RotateSize:                     *flagRotateSize,
+			RotateInterval:                 *flagRotateInterval,
+			RetentionSize:                  *flagRetentionSize,
+			Index:                          *flagIndex,
+			IndexBlockSize:                 *flagIndexBlockSize,
 			CalculateEntropy:               *flagCalcEntropy,
 			SaveConns:                      *flagSaveConns,
 			TCPDebug:                       *flagTCPDebug,
@@ -367,6 +421,7 @@ func Run() {
 			RemoveClosedStreams:            *flagRemoveClosedStreams,
 			CompressionBlockSize:           *flagCompressionBlockSize,
 			CompressionLevel:               getCompressionLevel(*flagCompressionLevel),
+			CompressionCodec:               *flagCompressionCodec,
 		},
 		ResolverConfig: resolvers.Config{
 			ReverseDNS:    *flagReverseDNS,
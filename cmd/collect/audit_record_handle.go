@@ -77,7 +77,13 @@ func newAuditRecordHandle(b *types.Batch, path string) *auditRecordHandle {
 	}
 
 	// add file header
-	err = delimited.NewWriter(gWriter).PutProto(io.NewHeader(b.MessageType, conf.Source, netcap.Version, conf.IncludePayloads, time.Now()))
+	header := io.NewHeader(b.MessageType, conf.Source, netcap.Version, conf.IncludePayloads, time.Now())
+	if err = io.AddSchema(header); err != nil {
+		fmt.Println("failed to add schema to header")
+		panic(err)
+	}
+
+	err = delimited.NewWriter(gWriter).PutProto(header)
 	if err != nil {
 		fmt.Println("failed to write header")
 		panic(err)
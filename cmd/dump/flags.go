@@ -50,4 +50,29 @@ var (
 	flagJSON            = fs.Bool("json", false, "print as JSON")
 	flagMemBufferSize   = fs.Int("membuf-size", defaults.BufferSize, "set size for membuf")
 	flagForceColors     = fs.Bool("c", false, "force colors")
+
+	flagParquet             = fs.Bool("parquet", false, "convert the audit records into an apache parquet file written to stdout")
+	flagParquetRowGroupSize = fs.Int("parquet-rowgroup", defaults.ParquetRowGroupSize, "number of audit records per parquet row group")
+	flagParquetCompression  = fs.String("parquet-compression", defaults.ParquetCompression, "compression codec for parquet files: snappy, gzip, zstd or none")
+
+	flagZeek     = fs.Bool("zeek", false, "convert the audit records into a zeek log written to stdout")
+	flagZeekJSON = fs.Bool("zeek-json", false, "write the zeek log as JSON instead of tab separated values")
+
+	flagEve = fs.Bool("eve", false, "convert the audit records into suricata EVE JSON events written to stdout")
+
+	flagCommunityIDSeed = fs.Int("community-id-seed", defaults.CommunityIDSeed, "seed for the Community ID flow hashes of zeek logs and EVE events, if the audit records do not contain them")
+
+	flagIPFIX                  = fs.Bool("ipfix", false, "export Connection audit records as IPFIX or NetFlow v9 flow records written to stdout")
+	flagIPFIXCollector         = fs.String("ipfix-collector", "", "send the flow records to the UDP address of a flow collector instead, e.g. 127.0.0.1:4739")
+	flagIPFIXVersion           = fs.Int("ipfix-version", defaults.IPFIXVersion, "version of the flow records: 10 for IPFIX or 9 for NetFlow v9")
+	flagIPFIXEnterpriseNumber  = fs.Uint("ipfix-pen", defaults.IPFIXEnterpriseNumber, "private enterprise number of the netcap information elements in IPFIX templates")
+	flagIPFIXObservationDomain = fs.Uint("ipfix-domain", 0, "observation domain id of the flow exporter")
+
+	flagSQLite          = fs.String("sqlite", "", "insert the audit records into the sqlite database at the path, existing tables are appended to")
+	flagSQLiteBatchSize = fs.Int("sqlite-batch", defaults.SQLiteBatchSize, "number of audit records inserted per sqlite transaction")
+
+	flagStart = fs.String("start", "", "only dump audit records at or after this time, e.g. 2020-12-28 14:02:00, uses the time index of the file if available")
+	flagStop  = fs.String("stop", "", "only dump audit records at or before this time, e.g. 2020-12-28 14:05:00, uses the time index of the file if available")
+
+	flagDynamic = fs.Bool("dynamic", false, "decode the audit records with the schema embedded in the file instead of the types of this version, prints structured or JSON output")
 )
@@ -20,7 +20,9 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/araddon/dateparse"
 	"github.com/evilsocket/islazy/tui"
 	"github.com/mgutz/ansi"
 
@@ -49,7 +51,7 @@ func Run() {
 	// abort if there is no input or no live capture
 	if *flagInput == "" {
 		printHeader()
-		fmt.Println(ansi.Red + "> nothing to do. need a NETCAP audit record file (.ncap.gz or .ncap) with the read flag (-read)" + ansi.Reset)
+		fmt.Println(ansi.Red + "> nothing to do. need a NETCAP audit record file (.ncap.gz, .ncap.zst or .ncap) with the read flag (-read)" + ansi.Reset)
 		os.Exit(1)
 	}
 
@@ -80,6 +82,7 @@ func Run() {
 			{"Version", h.Version},
 			{"Type", h.Type.String()},
 			{"ContainsPayloads", strconv.FormatBool(h.ContainsPayloads)},
+			{"Schema", schemaInfo(h)},
 		})
 		os.Exit(0) // bye bye
 	}
@@ -90,7 +93,17 @@ func Run() {
 	types.FieldSeparator = *flagStructSeparator
 
 	// read ncap file and print to stdout
-	if filepath.Ext(*flagInput) == defaults.FileExtension || filepath.Ext(*flagInput) == ".gz" {
+	if ext := filepath.Ext(*flagInput); ext == defaults.FileExtension || ext == ".gz" || ext == ".zst" {
+		start, errStart := parseTime(*flagStart)
+		if errStart != nil {
+			log.Fatal("invalid start time: ", errStart)
+		}
+
+		stop, errStop := parseTime(*flagStop)
+		if errStop != nil {
+			log.Fatal("invalid stop time: ", errStop)
+		}
+
 		err = io.Dump(
 			os.Stdout,
 			io.DumpConfig{
@@ -105,6 +118,30 @@ func Run() {
 				JSON:         *flagJSON,
 				CSV:          *flagCSV,
 				ForceColors:  *flagForceColors,
+
+				Parquet:             *flagParquet,
+				ParquetRowGroupSize: *flagParquetRowGroupSize,
+				ParquetCompression:  *flagParquetCompression,
+
+				Zeek:     *flagZeek,
+				ZeekJSON: *flagZeekJSON,
+
+				Eve:             *flagEve,
+				CommunityIDSeed: *flagCommunityIDSeed,
+
+				IPFIX:                  *flagIPFIX,
+				IPFIXCollector:         *flagIPFIXCollector,
+				IPFIXVersion:           *flagIPFIXVersion,
+				IPFIXEnterpriseNumber:  uint32(*flagIPFIXEnterpriseNumber),
+				IPFIXObservationDomain: uint32(*flagIPFIXObservationDomain),
+
+				SQLite:          *flagSQLite,
+				SQLiteBatchSize: *flagSQLiteBatchSize,
+
+				Start: start,
+				End:   stop,
+
+				Dynamic: *flagDynamic,
 			},
 		)
 		if err != nil {
@@ -114,3 +151,40 @@ func Run() {
 		return
 	}
 }
+
+// parseTime parses a time for the time range, in UTC unless -utc=false is set.
+// An empty string returns the zero time.
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	loc := time.Local
+	if *flagUTC {
+		loc = time.UTC
+	}
+
+	return dateparse.ParseIn(s, loc)
+}
+
+// schemaInfo describes the schema embedded in the header and its compatibility with this version.
+func schemaInfo(h *types.Header) string {
+	if len(h.Schema) == 0 {
+		return "none"
+	}
+
+	info := h.SchemaMessage + ", " + strconv.Itoa(len(h.Schema)) + " bytes"
+
+	diff, err := io.CheckSchema(h)
+
+	switch {
+	case err != nil:
+		return info + ", " + err.Error()
+	case diff.Equal():
+		return info + ", identical to this version"
+	case diff.Compatible():
+		return info + ", compatible with this version (" + diff.String() + ")"
+	default:
+		return info + ", incompatible with this version (" + diff.String() + ")"
+	}
+}
@@ -75,7 +75,7 @@ func Run() {
 	}
 
 	switch {
-	case filepath.Ext(*flagInput) == defaults.FileExtension || filepath.Ext(*flagInput) == ".gz":
+	case filepath.Ext(*flagInput) == defaults.FileExtension || filepath.Ext(*flagInput) == ".gz" || filepath.Ext(*flagInput) == ".zst":
 		metrics.ServeMetricsAt(*flagMetricsAddress, nil)
 		exportFile(*flagInput)
 	case *flagDir != "":
@@ -112,38 +112,58 @@ func Run() {
 			Promisc:             *flagPromiscMode,
 			LogErrors:           *flagLogErrors,
 			DecoderConfig: &config.Config{
-				Buffer:               *flagBuffer,
-				Compression:          *flagCompress,
-				CSV:                  *flagCSV,
-				IncludeDecoders:      *flagInclude,
-				ExcludeDecoders:      *flagExclude,
-				Out:                  *flagOutDir,
-				Source:               source,
-				IncludePayloads:      *flagPayload,
-				ExportMetrics:        true,
-				AddContext:           *flagContext,
-				MemBufferSize:        *flagMemBufferSize,
-				FlushEvery:           *flagFlushevery,
-				DefragIPv4:           *flagDefragIPv4,
-				Checksum:             *flagChecksum,
-				NoOptCheck:           *flagNooptcheck,
-				IgnoreFSMerr:         *flagIgnorefsmerr,
-				AllowMissingInit:     *flagAllowmissinginit,
-				Debug:                *flagDebug,
-				HexDump:              *flagHexdump,
-				WaitForConnections:   *flagWaitForConnections,
-				WriteIncomplete:      *flagWriteincomplete,
-				MemProfile:           *flagMemprofile,
-				ConnFlushInterval:    *flagConnFlushInterval,
-				ConnTimeOut:          *flagConnTimeOut,
-				FlowFlushInterval:    *flagFlowFlushInterval,
-				FlowTimeOut:          *flagFlowTimeOut,
-				CloseInactiveTimeOut: *flagCloseInactiveTimeout,
-				ClosePendingTimeOut:  *flagClosePendingTimeout,
-				FileStorage:          *flagFileStorage,
-				CalculateEntropy:     *flagCalcEntropy,
-				Quiet:                false,
-				PrintProgress:        false,
+				Buffer:                      *flagBuffer,
+				Compression:                 *flagCompress,
+				CSV:                         *flagCSV,
+				IncludeDecoders:             *flagInclude,
+				ExcludeDecoders:             *flagExclude,
+				Out:                         *flagOutDir,
+				Source:                      source,
+				IncludePayloads:             *flagPayload,
+				ExportMetrics:               true,
+				AddContext:                  *flagContext,
+				MemBufferSize:               *flagMemBufferSize,
+				FlushEvery:                  *flagFlushevery,
+				DefragIPv4:                  *flagDefragIPv4,
+				DefragIPv6:                  *flagDefragIPv6,
+				DefragIPv6Timeout:           *flagDefragIPv6Timeout,
+				DefragIPv6MaxFragments:      *flagDefragIPv6MaxFragments,
+				DefragIPv6MaxDatagrams:      *flagDefragIPv6MaxDatagrams,
+				DefragIPv6BufferSize:        *flagDefragIPv6Buffer,
+				DefragIPv6TinyFragmentSize:  *flagDefragIPv6TinyFragment,
+				OverlapPolicy:               *flagOverlapPolicy,
+				ReassemblyPageBudget:        *flagReassemblyPageBudget,
+				ReassemblyEviction:          *flagReassemblyEviction,
+				IncrementalStreamDecoding:   *flagIncrementalDecoding,
+				IncrementalStreamBufferSize: *flagIncrementalBuffer,
+				UDPStreamIdleTimeout:        *flagUDPIdleTimeout,
+				UDPStreamMaxAge:             *flagUDPMaxAge,
+				UDPStreamMemoryBudget:       *flagUDPMemBudget,
+				SCTPIdleTimeout:             *flagSCTPIdleTimeout,
+				StreamDecoderPorts:          *flagStreamPorts,
+				StreamDecoderForce:          *flagStreamForce,
+				CommunityIDSeed:             *flagCommunityIDSeed,
+				Checksum:                    *flagChecksum,
+				NoOptCheck:                  *flagNooptcheck,
+				IgnoreFSMerr:                *flagIgnorefsmerr,
+				AllowMissingInit:            *flagAllowmissinginit,
+				Debug:                       *flagDebug,
+				HexDump:                     *flagHexdump,
+				WaitForConnections:          *flagWaitForConnections,
+				WriteIncomplete:             *flagWriteincomplete,
+				MemProfile:                  *flagMemprofile,
+				ConnFlushInterval:           *flagConnFlushInterval,
+				ConnTimeOut:                 *flagConnTimeOut,
+				FlowFlushInterval:           *flagFlowFlushInterval,
+				FlowTimeOut:                 *flagFlowTimeOut,
+				CloseInactiveTimeOut:        *flagCloseInactiveTimeout,
+				ClosePendingTimeOut:         *flagClosePendingTimeout,
+				FileStorage:                 *flagFileStorage,
+				ConnectionFeatures:          *flagConnFeatures,
+				ConnectionFeaturesPackets:   *flagConnPackets,
+				CalculateEntropy:            *flagCalcEntropy,
+				Quiet:                       false,
+				PrintProgress:               false,
 			},
 			BaseLayer:     utils.GetBaseLayer(*flagBaseLayer),
 			DecodeOptions: utils.GetDecodeOptions(*flagDecodeOptions),
@@ -160,6 +180,7 @@ func Run() {
 			OutDirPermission:      0o700,
 			FreeOSMem:             0,
 			ReassembleConnections: true,
+			Decapsulate:           defaults.Decapsulate,
 		})
 
 		metrics.ServeMetricsAt(*flagMetricsAddress, c)
@@ -63,7 +63,7 @@ func exportDir(path string) {
 			ext   = filepath.Ext(fName)
 		)
 
-		if ext == defaults.FileExtension || ext == ".gz" {
+		if ext == defaults.FileExtension || ext == ".gz" || ext == ".zst" {
 			if !*flagReplay {
 				fmt.Println("exporting", fName)
 
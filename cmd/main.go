@@ -59,6 +59,7 @@ const (
 	extConfig = ".conf"
 	extNetcap = defaults.FileExtension
 	extGzip   = ".gz"
+	extZstd   = ".zst"
 )
 
 var (
@@ -205,7 +206,7 @@ func printCompletions(previous, current, full string) {
 			printFlagsFiltered(capture.Flags())
 		case cmdUtil:
 			if previous == nameReadFlag {
-				printFileForExt(extNetcap, extGzip)
+				printFileForExt(extNetcap, extGzip, extZstd)
 			}
 
 			handleConfigFlag()
@@ -226,14 +227,14 @@ func printCompletions(previous, current, full string) {
 			printFlagsFiltered(label.Flags())
 		case cmdExport:
 			if previous == nameReadFlag {
-				printFileForExt(extNetcap, extGzip, extPCAP, extPCAPNG)
+				printFileForExt(extNetcap, extGzip, extZstd, extPCAP, extPCAPNG)
 			}
 
 			handleConfigFlag()
 			printFlagsFiltered(export.Flags())
 		case cmdDump:
 			if previous == nameReadFlag {
-				printFileForExt(extNetcap, extGzip)
+				printFileForExt(extNetcap, extGzip, extZstd)
 			}
 
 			handleConfigFlag()
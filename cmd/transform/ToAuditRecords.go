@@ -55,6 +55,23 @@ var maltegoBaseConfig = collector.Config{
 		AddContext:                     true,
 		FlushEvery:                     100,
 		DefragIPv4:                     defaults.DefragIPv4,
+		DefragIPv6:                     defaults.DefragIPv6,
+		DefragIPv6Timeout:              defaults.DefragIPv6Timeout,
+		DefragIPv6MaxFragments:         defaults.DefragIPv6MaxFragments,
+		DefragIPv6MaxDatagrams:         defaults.DefragIPv6MaxDatagrams,
+		DefragIPv6BufferSize:           defaults.DefragIPv6BufferSize,
+		DefragIPv6TinyFragmentSize:     defaults.DefragIPv6TinyFragmentSize,
+		ReassemblyPageBudget:           defaults.ReassemblyPageBudget,
+		ReassemblyEviction:             defaults.ReassemblyEviction,
+		IncrementalStreamDecoding:      defaults.IncrementalStreamDecoding,
+		IncrementalStreamBufferSize:    defaults.IncrementalStreamBufferSize,
+		UDPStreamIdleTimeout:           defaults.UDPStreamIdleTimeout,
+		UDPStreamMaxAge:                defaults.UDPStreamMaxAge,
+		UDPStreamMemoryBudget:          defaults.UDPStreamMemoryBudget,
+		SCTPIdleTimeout:                defaults.SCTPIdleTimeout,
+		StreamDecoderPorts:             defaults.StreamDecoderPorts,
+		StreamDecoderForce:             defaults.StreamDecoderForce,
+		CommunityIDSeed:                defaults.CommunityIDSeed,
 		Checksum:                       defaults.Checksum,
 		NoOptCheck:                     defaults.NoOptCheck,
 		IgnoreFSMerr:                   defaults.IgnoreFSMErr,
@@ -72,6 +89,8 @@ var maltegoBaseConfig = collector.Config{
 		ClosePendingTimeOut:            defaults.ClosePendingTimeout,
 		FileStorage:                    defaults.FileStorage,
 		CalculateEntropy:               false,
+		ConnectionFeatures:             false,
+		ConnectionFeaturesPackets:      defaults.ConnectionFeaturesPackets,
 		SaveConns:                      true,
 		TCPDebug:                       false,
 		UseRE2:                         true,
@@ -101,6 +120,7 @@ var maltegoBaseConfig = collector.Config{
 	OutDirPermission:      0o700,
 	FreeOSMem:             0,
 	ReassembleConnections: true,
+	Decapsulate:           defaults.Decapsulate,
 }
 
 func toAuditRecords() {
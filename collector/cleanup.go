@@ -27,7 +27,6 @@ import (
 	"github.com/dustin/go-humanize"
 	"go.uber.org/zap"
 
-	"github.com/dreadl0ck/netcap/decoder/stream/tcp"
 	"github.com/dreadl0ck/netcap/resolvers"
 )
 
@@ -78,9 +77,11 @@ func (c *Collector) cleanup(force bool) {
 
 	if c.config.ReassembleConnections {
 		// teardown the TCP stream reassembly and print stats
-		tcp.CleanupReassembly(!force, c.assemblers)
+		c.streamFactory.CleanupReassembly(!force, c.assemblers)
 	}
 
+	c.streamFactory.ReleaseMemoryBudget(c.assemblers)
+
 	c.teardown()
 }
 
@@ -39,9 +39,8 @@ import (
 
 	"github.com/dreadl0ck/netcap/decoder/core"
 	"github.com/dreadl0ck/netcap/decoder/packet"
-	"github.com/dreadl0ck/netcap/decoder/stream/service"
+	"github.com/dreadl0ck/netcap/decoder/stream"
 	"github.com/dreadl0ck/netcap/decoder/stream/tcp"
-	"github.com/dreadl0ck/netcap/decoder/stream/udp"
 	decoderutils "github.com/dreadl0ck/netcap/decoder/utils"
 	"github.com/dreadl0ck/netcap/defaults"
 	netio "github.com/dreadl0ck/netcap/io"
@@ -63,7 +62,7 @@ type Collector struct {
 	totalBytesWritten int64
 	numPackets        int64
 	numWorkers        int
-	workers           []chan gopacket.Packet
+	workers           []*packetWorker
 	start             time.Time
 
 	// when running multiple epochs, the timestamp of the first run can be preserved.
@@ -73,6 +72,9 @@ type Collector struct {
 	packetDecoders           []packet.DecoderAPI
 	streamDecoders           []core.StreamDecoderAPI
 	abstractDecoders         []core.DecoderAPI
+	packetState              *packet.State
+	decoders                 *stream.Decoders
+	streamFactory            *tcp.StreamFactory
 	progressString           string
 	next                     int
 	unkownPcapWriterAtomic   *atomicPcapGoWriter
@@ -92,6 +94,12 @@ type Collector struct {
 	shutdown                 bool
 	isLive                   bool
 
+	// packets handed over between workers
+	forwardWg sync.WaitGroup
+
+	// workers that have received the stop signal on their input
+	workersStopped sync.WaitGroup
+
 	// logging
 	log           *zap.Logger // collector.log
 	netcapLog     *log.Logger // netcap.log
@@ -140,18 +148,35 @@ func (c *Collector) stopWorkers() {
 	c.mu.Lock()
 	for i, w := range c.workers {
 		select {
-		case w <- nil:
+		case w.in <- nil:
 			c.log.Info("worker done", zap.Int("num", i))
 			//case <-time.After(5 * time.Second):
 			//	fmt.Println("worker", i, "seems stuck, skipping...")
 		}
 	}
+
+	// no new packets are read, wait until the packets handed over between workers have been processed
+	c.workersStopped.Wait()
+	c.forwardWg.Wait()
+
+	for _, w := range c.workers {
+		close(w.forwarded)
+	}
 	c.mu.Unlock()
 }
 
 // handleSignals catches signals and runs the cleanup
 // SIGQUIT is not caught, to allow debugging by producing a stack and goroutine trace.
 func (c *Collector) handleSignals() {
+	if c.config.HTTPShutdownEndpoint {
+		c.printlnStdOut("serving http shutdown endpoint")
+		go c.serveCleanupHTTPEndpoint()
+	}
+
+	if c.config.NoSignalHandler {
+		return
+	}
+
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 
@@ -172,11 +197,6 @@ func (c *Collector) handleSignals() {
 		c.cleanup(true)
 		os.Exit(0)
 	}()
-
-	if c.config.HTTPShutdownEndpoint {
-		c.printlnStdOut("serving http shutdown endpoint")
-		go c.serveCleanupHTTPEndpoint()
-	}
 }
 
 func (c *Collector) serveCleanupHTTPEndpoint() {
@@ -251,32 +271,17 @@ func (c *Collector) serveCleanupHTTPEndpoint() {
 }
 
 // to decode incoming packets in parallel
-// they are passed to several worker goroutines in round robin style.
+// they are passed to the worker goroutine that owns the flow of the packet.
 func (c *Collector) handlePacket(p gopacket.Packet) {
-	// make it work for 1 worker only, can be used for debugging
-	if c.numWorkers == 1 {
-		c.workers[0] <- p
-
-		return
-	}
-
-	c.workers[c.next] <- p
-
-	// increment or reset next
-	if c.config.Workers == c.next+1 {
-		// reset
-		c.next = 0
-	} else {
-		c.next++
-	}
+	c.workers[c.workerIndex(p)].in <- p
 }
 
 // to decode incoming packets in parallel
-// they are passed to several worker goroutines in round robin style.
+// they are passed to the worker goroutine that owns the flow of the packet.
 func (c *Collector) handlePacketTimeout(p gopacket.Packet) {
 	select {
 	// send the packetInfo to the decoder routine
-	case c.workers[c.next] <- p:
+	case c.workers[c.workerIndex(p)].in <- p:
 	case <-time.After(3 * time.Second):
 		pkt := gopacket.NewPacket(p.Data(), c.config.BaseLayer, gopacket.Default)
 
@@ -295,14 +300,27 @@ func (c *Collector) handlePacketTimeout(p gopacket.Packet) {
 
 		fmt.Println("handle packet timeout", nf, tf)
 	}
+}
+
+// workerIndex returns the worker for a packet read from the input.
+// Packets without an IP layer do not belong to a flow,
+// they are passed to the workers in round robin style.
+func (c *Collector) workerIndex(p gopacket.Packet) int {
+	if index, ok := c.shardIndex(p.Data(), c.config.BaseLayer); ok {
+		return index
+	}
+
+	index := c.next
 
 	// increment or reset next
-	if c.config.Workers == c.next+1 {
+	if c.numWorkers == c.next+1 {
 		// reset
 		c.next = 0
 	} else {
 		c.next++
 	}
+
+	return index
 }
 
 // print errors to stdout in red.
@@ -432,8 +450,10 @@ func (c *Collector) stats() {
 	}
 
 	if c.config.DecoderConfig.SaveConns {
-		_, _ = fmt.Fprintln(target, "saved TCP connections:", tcp.NumSavedTCPConns())
-		_, _ = fmt.Fprintln(target, "saved UDP conversations:", udp.NumSavedUDPConns())
+		c.decoders.Stats.Lock()
+		_, _ = fmt.Fprintln(target, "saved TCP connections:", c.decoders.Stats.SavedTCPConnections)
+		_, _ = fmt.Fprintln(target, "saved UDP conversations:", c.decoders.Stats.SavedUDPConnections)
+		c.decoders.Stats.Unlock()
 	}
 
 	// dump label manager stats table if configured
@@ -533,8 +553,8 @@ func (c *Collector) printProgressInterval() chan struct{} {
 						utils.Progress(curr, num),
 						// decoder.Flows.Size(), // TODO: fetch this info from stats?
 						// decoder.Connections.Size(), // TODO: fetch this info from stats?
-						packet.DeviceProfiles.Size(),
-						service.Store.Size(),
+						c.packetState.NumDeviceProfiles(),
+						c.decoders.Service.Store.Size(),
 						int(curr),
 						pps,
 					)
@@ -542,8 +562,8 @@ func (c *Collector) printProgressInterval() chan struct{} {
 						utils.Progress(curr, num),
 						// decoder.Flows.Size(), // TODO: fetch this info from stats?
 						// decoder.Connections.Size(), // TODO: fetch this info from stats?
-						packet.DeviceProfiles.Size(),
-						service.Store.Size(),
+						c.packetState.NumDeviceProfiles(),
+						c.decoders.Service.Store.Size(),
 						int(curr),
 						pps)
 				}
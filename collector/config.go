@@ -41,6 +41,7 @@ var DefaultConfig = Config{
 	ResolverConfig:      resolvers.DefaultConfig,
 	Timeout:             pcap.BlockForever,
 	LogErrors:           false,
+	Decapsulate:         defaults.Decapsulate,
 }
 
 // DefaultConfigDPI is a sane example configuration for use with Deep Packet Inspection.
@@ -57,6 +58,7 @@ var DefaultConfigDPI = Config{
 	DecoderConfig:       config.DefaultConfig,
 	ResolverConfig:      resolvers.DefaultConfig,
 	LogErrors:           false,
+	Decapsulate:         defaults.Decapsulate,
 }
 
 // Config contains configuration parameters
@@ -103,12 +105,19 @@ type Config struct {
 	// Use TCP reassembly
 	ReassembleConnections bool
 
+	// Decode packets transported in GRE, ERSPAN, VXLAN, Geneve, 6in4, Teredo and IP-in-IP tunnels as separate packets
+	Decapsulate bool
+
 	// LogErrors will log verbose packet decoding errors into the errors.log file
 	LogErrors bool
 
 	// NoPrompt will disable all human interaction prompts
 	NoPrompt bool
 
+	// NoSignalHandler will not install handlers for SIGINT and SIGTERM,
+	// which is useful when the collector is embedded into an application that handles them itself
+	NoSignalHandler bool
+
 	// HTTPShutdownEndpoint will run a HTTP service on localhost:60589
 	// sending a GET request there can be used to trigger teardown and audit record flushing
 	// which can be used as alternative to using OS signals
@@ -22,20 +22,20 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 
-	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
 	"github.com/dreadl0ck/netcap/decoder/packet"
 	"github.com/dreadl0ck/netcap/decoder/stream"
 	"github.com/dreadl0ck/netcap/decoder/stream/tcp"
-	decoderutils "github.com/dreadl0ck/netcap/decoder/utils"
 	"github.com/dreadl0ck/netcap/defaults"
 	"github.com/dreadl0ck/netcap/dpi"
-	"github.com/dreadl0ck/netcap/reassembly"
 	"github.com/dreadl0ck/netcap/resolvers"
 	"github.com/dreadl0ck/netcap/types"
 )
 
 var errAborted = errors.New("operation aborted by user")
 
+// registerMetrics ensures the audit record metrics are only registered once per process.
+var registerMetrics sync.Once
+
 // Init sets up the collector and starts the configured number of workers
 // must be called prior to usage of the collector instance.
 func (c *Collector) Init() (err error) {
@@ -48,18 +48,19 @@ func (c *Collector) Init() (err error) {
 		c.config.Timeout = pcap.BlockForever
 	}
 
-	// set configuration for decoder pkgs
-	packet.SetConfig(c.config.DecoderConfig)
-
-	decoderconfig.Instance = c.config.DecoderConfig
-	stream.Debug = c.config.DecoderConfig.Debug
 	if c.config.Labels != "" {
 		io.InitLabelManager(c.config.Labels, c.config.DecoderConfig.Debug, c.config.Scatter, c.config.ScatterDuration)
 	}
 
-	// create state machine options
-	tcp.StreamFactory.FSMOptions = reassembly.TCPSimpleFSMOptions{
-		SupportMissingEstablishment: c.config.DecoderConfig.AllowMissingInit,
+	// create the decoder state owned by this collector
+	c.packetState = packet.NewState(c.config.DecoderConfig)
+	c.packetState.SetShards(c.config.Workers)
+	c.decoders = stream.NewDecoders(c.config.DecoderConfig, c.errorMap)
+
+	// create the TCP stream reassembly, its assemblers are created for the shards of the workers
+	c.streamFactory, err = tcp.NewStreamFactory(c.config.DecoderConfig, c.decoders, c.packetState)
+	if err != nil {
+		return err
 	}
 
 	// handle signals for a clean exit
@@ -95,7 +96,7 @@ func (c *Collector) Init() (err error) {
 	resolvers.Init(c.config.ResolverConfig, c.config.DecoderConfig.Quiet)
 
 	if c.config.ResolverConfig.LocalDNS {
-		packet.LocalDNS = true
+		c.packetState.LocalDNS = true
 	}
 
 	// check for files from previous run in the output directory
@@ -103,6 +104,7 @@ func (c *Collector) Init() (err error) {
 	var (
 		// create paths
 		files, _     = filepath.Glob(filepath.Join(c.config.DecoderConfig.Out, "*.ncap.gz"))
+		filesZstd, _ = filepath.Glob(filepath.Join(c.config.DecoderConfig.Out, "*.ncap.zst"))
 		filesBare, _ = filepath.Glob(filepath.Join(c.config.DecoderConfig.Out, "*.ncap"))
 		udpPath      = filepath.Join(c.config.DecoderConfig.Out, "udp")
 		tcpPath      = filepath.Join(c.config.DecoderConfig.Out, "tcp")
@@ -113,6 +115,7 @@ func (c *Collector) Init() (err error) {
 	)
 
 	// collect files
+	files = append(files, filesZstd...)
 	files = append(files, filesBare...)
 
 	// check
@@ -144,13 +147,15 @@ func (c *Collector) Init() (err error) {
 	c.netcapLog.Println("initializing decoders... ")
 
 	if c.config.DecoderConfig.ExportMetrics {
-		for i, m := range types.Metrics {
-			err = prometheus.Register(m)
-			if err != nil {
-				spew.Dump(m)
-				log.Fatal("array index:", i, ", error:", err)
+		// the metrics are shared by all collectors in the process
+		registerMetrics.Do(func() {
+			for i, m := range types.Metrics {
+				if errRegister := prometheus.Register(m); errRegister != nil {
+					spew.Dump(m)
+					log.Fatal("array index:", i, ", error:", errRegister)
+				}
 			}
-		}
+		})
 	}
 
 	encoder.SetConfig(&encoder.Config{
@@ -169,35 +174,32 @@ func (c *Collector) Init() (err error) {
 	go func() {
 		// initialize decoders
 		var errInit error
-		c.goPacketDecoders, errInit = packet.InitGoPacketDecoders(c.config.DecoderConfig)
+		c.goPacketDecoders, errInit = c.packetState.InitGoPacketDecoders()
 		handleDecoderInitError(errInit, "gopacket")
 		wg.Done()
 	}()
 
 	go func() {
 		var errInit error
-		c.packetDecoders, errInit = packet.InitPacketDecoders(c.config.DecoderConfig)
+		c.packetDecoders, errInit = c.packetState.InitPacketDecoders()
 		handleDecoderInitError(errInit, "packet")
 		wg.Done()
 	}()
 
 	go func() {
 		var errInit error
-		c.streamDecoders, errInit = stream.InitDecoders(c.config.DecoderConfig)
+		c.streamDecoders, errInit = c.decoders.InitStreamDecoders()
 		handleDecoderInitError(errInit, "stream")
 		wg.Done()
 	}()
 
 	go func() {
 		var errInit error
-		c.abstractDecoders, errInit = stream.InitAbstractDecoders(c.config.DecoderConfig)
+		c.abstractDecoders, errInit = c.decoders.InitAbstractDecoders()
 		handleDecoderInitError(errInit, "abstract")
 		wg.Done()
 	}()
 
-	// set pointer of collectors atomic counter map in decoder pkg
-	decoderutils.SetErrorMap(c.errorMap)
-
 	// create pcap files for packets
 	// with unknown protocols or errors while decoding
 	if err = c.createUnknownPcap(); err != nil {
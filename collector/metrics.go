@@ -15,6 +15,8 @@ package collector
 
 import (
 	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/dreadl0ck/netcap/decoder/stream/tcp"
 )
 
 var (
@@ -67,6 +69,33 @@ var (
 		},
 		[]string{},
 	)
+	reassemblyMemoryBytes = prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name: "nc_reassembly_memory_bytes",
+			Help: "Memory used for buffered out-of-order data and connections by the TCP reassembly",
+		},
+		func() float64 {
+			return float64(tcp.MemoryStats().UsedBytes)
+		},
+	)
+	reassemblyEvictedBytesTotal = prometheus.NewCounterFunc(
+		prometheus.CounterOpts{
+			Name: "nc_reassembly_evicted_bytes_total",
+			Help: "Memory released by evicting connections to stay within the TCP reassembly memory budget",
+		},
+		func() float64 {
+			return float64(tcp.MemoryStats().EvictedBytes)
+		},
+	)
+	reassemblyForcedClosesTotal = prometheus.NewCounterFunc(
+		prometheus.CounterOpts{
+			Name: "nc_reassembly_forced_closes_total",
+			Help: "Number of TCP streams closed to stay within the TCP reassembly memory budget",
+		},
+		func() float64 {
+			return float64(tcp.MemoryStats().ForcedCloses)
+		},
+	)
 )
 
 func init() {
@@ -78,5 +107,8 @@ func init() {
 		gopacketDecoderTime,
 		reassemblyTime,
 		newPacketsPerSecond,
+		reassemblyMemoryBytes,
+		reassemblyEvictedBytesTotal,
+		reassemblyForcedClosesTotal,
 	)
 }
@@ -14,6 +14,7 @@
 package collector
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log"
@@ -26,6 +27,7 @@ import (
 	"github.com/dreadl0ck/gopacket/pcapgo"
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
 )
 
 const errReadingPacketData = "error reading packet data"
@@ -107,6 +109,12 @@ func countPackets(path string) (count int64, err error) {
 
 // CollectPcap implements parallel decoding of incoming packets.
 func (c *Collector) CollectPcap(path string) error {
+	return c.CollectPcapContext(path, context.Background())
+}
+
+// CollectPcapContext implements parallel decoding of incoming packets,
+// reading stops when the context is canceled and the packets read so far are processed before returning.
+func (c *Collector) CollectPcapContext(path string, ctx context.Context) error {
 	// stat input file
 	stat, err := os.Stat(path)
 	if err != nil {
@@ -158,7 +166,14 @@ func (c *Collector) CollectPcap(path string) error {
 		stopProgress = c.printProgressInterval()
 	)
 
-	for { // fetch the next packet data and packet header
+	for {
+		if ctx.Err() != nil {
+			c.log.Info("pcap collection canceled via context", zap.String("path", path))
+
+			break
+		}
+
+		// fetch the next packet data and packet header
 		data, ci, err = r.ReadPacketData()
 		if err != nil {
 			if errors.Is(err, io.EOF) {
@@ -14,6 +14,7 @@
 package collector
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -24,6 +25,7 @@ import (
 	"github.com/dreadl0ck/gopacket/pcapgo"
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
 )
 
 // openPcap opens pcap files.
@@ -78,6 +80,12 @@ func countPacketsNG(path string) (count int64, err error) {
 
 // CollectPcapNG implements parallel decoding of incoming packets.
 func (c *Collector) CollectPcapNG(path string) error {
+	return c.CollectPcapNGContext(path, context.Background())
+}
+
+// CollectPcapNGContext implements parallel decoding of incoming packets,
+// reading stops when the context is canceled and the packets read so far are processed before returning.
+func (c *Collector) CollectPcapNGContext(path string, ctx context.Context) error {
 	// stat input file
 	stat, err := os.Stat(path)
 	if err != nil {
@@ -130,6 +138,12 @@ func (c *Collector) CollectPcapNG(path string) error {
 	)
 
 	for {
+		if ctx.Err() != nil {
+			c.log.Info("pcapng collection canceled via context", zap.String("path", path))
+
+			break
+		}
+
 		// fetch the next packet data and packet header
 		data, ci, err = r.ReadPacketData()
 		if err != nil {
@@ -0,0 +1,241 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package collector
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/dreadl0ck/gopacket"
+	"github.com/dreadl0ck/gopacket/layers"
+
+	"github.com/dreadl0ck/netcap/decoder/stream/tcp"
+)
+
+// Packets are sharded to the workers by their flow,
+// so that all packets of a connection are processed by the same worker.
+// This allows each worker to own its reassembly state without locking.
+
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+
+	// limits the number of IPv6 extension headers that are skipped when looking for the transport layer.
+	maxIPv6ExtensionHeaders = 8
+)
+
+// forwardedPacket is a packet that has been handed over to the worker owning its flow.
+type forwardedPacket struct {
+	pkt   gopacket.Packet
+	depth int
+}
+
+// packetWorker owns the reassembly state for a subset of all flows.
+type packetWorker struct {
+	index int
+
+	// packets read from the input
+	in chan gopacket.Packet
+
+	// packets handed over by other workers
+	forwarded chan *forwardedPacket
+
+	shard *tcp.Shard
+}
+
+// shardIndex returns the index of the worker that owns the flow of the raw packet data.
+// ok is false if the packet does not contain an IPv4 or IPv6 layer.
+func (c *Collector) shardIndex(data []byte, first gopacket.LayerType) (index int, ok bool) {
+	if c.numWorkers == 1 {
+		return 0, true
+	}
+
+	h, ok := flowHash(data, first)
+	if !ok {
+		return 0, false
+	}
+
+	return int(h % uint64(c.numWorkers)), true
+}
+
+// forward hands the packet over to the worker that owns its flow.
+// It returns false if the current worker owns the flow and should process the packet itself.
+// A packet is never processed by a worker that does not own its flow.
+// If the queue of the owner is full, the worker processes the packets handed over to itself while waiting,
+// so that two workers forwarding to each other can not deadlock.
+func (c *Collector) forward(w *packetWorker, p gopacket.Packet, depth int) bool {
+	allLayers := p.Layers()
+	if len(allLayers) == 0 {
+		return false
+	}
+
+	index, ok := c.shardIndex(p.Data(), allLayers[0].LayerType())
+	if !ok || index == w.index {
+		return false
+	}
+
+	c.forwardWg.Add(1)
+
+	f := &forwardedPacket{pkt: p, depth: depth}
+
+	for {
+		select {
+		case c.workers[index].forwarded <- f:
+			return true
+		case own := <-w.forwarded:
+			// the channel is only closed after all forwarded packets have been processed,
+			// this includes the packet that is currently being handed over.
+			c.processForwarded(own, w)
+		}
+	}
+}
+
+// flowHash calculates a hash over the addresses, ports and transport protocol of a packet,
+// without decoding it with gopacket. The hash is symmetric,
+// packets of both directions of a connection produce the same value.
+// Fragments only carry the transport header in the first fragment,
+// their hash is therefore calculated over the addresses and protocol only.
+func flowHash(data []byte, first gopacket.LayerType) (uint64, bool) {
+	var etherType uint16
+
+	switch first {
+	case layers.LayerTypeEthernet:
+		if len(data) < 14 {
+			return 0, false
+		}
+
+		etherType = binary.BigEndian.Uint16(data[12:14])
+		data = data[14:]
+
+		// skip VLAN tags
+		for etherType == uint16(layers.EthernetTypeDot1Q) || etherType == uint16(layers.EthernetTypeQinQ) || etherType == 0x9100 {
+			if len(data) < 4 {
+				return 0, false
+			}
+
+			etherType = binary.BigEndian.Uint16(data[2:4])
+			data = data[4:]
+		}
+	case layers.LayerTypeLinuxSLL:
+		if len(data) < 16 {
+			return 0, false
+		}
+
+		etherType = binary.BigEndian.Uint16(data[14:16])
+		data = data[16:]
+	case layers.LayerTypeIPv4:
+		etherType = uint16(layers.EthernetTypeIPv4)
+	case layers.LayerTypeIPv6:
+		etherType = uint16(layers.EthernetTypeIPv6)
+	default:
+		return 0, false
+	}
+
+	var (
+		src, dst []byte
+		proto    byte
+		payload  []byte
+	)
+
+	switch layers.EthernetType(etherType) {
+	case layers.EthernetTypeIPv4:
+		if len(data) < 20 {
+			return 0, false
+		}
+
+		ihl := int(data[0]&0x0f) * 4
+		if ihl < 20 || len(data) < ihl {
+			return 0, false
+		}
+
+		src, dst, proto = data[12:16], data[16:20], data[9]
+
+		// the transport header is only present, if neither the more fragments flag nor a fragment offset is set
+		if binary.BigEndian.Uint16(data[6:8])&0x3fff == 0 {
+			payload = data[ihl:]
+		}
+	case layers.EthernetTypeIPv6:
+		if len(data) < 40 {
+			return 0, false
+		}
+
+		src, dst, proto = data[8:24], data[24:40], data[6]
+		payload = data[40:]
+
+	extensions:
+		for i := 0; i < maxIPv6ExtensionHeaders; i++ {
+			switch layers.IPProtocol(proto) {
+			case layers.IPProtocolIPv6HopByHop, layers.IPProtocolIPv6Routing, layers.IPProtocolIPv6Destination:
+				if len(payload) < 8 {
+					payload = nil
+
+					break extensions
+				}
+
+				proto = payload[0]
+				if l := (int(payload[1]) + 1) * 8; len(payload) >= l {
+					payload = payload[l:]
+				} else {
+					payload = nil
+				}
+			case layers.IPProtocolIPv6Fragment:
+				if len(payload) >= 1 {
+					proto = payload[0]
+				}
+
+				payload = nil
+
+				break extensions
+			default:
+				break extensions
+			}
+		}
+	default:
+		return 0, false
+	}
+
+	a := make([]byte, 0, len(src)+2)
+	b := make([]byte, 0, len(dst)+2)
+	a = append(a, src...)
+	b = append(b, dst...)
+
+	switch layers.IPProtocol(proto) {
+	case layers.IPProtocolTCP, layers.IPProtocolUDP, layers.IPProtocolSCTP:
+		if len(payload) >= 4 {
+			a = append(a, payload[0:2]...)
+			b = append(b, payload[2:4]...)
+		}
+	}
+
+	// order the endpoints to get the same hash for both directions
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+
+	h := uint64(fnvOffset64)
+	for _, d := range [][]byte{a, b, {proto}} {
+		for _, v := range d {
+			h ^= uint64(v)
+			h *= fnvPrime64
+		}
+	}
+
+	// the low bits of FNV are poorly distributed for inputs that differ in few bits,
+	// mix in the high bits since the hash is used modulo the number of workers.
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+
+	return h, true
+}
@@ -0,0 +1,236 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package collector
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dreadl0ck/gopacket"
+	"github.com/dreadl0ck/gopacket/layers"
+
+	"github.com/dreadl0ck/netcap/decoder/config"
+	"github.com/dreadl0ck/netcap/decoder/packet"
+	"github.com/dreadl0ck/netcap/decoder/stream"
+	"github.com/dreadl0ck/netcap/decoder/stream/tcp"
+)
+
+func serializeShardTestPacket(t *testing.T, l ...gopacket.SerializableLayer) []byte {
+	t.Helper()
+
+	buf := gopacket.NewSerializeBuffer()
+	if err := gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true}, l...); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func shardTestTCPPacket(t *testing.T, src, dst net.IP, srcPort, dstPort layers.TCPPort, vlan bool) []byte {
+	t.Helper()
+
+	eth := &layers.Ethernet{
+		SrcMAC:       net.HardwareAddr{1, 2, 3, 4, 5, 6},
+		DstMAC:       net.HardwareAddr{6, 5, 4, 3, 2, 1},
+		EthernetType: layers.EthernetTypeIPv4,
+	}
+	ip := &layers.IPv4{Version: 4, IHL: 5, TTL: 64, Protocol: layers.IPProtocolTCP, SrcIP: src, DstIP: dst}
+	tcp := &layers.TCP{SrcPort: srcPort, DstPort: dstPort, DataOffset: 5}
+
+	if vlan {
+		eth.EthernetType = layers.EthernetTypeDot1Q
+
+		return serializeShardTestPacket(t, eth, &layers.Dot1Q{VLANIdentifier: 10, Type: layers.EthernetTypeIPv4}, ip, tcp)
+	}
+
+	return serializeShardTestPacket(t, eth, ip, tcp)
+}
+
+func TestFlowHash(t *testing.T) {
+	var (
+		a = net.IP{192, 168, 1, 1}
+		b = net.IP{10, 0, 0, 1}
+	)
+
+	hash := func(data []byte, first gopacket.LayerType) uint64 {
+		h, ok := flowHash(data, first)
+		if !ok {
+			t.Fatal("failed to hash packet")
+		}
+
+		return h
+	}
+
+	forward := hash(shardTestTCPPacket(t, a, b, 51000, 443, false), layers.LayerTypeEthernet)
+
+	if reverse := hash(shardTestTCPPacket(t, b, a, 443, 51000, false), layers.LayerTypeEthernet); reverse != forward {
+		t.Fatal("hash is not symmetric")
+	}
+
+	if tagged := hash(shardTestTCPPacket(t, b, a, 443, 51000, true), layers.LayerTypeEthernet); tagged != forward {
+		t.Fatal("expected VLAN tag to be skipped")
+	}
+
+	if other := hash(shardTestTCPPacket(t, a, b, 51001, 443, false), layers.LayerTypeEthernet); other == forward {
+		t.Fatal("expected different hash for a different source port")
+	}
+
+	// the same flow, starting with the IP layer
+	ip := shardTestTCPPacket(t, a, b, 51000, 443, false)[14:]
+	if h := hash(ip, layers.LayerTypeIPv4); h != forward {
+		t.Fatal("expected the same hash for a packet without link layer")
+	}
+
+	// fragments are hashed on the addresses and protocol only
+	frag := append([]byte(nil), ip...)
+	frag[6] |= 0x20 // more fragments
+
+	other := append([]byte(nil), frag...)
+	other[20], other[21] = 0, 1 // source port
+
+	if hash(frag, layers.LayerTypeIPv4) != hash(other, layers.LayerTypeIPv4) {
+		t.Fatal("expected ports to be ignored for fragments")
+	}
+
+	arp := serializeShardTestPacket(t,
+		&layers.Ethernet{SrcMAC: net.HardwareAddr{1, 2, 3, 4, 5, 6}, DstMAC: net.HardwareAddr{6, 5, 4, 3, 2, 1}, EthernetType: layers.EthernetTypeARP},
+		&layers.ARP{AddrType: layers.LinkTypeEthernet, Protocol: layers.EthernetTypeIPv4, HwAddressSize: 6, ProtAddressSize: 4, SourceHwAddress: []byte{1, 2, 3, 4, 5, 6}, SourceProtAddress: a, DstHwAddress: []byte{0, 0, 0, 0, 0, 0}, DstProtAddress: b},
+	)
+	if _, ok := flowHash(arp, layers.LayerTypeEthernet); ok {
+		t.Fatal("expected non IP packet to be rejected")
+	}
+}
+
+// shardTestFragments returns the IPv4 fragments of a TCP segment with payload.
+func shardTestFragments(t *testing.T, src, dst net.IP, srcPort, dstPort layers.TCPPort) []gopacket.Packet {
+	t.Helper()
+
+	ip := &layers.IPv4{Version: 4, IHL: 5, TTL: 64, Protocol: layers.IPProtocolTCP, SrcIP: src, DstIP: dst}
+	segment := &layers.TCP{SrcPort: srcPort, DstPort: dstPort, Seq: 1000, SYN: true, DataOffset: 5}
+	if err := segment.SetNetworkLayerForChecksum(ip); err != nil {
+		t.Fatal(err)
+	}
+
+	// TCP header and payload, split at a multiple of 8 bytes
+	data := serializeShardTestPacket(t, segment, gopacket.Payload(make([]byte, 32)))
+
+	var (
+		ts    = time.Unix(1600000000, 0)
+		frags []gopacket.Packet
+	)
+
+	for _, f := range []struct {
+		offset int
+		end    int
+		flags  layers.IPv4Flag
+	}{
+		{0, 24, layers.IPv4MoreFragments},
+		{24, len(data), 0},
+	} {
+		frag := *ip
+		frag.Id = 42
+		frag.Flags = f.flags
+		frag.FragOffset = uint16(f.offset / 8)
+
+		p := gopacket.NewPacket(serializeShardTestPacket(t,
+			&layers.Ethernet{SrcMAC: net.HardwareAddr{1, 2, 3, 4, 5, 6}, DstMAC: net.HardwareAddr{6, 5, 4, 3, 2, 1}, EthernetType: layers.EthernetTypeIPv4},
+			&frag,
+			gopacket.Payload(data[f.offset:f.end]),
+		), layers.LayerTypeEthernet, gopacket.Default)
+
+		p.Metadata().Timestamp = ts
+		p.Metadata().CaptureInfo.Timestamp = ts
+
+		frags = append(frags, p)
+	}
+
+	return frags
+}
+
+func TestForwardDefragmentedSegment(t *testing.T) {
+	conf := &config.Config{DefragIPv4: true}
+
+	c := New(Config{DecoderConfig: conf, ReassembleConnections: true})
+	c.packetState = packet.NewState(conf)
+	c.packetState.SetShards(4)
+	c.decoders = stream.NewDecoders(conf, c.errorMap)
+
+	var err error
+	if c.streamFactory, err = tcp.NewStreamFactory(conf, c.decoders, c.packetState); err != nil {
+		t.Fatal(err)
+	}
+
+	// workers without goroutines, the test processes the packets and the forwarded queues itself
+	c.numWorkers = 4
+	for i := 0; i < c.numWorkers; i++ {
+		c.workers = append(c.workers, &packetWorker{
+			index:     i,
+			forwarded: make(chan *forwardedPacket, 10),
+			shard:     c.streamFactory.NewShard(),
+		})
+	}
+
+	var (
+		a = net.IP{192, 168, 1, 1}
+		b = net.IP{10, 0, 0, 1}
+	)
+
+	// fragments are sharded without their ports, find a connection that is owned by another worker
+	frags := shardTestFragments(t, a, b, 51000, 443)
+	fragOwner, _ := c.shardIndex(frags[0].Data(), layers.LayerTypeEthernet)
+
+	var srcPort layers.TCPPort
+	for srcPort = 51000; srcPort < 52000; srcPort++ {
+		if owner, _ := c.shardIndex(shardTestTCPPacket(t, a, b, srcPort, 443, false), layers.LayerTypeEthernet); owner != fragOwner {
+			break
+		}
+	}
+
+	frags = shardTestFragments(t, a, b, srcPort, 443)
+	for _, f := range frags {
+		if owner, _ := c.shardIndex(f.Data(), layers.LayerTypeEthernet); owner != fragOwner {
+			t.Fatal("expected fragments to be sharded to the same worker")
+		}
+
+		c.decodePacket(f, c.workers[fragOwner], 0)
+	}
+
+	owner := -1
+	for i, w := range c.workers {
+		select {
+		case f := <-w.forwarded:
+			owner = i
+			c.processForwarded(f, w)
+		default:
+		}
+	}
+
+	if owner < 0 || owner == fragOwner {
+		t.Fatal("expected the reassembled segment to be forwarded to the worker owning the connection")
+	}
+
+	// only the shard of the owner tracks the connection
+	for i, w := range c.workers {
+		want := "Remaining 0 connections"
+		if i == owner {
+			want = "Remaining 1 connections"
+		}
+
+		if dump := w.shard.Assembler.StreamPool().DumpString(); !strings.HasPrefix(dump, want) {
+			t.Errorf("worker %d: expected %q, got %q", i, want, dump)
+		}
+	}
+}
@@ -18,21 +18,94 @@ import (
 	"time"
 
 	"github.com/dreadl0ck/gopacket"
+	"github.com/dreadl0ck/gopacket/layers"
 
 	"github.com/dreadl0ck/netcap/decoder/packet"
 	"github.com/dreadl0ck/netcap/decoder/stream/tcp"
-	"github.com/dreadl0ck/netcap/reassembly"
 	"github.com/dreadl0ck/netcap/types"
 	"github.com/dreadl0ck/netcap/utils"
 )
 
+// maxDecapsulationDepth limits the number of nested tunnels that will be decapsulated.
+const maxDecapsulationDepth = 4
+
 // worker spawns a new worker goroutine
-// and returns a channel for receiving input packets.
-func (c *Collector) worker(assembler *reassembly.Assembler) chan gopacket.Packet {
+// that processes the packets on the input channel of w and the packets forwarded by other workers.
+func (c *Collector) worker(w *packetWorker) {
 	var (
-		in  = make(chan gopacket.Packet, c.config.PacketBufferSize)
+		in  = w.in
 		pkt gopacket.Packet
+	)
+
+	// start worker
+	go func() {
+		for {
+			select {
+			case pkt = <-in:
+				// nil packet is used to stop reading from the input,
+				// the worker keeps processing forwarded packets until the channel is closed
+				if pkt == nil {
+					in = nil
+
+					c.workersStopped.Done()
+
+					continue
+				}
+
+				pkt.Metadata().Timestamp = pkt.Metadata().CaptureInfo.Timestamp
+				pkt.Metadata().Length = pkt.Metadata().CaptureInfo.Length
+				pkt.Metadata().CaptureLength = pkt.Metadata().CaptureInfo.CaptureLength
+
+				c.decodePacket(pkt, w, 0)
+
+				c.wg.Done()
+			case f, ok := <-w.forwarded:
+				if !ok {
+					return
+				}
+
+				c.processForwarded(f, w)
+			}
+		}
+	}()
+}
 
+// processForwarded processes a packet that has been handed over to the worker w by another worker.
+func (c *Collector) processForwarded(f *forwardedPacket, w *packetWorker) {
+	c.decodePacket(f.pkt, w, f.depth)
+	c.forwardWg.Done()
+}
+
+// defragment passes the packet to the defragmenter, if it is a fragment and defragmentation is enabled.
+// It returns whether the packet is a fragment, and the reassembled datagram once the fragment completed it.
+func (c *Collector) defragment(pkt gopacket.Packet) (fragment bool, datagram gopacket.Packet) {
+	if c.config.DecoderConfig.DefragIPv4 {
+		if ip4, ok := pkt.Layer(layers.LayerTypeIPv4).(*layers.IPv4); ok && (ip4.Flags&layers.IPv4MoreFragments != 0 || ip4.FragOffset != 0) {
+			return true, c.streamFactory.DefragIPv4(pkt)
+		}
+	}
+
+	if c.config.DecoderConfig.DefragIPv6 && pkt.Layer(layers.LayerTypeIPv6Fragment) != nil {
+		return true, c.streamFactory.DefragIPv6(pkt)
+	}
+
+	return false, nil
+}
+
+// reassemblePacket passes the packet to the reassembly of the worker.
+func (c *Collector) reassemblePacket(pkt gopacket.Packet, w *packetWorker) {
+	t := time.Now()
+
+	tcp.ReassemblePacket(pkt, w.shard)
+	reassemblyTime.WithLabelValues().Set(float64(time.Since(t).Nanoseconds()))
+}
+
+// decodePacket passes the packet to reassembly and all decoders.
+// If the packet carries a tunneled packet, the inner packet is decoded
+// as a separate packet after the outer one has been processed.
+// Reassembled datagrams are decoded as separate packets as well, after the fragment that completed them.
+func (c *Collector) decodePacket(pkt gopacket.Packet, w *packetWorker, depth int) {
+	var (
 		errLayer gopacket.ErrorLayer
 		err      error
 
@@ -44,168 +117,211 @@ func (c *Collector) worker(assembler *reassembly.Assembler) chan gopacket.Packet
 		netLayer       gopacket.NetworkLayer
 		transportLayer gopacket.TransportLayer
 		layer          gopacket.Layer
-	)
 
-	// start worker
-	go func() {
-		for pkt = range in {
-			// nil packet is used to exit goroutine
-			if pkt == nil {
-				return
-			}
+		inner       gopacket.Packet
+		tunnelIndex int
+		allLayers   = pkt.Layers()
 
-			pkt.Metadata().Timestamp = pkt.Metadata().CaptureInfo.Timestamp
-			pkt.Metadata().Length = pkt.Metadata().CaptureInfo.Length
-			pkt.Metadata().CaptureLength = pkt.Metadata().CaptureInfo.CaptureLength
+		// set if the transport layer gopacket reports for the packet belongs to the tunneled packet
+		tunneledTransport bool
 
-			// pass packet to reassembly
-			if c.config.ReassembleConnections {
-				t := time.Now()
-				tcp.ReassemblePacket(pkt, assembler)
-				reassemblyTime.WithLabelValues().Set(float64(time.Since(t).Nanoseconds()))
-			}
-
-			// create context for packet
-			ctx := &types.PacketContext{}
+		// the flow of a fragment is tracked by the decoders for the reassembled datagram
+		fragment, datagram = c.defragment(pkt)
+	)
 
-			if c.config.DecoderConfig.AddContext {
-				netLayer = pkt.NetworkLayer()
-				transportLayer = pkt.TransportLayer()
+	// packets are only processed by the worker owning their flow,
+	// the packet decoders keep the state for the flow in the shard of that worker
+	if len(allLayers) > 0 {
+		if _, ok = c.shardIndex(pkt.Data(), allLayers[0].LayerType()); ok {
+			packet.SetShard(pkt, w.index)
+		}
+	}
 
-				if netLayer != nil {
-					ctx.SrcIP = netLayer.NetworkFlow().Src().String()
-					ctx.DstIP = netLayer.NetworkFlow().Dst().String()
-				}
+	if c.config.Decapsulate && depth < maxDecapsulationDepth {
+		if inner, tunnelIndex = packet.Decapsulate(pkt, c.config.DecodeOptions); inner != nil {
+			// layers after the tunnel belong to the inner packet and are decoded with it
+			allLayers = allLayers[:tunnelIndex+1]
+			tunneledTransport = packet.TransportLayerTunneled(pkt, tunnelIndex)
+		}
+	}
 
-				if transportLayer != nil {
-					ctx.SrcPort = utils.DecodePort(transportLayer.TransportFlow().Src().Raw())
-					ctx.DstPort = utils.DecodePort(transportLayer.TransportFlow().Dst().Raw())
-				}
-			}
+	// pass packet to reassembly
+	// the transport layer of a tunneled packet belongs to the inner packet, which is reassembled instead,
+	// the same applies to fragments and the reassembled datagram
+	if c.config.ReassembleConnections && inner == nil && !fragment {
+		c.reassemblePacket(pkt, w)
+	}
 
-			// iterate over all layers
-			for _, layer = range pkt.Layers() {
+	// create context for packet
+	// the tunnel of a decapsulated packet is always set, it identifies the virtual network of the records
+	ctx := &types.PacketContext{
+		Tunnel: packet.GetTunnel(pkt),
+	}
 
-				// increment counter for layer type
-				c.allProtosAtomic.Inc(layer.LayerType().String())
+	if c.config.DecoderConfig.AddContext {
+		netLayer = pkt.NetworkLayer()
+		transportLayer = pkt.TransportLayer()
 
-				if c.config.DecoderConfig.ExportMetrics {
-					allProtosTotal.WithLabelValues(layer.LayerType().String()).Inc()
-				}
+		if netLayer != nil {
+			ctx.SrcIP = netLayer.NetworkFlow().Src().String()
+			ctx.DstIP = netLayer.NetworkFlow().Dst().String()
+		}
 
-				// check if packet contains an unknown layer
-				switch layer.LayerType() {
-				case gopacket.LayerTypeZero: // not known to gopacket
-					// increase counter
-					c.unknownProtosAtomic.Inc(layer.LayerType().String())
+		if transportLayer != nil && !tunneledTransport {
+			ctx.SrcPort = utils.DecodePort(transportLayer.TransportFlow().Src().Raw())
+			ctx.DstPort = utils.DecodePort(transportLayer.TransportFlow().Dst().Raw())
+		}
+	}
 
-					if c.config.DecoderConfig.ExportMetrics {
-						unknownProtosTotal.WithLabelValues(layer.LayerType().String()).Inc()
-					}
+	// iterate over all layers
+	for _, layer = range allLayers {
 
-					// write to unknown.pcap file
-					if err = c.writePacketToUnknownPcap(pkt); err != nil {
-						fmt.Println("failed to write packet to unknown.pcap file:", err)
-					}
+		// increment counter for layer type
+		c.allProtosAtomic.Inc(layer.LayerType().String())
 
-					// call custom decoders
-					goto done
-				case gopacket.LayerTypeDecodeFailure:
-					// call custom decoders
-					goto done
-				}
+		if c.config.DecoderConfig.ExportMetrics {
+			allProtosTotal.WithLabelValues(layer.LayerType().String()).Inc()
+		}
 
-				// pick decoders from the decoderMap by looking up the layer type
-				if decoders, ok = c.goPacketDecoders[layer.LayerType()]; ok {
-					for _, dec = range decoders {
-						t := time.Now()
-						err = dec.Decode(ctx, pkt, layer)
-						gopacketDecoderTime.WithLabelValues(layer.LayerType().String()).Set(float64(time.Since(t).Nanoseconds()))
-						if err != nil {
-							if c.config.DecoderConfig.ExportMetrics {
-								decodingErrorsTotal.WithLabelValues(layer.LayerType().String(), err.Error()).Inc()
-							}
-
-							if err = c.logPacketError(pkt, "GoPacketDecoder Error: "+layer.LayerType().String()+": "+err.Error()); err != nil {
-								fmt.Println("failed to log packet error:", err)
-							}
-
-							goto done
-						}
-					}
-				} else { // no netcap decoder implemented
+		// check if packet contains an unknown layer
+		switch layer.LayerType() {
+		case gopacket.LayerTypeZero: // not known to gopacket
+			// increase counter
+			c.unknownProtosAtomic.Inc(layer.LayerType().String())
 
-					// increment unknown layer type counter
-					c.unknownProtosAtomic.Inc(layer.LayerType().String())
-					if c.config.DecoderConfig.ExportMetrics {
-						unknownProtosTotal.WithLabelValues(layer.LayerType().String()).Inc()
-					}
+			if c.config.DecoderConfig.ExportMetrics {
+				unknownProtosTotal.WithLabelValues(layer.LayerType().String()).Inc()
+			}
 
-					// if its not a payload layer, write to unknown .pcap file
-					if layer.LayerType() != gopacket.LayerTypePayload {
-						if err = c.writePacketToUnknownPcap(pkt); err != nil {
-							fmt.Println("failed to write packet to unknown.pcap file:", err)
-						}
-					}
-				}
-			} // END goPacket.Layers()
+			// write to unknown.pcap file
+			if err = c.writePacketToUnknownPcap(pkt); err != nil {
+				fmt.Println("failed to write packet to unknown.pcap file:", err)
+			}
 
-		done:
 			// call custom decoders
-			for _, customDec = range c.packetDecoders {
+			goto done
+		case gopacket.LayerTypeDecodeFailure:
+			// call custom decoders
+			goto done
+		}
+
+		// pick decoders from the decoderMap by looking up the layer type
+		if decoders, ok = c.goPacketDecoders[layer.LayerType()]; ok {
+			for _, dec = range decoders {
 				t := time.Now()
-				err = customDec.Decode(pkt)
-				customDecoderTime.WithLabelValues(customDec.GetName()).Set(float64(time.Since(t).Nanoseconds()))
+				err = dec.Decode(ctx, pkt, layer)
+				gopacketDecoderTime.WithLabelValues(layer.LayerType().String()).Set(float64(time.Since(t).Nanoseconds()))
 				if err != nil {
 					if c.config.DecoderConfig.ExportMetrics {
-						decodingErrorsTotal.WithLabelValues(customDec.GetName(), err.Error()).Inc()
+						decodingErrorsTotal.WithLabelValues(layer.LayerType().String(), err.Error()).Inc()
 					}
-					if err = c.logPacketError(pkt, "PacketDecoder Error: "+customDec.GetName()+": "+err.Error()); err != nil {
+
+					if err = c.logPacketError(pkt, "GoPacketDecoder Error: "+layer.LayerType().String()+": "+err.Error()); err != nil {
 						fmt.Println("failed to log packet error:", err)
 					}
 
-					continue
+					goto done
 				}
 			}
+		} else { // no netcap decoder implemented
 
-			// Check for errors after decoding all layers
-			// if an error has occurred while decoding the packet
-			// it will be logged and written into the errors.pcap file
-			if errLayer = pkt.ErrorLayer(); errLayer != nil {
-				if err = c.logPacketError(pkt, errLayer.Error().Error()); err != nil {
-					fmt.Println("failed to log packet error:", err)
-				}
+			// increment unknown layer type counter
+			c.unknownProtosAtomic.Inc(layer.LayerType().String())
+			if c.config.DecoderConfig.ExportMetrics {
+				unknownProtosTotal.WithLabelValues(layer.LayerType().String()).Inc()
+			}
 
-				if c.config.DecoderConfig.ExportMetrics {
-					decodingErrorsTotal.WithLabelValues(errLayer.LayerType().String(), errLayer.Error().Error()).Inc()
+			// if its not a payload layer, write to unknown .pcap file
+			if layer.LayerType() != gopacket.LayerTypePayload {
+				if err = c.writePacketToUnknownPcap(pkt); err != nil {
+					fmt.Println("failed to write packet to unknown.pcap file:", err)
 				}
 			}
+		}
+	} // END goPacket.Layers()
+
+done:
+	// call custom decoders
+	for _, customDec = range c.packetDecoders {
+		decPkt := pkt
+
+		// gopacket reports the transport and application layers of the inner packet for the outer packet as well,
+		// decoders that track flows would mix the addresses of the tunnel with the inner ports, they only process the inner packet.
+		// The connection decoder tracks the outer flow of the tunnel without the layers of the inner packet.
+		// Fragments are accounted for by the flow decoders once the datagram has been reassembled.
+		if (inner != nil || fragment) && packet.IsFlowDecoder(customDec) {
+			if fragment || customDec.GetType() != types.Type_NC_Connection {
+				continue
+			}
 
-			c.wg.Done()
+			decPkt = packet.OuterPacket(pkt, tunnelIndex)
+		}
+
+		t := time.Now()
+		err = customDec.Decode(decPkt)
+		customDecoderTime.WithLabelValues(customDec.GetName()).Set(float64(time.Since(t).Nanoseconds()))
+		if err != nil {
+			if c.config.DecoderConfig.ExportMetrics {
+				decodingErrorsTotal.WithLabelValues(customDec.GetName(), err.Error()).Inc()
+			}
+			if err = c.logPacketError(pkt, "PacketDecoder Error: "+customDec.GetName()+": "+err.Error()); err != nil {
+				fmt.Println("failed to log packet error:", err)
+			}
 
 			continue
 		}
-	}()
+	}
 
-	// return input channel
-	return in
+	// Check for errors after decoding all layers
+	// if an error has occurred while decoding the packet
+	// it will be logged and written into the errors.pcap file
+	if errLayer = pkt.ErrorLayer(); errLayer != nil {
+		if err = c.logPacketError(pkt, errLayer.Error().Error()); err != nil {
+			fmt.Println("failed to log packet error:", err)
+		}
+
+		if c.config.DecoderConfig.ExportMetrics {
+			decodingErrorsTotal.WithLabelValues(errLayer.LayerType().String(), errLayer.Error().Error()).Inc()
+		}
+	}
+
+	// the inner packet belongs to a different flow than the tunnel
+	if inner != nil && !c.forward(w, inner, depth+1) {
+		c.decodePacket(inner, w, depth+1)
+	}
+
+	// fragments are sharded without their ports, the reassembled datagram can belong to a flow owned by another worker
+	if datagram != nil && !c.forward(w, datagram, depth) {
+		c.decodePacket(datagram, w, depth)
+	}
 }
 
 // spawn the configured number of workers.
-func (c *Collector) initWorkers() []chan gopacket.Packet {
+// Each worker owns a shard of the reassembly state.
+func (c *Collector) initWorkers() []*packetWorker {
 
 	// init worker slice
-	workers := make([]chan gopacket.Packet, c.config.Workers)
+	workers := make([]*packetWorker, c.config.Workers)
 
-	// create assemblers
+	// create shards
 	for i := range workers {
-		a := reassembly.NewAssembler(tcp.GetStreamPool())
-		c.assemblers = append(c.assemblers, a)
-		workers[i] = c.worker(a)
+		shard := c.streamFactory.NewShard()
+		c.assemblers = append(c.assemblers, shard.Assembler)
+		workers[i] = &packetWorker{
+			index:     i,
+			in:        make(chan gopacket.Packet, c.config.PacketBufferSize),
+			forwarded: make(chan *forwardedPacket, c.config.PacketBufferSize),
+			shard:     shard,
+		}
 	}
 
 	// update num worker count
 	c.numWorkers = len(workers)
+	c.workersStopped.Add(len(workers))
+
+	for _, w := range workers {
+		c.worker(w)
+	}
 
 	return workers
 }
@@ -14,64 +14,89 @@
 package config
 
 import (
+	"reflect"
 	"runtime"
 	"sync"
 	"time"
 
 	"github.com/dreadl0ck/netcap/defaults"
 	"github.com/dreadl0ck/netcap/io"
+	"github.com/dreadl0ck/netcap/types"
 )
 
-// Instance contains the config at runtime.
-var Instance *Config
-
 // DefaultConfig is a sane example configuration for the decoder package.
 var DefaultConfig = &Config{
-	Buffer:                     true,
-	MemBufferSize:              defaults.BufferSize,
-	Compression:                true,
-	CSV:                        false,
-	IncludeDecoders:            "",
-	ExcludeDecoders:            "",
-	Out:                        "",
-	Chan:                       false,
-	Proto:                      true,
-	Source:                     "",
-	IncludePayloads:            false,
-	ExportMetrics:              false,
-	AddContext:                 true,
-	FlushEvery:                 100,
-	DefragIPv4:                 false,
-	Checksum:                   false,
-	NoOptCheck:                 false,
-	IgnoreFSMerr:               false,
-	AllowMissingInit:           false,
-	Debug:                      false,
-	HexDump:                    false,
-	WaitForConnections:         true,
-	WriteIncomplete:            false,
-	MemProfile:                 "",
-	ConnFlushInterval:          10000,
-	ConnTimeOut:                10 * time.Second,
-	FlowFlushInterval:          2000,
-	FlowTimeOut:                10 * time.Second,
-	CloseInactiveTimeOut:       24 * time.Hour,
-	ClosePendingTimeOut:        5 * time.Second,
-	FileStorage:                defaults.FileStorage,
-	CalculateEntropy:           false,
-	SaveConns:                  false,
-	TCPDebug:                   false,
-	UseRE2:                     true,
-	HarvesterBannerSize:        512,
-	BannerSize:                 512,
-	StopAfterHarvesterMatch:    true,
-	StopAfterServiceProbeMatch: true,
-	IgnoreDecoderInitErrors:    true,
-	RemoveClosedStreams:        false,
-	CompressionBlockSize:       defaults.CompressionBlockSize,
-	CompressionLevel:           defaults.CompressionLevel,
-	NumStreamWorkers:           runtime.NumCPU(),
-	StreamBufferSize:           100,
+	Buffer:                      true,
+	MemBufferSize:               defaults.BufferSize,
+	OutputBufferSize:            defaults.OutputBufferSize,
+	ParquetRowGroupSize:         defaults.ParquetRowGroupSize,
+	ParquetCompression:          defaults.ParquetCompression,
+	Compression:                 true,
+	CSV:                         false,
+	IncludeDecoders:             "",
+	ExcludeDecoders:             "",
+	Out:                         "",
+	Chan:                        false,
+	Proto:                       true,
+	Source:                      "",
+	IncludePayloads:             false,
+	ExportMetrics:               false,
+	AddContext:                  true,
+	FlushEvery:                  100,
+	DefragIPv4:                  false,
+	DefragIPv6:                  false,
+	DefragIPv6Timeout:           60 * time.Second,
+	DefragIPv6MaxFragments:      256,
+	DefragIPv6MaxDatagrams:      4096,
+	DefragIPv6BufferSize:        32 << 20,
+	DefragIPv6TinyFragmentSize:  256,
+	ReassemblyPageBudget:        0,
+	ReassemblyEviction:          "oldest",
+	IncrementalStreamDecoding:   false,
+	IncrementalStreamBufferSize: 10 << 20,
+	UDPStreamIdleTimeout:        time.Minute,
+	UDPStreamMaxAge:             10 * time.Minute,
+	UDPStreamMemoryBudget:       0,
+	SCTPIdleTimeout:             5 * time.Minute,
+	StreamDecoderPorts:          "HTTP:80,8000,8080;POP3:110;SSH:22;SMTP:25,587,2525",
+	StreamDecoderForce:          "",
+	CommunityIDSeed:             0,
+	OverlapPolicy:               "",
+	Checksum:                    false,
+	NoOptCheck:                  false,
+	IgnoreFSMerr:                false,
+	AllowMissingInit:            false,
+	Debug:                       false,
+	HexDump:                     false,
+	WaitForConnections:          true,
+	WriteIncomplete:             false,
+	MemProfile:                  "",
+	ConnFlushInterval:           10000,
+	ConnTimeOut:                 10 * time.Second,
+	FlowFlushInterval:           2000,
+	FlowTimeOut:                 10 * time.Second,
+	CloseInactiveTimeOut:        24 * time.Hour,
+	ClosePendingTimeOut:         5 * time.Second,
+	FileStorage:                 defaults.FileStorage,
+	CalculateEntropy:            false,
+	ConnectionFeatures:          false,
+	ConnectionFeaturesPackets:   defaults.ConnectionFeaturesPackets,
+	SaveConns:                   false,
+	TCPDebug:                    false,
+	UseRE2:                      true,
+	HarvesterBannerSize:         512,
+	BannerSize:                  512,
+	StopAfterHarvesterMatch:     true,
+	StopAfterServiceProbeMatch:  true,
+	IgnoreDecoderInitErrors:     true,
+	RemoveClosedStreams:         false,
+	CompressionBlockSize:        defaults.CompressionBlockSize,
+	CompressionLevel:            defaults.CompressionLevel,
+	CompressionCodec:            defaults.CompressionCodec,
+	Index:                       true,
+	IndexBlockSize:              defaults.IndexBlockSize,
+	NumStreamWorkers:            runtime.NumCPU(),
+	StreamBufferSize:            100,
 }
 
 // Config contains configuration parameters
@@ -101,6 +126,10 @@ type Config struct {
 	// If a path is set files will be extracted and written to the specified path
 	FileStorage string
 
+	// OverlapPolicy configures how overlapping TCP segments are resolved per destination host or subnet,
+	// e.g. "default=bsd,10.0.0.0/8=windows,192.168.1.5=linux"
+	OverlapPolicy string
+
 	// Number of packets to arrive until the connections are checked for timeouts
 	ConnFlushInterval int
 
@@ -134,6 +163,10 @@ type Config struct {
 	// Used to flush connections to disk whose last timestamp is connTimeOut older than current packet
 	ConnTimeOut time.Duration
 
+	// Write Connection audit records for connections that have been idle longer than ConnTimeOut while capturing,
+	// instead of when the capture ends. The connections are checked in the background, in intervals of half the ConnTimeOut between one and ten seconds.
+	ExpireConnections bool
+
 	// Use the RE2 engine from the go standard library
 	// if this is set to false an alternative regex engine that is compatible to the .NET syntax will be used for service banner detection
 	UseRE2 bool
@@ -159,6 +192,9 @@ type Config struct {
 	// Size for the channel writer
 	ChanSize int
 
+	// Deliver the audit records in memory by sending them into this channel, instead of writing them to disk
+	Records chan<- types.AuditRecord `json:"-"`
+
 	// Generate CSV instead of audit records
 	CSV bool
 
@@ -187,9 +223,70 @@ type Config struct {
 	// Output JSON
 	JSON bool
 
+	// Output apache parquet files
+	Parquet bool
+
+	// Number of audit records per parquet row group
+	ParquetRowGroupSize int
+
+	// Compression codec for the pages of parquet files: snappy, gzip, zstd or none
+	ParquetCompression string
+
+	// Output zeek logs
+	Zeek bool
+
+	// Write the zeek logs as JSON instead of tab separated values
+	ZeekJSON bool
+
+	// Output suricata EVE JSON events
+	Eve bool
+
+	// Export Connection audit records as IPFIX or NetFlow version 9 flow records
+	IPFIX bool
+
+	// UDP address of the flow collector, the flow records are written to a file if not set
+	IPFIXCollector string
+
+	// Version of the flow records: 10 for IPFIX or 9 for NetFlow version 9
+	IPFIXVersion int
+
+	// Private enterprise number of the netcap information elements
+	IPFIXEnterpriseNumber uint32
+
+	// Observation domain id of the exporter
+	IPFIXObservationDomain uint32
+
+	// Insert the audit records into a sqlite database
+	SQLite bool
+
+	// Number of audit records inserted per sqlite transaction
+	SQLiteBatchSize int
+
+	// Start a new proto, csv or json file after this many bytes
+	RotateSize int64
+
+	// Start a new proto, csv or json file on each multiple of this interval
+	RotateInterval time.Duration
+
+	// Quota for the rotated files in the output directory, the oldest files are removed when it is exceeded
+	RetentionSize int64
+
+	// Write a time index next to each protobuf audit record file
+	Index bool
+
+	// Number of audit records per block of the time index
+	IndexBlockSize int
+
 	// Discard all data and write nothing to disk
 	Null bool
 
+	// Write the audit records to multiple outputs at once, comma separated list of: proto, csv, json, elastic, unix, parquet, zeek, eve, ipfix, sqlite
+	// Overrides the individual output settings if set
+	Outputs string
+
+	// Number of audit records buffered for each output when writing to multiple outputs
+	OutputBufferSize int
+
 	// Add context to supported audit records
 	AddContext bool
 
@@ -211,6 +308,12 @@ type Config struct {
 	// Calculate entropy for payloads in Ethernet and IP audit records
 	CalculateEntropy bool
 
+	// Collect packet length, inter-arrival time and byte distribution features for encrypted traffic analysis on Connection audit records
+	ConnectionFeatures bool
+
+	// Number of packets with payload whose lengths and inter-arrival times are collected when ConnectionFeatures is enabled
+	ConnectionFeaturesPackets int
+
 	// Save the entire raw TCP conversations for all tracked connections to disk
 	SaveConns bool
 
@@ -226,6 +329,58 @@ type Config struct {
 	// Defragment IPv4 packets
 	DefragIPv4 bool
 
+	// Defragment IPv6 packets
+	DefragIPv6 bool
+
+	// Incomplete IPv6 datagrams are discarded after this duration
+	DefragIPv6Timeout time.Duration
+
+	// IPv6 datagrams with more fragments are discarded
+	DefragIPv6MaxFragments int
+
+	// Limit for the number of IPv6 datagrams reassembled at the same time
+	DefragIPv6MaxDatagrams int
+
+	// Limit for the fragment data buffered for incomplete IPv6 datagrams in bytes
+	DefragIPv6BufferSize int
+
+	// Fragments except the last one smaller than this are discarded as tiny fragments
+	DefragIPv6TinyFragmentSize int
+
+	// Limit for the memory used by out-of-order pages and connection state of the TCP reassembly in bytes, 0 means unlimited.
+	// Data passed to the stream decoders is not included.
+	ReassemblyPageBudget int
+
+	// Connections to close first, once the memory budget is exceeded: oldest, largest or lru
+	ReassemblyEviction string
+
+	// Decode supported protocols while TCP connections are reassembled, instead of once they have been closed
+	IncrementalStreamDecoding bool
+
+	// Limit for the buffered data per connection direction during incremental decoding in bytes
+	IncrementalStreamBufferSize int
+
+	// UDP streams without packets for this duration are processed during capture, 0 disables the timeout
+	UDPStreamIdleTimeout time.Duration
+
+	// UDP streams older than this are processed during capture, 0 disables the limit
+	UDPStreamMaxAge time.Duration
+
+	// Limit for the memory used by buffered UDP stream data in bytes, 0 means unlimited
+	UDPStreamMemoryBudget int
+
+	// SCTP associations without packets for this duration are written during capture, 0 disables the timeout
+	SCTPIdleTimeout time.Duration
+
+	// Ports and port ranges each stream decoder is tried on first, e.g. HTTP:80,8080-8090;SMTP:25,587
+	StreamDecoderPorts string
+
+	// Stream decoders used for all conversations with a server, e.g. 10.0.0.1:8443=HTTP
+	StreamDecoderForce string
+
+	// Seed for the Community ID flow hashes, must be identical for all sensors whose records are correlated
+	CommunityIDSeed int
+
 	// ExportMetrics will export prometheus metrics
 	ExportMetrics bool
 
@@ -265,4 +420,30 @@ type Config struct {
 
 	// CompressionLevel is the compression level to use by default
 	CompressionLevel int
+
+	// CompressionCodec is the codec for compressed audit record files: gzip or zstd
+	CompressionCodec string
+}
+
+// Copy returns a copy of the configuration, that can be modified without affecting the original.
+// The mutex is not copied.
+func (c *Config) Copy() *Config {
+	c.Lock()
+	defer c.Unlock()
+
+	var (
+		cp  = new(Config)
+		src = reflect.ValueOf(c).Elem()
+		dst = reflect.ValueOf(cp).Elem()
+	)
+
+	for i := 0; i < src.NumField(); i++ {
+		if src.Type().Field(i).Name == "Mutex" {
+			continue
+		}
+
+		dst.Field(i).Set(src.Field(i))
+	}
+
+	return cp
 }
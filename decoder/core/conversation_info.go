@@ -13,7 +13,11 @@
 
 package core
 
-import "time"
+import (
+	"time"
+
+	"github.com/dreadl0ck/netcap/types"
+)
 
 // ConversationInfo is wrapper structure for traffic sent over a Transport protocol
 // to allow Transport agnostic decoding of data streams.
@@ -27,4 +31,7 @@ type ConversationInfo struct {
 	ServerIP   string
 	ClientPort int32
 	ServerPort int32
+
+	// tunnel the conversation was decapsulated from, if any
+	Tunnel *types.Tunnel
 }
@@ -52,3 +52,15 @@ type StreamDecoderInterface interface {
 	// Decode parses the stream according to the identified protocol.
 	Decode()
 }
+
+// IncrementalStreamDecoderInterface is implemented by stream decoders that can process a connection while it is being reassembled.
+// When incremental stream decoding is enabled, Consume is called for every reassembled data fragment
+// and records are emitted as soon as a message is complete, instead of waiting for the connection to be closed.
+// Decode is still called once the connection has been closed, to process the remaining buffered data.
+// The ConversationInfo passed to the factory does not contain any data fragments in this mode.
+type IncrementalStreamDecoderInterface interface {
+	StreamDecoderInterface
+
+	// Consume processes a data fragment of the connection.
+	Consume(data *StreamData)
+}
@@ -3,23 +3,28 @@ package db
 import (
 	"fmt"
 	"io"
+	"sync"
 
 	"github.com/blevesearch/bleve"
 	"go.uber.org/zap"
 )
 
 var (
-	// VulnerabilitiesIndex is the handle to the bleve database for vulnerability information
-	VulnerabilitiesIndex bleve.Index
-
-	// ExploitsIndex is the handle to the bleve database for exploit information
-	ExploitsIndex bleve.Index
-
 	// VulnerabilityDBName is the name of the database directory on disk
 	VulnerabilityDBName = "nvd.bleve"
 	dbLog               = zap.NewNop()
+
+	// indexes opened with AcquireBleve, by path
+	sharedIndexes   = make(map[string]*sharedIndex)
+	sharedIndexesMu sync.Mutex
 )
 
+// sharedIndex is a bleve index that is used by several decoders.
+type sharedIndex struct {
+	bleve.Index
+	refs int
+}
+
 // SetLogger will set the logger for this package.
 func SetLogger(l *zap.Logger) {
 	dbLog = l
@@ -47,3 +52,50 @@ func CloseBleve(index io.Closer) {
 		fmt.Println(err)
 	}
 }
+
+// AcquireBleve opens the bleve index at path, or returns it if it has already been opened.
+// Bleve locks the database while it is open, so decoders of collectors running in the same process share the index.
+// The index must be released with ReleaseBleve once it is no longer used.
+func AcquireBleve(path string) (bleve.Index, error) {
+	sharedIndexesMu.Lock()
+	defer sharedIndexesMu.Unlock()
+
+	if i, ok := sharedIndexes[path]; ok {
+		i.refs++
+
+		return i.Index, nil
+	}
+
+	index, err := OpenBleve(path)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedIndexes[path] = &sharedIndex{Index: index, refs: 1}
+
+	return index, nil
+}
+
+// ReleaseBleve releases an index returned by AcquireBleve, it is closed when it has been released by all users.
+func ReleaseBleve(index bleve.Index) {
+	if index == nil {
+		return
+	}
+
+	sharedIndexesMu.Lock()
+	defer sharedIndexesMu.Unlock()
+
+	for path, i := range sharedIndexes {
+		if i.Index != index {
+			continue
+		}
+
+		i.refs--
+		if i.refs == 0 {
+			delete(sharedIndexes, path)
+			CloseBleve(index)
+		}
+
+		return
+	}
+}
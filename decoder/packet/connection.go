@@ -16,7 +16,6 @@ package packet
 import (
 	"fmt"
 	"github.com/dreadl0ck/gopacket/layers"
-	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
 	"github.com/dreadl0ck/netcap/utils"
 	"log"
 	"strconv"
@@ -27,6 +26,8 @@ import (
 	"github.com/dreadl0ck/gopacket"
 	"github.com/gogo/protobuf/proto"
 
+	"github.com/dreadl0ck/netcap/decoder/config"
+	decoderutils "github.com/dreadl0ck/netcap/decoder/utils"
 	"github.com/dreadl0ck/netcap/types"
 )
 
@@ -37,23 +38,44 @@ type connectionID struct {
 	LinkFlowID      uint64
 	NetworkFlowID   uint64
 	TransportFlowID uint64
+
+	// identifies the tunnel for decapsulated packets,
+	// to separate overlapping address spaces of different virtual networks.
+	TunnelID uint64
 }
 
 func (c connectionID) String() string {
-	return strconv.FormatUint(c.LinkFlowID, 10) + strconv.FormatUint(c.NetworkFlowID, 10) + strconv.FormatUint(c.TransportFlowID, 10)
+	id := strconv.FormatUint(c.LinkFlowID, 10) + strconv.FormatUint(c.NetworkFlowID, 10) + strconv.FormatUint(c.TransportFlowID, 10)
+	if c.TunnelID != 0 {
+		id += "-" + strconv.FormatUint(c.TunnelID, 10)
+	}
+
+	return id
 }
 
 type connection struct {
 	sync.Mutex
 	*types.Connection
-	clientIP string
+
+	// address of the host that sent the first packet,
+	// the port is needed to tell the directions apart for connections between the same host
+	clientIP   string
+	clientPort string
+
+	// transport health analysis, only set for TCP connections
+	tcp *tcpAnalysis
+
+	// encrypted traffic analysis features, only set if enabled in the config
+	features *connectionFeatures
 
 	// to break the initialization loop when accessing the connectionDecoder variable within the connection processor
 	// we simply set a reference to it when passing connections to the workers.
 	decoder *Decoder
 }
 
-// atomicConnMap contains all connections and provides synchronized access.
+// atomicConnMap contains the connections of a shard and provides synchronized access.
+// A shard is only updated by the worker that owns it, the lock is held for the whole update of a connection
+// and is only contended by the expiry of idle connections.
 type atomicConnMap struct {
 	sync.Mutex
 	Items map[string]*connection
@@ -67,37 +89,126 @@ func (a *atomicConnMap) Size() int {
 	return len(a.Items)
 }
 
-var conns = &atomicConnMap{
-	Items: make(map[string]*connection),
+// shardedConnMap contains a connection table for each worker of the collector.
+// Packets are sharded to the workers by their flow, so each connection is only tracked in the table of its worker.
+// Packets without a shard, like those that do not belong to an IP flow, are tracked in the first table.
+// The tables are merged when the connections are flushed.
+type shardedConnMap struct {
+	shards []*atomicConnMap
+
+	// capture time of the packets, to expire idle connections
+	clock decoderutils.CaptureClock
+
+	// checks for idle connections in the background
+	expiry *decoderutils.Ticker
+}
+
+func newShardedConnMap(numShards int) *shardedConnMap {
+	if numShards < 1 {
+		numShards = 1
+	}
+
+	m := &shardedConnMap{
+		shards: make([]*atomicConnMap, numShards),
+	}
+	for i := range m.shards {
+		m.shards[i] = &atomicConnMap{
+			Items: make(map[string]*connection),
+		}
+	}
+
+	return m
+}
+
+// shard returns the table of the worker processing the packet.
+func (m *shardedConnMap) shard(p gopacket.Packet) *atomicConnMap {
+	if index, ok := Shard(p); ok && index < len(m.shards) {
+		return m.shards[index]
+	}
+
+	return m.shards[0]
+}
+
+// Size returns the number of connections in all shards.
+func (m *shardedConnMap) Size() (n int) {
+	for _, shard := range m.shards {
+		n += shard.Size()
+	}
+
+	return n
+}
+
+// shardIndex marks the worker that owns the flow of a packet in its ancillary data.
+type shardIndex int
+
+// SetShard records the index of the worker owning the flow of the packet,
+// so that packet decoders can keep their state for the flow in the shard of that worker.
+// The index of a packet decapsulated from a tunnel or forwarded to another worker is replaced.
+func SetShard(p gopacket.Packet, index int) {
+	md := p.Metadata()
+	for i, data := range md.AncillaryData {
+		if _, ok := data.(shardIndex); ok {
+			md.AncillaryData[i] = shardIndex(index)
+
+			return
+		}
+	}
+
+	md.AncillaryData = append(md.AncillaryData, shardIndex(index))
+}
+
+// Shard returns the index of the worker owning the flow of the packet, as recorded by SetShard.
+func Shard(p gopacket.Packet) (int, bool) {
+	for _, data := range p.Metadata().AncillaryData {
+		if index, ok := data.(shardIndex); ok {
+			return int(index), true
+		}
+	}
+
+	return 0, false
 }
 
 var connectionDecoder = newPacketDecoder(
 	types.Type_NC_Connection,
 	"Connection",
 	"A connection represents bi-directional network communication between two hosts based on the combined link-, network- and transport layer identifiers",
-	nil,
-	func(p gopacket.Packet) proto.Message {
-		return handlePacket(p)
-	},
 	func(decoder *Decoder) error {
+		if c := decoder.state.conf; c.ExpireConnections && c.ConnTimeOut > 0 {
+			decoder.state.conns.expiry = decoderutils.StartTicker(decoderutils.ExpiryInterval(c.ConnTimeOut), func() {
+				decoder.expireConns(decoder.state.conns.clock.Now())
+			})
+		}
 
-		cp := connectionProcessor{}
-		cp.initWorkers(decoderconfig.Instance.StreamBufferSize, decoderconfig.Instance.NumStreamWorkers)
+		return nil
+	},
+	func(d *Decoder, p gopacket.Packet) proto.Message {
+		d.state.conns.clock.Observe(p.Metadata().Timestamp)
 
-		conns.Lock()
-		cp.numTotal = len(conns.Items)
-		for _, conn := range conns.Items {
-			conn.decoder = decoder
-			cp.handleConnection(conn)
+		return handlePacket(d.state.conns, p, d.state.conf)
+	},
+	func(decoder *Decoder) error {
+		decoder.state.conns.expiry.Stop()
+
+		cp := connectionProcessor{quiet: decoder.state.conf.Quiet}
+		cp.initWorkers(decoder.state.conf.StreamBufferSize, decoder.state.conf.NumStreamWorkers)
+
+		// merge the connections of all shards
+		cp.numTotal = decoder.state.conns.Size()
+		for _, shard := range decoder.state.conns.shards {
+			shard.Lock()
+			for _, conn := range shard.Items {
+				conn.decoder = decoder
+				cp.handleConnection(conn)
+			}
+			shard.Unlock()
 		}
-		conns.Unlock()
 		cp.wg.Wait()
 
 		return nil
 	},
 )
 
-func handlePacket(p gopacket.Packet) proto.Message {
+func handlePacket(conns *shardedConnMap, p gopacket.Packet, conf *config.Config) proto.Message {
 	// assemble connectionID
 	connID := connectionID{}
 	ll := p.LinkLayer()
@@ -115,10 +226,16 @@ func handlePacket(p gopacket.Packet) proto.Message {
 		connID.TransportFlowID = tl.TransportFlow().FastHash()
 	}
 
+	tunnel := GetTunnel(p)
+	if tunnel != nil {
+		connID.TunnelID = TunnelID(p)
+	}
+
 	// lookup connection
-	conns.Lock()
+	shard := conns.shard(p)
+	shard.Lock()
 
-	if conn, ok := conns.Items[connID.String()]; ok {
+	if conn, ok := shard.Items[connID.String()]; ok {
 
 		conn.Lock()
 
@@ -153,8 +270,14 @@ func handlePacket(p gopacket.Packet) proto.Message {
 			conn.AppPayloadSize += int32(len(al.LayerPayload()))
 		}
 
+		fromClient := true
 		if nl != nil {
-			if conn.clientIP == nl.NetworkFlow().Src().String() {
+			fromClient = conn.clientIP == nl.NetworkFlow().Src().String()
+			if tl != nil {
+				fromClient = fromClient && conn.clientPort == tl.TransportFlow().Src().String()
+			}
+
+			if fromClient {
 				conn.BytesClientToServer += int64(p.Metadata().Length)
 			} else {
 				conn.BytesServerToClient += int64(p.Metadata().Length)
@@ -162,6 +285,10 @@ func handlePacket(p gopacket.Packet) proto.Message {
 		}
 		conn.NumPackets++
 		trackTCPStats(conn.Connection, p)
+		conn.analyzeTCP(p)
+		if conf.ConnectionFeatures {
+			conn.collectFeatures(p, fromClient, conf.ConnectionFeaturesPackets)
+		}
 		conn.TotalSize += int32(p.Metadata().Length)
 
 		// check if LAST timestamp was before the current packet
@@ -182,6 +309,7 @@ func handlePacket(p gopacket.Packet) proto.Message {
 		co.TimestampLast = p.Metadata().Timestamp.UnixNano()
 		co.TotalSize = int32(p.Metadata().Length)
 		co.NumPackets = 1
+		co.Tunnel = tunnel
 		trackTCPStats(co, p)
 
 		if ll != nil {
@@ -199,6 +327,7 @@ func handlePacket(p gopacket.Packet) proto.Message {
 			co.SrcPort = tl.TransportFlow().Src().String()
 			co.DstPort = tl.TransportFlow().Dst().String()
 		}
+		co.CommunityID = communityID(conf.CommunityIDSeed, p)
 		if al := p.ApplicationLayer(); al != nil {
 			co.ApplicationProto = al.LayerType().String()
 			co.AppPayloadSize = int32(len(al.LayerPayload()))
@@ -207,20 +336,19 @@ func handlePacket(p gopacket.Packet) proto.Message {
 		// track amount of transferred bytes
 		co.BytesClientToServer += int64(p.Metadata().Length)
 
-		conns.Items[connID.String()] = &connection{
+		conn := &connection{
 			Connection: co,
 			clientIP:   co.SrcIP,
+			clientPort: co.SrcPort,
+		}
+		conn.analyzeTCP(p)
+		if conf.ConnectionFeatures {
+			conn.collectFeatures(p, true, conf.ConnectionFeaturesPackets)
 		}
 
-		// TODO: add dedicated stats structure for decoder pkg
-		// conns := atomic.AddInt64(&stream.stats.numConns, 1)
-
-		// flush
-		//if conf.ConnFlushInterval != 0 && conns%int64(conf.ConnFlushInterval) == 0 {
-		//	cd.flushConns(p)
-		//}
+		shard.Items[connID.String()] = conn
 	}
-	conns.Unlock()
+	shard.Unlock()
 
 	return nil
 }
@@ -266,46 +394,67 @@ func movingAverage(current int32, newValue int32, n int32) int32 {
 	return (current + (newValue - current)) / n
 }
 
-/*func flushConns(p gopacket.Packet) {
-	var selectConns []*types.Connection
-
-	for id, entry := range conns.Items {
+// expireConns writes the connections whose last packet is older than the connection timeout
+// and removes them from the connection table, further packets of the flow start a new connection.
+func (d *Decoder) expireConns(now time.Time) {
+	var (
+		expired  []*connection
+		deadline = now.Add(-d.state.conf.ConnTimeOut).UnixNano()
+	)
+
+	for _, shard := range d.state.conns.shards {
+		shard.Lock()
+		for id, conn := range shard.Items {
+			conn.Lock()
+			idle := conn.TimestampLast < deadline
+			conn.Unlock()
+
+			if idle {
+				expired = append(expired, conn)
+				delete(shard.Items, id)
+			}
+		}
+		shard.Unlock()
+	}
 
-		// flush entries whose last timestamp is connTimeOut older than current packet
-		if p.Metadata().Timestamp.Sub(time.Unix(0, entry.TimestampLast)) > conf.ConnTimeOut {
+	for _, conn := range expired {
+		conn.write(d)
+	}
+}
 
-			selectConns = append(selectConns, entry.Connection)
+// write sets the analysis results on the audit record and writes it.
+func (c *connection) write(d *Decoder) {
+	if c.tcp != nil {
+		c.tcp.setFields(c.Connection)
+	}
 
-			// cleanup
-			delete(conns.Items, id)
-		}
+	if c.features != nil {
+		c.features.setFields(c.Connection, c.swapped())
 	}
 
-	// flush selection in background
-	go func() {
-		for _, selectedConn := range selectConns {
-			writeConn(selectedConn)
-		}
-	}()
-}*/
+	d.writeConn(c.Connection, c.swapped())
+}
+
+// swapped returns true if a packet captured before the first one has changed the direction of the connection,
+// after the bytes have been tracked for the client that sent the first packet.
+func (c *connection) swapped() bool {
+	return c.clientIP != c.SrcIP || c.clientPort != c.SrcPort
+}
 
 // writeConn writes the connection.
-func (d *Decoder) writeConn(conn *types.Connection, clientIP string) {
+func (d *Decoder) writeConn(conn *types.Connection, swapped bool) {
 
 	// calculate duration
 	conn.Duration = time.Unix(0, conn.TimestampLast).Sub(time.Unix(0, conn.TimestampFirst)).Nanoseconds()
 
-	// check if client IP for connection is still correct
-	if clientIP != conn.SrcIP {
-
-		// update client address
-		clientIP = conn.SrcIP
+	// check if the client for the connection is still correct
+	if swapped {
 
 		// swap num bytes tracked
 		conn.BytesClientToServer, conn.BytesServerToClient = conn.BytesServerToClient, conn.BytesClientToServer
 	}
 
-	if conf.ExportMetrics {
+	if d.state.conf.ExportMetrics {
 		conn.Inc()
 	}
 
@@ -328,6 +477,7 @@ type connectionProcessor struct {
 	numDone    int
 	numTotal   int
 	bufferSize int
+	quiet      bool
 }
 
 // to process the streams in parallel
@@ -365,12 +515,12 @@ func (cp *connectionProcessor) connectionWorker(wg *sync.WaitGroup) chan *connec
 				return
 			}
 
-			conn.decoder.writeConn(conn.Connection, conn.clientIP)
+			conn.write(conn.decoder)
 
 			cp.Lock()
 			cp.numDone++
 
-			if !decoderconfig.Instance.Quiet {
+			if !cp.quiet {
 				utils.ClearLine()
 				fmt.Print("processing remaining Connection audit records... ", "(", cp.numDone, "/", cp.numTotal, ")")
 			}
@@ -0,0 +1,172 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package packet
+
+import (
+	"math"
+	"time"
+
+	"github.com/dreadl0ck/gopacket"
+
+	"github.com/dreadl0ck/netcap/types"
+)
+
+// indices for the per direction statistics.
+const (
+	clientToServer = 0
+	serverToClient = 1
+)
+
+// connectionFeatures collects the sequence of packet lengths and times (SPLT),
+// the byte distribution of the payloads and per direction packet size and inter-arrival time statistics
+// for the classification of encrypted traffic.
+type connectionFeatures struct {
+	spltLengths   []int32
+	spltIntervals []int64
+	lastSPLT      time.Time
+
+	byteDistribution [256]int32
+
+	sizes      [2]runningStats
+	intervals  [2]runningStats
+	lastPacket [2]time.Time
+}
+
+// collectFeatures updates the encrypted traffic analysis features of the connection with the packet,
+// the lengths and inter-arrival times are collected for the first numPackets packets that carry a payload.
+func (c *connection) collectFeatures(p gopacket.Packet, fromClient bool, numPackets int) {
+	if c.features == nil {
+		c.features = &connectionFeatures{}
+	}
+
+	var payload []byte
+	if tl := p.TransportLayer(); tl != nil {
+		payload = tl.LayerPayload()
+	} else if nl := p.NetworkLayer(); nl != nil {
+		payload = nl.LayerPayload()
+	}
+
+	c.features.update(p.Metadata().Timestamp, p.Metadata().Length, payload, fromClient, numPackets)
+}
+
+func (f *connectionFeatures) update(ts time.Time, length int, payload []byte, fromClient bool, numPackets int) {
+	dir := serverToClient
+	if fromClient {
+		dir = clientToServer
+	}
+
+	f.sizes[dir].add(float64(length))
+
+	if last := f.lastPacket[dir]; !last.IsZero() {
+		f.intervals[dir].add(float64(interval(last, ts)))
+	}
+
+	if ts.After(f.lastPacket[dir]) {
+		f.lastPacket[dir] = ts
+	}
+
+	for _, b := range payload {
+		f.byteDistribution[b]++
+	}
+
+	if len(payload) == 0 || len(f.spltLengths) >= numPackets {
+		return
+	}
+
+	size := int32(len(payload))
+	if !fromClient {
+		size = -size
+	}
+
+	var iat int64
+	if len(f.spltLengths) > 0 {
+		iat = interval(f.lastSPLT, ts)
+	}
+
+	f.spltLengths = append(f.spltLengths, size)
+	f.spltIntervals = append(f.spltIntervals, iat)
+	f.lastSPLT = ts
+}
+
+// setFields adds the features to the connection audit record,
+// swap must be true if the client is the destination of the audit record.
+func (f *connectionFeatures) setFields(co *types.Connection, swap bool) {
+	src, dst := clientToServer, serverToClient
+	if swap {
+		src, dst = dst, src
+
+		for i := range f.spltLengths {
+			f.spltLengths[i] = -f.spltLengths[i]
+		}
+	}
+
+	co.SPLTLengths = f.spltLengths
+	co.SPLTIntervals = f.spltIntervals
+	co.ByteDistribution = f.byteDistribution[:]
+	co.PacketSizesClientToServer = f.sizes[src].stats()
+	co.PacketSizesServerToClient = f.sizes[dst].stats()
+	co.InterArrivalTimesClientToServer = f.intervals[src].stats()
+	co.InterArrivalTimesServerToClient = f.intervals[dst].stats()
+}
+
+// interval returns the time between two packets in nanoseconds,
+// packets that have been processed out of order have no interval.
+func interval(last, ts time.Time) int64 {
+	if d := ts.Sub(last); d > 0 {
+		return d.Nanoseconds()
+	}
+
+	return 0
+}
+
+// runningStats calculates the minimum, maximum, mean and standard deviation of a series of values,
+// without storing the values.
+type runningStats struct {
+	n        int64
+	min, max float64
+	mean     float64
+
+	// sum of the squared differences from the mean
+	m2 float64
+}
+
+func (r *runningStats) add(v float64) {
+	r.n++
+
+	if r.n == 1 || v < r.min {
+		r.min = v
+	}
+
+	if r.n == 1 || v > r.max {
+		r.max = v
+	}
+
+	delta := v - r.mean
+	r.mean += delta / float64(r.n)
+	r.m2 += delta * (v - r.mean)
+}
+
+// stats returns the statistics, or nil if no values have been added.
+func (r *runningStats) stats() *types.Stats {
+	if r.n == 0 {
+		return nil
+	}
+
+	return &types.Stats{
+		Min:    r.min,
+		Max:    r.max,
+		Mean:   r.mean,
+		StdDev: math.Sqrt(r.m2 / float64(r.n)),
+	}
+}
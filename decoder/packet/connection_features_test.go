@@ -0,0 +1,178 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package packet
+
+import (
+	"math"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dreadl0ck/gopacket"
+	"github.com/dreadl0ck/gopacket/layers"
+
+	"github.com/dreadl0ck/netcap/decoder/config"
+	"github.com/dreadl0ck/netcap/types"
+)
+
+func TestConnectionFeatures(t *testing.T) {
+	var (
+		f     = &connectionFeatures{}
+		start = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	for _, p := range []struct {
+		ms         int
+		length     int
+		payload    []byte
+		fromClient bool
+	}{
+		{0, 60, nil, true},
+		{10, 100, []byte("aab"), true},
+		{20, 200, []byte("bc"), false},
+		{50, 60, nil, false},
+		{60, 300, []byte("c"), true},
+		{70, 400, []byte("d"), false},
+	} {
+		f.update(start.Add(time.Duration(p.ms)*time.Millisecond), p.length, p.payload, p.fromClient, 3)
+	}
+
+	co := &types.Connection{}
+	f.setFields(co, false)
+
+	if !reflect.DeepEqual(co.SPLTLengths, []int32{3, -2, 1}) {
+		t.Error("unexpected SPLT lengths", co.SPLTLengths)
+	}
+
+	ms := int64(time.Millisecond)
+	if !reflect.DeepEqual(co.SPLTIntervals, []int64{0, 10 * ms, 40 * ms}) {
+		t.Error("unexpected SPLT intervals", co.SPLTIntervals)
+	}
+
+	if co.ByteDistribution['a'] != 2 || co.ByteDistribution['b'] != 2 || co.ByteDistribution['c'] != 2 || co.ByteDistribution['d'] != 1 {
+		t.Error("unexpected byte distribution")
+	}
+
+	if s := co.PacketSizesClientToServer; s.Min != 60 || s.Max != 300 || math.Abs(s.Mean-460.0/3) > 1e-9 {
+		t.Error("unexpected packet sizes from client to server", s)
+	}
+
+	// intervals of 10 and 50 milliseconds
+	if s := co.InterArrivalTimesClientToServer; s.Mean != float64(30*ms) || s.StdDev != float64(20*ms) {
+		t.Error("unexpected inter-arrival times from client to server", s)
+	}
+
+	if s := co.PacketSizesServerToClient; s.Min != 60 || s.Max != 400 {
+		t.Error("unexpected packet sizes from server to client", s)
+	}
+
+	// the directions are swapped if the client is the destination of the audit record
+	swapped := &types.Connection{}
+	f.setFields(swapped, true)
+
+	if !reflect.DeepEqual(swapped.SPLTLengths, []int32{-3, 2, -1}) || swapped.PacketSizesClientToServer.Max != 400 {
+		t.Error("unexpected features for swapped directions", swapped.SPLTLengths, swapped.PacketSizesClientToServer)
+	}
+}
+
+func TestRunningStatsEmpty(t *testing.T) {
+	var r runningStats
+	if r.stats() != nil {
+		t.Error("expected no stats without values")
+	}
+}
+
+func TestConnectionDirectionSameHost(t *testing.T) {
+	var (
+		conns = newShardedConnMap(1)
+		conf  = &config.Config{ConnectionFeatures: true, ConnectionFeaturesPackets: 10}
+		start = time.Unix(1600000000, 0)
+		host  = net.IP{127, 0, 0, 1}
+	)
+
+	// both directions of a connection on the loopback interface differ only in the ports
+	for i, seg := range []struct {
+		srcPort, dstPort layers.TCPPort
+		payload          int
+	}{
+		{51000, 8080, 10},
+		{8080, 51000, 100},
+		{51000, 8080, 20},
+	} {
+		data := serializeLayers(t,
+			&layers.IPv4{Version: 4, IHL: 5, TTL: 64, Protocol: layers.IPProtocolTCP, SrcIP: host, DstIP: host},
+			&layers.TCP{SrcPort: seg.srcPort, DstPort: seg.dstPort, DataOffset: 5, ACK: true},
+			gopacket.Payload(make([]byte, seg.payload)),
+		)
+
+		p := gopacket.NewPacket(data, layers.LayerTypeIPv4, gopacket.Default)
+		p.Metadata().Timestamp = start.Add(time.Duration(i) * time.Second)
+		p.Metadata().Length = len(data)
+
+		handlePacket(conns, p, conf)
+	}
+
+	var conn *connection
+	for _, c := range conns.shards[0].Items {
+		conn = c
+	}
+
+	if conn == nil || conns.Size() != 1 {
+		t.Fatal("expected a single connection, got", conns.Size())
+	}
+
+	// headers are 40 bytes
+	if conn.BytesClientToServer != 110 || conn.BytesServerToClient != 140 {
+		t.Fatalf("unexpected bytes per direction: client %d, server %d", conn.BytesClientToServer, conn.BytesServerToClient)
+	}
+
+	conn.features.setFields(conn.Connection, conn.swapped())
+
+	if !reflect.DeepEqual(conn.SPLTLengths, []int32{10, -100, 20}) {
+		t.Fatal("unexpected packet lengths", conn.SPLTLengths)
+	}
+}
+
+func TestConnectionShards(t *testing.T) {
+	var (
+		conns = newShardedConnMap(2)
+		conf  = &config.Config{}
+	)
+
+	for i, shard := range []int{1, 1, 0, -1} {
+		data := serializeLayers(t,
+			&layers.IPv4{Version: 4, IHL: 5, TTL: 64, Protocol: layers.IPProtocolUDP, SrcIP: net.IP{10, 0, 0, byte(i)}, DstIP: net.IP{10, 0, 0, 100}},
+			&layers.UDP{SrcPort: 5000, DstPort: 53},
+		)
+
+		p := gopacket.NewPacket(data, layers.LayerTypeIPv4, gopacket.Default)
+		p.Metadata().Length = len(data)
+
+		// the index of a forwarded packet is replaced by the worker that owns it
+		if shard >= 0 {
+			SetShard(p, 0)
+			SetShard(p, shard)
+		}
+
+		handlePacket(conns, p, conf)
+	}
+
+	// packets without a shard are tracked in the first table
+	for i, want := range []int{2, 2} {
+		if n := conns.shards[i].Size(); n != want {
+			t.Errorf("expected %d connections in shard %d, got %d", want, i, n)
+		}
+	}
+}
@@ -48,19 +48,11 @@ func (a *atomicDeviceProfileMap) Size() int {
 	return len(a.Items)
 }
 
-var (
-	// DeviceProfiles hold all connections.
-	DeviceProfiles = &atomicDeviceProfileMap{
-		Items: make(map[string]*deviceProfile),
-	}
-	deviceProfiles int64
-
-	// flags for flushing intervals - no flushing for now.
-	// flagProfileFlushInterval = flag.Int("profile-flush-interval", 10000, "flush connections every X flows").
+// flags for flushing intervals - no flushing for now.
+// flagProfileFlushInterval = flag.Int("profile-flush-interval", 10000, "flush connections every X flows").
 
-	// profileFlushInterval int64
-	// profileTimeOut       time.Duration.
-)
+// profileFlushInterval int64
+// profileTimeOut       time.Duration.
 
 // getDeviceProfile fetches a known profile and updates it or returns a new one.
 //func getDeviceProfile(macAddr string, i *decoderutils.PacketInfo) *deviceProfile {
@@ -83,27 +75,26 @@ var (
 //}
 
 // updateDeviceProfile can be used to update the profile for the passed identifiers.
-func updateDeviceProfile(i *decoderutils.PacketInfo) {
+func (s *State) updateDeviceProfile(i *decoderutils.PacketInfo) {
 	// lookup profile
-	DeviceProfiles.Lock()
-	if p, ok := DeviceProfiles.Items[i.SrcMAC]; ok {
-		applyDeviceProfileUpdate(p, i)
+	s.deviceProfiles.Lock()
+	if p, ok := s.deviceProfiles.Items[i.SrcMAC]; ok {
+		s.applyDeviceProfileUpdate(p, i)
 	} else {
-		DeviceProfiles.Items[i.SrcMAC] = newDeviceProfile(i)
-		deviceProfiles++
+		s.deviceProfiles.Items[i.SrcMAC] = s.newDeviceProfile(i)
 	}
-	DeviceProfiles.Unlock()
+	s.deviceProfiles.Unlock()
 }
 
 // newDeviceProfile creates a new device specific profile.
-func newDeviceProfile(i *decoderutils.PacketInfo) *deviceProfile {
+func (s *State) newDeviceProfile(i *decoderutils.PacketInfo) *deviceProfile {
 	var contacts []string
-	if ip := getIPProfile(i.DstIP, i, false); ip != nil {
+	if ip := s.getIPProfile(i.DstIP, i, false); ip != nil {
 		contacts = append(contacts, ip.IPProfile.Addr)
 	}
 
 	var devices []string
-	if ip := getIPProfile(i.SrcIP, i, true); ip != nil {
+	if ip := s.getIPProfile(i.SrcIP, i, true); ip != nil {
 		devices = append(devices, ip.IPProfile.Addr)
 	}
 
@@ -120,7 +111,7 @@ func newDeviceProfile(i *decoderutils.PacketInfo) *deviceProfile {
 	}
 }
 
-func applyDeviceProfileUpdate(p *deviceProfile, i *decoderutils.PacketInfo) {
+func (s *State) applyDeviceProfileUpdate(p *deviceProfile, i *decoderutils.PacketInfo) {
 	p.Lock()
 
 	// deviceIPs
@@ -129,14 +120,14 @@ func applyDeviceProfileUpdate(p *deviceProfile, i *decoderutils.PacketInfo) {
 	for _, addr := range p.DeviceIPs {
 		if addr == i.SrcIP {
 			// update existing ip profile
-			_ = getIPProfile(i.SrcIP, i, true).IPProfile
+			_ = s.getIPProfile(i.SrcIP, i, true).IPProfile
 			found = true
 		}
 	}
 
 	// if no existing one has been updated, its a new one
 	if !found {
-		ip := getIPProfile(i.SrcIP, i, true)
+		ip := s.getIPProfile(i.SrcIP, i, true)
 		// if the packet has no network layer we wont get an IP here
 		// prevent adding a nil pointer to the array
 		if ip != nil {
@@ -150,14 +141,14 @@ func applyDeviceProfileUpdate(p *deviceProfile, i *decoderutils.PacketInfo) {
 	for _, addr := range p.Contacts {
 		if addr == i.DstIP {
 			// update existing ip profile
-			_ = getIPProfile(i.DstIP, i, false).IPProfile
+			_ = s.getIPProfile(i.DstIP, i, false).IPProfile
 			found = true
 		}
 	}
 
 	// if no existing one has been updated, its a new one
 	if !found {
-		ip := getIPProfile(i.DstIP, i, false)
+		ip := s.getIPProfile(i.DstIP, i, false)
 
 		// if the packet has no network layer we wont get an IP here
 		// prevent adding a nil pointer to the array
@@ -178,15 +169,15 @@ var deviceProfileDecoder = newPacketDecoder(
 	func(d *Decoder) error {
 		return nil
 	},
-	func(p gopacket.Packet) proto.Message {
+	func(d *Decoder, p gopacket.Packet) proto.Message {
 		// handle packet
-		updateDeviceProfile(decoderutils.NewPacketInfo(p))
+		d.state.updateDeviceProfile(decoderutils.NewPacketInfo(p))
 
 		return nil
 	},
 	func(d *Decoder) error {
 		// flush writer
-		for _, item := range DeviceProfiles.Items {
+		for _, item := range d.state.deviceProfiles.Items {
 			item.Lock()
 			d.writeDeviceProfile(item.DeviceProfile)
 			item.Unlock()
@@ -198,7 +189,7 @@ var deviceProfileDecoder = newPacketDecoder(
 
 // writeDeviceProfile writes the profile.
 func (d *Decoder) writeDeviceProfile(dp *types.DeviceProfile) {
-	if conf.ExportMetrics {
+	if d.state.conf.ExportMetrics {
 		dp.Inc()
 	}
 
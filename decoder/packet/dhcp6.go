@@ -28,7 +28,7 @@ var dhcpv6Decoder = newGoPacketDecoder(
 	types.Type_NC_DHCPv6,
 	layers.LayerTypeDHCPv6,
 	"The Dynamic Host Configuration Protocol (DHCP) is a network management protocol used on Internet Protocol networks whereby a DHCP server dynamically assigns an IP address and other network configuration parameters to each device on a network so they can communicate with other IP networks",
-	func(layer gopacket.Layer, timestamp int64) proto.Message {
+	func(d *GoPacketDecoder, layer gopacket.Layer, timestamp int64) proto.Message {
 		if dhcp6, ok := layer.(*layers.DHCPv6); ok {
 
 			var (
@@ -25,7 +25,7 @@ var eapDecoder = newGoPacketDecoder(
 	types.Type_NC_EAP,
 	layers.LayerTypeEAP,
 	"Extensible Authentication Protocol is an authentication framework frequently used in network and internet connections",
-	func(layer gopacket.Layer, timestamp int64) proto.Message {
+	func(d *GoPacketDecoder, layer gopacket.Layer, timestamp int64) proto.Message {
 		if eap, ok := layer.(*layers.EAP); ok {
 			return &types.EAP{
 				Timestamp: timestamp,
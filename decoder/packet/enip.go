@@ -25,7 +25,7 @@ var ethernetIPDecoder = newGoPacketDecoder(
 	types.Type_NC_ENIP,
 	layers.LayerTypeENIP,
 	"Industrial network protocol that adapts the Common Industrial Protocol to standard Ethernet",
-	func(layer gopacket.Layer, timestamp int64) proto.Message {
+	func(d *GoPacketDecoder, layer gopacket.Layer, timestamp int64) proto.Message {
 		if enip, ok := layer.(*layers.ENIP); ok {
 			cmdSpecificData := &types.ENIPCommandSpecificData{
 				Cmd:  uint32(enip.CommandSpecific.Cmd),
@@ -25,7 +25,7 @@ var ethernetCTPReplyDecoder = newGoPacketDecoder(
 	types.Type_NC_EthernetCTPReply,
 	layers.LayerTypeEthernetCTPReply,
 	"Ethernet Configuration Testing Protocol is a diagnostic protocol included in the Xerox Ethernet II specification",
-	func(layer gopacket.Layer, timestamp int64) proto.Message {
+	func(d *GoPacketDecoder, layer gopacket.Layer, timestamp int64) proto.Message {
 		if ethctpr, ok := layer.(*layers.EthernetCTPReply); ok {
 			return &types.EthernetCTPReply{
 				Timestamp:     timestamp,
@@ -40,7 +40,7 @@ var defaultGoPacketDecoders []*GoPacketDecoder
 
 type (
 	// goPacketDecoderHandler is the handler function for a layer decoder.
-	goPacketDecoderHandler = func(layer gopacket.Layer, timestamp int64) proto.Message
+	goPacketDecoderHandler = func(d *GoPacketDecoder, layer gopacket.Layer, timestamp int64) proto.Message
 
 	// GoPacketDecoder represents an decoder for the gopacket.Layer type
 	// this structure has an optimized field order to avoid excessive padding.
@@ -54,6 +54,9 @@ type (
 		writer io.AuditRecordWriter
 		Type   types.Type
 		export bool
+
+		// configuration of the collector
+		conf *config.Config
 	}
 )
 
@@ -85,11 +88,16 @@ func (dec *GoPacketDecoder) NumRecords() int64 {
 	return dec.numRecords
 }
 
-// InitGoPacketDecoders initializes all gopacket decoders.
-func InitGoPacketDecoders(c *config.Config) (decoders map[gopacket.LayerType][]*GoPacketDecoder, err error) {
+// InitGoPacketDecoders initializes the gopacket decoders selected in the configuration.
+func (s *State) InitGoPacketDecoders() (decoders map[gopacket.LayerType][]*GoPacketDecoder, err error) {
 	decoders = map[gopacket.LayerType][]*GoPacketDecoder{}
 
 	var (
+		c = s.conf
+
+		// gopacket decoders for the collector
+		loaded = make([]*GoPacketDecoder, 0, len(defaultGoPacketDecoders))
+
 		// values from command-line flags
 		in = strings.Split(c.IncludeDecoders, ",")
 		ex = strings.Split(c.ExcludeDecoders, ",")
@@ -101,6 +109,13 @@ func InitGoPacketDecoders(c *config.Config) (decoders map[gopacket.LayerType][]*
 		selection []*GoPacketDecoder
 	)
 
+	// create the decoders for the collector from the registered ones
+	for _, e := range defaultGoPacketDecoders {
+		dec := *e
+		dec.conf = c
+		loaded = append(loaded, &dec)
+	}
+
 	// if there are includes and the first item is not an empty string
 	if len(in) > 0 && in[0] != "" { // iterate over includes
 		for _, name := range in {
@@ -115,14 +130,14 @@ func InitGoPacketDecoders(c *config.Config) (decoders map[gopacket.LayerType][]*
 		}
 
 		// iterate over gopacket decoders and collect those that are named in the includeMap
-		for _, e := range defaultGoPacketDecoders {
+		for _, e := range loaded {
 			if _, ok := inMap[e.Layer.String()]; ok {
 				selection = append(selection, e)
 			}
 		}
 
 		// update gopacket decoders to new selection
-		defaultGoPacketDecoders = selection
+		loaded = selection
 	}
 
 	// iterate over excluded decoders
@@ -132,11 +147,11 @@ func InitGoPacketDecoders(c *config.Config) (decoders map[gopacket.LayerType][]*
 				return nil, errors.Wrap(ErrInvalidDecoder, name)
 			}
 
-			// remove named decoder from defaultGoPacketDecoders
-			for i, e := range defaultGoPacketDecoders {
+			// remove named decoder from the selection
+			for i, e := range loaded {
 				if name == e.Layer.String() {
 					// remove decoder
-					defaultGoPacketDecoders = append(defaultGoPacketDecoders[:i], defaultGoPacketDecoders[i+1:]...)
+					loaded = append(loaded[:i:i], loaded[i+1:]...)
 					break
 				}
 			}
@@ -149,11 +164,7 @@ func InitGoPacketDecoders(c *config.Config) (decoders map[gopacket.LayerType][]*
 	)
 
 	// initialize decoders
-	for _, e := range defaultGoPacketDecoders { // fmt.Println("init", e.Layer)
-
-		// reset decoder stat in case it is reinitialized at runtime.
-		e.numRecords = 0
-
+	for _, e := range loaded { // fmt.Println("init", e.Layer)
 		wg.Add(1)
 
 		go func(dec *GoPacketDecoder) {
@@ -178,7 +189,9 @@ func InitGoPacketDecoders(c *config.Config) (decoders map[gopacket.LayerType][]*
 				Label:      c.Label,
 				Proto:      c.Proto,
 				JSON:       c.JSON,
+				Parquet:    c.Parquet,
 				Chan:       c.Chan,
+				Records:    c.Records,
 				Null:       c.Null,
 				Elastic:    c.Elastic,
 				ElasticConfig: io.ElasticConfig{
@@ -199,6 +212,32 @@ func InitGoPacketDecoders(c *config.Config) (decoders map[gopacket.LayerType][]*
 				StartTime:            time.Now(),
 				CompressionBlockSize: c.CompressionBlockSize,
 				CompressionLevel:     c.CompressionLevel,
+				CompressionCodec:     c.CompressionCodec,
+				Outputs:              c.Outputs,
+				OutputBufferSize:     c.OutputBufferSize,
+				ParquetRowGroupSize:  c.ParquetRowGroupSize,
+				ParquetCompression:   c.ParquetCompression,
+				Zeek:                 c.Zeek,
+				ZeekJSON:             c.ZeekJSON,
+				Eve:                  c.Eve,
+				CommunityIDSeed:      c.CommunityIDSeed,
+
+				IPFIX:                  c.IPFIX,
+				IPFIXCollector:         c.IPFIXCollector,
+				IPFIXVersion:           c.IPFIXVersion,
+				IPFIXEnterpriseNumber:  c.IPFIXEnterpriseNumber,
+				IPFIXObservationDomain: c.IPFIXObservationDomain,
+				ConnTimeOut:            c.ConnTimeOut,
+
+				SQLite:          c.SQLite,
+				SQLiteBatchSize: c.SQLiteBatchSize,
+
+				RotateSize:     c.RotateSize,
+				RotateInterval: c.RotateInterval,
+				RetentionSize:  c.RetentionSize,
+
+				Index:          c.Index,
+				IndexBlockSize: c.IndexBlockSize,
 			})
 
 			// write netcap header
@@ -222,10 +261,13 @@ func InitGoPacketDecoders(c *config.Config) (decoders map[gopacket.LayerType][]*
 	wg.Wait()
 	decoderLog.Info("initialized gopacket decoders", zap.Int("total", len(decoders)))
 
+	s.goPacketDecoders = decoders
+
 	return decoders, nil
 }
 
-// newGoPacketDecoder returns a new GoPacketDecoder instance and registers it.
+// newGoPacketDecoder returns a new GoPacketDecoder instance and registers it,
+// the registered decoders are copied for each collector during initialization.
 func newGoPacketDecoder(nt types.Type, lt gopacket.LayerType, description string, handler goPacketDecoderHandler) *GoPacketDecoder {
 	d := &GoPacketDecoder{
 		Layer:       lt,
@@ -241,7 +283,7 @@ func newGoPacketDecoder(nt types.Type, lt gopacket.LayerType, description string
 // this calls the handler function of the decoder
 // and writes the serialized protobuf into the data pipe.
 func (dec *GoPacketDecoder) Decode(ctx *types.PacketContext, p gopacket.Packet, l gopacket.Layer) error {
-	record := dec.Handler(l, p.Metadata().Timestamp.UnixNano())
+	record := dec.Handler(dec, l, p.Metadata().Timestamp.UnixNano())
 	if record != nil {
 
 		if ctx != nil {
@@ -254,6 +296,16 @@ func (dec *GoPacketDecoder) Decode(ctx *types.PacketContext, p gopacket.Packet,
 			}
 		}
 
+		// add the Community ID flow hash, the handlers only receive the decoded layer
+		switch r := record.(type) {
+		case *types.TCP:
+			r.CommunityID = communityID(dec.conf.CommunityIDSeed, p)
+		case *types.UDP:
+			r.CommunityID = communityID(dec.conf.CommunityIDSeed, p)
+		case *types.DNS:
+			r.CommunityID = communityID(dec.conf.CommunityIDSeed, p)
+		}
+
 		atomic.AddInt64(&dec.numRecords, 1)
 		err := dec.writer.Write(record)
 		if err != nil {
@@ -265,7 +317,7 @@ func (dec *GoPacketDecoder) Decode(ctx *types.PacketContext, p gopacket.Packet,
 			// assert to audit record
 			if auditRecord, ok := record.(types.AuditRecord); ok {
 
-				if conf.Debug {
+				if dec.conf.Debug {
 					defer func() {
 						if r := recover(); r != nil {
 							spew.Dump(auditRecord)
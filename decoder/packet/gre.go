@@ -25,7 +25,7 @@ var greDecoder = newGoPacketDecoder(
 	types.Type_NC_GRE,
 	layers.LayerTypeGRE,
 	"Generic Routing Encapsulation is a tunneling protocol developed by Cisco Systems that can encapsulate a wide variety of network layer protocols inside virtual point-to-point links or point-to-multipoint links over an Internet Protocol network",
-	func(layer gopacket.Layer, timestamp int64) proto.Message {
+	func(d *GoPacketDecoder, layer gopacket.Layer, timestamp int64) proto.Message {
 		if gre, ok := layer.(*layers.GRE); ok {
 			return &types.GRE{
 				Timestamp:         timestamp,
@@ -25,7 +25,7 @@ var icmpv4Decoder = newGoPacketDecoder(
 	types.Type_NC_ICMPv4,
 	layers.LayerTypeICMPv4,
 	"The Internet Control Message Protocol (ICMP) is a supporting protocol in the Internet protocol suite",
-	func(layer gopacket.Layer, timestamp int64) proto.Message {
+	func(d *GoPacketDecoder, layer gopacket.Layer, timestamp int64) proto.Message {
 		if icmp4, ok := layer.(*layers.ICMPv4); ok {
 			return &types.ICMPv4{
 				Timestamp: timestamp,
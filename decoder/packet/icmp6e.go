@@ -25,7 +25,7 @@ var icmpv6EchoDecoder = newGoPacketDecoder(
 	types.Type_NC_ICMPv6Echo,
 	layers.LayerTypeICMPv6Echo,
 	"The Internet Control Message Protocol (ICMP) is a supporting protocol in the Internet protocol suite",
-	func(layer gopacket.Layer, timestamp int64) proto.Message {
+	func(d *GoPacketDecoder, layer gopacket.Layer, timestamp int64) proto.Message {
 		if icmp6e, ok := layer.(*layers.ICMPv6Echo); ok {
 			return &types.ICMPv6Echo{
 				Timestamp:  timestamp,
@@ -25,7 +25,7 @@ var icmpv6RouterSolicitationDecoder = newGoPacketDecoder(
 	types.Type_NC_ICMPv6RouterSolicitation,
 	layers.LayerTypeICMPv6RouterSolicitation,
 	"The Internet Control Message Protocol (ICMP) is a supporting protocol in the Internet protocol suite",
-	func(layer gopacket.Layer, timestamp int64) proto.Message {
+	func(d *GoPacketDecoder, layer gopacket.Layer, timestamp int64) proto.Message {
 		if icmp6rs, ok := layer.(*layers.ICMPv6RouterSolicitation); ok {
 			var opts []*types.ICMPv6Option
 			for _, o := range icmp6rs.Options {
@@ -25,7 +25,7 @@ var igmpDecoder = newGoPacketDecoder(
 	types.Type_NC_IGMP,
 	layers.LayerTypeIGMP,
 	"The Internet Group Management Protocol (IGMP) is a communications protocol used by hosts and adjacent routers on IPv4 networks to establish multicast group memberships",
-	func(layer gopacket.Layer, timestamp int64) proto.Message {
+	func(d *GoPacketDecoder, layer gopacket.Layer, timestamp int64) proto.Message {
 		if igmp, ok := layer.(*layers.IGMP); ok {
 			var addresses []string
 			for _, ip := range igmp.SourceAddresses {
@@ -25,10 +25,10 @@ var ipv6Decoder = newGoPacketDecoder(
 	types.Type_NC_IPv6,
 	layers.LayerTypeIPv6,
 	"Internet Protocol version 6 (IPv6) is the most recent version of the Internet Protocol (IP), the communications protocol that provides an identification and location system for computers on networks and routes traffic across the Internet",
-	func(layer gopacket.Layer, timestamp int64) proto.Message {
+	func(d *GoPacketDecoder, layer gopacket.Layer, timestamp int64) proto.Message {
 		if ip6, ok := layer.(*layers.IPv6); ok {
 			var e float64
-			if conf.CalculateEntropy {
+			if d.conf.CalculateEntropy {
 				e = entropy(ip6.Payload)
 			}
 
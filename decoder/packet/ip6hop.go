@@ -25,7 +25,7 @@ var ipv6HopByHopDecoder = newGoPacketDecoder(
 	types.Type_NC_IPv6HopByHop,
 	layers.LayerTypeIPv6HopByHop,
 	"Internet Protocol version 6 (IPv6) is the most recent version of the Internet Protocol (IP), the communications protocol that provides an identification and location system for computers on networks and routes traffic across the Internet",
-	func(layer gopacket.Layer, timestamp int64) proto.Message {
+	func(d *GoPacketDecoder, layer gopacket.Layer, timestamp int64) proto.Message {
 		if ip6hop, ok := layer.(*layers.IPv6HopByHop); ok {
 			var options []*types.IPv6HopByHopOption
 			for _, o := range ip6hop.Options {
@@ -15,6 +15,7 @@ package packet
 
 import (
 	"log"
+	"strconv"
 	"sync"
 	"sync/atomic"
 
@@ -30,15 +31,9 @@ import (
 	"github.com/dreadl0ck/netcap/utils"
 )
 
-var (
-	// LocalDNS controls whether the DNS names shall be resolved locally
-	// without contacting a nameserver.
-	LocalDNS = true
-)
-
 // atomicIPProfileMap contains all connections and provides synchronized access.
 type atomicIPProfileMap struct {
-	// SrcIP to DeviceProfiles
+	// SrcIP and tunnel to IPProfiles
 	Items map[string]*ipProfile
 	sync.Mutex
 }
@@ -51,11 +46,6 @@ func (a *atomicIPProfileMap) Size() int {
 	return len(a.Items)
 }
 
-// ipProfiles contains a map of IP specific behavior profiles at runtime.
-var ipProfiles = &atomicIPProfileMap{
-	Items: make(map[string]*ipProfile),
-}
-
 // wrapper for the types.IPProfile that can be locked.
 type ipProfile struct {
 	sync.Mutex
@@ -69,12 +59,12 @@ var ipProfileDecoder = newPacketDecoder(
 	func(d *Decoder) error {
 		return nil
 	},
-	func(p gopacket.Packet) proto.Message {
+	func(d *Decoder, p gopacket.Packet) proto.Message {
 		return nil
 	},
 	func(d *Decoder) error {
 		// flush writer
-		for _, item := range ipProfiles.Items {
+		for _, item := range d.state.ipProfiles.Items {
 			item.Lock()
 			d.writeIPProfile(item.IPProfile)
 			item.Unlock()
@@ -84,15 +74,30 @@ var ipProfileDecoder = newPacketDecoder(
 	},
 )
 
+// ipProfileKey returns the key of the profile for an address in the tunnel,
+// the same address can belong to different hosts in different virtual networks.
+func ipProfileKey(ipAddr string, tunnel *types.Tunnel) string {
+	if id := tunnelID(tunnel); id != 0 {
+		return ipAddr + "-" + strconv.FormatUint(id, 10)
+	}
+
+	return ipAddr
+}
+
 // GetIPProfile fetches a known profile and updates it or returns a new one.
-func getIPProfile(ipAddr string, i *decoderutils.PacketInfo, source bool) *ipProfile {
+func (s *State) getIPProfile(ipAddr string, i *decoderutils.PacketInfo, source bool) *ipProfile {
 	if ipAddr == "" {
 		return nil
 	}
 
-	ipProfiles.Lock()
-	if p, ok := ipProfiles.Items[ipAddr]; ok {
-		ipProfiles.Unlock()
+	var (
+		tunnel = GetTunnel(i.Packet)
+		key    = ipProfileKey(ipAddr, tunnel)
+	)
+
+	s.ipProfiles.Lock()
+	if p, ok := s.ipProfiles.Items[key]; ok {
+		s.ipProfiles.Unlock()
 
 		p.Lock()
 
@@ -150,7 +155,7 @@ func getIPProfile(ipAddr string, i *decoderutils.PacketInfo, source bool) *ipPro
 
 		return p
 	}
-	ipProfiles.Unlock()
+	s.ipProfiles.Unlock()
 
 	var (
 		protos  = make(map[string]*types.Protocol)
@@ -188,7 +193,7 @@ func getIPProfile(ipAddr string, i *decoderutils.PacketInfo, source bool) *ipPro
 	}
 
 	var names []string
-	if LocalDNS {
+	if s.LocalDNS {
 		if name := resolvers.LookupDNSNameLocal(ipAddr); len(name) != 0 {
 			names = append(names, name)
 		}
@@ -211,16 +216,48 @@ func getIPProfile(ipAddr string, i *decoderutils.PacketInfo, source bool) *ipPro
 			DstPorts:       dstPorts,
 			ContactedPorts: contactedPorts,
 			SNIs:           sniMap,
+			Tunnel:         tunnel,
 		},
 	}
 
-	ipProfiles.Lock()
-	ipProfiles.Items[ipAddr] = p
-	ipProfiles.Unlock()
+	s.ipProfiles.Lock()
+	s.ipProfiles.Items[key] = p
+	s.ipProfiles.Unlock()
 
 	return p
 }
 
+// AddProtocol adds an application protocol that has been identified for a reassembled conversation
+// to the profile of the IP address, the number of packets is incremented by the given value.
+// Only profiles of addresses that have been seen by the IPProfile decoder are updated.
+func (s *State) AddProtocol(ipAddr string, tunnel *types.Tunnel, protocol string, category string, packets uint64) {
+	s.ipProfiles.Lock()
+	p, ok := s.ipProfiles.Items[ipProfileKey(ipAddr, tunnel)]
+	s.ipProfiles.Unlock()
+
+	if !ok {
+		return
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	if prot, exists := p.Protocols[protocol]; exists {
+		prot.Packets += packets
+
+		return
+	}
+
+	if p.Protocols == nil {
+		p.Protocols = make(map[string]*types.Protocol)
+	}
+
+	p.Protocols[protocol] = &types.Protocol{
+		Packets:  packets,
+		Category: category,
+	}
+}
+
 func doSrcPortUpdate(p *ipProfile, srcPort int32, layerType string, dataLen uint64) {
 	var found bool
 
@@ -358,7 +395,7 @@ func initPorts(i *decoderutils.PacketInfo, source bool) (
 
 // writeIPProfile writes the ip profile.
 func (d *Decoder) writeIPProfile(i *types.IPProfile) {
-	if conf.ExportMetrics {
+	if d.state.conf.ExportMetrics {
 		i.Inc()
 	}
 
@@ -25,7 +25,7 @@ var lcmDecoder = newGoPacketDecoder(
 	types.Type_NC_LCM,
 	layers.LayerTypeLCM,
 	"LCM is a set of libraries and tools for message passing and data marshaling, targeted at real-time systems where high-bandwidth and low latency are critical",
-	func(layer gopacket.Layer, timestamp int64) proto.Message {
+	func(d *GoPacketDecoder, layer gopacket.Layer, timestamp int64) proto.Message {
 		if lcm, ok := layer.(*layers.LCM); ok {
 			return &types.LCM{
 				Timestamp:      timestamp,
@@ -25,7 +25,7 @@ var linkLayerDiscoveryDecoder = newGoPacketDecoder(
 	types.Type_NC_LinkLayerDiscovery,
 	layers.LayerTypeLinkLayerDiscovery,
 	"The Link Layer Discovery Protocol is a vendor-neutral link layer protocol used by network devices for advertising their identity, capabilities, and neighbors on a local area network based on IEEE 802 technology, principally wired Ethernet",
-	func(layer gopacket.Layer, timestamp int64) proto.Message {
+	func(d *GoPacketDecoder, layer gopacket.Layer, timestamp int64) proto.Message {
 		if lld, ok := layer.(*layers.LinkLayerDiscovery); ok {
 			var vals []*types.LinkLayerDiscoveryValue
 			for _, v := range lld.Values {
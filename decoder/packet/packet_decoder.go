@@ -25,7 +25,6 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	"github.com/dreadl0ck/gopacket"
 	"github.com/dreadl0ck/netcap"
-	"github.com/dreadl0ck/netcap/decoder/config"
 	"github.com/dreadl0ck/netcap/decoder/core"
 	decoderutils "github.com/dreadl0ck/netcap/decoder/utils"
 	"github.com/dreadl0ck/netcap/io"
@@ -35,13 +34,6 @@ import (
 	"github.com/pkg/errors"
 )
 
-var conf *config.Config
-
-// SetConfig can be used to set a configuration for the package.
-func SetConfig(cfg *config.Config) {
-	conf = cfg
-}
-
 var (
 	// ErrInvalidDecoder occurs when a decoder name is unknown during initialization.
 	ErrInvalidDecoder = errors.New("invalid decoder")
@@ -52,7 +44,7 @@ var (
 
 type (
 	// packetDecoderHandler takes a gopacket.Packet and returns a proto.Message.
-	packetDecoderHandler = func(p gopacket.Packet) proto.Message
+	packetDecoderHandler = func(d *Decoder, p gopacket.Packet) proto.Message
 
 	// Decoder implements custom logic to decode data from a gopacket.Packet
 	// this structure has an optimized field order to avoid excessive padding.
@@ -82,6 +74,9 @@ type (
 
 		// Type of the audit records produced by this decoder
 		Type types.Type
+
+		// state shared by the packet decoders of a collector
+		state *State
 	}
 
 	// DecoderAPI PacketDecoderAPI describes an interface that all custom decoders need to implement
@@ -106,7 +101,8 @@ func init() {
 	}
 }
 
-// newPacketDecoder returns a new Decoder instance.
+// newPacketDecoder returns a new Decoder instance and registers it,
+// the registered decoders are copied for each collector during initialization.
 func newPacketDecoder(t types.Type, name, description string, postinit func(*Decoder) error, handler packetDecoderHandler, deinit func(*Decoder) error) *Decoder {
 	d := &Decoder{
 		Name:        strings.Title(name),
@@ -120,9 +116,14 @@ func newPacketDecoder(t types.Type, name, description string, postinit func(*Dec
 	return d
 }
 
-// InitPacketDecoders initializes all packet decoders.
-func InitPacketDecoders(c *config.Config) (decoders []DecoderAPI, err error) {
+// InitPacketDecoders initializes the packet decoders selected in the configuration.
+func (s *State) InitPacketDecoders() (decoders []DecoderAPI, err error) {
 	var (
+		c = s.conf
+
+		// packet decoders for the collector
+		loaded = make([]DecoderAPI, 0, len(defaultPacketDecoders))
+
 		// values from command-line flags
 		in = strings.Split(c.IncludeDecoders, ",")
 		ex = strings.Split(c.ExcludeDecoders, ",")
@@ -134,6 +135,13 @@ func InitPacketDecoders(c *config.Config) (decoders []DecoderAPI, err error) {
 		selection []DecoderAPI
 	)
 
+	// create the decoders for the collector from the registered ones
+	for _, d := range defaultPacketDecoders {
+		dec := *d.(*Decoder)
+		dec.state = s
+		loaded = append(loaded, &dec)
+	}
+
 	// if there are includes and the first item is not an empty string
 	if len(in) > 0 && in[0] != "" { // iterate over includes
 		for _, name := range in {
@@ -148,14 +156,14 @@ func InitPacketDecoders(c *config.Config) (decoders []DecoderAPI, err error) {
 		}
 
 		// iterate over packet decoders and collect those that are named in the includeMap
-		for _, e := range defaultPacketDecoders {
+		for _, e := range loaded {
 			if _, ok := inMap[e.GetName()]; ok {
 				selection = append(selection, e)
 			}
 		}
 
 		// update packet decoders to new selection
-		defaultPacketDecoders = selection
+		loaded = selection
 	}
 
 	// iterate over excluded decoders
@@ -165,11 +173,11 @@ func InitPacketDecoders(c *config.Config) (decoders []DecoderAPI, err error) {
 				return nil, errors.Wrap(ErrInvalidDecoder, name)
 			}
 
-			// remove named decoder from defaultPacketDecoders
-			for i, e := range defaultPacketDecoders {
+			// remove named decoder from the selection
+			for i, e := range loaded {
 				if name == e.GetName() {
 					// remove decoder
-					defaultPacketDecoders = append(defaultPacketDecoders[:i], defaultPacketDecoders[i+1:]...)
+					loaded = append(loaded[:i:i], loaded[i+1:]...)
 
 					break
 				}
@@ -183,11 +191,7 @@ func InitPacketDecoders(c *config.Config) (decoders []DecoderAPI, err error) {
 	)
 
 	// initialize decoders
-	for _, d := range defaultPacketDecoders {
-
-		// reset decoder stat in case it is reinitialized at runtime.
-		d.(*Decoder).NumRecordsWritten = 0
-
+	for _, d := range loaded {
 		wg.Add(1)
 
 		go func(dec DecoderAPI) {
@@ -198,6 +202,7 @@ func InitPacketDecoders(c *config.Config) (decoders []DecoderAPI, err error) {
 				Encode:     c.Encode,
 				Proto:      c.Proto,
 				JSON:       c.JSON,
+				Parquet:    c.Parquet,
 				Name:       dec.GetName(),
 				Type:       dec.GetType(),
 				Null:       c.Null,
@@ -214,6 +219,7 @@ func InitPacketDecoders(c *config.Config) (decoders []DecoderAPI, err error) {
 				Out:                  c.Out,
 				Chan:                 c.Chan,
 				ChanSize:             c.ChanSize,
+				Records:              c.Records,
 				MemBufferSize:        c.MemBufferSize,
 				Source:               c.Source,
 				Version:              netcap.Version,
@@ -221,6 +227,32 @@ func InitPacketDecoders(c *config.Config) (decoders []DecoderAPI, err error) {
 				StartTime:            time.Now(),
 				CompressionBlockSize: c.CompressionBlockSize,
 				CompressionLevel:     c.CompressionLevel,
+				CompressionCodec:     c.CompressionCodec,
+				Outputs:              c.Outputs,
+				OutputBufferSize:     c.OutputBufferSize,
+				ParquetRowGroupSize:  c.ParquetRowGroupSize,
+				ParquetCompression:   c.ParquetCompression,
+				Zeek:                 c.Zeek,
+				ZeekJSON:             c.ZeekJSON,
+				Eve:                  c.Eve,
+				CommunityIDSeed:      c.CommunityIDSeed,
+
+				IPFIX:                  c.IPFIX,
+				IPFIXCollector:         c.IPFIXCollector,
+				IPFIXVersion:           c.IPFIXVersion,
+				IPFIXEnterpriseNumber:  c.IPFIXEnterpriseNumber,
+				IPFIXObservationDomain: c.IPFIXObservationDomain,
+				ConnTimeOut:            c.ConnTimeOut,
+
+				SQLite:          c.SQLite,
+				SQLiteBatchSize: c.SQLiteBatchSize,
+
+				RotateSize:     c.RotateSize,
+				RotateInterval: c.RotateInterval,
+				RetentionSize:  c.RetentionSize,
+
+				Index:          c.Index,
+				IndexBlockSize: c.IndexBlockSize,
 			})
 			dec.SetWriter(w)
 
@@ -300,7 +332,7 @@ func (pd *Decoder) GetDescription() string {
 // and writes the serialized protobuf into the data pipe.
 func (pd *Decoder) Decode(p gopacket.Packet) error {
 	// call the Handler function of the decoder
-	record := pd.Handler(p)
+	record := pd.Handler(pd, p)
 	if record != nil {
 
 		// increase counter
@@ -312,11 +344,11 @@ func (pd *Decoder) Decode(p gopacket.Packet) error {
 		}
 
 		// export metrics if configured
-		if conf.ExportMetrics {
+		if pd.state.conf.ExportMetrics {
 			// assert to audit record
 			if r, ok := record.(types.AuditRecord); ok {
 
-				if conf.Debug {
+				if pd.state.conf.Debug {
 					defer func() {
 						if errRecover := recover(); errRecover != nil {
 							spew.Dump(r)
@@ -363,9 +395,9 @@ func (pd *Decoder) NumRecords() int64 {
 
 // writeDeviceProfile writes the profile.
 func (pd *Decoder) write(r types.AuditRecord) {
-	if conf.ExportMetrics {
+	if pd.state.conf.ExportMetrics {
 
-		if conf.Debug {
+		if pd.state.conf.Debug {
 			defer func() {
 				if errRecover := recover(); errRecover != nil {
 					spew.Dump(r)
@@ -383,18 +415,3 @@ func (pd *Decoder) write(r types.AuditRecord) {
 		log.Fatal("failed to write proto: ", err)
 	}
 }
-
-/*
- * Utils
- */
-
-// isPacketDecoderLoaded checks if a decoder is loaded.
-func isPacketDecoderLoaded(name string) bool {
-	for _, e := range defaultPacketDecoders {
-		if e.GetName() == name {
-			return true
-		}
-	}
-
-	return false
-}
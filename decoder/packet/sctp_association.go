@@ -0,0 +1,837 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package packet
+
+import (
+	"encoding/binary"
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dreadl0ck/gopacket"
+	"github.com/dreadl0ck/gopacket/layers"
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+
+	decoderutils "github.com/dreadl0ck/netcap/decoder/utils"
+	"github.com/dreadl0ck/netcap/types"
+	"github.com/dreadl0ck/netcap/utils"
+)
+
+// SCTP chunk types, see RFC 4960 section 3.2.
+const (
+	sctpChunkData             = 0
+	sctpChunkInit             = 1
+	sctpChunkAbort            = 6
+	sctpChunkShutdown         = 7
+	sctpChunkShutdownComplete = 14
+)
+
+// SCTP payload protocol identifiers that are not defined by gopacket.
+const (
+	sctpPayloadDiameter     layers.SCTPPayloadProtocol = 46
+	sctpPayloadDiameterDTLS layers.SCTPPayloadProtocol = 47
+)
+
+// limits to bound the memory used for reassembling SCTP user messages.
+const (
+	// maximum number of fragments buffered per direction of an association
+	sctpMaxPendingFragments = 1024
+
+	// maximum number of complete messages waiting for a missing stream sequence number
+	sctpMaxPendingMessages = 128
+
+	// maximum size of a reassembled user message
+	sctpMaxMessageSize = 1 << 20
+
+	// number of recently seen TSNs used to detect retransmissions
+	sctpSeenTSNWindow = 4096
+)
+
+// errInvalidSCTPChunk indicates a chunk header with an invalid length.
+var errInvalidSCTPChunk = errors.New("invalid SCTP chunk length")
+
+// sctpPayloadLayers maps the payload protocol identifier of reassembled user messages
+// to the gopacket layer used to decode them.
+// Messages for protocols without a registered layer (e.g. S1AP or M3UA) are reassembled
+// and accounted for in the SCTPAssociation record, but not decoded any further.
+var sctpPayloadLayers = map[layers.SCTPPayloadProtocol]gopacket.LayerType{
+	sctpPayloadDiameter:     layers.LayerTypeDiameter,
+	sctpPayloadDiameterDTLS: layers.LayerTypeDiameter,
+}
+
+// sctpPayloadProtocolName returns a human readable name for a payload protocol identifier.
+func sctpPayloadProtocolName(p layers.SCTPPayloadProtocol) string {
+	switch p {
+	case sctpPayloadDiameter:
+		return "Diameter"
+	case sctpPayloadDiameterDTLS:
+		return "DiameterDTLS"
+	}
+
+	return p.String()
+}
+
+// sctpChunk is a single chunk parsed from the payload of an SCTP packet.
+type sctpChunk struct {
+	typ   uint8
+	flags uint8
+	value []byte
+}
+
+// parseSCTPChunks splits the payload of an SCTP packet into its bundled chunks.
+func parseSCTPChunks(data []byte) (chunks []sctpChunk, err error) {
+	for len(data) >= 4 {
+		length := int(binary.BigEndian.Uint16(data[2:4]))
+		if length < 4 || length > len(data) {
+			return chunks, errInvalidSCTPChunk
+		}
+
+		chunks = append(chunks, sctpChunk{
+			typ:   data[0],
+			flags: data[1],
+			value: data[4:length],
+		})
+
+		// chunks are padded to a multiple of four bytes
+		padded := (length + 3) &^ 3
+		if padded >= len(data) {
+			break
+		}
+
+		data = data[padded:]
+	}
+
+	return chunks, nil
+}
+
+// sctpDataChunk is a DATA chunk carrying a whole user message or a fragment of it.
+type sctpDataChunk struct {
+	tsn       uint32
+	stream    uint16
+	ssn       uint16
+	ppid      layers.SCTPPayloadProtocol
+	unordered bool
+	begin     bool
+	end       bool
+	data      []byte
+}
+
+// newSCTPDataChunk parses the value of a DATA chunk, see RFC 4960 section 3.3.1.
+func newSCTPDataChunk(c sctpChunk) (*sctpDataChunk, error) {
+	if len(c.value) < 12 {
+		return nil, errInvalidSCTPChunk
+	}
+
+	return &sctpDataChunk{
+		unordered: c.flags&0x4 != 0,
+		begin:     c.flags&0x2 != 0,
+		end:       c.flags&0x1 != 0,
+		tsn:       binary.BigEndian.Uint32(c.value[0:4]),
+		stream:    binary.BigEndian.Uint16(c.value[4:6]),
+		ssn:       binary.BigEndian.Uint16(c.value[6:8]),
+		ppid:      layers.SCTPPayloadProtocol(binary.BigEndian.Uint32(c.value[8:12])),
+		data:      c.value[12:],
+	}, nil
+}
+
+// sctpMessage is a reassembled user message.
+type sctpMessage struct {
+	stream       uint16
+	ssn          uint16
+	ppid         layers.SCTPPayloadProtocol
+	unordered    bool
+	numFragments int
+	data         []byte
+
+	// packet that completed the message, used for timestamps and context when dispatching
+	packet gopacket.Packet
+}
+
+// sctpStream keeps track of the ordered delivery for a single SCTP stream.
+type sctpStream struct {
+	nextSSN     uint16
+	initialized bool
+	pending     map[uint16]*sctpMessage
+}
+
+// sctpDirection reassembles the DATA chunks sent by one endpoint of an association.
+type sctpDirection struct {
+	fragments map[uint32]*sctpDataChunk
+	streams   map[uint16]*sctpStream
+
+	// ring of recently seen TSNs to detect retransmissions
+	seen      map[uint32]struct{}
+	seenOrder []uint32
+
+	highestTSN  uint32
+	initialized bool
+
+	// set when the association setup has been observed,
+	// in this case the stream sequence numbers of all streams start at zero.
+	fromStart bool
+
+	numFragments  int32
+	numOutOfOrder int32
+	numDuplicates int32
+	numIncomplete int32
+}
+
+func newSCTPDirection() *sctpDirection {
+	return &sctpDirection{
+		fragments: make(map[uint32]*sctpDataChunk),
+		streams:   make(map[uint16]*sctpStream),
+		seen:      make(map[uint32]struct{}),
+	}
+}
+
+// tsnLess compares two TSNs using serial number arithmetic (RFC 1982).
+func tsnLess(a, b uint32) bool {
+	return int32(a-b) < 0
+}
+
+// ssnLess compares two stream sequence numbers using serial number arithmetic.
+func ssnLess(a, b uint16) bool {
+	return int16(a-b) < 0
+}
+
+// handle processes a DATA chunk and calls deliver for every user message that became available.
+func (d *sctpDirection) handle(c *sctpDataChunk, p gopacket.Packet, deliver func(*sctpMessage)) {
+	// drop retransmissions of chunks that have already been seen
+	if _, ok := d.seen[c.tsn]; ok {
+		d.numDuplicates++
+
+		return
+	}
+
+	d.seen[c.tsn] = struct{}{}
+	d.seenOrder = append(d.seenOrder, c.tsn)
+
+	if len(d.seenOrder) > sctpSeenTSNWindow {
+		delete(d.seen, d.seenOrder[0])
+		d.seenOrder = d.seenOrder[1:]
+	}
+
+	if d.initialized && tsnLess(c.tsn, d.highestTSN) {
+		d.numOutOfOrder++
+	} else {
+		d.highestTSN = c.tsn
+		d.initialized = true
+	}
+
+	// unfragmented user message
+	if c.begin && c.end {
+		d.enqueue(&sctpMessage{
+			stream:       c.stream,
+			ssn:          c.ssn,
+			ppid:         c.ppid,
+			unordered:    c.unordered,
+			numFragments: 1,
+			data:         c.data,
+			packet:       p,
+		}, deliver)
+
+		return
+	}
+
+	d.numFragments++
+
+	if len(d.fragments) >= sctpMaxPendingFragments {
+		// give up on the buffered fragments, instead of growing without limit
+		d.numIncomplete += int32(d.countPendingMessages())
+		d.fragments = make(map[uint32]*sctpDataChunk)
+	}
+
+	// fragment data must be copied, since the packet buffer might be reused
+	c.data = append([]byte(nil), c.data...)
+	d.fragments[c.tsn] = c
+
+	if msg := d.assemble(c.tsn); msg != nil {
+		msg.packet = p
+		d.enqueue(msg, deliver)
+	}
+}
+
+// assemble tries to build a complete user message around the fragment with the given TSN.
+// The fragments of a user message carry consecutive TSNs, the first one has the begin flag set
+// and the last one the end flag.
+func (d *sctpDirection) assemble(tsn uint32) *sctpMessage {
+	var (
+		frag  = d.fragments[tsn]
+		first = tsn
+		last  = tsn
+	)
+
+	// search backwards for the first fragment
+	for !d.fragments[first].begin {
+		prev, ok := d.fragments[first-1]
+		if !ok || prev.stream != frag.stream || prev.end {
+			return nil
+		}
+
+		first--
+	}
+
+	// search forward for the last fragment
+	for !d.fragments[last].end {
+		next, ok := d.fragments[last+1]
+		if !ok || next.stream != frag.stream || next.begin {
+			return nil
+		}
+
+		last++
+	}
+
+	var (
+		size   int
+		numTSN = int(last-first) + 1
+	)
+
+	for t := first; t != last+1; t++ {
+		size += len(d.fragments[t].data)
+	}
+
+	start := d.fragments[first]
+	msg := &sctpMessage{
+		stream:       start.stream,
+		ssn:          start.ssn,
+		ppid:         start.ppid,
+		unordered:    start.unordered,
+		numFragments: numTSN,
+	}
+
+	if size <= sctpMaxMessageSize {
+		msg.data = make([]byte, 0, size)
+	}
+
+	for t := first; t != last+1; t++ {
+		if msg.data != nil {
+			msg.data = append(msg.data, d.fragments[t].data...)
+		}
+
+		delete(d.fragments, t)
+	}
+
+	if msg.data == nil {
+		d.numIncomplete++
+
+		return nil
+	}
+
+	return msg
+}
+
+// enqueue delivers a complete message, honoring the stream sequence for ordered messages.
+func (d *sctpDirection) enqueue(msg *sctpMessage, deliver func(*sctpMessage)) {
+	if msg.unordered {
+		deliver(msg)
+
+		return
+	}
+
+	s, ok := d.streams[msg.stream]
+	if !ok {
+		s = &sctpStream{
+			pending: make(map[uint16]*sctpMessage),
+		}
+		d.streams[msg.stream] = s
+	}
+
+	// the capture might start in the middle of an association,
+	// in this case the first message seen on a stream defines the expected sequence number.
+	if !s.initialized {
+		if !d.fromStart {
+			s.nextSSN = msg.ssn
+		}
+
+		s.initialized = true
+	}
+
+	switch {
+	case msg.ssn == s.nextSSN:
+		deliver(msg)
+		s.nextSSN++
+	case ssnLess(msg.ssn, s.nextSSN):
+		// already delivered
+		d.numDuplicates++
+
+		return
+	default:
+		// the data of unfragmented messages points into the packet buffer, which might be reused until the message is delivered
+		msg.data = append([]byte(nil), msg.data...)
+		s.pending[msg.ssn] = msg
+		if len(s.pending) <= sctpMaxPendingMessages {
+			return
+		}
+
+		// skip the gap and continue with the oldest buffered message
+		s.nextSSN = s.oldestPending()
+	}
+
+	s.deliverPending(deliver)
+}
+
+// oldestPending returns the lowest buffered stream sequence number.
+func (s *sctpStream) oldestPending() uint16 {
+	var (
+		oldest uint16
+		first  = true
+	)
+
+	for ssn := range s.pending {
+		if first || ssnLess(ssn, oldest) {
+			oldest = ssn
+			first = false
+		}
+	}
+
+	return oldest
+}
+
+// deliverPending delivers all buffered messages that are next in sequence.
+func (s *sctpStream) deliverPending(deliver func(*sctpMessage)) {
+	for {
+		next, ok := s.pending[s.nextSSN]
+		if !ok {
+			return
+		}
+
+		delete(s.pending, s.nextSSN)
+		deliver(next)
+		s.nextSSN++
+	}
+}
+
+// countPendingMessages returns the number of distinct user messages with buffered fragments.
+func (d *sctpDirection) countPendingMessages() int {
+	var n int
+
+	for _, f := range d.fragments {
+		if f.begin {
+			n++
+		}
+	}
+
+	if n == 0 && len(d.fragments) > 0 {
+		n = 1
+	}
+
+	return n
+}
+
+// flush delivers all messages still waiting for missing stream sequence numbers
+// and accounts for the user messages that could not be completed.
+func (d *sctpDirection) flush(deliver func(*sctpMessage)) {
+	d.numIncomplete += int32(d.countPendingMessages())
+	d.fragments = make(map[uint32]*sctpDataChunk)
+
+	for _, s := range d.streams {
+		if len(s.pending) == 0 {
+			continue
+		}
+
+		ssns := make([]uint16, 0, len(s.pending))
+		for ssn := range s.pending {
+			ssns = append(ssns, ssn)
+		}
+
+		sort.Slice(ssns, func(i, j int) bool {
+			return ssnLess(ssns[i], ssns[j])
+		})
+
+		for _, ssn := range ssns {
+			deliver(s.pending[ssn])
+			delete(s.pending, ssn)
+		}
+	}
+}
+
+// sctpAssociationID identifies an association by the hashes of its network and transport flows.
+// gopacket flow hashes are symmetric, so both directions map to the same identifier.
+type sctpAssociationID struct {
+	NetworkFlowID   uint64
+	TransportFlowID uint64
+
+	// separates associations with the same addresses in different tunnels
+	TunnelID uint64
+}
+
+func (id sctpAssociationID) String() string {
+	s := strconv.FormatUint(id.NetworkFlowID, 10) + "-" + strconv.FormatUint(id.TransportFlowID, 10)
+	if id.TunnelID != 0 {
+		s += "-" + strconv.FormatUint(id.TunnelID, 10)
+	}
+
+	return s
+}
+
+type sctpAssociation struct {
+	sync.Mutex
+	*types.SCTPAssociation
+
+	// client is the endpoint that initiated the association,
+	// or the sender of the first observed packet if the INIT was not captured.
+	client *sctpDirection
+	server *sctpDirection
+
+	streams   map[uint16]struct{}
+	protocols map[layers.SCTPPayloadProtocol]struct{}
+
+	// state of the packet decoders, used to decode the reassembled messages
+	state *State
+
+	// set under the lock of the association once its record is written,
+	// packets that find a closed association in the map start a new one
+	closed bool
+}
+
+// atomicSCTPAssociationMap contains all associations and provides synchronized access.
+type atomicSCTPAssociationMap struct {
+	sync.Mutex
+	Items map[sctpAssociationID]*sctpAssociation
+
+	// capture time of the SCTP packets, to expire idle associations
+	clock decoderutils.CaptureClock
+
+	// checks for idle associations in the background
+	expiry *decoderutils.Ticker
+}
+
+var sctpAssociationDecoder = newPacketDecoder(
+	types.Type_NC_SCTPAssociation,
+	"SCTPAssociation",
+	"An SCTPAssociation summarizes a tracked SCTP association, its DATA chunks are reassembled per stream and dispatched to the decoders for the carried payload protocol",
+	func(decoder *Decoder) error {
+		if timeout := decoder.state.conf.SCTPIdleTimeout; timeout > 0 {
+			decoder.state.sctpAssociations.expiry = decoderutils.StartTicker(decoderutils.ExpiryInterval(timeout), func() {
+				decoder.expireSCTPAssociations(decoder.state.sctpAssociations.clock.Now().Add(-timeout))
+			})
+		}
+
+		return nil
+	},
+	func(d *Decoder, p gopacket.Packet) proto.Message {
+		if a := handleSCTPPacket(d.state, p); a != nil {
+			return a
+		}
+
+		return nil
+	},
+	func(decoder *Decoder) error {
+		decoder.state.sctpAssociations.expiry.Stop()
+
+		decoder.state.sctpAssociations.Lock()
+		defer decoder.state.sctpAssociations.Unlock()
+
+		for id, a := range decoder.state.sctpAssociations.Items {
+			a.Lock()
+			if !a.closed {
+				a.closed = true
+				decoder.write(a.close())
+			}
+			a.Unlock()
+
+			delete(decoder.state.sctpAssociations.Items, id)
+		}
+
+		return nil
+	},
+)
+
+// expireSCTPAssociations writes the associations whose last packet is older than the deadline
+// and removes them, further packets start a new association.
+func (d *Decoder) expireSCTPAssociations(deadline time.Time) {
+	var (
+		m       = d.state.sctpAssociations
+		expired []*sctpAssociation
+	)
+
+	m.Lock()
+	for id, a := range m.Items {
+		a.Lock()
+		if !a.closed && a.TimestampLast < deadline.UnixNano() {
+			// mark as closed, so the record is not written again by a packet handled concurrently
+			a.closed = true
+			expired = append(expired, a)
+			delete(m.Items, id)
+		}
+		a.Unlock()
+	}
+	m.Unlock()
+
+	for _, a := range expired {
+		a.Lock()
+		d.write(a.close())
+		a.Unlock()
+	}
+}
+
+// handleSCTPPacket tracks the association of the packet and reassembles its DATA chunks.
+// Once the association has been terminated by an ABORT or SHUTDOWN COMPLETE chunk,
+// it is removed and its audit record is returned.
+func handleSCTPPacket(state *State, p gopacket.Packet) *types.SCTPAssociation {
+	s, ok := p.Layer(layers.LayerTypeSCTP).(*layers.SCTP)
+	if !ok {
+		return nil
+	}
+
+	nl := p.NetworkLayer()
+	if nl == nil {
+		return nil
+	}
+
+	state.sctpAssociations.clock.Observe(p.Metadata().Timestamp)
+
+	chunks, err := parseSCTPChunks(s.LayerPayload())
+	if err != nil && len(chunks) == 0 {
+		decoderLog.Debug("failed to parse SCTP chunks", zap.Error(err))
+
+		return nil
+	}
+
+	var (
+		srcIP = nl.NetworkFlow().Src().String()
+		ts    = p.Metadata().Timestamp.UnixNano()
+		id    = sctpAssociationID{
+			NetworkFlowID:   nl.NetworkFlow().FastHash(),
+			TransportFlowID: s.TransportFlow().FastHash(),
+			TunnelID:        TunnelID(p),
+		}
+	)
+
+	state.sctpAssociations.Lock()
+
+	a, ok := state.sctpAssociations.Items[id]
+	if ok {
+		a.Lock()
+
+		// the association has been terminated and its record written by another packet or the expiry,
+		// the packet starts a new association instead of adding data that would be lost
+		if a.closed {
+			a.Unlock()
+
+			ok = false
+		}
+	}
+
+	if !ok {
+		a = &sctpAssociation{
+			SCTPAssociation: &types.SCTPAssociation{
+				UID:            calcMd5(id.String()),
+				TimestampFirst: ts,
+				SrcIP:          srcIP,
+				SrcPort:        int32(s.SrcPort),
+				DstIP:          nl.NetworkFlow().Dst().String(),
+				DstPort:        int32(s.DstPort),
+				Tunnel:         GetTunnel(p),
+			},
+			client:    newSCTPDirection(),
+			server:    newSCTPDirection(),
+			streams:   make(map[uint16]struct{}),
+			protocols: make(map[layers.SCTPPayloadProtocol]struct{}),
+			state:     state,
+		}
+		state.sctpAssociations.Items[id] = a
+
+		a.Lock()
+	}
+
+	state.sctpAssociations.Unlock()
+
+	if !a.handle(p, s, chunks, srcIP, ts) {
+		a.Unlock()
+
+		return nil
+	}
+
+	// the association has been terminated:
+	// the lock of the association must be released before locking the map, since packets for new associations lock both in the opposite order.
+	written := a.closed
+	a.closed = true
+	a.Unlock()
+
+	if written {
+		return nil
+	}
+
+	state.sctpAssociations.Lock()
+	if state.sctpAssociations.Items[id] == a {
+		delete(state.sctpAssociations.Items, id)
+	}
+	state.sctpAssociations.Unlock()
+
+	a.Lock()
+	defer a.Unlock()
+
+	return a.close()
+}
+
+// handle updates the association for a packet and returns whether it has been terminated.
+// The association must be locked by the caller.
+func (a *sctpAssociation) handle(p gopacket.Packet, s *layers.SCTP, chunks []sctpChunk, srcIP string, ts int64) (terminated bool) {
+	fromClient := srcIP == a.SrcIP && int32(s.SrcPort) == a.SrcPort
+
+	// the sender of the INIT chunk is the initiator of the association
+	for _, c := range chunks {
+		if c.typ == sctpChunkInit && !fromClient {
+			a.swap()
+			fromClient = true
+		}
+	}
+
+	a.NumPackets++
+	a.NumChunks += int32(len(chunks))
+
+	if ts < a.TimestampFirst {
+		a.TimestampFirst = ts
+	}
+
+	if ts > a.TimestampLast {
+		a.TimestampLast = ts
+	}
+
+	// the verification tag identifies the receiver of the packet
+	dir := a.server
+	if fromClient {
+		dir = a.client
+		a.BytesClientToServer += int64(p.Metadata().Length)
+
+		if s.VerificationTag != 0 {
+			a.DstVerificationTag = s.VerificationTag
+		}
+	} else {
+		a.BytesServerToClient += int64(p.Metadata().Length)
+
+		if s.VerificationTag != 0 {
+			a.SrcVerificationTag = s.VerificationTag
+		}
+	}
+
+	for _, c := range chunks {
+		switch c.typ {
+		case sctpChunkInit:
+			a.Init = true
+			a.client.fromStart = true
+			a.server.fromStart = true
+		case sctpChunkAbort:
+			a.Abort = true
+			terminated = true
+		case sctpChunkShutdown:
+			a.Shutdown = true
+		case sctpChunkShutdownComplete:
+			a.Shutdown = true
+			terminated = true
+		case sctpChunkData:
+			d, errData := newSCTPDataChunk(c)
+			if errData != nil {
+				decoderLog.Debug("invalid SCTP DATA chunk", zap.Error(errData))
+
+				continue
+			}
+
+			a.NumDataChunks++
+			a.streams[d.stream] = struct{}{}
+			a.protocols[d.ppid] = struct{}{}
+
+			dir.handle(d, p, a.deliver)
+		}
+	}
+
+	return terminated
+}
+
+// swap exchanges the endpoints, used when the initiator of an association was not the first packet seen.
+func (a *sctpAssociation) swap() {
+	a.SrcIP, a.DstIP = a.DstIP, a.SrcIP
+	a.SrcPort, a.DstPort = a.DstPort, a.SrcPort
+	a.SrcVerificationTag, a.DstVerificationTag = a.DstVerificationTag, a.SrcVerificationTag
+	a.BytesClientToServer, a.BytesServerToClient = a.BytesServerToClient, a.BytesClientToServer
+	a.client, a.server = a.server, a.client
+}
+
+// deliver accounts for a reassembled user message and dispatches it to the decoder for its payload protocol.
+func (a *sctpAssociation) deliver(msg *sctpMessage) {
+	a.NumMessages++
+
+	lt, ok := sctpPayloadLayers[msg.ppid]
+	if !ok || len(a.state.goPacketDecoders[lt]) == 0 {
+		return
+	}
+
+	var ctx *types.PacketContext
+	if a.state.conf.AddContext {
+		ctx = newSCTPPacketContext(msg.packet)
+	}
+
+	pkt := gopacket.NewPacket(msg.data, lt, gopacket.DecodeOptions{Lazy: true, NoCopy: true})
+	for _, l := range pkt.Layers() {
+		for _, dec := range a.state.goPacketDecoders[l.LayerType()] {
+			if err := dec.Decode(ctx, msg.packet, l); err != nil {
+				decoderLog.Error("failed to decode reassembled SCTP message",
+					zap.String("layer", l.LayerType().String()),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+}
+
+// close delivers the buffered messages of both directions and returns the audit record.
+// The association must be locked by the caller.
+func (a *sctpAssociation) close() *types.SCTPAssociation {
+	a.client.flush(a.deliver)
+	a.server.flush(a.deliver)
+
+	return a.finish()
+}
+
+// finish updates the summary fields and returns the audit record.
+func (a *sctpAssociation) finish() *types.SCTPAssociation {
+	a.Duration = time.Unix(0, a.TimestampLast).Sub(time.Unix(0, a.TimestampFirst)).Nanoseconds()
+	a.NumStreams = int32(len(a.streams))
+	a.NumFragments = a.client.numFragments + a.server.numFragments
+	a.NumOutOfOrder = a.client.numOutOfOrder + a.server.numOutOfOrder
+	a.NumDuplicates = a.client.numDuplicates + a.server.numDuplicates
+	a.NumIncomplete = a.client.numIncomplete + a.server.numIncomplete
+
+	a.PayloadProtocols = a.PayloadProtocols[:0]
+	for ppid := range a.protocols {
+		a.PayloadProtocols = append(a.PayloadProtocols, sctpPayloadProtocolName(ppid))
+	}
+
+	sort.Strings(a.PayloadProtocols)
+
+	return a.SCTPAssociation
+}
+
+// newSCTPPacketContext creates the packet context for a record decoded from a reassembled message.
+func newSCTPPacketContext(p gopacket.Packet) *types.PacketContext {
+	ctx := &types.PacketContext{
+		Tunnel: GetTunnel(p),
+	}
+
+	if nl := p.NetworkLayer(); nl != nil {
+		ctx.SrcIP = nl.NetworkFlow().Src().String()
+		ctx.DstIP = nl.NetworkFlow().Dst().String()
+	}
+
+	if tl := p.TransportLayer(); tl != nil {
+		ctx.SrcPort = utils.DecodePort(tl.TransportFlow().Src().Raw())
+		ctx.DstPort = utils.DecodePort(tl.TransportFlow().Dst().Raw())
+	}
+
+	return ctx
+}
@@ -0,0 +1,256 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package packet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/dreadl0ck/gopacket"
+	"github.com/dreadl0ck/gopacket/layers"
+
+	"github.com/dreadl0ck/netcap/decoder/config"
+	"github.com/dreadl0ck/netcap/io"
+	"github.com/dreadl0ck/netcap/types"
+)
+
+func sctpDataChunkBytes(flags uint8, tsn uint32, stream, ssn uint16, ppid uint32, data []byte) []byte {
+	b := make([]byte, 16, 16+len(data)+3)
+	b[0] = sctpChunkData
+	b[1] = flags
+	binary.BigEndian.PutUint16(b[2:4], uint16(16+len(data)))
+	binary.BigEndian.PutUint32(b[4:8], tsn)
+	binary.BigEndian.PutUint16(b[8:10], stream)
+	binary.BigEndian.PutUint16(b[10:12], ssn)
+	binary.BigEndian.PutUint32(b[12:16], ppid)
+	b = append(b, data...)
+
+	// pad to four bytes
+	for len(b)%4 != 0 {
+		b = append(b, 0)
+	}
+
+	return b
+}
+
+func TestParseSCTPChunks(t *testing.T) {
+	var payload []byte
+	payload = append(payload, sctpDataChunkBytes(0x3, 1, 0, 0, 46, []byte("abcde"))...)
+	payload = append(payload, sctpDataChunkBytes(0x3, 2, 1, 0, 18, []byte("fg"))...)
+
+	chunks, err := parseSCTPChunks(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(chunks) != 2 {
+		t.Fatal("expected 2 chunks, got", len(chunks))
+	}
+
+	d, err := newSCTPDataChunk(chunks[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d.tsn != 2 || d.stream != 1 || d.ppid != 18 || !d.begin || !d.end || string(d.data) != "fg" {
+		t.Fatalf("unexpected data chunk: %+v", d)
+	}
+
+	if _, err = parseSCTPChunks([]byte{0, 0, 0, 2}); err == nil {
+		t.Fatal("expected error for invalid chunk length")
+	}
+}
+
+func TestSCTPDirectionReassembly(t *testing.T) {
+	var (
+		d         = newSCTPDirection()
+		delivered [][]byte
+		deliver   = func(m *sctpMessage) {
+			delivered = append(delivered, m.data)
+		}
+	)
+
+	// association setup was observed, streams start at sequence number zero
+	d.fromStart = true
+
+	// message 1 (ssn 1) is fragmented into three chunks that arrive out of order,
+	// message 0 (ssn 0) arrives after message 1 is complete.
+	d.handle(&sctpDataChunk{tsn: 12, ssn: 1, end: true, data: []byte("ghi")}, nil, deliver)
+	d.handle(&sctpDataChunk{tsn: 10, ssn: 1, begin: true, data: []byte("abc")}, nil, deliver)
+	d.handle(&sctpDataChunk{tsn: 11, ssn: 1, data: []byte("def")}, nil, deliver)
+
+	if len(delivered) != 0 {
+		t.Fatal("message delivered before the previous stream sequence number")
+	}
+
+	d.handle(&sctpDataChunk{tsn: 9, ssn: 0, begin: true, end: true, data: []byte("first")}, nil, deliver)
+
+	if len(delivered) != 2 {
+		t.Fatal("expected 2 messages, got", len(delivered))
+	}
+
+	if !bytes.Equal(delivered[0], []byte("first")) || !bytes.Equal(delivered[1], []byte("abcdefghi")) {
+		t.Fatalf("unexpected delivery order: %q", delivered)
+	}
+
+	// retransmission of an already seen TSN
+	d.handle(&sctpDataChunk{tsn: 11, ssn: 1, data: []byte("def")}, nil, deliver)
+
+	if d.numDuplicates != 1 {
+		t.Fatal("expected 1 duplicate, got", d.numDuplicates)
+	}
+
+	if d.numFragments != 3 {
+		t.Fatal("expected 3 fragments, got", d.numFragments)
+	}
+
+	// incomplete message is accounted for when flushing
+	d.handle(&sctpDataChunk{tsn: 13, ssn: 2, begin: true, data: []byte("x")}, nil, deliver)
+	d.flush(deliver)
+
+	if d.numIncomplete != 1 {
+		t.Fatal("expected 1 incomplete message, got", d.numIncomplete)
+	}
+}
+
+func TestSCTPDirectionPendingCopy(t *testing.T) {
+	var (
+		d         = newSCTPDirection()
+		buf       = []byte("second")
+		delivered [][]byte
+		deliver   = func(m *sctpMessage) {
+			delivered = append(delivered, m.data)
+		}
+	)
+
+	d.fromStart = true
+
+	// the message with ssn 1 waits for ssn 0, while the packet buffer is reused
+	d.handle(&sctpDataChunk{tsn: 2, ssn: 1, begin: true, end: true, data: buf}, nil, deliver)
+	copy(buf, "reused")
+	d.handle(&sctpDataChunk{tsn: 1, ssn: 0, begin: true, end: true, data: []byte("first")}, nil, deliver)
+
+	if len(delivered) != 2 || !bytes.Equal(delivered[1], []byte("second")) {
+		t.Fatalf("unexpected delivered messages: %q", delivered)
+	}
+}
+
+func TestSCTPDirectionUnordered(t *testing.T) {
+	var (
+		d   = newSCTPDirection()
+		num int
+	)
+
+	d.handle(&sctpDataChunk{tsn: 1, ssn: 5, begin: true, end: true}, nil, func(*sctpMessage) { num++ })
+	d.handle(&sctpDataChunk{tsn: 2, unordered: true, begin: true, end: true}, nil, func(*sctpMessage) { num++ })
+	d.handle(&sctpDataChunk{tsn: 3, ssn: 7, begin: true, end: true}, nil, func(*sctpMessage) { num++ })
+
+	if num != 2 {
+		t.Fatal("expected 2 delivered messages, got", num)
+	}
+
+	d.flush(func(*sctpMessage) { num++ })
+
+	if num != 3 {
+		t.Fatal("expected pending message to be delivered on flush, got", num)
+	}
+}
+
+func sctpPacket(t *testing.T, ts time.Time, srcPort, dstPort uint16, chunks ...[]byte) gopacket.Packet {
+	t.Helper()
+
+	var payload []byte
+	for _, c := range chunks {
+		payload = append(payload, c...)
+	}
+
+	data := serializeLayers(t,
+		&layers.IPv4{
+			Version:  4,
+			IHL:      5,
+			TTL:      64,
+			Protocol: layers.IPProtocolSCTP,
+			SrcIP:    net.IP{10, 0, 0, 1},
+			DstIP:    net.IP{10, 0, 0, 2},
+		},
+		&layers.SCTP{SrcPort: layers.SCTPPort(srcPort), DstPort: layers.SCTPPort(dstPort), VerificationTag: 1},
+		gopacket.Payload(payload),
+	)
+
+	p := gopacket.NewPacket(data, layers.LayerTypeIPv4, gopacket.Default)
+	p.Metadata().Timestamp = ts
+
+	return p
+}
+
+func TestSCTPAssociationClose(t *testing.T) {
+	var (
+		records = make(chan types.AuditRecord, 10)
+		conf    = &config.Config{SCTPIdleTimeout: time.Minute}
+		state   = NewState(conf)
+		d       = &Decoder{state: state, Writer: io.NewAuditRecordWriter(&io.WriterConfig{Records: records})}
+		start   = time.Unix(1600000000, 0)
+	)
+
+	// terminated by SHUTDOWN COMPLETE
+	handleSCTPPacket(state, sctpPacket(t, start, 2905, 2905, sctpDataChunkBytes(0x3, 1, 0, 0, 46, []byte("abcd"))))
+
+	a := handleSCTPPacket(state, sctpPacket(t, start.Add(time.Second), 2905, 2905, []byte{sctpChunkShutdownComplete, 0, 0, 4}))
+	if a == nil || !a.Shutdown || a.NumPackets != 2 || a.NumMessages != 1 {
+		t.Fatalf("expected record for terminated association, got %+v", a)
+	}
+
+	if len(state.sctpAssociations.Items) != 0 {
+		t.Fatal("terminated association has not been removed")
+	}
+
+	// idle associations are written by the expiry
+	handleSCTPPacket(state, sctpPacket(t, start.Add(2*time.Second), 3868, 3868, sctpDataChunkBytes(0x3, 1, 0, 0, 46, []byte("abcd"))))
+	handleSCTPPacket(state, sctpPacket(t, start.Add(time.Hour), 2905, 36412, sctpDataChunkBytes(0x3, 1, 0, 0, 46, []byte("abcd"))))
+
+	d.expireSCTPAssociations(start.Add(time.Hour).Add(-conf.SCTPIdleTimeout))
+
+	if len(state.sctpAssociations.Items) != 1 || len(records) != 1 {
+		t.Fatal("expected one expired and one active association, got", len(records), len(state.sctpAssociations.Items))
+	}
+
+	if r := (<-records).(*types.SCTPAssociation); r.SrcPort != 3868 {
+		t.Fatal("unexpected expired association", r)
+	}
+
+	// a packet for an association that has been written but not yet removed starts a new association
+	var closed *sctpAssociation
+	for _, a := range state.sctpAssociations.Items {
+		closed = a
+		closed.closed = true
+	}
+
+	handleSCTPPacket(state, sctpPacket(t, start.Add(time.Hour+time.Second), 2905, 36412, sctpDataChunkBytes(0x3, 2, 0, 1, 46, []byte("efgh"))))
+
+	for _, a := range state.sctpAssociations.Items {
+		if a == closed || a.NumPackets != 1 || closed.NumPackets != 1 {
+			t.Fatal("expected a new association, got", a.SCTPAssociation)
+		}
+	}
+}
+
+func TestSCTPAssociationID(t *testing.T) {
+	// without a delimiter both identifiers would be "1234"
+	if (sctpAssociationID{NetworkFlowID: 12, TransportFlowID: 34}).String() == (sctpAssociationID{NetworkFlowID: 1, TransportFlowID: 234}).String() {
+		t.Fatal("identifiers of different associations collide")
+	}
+}
@@ -0,0 +1,77 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package packet
+
+import (
+	"github.com/dreadl0ck/gopacket"
+
+	"github.com/dreadl0ck/netcap/decoder/config"
+)
+
+// State contains the data the packet decoders of a collector keep while processing packets,
+// so that multiple collectors can be used in the same process.
+type State struct {
+	conf *config.Config
+
+	// LocalDNS controls whether the DNS names shall be resolved locally
+	// without contacting a nameserver.
+	LocalDNS bool
+
+	// tracked connections, in a table for each worker
+	conns *shardedConnMap
+
+	// SrcMAC to device profiles
+	deviceProfiles *atomicDeviceProfileMap
+
+	// IP address to ip profiles
+	ipProfiles *atomicIPProfileMap
+
+	// tracked SCTP associations
+	sctpAssociations *atomicSCTPAssociationMap
+
+	// contains the initialized gopacket decoders mapped to their layer types,
+	// used by packet decoders that need to decode payloads they reassembled themselves.
+	goPacketDecoders map[gopacket.LayerType][]*GoPacketDecoder
+}
+
+// NewState returns the state for the packet decoders of a collector using the configuration.
+func NewState(conf *config.Config) *State {
+	return &State{
+		conf:     conf,
+		LocalDNS: true,
+		conns:    newShardedConnMap(1),
+		deviceProfiles: &atomicDeviceProfileMap{
+			Items: make(map[string]*deviceProfile),
+		},
+		ipProfiles: &atomicIPProfileMap{
+			Items: make(map[string]*ipProfile),
+		},
+		sctpAssociations: &atomicSCTPAssociationMap{
+			Items: make(map[sctpAssociationID]*sctpAssociation),
+		},
+		goPacketDecoders: map[gopacket.LayerType][]*GoPacketDecoder{},
+	}
+}
+
+// SetShards creates a separate connection table for each of the numShards workers of the collector,
+// the worker owning the flow of a packet is recorded with SetShard.
+// It must be called before any packets are decoded.
+func (s *State) SetShards(numShards int) {
+	s.conns = newShardedConnMap(numShards)
+}
+
+// NumDeviceProfiles returns the number of device profiles.
+func (s *State) NumDeviceProfiles() int {
+	return s.deviceProfiles.Size()
+}
@@ -25,17 +25,17 @@ var tcpDecoder = newGoPacketDecoder(
 	types.Type_NC_TCP,
 	layers.LayerTypeTCP,
 	"The Transmission Control Protocol (TCP) is a connection-oriented communications protocol, that facilitates the exchange of messages between computing devices in a network",
-	func(layer gopacket.Layer, timestamp int64) proto.Message {
+	func(d *GoPacketDecoder, layer gopacket.Layer, timestamp int64) proto.Message {
 		if tcp, ok := layer.(*layers.TCP); ok {
 			var (
 				opts    []*types.TCPOption
 				payload []byte
 			)
-			if conf.IncludePayloads {
+			if d.conf.IncludePayloads {
 				payload = layer.LayerPayload()
 			}
 			var e float64
-			if conf.CalculateEntropy {
+			if d.conf.CalculateEntropy {
 				e = entropy(tcp.Payload)
 			}
 			for _, o := range tcp.Options {
@@ -0,0 +1,288 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package packet
+
+import (
+	"encoding/binary"
+	"strconv"
+	"time"
+
+	"github.com/dreadl0ck/gopacket"
+	"github.com/dreadl0ck/gopacket/layers"
+
+	"github.com/dreadl0ck/netcap/types"
+)
+
+// segments arriving within this duration after the segment with the highest sequence number
+// are considered out-of-order instead of retransmitted, if the handshake round trip time is unknown.
+// wireshark uses the same default.
+const defaultOutOfOrderThreshold = 3 * time.Millisecond
+
+// termination reasons for TCP connections.
+const (
+	terminationClosed      = "Closed"
+	terminationHalfClosed  = "HalfClosed"
+	terminationClientReset = "ClientReset"
+	terminationServerReset = "ServerReset"
+	terminationRejected    = "Rejected"
+	terminationOpen        = "Open"
+)
+
+// tcpDirection contains the analysis state for the segments sent by one side of a TCP connection.
+type tcpDirection struct {
+	// sequence space
+	seqSeen     bool
+	nextSeq     uint32
+	lastSegment time.Time
+
+	// acknowledgements sent
+	ackSeen    bool
+	lastAck    uint32
+	lastWindow uint16
+
+	// options from the SYN
+	windowScale int32
+	mss         int32
+
+	fin bool
+
+	retransmissions int32
+	dupACKs         int32
+	outOfOrder      int32
+	zeroWindows     int32
+}
+
+// tcpAnalysis tracks transport health indicators for a TCP connection,
+// similar to the expert analysis of wireshark.
+type tcpAnalysis struct {
+	// the client is the side that sent the SYN,
+	// or the sender of the first packet if the handshake has not been seen.
+	clientSeen bool
+	clientIP   gopacket.Endpoint
+	clientPort layers.TCPPort
+
+	client tcpDirection
+	server tcpDirection
+
+	synTime    time.Time
+	synAckSeen bool
+	synAckSeq  uint32
+	rtt        time.Duration
+
+	termination string
+}
+
+// analyzeTCP updates the TCP analysis of the connection with the packet,
+// packets without a TCP layer are ignored.
+func (c *connection) analyzeTCP(p gopacket.Packet) {
+	t, ok := p.TransportLayer().(*layers.TCP)
+	if !ok {
+		return
+	}
+
+	nl := p.NetworkLayer()
+	if nl == nil {
+		return
+	}
+
+	if c.tcp == nil {
+		c.tcp = &tcpAnalysis{}
+	}
+
+	c.tcp.update(nl.NetworkFlow().Src(), nl.NetworkFlow().Dst(), t, p.Metadata().Timestamp)
+}
+
+func (a *tcpAnalysis) update(src, dst gopacket.Endpoint, t *layers.TCP, ts time.Time) {
+	switch {
+	case !a.clientSeen:
+		// the receiver of a SYN/ACK is the client, otherwise the sender of the first packet
+		a.clientSeen = true
+		if t.SYN && t.ACK {
+			a.clientIP, a.clientPort = dst, t.DstPort
+		} else {
+			a.clientIP, a.clientPort = src, t.SrcPort
+		}
+	case t.SYN && !t.ACK && a.synTime.IsZero() && (src != a.clientIP || t.SrcPort != a.clientPort):
+		// the first packet has been sent by the server
+		a.client, a.server = a.server, a.client
+		a.clientIP, a.clientPort = src, t.SrcPort
+	}
+
+	fromClient := src == a.clientIP && t.SrcPort == a.clientPort
+
+	snd := &a.server
+	if fromClient {
+		snd = &a.client
+	}
+
+	switch {
+	case t.SYN && !t.ACK:
+		if a.synTime.IsZero() {
+			a.synTime = ts
+		}
+		snd.parseOptions(t)
+	case t.SYN && t.ACK:
+		if !a.synAckSeen {
+			a.synAckSeen, a.synAckSeq = true, t.Seq
+		}
+		snd.parseOptions(t)
+	case t.ACK && fromClient && a.synAckSeen && a.rtt == 0 && !a.synTime.IsZero() && t.Ack == a.synAckSeq+1:
+		if d := ts.Sub(a.synTime); d > 0 {
+			a.rtt = d
+		}
+	}
+
+	snd.analyzeSegment(t, ts, a.outOfOrderThreshold())
+
+	if t.FIN {
+		snd.fin = true
+	}
+
+	// a reset after both sides closed the connection does not change the termination reason
+	if t.RST && a.termination == "" && !(a.client.fin && a.server.fin) {
+		switch {
+		case !fromClient && !a.synAckSeen && !a.synTime.IsZero():
+			a.termination = terminationRejected
+		case fromClient:
+			a.termination = terminationClientReset
+		default:
+			a.termination = terminationServerReset
+		}
+	}
+}
+
+func (a *tcpAnalysis) outOfOrderThreshold() time.Duration {
+	if a.rtt > 0 {
+		return a.rtt
+	}
+
+	return defaultOutOfOrderThreshold
+}
+
+func (a *tcpAnalysis) terminationReason() string {
+	switch {
+	case a.termination != "":
+		return a.termination
+	case a.client.fin && a.server.fin:
+		return terminationClosed
+	case a.client.fin || a.server.fin:
+		return terminationHalfClosed
+	default:
+		return terminationOpen
+	}
+}
+
+// setFields adds the analysis results to the connection audit record,
+// the values for the client are set for the source of the audit record.
+func (a *tcpAnalysis) setFields(co *types.Connection) {
+	src, dst := &a.client, &a.server
+	if co.SrcIP != a.clientIP.String() || co.SrcPort != strconv.Itoa(int(a.clientPort)) {
+		src, dst = dst, src
+	}
+
+	co.HandshakeRTT = a.rtt.Nanoseconds()
+	co.RetransmissionsClientToServer = src.retransmissions
+	co.RetransmissionsServerToClient = dst.retransmissions
+	co.DupACKsClientToServer = src.dupACKs
+	co.DupACKsServerToClient = dst.dupACKs
+	co.OutOfOrderClientToServer = src.outOfOrder
+	co.OutOfOrderServerToClient = dst.outOfOrder
+	co.ZeroWindowsClientToServer = src.zeroWindows
+	co.ZeroWindowsServerToClient = dst.zeroWindows
+	co.WindowScaleClient = src.windowScale
+	co.WindowScaleServer = dst.windowScale
+	co.MSSClient = src.mss
+	co.MSSServer = dst.mss
+	co.TerminationReason = a.terminationReason()
+}
+
+// parseOptions collects the window scale and MSS options from a SYN segment.
+func (d *tcpDirection) parseOptions(t *layers.TCP) {
+	// the window is not scaled if the option is missing
+	d.windowScale = 1
+
+	for _, o := range t.Options {
+		switch o.OptionType {
+		case layers.TCPOptionKindWindowScale:
+			if len(o.OptionData) == 1 {
+				shift := o.OptionData[0]
+				if shift > 14 {
+					shift = 14
+				}
+				d.windowScale = 1 << shift
+			}
+		case layers.TCPOptionKindMSS:
+			if len(o.OptionData) == 2 {
+				d.mss = int32(binary.BigEndian.Uint16(o.OptionData))
+			}
+		}
+	}
+}
+
+// analyzeSegment checks a segment sent in this direction for retransmissions, out-of-order delivery,
+// duplicate acknowledgements and zero window advertisements.
+func (d *tcpDirection) analyzeSegment(t *layers.TCP, ts time.Time, threshold time.Duration) {
+	segLen := uint32(len(t.Payload))
+	if t.SYN || t.FIN {
+		segLen++
+	}
+
+	if !t.RST {
+		if t.Window == 0 && !t.SYN && !t.FIN {
+			d.zeroWindows++
+		}
+
+		if t.ACK {
+			if segLen == 0 && d.ackSeen && t.Ack == d.lastAck && t.Window == d.lastWindow {
+				d.dupACKs++
+			}
+
+			d.ackSeen, d.lastAck, d.lastWindow = true, t.Ack, t.Window
+		}
+	}
+
+	if segLen == 0 {
+		return
+	}
+
+	end := t.Seq + segLen
+
+	if !d.seqSeen {
+		d.seqSeen, d.nextSeq, d.lastSegment = true, end, ts
+
+		return
+	}
+
+	if seqLess(t.Seq, d.nextSeq) {
+		// keep-alives repeat the last sequence number with at most one byte of data
+		if segLen <= 1 && !t.SYN && !t.FIN && t.Seq == d.nextSeq-1 {
+			return
+		}
+
+		if ts.Sub(d.lastSegment) < threshold {
+			d.outOfOrder++
+		} else {
+			d.retransmissions++
+		}
+	}
+
+	if seqLess(d.nextSeq, end) {
+		d.nextSeq, d.lastSegment = end, ts
+	}
+}
+
+// seqLess compares two sequence numbers, taking wrap arounds into account.
+func seqLess(a, b uint32) bool {
+	return int32(a-b) < 0
+}
@@ -0,0 +1,174 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package packet
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/dreadl0ck/gopacket/layers"
+
+	"github.com/dreadl0ck/netcap/types"
+)
+
+var (
+	analysisClient = layers.NewIPEndpoint(net.IP{10, 0, 0, 1})
+	analysisServer = layers.NewIPEndpoint(net.IP{10, 0, 0, 2})
+	analysisStart  = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type analysisSegment struct {
+	fromClient bool
+	ms         int
+	tcp        layers.TCP
+}
+
+func runAnalysis(segments []analysisSegment) *tcpAnalysis {
+	a := &tcpAnalysis{}
+
+	for _, s := range segments {
+		t := s.tcp
+		src, dst := analysisServer, analysisClient
+		t.SrcPort, t.DstPort = 80, 1234
+		if s.fromClient {
+			src, dst = dst, src
+			t.SrcPort, t.DstPort = t.DstPort, t.SrcPort
+		}
+
+		a.update(src, dst, &t, analysisStart.Add(time.Duration(s.ms)*time.Millisecond))
+	}
+
+	return a
+}
+
+func TestTCPAnalysis(t *testing.T) {
+	a := runAnalysis([]analysisSegment{
+		{true, 0, layers.TCP{SYN: true, Seq: 100, Window: 64240, Options: []layers.TCPOption{
+			{OptionType: layers.TCPOptionKindMSS, OptionLength: 4, OptionData: []byte{0x05, 0xb4}},
+			{OptionType: layers.TCPOptionKindWindowScale, OptionLength: 3, OptionData: []byte{7}},
+		}}},
+		{false, 10, layers.TCP{SYN: true, ACK: true, Seq: 500, Ack: 101, Window: 65535, Options: []layers.TCPOption{
+			{OptionType: layers.TCPOptionKindMSS, OptionLength: 4, OptionData: []byte{0x05, 0x78}},
+		}}},
+		{true, 20, layers.TCP{ACK: true, Seq: 101, Ack: 501, Window: 502}},
+		{true, 30, layers.TCP{ACK: true, PSH: true, Seq: 101, Ack: 501, Window: 502, BaseLayer: layers.BaseLayer{Payload: make([]byte, 10)}}},
+		{true, 31, layers.TCP{ACK: true, PSH: true, Seq: 111, Ack: 501, Window: 502, BaseLayer: layers.BaseLayer{Payload: make([]byte, 10)}}},
+		// retransmission, more than one round trip time after the last segment
+		{true, 100, layers.TCP{ACK: true, PSH: true, Seq: 101, Ack: 501, Window: 502, BaseLayer: layers.BaseLayer{Payload: make([]byte, 10)}}},
+		// out-of-order, the segment with seq 121 arrives after the one with seq 131
+		{true, 110, layers.TCP{ACK: true, PSH: true, Seq: 131, Ack: 501, Window: 502, BaseLayer: layers.BaseLayer{Payload: make([]byte, 10)}}},
+		{true, 115, layers.TCP{ACK: true, PSH: true, Seq: 121, Ack: 501, Window: 502, BaseLayer: layers.BaseLayer{Payload: make([]byte, 10)}}},
+		// zero window, then a duplicate ack
+		{false, 120, layers.TCP{ACK: true, Seq: 501, Ack: 141, Window: 0}},
+		{false, 121, layers.TCP{ACK: true, Seq: 501, Ack: 141, Window: 0}},
+		{false, 130, layers.TCP{ACK: true, FIN: true, Seq: 501, Ack: 141, Window: 10}},
+		{true, 140, layers.TCP{RST: true, Seq: 141}},
+	})
+
+	co := &types.Connection{SrcIP: "10.0.0.1", SrcPort: "1234", DstIP: "10.0.0.2", DstPort: "80"}
+	a.setFields(co)
+
+	if co.HandshakeRTT != (20 * time.Millisecond).Nanoseconds() {
+		t.Error("unexpected handshake rtt", co.HandshakeRTT)
+	}
+
+	if co.RetransmissionsClientToServer != 1 || co.RetransmissionsServerToClient != 0 {
+		t.Error("unexpected retransmissions", co.RetransmissionsClientToServer, co.RetransmissionsServerToClient)
+	}
+
+	if co.OutOfOrderClientToServer != 1 || co.OutOfOrderServerToClient != 0 {
+		t.Error("unexpected out-of-order segments", co.OutOfOrderClientToServer, co.OutOfOrderServerToClient)
+	}
+
+	if co.DupACKsServerToClient != 1 || co.DupACKsClientToServer != 0 {
+		t.Error("unexpected duplicate acks", co.DupACKsClientToServer, co.DupACKsServerToClient)
+	}
+
+	if co.ZeroWindowsServerToClient != 2 || co.ZeroWindowsClientToServer != 0 {
+		t.Error("unexpected zero windows", co.ZeroWindowsClientToServer, co.ZeroWindowsServerToClient)
+	}
+
+	if co.WindowScaleClient != 128 || co.WindowScaleServer != 1 {
+		t.Error("unexpected window scale", co.WindowScaleClient, co.WindowScaleServer)
+	}
+
+	if co.MSSClient != 1460 || co.MSSServer != 1400 {
+		t.Error("unexpected mss", co.MSSClient, co.MSSServer)
+	}
+
+	if co.TerminationReason != terminationClientReset {
+		t.Error("unexpected termination reason", co.TerminationReason)
+	}
+
+	// values are set from the perspective of the audit record source
+	reversed := &types.Connection{SrcIP: "10.0.0.2", SrcPort: "80", DstIP: "10.0.0.1", DstPort: "1234"}
+	a.setFields(reversed)
+
+	if reversed.MSSClient != 1400 || reversed.RetransmissionsServerToClient != 1 {
+		t.Error("unexpected values for reversed connection", reversed.MSSClient, reversed.RetransmissionsServerToClient)
+	}
+}
+
+func TestTCPAnalysisTermination(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		segments []analysisSegment
+		expected string
+	}{
+		{
+			name: "rejected",
+			segments: []analysisSegment{
+				{true, 0, layers.TCP{SYN: true, Seq: 100}},
+				{false, 1, layers.TCP{RST: true, ACK: true, Ack: 101}},
+			},
+			expected: terminationRejected,
+		},
+		{
+			name: "closed",
+			segments: []analysisSegment{
+				{true, 0, layers.TCP{FIN: true, ACK: true, Seq: 100, Ack: 500}},
+				{false, 1, layers.TCP{FIN: true, ACK: true, Seq: 500, Ack: 101}},
+				{true, 2, layers.TCP{RST: true, Seq: 101}},
+			},
+			expected: terminationClosed,
+		},
+		{
+			name: "half closed",
+			segments: []analysisSegment{
+				{false, 0, layers.TCP{FIN: true, ACK: true, Seq: 500, Ack: 100}},
+			},
+			expected: terminationHalfClosed,
+		},
+		{
+			name: "server reset",
+			segments: []analysisSegment{
+				{true, 0, layers.TCP{ACK: true, Seq: 100, Ack: 500, BaseLayer: layers.BaseLayer{Payload: []byte("data")}}},
+				{false, 1, layers.TCP{RST: true, Seq: 500}},
+			},
+			expected: terminationServerReset,
+		},
+		{
+			name: "open",
+			segments: []analysisSegment{
+				{true, 0, layers.TCP{ACK: true, Seq: 100, Ack: 500}},
+			},
+			expected: terminationOpen,
+		},
+	} {
+		if reason := runAnalysis(c.segments).terminationReason(); reason != c.expected {
+			t.Error(c.name, "unexpected termination reason", reason, "expected", c.expected)
+		}
+	}
+}
@@ -29,7 +29,7 @@ var tlsClientHelloDecoder = newPacketDecoder(
 	"TLSClientHello",
 	"The client hello from a Transport Layer Security handshake",
 	nil,
-	func(p gopacket.Packet) proto.Message {
+	func(d *Decoder, p gopacket.Packet) proto.Message {
 		hello := tlsx.GetClientHello(p)
 		if hello != nil {
 
@@ -110,6 +110,8 @@ var tlsClientHelloDecoder = newPacketDecoder(
 				SrcPort:          int32(srcPort),
 				DstPort:          int32(dstPort),
 				Extensions:       extensions,
+				CommunityID:      communityID(d.state.conf.CommunityIDSeed, p),
+				Tunnel:           GetTunnel(p),
 			}
 		}
 
@@ -29,7 +29,7 @@ var tlsServerHelloDecoder = newPacketDecoder(
 	"TLSServerHello",
 	"The server hello from a Transport Layer Security handshake",
 	nil,
-	func(p gopacket.Packet) proto.Message {
+	func(d *Decoder, p gopacket.Packet) proto.Message {
 		hello := tlsx.GetServerHello(p)
 		if hello != nil {
 
@@ -88,6 +88,8 @@ var tlsServerHelloDecoder = newPacketDecoder(
 				SrcPort:                      int32(srcPort),
 				DstPort:                      int32(dstPort),
 				Extensions:                   extensions,
+				CommunityID:                  communityID(d.state.conf.CommunityIDSeed, p),
+				Tunnel:                       GetTunnel(p),
 			}
 		}
 
@@ -0,0 +1,328 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package packet
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+
+	"github.com/dreadl0ck/gopacket"
+	"github.com/dreadl0ck/gopacket/layers"
+
+	"github.com/dreadl0ck/netcap/types"
+)
+
+// tunnel types as reported in the Tunnel field of audit records.
+const (
+	tunnelGRE    = "GRE"
+	tunnelERSPAN = "ERSPAN"
+	tunnelVXLAN  = "VXLAN"
+	tunnelGeneve = "Geneve"
+	tunnel6in4   = "6in4"
+	tunnelTeredo = "Teredo"
+	tunnelIPinIP = "IPinIP"
+)
+
+// teredoPort is the well known UDP port for Teredo (RFC 4380).
+const teredoPort = 3544
+
+// ethernetTypeERSPANIII is the GRE protocol type of ERSPAN type III, which is not known to gopacket.
+const ethernetTypeERSPANIII layers.EthernetType = 0x22EB
+
+// Decapsulate checks if the packet carries a tunneled packet
+// and returns the inner packet, decoded with the provided options.
+// The returned index points to the layer of the outer packet that contains the inner packet,
+// the layers following it belong to the inner packet and should not be processed again for the outer packet.
+// The tunnel identifiers are attached to the inner packet and can be retrieved with GetTunnel.
+// If no supported tunnel was found, the returned packet is nil.
+func Decapsulate(p gopacket.Packet, opts gopacket.DecodeOptions) (inner gopacket.Packet, index int) {
+	var (
+		outerSrc, outerDst string
+		allLayers          = p.Layers()
+	)
+
+	for i, l := range allLayers {
+		var (
+			tunnel  *types.Tunnel
+			payload []byte
+			first   gopacket.Decoder
+		)
+
+		switch l := l.(type) {
+		case *layers.IPv4:
+			outerSrc, outerDst = l.SrcIP.String(), l.DstIP.String()
+
+			payload = l.Payload
+			first, tunnel = ipTunnel(l.Protocol, true)
+		case *layers.IPv6:
+			outerSrc, outerDst = l.SrcIP.String(), l.DstIP.String()
+
+			payload = l.Payload
+			first, tunnel = ipTunnel(l.NextHeader, false)
+		case *layers.GRE:
+			tunnel = &types.Tunnel{Type: tunnelGRE, GREKey: l.Key}
+
+			switch l.Protocol {
+			case layers.EthernetTypeERSPAN:
+				// ERSPAN type II header is followed by the mirrored ethernet frame
+				if len(l.Payload) < 8 {
+					continue
+				}
+
+				tunnel.Type = tunnelERSPAN
+				tunnel.SessionID = uint32(binary.BigEndian.Uint16(l.Payload[2:4]) & 0x03FF)
+				payload = l.Payload[8:]
+				first = layers.LayerTypeEthernet
+			case ethernetTypeERSPANIII:
+				if payload, first = erspanIIIPayload(l.Payload); payload == nil {
+					continue
+				}
+
+				tunnel.Type = tunnelERSPAN
+				tunnel.SessionID = uint32(binary.BigEndian.Uint16(l.Payload[2:4]) & 0x03FF)
+			case layers.EthernetTypeIPv4, layers.EthernetTypeIPv6, layers.EthernetTypeTransparentEthernetBridging:
+				payload = l.Payload
+				first = l.Protocol
+			default:
+				continue
+			}
+		case *layers.VXLAN:
+			tunnel = &types.Tunnel{Type: tunnelVXLAN, VNI: l.VNI}
+			payload = l.Payload
+			first = layers.LayerTypeEthernet
+		case *layers.Geneve:
+			tunnel = &types.Tunnel{Type: tunnelGeneve, VNI: l.VNI}
+			payload = l.Payload
+			first = l.Protocol
+		case *layers.UDP:
+			if l.SrcPort != teredoPort && l.DstPort != teredoPort {
+				continue
+			}
+
+			if payload = teredoPayload(l.Payload); payload != nil {
+				tunnel = &types.Tunnel{Type: tunnelTeredo}
+				first = layers.LayerTypeIPv6
+			}
+		}
+
+		if tunnel == nil || len(payload) == 0 {
+			continue
+		}
+
+		tunnel.OuterSrcIP = outerSrc
+		tunnel.OuterDstIP = outerDst
+
+		inner = gopacket.NewPacket(payload, first, opts)
+
+		// preserve capture information of the outer packet and attach the tunnel identifiers
+		md := inner.Metadata()
+		md.CaptureInfo = p.Metadata().CaptureInfo
+		md.CaptureLength = len(payload)
+		md.Length = len(payload)
+		md.AncillaryData = append(append([]interface{}(nil), p.Metadata().AncillaryData...), tunnel)
+
+		return inner, i
+	}
+
+	return nil, len(allLayers)
+}
+
+// GetTunnel returns the innermost tunnel a decapsulated packet was transported in,
+// or nil if the packet was not decapsulated.
+func GetTunnel(p gopacket.Packet) *types.Tunnel {
+	data := p.Metadata().AncillaryData
+	for i := len(data) - 1; i >= 0; i-- {
+		if t, ok := data[i].(*types.Tunnel); ok {
+			return t
+		}
+	}
+
+	return nil
+}
+
+// TunnelID returns an identifier for the virtual network a decapsulated packet was transported in,
+// derived from the VNI and GRE key of its innermost tunnel, or from its endpoints for tunnels without a key.
+// It returns 0 if the packet was not decapsulated.
+func TunnelID(p gopacket.Packet) uint64 {
+	return tunnelID(GetTunnel(p))
+}
+
+// keylessTunnel marks identifiers derived from the tunnel endpoints,
+// the VNI only occupies 24 bits and cannot collide with it.
+const keylessTunnel = 1 << 63
+
+// tunnelID returns the identifier for the virtual network of the tunnel, or 0 if t is nil.
+// Tunnels without VNI and GRE key, like IP in IP, 6in4 and Teredo, are identified by their type and endpoints,
+// independent of the direction, so that their inner flows are not merged with untunneled flows.
+func tunnelID(t *types.Tunnel) uint64 {
+	if t == nil {
+		return 0
+	}
+
+	if t.VNI != 0 || t.GREKey != 0 {
+		return uint64(t.VNI)<<32 | uint64(t.GREKey)
+	}
+
+	a, b := t.OuterSrcIP, t.OuterDstIP
+	if a > b {
+		a, b = b, a
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(t.Type + "-" + a + "-" + b))
+
+	return h.Sum64() | keylessTunnel
+}
+
+// TransportLayerTunneled returns whether the transport layer reported for the outer packet of a tunnel
+// belongs to the tunneled packet. This is the case for tunnels directly on top of IP, like GRE, 6in4 and IP in IP,
+// but not for UDP based tunnels like VXLAN. index is the tunnel layer returned by Decapsulate.
+func TransportLayerTunneled(p gopacket.Packet, index int) bool {
+	tl := p.TransportLayer()
+	if tl == nil {
+		return false
+	}
+
+	for _, l := range p.Layers()[:index+1] {
+		if l == gopacket.Layer(tl) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// outerPacket is the outer packet of a tunnel, without the layers of the tunneled packet.
+type outerPacket struct {
+	gopacket.Packet
+	transport gopacket.TransportLayer
+}
+
+// OuterPacket returns the outer packet of a tunnel for decoders that track flows.
+// gopacket reports the transport and application layers of the tunneled packet for the outer packet as well,
+// the returned packet only reports the transport layer if it precedes the tunnel and no application layer.
+// index is the tunnel layer returned by Decapsulate.
+func OuterPacket(p gopacket.Packet, index int) gopacket.Packet {
+	o := &outerPacket{Packet: p}
+	if !TransportLayerTunneled(p, index) {
+		o.transport = p.TransportLayer()
+	}
+
+	return o
+}
+
+// TransportLayer returns the transport layer of the outer packet, or nil if the tunnel is directly on top of IP.
+func (o *outerPacket) TransportLayer() gopacket.TransportLayer {
+	return o.transport
+}
+
+// ApplicationLayer returns nil, the payload of the outer packet is the tunneled packet.
+func (o *outerPacket) ApplicationLayer() gopacket.ApplicationLayer {
+	return nil
+}
+
+// IsFlowDecoder returns whether the decoder tracks flows or their payloads,
+// these decoders must not process the outer packet of a tunnel.
+// The outer flow is only tracked by the connection decoder, see OuterPacket.
+func IsFlowDecoder(d DecoderAPI) bool {
+	switch d.GetType() {
+	case types.Type_NC_Connection, types.Type_NC_IPProfile, types.Type_NC_SCTPAssociation,
+		types.Type_NC_TLSClientHello, types.Type_NC_TLSServerHello:
+		return true
+	}
+
+	return false
+}
+
+// ipTunnel returns the decoder for the encapsulated packet and the tunnel type,
+// if the IP protocol number indicates IP encapsulated in IP.
+func ipTunnel(proto layers.IPProtocol, outerIPv4 bool) (gopacket.Decoder, *types.Tunnel) {
+	switch proto {
+	case layers.IPProtocolIPv4:
+		return layers.LayerTypeIPv4, &types.Tunnel{Type: tunnelIPinIP}
+	case layers.IPProtocolIPv6:
+		if outerIPv4 {
+			return layers.LayerTypeIPv6, &types.Tunnel{Type: tunnel6in4}
+		}
+
+		return layers.LayerTypeIPv6, &types.Tunnel{Type: tunnelIPinIP}
+	}
+
+	return nil, nil
+}
+
+// teredoPayload strips the optional Teredo authentication and origin indication headers
+// and returns the encapsulated IPv6 packet, or nil if the payload does not contain one.
+func teredoPayload(data []byte) []byte {
+	// authentication header
+	if len(data) >= 4 && data[0] == 0x00 && data[1] == 0x01 {
+		// indicator, ID length, AU length, client identifier, authentication value, nonce and confirmation
+		size := 4 + int(data[2]) + int(data[3]) + 9
+		if len(data) < size {
+			return nil
+		}
+
+		data = data[size:]
+	}
+
+	// origin indication
+	if len(data) >= 8 && data[0] == 0x00 && data[1] == 0x00 {
+		data = data[8:]
+	}
+
+	// the encapsulated packet must be a plausible IPv6 header
+	if len(data) < 40 || data[0]>>4 != 6 {
+		return nil
+	}
+
+	return data
+}
+
+// erspanIIIPayload strips the ERSPAN type III header and the optional platform specific subheader,
+// and returns the mirrored frame with the decoder for its frame type.
+// Frames that are neither ethernet nor IP are not decapsulated and nil is returned.
+func erspanIIIPayload(data []byte) ([]byte, gopacket.Decoder) {
+	// the version of type III headers is 2
+	if len(data) < 12 || data[0]>>4 != 2 {
+		return nil, nil
+	}
+
+	var (
+		flags     = binary.BigEndian.Uint16(data[10:12])
+		frameType = flags >> 10 & 0x1F
+		size      = 12
+	)
+
+	// the O flag indicates the platform specific subheader
+	if flags&0x1 != 0 {
+		size += 8
+	}
+
+	if len(data) <= size {
+		return nil, nil
+	}
+
+	payload := data[size:]
+
+	switch {
+	case frameType == 0:
+		return payload, layers.LayerTypeEthernet
+	case frameType == 2 && payload[0]>>4 == 4:
+		return payload, layers.LayerTypeIPv4
+	case frameType == 2 && payload[0]>>4 == 6:
+		return payload, layers.LayerTypeIPv6
+	}
+
+	return nil, nil
+}
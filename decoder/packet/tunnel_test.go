@@ -0,0 +1,365 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package packet
+
+import (
+	"net"
+	"testing"
+
+	"github.com/dreadl0ck/gopacket"
+	"github.com/dreadl0ck/gopacket/layers"
+
+	"github.com/dreadl0ck/netcap/decoder/config"
+	"github.com/dreadl0ck/netcap/types"
+)
+
+func serializeLayers(t *testing.T, l ...gopacket.SerializableLayer) []byte {
+	t.Helper()
+
+	buf := gopacket.NewSerializeBuffer()
+	if err := gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true}, l...); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestDecapsulate6in4(t *testing.T) {
+	var (
+		ip6 = &layers.IPv6{
+			Version:    6,
+			NextHeader: layers.IPProtocolUDP,
+			HopLimit:   64,
+			SrcIP:      net.ParseIP("2001:db8::1"),
+			DstIP:      net.ParseIP("2001:db8::2"),
+		}
+		udp = &layers.UDP{SrcPort: 5000, DstPort: 53}
+	)
+
+	if err := udp.SetNetworkLayerForChecksum(ip6); err != nil {
+		t.Fatal(err)
+	}
+
+	data := serializeLayers(t,
+		&layers.IPv4{
+			Version:  4,
+			IHL:      5,
+			TTL:      64,
+			Protocol: layers.IPProtocolIPv6,
+			SrcIP:    net.IP{192, 168, 1, 1},
+			DstIP:    net.IP{192, 168, 1, 2},
+		},
+		ip6,
+		udp,
+		gopacket.Payload("data"),
+	)
+
+	p := gopacket.NewPacket(data, layers.LayerTypeIPv4, gopacket.Default)
+
+	inner, index := Decapsulate(p, gopacket.Default)
+	if inner == nil {
+		t.Fatal("expected inner packet")
+	}
+
+	if index != 0 {
+		t.Fatal("expected tunnel at layer 0, got", index)
+	}
+
+	if nl := inner.NetworkLayer(); nl == nil || nl.NetworkFlow().Src().String() != "2001:db8::1" {
+		t.Fatal("unexpected inner network layer", inner)
+	}
+
+	tunnel := GetTunnel(inner)
+	if tunnel == nil {
+		t.Fatal("expected tunnel information on inner packet")
+	}
+
+	if tunnel.Type != tunnel6in4 || tunnel.OuterSrcIP != "192.168.1.1" || tunnel.OuterDstIP != "192.168.1.2" {
+		t.Fatalf("unexpected tunnel: %+v", tunnel)
+	}
+
+	if GetTunnel(p) != nil || TunnelID(p) != 0 {
+		t.Fatal("outer packet must not carry tunnel information")
+	}
+
+	// gopacket reports the inner UDP layer as transport layer of the outer packet
+	if !TransportLayerTunneled(p, index) {
+		t.Fatal("expected transport layer of the outer packet to belong to the inner packet")
+	}
+}
+
+func TestDecapsulateVXLAN(t *testing.T) {
+	data := serializeLayers(t,
+		&layers.IPv4{
+			Version:  4,
+			IHL:      5,
+			TTL:      64,
+			Protocol: layers.IPProtocolUDP,
+			SrcIP:    net.IP{10, 0, 0, 1},
+			DstIP:    net.IP{10, 0, 0, 2},
+		},
+		&layers.UDP{SrcPort: 40000, DstPort: 4789},
+		&layers.VXLAN{ValidIDFlag: true, VNI: 42},
+		&layers.Ethernet{
+			SrcMAC:       net.HardwareAddr{0, 1, 2, 3, 4, 5},
+			DstMAC:       net.HardwareAddr{0, 1, 2, 3, 4, 6},
+			EthernetType: layers.EthernetTypeIPv4,
+		},
+		&layers.IPv4{
+			Version:  4,
+			IHL:      5,
+			TTL:      64,
+			Protocol: layers.IPProtocolUDP,
+			SrcIP:    net.IP{172, 16, 0, 1},
+			DstIP:    net.IP{172, 16, 0, 2},
+		},
+		&layers.UDP{SrcPort: 1234, DstPort: 53},
+	)
+
+	p := gopacket.NewPacket(data, layers.LayerTypeIPv4, gopacket.Default)
+
+	inner, index := Decapsulate(p, gopacket.Default)
+	if inner == nil {
+		t.Fatal("expected inner packet")
+	}
+
+	if p.Layers()[index].LayerType() != layers.LayerTypeVXLAN {
+		t.Fatal("expected tunnel index to point to the VXLAN layer, got", p.Layers()[index].LayerType())
+	}
+
+	if inner.LinkLayer() == nil || inner.NetworkLayer().NetworkFlow().Src().String() != "172.16.0.1" {
+		t.Fatal("unexpected inner packet", inner)
+	}
+
+	tunnel := GetTunnel(inner)
+	if tunnel == nil || tunnel.Type != tunnelVXLAN || tunnel.VNI != 42 || tunnel.OuterSrcIP != "10.0.0.1" {
+		t.Fatalf("unexpected tunnel: %+v", tunnel)
+	}
+
+	if TunnelID(inner) != 42<<32 {
+		t.Fatal("unexpected tunnel id", TunnelID(inner))
+	}
+
+	// the UDP layer of the outer packet carries the tunnel
+	if TransportLayerTunneled(p, index) {
+		t.Fatal("expected transport layer of the outer packet to belong to the tunnel")
+	}
+}
+
+func TestDecapsulatePlainPacket(t *testing.T) {
+	data := serializeLayers(t,
+		&layers.IPv4{
+			Version:  4,
+			IHL:      5,
+			TTL:      64,
+			Protocol: layers.IPProtocolUDP,
+			SrcIP:    net.IP{10, 0, 0, 1},
+			DstIP:    net.IP{10, 0, 0, 2},
+		},
+		&layers.UDP{SrcPort: 1234, DstPort: 53},
+	)
+
+	p := gopacket.NewPacket(data, layers.LayerTypeIPv4, gopacket.Default)
+
+	if inner, _ := Decapsulate(p, gopacket.Default); inner != nil {
+		t.Fatal("expected no inner packet for plain UDP")
+	}
+}
+
+func TestDecapsulateERSPANIII(t *testing.T) {
+	frame := serializeLayers(t,
+		&layers.Ethernet{
+			SrcMAC:       net.HardwareAddr{0, 1, 2, 3, 4, 5},
+			DstMAC:       net.HardwareAddr{0, 1, 2, 3, 4, 6},
+			EthernetType: layers.EthernetTypeIPv4,
+		},
+		&layers.IPv4{
+			Version:  4,
+			IHL:      5,
+			TTL:      64,
+			Protocol: layers.IPProtocolUDP,
+			SrcIP:    net.IP{172, 16, 0, 1},
+			DstIP:    net.IP{172, 16, 0, 2},
+		},
+		&layers.UDP{SrcPort: 1234, DstPort: 53},
+	)
+
+	for _, tc := range []struct {
+		name   string
+		header []byte
+		inner  bool
+	}{
+		{"ethernet", []byte{0x20, 0, 0x00, 0x2a, 0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{"subheader", append([]byte{0x20, 0, 0x00, 0x2a, 0, 0, 0, 0, 0, 0, 0, 1}, make([]byte, 8)...), true},
+		{"frame type", []byte{0x20, 0, 0x00, 0x2a, 0, 0, 0, 0, 0, 0, 0x04, 0}, false},
+		{"version", []byte{0x10, 0, 0x00, 0x2a, 0, 0, 0, 0, 0, 0, 0, 0}, false},
+	} {
+		data := serializeLayers(t,
+			&layers.IPv4{
+				Version:  4,
+				IHL:      5,
+				TTL:      64,
+				Protocol: layers.IPProtocolGRE,
+				SrcIP:    net.IP{10, 0, 0, 1},
+				DstIP:    net.IP{10, 0, 0, 2},
+			},
+			&layers.GRE{Protocol: ethernetTypeERSPANIII},
+			gopacket.Payload(append(tc.header, frame...)),
+		)
+
+		inner, _ := Decapsulate(gopacket.NewPacket(data, layers.LayerTypeIPv4, gopacket.Default), gopacket.Default)
+		if !tc.inner {
+			if inner != nil {
+				t.Error(tc.name, "expected no inner packet")
+			}
+
+			continue
+		}
+
+		if inner == nil || inner.LinkLayer() == nil || inner.NetworkLayer().NetworkFlow().Src().String() != "172.16.0.1" {
+			t.Fatal(tc.name, "unexpected inner packet", inner)
+		}
+
+		if tunnel := GetTunnel(inner); tunnel.Type != tunnelERSPAN || tunnel.SessionID != 42 {
+			t.Fatalf("%s: unexpected tunnel: %+v", tc.name, tunnel)
+		}
+	}
+}
+
+func TestOuterPacket(t *testing.T) {
+	data := serializeLayers(t,
+		&layers.IPv4{
+			Version:  4,
+			IHL:      5,
+			TTL:      64,
+			Protocol: layers.IPProtocolIPv4,
+			SrcIP:    net.IP{192, 168, 1, 1},
+			DstIP:    net.IP{192, 168, 1, 2},
+		},
+		&layers.IPv4{
+			Version:  4,
+			IHL:      5,
+			TTL:      64,
+			Protocol: layers.IPProtocolUDP,
+			SrcIP:    net.IP{10, 0, 0, 1},
+			DstIP:    net.IP{10, 0, 0, 2},
+		},
+		&layers.UDP{SrcPort: 1234, DstPort: 53},
+		gopacket.Payload("data"),
+	)
+
+	var (
+		p            = gopacket.NewPacket(data, layers.LayerTypeIPv4, gopacket.Default)
+		conns        = newShardedConnMap(1)
+		inner, index = Decapsulate(p, gopacket.Default)
+	)
+
+	if inner == nil {
+		t.Fatal("expected inner packet")
+	}
+
+	outer := OuterPacket(p, index)
+	if outer.TransportLayer() != nil || outer.ApplicationLayer() != nil {
+		t.Fatal("expected no transport and application layer for the outer packet of an IP in IP tunnel")
+	}
+
+	handlePacket(conns, outer, &config.Config{})
+	handlePacket(conns, inner, &config.Config{})
+
+	if n := conns.Size(); n != 2 {
+		t.Fatal("expected separate connections for the tunnel and the tunneled flow, got", n)
+	}
+
+	for _, conn := range conns.shards[0].Items {
+		switch conn.SrcIP {
+		case "192.168.1.1":
+			if conn.Tunnel != nil || conn.TransportProto != "" || conn.AppPayloadSize != 0 {
+				t.Fatalf("unexpected connection for the outer flow: %+v", conn.Connection)
+			}
+		case "10.0.0.1":
+			if conn.Tunnel == nil || conn.Tunnel.Type != tunnelIPinIP || conn.DstPort != "53" {
+				t.Fatalf("unexpected connection for the inner flow: %+v", conn.Connection)
+			}
+		default:
+			t.Fatal("unexpected connection", conn.SrcIP)
+		}
+	}
+}
+
+func TestIPProfileKeyTunnel(t *testing.T) {
+	if ipProfileKey("10.0.0.1", nil) != "10.0.0.1" {
+		t.Fatal("expected the address as key without tunnel")
+	}
+
+	if ipProfileKey("10.0.0.1", &types.Tunnel{VNI: 1}) == ipProfileKey("10.0.0.1", &types.Tunnel{VNI: 2}) {
+		t.Fatal("expected different keys for the same address in different tunnels")
+	}
+}
+
+func TestTunnelIDIPinIP(t *testing.T) {
+	var (
+		inner = []gopacket.SerializableLayer{
+			&layers.IPv4{
+				Version:  4,
+				IHL:      5,
+				TTL:      64,
+				Protocol: layers.IPProtocolUDP,
+				SrcIP:    net.IP{10, 0, 0, 1},
+				DstIP:    net.IP{10, 0, 0, 2},
+			},
+			&layers.UDP{SrcPort: 1234, DstPort: 53},
+			gopacket.Payload("data"),
+		}
+		tunneled = func(src, dst net.IP) gopacket.Packet {
+			data := serializeLayers(t, append([]gopacket.SerializableLayer{
+				&layers.IPv4{
+					Version:  4,
+					IHL:      5,
+					TTL:      64,
+					Protocol: layers.IPProtocolIPv4,
+					SrcIP:    src,
+					DstIP:    dst,
+				},
+			}, inner...)...)
+
+			p, _ := Decapsulate(gopacket.NewPacket(data, layers.LayerTypeIPv4, gopacket.Default), gopacket.Default)
+			if p == nil {
+				t.Fatal("expected inner packet")
+			}
+
+			return p
+		}
+		a       = tunneled(net.IP{192, 168, 1, 1}, net.IP{192, 168, 1, 2})
+		reverse = tunneled(net.IP{192, 168, 1, 2}, net.IP{192, 168, 1, 1})
+		b       = tunneled(net.IP{192, 168, 2, 1}, net.IP{192, 168, 2, 2})
+		plain   = gopacket.NewPacket(serializeLayers(t, inner...), layers.LayerTypeIPv4, gopacket.Default)
+		conns   = newShardedConnMap(1)
+	)
+
+	if TunnelID(a) == 0 || TunnelID(a) == TunnelID(b) {
+		t.Fatal("expected different non zero ids for tunnels without key", TunnelID(a), TunnelID(b))
+	}
+
+	if TunnelID(a) != TunnelID(reverse) {
+		t.Fatal("expected the same id for both directions of a tunnel")
+	}
+
+	for _, p := range []gopacket.Packet{a, b, plain} {
+		handlePacket(conns, p, &config.Config{})
+	}
+
+	if n := conns.Size(); n != 3 {
+		t.Fatal("expected separate connections for the same flow in different tunnels and without tunnel, got", n)
+	}
+}
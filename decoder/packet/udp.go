@@ -25,14 +25,14 @@ var udpDecoder = newGoPacketDecoder(
 	types.Type_NC_UDP,
 	layers.LayerTypeUDP,
 	"User Datagram Protocol (UDP) is a connectionless communications protocol, that facilitates the exchange of messages between computing devices in a network",
-	func(layer gopacket.Layer, timestamp int64) proto.Message {
+	func(d *GoPacketDecoder, layer gopacket.Layer, timestamp int64) proto.Message {
 		if udp, ok := layer.(*layers.UDP); ok {
 			var payload []byte
-			if conf.IncludePayloads {
+			if d.conf.IncludePayloads {
 				payload = layer.LayerPayload()
 			}
 			var e float64
-			if conf.CalculateEntropy {
+			if d.conf.CalculateEntropy {
 				e = entropy(udp.Payload)
 			}
 
@@ -25,10 +25,10 @@ var usbDecoder = newGoPacketDecoder(
 	types.Type_NC_USB,
 	layers.LayerTypeUSB,
 	"Universal Serial Bus (USB) is an industry standard that establishes specifications for cables and connectors and protocols for connection, communication and power supply (interfacing) between computers, peripherals and other computers",
-	func(layer gopacket.Layer, timestamp int64) proto.Message {
+	func(d *GoPacketDecoder, layer gopacket.Layer, timestamp int64) proto.Message {
 		if usb, ok := layer.(*layers.USB); ok {
 			var payload []byte
-			if conf.IncludePayloads {
+			if d.conf.IncludePayloads {
 				payload = layer.LayerPayload()
 			}
 
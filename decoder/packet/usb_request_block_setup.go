@@ -25,7 +25,7 @@ var usbRequestBlockSetupDecoder = newGoPacketDecoder(
 	types.Type_NC_USBRequestBlockSetup,
 	layers.LayerTypeUSBRequestBlockSetup,
 	"Universal Serial Bus (USB) is an industry standard that establishes specifications for cables and connectors and protocols for connection, communication and power supply (interfacing) between computers, peripherals and other computers",
-	func(layer gopacket.Layer, timestamp int64) proto.Message {
+	func(d *GoPacketDecoder, layer gopacket.Layer, timestamp int64) proto.Message {
 		if usbR, ok := layer.(*layers.USBRequestBlockSetup); ok {
 			return &types.USBRequestBlockSetup{
 				Timestamp:   timestamp,
@@ -18,7 +18,6 @@ import (
 	"crypto/md5"
 	"encoding/hex"
 	"fmt"
-	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
 	"github.com/pkg/errors"
 	"math"
 	"os"
@@ -28,6 +27,9 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/dreadl0ck/gopacket"
+	"github.com/dreadl0ck/gopacket/layers"
+
 	"github.com/dreadl0ck/netcap/decoder/core"
 	"github.com/dreadl0ck/netcap/decoder/stream"
 	"github.com/dreadl0ck/netcap/defaults"
@@ -35,6 +37,7 @@ import (
 	"github.com/dreadl0ck/netcap"
 	netio "github.com/dreadl0ck/netcap/io"
 	"github.com/dreadl0ck/netcap/types"
+	"github.com/dreadl0ck/netcap/utils"
 	"github.com/evilsocket/islazy/tui"
 )
 
@@ -163,13 +166,6 @@ func ApplyActionToPacketDecoders(action func(DecoderAPI)) {
 
 // ApplyActionToPacketDecodersAsync can be used to run custom code for all packet decoders asynchronously.
 func ApplyActionToPacketDecodersAsync(action func(DecoderAPI)) {
-
-	// when debugging, enforce sequential processing so the logs are in order
-	if decoderconfig.Instance.Debug {
-		ApplyActionToPacketDecoders(action)
-		return
-	}
-
 	wg := sync.WaitGroup{}
 	for _, d := range defaultPacketDecoders {
 		wg.Add(1)
@@ -190,13 +186,6 @@ func ApplyActionToGoPacketDecoders(action func(*GoPacketDecoder)) {
 
 // ApplyActionToGoPacketDecodersAsync can be used to run custom code for all gopacket decoders asynchronously.
 func ApplyActionToGoPacketDecodersAsync(action func(*GoPacketDecoder)) {
-
-	// when debugging, enforce sequential processing so the logs are in order
-	if decoderconfig.Instance.Debug {
-		ApplyActionToGoPacketDecoders(action)
-		return
-	}
-
 	wg := sync.WaitGroup{}
 	for _, d := range defaultGoPacketDecoders {
 		wg.Add(1)
@@ -306,6 +295,29 @@ func entropy(data []byte) (entropy float64) {
 	return entropy
 }
 
+// communityID returns the Community ID flow hash for the packet,
+// an empty string is returned for packets without a TCP, UDP or SCTP layer.
+func communityID(seed int, p gopacket.Packet) string {
+	nl, tl := p.NetworkLayer(), p.TransportLayer()
+	if nl == nil || tl == nil {
+		return ""
+	}
+
+	var proto uint8
+	switch tl.LayerType() {
+	case layers.LayerTypeTCP:
+		proto = utils.ProtocolTCP
+	case layers.LayerTypeUDP:
+		proto = utils.ProtocolUDP
+	case layers.LayerTypeSCTP:
+		proto = utils.ProtocolSCTP
+	default:
+		return ""
+	}
+
+	return utils.CommunityIDFromLayerFlows(uint16(seed), nl.NetworkFlow(), tl.TransportFlow(), proto)
+}
+
 const dot = byte('.')
 
 func parseHexIPv4(ip []byte) string {
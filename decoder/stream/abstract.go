@@ -8,20 +8,10 @@ import (
 	"sync"
 	"time"
 
-	"github.com/dreadl0ck/netcap/decoder/stream/alert"
-	"github.com/dreadl0ck/netcap/decoder/stream/credentials"
-	"github.com/dreadl0ck/netcap/decoder/stream/exploit"
-	"github.com/dreadl0ck/netcap/decoder/stream/file"
-	"github.com/dreadl0ck/netcap/decoder/stream/mail"
-	"github.com/dreadl0ck/netcap/decoder/stream/service"
-	"github.com/dreadl0ck/netcap/decoder/stream/software"
-	"github.com/dreadl0ck/netcap/decoder/stream/vulnerability"
-
 	"github.com/mgutz/ansi"
 	"github.com/pkg/errors"
 
 	"github.com/dreadl0ck/netcap"
-	"github.com/dreadl0ck/netcap/decoder/config"
 	"github.com/dreadl0ck/netcap/decoder/core"
 
 	//"github.com/dreadl0ck/netcap/decoder/stream/credentials"
@@ -34,16 +24,7 @@ var errInvalidAbstractDecoder = errors.New("invalid abstract decoder")
 
 // DefaultAbstractDecoders contains decoders for custom abstractions
 // that do not represent a specific network protocol.
-var DefaultAbstractDecoders = []core.DecoderAPI{
-	file.Decoder,
-	service.Decoder,
-	exploit.Decoder,
-	mail.Decoder,
-	software.Decoder,
-	vulnerability.Decoder,
-	credentials.Decoder,
-	alert.Decoder,
-} // contains all available abstract decoders
+var DefaultAbstractDecoders = defaultDecoders.abstractDecoders()
 
 // package level init.
 func init() {
@@ -62,13 +43,6 @@ func ApplyActionToAbstractDecoders(action func(api core.DecoderAPI)) {
 
 // ApplyActionToAbstractDecodersAsync can be used to run custom code for all gopacket decoders asynchronously.
 func ApplyActionToAbstractDecodersAsync(action func(api core.DecoderAPI)) {
-
-	// when debugging, enforce sequential processing so the logs are in order
-	if Debug {
-		ApplyActionToAbstractDecoders(action)
-		return
-	}
-
 	wg := sync.WaitGroup{}
 	for _, d := range DefaultAbstractDecoders {
 		wg.Add(1)
@@ -80,9 +54,14 @@ func ApplyActionToAbstractDecodersAsync(action func(api core.DecoderAPI)) {
 	wg.Wait()
 }
 
-// InitAbstractDecoders initializes all stream decoders.
-func InitAbstractDecoders(c *config.Config) (decoders []core.DecoderAPI, err error) {
+// InitAbstractDecoders initializes the abstract decoders selected in the configuration.
+func (sd *Decoders) InitAbstractDecoders() (decoders []core.DecoderAPI, err error) {
 	var (
+		c = sd.conf
+
+		// abstract decoders of the instance
+		loaded = sd.abstractDecoders()
+
 		// values from command-line flags
 		in = strings.Split(c.IncludeDecoders, ",")
 		ex = strings.Split(c.ExcludeDecoders, ",")
@@ -108,14 +87,14 @@ func InitAbstractDecoders(c *config.Config) (decoders []core.DecoderAPI, err err
 		}
 
 		// iterate over packet decoders and collect those that are named in the includeMap
-		for _, dec := range DefaultAbstractDecoders {
+		for _, dec := range loaded {
 			if _, ok := inMap[dec.GetName()]; ok {
 				selection = append(selection, dec)
 			}
 		}
 
 		// update packet decoders to new selection
-		DefaultAbstractDecoders = selection
+		loaded = selection
 	}
 
 	// iterate over excluded decoders
@@ -126,10 +105,10 @@ func InitAbstractDecoders(c *config.Config) (decoders []core.DecoderAPI, err err
 			}
 
 			// remove named decoder from defaultPacketDecoders
-			for i, dec := range DefaultAbstractDecoders {
+			for i, dec := range loaded {
 				if name == dec.GetName() {
 					// remove decoder
-					DefaultAbstractDecoders = append(DefaultAbstractDecoders[:i], DefaultAbstractDecoders[i+1:]...)
+					loaded = append(loaded[:i:i], loaded[i+1:]...)
 
 					break
 				}
@@ -143,7 +122,7 @@ func InitAbstractDecoders(c *config.Config) (decoders []core.DecoderAPI, err err
 	)
 
 	// initialize decoders
-	for _, d := range DefaultAbstractDecoders {
+	for _, d := range loaded {
 
 		// reset decoder stat in case it is reinitialized at runtime.
 		d.(*decoder.AbstractDecoder).NumRecordsWritten = 0
@@ -157,6 +136,7 @@ func InitAbstractDecoders(c *config.Config) (decoders []core.DecoderAPI, err err
 				Label:   c.Label,
 				Proto:   c.Proto,
 				JSON:    c.JSON,
+				Parquet: c.Parquet,
 				Name:    d.GetName(),
 				Type:    d.GetType(),
 				Null:    c.Null,
@@ -173,6 +153,7 @@ func InitAbstractDecoders(c *config.Config) (decoders []core.DecoderAPI, err err
 				Out:                  c.Out,
 				Chan:                 c.Chan,
 				ChanSize:             c.ChanSize,
+				Records:              c.Records,
 				MemBufferSize:        c.MemBufferSize,
 				Source:               c.Source,
 				Version:              netcap.Version,
@@ -180,6 +161,32 @@ func InitAbstractDecoders(c *config.Config) (decoders []core.DecoderAPI, err err
 				StartTime:            time.Now(),
 				CompressionBlockSize: c.CompressionBlockSize,
 				CompressionLevel:     c.CompressionLevel,
+				CompressionCodec:     c.CompressionCodec,
+				Outputs:              c.Outputs,
+				OutputBufferSize:     c.OutputBufferSize,
+				ParquetRowGroupSize:  c.ParquetRowGroupSize,
+				ParquetCompression:   c.ParquetCompression,
+				Zeek:                 c.Zeek,
+				ZeekJSON:             c.ZeekJSON,
+				Eve:                  c.Eve,
+				CommunityIDSeed:      c.CommunityIDSeed,
+
+				IPFIX:                  c.IPFIX,
+				IPFIXCollector:         c.IPFIXCollector,
+				IPFIXVersion:           c.IPFIXVersion,
+				IPFIXEnterpriseNumber:  c.IPFIXEnterpriseNumber,
+				IPFIXObservationDomain: c.IPFIXObservationDomain,
+				ConnTimeOut:            c.ConnTimeOut,
+
+				SQLite:          c.SQLite,
+				SQLiteBatchSize: c.SQLiteBatchSize,
+
+				RotateSize:     c.RotateSize,
+				RotateInterval: c.RotateInterval,
+				RetentionSize:  c.RetentionSize,
+
+				Index:          c.Index,
+				IndexBlockSize: c.IndexBlockSize,
 			})
 			d.SetWriter(w)
 
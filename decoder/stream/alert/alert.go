@@ -28,21 +28,33 @@ import (
 )
 
 // Decoder for protocol analysis and writing audit records to disk.
-var Decoder = &decoder.AbstractDecoder{
-	Type:        types.Type_NC_Alert,
-	Name:        "Alert",
-	Description: "An alert based on observations from network traffic",
+type Decoder struct {
+	*decoder.AbstractDecoder
+
+	conf *decoderconfig.Config
+}
+
+// NewDecoder returns a new alert decoder that writes its audit records according to the configuration.
+func NewDecoder(conf *decoderconfig.Config) *Decoder {
+	return &Decoder{
+		AbstractDecoder: &decoder.AbstractDecoder{
+			Type:        types.Type_NC_Alert,
+			Name:        "Alert",
+			Description: "An alert based on observations from network traffic",
+		},
+		conf: conf,
+	}
 }
 
-// WriteAlert writeDeviceProfile writes the profile.
-func WriteAlert(f *types.Alert) {
-	if decoderconfig.Instance.ExportMetrics {
+// WriteAlert writes the alert audit record.
+func (d *Decoder) WriteAlert(f *types.Alert) {
+	if d.conf.ExportMetrics {
 		f.Inc()
 	}
 
-	atomic.AddInt64(&Decoder.NumRecordsWritten, 1)
+	atomic.AddInt64(&d.NumRecordsWritten, 1)
 
-	err := Decoder.Writer.Write(f)
+	err := d.Writer.Write(f)
 	if err != nil {
 		log.Fatal("failed to write proto: ", err)
 	}
@@ -50,7 +62,7 @@ func WriteAlert(f *types.Alert) {
 
 const networkTypeUnixgram = "unixgram"
 
-// SocketConn contains a pointer to the used socket at runtime.
+// SocketConn contains a pointer to the used socket at runtime, the socket is shared by all collectors of the process.
 var SocketConn *net.UnixConn
 
 var errClosed = errors.New("use of closed network connection")
@@ -16,6 +16,7 @@ package credentials
 import (
 	"log"
 	"regexp"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -24,83 +25,139 @@ import (
 	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
 	logging "github.com/dreadl0ck/netcap/logger"
 	"github.com/dreadl0ck/netcap/types"
+	"github.com/dreadl0ck/netcap/utils"
 	"go.uber.org/zap"
 )
 
-var credLog = zap.NewNop()
-
 // Decoder for protocol analysis and writing audit records to disk.
-var Decoder = &decoder.AbstractDecoder{
-	Name:        DecoderName,
-	Description: "Credentials represent a user and password combination to authenticate to a service",
-	Type:        types.Type_NC_Credentials,
-	PostInit: func(d *decoder.AbstractDecoder) (err error) {
-
-		useHarvesters = true
-
-		credLog, _, err = logging.InitZapLogger(
-			decoderconfig.Instance.Out,
-			"credentials",
-			decoderconfig.Instance.Debug,
-		)
-
-		if err != nil {
-			return err
-		}
-
-		if decoderconfig.Instance.CustomRegex != "" {
-			r, errCompile := regexp.Compile(decoderconfig.Instance.CustomRegex)
-			if errCompile != nil {
-				return errCompile
+type Decoder struct {
+	*decoder.AbstractDecoder
+
+	conf *decoderconfig.Config
+	log  *zap.Logger
+
+	// useHarvesters controls whether the harvesters should be invoked or not,
+	// it is set after the decoder has been initialized.
+	useHarvesters bool
+
+	// harvesters to be ran against all seen bi-directional communication in a TCP session
+	// new harvesters must be added in NewDecoder in order to get called.
+	tcpConnectionHarvesters []credentialHarvester
+
+	// mapped port number to the harvester based on the IANA standards
+	// used for the first guess which harvester to use.
+	harvesterPortMapping map[int]credentialHarvester
+
+	// credStore is used to deduplicate the credentials written to disk
+	// it maps an identifier in the format: c.Service + c.User + c.Password
+	// to the flow ident where the data was observed.
+	credStore   map[string]string
+	credStoreMu sync.Mutex
+}
+
+// NewDecoder returns a new credentials decoder that writes its audit records according to the configuration.
+func NewDecoder(conf *decoderconfig.Config) *Decoder {
+	d := &Decoder{
+		conf:      conf,
+		log:       zap.NewNop(),
+		credStore: make(map[string]string),
+	}
+
+	d.tcpConnectionHarvesters = []credentialHarvester{
+		ftpHarvester,
+		httpHarvester,
+		d.smtpHarvester,
+		telnetHarvester,
+		d.imapHarvester,
+	}
+
+	d.harvesterPortMapping = map[int]credentialHarvester{
+		21:  ftpHarvester,
+		80:  httpHarvester,
+		587: d.smtpHarvester,
+		465: d.smtpHarvester,
+		25:  d.smtpHarvester,
+		23:  telnetHarvester,
+		143: d.imapHarvester,
+	}
+
+	d.AbstractDecoder = &decoder.AbstractDecoder{
+		Name:        DecoderName,
+		Description: "Credentials represent a user and password combination to authenticate to a service",
+		Type:        types.Type_NC_Credentials,
+		PostInit: func(*decoder.AbstractDecoder) (err error) {
+
+			d.useHarvesters = true
+
+			d.log, _, err = logging.InitZapLogger(
+				d.conf.Out,
+				"credentials",
+				d.conf.Debug,
+			)
+
+			if err != nil {
+				return err
 			}
 
-			tcpConnectionHarvesters = append(tcpConnectionHarvesters, func(data []byte, ident string, ts time.Time) *types.Credentials {
-				matches := r.FindSubmatch(data)
-				if len(matches) > 1 {
-					notes := ""
-					for _, m := range matches {
-						notes += " " + string(m) + " "
-					}
+			if d.conf.CustomRegex != "" {
+				r, errCompile := regexp.Compile(d.conf.CustomRegex)
+				if errCompile != nil {
+					return errCompile
+				}
 
-					return &types.Credentials{
-						Notes: notes,
+				d.tcpConnectionHarvesters = append(d.tcpConnectionHarvesters, func(data []byte, ident string, ts time.Time) *types.Credentials {
+					matches := r.FindSubmatch(data)
+					if len(matches) > 1 {
+						notes := ""
+						for _, m := range matches {
+							notes += " " + string(m) + " "
+						}
+
+						return &types.Credentials{
+							Notes: notes,
+						}
 					}
-				}
 
-				return nil
-			})
-		}
+					return nil
+				})
+			}
+
+			return nil
+		},
+		DeInit: func(*decoder.AbstractDecoder) error {
+			return d.log.Sync()
+		},
+	}
 
-		return nil
-	},
-	DeInit: func(sd *decoder.AbstractDecoder) error {
-		return credLog.Sync()
-	},
+	return d
 }
 
 // WriteCredentials is a util that should be used to write credential audit to disk
 // it will deduplicate the audit records to avoid repeating information on disk.
-func WriteCredentials(creds *types.Credentials) {
+func (d *Decoder) WriteCredentials(creds *types.Credentials) {
 	ident := creds.Service + creds.User + creds.Password
 
 	// prevent saving duplicate credentials
-	credStoreMu.Lock()
-	if _, ok := credStore[ident]; ok {
-		credStoreMu.Unlock()
+	d.credStoreMu.Lock()
+	if _, ok := d.credStore[ident]; ok {
+		d.credStoreMu.Unlock()
 
 		return
 	}
 
-	credStore[ident] = creds.Flow
-	credStoreMu.Unlock()
+	d.credStore[ident] = creds.Flow
+	d.credStoreMu.Unlock()
+
+	// credentials are only harvested from TCP conversations
+	creds.CommunityID = utils.CommunityIDFromFlowIdent(uint16(d.conf.CommunityIDSeed), creds.Flow, utils.ProtocolTCP)
 
-	if decoderconfig.Instance.ExportMetrics {
+	if d.conf.ExportMetrics {
 		creds.Inc()
 	}
 
-	atomic.AddInt64(&Decoder.NumRecordsWritten, 1)
+	atomic.AddInt64(&d.NumRecordsWritten, 1)
 
-	err := Decoder.Writer.Write(creds)
+	err := d.Writer.Write(creds)
 	if err != nil {
 		log.Fatal("failed to write proto: ", err)
 	}
@@ -6,16 +6,20 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dreadl0ck/netcap/decoder/config"
 	"github.com/dreadl0ck/netcap/decoder/db"
 	"github.com/dreadl0ck/netcap/logger"
 )
 
+// testDecoder provides the harvesters that log decoding errors.
+var testDecoder = NewDecoder(config.DefaultConfig)
+
 // init functions in the unit tests do not seem to be called for the compiled program,
 // even if this file is not in a *_test package scope.
 // So we abuse it here to guarantee the logfile handles are initialized for all tests.
 func init() {
 	var err error
-	credLog, _, err = logger.InitZapLogger("../../tests", "decoder", true)
+	testDecoder.log, _, err = logger.InitZapLogger("../../tests", "decoder", true)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -150,7 +154,7 @@ func TestIMAPCredentialsHarvester(t *testing.T) {
 A1 login someuser@example.atmailcloud.com My_P@ssword1
 A1 OK [CAPABILITY IMAP4rev1 LITERAL+ SASL-IR LOGIN-REFERRALS ID ENABLE IDLE SORT SORT=DISPLAY THREAD=REFERENCES THREAD=REFS THREAD=ORDEREDSUBJECT MULTIAPPEND URL-PARTIAL CATENATE UNSELECT CHILDREN NAMESPACE UIDPLUS LIST-EXTENDED I18NLEVEL=1 CONDSTORE QRESYNC ESEARCH ESORT SEARCHRES WITHIN CONTEXT=SEARCH LIST-STATUS BINARY MOVE NOTIFY SPECIAL-USE QUOTA] Logged in`)
 	finalData := strings.ReplaceAll(string(data), "\n", "\r\n")
-	c := imapHarvester([]byte(finalData), "test", time.Now())
+	c := testDecoder.imapHarvester([]byte(finalData), "test", time.Now())
 	if c == nil {
 		t.Fatal("no credentials found")
 	}
@@ -171,7 +175,7 @@ c29tZXVzZXJAZXhhbXBsZS5hdG1haWxjbG91ZC5jb20=
 TXlfUEBzc3dvcmQx
 a OK [CAPABILITY IMAP4rev1 LITERAL+ SASL-IR LOGIN-REFERRALS ID ENABLE IDLE SORT SORT=DISPLAY THREAD=REFERENCES THREAD=REFS THREAD=ORDEREDSUBJECT MULTIAPPEND URL-PARTIAL CATENATE UNSELECT CHILDREN NAMESPACE UIDPLUS LIST-EXTENDED I18NLEVEL=1 CONDSTORE QRESYNC ESEARCH ESORT SEARCHRES WITHIN CONTEXT=SEARCH LIST-STATUS BINARY MOVE NOTIFY SPECIAL-USE QUOTA] Logged in`)
 	finalData = strings.ReplaceAll(string(data), "\n", "\r\n")
-	c = imapHarvester([]byte(finalData), "test", time.Now())
+	c = testDecoder.imapHarvester([]byte(finalData), "test", time.Now())
 	if c == nil {
 		t.Fatal("no credentials found")
 	}
@@ -190,7 +194,7 @@ a AUTHENTICATE PLAIN
 dGlnZXJAemV1cy5wAGFkbWluAGFkbWluMTIzNA==
 a OK [CAPABILITY IMAP4rev1 LITERAL+ SASL-IR LOGIN-REFERRALS ID ENABLE IDLE SORT SORT=DISPLAY THREAD=REFERENCES THREAD=REFS THREAD=ORDEREDSUBJECT MULTIAPPEND URL-PARTIAL CATENATE UNSELECT CHILDREN NAMESPACE UIDPLUS LIST-EXTENDED I18NLEVEL=1 CONDSTORE QRESYNC ESEARCH ESORT SEARCHRES WITHIN CONTEXT=SEARCH LIST-STATUS BINARY MOVE NOTIFY SPECIAL-USE QUOTA] Logged in`)
 	finalData = strings.ReplaceAll(string(data), "\n", "\r\n")
-	c = imapHarvester([]byte(finalData), "test", time.Now())
+	c = testDecoder.imapHarvester([]byte(finalData), "test", time.Now())
 	if c == nil {
 		t.Fatal("no credentials found")
 	}
@@ -209,7 +213,7 @@ A0001 AUTHENTICATE CRAM-MD5
 dGltIGI5MTNhNjAyYzdlZGE3YTQ5NWI0ZTZlNzMzNGQzODkw
 A0001 OK CRAM authentication successful`)
 	finalData = strings.ReplaceAll(string(data), "\n", "\r\n")
-	c = imapHarvester([]byte(finalData), "test", time.Now())
+	c = testDecoder.imapHarvester([]byte(finalData), "test", time.Now())
 	if c == nil {
 		t.Fatal("no credentials found")
 	}
@@ -259,7 +263,7 @@ dGVzdAB0ZXN0ADEyMzQ=
 235 2.7.0 Authentication successful`)
 
 	finalData := strings.ReplaceAll(string(data), "\n", "\r\n")
-	c := smtpHarvester([]byte(finalData), "test1", time.Now())
+	c := testDecoder.smtpHarvester([]byte(finalData), "test1", time.Now())
 	if c == nil {
 		t.Fatal("no credentials found")
 	}
@@ -281,7 +285,7 @@ AUTH PLAIN dGVzdAB0ZXN0ADEyMzQ= *
 235 2.7.0 Authentication successful`)
 
 	finalData = strings.ReplaceAll(string(data), "\n", "\r\n")
-	c = smtpHarvester([]byte(finalData), "test2", time.Now())
+	c = testDecoder.smtpHarvester([]byte(finalData), "test2", time.Now())
 	if c == nil {
 		t.Fatal("no credentials found")
 	}
@@ -306,7 +310,7 @@ dGVzdA==
 dGVzdDEyMzQ=
 235 2.7.0 Authentication successful`)
 	finalData = strings.ReplaceAll(string(data), "\n", "\r\n")
-	c = smtpHarvester([]byte(finalData), "test3", time.Now())
+	c = testDecoder.smtpHarvester([]byte(finalData), "test3", time.Now())
 	if c == nil {
 		t.Fatal("no credentials found")
 	}
@@ -329,7 +333,7 @@ AUTH CRAM-MD5
 cmpzMyBlYzNhNTlmZWQzOTVhYmExZWM2MzY3YzRmNGI0MWFjMA==
 235 2.7.0 Authentication successful`)
 	finalData = strings.ReplaceAll(string(data), "\n", "\r\n")
-	c = smtpHarvester([]byte(finalData), "test4", time.Now())
+	c = testDecoder.smtpHarvester([]byte(finalData), "test4", time.Now())
 	if c == nil {
 		t.Fatal("no credentials found")
 	}
@@ -18,14 +18,11 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
-	"sync"
 	"time"
 
 	"github.com/dreadl0ck/netcap/types"
 
 	"github.com/dreadl0ck/gopacket"
-
-	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
 )
 
 const (
@@ -47,31 +44,6 @@ const (
 type credentialHarvester func(data []byte, ident string, ts time.Time) *types.Credentials
 
 var (
-	// useHarvesters controls whether the harvesters should be invoked or not.
-	useHarvesters = false
-
-	// harvesters to be ran against all seen bi-directional communication in a TCP session
-	// new harvesters must be added here in order to get called.
-	tcpConnectionHarvesters = []credentialHarvester{
-		ftpHarvester,
-		httpHarvester,
-		smtpHarvester,
-		telnetHarvester,
-		imapHarvester,
-	}
-
-	// mapped port number to the harvester based on the IANA standards
-	// used for the first guess which harvester to use.
-	harvesterPortMapping = map[int]credentialHarvester{
-		21:  ftpHarvester,
-		80:  httpHarvester,
-		587: smtpHarvester,
-		465: smtpHarvester,
-		25:  smtpHarvester,
-		23:  telnetHarvester,
-		143: imapHarvester,
-	}
-
 	// regular expressions for the harvesters.
 	reFTP               = regexp.MustCompile(`220(?:.*?)\r\n(?:.*)\r?\n?(?:.*)\r?\n?USER\s(.*?)\r\n331(?:.*?)\r\nPASS\s(.*?)\r\n`)
 	reHTTPBasic         = regexp.MustCompile(`(?:.*?)HTTP(?:[\s\S]*)(?:Authorization: Basic )(.*?)\r\n`)
@@ -85,12 +57,6 @@ var (
 	reIMATPlainSeparate = regexp.MustCompile(`(?:.*?)(?:LOGIN|login)\r\n(?:.*?)\sVXNlcm5hbWU6\r\n(.*?)\r\n(?:.*?)\sUGFzc3dvcmQ6\r\n(.*?)\r\n(?:.*?)`)
 	reIMAPPlainAuth     = regexp.MustCompile(`(?:.*?)(?:AUTHENTICATE PLAIN|authenticate plain)\r\n(?:.*?)\r\n(.*?)\r\n(?:.*?)`)
 	reIMAPPCramMd5      = regexp.MustCompile(`(?:.*?)AUTHENTICATE CRAM-MD5\r\n(?:.*?)\s(.*?)\r\n(.*?)\r\n(?:.*?)`)
-
-	// credStore is used to deduplicate the credentials written to disk
-	// it maps an identifier in the format: c.Service + c.User + c.Password
-	// to the flow ident where the data was observed.
-	credStore   = make(map[string]string)
-	credStoreMu sync.Mutex
 )
 
 //goland:noinspection GoUnusedFunction
@@ -99,10 +65,11 @@ func harvesterDebug(ident string, data []byte, args ...interface{}) {
 }
 
 // RunHarvesters will use the service probes to determine the service type based on the provided banner.
-func RunHarvesters(banner []byte, transport gopacket.Flow, ident string, firstPacket time.Time) {
+// The credentials found are attributed to the tunnel the conversation was decapsulated from, if any.
+func (d *Decoder) RunHarvesters(banner []byte, transport gopacket.Flow, ident string, firstPacket time.Time, tunnel *types.Tunnel) {
 	// only use harvesters when credential audit record type is loaded
 	// useHarvesters is set after the custom decoder initialization
-	if !useHarvesters {
+	if !d.useHarvesters {
 		return
 	}
 
@@ -123,12 +90,13 @@ func RunHarvesters(banner []byte, transport gopacket.Flow, ident string, firstPa
 	}
 
 	// check if its a well known port and use the harvester for that one
-	if ch, ok := harvesterPortMapping[dstPort]; ok {
+	if ch, ok := d.harvesterPortMapping[dstPort]; ok {
 		if creds := ch(banner, ident, firstPacket); creds != nil { // write audit record
-			WriteCredentials(creds)
+			creds.Tunnel = tunnel
+			d.WriteCredentials(creds)
 
 			// we found a match and will stop processing
-			if decoderconfig.Instance.StopAfterHarvesterMatch {
+			if d.conf.StopAfterHarvesterMatch {
 				found = true
 			}
 		}
@@ -137,12 +105,13 @@ func RunHarvesters(banner []byte, transport gopacket.Flow, ident string, firstPa
 		tried = &ch
 	}
 
-	if ch, ok := harvesterPortMapping[srcPort]; ok {
+	if ch, ok := d.harvesterPortMapping[srcPort]; ok {
 		if creds := ch(banner, ident, firstPacket); creds != nil { // write audit record
-			WriteCredentials(creds)
+			creds.Tunnel = tunnel
+			d.WriteCredentials(creds)
 
 			// we found a match and will stop processing
-			if decoderconfig.Instance.StopAfterHarvesterMatch {
+			if d.conf.StopAfterHarvesterMatch {
 				found = true
 			}
 		}
@@ -154,15 +123,16 @@ func RunHarvesters(banner []byte, transport gopacket.Flow, ident string, firstPa
 	// if we dont have a match yet, match against all available harvesters
 	if !found {
 		// iterate over all harvesters
-		for _, ch := range tcpConnectionHarvesters {
+		for _, ch := range d.tcpConnectionHarvesters {
 			// if the port based first guess has not been found, do not run this harvester again
 			if &ch != tried {
 				// execute harvester
 				if creds := ch(banner, ident, firstPacket); creds != nil { // write audit record
-					WriteCredentials(creds)
+					creds.Tunnel = tunnel
+					d.WriteCredentials(creds)
 
 					// stop after a match if configured
-					if decoderconfig.Instance.StopAfterHarvesterMatch {
+					if d.conf.StopAfterHarvesterMatch {
 						break
 					}
 				}
@@ -22,7 +22,7 @@ import (
 )
 
 // harvester for the IMAP protocol.
-func imapHarvester(data []byte, ident string, ts time.Time) *types.Credentials {
+func (d *Decoder) imapHarvester(data []byte, ident string, ts time.Time) *types.Credentials {
 	var (
 		username             string
 		password             string
@@ -42,14 +42,14 @@ func imapHarvester(data []byte, ident string, ts time.Time) *types.Credentials {
 	if len(matchesPlainSeparate) > 1 {
 		usernameBin, err := base64.StdEncoding.DecodeString(string(matchesPlainSeparate[1]))
 		if err != nil {
-			credLog.Warn("captured IMAP credentials, but could not decode them",
+			d.log.Warn("captured IMAP credentials, but could not decode them",
 				zap.Error(err),
 				zap.String("input", string(matchesPlainSeparate[1])),
 			)
 		}
 		passwordBin, err := base64.StdEncoding.DecodeString(string(matchesPlainSeparate[2]))
 		if err != nil {
-			credLog.Warn("captured IMAP credentials, but could not decode them",
+			d.log.Warn("captured IMAP credentials, but could not decode them",
 				zap.Error(err),
 				zap.String("input", string(matchesPlainSeparate[2])),
 			)
@@ -62,7 +62,7 @@ func imapHarvester(data []byte, ident string, ts time.Time) *types.Credentials {
 	if len(matchesLogin) > 1 {
 		extractedData, err := base64.StdEncoding.DecodeString(string(matchesLogin[1]))
 		if err != nil {
-			credLog.Warn("captured IMAP credentials, but could not decode them",
+			d.log.Warn("captured IMAP credentials, but could not decode them",
 				zap.Error(err),
 				zap.String("input", string(matchesLogin[1])),
 			)
@@ -97,7 +97,7 @@ func imapHarvester(data []byte, ident string, ts time.Time) *types.Credentials {
 	if len(matchesCramMd5) > 1 {
 		usernameBin, err := base64.StdEncoding.DecodeString(string(matchesCramMd5[1]))
 		if err != nil {
-			credLog.Warn("captured IMAP credentials, but could not decode them",
+			d.log.Warn("captured IMAP credentials, but could not decode them",
 				zap.Error(err),
 				zap.String("input", string(matchesCramMd5[1])),
 			)
@@ -105,7 +105,7 @@ func imapHarvester(data []byte, ident string, ts time.Time) *types.Credentials {
 		username = string(usernameBin) // This is really the challenge
 		passwordBin, err := base64.StdEncoding.DecodeString(string(matchesCramMd5[2]))
 		if err != nil {
-			credLog.Warn("captured IMAP credentials, but could not decode them",
+			d.log.Warn("captured IMAP credentials, but could not decode them",
 				zap.Error(err),
 				zap.String("input", string(matchesCramMd5[2])),
 			)
@@ -22,7 +22,7 @@ import (
 )
 
 // harvester for the SMTP protocol.
-func smtpHarvester(data []byte, ident string, ts time.Time) *types.Credentials {
+func (d *Decoder) smtpHarvester(data []byte, ident string, ts time.Time) *types.Credentials {
 	var (
 		username             string
 		password             string
@@ -35,19 +35,19 @@ func smtpHarvester(data []byte, ident string, ts time.Time) *types.Credentials {
 
 	switch {
 	case len(matchesPlainSeparate) > 1:
-		username, password = decodeSMTPAuthPlain(string(matchesPlainSeparate[1]))
+		username, password = d.decodeSMTPAuthPlain(string(matchesPlainSeparate[1]))
 		serv = smtpAuthPlain
 
 	case len(matchesPlainSingle) > 1:
-		username, password = decodeSMTPAuthPlain(string(matchesPlainSingle[1]))
+		username, password = d.decodeSMTPAuthPlain(string(matchesPlainSingle[1]))
 		serv = smtpAuthPlain
 
 	case len(matchesLogin) > 1:
-		username, password = decodeSMTPLogin(matchesLogin, smtpAuthLogin)
+		username, password = d.decodeSMTPLogin(matchesLogin, smtpAuthLogin)
 		serv = smtpAuthLogin
 
 	case len(matchesCramMd5) > 1:
-		username, password = decodeSMTPLogin(matchesCramMd5, smtpAuthCramMd5)
+		username, password = d.decodeSMTPLogin(matchesCramMd5, smtpAuthCramMd5)
 		serv = smtpAuthCramMd5
 	}
 
@@ -63,17 +63,17 @@ func smtpHarvester(data []byte, ident string, ts time.Time) *types.Credentials {
 	return nil
 }
 
-func decodeSMTPLogin(in [][]byte, typ string) (user, pass string) {
+func (d *Decoder) decodeSMTPLogin(in [][]byte, typ string) (user, pass string) {
 	usernameBin, err := base64.StdEncoding.DecodeString(string(in[1]))
 	if err != nil {
-		credLog.Warn("captured "+typ+" credentials, but could not decode them", zap.String("input", string(in[1])))
+		d.log.Warn("captured "+typ+" credentials, but could not decode them", zap.String("input", string(in[1])))
 
 		return
 	}
 
 	passwordBin, err := base64.StdEncoding.DecodeString(string(in[2]))
 	if err != nil {
-		credLog.Warn("captured credentials, but could not decode them",
+		d.log.Warn("captured credentials, but could not decode them",
 			zap.String("input", string(in[2])),
 			zap.String("type", typ),
 		)
@@ -82,10 +82,10 @@ func decodeSMTPLogin(in [][]byte, typ string) (user, pass string) {
 	return string(usernameBin), string(passwordBin)
 }
 
-func decodeSMTPAuthPlain(in string) (user, pass string) {
+func (d *Decoder) decodeSMTPAuthPlain(in string) (user, pass string) {
 	data, err := base64.StdEncoding.DecodeString(in)
 	if err != nil {
-		credLog.Warn("captured SMTP Auth Plain credentials, but could not decode them", zap.String("input", in))
+		d.log.Warn("captured SMTP Auth Plain credentials, but could not decode them", zap.String("input", in))
 
 		return
 	}
@@ -0,0 +1,132 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package stream
+
+import (
+	"github.com/dreadl0ck/netcap/decoder/config"
+	"github.com/dreadl0ck/netcap/decoder/core"
+	"github.com/dreadl0ck/netcap/decoder/stream/alert"
+	"github.com/dreadl0ck/netcap/decoder/stream/credentials"
+	"github.com/dreadl0ck/netcap/decoder/stream/exploit"
+	"github.com/dreadl0ck/netcap/decoder/stream/file"
+	"github.com/dreadl0ck/netcap/decoder/stream/http"
+	"github.com/dreadl0ck/netcap/decoder/stream/mail"
+	"github.com/dreadl0ck/netcap/decoder/stream/pop3"
+	"github.com/dreadl0ck/netcap/decoder/stream/service"
+	"github.com/dreadl0ck/netcap/decoder/stream/smtp"
+	"github.com/dreadl0ck/netcap/decoder/stream/software"
+	"github.com/dreadl0ck/netcap/decoder/stream/ssh"
+	streamutils "github.com/dreadl0ck/netcap/decoder/stream/utils"
+	"github.com/dreadl0ck/netcap/decoder/stream/vulnerability"
+	decoderutils "github.com/dreadl0ck/netcap/decoder/utils"
+	"github.com/dreadl0ck/netcap/dpi"
+)
+
+// Decoders contains the stream and abstract decoders of a collector.
+// The decoders keep their state and audit record writers in the instance,
+// so that multiple collectors can be used in the same process.
+type Decoders struct {
+	conf *config.Config
+
+	// application protocols identified by the classifier for the conversations that are currently decoded
+	Conversations *dpi.Conversations
+
+	// Stats about the stream reassembly and decoding
+	Stats *streamutils.Stats
+
+	// abstract decoders
+	File          *file.Decoder
+	Service       *service.Decoder
+	Exploit       *exploit.Decoder
+	Mail          *mail.Decoder
+	Software      *software.Decoder
+	Vulnerability *vulnerability.Decoder
+	Credentials   *credentials.Decoder
+	Alert         *alert.Decoder
+
+	// stream decoders
+	HTTP *http.Decoder
+	POP3 *pop3.Decoder
+	SSH  *ssh.Decoder
+	SMTP *smtp.Decoder
+
+	// loaded stream decoders, in the order they are tried for a conversation
+	loaded []core.StreamDecoderAPI
+
+	// port mappings of the loaded stream decoders, in the order of the configuration
+	portMappings []portMapping
+
+	// stream decoders forced for a server IP:port
+	forcedDecoders map[string]core.StreamDecoderAPI
+
+	// match counters for the loaded stream decoders
+	matchCounters map[core.StreamDecoderAPI]*matchCounter
+
+	// number of conversations no stream decoder could be found for
+	numUnmatched int64
+}
+
+// NewDecoders creates the stream and abstract decoders for the configuration,
+// errors while writing audit records are counted in the errorMap.
+// The decoders must be initialized with InitStreamDecoders and InitAbstractDecoders before usage.
+func NewDecoders(conf *config.Config, errorMap *decoderutils.AtomicCounterMap) *Decoders {
+	d := &Decoders{
+		conf:           conf,
+		Conversations:  dpi.NewConversations(),
+		Stats:          new(streamutils.Stats),
+		forcedDecoders: make(map[string]core.StreamDecoderAPI),
+		matchCounters:  make(map[core.StreamDecoderAPI]*matchCounter),
+	}
+
+	d.Vulnerability = vulnerability.NewDecoder(conf)
+	d.Exploit = exploit.NewDecoder(conf)
+	d.Software = software.NewDecoder(conf, d.Vulnerability, d.Exploit, d.Conversations)
+	d.Service = service.NewDecoder(conf, d.Software)
+	d.Credentials = credentials.NewDecoder(conf)
+	d.File = file.NewDecoder(conf)
+	d.Mail = mail.NewDecoder(conf, d.File, d.Software)
+	d.Alert = alert.NewDecoder(conf)
+
+	d.HTTP = http.NewDecoder(conf, errorMap, d.Stats, d.Credentials, d.Software, d.File)
+	d.POP3 = pop3.NewDecoder(conf, errorMap, d.Mail, d.Credentials)
+	d.SSH = ssh.NewDecoder(conf, d.Stats, d.Software)
+	d.SMTP = smtp.NewDecoder(conf, errorMap, d.Stats, d.Mail)
+
+	return d
+}
+
+// streamDecoders returns all stream decoders,
+// if no decoder is mapped to the port of a conversation they are tried in this order.
+func (d *Decoders) streamDecoders() []core.StreamDecoderAPI {
+	return []core.StreamDecoderAPI{
+		d.HTTP.StreamDecoder,
+		d.POP3.StreamDecoder,
+		d.SSH.StreamDecoder,
+		d.SMTP.StreamDecoder,
+	}
+}
+
+// abstractDecoders returns all decoders for custom abstractions.
+func (d *Decoders) abstractDecoders() []core.DecoderAPI {
+	return []core.DecoderAPI{
+		d.File.AbstractDecoder,
+		d.Service.AbstractDecoder,
+		d.Exploit.AbstractDecoder,
+		d.Mail.AbstractDecoder,
+		d.Software.AbstractDecoder,
+		d.Vulnerability.AbstractDecoder,
+		d.Credentials.AbstractDecoder,
+		d.Alert.AbstractDecoder,
+	}
+}
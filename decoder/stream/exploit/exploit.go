@@ -16,6 +16,7 @@ package exploit
 import (
 	"path/filepath"
 
+	"github.com/blevesearch/bleve"
 	"go.uber.org/zap"
 
 	"github.com/dreadl0ck/netcap/decoder"
@@ -26,41 +27,65 @@ import (
 	"github.com/dreadl0ck/netcap/types"
 )
 
-var exploitLog = zap.NewNop()
-
 // Decoder for protocol analysis and writing audit records to disk.
-var Decoder = &decoder.AbstractDecoder{
-	Type:        types.Type_NC_Exploit,
-	Name:        "Exploit",
-	Description: "An exploit proof of concept code snippet for a vulnerable application",
-	PostInit: func(e *decoder.AbstractDecoder) error {
-		// Load vulnerabilities DB index
-		var (
-			err       error
-			indexName = filepath.Join(resolvers.DataBaseFolderPath, "exploit-db.bleve")
-		)
+type Decoder struct {
+	*decoder.AbstractDecoder
+
+	conf *decoderconfig.Config
+	log  *zap.Logger
+
+	// exploit database
+	index bleve.Index
+
+	// deduplicates the written exploits
+	store exploitStore
+}
+
+// NewDecoder returns a new exploit decoder that writes its audit records according to the configuration.
+func NewDecoder(conf *decoderconfig.Config) *Decoder {
+	d := &Decoder{
+		conf: conf,
+		log:  zap.NewNop(),
+		store: exploitStore{
+			items: make(map[string]struct{}),
+		},
+	}
+
+	d.AbstractDecoder = &decoder.AbstractDecoder{
+		Type:        types.Type_NC_Exploit,
+		Name:        "Exploit",
+		Description: "An exploit proof of concept code snippet for a vulnerable application",
+		PostInit: func(*decoder.AbstractDecoder) error {
+			// Load exploits DB index
+			var (
+				err       error
+				indexName = filepath.Join(resolvers.DataBaseFolderPath, "exploit-db.bleve")
+			)
+
+			d.log, _, err = logging.InitZapLogger(
+				d.conf.Out,
+				"exploit",
+				d.conf.Debug,
+			)
+			if err != nil {
+				return err
+			}
 
-		exploitLog, _, err = logging.InitZapLogger(
-			decoderconfig.Instance.Out,
-			"exploit",
-			decoderconfig.Instance.Debug,
-		)
-		if err != nil {
-			return err
-		}
+			d.index, err = db.AcquireBleve(indexName)
+			if err != nil {
+				// explicitly set to nil, otherwise it can't be determined whether the init succeeded later on
+				d.index = nil
+				return err
+			}
 
-		db.ExploitsIndex, err = db.OpenBleve(indexName)
-		if err != nil {
-			// explicitly set to nil, otherwise it can't be determined whether the init succeeded later on
-			db.ExploitsIndex = nil
-			return err
-		}
+			return nil
+		},
+		DeInit: func(*decoder.AbstractDecoder) error {
+			db.ReleaseBleve(d.index)
 
-		return nil
-	},
-	DeInit: func(decoder *decoder.AbstractDecoder) error {
-		db.CloseBleve(db.ExploitsIndex)
+			return d.log.Sync()
+		},
+	}
 
-		return exploitLog.Sync()
-	},
+	return d
 }
@@ -44,6 +44,9 @@ func init() {
 
 const debugExploitTests = false
 
+// exploitsIndex is the exploit database used by the tests.
+var exploitsIndex bleve.Index
+
 var testSoftware = []*types.Software{
 	{
 		Product: "PostgreSQL",
@@ -123,7 +126,7 @@ func exploitDBLookupTest(software *types.Software) *bleve.SearchResult {
 		query     = bleve.NewQueryStringQuery(queryTerm)
 		// query = bleve.NewMatchQuery("Google Chrome")
 		search             = bleve.NewSearchRequest(query)
-		searchResults, err = db.ExploitsIndex.Search(search)
+		searchResults, err = exploitsIndex.Search(search)
 	)
 	if debugExploitTests {
 		fmt.Println("query:", queryTerm)
@@ -141,11 +144,11 @@ func TestExploitSearch(t *testing.T) {
 		indexName = filepath.Join(resolvers.DataBaseFolderPath, "exploit-db.bleve")
 		err       error
 	)
-	db.ExploitsIndex, err = db.OpenBleve(indexName)
+	exploitsIndex, err = db.OpenBleve(indexName)
 	if err != nil {
 		t.Fatal(err)
 	}
-	defer db.CloseBleve(db.ExploitsIndex)
+	defer db.CloseBleve(exploitsIndex)
 
 	// TODO: ensure only numeric values in versions
 	// +Description:"Sucuri" +Versions:Cloudproxy ?
@@ -166,7 +169,7 @@ func TestExploitSearch(t *testing.T) {
 			for _, v := range searchResults.Hits {
 
 				var color string
-				doc, _ := db.ExploitsIndex.Document(v.ID)
+				doc, _ := exploitsIndex.Document(v.ID)
 				if v.Score > vulnerability.ThresholdNVD {
 					color = ansi.Red
 				}
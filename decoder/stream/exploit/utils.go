@@ -21,7 +21,6 @@ import (
 
 	"github.com/blevesearch/bleve"
 	"github.com/blevesearch/bleve/document"
-	"github.com/dreadl0ck/netcap/decoder/db"
 	"github.com/dreadl0ck/netcap/types"
 	"go.uber.org/zap"
 )
@@ -33,15 +32,11 @@ type exploitStore struct {
 	items map[string]struct{}
 }
 
-var expStore = exploitStore{
-	items: make(map[string]struct{}),
-}
-
 // ExploitsLookup searches for known exploits in the indexed bleve database
 // TODO:
 // - Make the threshold configurable on the commandline
 // - add caching layer to avoid repeating matching operations.
-func ExploitsLookup(software *types.Software) {
+func (d *Decoder) ExploitsLookup(software *types.Software) {
 	if software == nil {
 		return
 	}
@@ -50,7 +45,7 @@ func ExploitsLookup(software *types.Software) {
 		return
 	}
 
-	if db.ExploitsIndex == nil {
+	if d.index == nil {
 		return
 	}
 
@@ -58,13 +53,13 @@ func ExploitsLookup(software *types.Software) {
 		queryTerm          = buildExploitQuery(software.Vendor, software.Product, software.Version)
 		query              = bleve.NewQueryStringQuery(queryTerm)
 		search             = bleve.NewSearchRequest(query)
-		searchResults, err = db.ExploitsIndex.Search(search)
+		searchResults, err = d.index.Search(search)
 	)
 
-	exploitLog.Info("query exploit database", zap.String("query", queryTerm))
+	d.log.Info("query exploit database", zap.String("query", queryTerm))
 
 	if err != nil {
-		exploitLog.Info("failed to search for vulnerable software", zap.Error(err))
+		d.log.Info("failed to search for vulnerable software", zap.Error(err))
 
 		return
 	}
@@ -73,8 +68,8 @@ func ExploitsLookup(software *types.Software) {
 
 	for _, v := range searchResults.Hits {
 		if v.Score > thresholdExploits {
-			doc, _ := db.ExploitsIndex.Document(v.ID)
-			writeExploit(software, doc)
+			doc, _ := d.index.Document(v.ID)
+			d.writeExploit(software, doc)
 		}
 	}
 }
@@ -104,25 +99,25 @@ func buildExploitQuery(vendor, software, version string) string {
 	return b.String()
 }
 
-func writeExploit(software *types.Software, doc *document.Document) {
+func (d *Decoder) writeExploit(software *types.Software, doc *document.Document) {
 	// use exploitDB numeric ID as index
 	id := string(doc.Fields[0].Value())
 
-	expStore.Lock()
-	if _, ok := expStore.items[id]; ok {
-		expStore.Unlock()
+	d.store.Lock()
+	if _, ok := d.store.items[id]; ok {
+		d.store.Unlock()
 		// exists, exit.
 		return
 	} else {
-		expStore.items[id] = struct{}{}
+		d.store.items[id] = struct{}{}
 	}
-	expStore.Unlock()
+	d.store.Unlock()
 
 	// spew.Dump("exploit", doc.Fields)
 
-	atomic.AddInt64(&Decoder.NumRecordsWritten, 1)
+	atomic.AddInt64(&d.NumRecordsWritten, 1)
 
-	err := Decoder.Writer.Write(&types.Exploit{
+	err := d.Writer.Write(&types.Exploit{
 		Timestamp:   software.Timestamp,
 		ID:          id,
 		Description: strings.Trim(string(doc.Fields[2].Value()), "\""),
@@ -135,6 +130,6 @@ func writeExploit(software *types.Software, doc *document.Document) {
 		Software:    software,
 	})
 	if err != nil {
-		exploitLog.Error("failed to flush exploit audit record", zap.Error(err))
+		d.log.Error("failed to flush exploit audit record", zap.Error(err))
 	}
 }
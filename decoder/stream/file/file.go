@@ -20,24 +20,40 @@ import (
 	"github.com/dreadl0ck/netcap/decoder"
 	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
 	"github.com/dreadl0ck/netcap/types"
+	"github.com/dreadl0ck/netcap/utils"
 )
 
 // Decoder for protocol analysis and writing audit records to disk.
-var Decoder = &decoder.AbstractDecoder{
-	Type:        types.Type_NC_File,
-	Name:        "File",
-	Description: "A file that was transferred over the network",
+type Decoder struct {
+	*decoder.AbstractDecoder
+
+	conf *decoderconfig.Config
 }
 
-// WriteFile writeDeviceProfile writes the profile.
-func WriteFile(f *types.File) {
-	if decoderconfig.Instance.ExportMetrics {
+// NewDecoder returns a new file decoder that writes its audit records according to the configuration.
+func NewDecoder(conf *decoderconfig.Config) *Decoder {
+	return &Decoder{
+		AbstractDecoder: &decoder.AbstractDecoder{
+			Type:        types.Type_NC_File,
+			Name:        "File",
+			Description: "A file that was transferred over the network",
+		},
+		conf: conf,
+	}
+}
+
+// WriteFile writes the file audit record.
+func (d *Decoder) WriteFile(f *types.File) {
+	// files are only extracted from TCP conversations
+	f.CommunityID = utils.CommunityIDFromFlowIdent(uint16(d.conf.CommunityIDSeed), f.Ident, utils.ProtocolTCP)
+
+	if d.conf.ExportMetrics {
 		f.Inc()
 	}
 
-	atomic.AddInt64(&Decoder.NumRecordsWritten, 1)
+	atomic.AddInt64(&d.NumRecordsWritten, 1)
 
-	err := Decoder.Writer.Write(f)
+	err := d.Writer.Write(f)
 	if err != nil {
 		log.Fatal("failed to write proto: ", err)
 	}
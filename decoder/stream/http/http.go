@@ -21,34 +21,71 @@ import (
 
 	"github.com/dreadl0ck/netcap/decoder"
 	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
+	"github.com/dreadl0ck/netcap/decoder/stream/credentials"
+	"github.com/dreadl0ck/netcap/decoder/stream/file"
+	"github.com/dreadl0ck/netcap/decoder/stream/software"
+	streamutils "github.com/dreadl0ck/netcap/decoder/stream/utils"
+	decoderutils "github.com/dreadl0ck/netcap/decoder/utils"
 	logging "github.com/dreadl0ck/netcap/logger"
 	"github.com/dreadl0ck/netcap/types"
 )
 
-var httpLog = zap.NewNop()
-
 // Decoder for protocol analysis and writing audit records to disk.
-var Decoder = &decoder.StreamDecoder{
-	Type:        types.Type_NC_HTTP,
-	Name:        "HTTP",
-	Description: "The Hypertext Transfer Protocol is powering the world wide web",
-	PostInit: func(sd *decoder.StreamDecoder) error {
-		var err error
-		httpLog, _, err = logging.InitZapLogger(
-			decoderconfig.Instance.Out,
-			"http",
-			decoderconfig.Instance.Debug,
-		)
-		return err
-	},
-	CanDecode: func(client, server []byte) bool {
-		return containsHTTPProtocolName(server) && containsHTTPMethod(client)
-	},
-	DeInit: func(sd *decoder.StreamDecoder) error {
-		return httpLog.Sync()
-	},
-	Factory: &httpReader{},
-	Typ:     core.All,
+type Decoder struct {
+	*decoder.StreamDecoder
+
+	conf *decoderconfig.Config
+	log  *zap.Logger
+
+	// tracks errors while writing audit records
+	errorMap *decoderutils.AtomicCounterMap
+
+	// statistics of the collector
+	stats *streamutils.Stats
+
+	// decoders for the data found in requests and responses
+	credentials *credentials.Decoder
+	software    *software.Decoder
+	files       *file.Decoder
+}
+
+// NewDecoder returns a new HTTP decoder that writes its audit records according to the configuration,
+// and passes the credentials, software and files found in the conversations to the respective decoders.
+func NewDecoder(conf *decoderconfig.Config, errorMap *decoderutils.AtomicCounterMap, stats *streamutils.Stats, credentials *credentials.Decoder, software *software.Decoder, files *file.Decoder) *Decoder {
+	d := &Decoder{
+		conf:        conf,
+		log:         zap.NewNop(),
+		errorMap:    errorMap,
+		stats:       stats,
+		credentials: credentials,
+		software:    software,
+		files:       files,
+	}
+
+	d.StreamDecoder = &decoder.StreamDecoder{
+		Type:        types.Type_NC_HTTP,
+		Name:        "HTTP",
+		Description: "The Hypertext Transfer Protocol is powering the world wide web",
+		PostInit: func(*decoder.StreamDecoder) error {
+			var err error
+			d.log, _, err = logging.InitZapLogger(
+				d.conf.Out,
+				"http",
+				d.conf.Debug,
+			)
+			return err
+		},
+		CanDecode: func(client, server []byte) bool {
+			return containsHTTPProtocolName(server) && containsHTTPMethod(client)
+		},
+		DeInit: func(*decoder.StreamDecoder) error {
+			return d.log.Sync()
+		},
+		Factory: &httpReader{decoder: d},
+		Typ:     core.All,
+	}
+
+	return d
 }
 
 const keyUnknownParam = "unknown"
@@ -23,16 +23,15 @@ import (
 	"path"
 	"strings"
 	"sync/atomic"
+	"time"
 
 	"go.uber.org/zap"
 
-	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
 	"github.com/dreadl0ck/netcap/decoder/core"
-	"github.com/dreadl0ck/netcap/decoder/stream/credentials"
 	"github.com/dreadl0ck/netcap/decoder/stream/software"
 	streamutils "github.com/dreadl0ck/netcap/decoder/stream/utils"
-	decoderutils "github.com/dreadl0ck/netcap/decoder/utils"
 	"github.com/dreadl0ck/netcap/types"
+	"github.com/dreadl0ck/netcap/utils"
 )
 
 const (
@@ -69,15 +68,20 @@ type httpResponse struct {
 }
 
 type httpReader struct {
+	decoder      *Decoder
 	conversation *core.ConversationInfo
 
 	requests  []*httpRequest
 	responses []*httpResponse
+
+	// only set when decoding incrementally
+	incremental *streamutils.IncrementalDecoder
 }
 
 // New constructs a new http stream decoder.
 func (h *httpReader) New(conversation *core.ConversationInfo) core.StreamDecoderInterface {
 	return &httpReader{
+		decoder:      h.decoder,
 		conversation: conversation,
 	}
 }
@@ -85,37 +89,97 @@ func (h *httpReader) New(conversation *core.ConversationInfo) core.StreamDecoder
 // Decode parses the stream according to the HTTP protocol.
 func (h *httpReader) Decode() {
 	// prevent nil pointer access if decoder is not initialized
-	if Decoder.Writer == nil {
+	if h.decoder.Writer == nil {
 		return
 	}
 
-	streamutils.DecodeConversation(
-		h.conversation.Ident,
-		h.conversation.Data,
-		func(b *bufio.Reader) error {
-			return h.readRequest(b)
-		},
-		func(b *bufio.Reader) error {
-			return h.readResponse(b)
-		},
-	)
+	if h.incremental != nil {
+		h.incremental.Finish()
+	} else {
+		streamutils.DecodeConversation(
+			h.conversation.Ident,
+			h.conversation.Data,
+			func(b *bufio.Reader) error {
+				return h.readRequest(b)
+			},
+			func(b *bufio.Reader) error {
+				return h.readResponse(b)
+			},
+		)
+	}
+
+	h.writeResponses()
+
+	// iterate over unanswered requests
+	for _, req := range h.requests {
+		if req != nil {
+			ht := &types.HTTP{}
+			setRequest(ht, req)
+
+			if h.decoder.credentials.Writer != nil {
+				h.searchForLoginParams(req.request)
+				h.searchForBasicAuth(req.request)
+			}
+
+			atomic.AddInt64(&h.decoder.stats.NumRequests, 1)
+			atomic.AddInt64(&h.decoder.stats.NumUnansweredRequests, 1)
+
+			h.decoder.writeHTTP(ht, h.conversation)
+		} else {
+			atomic.AddInt64(&h.decoder.stats.NumNilRequests, 1)
+		}
+	}
+}
+
+// Consume parses the data fragment and writes a record for each response that has been received completely.
+// Requests that have not been answered are kept until the next response, or until the connection is closed.
+func (h *httpReader) Consume(data *core.StreamData) {
+	// prevent nil pointer access if decoder is not initialized
+	if h.decoder.Writer == nil {
+		return
+	}
+
+	if h.incremental == nil {
+		h.incremental = streamutils.NewIncrementalDecoder(
+			h.conversation.Ident,
+			h.decoder.conf.IncrementalStreamBufferSize,
+			h.decoder.stats,
+			h.readRequest,
+			h.readResponse,
+		)
+	}
+
+	h.incremental.Consume(data.Dir, data.RawData, data.AssemblerContext.GetCaptureInfo().Timestamp)
+	h.writeResponses()
+}
 
+// writeResponses writes a record for all parsed responses and removes them, along with their requests.
+// When decoding incrementally, responses are kept until the matching request has been parsed,
+// since the data of both directions is not necessarily delivered in order.
+func (h *httpReader) writeResponses() {
 	// iterate over responses
-	for _, res := range h.responses { // populate types.HTTP with all infos from response
+	for len(h.responses) > 0 { // populate types.HTTP with all infos from response
+		if len(h.requests) == 0 && h.incremental != nil && !h.incremental.Final() {
+			return
+		}
+
+		res := h.responses[0]
+		h.responses = h.responses[1:]
+
 		ht := newHTTPFromResponse(res.response)
 
 		_ = h.findRequest(res.response)
 
-		atomic.AddInt64(&streamutils.Stats.NumResponses, 1)
+		atomic.AddInt64(&h.decoder.stats.NumResponses, 1)
 
 		// now add request information
 		if res.response.Request != nil {
-			if credentials.Decoder.Writer != nil {
+			if h.decoder.credentials.Writer != nil {
 				h.searchForLoginParams(res.response.Request)
 				h.searchForBasicAuth(res.response.Request)
 			}
 
-			atomic.AddInt64(&streamutils.Stats.NumRequests, 1)
+			atomic.AddInt64(&h.decoder.stats.NumRequests, 1)
 			setRequest(ht, &httpRequest{
 				request:   res.response.Request,
 				timestamp: res.timestamp,
@@ -125,45 +189,52 @@ func (h *httpReader) Decode() {
 		} else {
 			// response without matching request
 			// don't add to output for now
-			atomic.AddInt64(&streamutils.Stats.NumUnmatchedResp, 1)
+			atomic.AddInt64(&h.decoder.stats.NumUnmatchedResp, 1)
 
 			continue
 		}
 
-		writeHTTP(ht, h.conversation.Ident)
+		h.decoder.writeHTTP(ht, h.conversation)
 	}
+}
 
-	// iterate over unanswered requests
-	for _, req := range h.requests {
-		if req != nil {
-			ht := &types.HTTP{}
-			setRequest(ht, req)
-
-			if credentials.Decoder.Writer != nil {
-				h.searchForLoginParams(req.request)
-				h.searchForBasicAuth(req.request)
-			}
+// timestamp returns the time of the message that is currently parsed when decoding incrementally,
+// and the time of the first packet of the stream otherwise.
+func (h *httpReader) timestamp(firstPacket time.Time) int64 {
+	if h.incremental != nil {
+		if ts := h.incremental.Timestamp(); !ts.IsZero() {
+			return ts.UnixNano()
+		}
+	}
 
-			atomic.AddInt64(&streamutils.Stats.NumRequests, 1)
-			atomic.AddInt64(&streamutils.Stats.NumUnansweredRequests, 1)
+	return firstPacket.UnixNano()
+}
 
-			writeHTTP(ht, h.conversation.Ident)
-		} else {
-			atomic.AddInt64(&streamutils.Stats.NumNilRequests, 1)
-		}
+// need passes the length of the message body to the incremental decoder,
+// to avoid parsing the message again before the body has been received completely.
+func (h *httpReader) need(contentLength int64) {
+	if h.incremental != nil && contentLength > 0 {
+		h.incremental.Need(int(contentLength))
 	}
 }
 
+// incomplete returns true if the message is not complete yet,
+// and more data might arrive on the connection.
+func (h *httpReader) incomplete(err error) bool {
+	return h.incremental != nil && !h.incremental.Final() && errors.Is(err, io.ErrUnexpectedEOF)
+}
+
 // search request header field for HTTP basic auth.
 func (h *httpReader) searchForBasicAuth(req *http.Request) {
 	if u, p, ok := req.BasicAuth(); ok {
 		if u != "" || p != "" {
-			credentials.WriteCredentials(&types.Credentials{
+			h.decoder.credentials.WriteCredentials(&types.Credentials{
 				Timestamp: h.conversation.FirstClientPacket.UnixNano(),
 				Service:   "HTTP Basic Auth",
 				Flow:      h.conversation.Ident,
 				User:      u,
 				Password:  p,
+				Tunnel:    h.conversation.Tunnel,
 			})
 		}
 	}
@@ -191,46 +262,52 @@ func (h *httpReader) searchForLoginParams(req *http.Request) {
 			pass = strings.Join(arr, "; ")
 		}
 
-		credentials.WriteCredentials(&types.Credentials{
+		h.decoder.credentials.WriteCredentials(&types.Credentials{
 			Timestamp: h.conversation.FirstClientPacket.UnixNano(),
 			Service:   "HTTP",
 			Flow:      h.conversation.Ident,
 			User:      strings.Join(values, "; "),
 			Password:  pass,
 			Notes:     "Login Parameters",
+			Tunnel:    h.conversation.Tunnel,
 		})
 	}
 }
 
-func writeHTTP(h *types.HTTP, ident string) {
+func (d *Decoder) writeHTTP(h *types.HTTP, conv *core.ConversationInfo) {
+	ident := conv.Ident
+
+	h.Tunnel = conv.Tunnel
+	h.CommunityID = utils.CommunityIDFromFlowIdent(uint16(d.conf.CommunityIDSeed), ident, utils.ProtocolTCP)
+
 	// TODO: this kills performance, make configurable
 	// updateHTTPStore(h)
 
-	if decoderconfig.Instance.IncludePayloads {
+	if d.conf.IncludePayloads {
 		// TODO: only include request body, not the entire stream contents...
 		// h.RequestBody = t.client.DataSlice().bytes()
 		// h.ResponseBody = t.server.DataSlice().bytes()
 	}
 
 	// export metrics if configured
-	if decoderconfig.Instance.ExportMetrics {
+	if d.conf.ExportMetrics {
 		h.Inc()
 	}
 
 	// write record to disk
-	atomic.AddInt64(&Decoder.NumRecordsWritten, 1)
-	err := Decoder.Writer.Write(h)
+	atomic.AddInt64(&d.NumRecordsWritten, 1)
+	err := d.Writer.Write(h)
 	if err != nil {
-		decoderutils.ErrorMap.Inc(err.Error())
+		d.errorMap.Inc(err.Error())
 	}
 
-	soft := software.WhatSoftwareHTTP(ident, h)
+	soft := d.software.WhatSoftwareHTTP(ident, h)
 
 	if len(soft) == 0 {
 		return
 	}
 
-	software.WriteSoftware(soft, func(s *software.AtomicSoftware) {
+	d.software.WriteSoftware(soft, func(s *software.AtomicSoftware) {
 		s.Lock()
 		for _, f := range s.Flows {
 			// prevent duplicates
@@ -253,7 +330,7 @@ func (h *httpReader) readResponse(b *bufio.Reader) error {
 	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
 		return err
 	} else if err != nil {
-		httpLog.Debug(
+		h.decoder.log.Debug(
 			"failed to read HTTP response",
 			zap.String("ident", h.conversation.Ident),
 			zap.Error(err),
@@ -261,10 +338,20 @@ func (h *httpReader) readResponse(b *bufio.Reader) error {
 		return err
 	}
 
+	// a response without content length and chunked encoding is delimited by closing the connection
+	if h.incremental != nil && !h.incremental.Final() && res.ContentLength < 0 && !chunked(res.TransferEncoding) {
+		return streamutils.ErrIncomplete
+	}
+
+	h.need(res.ContentLength)
+
 	body, err := ioutil.ReadAll(res.Body)
 	s := len(body)
+	if h.incomplete(err) {
+		return streamutils.ErrIncomplete
+	}
 	if err != nil {
-		httpLog.Debug(
+		h.decoder.log.Debug(
 			"failed to read HTTP response body",
 			zap.String("ident", h.conversation.Ident),
 			zap.Error(err),
@@ -292,7 +379,7 @@ func (h *httpReader) readResponse(b *bufio.Reader) error {
 	}
 
 	encoding := res.Header[headerContentEncoding]
-	httpLog.Debug("HTTP response",
+	h.decoder.log.Debug("HTTP response",
 		zap.String("ident", h.conversation.Ident),
 		zap.String("Status", res.Status),
 		zap.Int64("ContentLength", res.ContentLength),
@@ -303,19 +390,19 @@ func (h *httpReader) readResponse(b *bufio.Reader) error {
 	)
 
 	// increment counter
-	streamutils.Stats.Lock()
-	streamutils.Stats.Responses++
-	streamutils.Stats.Unlock()
+	h.decoder.stats.Lock()
+	h.decoder.stats.Responses++
+	h.decoder.stats.Unlock()
 
 	h.responses = append(h.responses, &httpResponse{
 		response:  res,
-		timestamp: h.conversation.FirstServerPacket.UnixNano(),
+		timestamp: h.timestamp(h.conversation.FirstServerPacket),
 		clientIP:  h.conversation.ClientIP,
 		serverIP:  h.conversation.ServerIP,
 	})
 
 	// write responses to disk if configured
-	if (err == nil || decoderconfig.Instance.WriteIncomplete) && decoderconfig.Instance.FileStorage != "" {
+	if (err == nil || h.decoder.conf.WriteIncomplete) && h.decoder.conf.FileStorage != "" {
 
 		var (
 			name         = "unknown"
@@ -339,7 +426,7 @@ func (h *httpReader) readResponse(b *bufio.Reader) error {
 		}
 
 		// save file to disk
-		return streamutils.SaveFile(h.conversation, source, name, err, body, encoding, host, ctype)
+		return streamutils.SaveFile(h.decoder.conf, h.decoder.files, h.conversation, source, name, err, body, encoding, host, ctype)
 	}
 
 	return nil
@@ -361,7 +448,7 @@ func (h *httpReader) findRequest(res *http.Response) string {
 	// set request instance on response
 	if req != nil {
 		res.Request = req
-		atomic.AddInt64(&streamutils.Stats.NumFoundRequests, 1)
+		atomic.AddInt64(&h.decoder.stats.NumFoundRequests, 1)
 	}
 
 	return reqURL
@@ -374,7 +461,7 @@ func (h *httpReader) readRequest(b *bufio.Reader) error {
 	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
 		return err
 	} else if err != nil {
-		httpLog.Error(
+		h.decoder.log.Error(
 			"failed to read HTTP request",
 			zap.String("ident", h.conversation.Ident),
 			zap.Error(err),
@@ -382,10 +469,15 @@ func (h *httpReader) readRequest(b *bufio.Reader) error {
 		return err
 	}
 
+	h.need(req.ContentLength)
+
 	body, err := ioutil.ReadAll(req.Body)
 	s := len(body)
+	if h.incomplete(err) {
+		return streamutils.ErrIncomplete
+	}
 	if err != nil {
-		httpLog.Error(
+		h.decoder.log.Error(
 			"failed to read HTTP request body",
 			zap.String("ident", h.conversation.Ident),
 			zap.Error(err),
@@ -402,14 +494,14 @@ func (h *httpReader) readRequest(b *bufio.Reader) error {
 	//	logReassemblyInfo("Body(%d/0x%x)\n%s\n", len(body), len(body), hex.Dump(body))
 	//}
 
-	httpLog.Debug("HTTP request",
+	h.decoder.log.Debug("HTTP request",
 		zap.String("ident", h.conversation.Ident),
 		zap.String("method", req.Method),
 		zap.String("url", req.URL.String()),
 		zap.Int("bodyLength", s),
 	)
 
-	t := h.conversation.FirstClientPacket.UnixNano()
+	t := h.timestamp(h.conversation.FirstClientPacket)
 
 	request := &httpRequest{
 		request:   req,
@@ -421,7 +513,7 @@ func (h *httpReader) readRequest(b *bufio.Reader) error {
 	// parse form values
 	err = req.ParseForm()
 	if err != nil {
-		httpLog.Error(
+		h.decoder.log.Error(
 			"failed to read HTTP form values",
 			zap.String("ident", h.conversation.Ident),
 			zap.Error(err),
@@ -429,16 +521,18 @@ func (h *httpReader) readRequest(b *bufio.Reader) error {
 	}
 
 	// increase counter
-	streamutils.Stats.Lock()
-	streamutils.Stats.Requests++
-	streamutils.Stats.Unlock()
+	h.decoder.stats.Lock()
+	h.decoder.stats.Requests++
+	h.decoder.stats.Unlock()
 
 	h.requests = append(h.requests, request)
 
 	if req.Method == methodPOST {
 		// write request payload to disk if configured
-		if (err == nil || decoderconfig.Instance.WriteIncomplete) && decoderconfig.Instance.FileStorage != "" {
+		if (err == nil || h.decoder.conf.WriteIncomplete) && h.decoder.conf.FileStorage != "" {
 			return streamutils.SaveFile(
+				h.decoder.conf,
+				h.decoder.files,
 				h.conversation,
 				"HTTP POST REQUEST to "+req.URL.Path,
 				path.Base(req.URL.Path),
@@ -453,3 +547,8 @@ func (h *httpReader) readRequest(b *bufio.Reader) error {
 
 	return nil
 }
+
+// chunked returns true if the chunked transfer encoding is used.
+func chunked(te []string) bool {
+	return len(te) > 0 && te[0] == "chunked"
+}
@@ -21,40 +21,64 @@ import (
 
 	"github.com/dreadl0ck/netcap/decoder"
 	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
+	"github.com/dreadl0ck/netcap/decoder/stream/file"
+	"github.com/dreadl0ck/netcap/decoder/stream/software"
 	logging "github.com/dreadl0ck/netcap/logger"
 	"github.com/dreadl0ck/netcap/types"
 )
 
-var mailLog = zap.NewNop()
-
 // Decoder for protocol analysis and writing audit records to disk.
-var Decoder = &decoder.AbstractDecoder{
-	Type:        types.Type_NC_Mail,
-	Name:        "Mail",
-	Description: "Email messages collected from the network traffic",
-	PostInit: func(d *decoder.AbstractDecoder) error {
-		var err error
-		mailLog, _, err = logging.InitZapLogger(
-			decoderconfig.Instance.Out,
-			"mail",
-			decoderconfig.Instance.Debug,
-		)
-		return err
-	},
-	DeInit: func(sd *decoder.AbstractDecoder) error {
-		return mailLog.Sync()
-	},
+type Decoder struct {
+	*decoder.AbstractDecoder
+
+	conf *decoderconfig.Config
+	log  *zap.Logger
+
+	// receive the attachments and the software identified from the mail headers
+	files    *file.Decoder
+	software *software.Decoder
+}
+
+// NewDecoder returns a new mail decoder that writes its audit records according to the configuration,
+// attachments and software products found in the mails are written with the given decoders.
+func NewDecoder(conf *decoderconfig.Config, files *file.Decoder, software *software.Decoder) *Decoder {
+	d := &Decoder{
+		conf:     conf,
+		log:      zap.NewNop(),
+		files:    files,
+		software: software,
+	}
+
+	d.AbstractDecoder = &decoder.AbstractDecoder{
+		Type:        types.Type_NC_Mail,
+		Name:        "Mail",
+		Description: "Email messages collected from the network traffic",
+		PostInit: func(*decoder.AbstractDecoder) error {
+			var err error
+			d.log, _, err = logging.InitZapLogger(
+				d.conf.Out,
+				"mail",
+				d.conf.Debug,
+			)
+			return err
+		},
+		DeInit: func(*decoder.AbstractDecoder) error {
+			return d.log.Sync()
+		},
+	}
+
+	return d
 }
 
 // WriteMail writes an email audit record to disk.
-func WriteMail(d *types.Mail) {
-	if decoderconfig.Instance.ExportMetrics {
-		d.Inc()
+func (d *Decoder) WriteMail(m *types.Mail) {
+	if d.conf.ExportMetrics {
+		m.Inc()
 	}
 
-	atomic.AddInt64(&Decoder.NumRecordsWritten, 1)
+	atomic.AddInt64(&d.NumRecordsWritten, 1)
 
-	err := Decoder.Writer.Write(d)
+	err := d.Writer.Write(m)
 	if err != nil {
 		log.Fatal("failed to write proto: ", err)
 	}
@@ -29,7 +29,6 @@ import (
 	"github.com/mgutz/ansi"
 	"go.uber.org/zap"
 
-	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
 	"github.com/dreadl0ck/netcap/decoder/core"
 	"github.com/dreadl0ck/netcap/decoder/stream/software"
 	streamutils "github.com/dreadl0ck/netcap/decoder/stream/utils"
@@ -98,7 +97,7 @@ func newMailID() string {
 }
 
 // Parse attempts to read a mail from the conversation.
-func Parse(conv *core.ConversationInfo, buf []byte, from, to string, logger *zap.SugaredLogger, origin string) *types.Mail {
+func (d *Decoder) Parse(conv *core.ConversationInfo, buf []byte, from, to string, logger *zap.SugaredLogger, origin string) *types.Mail {
 	logger.Info(ansi.Yellow, "parseMail, from:", from, "to:", to, conv.Ident, "\n", string(buf), ansi.Reset)
 
 	var (
@@ -108,7 +107,7 @@ func Parse(conv *core.ConversationInfo, buf []byte, from, to string, logger *zap
 
 	ts, err := dateparse.ParseAny(hdr["Delivery-Date"])
 	if err != nil {
-		mailLog.Error("failed to parse delivery date string from mail header", zap.Error(err))
+		d.log.Error("failed to parse delivery date string from mail header", zap.Error(err))
 	} else {
 		ti = ts.UnixNano()
 	}
@@ -138,16 +137,17 @@ func Parse(conv *core.ConversationInfo, buf []byte, from, to string, logger *zap
 		Body:            parseMailParts(conv, body, logger),
 		ID:              newMailID(),
 		Origin:          origin,
+		Tunnel:          conv.Tunnel,
 	}
 
 	for _, p := range mail.Body {
 		if strings.Contains(p.Header["Content-Disposition"], "attachment") {
 			mail.HasAttachments = true
 
-			if decoderconfig.Instance.FileStorage != "" {
-				err = streamutils.SaveFile(conv, origin, p.Filename, nil, []byte(p.Content), []string{p.Header["Content-Transfer-Encoding"]}, conv.ServerIP+":"+strconv.Itoa(int(conv.ServerPort)), "")
+			if d.conf.FileStorage != "" {
+				err = streamutils.SaveFile(d.conf, d.files, conv, origin, p.Filename, nil, []byte(p.Content), []string{p.Header["Content-Transfer-Encoding"]}, conv.ServerIP+":"+strconv.Itoa(int(conv.ServerPort)), "")
 				if err != nil {
-					mailLog.Error("failed to save attachment", zap.Error(err), zap.String("origin", origin))
+					d.log.Error("failed to save attachment", zap.Error(err), zap.String("origin", origin))
 				}
 			}
 
@@ -164,13 +164,13 @@ func Parse(conv *core.ConversationInfo, buf []byte, from, to string, logger *zap
 		if !ok {
 			userInfo = software.ParseUserAgent(ua)
 			software.UserAgentCache[ua] = userInfo
-			mailLog.Debug("UserAgent:", zap.String("userInfo", userInfo.Full))
+			d.log.Debug("UserAgent:", zap.String("userInfo", userInfo.Full))
 		}
 
 		software.UserAgentParserMutex.Unlock()
 
 		if userInfo.Product != "" || userInfo.Vendor != "" || userInfo.Version != "" {
-			software.WriteSoftware([]*software.AtomicSoftware{
+			d.software.WriteSoftware([]*software.AtomicSoftware{
 				{
 					Software: &types.Software{
 						Timestamp: ti,
@@ -184,6 +184,7 @@ func Parse(conv *core.ConversationInfo, buf []byte, from, to string, logger *zap
 						Flows:      []string{conv.Ident},
 						Notes:      userInfo.Full,
 						OS:         userInfo.OS,
+						Tunnel:     conv.Tunnel,
 					},
 				},
 			}, nil)
@@ -193,7 +194,7 @@ func Parse(conv *core.ConversationInfo, buf []byte, from, to string, logger *zap
 	// software detection: check X-Mailer header
 	if xm := hdr["X-Mailer"]; xm != "" {
 		if matches := software.RegexGenericVersion.FindStringSubmatch(xm); len(matches) > 0 {
-			software.WriteSoftware([]*software.AtomicSoftware{
+			d.software.WriteSoftware([]*software.AtomicSoftware{
 				{
 					Software: &types.Software{
 						Timestamp:  ti,
@@ -203,6 +204,7 @@ func Parse(conv *core.ConversationInfo, buf []byte, from, to string, logger *zap
 						SourceName: "X-Mailer",
 						Service:    origin,
 						Flows:      []string{conv.Ident},
+						Tunnel:     conv.Tunnel,
 					},
 				},
 			}, nil)
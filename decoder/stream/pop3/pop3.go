@@ -21,40 +21,73 @@ import (
 
 	"github.com/dreadl0ck/netcap/decoder"
 	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
+	"github.com/dreadl0ck/netcap/decoder/stream/credentials"
+	"github.com/dreadl0ck/netcap/decoder/stream/mail"
+	decoderutils "github.com/dreadl0ck/netcap/decoder/utils"
 	logging "github.com/dreadl0ck/netcap/logger"
 	"github.com/dreadl0ck/netcap/types"
 )
 
 // Decoder for protocol analysis and writing audit records to disk.
-var Decoder = &decoder.StreamDecoder{
-	Type:        types.Type_NC_POP3,
-	Name:        servicePOP3,
-	Description: "The POP3 protocol is used to fetch emails from a mail server",
-	PostInit: func(sd *decoder.StreamDecoder) (err error) {
-		pop3Log, _, err = logging.InitZapLogger(
-			decoderconfig.Instance.Out,
-			"pop3",
-			decoderconfig.Instance.Debug,
-		)
-		if err != nil {
-			return err
-		}
-		pop3LogSugared = pop3Log.Sugar()
-		return nil
-	},
-	CanDecode: func(client, server []byte) bool {
-		return bytes.Contains(server, pop3Ident)
-	},
-	DeInit: func(sd *decoder.StreamDecoder) error {
-		return pop3Log.Sync()
-	},
-	Factory: &pop3Reader{},
-	Typ:     core.TCP,
+type Decoder struct {
+	*decoder.StreamDecoder
+
+	conf       *decoderconfig.Config
+	log        *zap.Logger
+	logSugared *zap.SugaredLogger
+
+	// tracks errors while writing audit records
+	errorMap *decoderutils.AtomicCounterMap
+
+	// mail decoder for the fetched mails
+	mail *mail.Decoder
+
+	// credentials decoder for the login data
+	credentials *credentials.Decoder
+}
+
+// NewDecoder returns a new POP3 decoder that writes its audit records according to the configuration,
+// and passes the fetched mails and login data to the mail and credentials decoders.
+func NewDecoder(conf *decoderconfig.Config, errorMap *decoderutils.AtomicCounterMap, mail *mail.Decoder, credentials *credentials.Decoder) *Decoder {
+	d := &Decoder{
+		conf:        conf,
+		log:         zap.NewNop(),
+		logSugared:  zap.NewNop().Sugar(),
+		errorMap:    errorMap,
+		mail:        mail,
+		credentials: credentials,
+	}
+
+	d.StreamDecoder = &decoder.StreamDecoder{
+		Type:        types.Type_NC_POP3,
+		Name:        servicePOP3,
+		Description: "The POP3 protocol is used to fetch emails from a mail server",
+		PostInit: func(*decoder.StreamDecoder) (err error) {
+			d.log, _, err = logging.InitZapLogger(
+				d.conf.Out,
+				"pop3",
+				d.conf.Debug,
+			)
+			if err != nil {
+				return err
+			}
+			d.logSugared = d.log.Sugar()
+			return nil
+		},
+		CanDecode: func(client, server []byte) bool {
+			return bytes.Contains(server, pop3Ident)
+		},
+		DeInit: func(*decoder.StreamDecoder) error {
+			return d.log.Sync()
+		},
+		Factory: &pop3Reader{decoder: d},
+		Typ:     core.TCP,
+	}
+
+	return d
 }
 
 var (
-	pop3Ident      = []byte("POP server ready")
-	servicePOP3    = "POP3"
-	pop3Log        *zap.Logger
-	pop3LogSugared *zap.SugaredLogger
+	pop3Ident   = []byte("POP server ready")
+	servicePOP3 = "POP3"
 )
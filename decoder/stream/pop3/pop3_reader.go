@@ -24,12 +24,8 @@ import (
 	"github.com/mgutz/ansi"
 	"go.uber.org/zap"
 
-	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
 	"github.com/dreadl0ck/netcap/decoder/core"
-	"github.com/dreadl0ck/netcap/decoder/stream/credentials"
-	"github.com/dreadl0ck/netcap/decoder/stream/mail"
 	streamutils "github.com/dreadl0ck/netcap/decoder/stream/utils"
-	"github.com/dreadl0ck/netcap/decoder/utils"
 	"github.com/dreadl0ck/netcap/types"
 )
 
@@ -74,6 +70,7 @@ const (
 )
 
 type pop3Reader struct {
+	decoder      *Decoder
 	conversation *core.ConversationInfo
 
 	pop3Requests  []*types.POP3Request
@@ -96,6 +93,7 @@ func validPop3ServerCommand(cmd string) bool {
 // New will instantiate a new POP3 reader.
 func (h *pop3Reader) New(conv *core.ConversationInfo) core.StreamDecoderInterface {
 	return &pop3Reader{
+		decoder:      h.decoder,
 		conversation: conv,
 	}
 }
@@ -103,7 +101,7 @@ func (h *pop3Reader) New(conv *core.ConversationInfo) core.StreamDecoderInterfac
 // Decode parses the stream according to the POP3 protocol.
 func (h *pop3Reader) Decode() {
 	// prevent nil pointer access if decoder is not initialized
-	if Decoder.Writer == nil {
+	if h.decoder.Writer == nil {
 		return
 	}
 
@@ -135,34 +133,36 @@ func (h *pop3Reader) Decode() {
 		Pass:      pass,
 		MailIDs:   mails,
 		Commands:  commands,
+		Tunnel:    h.conversation.Tunnel,
 	}
 
 	if user != "" || pass != "" {
-		credentials.WriteCredentials(&types.Credentials{
+		h.decoder.credentials.WriteCredentials(&types.Credentials{
 			Timestamp: h.conversation.FirstClientPacket.UnixNano(),
 			Service:   servicePOP3,
 			Flow:      h.conversation.Ident,
 			User:      user,
 			Password:  pass,
+			Tunnel:    h.conversation.Tunnel,
 		})
 	}
 
 	// export metrics if configured
-	if decoderconfig.Instance.ExportMetrics {
+	if h.decoder.conf.ExportMetrics {
 		pop3Msg.Inc()
 	}
 
 	// write record to disk
-	atomic.AddInt64(&Decoder.NumRecordsWritten, 1)
+	atomic.AddInt64(&h.decoder.NumRecordsWritten, 1)
 
-	err := Decoder.Writer.Write(pop3Msg)
+	err := h.decoder.Writer.Write(pop3Msg)
 	if err != nil {
-		utils.ErrorMap.Inc(err.Error())
+		h.decoder.errorMap.Inc(err.Error())
 	}
 }
 
-func pop3Debug(args ...interface{}) {
-	pop3LogSugared.Info(args...)
+func (h *pop3Reader) pop3Debug(args ...interface{}) {
+	h.decoder.logSugared.Info(args...)
 }
 
 func (h *pop3Reader) readRequest(b *bufio.Reader) error {
@@ -173,7 +173,7 @@ func (h *pop3Reader) readRequest(b *bufio.Reader) error {
 	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
 		return err
 	} else if err != nil {
-		pop3Log.Error("error reading POP3 request",
+		h.decoder.log.Error("error reading POP3 request",
 			zap.String("ident", h.conversation.Ident),
 			zap.Error(err),
 		)
@@ -181,7 +181,7 @@ func (h *pop3Reader) readRequest(b *bufio.Reader) error {
 		return err
 	}
 
-	pop3Debug(ansi.Red, h.conversation.Ident, "readRequest", line, ansi.Reset)
+	h.pop3Debug(ansi.Red, h.conversation.Ident, "readRequest", line, ansi.Reset)
 
 	cmd, args := getCommand(line)
 
@@ -205,7 +205,7 @@ func (h *pop3Reader) readResponse(b *bufio.Reader) error {
 	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
 		return err
 	} else if err != nil {
-		pop3Log.Error("error reading POP3 response",
+		h.decoder.log.Error("error reading POP3 response",
 			zap.String("ident", h.conversation.Ident),
 			zap.Error(err),
 		)
@@ -213,7 +213,7 @@ func (h *pop3Reader) readResponse(b *bufio.Reader) error {
 		return err
 	}
 
-	pop3Debug(ansi.Blue, h.conversation.Ident, "readResponse", line, ansi.Reset)
+	h.pop3Debug(ansi.Blue, h.conversation.Ident, "readResponse", line, ansi.Reset)
 
 	cmd, args := getCommand(line)
 
@@ -316,8 +316,8 @@ func (h *pop3Reader) processPOP3Conversation() (mailIDs []string, user, pass, to
 
 				for _, reply := range h.pop3Responses[h.resIndex:] {
 					if reply.Command == pop3Dot {
-						m := mail.Parse(h.conversation, []byte(mailBuf), "", "", pop3LogSugared, servicePOP3)
-						mail.WriteMail(m)
+						m := h.decoder.mail.Parse(h.conversation, []byte(mailBuf), "", "", h.decoder.logSugared, servicePOP3)
+						h.decoder.mail.WriteMail(m)
 						mailIDs = append(mailIDs, m.ID)
 						mailBuf = ""
 						numMails++
@@ -427,7 +427,7 @@ func (h *pop3Reader) processPOP3Conversation() (mailIDs []string, user, pass, to
 
 				continue
 			default:
-				pop3Debug("unhandled POP3 command: ", r.Command)
+				h.pop3Debug("unhandled POP3 command: ", r.Command)
 				h.resIndex++
 			}
 		}
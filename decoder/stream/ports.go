@@ -0,0 +1,291 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package stream
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+
+	"github.com/dreadl0ck/netcap/decoder/core"
+)
+
+// errInvalidPortMapping occurs when the port or forced decoder configuration can not be parsed.
+var errInvalidPortMapping = errors.New("invalid stream decoder port mapping")
+
+// portRange is an inclusive range of ports, a single port has low and high set to the same value.
+type portRange struct {
+	low  int32
+	high int32
+}
+
+// portMapping contains the ports a stream decoder is tried for first.
+type portMapping struct {
+	decoder core.StreamDecoderAPI
+	ranges  []portRange
+}
+
+// matchCounter counts how a stream decoder has been selected for a conversation.
+type matchCounter struct {
+	forced  int64
+	port    int64
+	content int64
+}
+
+// names of all available stream decoders
+var streamDecoderNames = make(map[string]struct{})
+
+// parsePortMappings parses the ports for each stream decoder.
+// The format is a semicolon separated list of decoder names,
+// followed by a colon and a comma separated list of ports and port ranges,
+// e.g. HTTP:80,8080-8090;SMTP:25,587,2525
+// Mappings for decoders that are not loaded are ignored.
+func parsePortMappings(spec string, decoders []core.StreamDecoderAPI) ([]portMapping, error) {
+	var mappings []portMapping
+
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.SplitN(entry, ":", 2)
+		if len(parts) != 2 {
+			return nil, errors.Wrap(errInvalidPortMapping, entry)
+		}
+
+		name := strings.TrimSpace(parts[0])
+		if _, ok := streamDecoderNames[name]; !ok {
+			return nil, errors.Wrap(errInvalidStreamDecoder, name)
+		}
+
+		ranges, err := parsePortRanges(parts[1])
+		if err != nil {
+			return nil, errors.Wrap(err, entry)
+		}
+
+		if d := findDecoder(decoders, name); d != nil {
+			mappings = append(mappings, portMapping{decoder: d, ranges: ranges})
+		}
+	}
+
+	return mappings, nil
+}
+
+// parsePortRanges parses a comma separated list of ports and port ranges, e.g. 80,8080-8090.
+func parsePortRanges(spec string) ([]portRange, error) {
+	var ranges []portRange
+
+	for _, p := range strings.Split(spec, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+
+		bounds := strings.SplitN(p, "-", 2)
+
+		low, err := parsePort(bounds[0])
+		if err != nil {
+			return nil, err
+		}
+
+		high := low
+		if len(bounds) == 2 {
+			if high, err = parsePort(bounds[1]); err != nil {
+				return nil, err
+			}
+
+			if high < low {
+				return nil, errors.Wrap(errInvalidPortMapping, p)
+			}
+		}
+
+		ranges = append(ranges, portRange{low: low, high: high})
+	}
+
+	if len(ranges) == 0 {
+		return nil, errors.Wrap(errInvalidPortMapping, "no ports")
+	}
+
+	return ranges, nil
+}
+
+// parsePort parses a single port number.
+func parsePort(s string) (int32, error) {
+	p, err := strconv.ParseUint(strings.TrimSpace(s), 10, 16)
+	if err != nil {
+		return 0, errors.Wrap(errInvalidPortMapping, s)
+	}
+
+	return int32(p), nil
+}
+
+// parseForcedDecoders parses the stream decoders that are used for a server,
+// without checking whether they can decode the conversation.
+// The format is a comma separated list of server IP:port and decoder name pairs,
+// e.g. 10.0.0.1:8443=HTTP,[2001:db8::1]:2222=SSH
+// Entries for decoders that are not loaded are ignored.
+func parseForcedDecoders(spec string, decoders []core.StreamDecoderAPI) (map[string]core.StreamDecoderAPI, error) {
+	forced := make(map[string]core.StreamDecoderAPI)
+
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 {
+			return nil, errors.Wrap(errInvalidPortMapping, entry)
+		}
+
+		host, port, err := net.SplitHostPort(strings.TrimSpace(parts[0]))
+		if err != nil {
+			return nil, errors.Wrap(errInvalidPortMapping, entry)
+		}
+
+		ip := net.ParseIP(host)
+		if ip == nil {
+			return nil, errors.Wrap(errInvalidPortMapping, entry)
+		}
+
+		p, err := parsePort(port)
+		if err != nil {
+			return nil, errors.Wrap(err, entry)
+		}
+
+		name := strings.TrimSpace(parts[1])
+		if _, ok := streamDecoderNames[name]; !ok {
+			return nil, errors.Wrap(errInvalidStreamDecoder, name)
+		}
+
+		if d := findDecoder(decoders, name); d != nil {
+			forced[serverKey(ip.String(), p)] = d
+		}
+	}
+
+	return forced, nil
+}
+
+// findDecoder returns the stream decoder with the given name, or nil if it is not part of the decoders.
+func findDecoder(decoders []core.StreamDecoderAPI, name string) core.StreamDecoderAPI {
+	for _, d := range decoders {
+		if d.GetName() == name {
+			return d
+		}
+	}
+
+	return nil
+}
+
+// serverKey returns the key for looking up forced decoders.
+func serverKey(ip string, port int32) string {
+	return net.JoinHostPort(ip, strconv.Itoa(int(port)))
+}
+
+// contains checks if the port is part of the mapping.
+func (m *portMapping) contains(port int32) bool {
+	for _, r := range m.ranges {
+		if port >= r.low && port <= r.high {
+			return true
+		}
+	}
+
+	return false
+}
+
+// supports checks if the stream decoder can be used for the transport protocol.
+func supports(sd core.StreamDecoderAPI, transport core.TransportProtocol) bool {
+	return sd.GetReaderFactory() != nil && (sd.Transport() == transport || sd.Transport() == core.All)
+}
+
+// SelectDecoder returns the stream decoder for a conversation based on the first client and server data,
+// or nil if none matched. Decoders forced for the server are used without further checks,
+// otherwise the decoders mapped to the server port are tried first,
+// before falling back to all loaded decoders for the transport protocol.
+func (d *Decoders) SelectDecoder(conv *core.ConversationInfo, transport core.TransportProtocol, client, server []byte) core.StreamDecoderAPI {
+	if sd, ok := d.forcedDecoders[serverKey(conv.ServerIP, conv.ServerPort)]; ok && supports(sd, transport) {
+		d.countMatch(sd, func(c *matchCounter) *int64 { return &c.forced })
+
+		return sd
+	}
+
+	// make a good first guess based on the destination port of the connection
+	for i := range d.portMappings {
+		m := &d.portMappings[i]
+		if m.contains(conv.ServerPort) && supports(m.decoder, transport) && m.decoder.CanDecodeStream(client, server) {
+			d.countMatch(m.decoder, func(c *matchCounter) *int64 { return &c.port })
+
+			return m.decoder
+		}
+	}
+
+	// if no stream decoder for the port was found, or the stream decoder did not match
+	// try all available decoders and use the first one that matches
+	for _, sd := range d.loaded {
+		if supports(sd, transport) && sd.CanDecodeStream(client, server) {
+			d.countMatch(sd, func(c *matchCounter) *int64 { return &c.content })
+
+			return sd
+		}
+	}
+
+	atomic.AddInt64(&d.numUnmatched, 1)
+
+	return nil
+}
+
+// countMatch increments the selected counter of the stream decoder.
+func (d *Decoders) countMatch(sd core.StreamDecoderAPI, counter func(c *matchCounter) *int64) {
+	if c, ok := d.matchCounters[sd]; ok {
+		atomic.AddInt64(counter(c), 1)
+	}
+}
+
+// MatchStat contains the number of conversations a stream decoder has been selected for.
+type MatchStat struct {
+	Name string
+
+	// selected because it was forced for the server
+	Forced int64
+
+	// selected because it was mapped to the server port
+	Port int64
+
+	// selected by trying all decoders on the content
+	Content int64
+}
+
+// MatchStats returns the match counts of the loaded stream decoders,
+// and the number of conversations no stream decoder could be found for.
+func (d *Decoders) MatchStats() (stats []MatchStat, unmatched int64) {
+	for _, sd := range d.loaded {
+		c, ok := d.matchCounters[sd]
+		if !ok {
+			continue
+		}
+
+		stats = append(stats, MatchStat{
+			Name:    sd.GetName(),
+			Forced:  atomic.LoadInt64(&c.forced),
+			Port:    atomic.LoadInt64(&c.port),
+			Content: atomic.LoadInt64(&c.content),
+		})
+	}
+
+	return stats, atomic.LoadInt64(&d.numUnmatched)
+}
@@ -0,0 +1,149 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package stream
+
+import (
+	"testing"
+
+	"github.com/dreadl0ck/netcap/decoder/config"
+	"github.com/dreadl0ck/netcap/decoder/core"
+)
+
+func TestParsePortMappings(t *testing.T) {
+	d := NewDecoders(config.DefaultConfig, nil)
+
+	mappings, err := parsePortMappings("HTTP:80,8080-8090; SMTP:25,587,2525;POP3:110", []core.StreamDecoderAPI{d.HTTP.StreamDecoder, d.SMTP.StreamDecoder})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// POP3 is not loaded and must be ignored
+	if len(mappings) != 2 {
+		t.Fatal("expected 2 mappings, got", len(mappings))
+	}
+
+	for _, c := range []struct {
+		mapping int
+		port    int32
+		match   bool
+	}{
+		{0, 80, true},
+		{0, 8079, false},
+		{0, 8080, true},
+		{0, 8085, true},
+		{0, 8090, true},
+		{0, 8091, false},
+		{1, 587, true},
+		{1, 2525, true},
+		{1, 80, false},
+	} {
+		if m := mappings[c.mapping].contains(c.port); m != c.match {
+			t.Error("unexpected match for", mappings[c.mapping].decoder.GetName(), "on port", c.port, "got", m)
+		}
+	}
+
+	for _, spec := range []string{
+		"HTTP",
+		"HTTP:",
+		"HTTP:abc",
+		"HTTP:70000",
+		"HTTP:90-80",
+		"Unknown:80",
+	} {
+		if _, err = parsePortMappings(spec, d.streamDecoders()); err == nil {
+			t.Error("expected an error for", spec)
+		}
+	}
+}
+
+func TestParseForcedDecoders(t *testing.T) {
+	d := NewDecoders(config.DefaultConfig, nil)
+
+	forced, err := parseForcedDecoders("10.0.0.1:8443=HTTP, [2001:db8:0::1]:2222=SSH", d.streamDecoders())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if forced[serverKey("10.0.0.1", 8443)] != d.HTTP.StreamDecoder {
+		t.Error("expected HTTP to be forced for 10.0.0.1:8443")
+	}
+
+	// the address is normalized
+	if forced[serverKey("2001:db8::1", 2222)] != d.SSH.StreamDecoder {
+		t.Error("expected SSH to be forced for [2001:db8::1]:2222")
+	}
+
+	for _, spec := range []string{
+		"10.0.0.1:8443",
+		"10.0.0.1=HTTP",
+		"host:80=HTTP",
+		"10.0.0.1:80=Unknown",
+	} {
+		if _, err = parseForcedDecoders(spec, d.streamDecoders()); err == nil {
+			t.Error("expected an error for", spec)
+		}
+	}
+}
+
+func TestSelectDecoder(t *testing.T) {
+	var (
+		d   = NewDecoders(config.DefaultConfig, nil)
+		err error
+	)
+
+	d.loaded = d.streamDecoders()
+
+	if d.portMappings, err = parsePortMappings("HTTP:8080-8090", d.loaded); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.forcedDecoders, err = parseForcedDecoders("10.0.0.1:9000=SSH", d.loaded); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, sd := range d.loaded {
+		d.matchCounters[sd] = new(matchCounter)
+	}
+
+	var (
+		client = []byte("GET / HTTP/1.1\r\nHost: example.com\r\n\r\n")
+		server = []byte("HTTP/1.1 200 OK\r\nContent-Length: 0\r\n\r\n")
+	)
+
+	if sd := d.SelectDecoder(&core.ConversationInfo{ServerIP: "10.0.0.2", ServerPort: 8085}, core.TCP, client, server); sd != d.HTTP.StreamDecoder {
+		t.Fatal("expected HTTP on mapped port, got", sd)
+	}
+
+	if sd := d.SelectDecoder(&core.ConversationInfo{ServerIP: "10.0.0.2", ServerPort: 1234}, core.TCP, client, server); sd != d.HTTP.StreamDecoder {
+		t.Fatal("expected HTTP by content, got", sd)
+	}
+
+	if sd := d.SelectDecoder(&core.ConversationInfo{ServerIP: "10.0.0.1", ServerPort: 9000}, core.TCP, client, server); sd != d.SSH.StreamDecoder {
+		t.Fatal("expected forced SSH, got", sd)
+	}
+
+	// the SSH decoder does not support UDP
+	if sd := d.SelectDecoder(&core.ConversationInfo{ServerIP: "10.0.0.1", ServerPort: 9000}, core.UDP, []byte("a"), []byte("b")); sd != nil {
+		t.Fatal("expected no decoder, got", sd)
+	}
+
+	c := d.matchCounters[d.HTTP.StreamDecoder]
+	if c.port != 1 || c.content != 1 || c.forced != 0 {
+		t.Error("unexpected HTTP match counts", *c)
+	}
+
+	if c = d.matchCounters[d.SSH.StreamDecoder]; c.forced != 1 {
+		t.Error("unexpected SSH match counts", *c)
+	}
+}
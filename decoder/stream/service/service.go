@@ -18,50 +18,86 @@ import (
 
 	"github.com/dreadl0ck/netcap/decoder"
 	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
+	"github.com/dreadl0ck/netcap/decoder/stream/software"
 	logging "github.com/dreadl0ck/netcap/logger"
 	"github.com/dreadl0ck/netcap/types"
+	"github.com/dreadl0ck/netcap/utils"
 	"go.uber.org/zap"
 )
 
-var (
-	serviceLog        *zap.Logger
-	serviceLogSugared *zap.SugaredLogger
-)
-
 // Decoder for protocol analysis and writing audit records to disk.
-var Decoder = &decoder.AbstractDecoder{
-	Type:        types.Type_NC_Service,
-	Name:        "Service",
-	Description: "A network service",
-	PostInit: func(d *decoder.AbstractDecoder) error {
-		var err error
-		serviceLog, _, err = logging.InitZapLogger(
-			decoderconfig.Instance.Out,
-			"service",
-			decoderconfig.Instance.Debug,
-		)
-		if err != nil {
-			return err
-		}
-
-		serviceLogSugared = serviceLog.Sugar()
-
-		return initServiceProbes()
-	},
-	DeInit: func(e *decoder.AbstractDecoder) error {
-		// flush writer
-		var err error
-		for _, item := range Store.Items {
-			item.Lock()
-			err = e.Writer.Write(item.Service)
+type Decoder struct {
+	*decoder.AbstractDecoder
+
+	conf       *decoderconfig.Config
+	log        *zap.Logger
+	logSugared *zap.SugaredLogger
+
+	// Store holds all tcp service banners.
+	Store *atomicServiceMap
+
+	// initialized nmap service probes, by category
+	probes map[string][]*serviceProbe
+
+	// receives the software identified by the service probes
+	software *software.Decoder
+}
+
+// NewDecoder returns a new service decoder that writes its audit records according to the configuration,
+// the software products identified from service banners are written with the given software decoder.
+func NewDecoder(conf *decoderconfig.Config, software *software.Decoder) *Decoder {
+	d := &Decoder{
+		conf:       conf,
+		log:        zap.NewNop(),
+		logSugared: zap.NewNop().Sugar(),
+		Store: &atomicServiceMap{
+			Items: make(map[string]*service),
+		},
+		software: software,
+	}
+
+	d.AbstractDecoder = &decoder.AbstractDecoder{
+		Type:        types.Type_NC_Service,
+		Name:        "Service",
+		Description: "A network service",
+		PostInit: func(*decoder.AbstractDecoder) error {
+			var err error
+			d.log, _, err = logging.InitZapLogger(
+				d.conf.Out,
+				"service",
+				d.conf.Debug,
+			)
 			if err != nil {
-				serviceLog.Error("failed to flush service audit record", zap.Error(err))
+				return err
+			}
+
+			d.logSugared = d.log.Sugar()
+
+			return d.initServiceProbes()
+		},
+		DeInit: func(e *decoder.AbstractDecoder) error {
+			// flush writer
+			var err error
+			for _, item := range d.Store.Items {
+				item.Lock()
+				proto := utils.ProtocolTCP
+				if item.Protocol == "UDP" {
+					proto = utils.ProtocolUDP
+				}
+				item.CommunityIDs = utils.CommunityIDsFromFlowIdents(uint16(d.conf.CommunityIDSeed), item.Flows, proto)
+
+				err = e.Writer.Write(item.Service)
+				if err != nil {
+					d.log.Error("failed to flush service audit record", zap.Error(err))
+				}
+				item.Unlock()
+
+				atomic.AddInt64(&e.NumRecordsWritten, 1)
 			}
-			item.Unlock()
 
-			atomic.AddInt64(&e.NumRecordsWritten, 1)
-		}
+			return d.log.Sync()
+		},
+	}
 
-		return serviceLog.Sync()
-	},
+	return d
 }
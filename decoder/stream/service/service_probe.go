@@ -33,16 +33,12 @@ import (
 	"github.com/umisama/go-cpe"
 	"go.uber.org/zap"
 
-	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
 	"github.com/dreadl0ck/netcap/decoder/stream/software"
 	"github.com/dreadl0ck/netcap/resolvers"
 	"github.com/dreadl0ck/netcap/types"
 )
 
 var (
-	// all initialized service probes at runtime.
-	serviceProbes map[string][]*serviceProbe
-
 	// ignored probes for RE2 engine (RE2 does not support backtracking
 	// groups in regexes with backtracking will be replaced by wildcard groups).
 	ignoredProbesRE2 = map[string]struct{}{
@@ -69,12 +65,6 @@ var (
 	}
 )
 
-func init() {
-	decoderconfig.Instance = decoderconfig.DefaultConfig
-	serviceLog = zap.NewNop()
-	serviceLogSugared = serviceLog.Sugar()
-}
-
 const (
 	debugRegexClean = false
 )
@@ -147,8 +137,8 @@ func (s *serviceProbe) String() string {
 	return b.String()
 }
 
-func writeSoftwareFromBanner(serv *service, ident, probeIdent string) {
-	software.WriteSoftware([]*software.AtomicSoftware{
+func (d *Decoder) writeSoftwareFromBanner(serv *service, ident, probeIdent string) {
+	d.software.WriteSoftware([]*software.AtomicSoftware{
 		{
 			Software: &types.Software{
 				Timestamp:  serv.Timestamp,
@@ -159,13 +149,14 @@ func writeSoftwareFromBanner(serv *service, ident, probeIdent string) {
 				Service:    serv.Name,
 				Flows:      []string{ident},
 				Notes:      "Protocol: " + serv.Protocol,
+				Tunnel:     serv.Tunnel,
 			},
 		},
 	}, nil)
 }
 
 // MatchServiceProbes will check the service banner against the probes.
-func MatchServiceProbes(serv *service, banner []byte, ident string) {
+func (d *Decoder) MatchServiceProbes(serv *service, banner []byte, ident string) {
 	var (
 		expectedCategory string
 		found            bool
@@ -181,39 +172,39 @@ func MatchServiceProbes(serv *service, banner []byte, ident string) {
 	}
 
 	if expectedCategory != "" {
-		if probes, ok := serviceProbes[expectedCategory]; ok {
-			serviceLog.Debug("matching probes", zap.String("ident", ident), zap.String("expectedCategory", expectedCategory))
-			found, matched = matchProbes(serv, probes, banner, ident)
-			serviceLogSugared.Info(ident, "found?", found, "at", matched, "of", len(probes), "expected", expectedCategory)
+		if probes, ok := d.probes[expectedCategory]; ok {
+			d.log.Debug("matching probes", zap.String("ident", ident), zap.String("expectedCategory", expectedCategory))
+			found, matched = d.matchProbes(serv, probes, banner, ident)
+			d.logSugared.Info(ident, "found?", found, "at", matched, "of", len(probes), "expected", expectedCategory)
 		}
-		if !found && decoderconfig.Instance.StopAfterServiceCategoryMiss {
+		if !found && d.conf.StopAfterServiceCategoryMiss {
 			return
 		}
 	}
 
 	// if no match was found OR stopping after a match is disabled
-	if !found || !decoderconfig.Instance.StopAfterServiceProbeMatch {
+	if !found || !d.conf.StopAfterServiceProbeMatch {
 		// match banner against ALL nmap service probes
-		for category, probes := range serviceProbes {
+		for category, probes := range d.probes {
 			// exclude the category that was already searched
 			if category == expectedCategory {
 				continue
 			}
 
-			found, matched = matchProbes(serv, probes, banner, ident)
-			if found && decoderconfig.Instance.StopAfterServiceProbeMatch {
-				serviceLogSugared.Info(ident, "FOUND at", matched, "of", len(probes), "expected", expectedCategory)
+			found, matched = d.matchProbes(serv, probes, banner, ident)
+			if found && d.conf.StopAfterServiceProbeMatch {
+				d.logSugared.Info(ident, "FOUND at", matched, "of", len(probes), "expected", expectedCategory)
 				return
 			}
 		}
 
-		serviceLog.Debug("all probes tried", zap.String("ident", ident), zap.Bool("found", found), zap.Int("matched", matched))
+		d.log.Debug("all probes tried", zap.String("ident", ident), zap.Bool("found", found), zap.Int("matched", matched))
 	}
 }
 
-func matchProbes(serv *service, probes []*serviceProbe, banner []byte, ident string) (found bool, index int) {
+func (d *Decoder) matchProbes(serv *service, probes []*serviceProbe, banner []byte, ident string) (found bool, index int) {
 	for i, probe := range probes {
-		if decoderconfig.Instance.UseRE2 {
+		if d.conf.UseRE2 {
 			if m := probe.RegEx.FindStringSubmatch(string(banner)); m != nil {
 
 				// add initial values, may contain group identifiers ($1, $2 etc)
@@ -223,15 +214,15 @@ func matchProbes(serv *service, probes []*serviceProbe, banner []byte, ident str
 				serv.OS = addInfo(serv.OS, extractGroup(&probe.OS, m))
 				serv.Version = addInfo(serv.Version, extractGroup(&probe.Version, m))
 
-				if decoderconfig.Instance.Debug { // prevent evaluating the log statement if not in debug mode
-					serviceLogSugared.Info("\n\nMATCH!", ident)
-					serviceLogSugared.Info(probe, "\n\nSERVICE:\n"+proto.MarshalTextString(serv.Service), "\nBanner:", "\n"+hex.Dump(banner))
+				if d.conf.Debug { // prevent evaluating the log statement if not in debug mode
+					d.logSugared.Info("\n\nMATCH!", ident)
+					d.logSugared.Info(probe, "\n\nSERVICE:\n"+proto.MarshalTextString(serv.Service), "\nBanner:", "\n"+hex.Dump(banner))
 				}
 
-				writeSoftwareFromBanner(serv, ident, probe.Ident)
+				d.writeSoftwareFromBanner(serv, ident, probe.Ident)
 
 				// return true if search shall be stopped after the first match
-				if decoderconfig.Instance.StopAfterServiceProbeMatch {
+				if d.conf.StopAfterServiceProbeMatch {
 					return true, i
 				}
 
@@ -248,15 +239,15 @@ func matchProbes(serv *service, probes []*serviceProbe, banner []byte, ident str
 				serv.OS = addInfo(serv.OS, extractGroupDotNet(&probe.OS, m))
 				serv.Version = addInfo(serv.Version, extractGroupDotNet(&probe.Version, m))
 
-				if decoderconfig.Instance.Debug { // prevent evaluating the log statement if not in debug mode
-					serviceLogSugared.Info("\n\nMATCH!", ident)
-					serviceLogSugared.Info(probe, "\n\nSERVICE:\n"+proto.MarshalTextString(serv.Service), "\nBanner:", "\n"+hex.Dump(banner))
+				if d.conf.Debug { // prevent evaluating the log statement if not in debug mode
+					d.logSugared.Info("\n\nMATCH!", ident)
+					d.logSugared.Info(probe, "\n\nSERVICE:\n"+proto.MarshalTextString(serv.Service), "\nBanner:", "\n"+hex.Dump(banner))
 				}
 
-				writeSoftwareFromBanner(serv, ident, probe.Ident)
+				d.writeSoftwareFromBanner(serv, ident, probe.Ident)
 
 				// return true if search shall be stopped after the first match
-				if decoderconfig.Instance.StopAfterServiceProbeMatch {
+				if d.conf.StopAfterServiceProbeMatch {
 					return true, i
 				}
 
@@ -348,29 +339,32 @@ func parseVersionInfo(r io.ByteReader) (string, error) {
 	return string(res), nil
 }
 
-var serviceProbeIdentEnums = make(map[string]int)
-
-func enumerate(in string) string {
-	if v, ok := serviceProbeIdentEnums[in]; ok {
-		serviceProbeIdentEnums[in]++
+// enumerate appends the number of occurrences of the ident to it, the occurrences are counted in enums.
+func enumerate(enums map[string]int, in string) string {
+	if v, ok := enums[in]; ok {
+		enums[in]++
 
 		return in + "-" + strconv.Itoa(v+1)
 	}
 
-	serviceProbeIdentEnums[in] = 1
+	enums[in] = 1
 
 	return in + "-1"
 }
 
-func initServiceProbes() error {
+func (d *Decoder) initServiceProbes() error {
 	// load nmap service probes
 	data, err := ioutil.ReadFile(filepath.Join(resolvers.DataBaseFolderPath, "nmap-service-probes"))
 	if err != nil {
 		return err
 	}
 
-	lines := strings.Split(string(data), "\n")
-	serviceProbes = make(map[string][]*serviceProbe, 0)
+	var (
+		lines = strings.Split(string(data), "\n")
+		enums = make(map[string]int)
+	)
+
+	d.probes = make(map[string][]*serviceProbe, 0)
 
 	for _, line := range lines {
 		if len(line) == 0 || line == "\n" || strings.HasPrefix(line, "#") {
@@ -382,15 +376,15 @@ func initServiceProbes() error {
 
 			// check if rule ident field has been excluded
 			ident := strings.Fields(line)[1]
-			if decoderconfig.Instance.UseRE2 {
+			if d.conf.UseRE2 {
 				if _, ok := ignoredProbesRE2[ident]; ok {
-					serviceLog.Debug("ignoring probe", zap.String("ident", ident))
+					d.log.Debug("ignoring probe", zap.String("ident", ident))
 
 					continue
 				}
 			} else {
 				if _, ok := ignoredProbes[ident]; ok {
-					serviceLog.Debug("ignoring probe", zap.String("ident", ident))
+					d.log.Debug("ignoring probe", zap.String("ident", ident))
 
 					continue
 				}
@@ -409,7 +403,7 @@ func initServiceProbes() error {
 
 			// enumerate the ident type (e.g: http -> http-1 for the first http banner probe)
 			// useful to see which rule matched exactly, since multiple rules for the same protocol / service are usually present
-			s.Ident = enumerate(ident)
+			s.Ident = enumerate(enums, ident)
 
 			for {
 				b, err = r.ReadByte()
@@ -486,7 +480,7 @@ func initServiceProbes() error {
 
 								i, err = cpe.NewItemFromUri(buf.String())
 								if err != nil {
-									serviceLog.Error("error while parsing cpe tag for service probe",
+									d.log.Error("error while parsing cpe tag for service probe",
 										zap.Error(err),
 										zap.String("probe", s.Ident),
 									)
@@ -579,7 +573,7 @@ func initServiceProbes() error {
 			finalReg += ")" + strings.TrimSpace(string(regex))
 			before := finalReg
 
-			if decoderconfig.Instance.UseRE2 {
+			if d.conf.UseRE2 {
 				finalReg = clean(finalReg)
 				s.RegEx, errCompile = regexp.Compile(finalReg)
 			} else {
@@ -587,30 +581,30 @@ func initServiceProbes() error {
 			}
 
 			if errCompile != nil {
-				if decoderconfig.Instance.Debug {
-					if decoderconfig.Instance.UseRE2 {
+				if d.conf.Debug {
+					if d.conf.UseRE2 {
 						if before != finalReg {
-							serviceLogSugared.Info("before != finalReg:", before)
+							d.logSugared.Info("before != finalReg:", before)
 						}
 
-						serviceLogSugared.Info("failed to compile regex:", ansi.Yellow, s.Ident, ansi.Red, errCompile, ansi.White, finalReg, ansi.Reset) // stdlib regexp only logs the broken part of the regex. this logs the full regex string for debugging
+						d.logSugared.Info("failed to compile regex:", ansi.Yellow, s.Ident, ansi.Red, errCompile, ansi.White, finalReg, ansi.Reset) // stdlib regexp only logs the broken part of the regex. this logs the full regex string for debugging
 					} else {
-						serviceLogSugared.Info("failed to compile regex:", ansi.Yellow, s.Ident, ansi.Red, errCompile, ansi.Reset)
-						serviceLogSugared.Info(ansi.White, line, ansi.Reset)
+						d.logSugared.Info("failed to compile regex:", ansi.Yellow, s.Ident, ansi.Red, errCompile, ansi.Reset)
+						d.logSugared.Info(ansi.White, line, ansi.Reset)
 					}
 				}
 			} else {
 				s.RegExRaw = finalReg
-				if arr, ok := serviceProbes[ident]; ok {
+				if arr, ok := d.probes[ident]; ok {
 					arr = append(arr, s)
 				} else {
-					serviceProbes[ident] = []*serviceProbe{s}
+					d.probes[ident] = []*serviceProbe{s}
 				}
 			}
 		}
 	}
 
-	serviceLog.Info("loaded nmap service probes", zap.Int("total", len(serviceProbes)))
+	d.log.Info("loaded nmap service probes", zap.Int("total", len(d.probes)))
 
 	return nil
 }
@@ -630,8 +624,8 @@ func colorize(in string, num int) string {
 }
 
 // dumpServiceProbes prints all loaded probes as JSON.
-func dumpServiceProbes() {
-	for _, p := range serviceProbes {
+func (d *Decoder) dumpServiceProbes() {
+	for _, p := range d.probes {
 		data, err := json.MarshalIndent(p, " ", "  ")
 		if err == nil {
 			fmt.Println(string(data))
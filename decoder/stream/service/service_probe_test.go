@@ -15,18 +15,19 @@ package service
 
 import (
 	"fmt"
-	"path/filepath"
 	"regexp"
 	"testing"
 	"time"
 
 	"github.com/mgutz/ansi"
-	"go.uber.org/zap"
 
 	"github.com/dreadl0ck/netcap/decoder/config"
 	"github.com/dreadl0ck/netcap/decoder/db"
+	"github.com/dreadl0ck/netcap/decoder/stream/exploit"
+	"github.com/dreadl0ck/netcap/decoder/stream/software"
+	"github.com/dreadl0ck/netcap/decoder/stream/vulnerability"
+	"github.com/dreadl0ck/netcap/dpi"
 	"github.com/dreadl0ck/netcap/logger"
-	"github.com/dreadl0ck/netcap/resolvers"
 )
 
 func init() {
@@ -36,8 +37,6 @@ func init() {
 	}
 
 	db.SetLogger(dbLog)
-
-	serviceLog = zap.NewNop()
 }
 
 type regexTest struct {
@@ -137,27 +136,20 @@ var serviceBanners = []bannerTest{
 	//},
 }
 
-// use one global var for the vuln index so it can be shared between tests?
 func TestClassifyBanners(t *testing.T) {
 	conf := config.DefaultConfig
 
+	vulnerabilities := vulnerability.NewDecoder(conf)
+
 	// Load vulnerabilities DB index
-	indexName := filepath.Join(resolvers.DataBaseFolderPath, "nvd.bleve")
-	var err error
-	db.VulnerabilitiesIndex, err = db.OpenBleve(indexName)
+	err := vulnerabilities.OpenIndex()
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	defer db.CloseBleve(db.VulnerabilitiesIndex)
-
-	indexName = filepath.Join(resolvers.DataBaseFolderPath, "exploit-db.bleve")
-	db.ExploitsIndex, err = db.OpenBleve(indexName)
-	if err != nil {
-		t.Fatal(err)
-	}
+	defer vulnerabilities.CloseIndex()
 
-	defer db.CloseBleve(db.ExploitsIndex)
+	d := NewDecoder(conf, software.NewDecoder(conf, vulnerabilities, exploit.NewDecoder(conf), dpi.NewConversations()))
 
 	// conf.Debug = true
 	// important: needs to be set prior to loading probes
@@ -165,7 +157,7 @@ func TestClassifyBanners(t *testing.T) {
 	conf.UseRE2 = true
 
 	// load nmap service probes
-	err = initServiceProbes()
+	err = d.initServiceProbes()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -180,12 +172,12 @@ func TestClassifyBanners(t *testing.T) {
 			}
 		} else {
 			// invoke nmap banner probes
-			b.testClassifyBanner(t)
+			b.testClassifyBanner(t, d)
 		}
 	}
 }
 
-func (b bannerTest) testClassifyBanner(t *testing.T) {
+func (b bannerTest) testClassifyBanner(t *testing.T, d *Decoder) {
 	// make dummy service
 	serv := NewService(time.Now().UnixNano(), 0, 0, "")
 	serv.IP = "127.0.0.1"
@@ -193,7 +185,7 @@ func (b bannerTest) testClassifyBanner(t *testing.T) {
 	ident := "127.0.0.1:4322->127.0.0.1:21"
 	serv.Flows = []string{ident}
 
-	MatchServiceProbes(serv, []byte(b.banner), ident)
+	d.MatchServiceProbes(serv, []byte(b.banner), ident)
 
 	if serv.Product != b.product {
 		t.Fatal("unexpected product, expected", b.product, "got:", serv.Product)
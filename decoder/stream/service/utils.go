@@ -41,11 +41,6 @@ func (a *atomicServiceMap) Size() int {
 	return len(a.Items)
 }
 
-// Store ServiceStore holds all tcp service banners.
-var Store = &atomicServiceMap{
-	Items: make(map[string]*service),
-}
-
 // addInfo is util to append information to a string using a delimiter
 // information will be deduplicated.
 func addInfo(old string, new string) string {
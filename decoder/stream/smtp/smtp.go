@@ -23,43 +23,76 @@ import (
 
 	"github.com/dreadl0ck/netcap/decoder"
 	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
+	"github.com/dreadl0ck/netcap/decoder/stream/mail"
+	streamutils "github.com/dreadl0ck/netcap/decoder/stream/utils"
+	decoderutils "github.com/dreadl0ck/netcap/decoder/utils"
 	logging "github.com/dreadl0ck/netcap/logger"
 	"github.com/dreadl0ck/netcap/types"
 )
 
 var (
-	smtpLog               *zap.Logger
-	smtpLogSugared        *zap.SugaredLogger
 	smtpServiceReadyBytes = []byte(strconv.Itoa(smtpServiceReady))
 	smtpName              = []byte("SMTP")
 )
 
 // Decoder for protocol analysis and writing audit records to disk.
-var Decoder = &decoder.StreamDecoder{
-	Type:        types.Type_NC_SMTP,
-	Name:        serviceSMTP,
-	Description: "The Simple Mail Transfer Protocol is a communication protocol for electronic mail transmission",
-	PostInit: func(d *decoder.StreamDecoder) (err error) {
-		smtpLog, _, err = logging.InitZapLogger(
-			decoderconfig.Instance.Out,
-			"mail",
-			decoderconfig.Instance.Debug,
-		)
-
-		if err != nil {
-			return err
-		}
-
-		smtpLogSugared = smtpLog.Sugar()
-
-		return nil
-	},
-	CanDecode: func(client, server []byte) bool {
-		return bytes.HasPrefix(server, smtpServiceReadyBytes) && bytes.Contains(server, smtpName)
-	},
-	DeInit: func(sd *decoder.StreamDecoder) error {
-		return smtpLog.Sync()
-	},
-	Factory: &smtpReader{},
-	Typ:     core.TCP,
+type Decoder struct {
+	*decoder.StreamDecoder
+
+	conf       *decoderconfig.Config
+	log        *zap.Logger
+	logSugared *zap.SugaredLogger
+
+	// tracks errors while writing audit records
+	errorMap *decoderutils.AtomicCounterMap
+
+	// statistics of the collector
+	stats *streamutils.Stats
+
+	// mail decoder for the transferred mails
+	mail *mail.Decoder
+}
+
+// NewDecoder returns a new SMTP decoder that writes its audit records according to the configuration,
+// and passes the transferred mails to the mail decoder.
+func NewDecoder(conf *decoderconfig.Config, errorMap *decoderutils.AtomicCounterMap, stats *streamutils.Stats, mail *mail.Decoder) *Decoder {
+	d := &Decoder{
+		conf:       conf,
+		log:        zap.NewNop(),
+		logSugared: zap.NewNop().Sugar(),
+		errorMap:   errorMap,
+		stats:      stats,
+		mail:       mail,
+	}
+
+	d.StreamDecoder = &decoder.StreamDecoder{
+		Type:        types.Type_NC_SMTP,
+		Name:        serviceSMTP,
+		Description: "The Simple Mail Transfer Protocol is a communication protocol for electronic mail transmission",
+		PostInit: func(*decoder.StreamDecoder) (err error) {
+			d.log, _, err = logging.InitZapLogger(
+				d.conf.Out,
+				"mail",
+				d.conf.Debug,
+			)
+
+			if err != nil {
+				return err
+			}
+
+			d.logSugared = d.log.Sugar()
+
+			return nil
+		},
+		CanDecode: func(client, server []byte) bool {
+			return bytes.HasPrefix(server, smtpServiceReadyBytes) && bytes.Contains(server, smtpName)
+		},
+		DeInit: func(*decoder.StreamDecoder) error {
+			return d.log.Sync()
+		},
+		Factory: &smtpReader{decoder: d},
+		Typ:     core.TCP,
+	}
+
+	return d
 }
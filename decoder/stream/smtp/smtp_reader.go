@@ -25,11 +25,8 @@ import (
 	"github.com/mgutz/ansi"
 	"go.uber.org/zap"
 
-	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
 	"github.com/dreadl0ck/netcap/decoder/core"
-	"github.com/dreadl0ck/netcap/decoder/stream/mail"
 	streamutils "github.com/dreadl0ck/netcap/decoder/stream/utils"
-	decoderutils "github.com/dreadl0ck/netcap/decoder/utils"
 	"github.com/dreadl0ck/netcap/types"
 )
 
@@ -99,6 +96,7 @@ const (
 )
 
 type smtpReader struct {
+	decoder      *Decoder
 	conversation *core.ConversationInfo
 
 	smtpRequests  []*types.SMTPRequest
@@ -107,6 +105,13 @@ type smtpReader struct {
 	resIndex      int
 
 	user, pass, token string
+
+	// state of the mail transaction, kept between calls when decoding incrementally
+	from, to string
+	mailIDs  []string
+
+	// only set when decoding incrementally
+	incremental *streamutils.IncrementalDecoder
 }
 
 func validSMTPCommand(cmd string) bool {
@@ -134,6 +139,7 @@ func validSMTPCommand(cmd string) bool {
 // New returns a SMTP reader instance.
 func (h *smtpReader) New(conv *core.ConversationInfo) core.StreamDecoderInterface {
 	return &smtpReader{
+		decoder:      h.decoder,
 		conversation: conv,
 	}
 }
@@ -141,20 +147,24 @@ func (h *smtpReader) New(conv *core.ConversationInfo) core.StreamDecoderInterfac
 // Decode parses the stream according to the SMTP protocol.
 func (h *smtpReader) Decode() {
 	// prevent nil pointer access if decoder is not initialized
-	if Decoder.Writer == nil {
+	if h.decoder.Writer == nil {
 		return
 	}
 
-	streamutils.DecodeConversation(
-		h.conversation.Ident,
-		h.conversation.Data,
-		func(b *bufio.Reader) error {
-			return h.readRequest(b)
-		},
-		func(b *bufio.Reader) error {
-			return h.readResponse(b)
-		},
-	)
+	if h.incremental != nil {
+		h.incremental.Finish()
+	} else {
+		streamutils.DecodeConversation(
+			h.conversation.Ident,
+			h.conversation.Data,
+			func(b *bufio.Reader) error {
+				return h.readRequest(b)
+			},
+			func(b *bufio.Reader) error {
+				return h.readResponse(b)
+			},
+		)
+	}
 
 	var commands []string
 
@@ -162,9 +172,9 @@ func (h *smtpReader) Decode() {
 		commands = append(commands, c.Command)
 	}
 
-	smtpDebug(ansi.LightGreen, serviceSMTP, h.conversation.Ident, "requests", len(h.smtpRequests), "responses", len(h.smtpResponses), ansi.Reset)
+	h.smtpDebug(ansi.LightGreen, serviceSMTP, h.conversation.Ident, "requests", len(h.smtpRequests), "responses", len(h.smtpResponses), ansi.Reset)
 
-	mails := h.processSMTPConversation()
+	h.processSMTPConversation()
 
 	smtpMsg := &types.SMTP{
 		Timestamp: h.conversation.FirstClientPacket.UnixNano(),
@@ -172,26 +182,54 @@ func (h *smtpReader) Decode() {
 		DstIP:     h.conversation.ServerIP,
 		SrcPort:   h.conversation.ClientPort,
 		DstPort:   h.conversation.ServerPort,
-		MailIDs:   mails,
+		MailIDs:   h.mailIDs,
 		Commands:  commands,
+		Tunnel:    h.conversation.Tunnel,
 	}
 
 	// export metrics if configured
-	if decoderconfig.Instance.ExportMetrics {
+	if h.decoder.conf.ExportMetrics {
 		smtpMsg.Inc()
 	}
 
 	// write record to disk
-	atomic.AddInt64(&Decoder.NumRecordsWritten, 1)
+	atomic.AddInt64(&h.decoder.NumRecordsWritten, 1)
 
-	err := Decoder.Writer.Write(smtpMsg)
+	err := h.decoder.Writer.Write(smtpMsg)
 	if err != nil {
-		decoderutils.ErrorMap.Inc(err.Error())
+		h.decoder.errorMap.Inc(err.Error())
 	}
 }
 
-func smtpDebug(args ...interface{}) {
-	smtpLogSugared.Info(args...)
+// Consume parses the data fragment and writes the mails that have been transferred completely.
+// The summary record for the connection is written once it has been closed.
+func (h *smtpReader) Consume(data *core.StreamData) {
+	// prevent nil pointer access if decoder is not initialized
+	if h.decoder.Writer == nil {
+		return
+	}
+
+	if h.incremental == nil {
+		h.incremental = streamutils.NewIncrementalDecoder(
+			h.conversation.Ident,
+			h.decoder.conf.IncrementalStreamBufferSize,
+			h.decoder.stats,
+			h.readRequest,
+			h.readResponse,
+		)
+	}
+
+	h.incremental.Consume(data.Dir, data.RawData, data.AssemblerContext.GetCaptureInfo().Timestamp)
+	h.processSMTPConversation()
+}
+
+// final returns true if no more data will arrive for the conversation.
+func (h *smtpReader) final() bool {
+	return h.incremental == nil || h.incremental.Final()
+}
+
+func (h *smtpReader) smtpDebug(args ...interface{}) {
+	h.decoder.logSugared.Info(args...)
 }
 
 func (h *smtpReader) readRequest(b *bufio.Reader) error {
@@ -207,7 +245,7 @@ nextLine:
 	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
 		return err
 	} else if err != nil {
-		smtpLog.Error("SMTP Request error",
+		h.decoder.log.Error("SMTP Request error",
 			zap.String("ident", h.conversation.Ident),
 			zap.Error(err),
 		)
@@ -215,13 +253,13 @@ nextLine:
 		return err
 	}
 
-	smtpDebug(ansi.Red, h.conversation.Ident, "readSMTPRequest", line, ansi.Reset)
+	h.smtpDebug(ansi.Red, h.conversation.Ident, "readSMTPRequest", line, ansi.Reset)
 
 	cmd, args := getSMTPCommand(line)
 
 	if cmd == smtpDot {
 
-		smtpDebug("collected data", strings.Join(data, "\n"))
+		h.smtpDebug("collected data", strings.Join(data, "\n"))
 
 		h.smtpRequests = append(h.smtpRequests, &types.SMTPRequest{
 			Command: smtpDATA,
@@ -251,7 +289,8 @@ nextLine:
 		goto nextLine
 	}
 
-	if cmd == smtpQUIT {
+	// when decoding incrementally, io.EOF would signal an incomplete message
+	if cmd == smtpQUIT && h.incremental == nil {
 		return io.EOF
 	}
 
@@ -287,7 +326,7 @@ nextLine:
 	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
 		return err
 	} else if err != nil {
-		smtpLog.Error(
+		h.decoder.log.Error(
 			"failed to read next SMTP response line",
 			zap.String("ident", h.conversation.Ident),
 			zap.Error(err),
@@ -295,7 +334,7 @@ nextLine:
 		return err
 	}
 
-	smtpDebug(ansi.Blue, h.conversation.Ident, "readSMTPResponse", line, ansi.Reset)
+	h.smtpDebug(ansi.Blue, h.conversation.Ident, "readSMTPResponse", line, ansi.Reset)
 
 	cmd, args = getSMTPCommand(line)
 
@@ -308,7 +347,7 @@ nextLine:
 
 	code, err := strconv.Atoi(cmd)
 	if err != nil {
-		smtpDebug(ansi.Red, h.conversation.Ident, "invalid response code", cmd)
+		h.smtpDebug(ansi.Red, h.conversation.Ident, "invalid response code", cmd)
 	}
 
 	h.smtpResponses = append(h.smtpResponses, &types.SMTPResponse{
@@ -318,24 +357,24 @@ nextLine:
 	})
 
 	// if QUIT was acked - quit
-	if code == smtpOK && strings.Contains(args[len(args)-1], smtpQUIT) {
+	if code == smtpOK && strings.Contains(args[len(args)-1], smtpQUIT) && h.incremental == nil {
 		return io.EOF
 	}
 
 	return nil
 }
 
-// process the SMTP conversation and returns a list of extracted mail identifiers
-func (h *smtpReader) processSMTPConversation() (mailIDs []string) {
+// process the SMTP conversation and collect the identifiers of the extracted mails.
+// When decoding incrementally, processing stops at commands whose reply has not been received yet,
+// and continues with the next call.
+func (h *smtpReader) processSMTPConversation() {
 	if len(h.smtpResponses) == 0 || len(h.smtpRequests) == 0 {
 		return
 	}
 
 	var (
 		// state    = stateNotAuthenticated
-		numMails int
-		from, to string
-		next     = func() *types.SMTPRequest {
+		next = func() *types.SMTPRequest {
 			return h.smtpRequests[h.reqIndex]
 		}
 		r *types.SMTPRequest
@@ -351,7 +390,7 @@ func (h *smtpReader) processSMTPConversation() (mailIDs []string) {
 		r = next()
 		h.reqIndex++
 
-		smtpDebug("CMD", r.Command, r.Argument, "h.resIndex", h.resIndex)
+		h.smtpDebug("CMD", r.Command, r.Argument, "h.resIndex", h.resIndex)
 
 		switch r.Command {
 		case smtpEHLO, smtpHELO:
@@ -360,22 +399,31 @@ func (h *smtpReader) processSMTPConversation() (mailIDs []string) {
 			continue
 		case smtpDATA:
 
-			m := mail.Parse(h.conversation, []byte(r.Data), from, to, smtpLogSugared, serviceSMTP)
-			mail.WriteMail(m)
-			mailIDs = append(mailIDs, m.ID)
-			numMails++
+			m := h.decoder.mail.Parse(h.conversation, []byte(r.Data), h.from, h.to, h.decoder.logSugared, serviceSMTP)
+			h.decoder.mail.WriteMail(m)
+			h.mailIDs = append(h.mailIDs, m.ID)
 			h.resIndex++
 
+			// the mail has been written, release the data
+			r.Data = ""
+
 			continue
 
 		case smtpMAILFROM:
 			if len(h.smtpResponses) <= h.resIndex {
+				if !h.final() {
+					// wait for the reply
+					h.reqIndex--
+
+					return
+				}
+
 				continue
 			}
 
 			reply := h.smtpResponses[h.resIndex]
 			if reply.ResponseCode == smtpMailActionCompleted {
-				from = r.Argument
+				h.from = r.Argument
 			}
 
 			h.resIndex++
@@ -384,13 +432,20 @@ func (h *smtpReader) processSMTPConversation() (mailIDs []string) {
 		case smtpRCPTTO:
 
 			if len(h.smtpResponses) <= h.resIndex {
+				if !h.final() {
+					// wait for the reply
+					h.reqIndex--
+
+					return
+				}
+
 				continue
 			}
 
 			reply := h.smtpResponses[h.resIndex]
 
 			if reply.ResponseCode == smtpMailActionCompleted {
-				to = r.Argument
+				h.to = r.Argument
 			}
 
 			h.resIndex++
@@ -399,7 +454,7 @@ func (h *smtpReader) processSMTPConversation() (mailIDs []string) {
 		case "QUIT":
 			return
 		default:
-			smtpDebug("unhandled SMTP command: ", r.Command)
+			h.smtpDebug("unhandled SMTP command: ", r.Command)
 			h.resIndex++
 		}
 	}
@@ -25,7 +25,7 @@ import (
 )
 
 // load JSON database for frontend frameworks from the file system
-func loadCmsDB() error {
+func loadCmsDB(log *zap.Logger) error {
 	// read CMS db JSON
 	data, err := ioutil.ReadFile(filepath.Join(resolvers.DataBaseFolderPath, "cmsdb.json"))
 	if err != nil {
@@ -64,7 +64,7 @@ func loadCmsDB() error {
 				// compile the supplied regex
 				r, errCompile := regexp.Compile(fmt.Sprint(re))
 				if errCompile != nil {
-					softwareLog.Info("failed to compile regex from CMS db HEADER",
+					log.Info("failed to compile regex from CMS db HEADER",
 						zap.Error(errCompile),
 						zap.String("re", fmt.Sprint(re)),
 						zap.String("framework", framework),
@@ -87,7 +87,7 @@ func loadCmsDB() error {
 				// compile the supplied regex
 				r, errCompile := regexp.Compile(fmt.Sprint(re))
 				if errCompile != nil {
-					softwareLog.Info("failed to compile regex from CMS db COOKIE",
+					log.Info("failed to compile regex from CMS db COOKIE",
 						zap.Error(errCompile),
 						zap.String("re", fmt.Sprint(re)),
 						zap.String("framework", framework),
@@ -30,122 +30,182 @@ import (
 
 	"github.com/dreadl0ck/netcap/decoder"
 	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
-	"github.com/dreadl0ck/netcap/decoder/db"
 	"github.com/dreadl0ck/netcap/decoder/stream/exploit"
 	"github.com/dreadl0ck/netcap/decoder/stream/vulnerability"
+	"github.com/dreadl0ck/netcap/dpi"
 	"github.com/dreadl0ck/netcap/logger"
 	"github.com/dreadl0ck/netcap/resolvers"
 	"github.com/dreadl0ck/netcap/types"
+	"github.com/dreadl0ck/netcap/utils"
 )
 
-var softwareLog = zap.NewNop()
-
 // Decoder for protocol analysis and writing audit records to disk.
-var Decoder = &decoder.AbstractDecoder{
-	Type:        types.Type_NC_Software,
-	Name:        "Software",
-	Description: "A software product that was observed on the network",
-	PostInit: func(d *decoder.AbstractDecoder) error {
-		var err error
-		softwareLog, _, err = logger.InitZapLogger(
-			decoderconfig.Instance.Out,
-			"software",
-			decoderconfig.Instance.Debug,
-		)
-		if err != nil {
-			return err
-		}
+type Decoder struct {
+	*decoder.AbstractDecoder
 
-		if errInitUAParser != nil {
-			return errInitUAParser
-		}
+	conf *decoderconfig.Config
+	log  *zap.Logger
 
-		// Load the JSON database of JA3/JA3S combinations into memory
-		data, err := ioutil.ReadFile(filepath.Join(resolvers.DataBaseFolderPath, "ja_3_3s.json"))
-		if err != nil {
-			return err
-		}
+	// Store holds all software products observed on the network.
+	Store *atomicSoftwareMap
 
-		// unpack JSON
-		err = json.Unmarshal(data, &ja3db.Servers)
-		if err != nil {
-			return err
-		}
+	// lookups for known issues with the observed software
+	vulnerabilities *vulnerability.Decoder
+	exploits        *exploit.Decoder
 
-		// Load the JSON database of HASSH signatures
-		data, err = ioutil.ReadFile(filepath.Join(resolvers.DataBaseFolderPath, "hasshdb.json"))
-		if err != nil {
-			return err
-		}
-
-		// unpack JSON
-		err = json.Unmarshal(data, &hasshDB)
-		if err != nil {
-			return err
-		}
+	// application protocols identified by the pure Go classifier
+	conversations *dpi.Conversations
+}
 
-		HashDBMap = make(map[string][]sshSoftware)
+// NewDecoder returns a new software decoder that writes its audit records according to the configuration,
+// the vulnerabilities and exploits for new software products are looked up with the given decoders.
+func NewDecoder(conf *decoderconfig.Config, vulnerabilities *vulnerability.Decoder, exploits *exploit.Decoder, conversations *dpi.Conversations) *Decoder {
+	d := &Decoder{
+		conf: conf,
+		log:  zap.NewNop(),
+		Store: &atomicSoftwareMap{
+			Items: make(map[string]*AtomicSoftware),
+		},
+		vulnerabilities: vulnerabilities,
+		exploits:        exploits,
+		conversations:   conversations,
+	}
 
-		for _, v := range hasshDB {
-			HashDBMap[v.Hash] = v.Software
-		}
+	d.AbstractDecoder = &decoder.AbstractDecoder{
+		Type:        types.Type_NC_Software,
+		Name:        "Software",
+		Description: "A software product that was observed on the network",
+		PostInit: func(*decoder.AbstractDecoder) error {
+			var err error
+			d.log, _, err = logger.InitZapLogger(
+				d.conf.Out,
+				"software",
+				d.conf.Debug,
+			)
+			if err != nil {
+				return err
+			}
 
-		softwareLog.Info("loaded HASSH digests", zap.Int("total", len(HashDBMap)))
+			if errInitUAParser != nil {
+				return errInitUAParser
+			}
 
-		// read CMS db JSON
-		err = loadCmsDB()
-		if err != nil {
-			return errors.Wrap(err, "failed to load CMS database")
-		}
+			err = loadDatabases(d.log)
+			if err != nil {
+				return err
+			}
 
-		softwareLog.Info("loaded CMS db", zap.Int("total", len(cmsDB)))
+			d.log.Info("loaded HASSH digests", zap.Int("total", len(HashDBMap)))
+			d.log.Info("loaded CMS db", zap.Int("total", len(cmsDB)))
 
-		// Load vulnerabilities DB index
-		indexName := filepath.Join(resolvers.DataBaseFolderPath, db.VulnerabilityDBName)
-		db.VulnerabilitiesIndex, err = db.OpenBleve(indexName)
-		if err != nil {
-			// explicitly set to nil, otherwise it can't be determined whether the init succeeded later on
-			db.VulnerabilitiesIndex = nil
+			// Load vulnerabilities DB index
+			err = d.vulnerabilities.OpenIndex()
+			if err != nil {
+				return err
+			}
 
-			return errors.Wrap(err, "failed to open vulnerability bleve index at: "+indexName)
-		}
+			d.log.Info("loaded Ja3/ja3S database", zap.Int("total_records", len(ja3db.Servers)))
+
+			return nil
+		},
+		DeInit: func(e *decoder.AbstractDecoder) error {
+			// TODO: make collecting and dumping unique user agents, server names and header fields configurable
+			//httpStore.Lock()
+			//var rows [][]string
+			//for ip, ua := range httpStore.UserAgents {
+			//	rows = append(rows, []string{ip, ua})
+			//}
+			//tui.Table(decoderLogFileHandle, []string{"IP", "UserAgents"}, rows)
+			//rows = [][]string{}
+			//for ip, sn := range httpStore.ServerNames {
+			//	rows = append(rows, []string{ip, sn})
+			//}
+			//tui.Table(decoderLogFileHandle, []string{"IP", "ServerNames"}, rows)
+			//httpStore.Unlock()
+
+			// flush writer
+			var err error
+			for _, item := range d.Store.Items {
+				item.Lock()
+				// software is identified from TCP conversations, or from UDP service banners that add the protocol to the notes
+				proto := utils.ProtocolTCP
+				if strings.Contains(item.Notes, "Protocol: UDP") {
+					proto = utils.ProtocolUDP
+				}
+				item.CommunityIDs = utils.CommunityIDsFromFlowIdents(uint16(d.conf.CommunityIDSeed), item.Flows, proto)
 
-		softwareLog.Info("loaded Ja3/ja3S database", zap.Int("total_records", len(ja3db.Servers)))
+				err = e.Writer.Write(item.Software)
+				if err != nil {
+					d.log.Error("failed to flush software audit record", zap.Error(err))
+				}
 
-		return nil
-	},
-	DeInit: func(e *decoder.AbstractDecoder) error {
-		// TODO: make collecting and dumping unique user agents, server names and header fields configurable
-		//httpStore.Lock()
-		//var rows [][]string
-		//for ip, ua := range httpStore.UserAgents {
-		//	rows = append(rows, []string{ip, ua})
-		//}
-		//tui.Table(decoderLogFileHandle, []string{"IP", "UserAgents"}, rows)
-		//rows = [][]string{}
-		//for ip, sn := range httpStore.ServerNames {
-		//	rows = append(rows, []string{ip, sn})
-		//}
-		//tui.Table(decoderLogFileHandle, []string{"IP", "ServerNames"}, rows)
-		//httpStore.Unlock()
-
-		// flush writer
-		var err error
-		for _, item := range Store.Items {
-			item.Lock()
-			err = e.Writer.Write(item.Software)
-			if err != nil {
-				softwareLog.Error("failed to flush software audit record", zap.Error(err))
+				atomic.AddInt64(&e.NumRecordsWritten, 1)
+				item.Unlock()
 			}
 
-			atomic.AddInt64(&e.NumRecordsWritten, 1)
-			item.Unlock()
-		}
+			d.vulnerabilities.CloseIndex()
+
+			return d.log.Sync()
+		},
+	}
+
+	return d
+}
+
+// the reference databases are loaded once and shared by the decoders of all collectors,
+// they are not modified afterwards.
+var (
+	loadDatabasesOnce sync.Once
+	errLoadDatabases  error
+)
 
-		db.CloseBleve(db.VulnerabilitiesIndex)
+// loadDatabases loads the JA3, HASSH and CMS databases into memory.
+func loadDatabases(log *zap.Logger) error {
+	loadDatabasesOnce.Do(func() {
+		errLoadDatabases = loadDatabasesFromDisk(log)
+	})
 
-		return softwareLog.Sync()
-	},
+	return errLoadDatabases
+}
+
+func loadDatabasesFromDisk(log *zap.Logger) error {
+	// Load the JSON database of JA3/JA3S combinations into memory
+	data, err := ioutil.ReadFile(filepath.Join(resolvers.DataBaseFolderPath, "ja_3_3s.json"))
+	if err != nil {
+		return err
+	}
+
+	// unpack JSON
+	err = json.Unmarshal(data, &ja3db.Servers)
+	if err != nil {
+		return err
+	}
+
+	// Load the JSON database of HASSH signatures
+	data, err = ioutil.ReadFile(filepath.Join(resolvers.DataBaseFolderPath, "hasshdb.json"))
+	if err != nil {
+		return err
+	}
+
+	// unpack JSON
+	err = json.Unmarshal(data, &hasshDB)
+	if err != nil {
+		return err
+	}
+
+	HashDBMap = make(map[string][]sshSoftware)
+
+	for _, v := range hasshDB {
+		HashDBMap[v.Hash] = v.Software
+	}
+
+	// read CMS db JSON
+	err = loadCmsDB(log)
+	if err != nil {
+		return errors.Wrap(err, "failed to load CMS database")
+	}
+
+	return nil
 }
 
 // header is a HTTP header structure.
@@ -217,11 +277,6 @@ func (a *atomicSoftwareMap) Size() int {
 }
 
 var (
-	// Store SoftwareStore hold all connections.
-	Store = &atomicSoftwareMap{
-		Items: make(map[string]*AtomicSoftware),
-	}
-
 	parser, errInitUAParser = uaparser.New(filepath.Join(resolvers.DataBaseFolderPath, "regexes.yaml"))
 
 	// UserAgentParserMutex ensures atomic access to the user agent parser.
@@ -445,7 +500,7 @@ func softwareHarvester(data []byte, flowIdent string, ts time.Time, service stri
 //}
 
 // WhatSoftwareHTTP TODO: pass in the device profile.
-func WhatSoftwareHTTP(flowIdent string, h *types.HTTP) (s []*AtomicSoftware) {
+func (d *Decoder) WhatSoftwareHTTP(flowIdent string, h *types.HTTP) (s []*AtomicSoftware) {
 	// HTTP User Agents
 	// TODO: check for userAgents retrieved by Ja3 lookup as well
 	if h.UserAgent != "" && h.UserAgent != " " {
@@ -457,7 +512,7 @@ func WhatSoftwareHTTP(flowIdent string, h *types.HTTP) (s []*AtomicSoftware) {
 			userInfo = ParseUserAgent(h.UserAgent)
 			if userInfo != nil {
 				UserAgentCache[h.UserAgent] = userInfo
-				softwareLog.Debug("UserAgent:", zap.String("userInfo", userInfo.Full))
+				d.log.Debug("UserAgent:", zap.String("userInfo", userInfo.Full))
 			}
 		}
 
@@ -476,6 +531,7 @@ func WhatSoftwareHTTP(flowIdent string, h *types.HTTP) (s []*AtomicSoftware) {
 						SourceData: h.UserAgent,
 						Service:    "HTTP",
 						Flows:      []string{flowIdent},
+						Tunnel:     h.Tunnel,
 						Notes:      userInfo.Full,
 						OS:         userInfo.OS,
 					},
@@ -499,6 +555,7 @@ func WhatSoftwareHTTP(flowIdent string, h *types.HTTP) (s []*AtomicSoftware) {
 				SourceData: h.ServerName,
 				Service:    "HTTP",
 				Flows:      []string{flowIdent},
+				Tunnel:     h.Tunnel,
 			},
 		})
 	}
@@ -518,6 +575,7 @@ func WhatSoftwareHTTP(flowIdent string, h *types.HTTP) (s []*AtomicSoftware) {
 					SourceData: poweredBy,
 					Service:    "HTTP",
 					Flows:      []string{flowIdent},
+					Tunnel:     h.Tunnel,
 				},
 			})
 		}
@@ -590,9 +648,9 @@ func WhatSoftwareHTTP(flowIdent string, h *types.HTTP) (s []*AtomicSoftware) {
 				if matchesHeader() {
 
 					// we found a match
-					s = append(s, makeSoftware(h.Timestamp, product, info.Website, sourceName, sourceData, flowIdent))
+					s = append(s, makeSoftware(h.Timestamp, product, info.Website, sourceName, sourceData, flowIdent, h.Tunnel))
 
-					if decoderconfig.Instance.StopAfterServiceProbeMatch {
+					if d.conf.StopAfterServiceProbeMatch {
 						return s
 					}
 				}
@@ -631,9 +689,9 @@ func WhatSoftwareHTTP(flowIdent string, h *types.HTTP) (s []*AtomicSoftware) {
 				if matchesCookie() {
 
 					// we found a match
-					s = append(s, makeSoftware(h.Timestamp, product, info.Website, sourceName, sourceData, flowIdent))
+					s = append(s, makeSoftware(h.Timestamp, product, info.Website, sourceName, sourceData, flowIdent, h.Tunnel))
 
-					if decoderconfig.Instance.StopAfterServiceProbeMatch {
+					if d.conf.StopAfterServiceProbeMatch {
 						return s
 					}
 				}
@@ -736,11 +794,11 @@ func WhatSoftwareHTTP(flowIdent string, h *types.HTTP) (s []*AtomicSoftware) {
 //}
 
 // WriteSoftware can be used to write software to the software audit record writer.
-func WriteSoftware(software []*AtomicSoftware, update func(s *AtomicSoftware)) {
+func (d *Decoder) WriteSoftware(software []*AtomicSoftware, update func(s *AtomicSoftware)) {
 	var newSoftwareProducts []*types.Software
 
 	// add new audit records or update existing
-	Store.Lock()
+	d.Store.Lock()
 	for _, s := range software {
 		if s == nil {
 			continue
@@ -759,31 +817,59 @@ func WriteSoftware(software []*AtomicSoftware, update func(s *AtomicSoftware)) {
 		if len(s.Version) > 15 {
 			s.Version = s.Version[:15] + "..."
 		}
+		d.addDPIResults(s.Software, s.Flows)
 		s.Unlock()
-		if item, ok := Store.Items[ident]; ok {
+		if item, ok := d.Store.Items[ident]; ok {
 			if update != nil {
 				update(item)
 			}
+
+			item.Lock()
+			d.addDPIResults(item.Software, s.Flows)
+			item.Unlock()
 		} else {
 			// fmt.Println(SoftwareStore.Items, s.Product, s.Version)
-			Store.Items[ident] = s
+			d.Store.Items[ident] = s
 
 			newSoftwareProducts = append(newSoftwareProducts, s.Software)
 		}
 	}
-	Store.Unlock()
+	d.Store.Unlock()
 
 	if len(newSoftwareProducts) > 0 {
 		// lookup known issues with identified software in the background
 		go func() {
 			for _, s := range newSoftwareProducts {
-				vulnerability.VulnerabilitiesLookup(s)
-				exploit.ExploitsLookup(s)
+				d.vulnerabilities.VulnerabilitiesLookup(s)
+				d.exploits.ExploitsLookup(s)
 			}
 		}()
 	}
 }
 
+// addDPIResults adds the application protocols identified for the flows by the pure Go classifier.
+func (d *Decoder) addDPIResults(s *types.Software, flows []string) {
+	for _, f := range flows {
+		res, ok := d.conversations.Lookup(f)
+		if !ok {
+			continue
+		}
+
+		var found bool
+		for _, p := range s.DPIResults {
+			if p == res.Protocol {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			s.DPIResults = append(s.DPIResults, res.Protocol)
+		}
+	}
+}
+
 //// newSoftware creates a new device specific profile.
 //func newSoftware(i *decoderutils.PacketInfo) *AtomicSoftware {
 //	return &AtomicSoftware{
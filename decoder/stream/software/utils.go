@@ -31,7 +31,7 @@ func determineVendor(product string) (vendor string) {
 	return vendor
 }
 
-func makeSoftware(ts int64, product, website, sourceName, sourceData, flowIdent string) *AtomicSoftware {
+func makeSoftware(ts int64, product, website, sourceName, sourceData, flowIdent string, tunnel *types.Tunnel) *AtomicSoftware {
 	return &AtomicSoftware{
 		Software: &types.Software{
 			Timestamp:  ts,
@@ -42,6 +42,7 @@ func makeSoftware(ts int64, product, website, sourceName, sourceData, flowIdent
 			SourceData: sourceData,
 			Service:    "HTTP",
 			Flows:      []string{flowIdent},
+			Tunnel:     tunnel,
 		},
 	}
 }
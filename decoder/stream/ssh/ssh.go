@@ -21,34 +21,60 @@ import (
 
 	"github.com/dreadl0ck/netcap/decoder"
 	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
+	"github.com/dreadl0ck/netcap/decoder/stream/software"
+	streamutils "github.com/dreadl0ck/netcap/decoder/stream/utils"
 	logging "github.com/dreadl0ck/netcap/logger"
 	"github.com/dreadl0ck/netcap/types"
 )
 
-var sshLog = zap.NewNop()
-
 // Decoder for protocol analysis and writing audit records to disk.
-var Decoder = &decoder.StreamDecoder{
-	Type:        types.Type_NC_SSH,
-	Name:        serviceSSH,
-	Description: "The Secure Shell Protocol allows controlling remote machines over an encrypted connection",
-	PostInit: func(d *decoder.StreamDecoder) error {
-		var err error
-		sshLog, _, err = logging.InitZapLogger(
-			decoderconfig.Instance.Out,
-			"ssh",
-			decoderconfig.Instance.Debug,
-		)
-		return err
-	},
-	CanDecode: func(client, server []byte) bool {
-		return bytes.Contains(server, sshServiceName)
-	},
-	DeInit: func(sd *decoder.StreamDecoder) error {
-		return sshLog.Sync()
-	},
-	Factory: &sshReader{},
-	Typ:     core.TCP,
+type Decoder struct {
+	*decoder.StreamDecoder
+
+	conf *decoderconfig.Config
+	log  *zap.Logger
+
+	// statistics of the collector
+	stats *streamutils.Stats
+
+	// software decoder for the products identified in the handshakes
+	software *software.Decoder
+}
+
+// NewDecoder returns a new SSH decoder that writes its audit records according to the configuration,
+// and passes the software identified in the handshakes to the software decoder.
+func NewDecoder(conf *decoderconfig.Config, stats *streamutils.Stats, software *software.Decoder) *Decoder {
+	d := &Decoder{
+		conf:     conf,
+		log:      zap.NewNop(),
+		stats:    stats,
+		software: software,
+	}
+
+	d.StreamDecoder = &decoder.StreamDecoder{
+		Type:        types.Type_NC_SSH,
+		Name:        serviceSSH,
+		Description: "The Secure Shell Protocol allows controlling remote machines over an encrypted connection",
+		PostInit: func(*decoder.StreamDecoder) error {
+			var err error
+			d.log, _, err = logging.InitZapLogger(
+				d.conf.Out,
+				"ssh",
+				d.conf.Debug,
+			)
+			return err
+		},
+		CanDecode: func(client, server []byte) bool {
+			return bytes.Contains(server, sshServiceName)
+		},
+		DeInit: func(*decoder.StreamDecoder) error {
+			return d.log.Sync()
+		},
+		Factory: &sshReader{decoder: d},
+		Typ:     core.TCP,
+	}
+
+	return d
 }
 
 var (
@@ -31,7 +31,6 @@ import (
 
 	"github.com/dreadl0ck/netcap/decoder/core"
 	"github.com/dreadl0ck/netcap/decoder/stream/software"
-	streamutils "github.com/dreadl0ck/netcap/decoder/stream/utils"
 	"github.com/dreadl0ck/netcap/reassembly"
 	"github.com/dreadl0ck/netcap/types"
 	"github.com/dreadl0ck/netcap/utils"
@@ -41,7 +40,11 @@ import (
  * SSH - The Secure Shell Protocol
  */
 
+// 2255k bytes should be enough to capture ident (max 255 bytes) + kexInit (usually ~1200-1700 bytes)
+const kexInitSearchLen = 2255
+
 type sshReader struct {
+	decoder      *Decoder
 	conversation *core.ConversationInfo
 
 	clientIdent   string
@@ -49,11 +52,19 @@ type sshReader struct {
 	clientKexInit *KexInitMsg
 	serverKexInit *KexInitMsg
 	software      []*types.Software
+
+	// buffered handshake data, only used when decoding incrementally
+	incremental bool
+	clientBuf   bytes.Buffer
+	serverBuf   bytes.Buffer
+	clientDone  bool
+	serverDone  bool
 }
 
 // New returns a new SSH reader.
 func (h *sshReader) New(conversation *core.ConversationInfo) core.StreamDecoderInterface {
 	return &sshReader{
+		decoder:      h.decoder,
 		conversation: conversation,
 	}
 }
@@ -61,58 +72,117 @@ func (h *sshReader) New(conversation *core.ConversationInfo) core.StreamDecoderI
 // Decode parses the stream according to the SSH protocol.
 func (h *sshReader) Decode() {
 	// prevent nil pointer access if decoder is not initialized
-	if Decoder.Writer == nil {
+	if h.decoder.Writer == nil {
 		return
 	}
 
-	var (
-		serverBuf bytes.Buffer
-		clientBuf bytes.Buffer
-	)
+	if h.incremental {
+		// the handshake has already been processed
+		if h.clientDone && h.serverDone {
+			return
+		}
 
-	for _, d := range h.conversation.Data {
-		if d.Direction() == reassembly.TCPDirClientToServer {
-			// 2255k bytes should be enough to capture ident (max 255 bytes) + kexInit (usually ~1200-1700 bytes)
-			if clientBuf.Len() < 2255 {
-				clientBuf.Write(d.Raw())
-			}
-		} else {
-			// 2255k bytes should be enough to capture ident (max 255 bytes) + kexInit (usually ~1200-1700 bytes)
-			if serverBuf.Len() < 2255 {
-				serverBuf.Write(d.Raw())
+		h.clientDone, h.serverDone = true, true
+	} else {
+		for _, d := range h.conversation.Data {
+			if d.Direction() == reassembly.TCPDirClientToServer {
+				if h.clientBuf.Len() < kexInitSearchLen {
+					h.clientBuf.Write(d.Raw())
+				}
+			} else {
+				if h.serverBuf.Len() < kexInitSearchLen {
+					h.serverBuf.Write(d.Raw())
+				}
 			}
 		}
 	}
 
-	h.searchKexInit(bufio.NewReader(&clientBuf), reassembly.TCPDirClientToServer)
-	h.searchKexInit(bufio.NewReader(&serverBuf), reassembly.TCPDirServerToClient)
+	h.searchKexInit(bufio.NewReader(&h.clientBuf), reassembly.TCPDirClientToServer)
+	h.searchKexInit(bufio.NewReader(&h.serverBuf), reassembly.TCPDirServerToClient)
+
+	h.storeSoftware()
+}
+
+// Consume buffers the handshake data of the connection,
+// and searches for the ident and KexInit of each side once they have been received.
+func (h *sshReader) Consume(data *core.StreamData) {
+	// prevent nil pointer access if decoder is not initialized
+	if h.decoder.Writer == nil {
+		return
+	}
+
+	h.incremental = true
+
+	if data.Dir == reassembly.TCPDirClientToServer {
+		h.consume(&h.clientBuf, &h.clientDone, data)
+	} else {
+		h.consume(&h.serverBuf, &h.serverDone, data)
+	}
+}
+
+func (h *sshReader) consume(buf *bytes.Buffer, done *bool, data *core.StreamData) {
+	if *done {
+		return
+	}
+
+	buf.Write(data.RawData)
+
+	if buf.Len() < kexInitSearchLen && !kexInitComplete(buf.Bytes()) {
+		return
+	}
+
+	*done = true
+
+	h.searchKexInit(bufio.NewReader(buf), data.Dir)
+
+	if h.clientDone && h.serverDone {
+		h.storeSoftware()
+	}
+}
+
+// kexInitComplete checks if the data contains the ident line and the complete binary packet following it.
+func kexInitComplete(data []byte) bool {
+	i := bytes.Index(data, []byte{0x0d, 0x0a})
+	if i == -1 {
+		return false
+	}
+
+	data = data[i+2:]
+	if len(data) < 4 {
+		return false
+	}
+
+	return len(data)-4 >= int(binary.BigEndian.Uint32(data[:4]))
+}
 
+// storeSoftware adds the software identified in the handshake to the store.
+func (h *sshReader) storeSoftware() {
 	if len(h.software) == 0 {
 		return
 	}
 
 	// add new audit records or update existing
-	software.Store.Lock()
+	h.decoder.software.Store.Lock()
 	for _, s := range h.software {
-		if _, ok := software.Store.Items[s.Product+"/"+s.Version]; ok {
+		if _, ok := h.decoder.software.Store.Items[s.Product+"/"+s.Version]; ok {
 			// TODO updateSoftwareAuditRecord(dp, p, i)
 		} else {
-			software.Store.Items[s.Product+"/"+s.Version] = &software.AtomicSoftware{
+			h.decoder.software.Store.Items[s.Product+"/"+s.Version] = &software.AtomicSoftware{
 				Software: s,
 				Mutex:    sync.Mutex{},
 			}
-			streamutils.Stats.Lock()
-			streamutils.Stats.NumSoftware++
-			streamutils.Stats.Unlock()
+			h.decoder.stats.Lock()
+			h.decoder.stats.NumSoftware++
+			h.decoder.stats.Unlock()
 		}
 	}
-	software.Store.Unlock()
+	h.decoder.software.Store.Unlock()
 }
 
 func (h *sshReader) processSSHIdent(ident string, entity string) {
 	i := parseSSHIdent(ident)
 	if i != nil {
-		software.WriteSoftware([]*software.AtomicSoftware{
+		h.decoder.software.WriteSoftware([]*software.AtomicSoftware{
 			{
 				Software: &types.Software{
 					Timestamp:  h.conversation.FirstClientPacket.UnixNano(),
@@ -123,6 +193,7 @@ func (h *sshReader) processSSHIdent(ident string, entity string) {
 					Flows:      []string{h.conversation.Ident},
 					Notes:      "SSH version: " + i.sshVersion + " OS: " + i.os,
 					SourceData: h.serverIdent,
+					Tunnel:     h.conversation.Tunnel,
 				},
 			},
 		}, nil)
@@ -224,41 +295,45 @@ func (h *sshReader) searchKexInit(r *bufio.Reader, dir reassembly.TCPFlowDirecti
 		hash, raw := computeHASSH(init)
 
 		if dir == reassembly.TCPDirClientToServer {
-			err = Decoder.Writer.Write(&types.SSH{
-				Timestamp:  h.conversation.FirstClientPacket.UnixNano(),
-				HASSH:      hash,
-				Flow:       h.conversation.Ident,
-				Ident:      h.clientIdent,
-				Algorithms: raw,
-				IsClient:   true,
+			err = h.decoder.Writer.Write(&types.SSH{
+				Timestamp:   h.conversation.FirstClientPacket.UnixNano(),
+				HASSH:       hash,
+				Flow:        h.conversation.Ident,
+				Ident:       h.clientIdent,
+				Algorithms:  raw,
+				IsClient:    true,
+				CommunityID: utils.CommunityIDFromFlowIdent(uint16(h.decoder.conf.CommunityIDSeed), h.conversation.Ident, utils.ProtocolTCP),
+				Tunnel:      h.conversation.Tunnel,
 			})
 			if err != nil {
-				sshLog.Error("failed to flush ssh audit record", zap.Error(err))
+				h.decoder.log.Error("failed to flush ssh audit record", zap.Error(err))
 			}
 
-			atomic.AddInt64(&Decoder.NumRecordsWritten, 1)
+			atomic.AddInt64(&h.decoder.NumRecordsWritten, 1)
 
 			h.clientKexInit = &init
 
-			sshLog.Info("found clientKexInit", zap.String("ident", h.conversation.Ident))
+			h.decoder.log.Info("found clientKexInit", zap.String("ident", h.conversation.Ident))
 		} else {
-			err = Decoder.Writer.Write(&types.SSH{
-				Timestamp:  h.conversation.FirstServerPacket.UnixNano(),
-				HASSH:      hash,
-				Flow:       utils.ReverseFlowIdent(h.conversation.Ident),
-				Ident:      h.serverIdent,
-				Algorithms: raw,
-				IsClient:   false,
+			err = h.decoder.Writer.Write(&types.SSH{
+				Timestamp:   h.conversation.FirstServerPacket.UnixNano(),
+				HASSH:       hash,
+				Flow:        utils.ReverseFlowIdent(h.conversation.Ident),
+				Ident:       h.serverIdent,
+				Algorithms:  raw,
+				IsClient:    false,
+				CommunityID: utils.CommunityIDFromFlowIdent(uint16(h.decoder.conf.CommunityIDSeed), h.conversation.Ident, utils.ProtocolTCP),
+				Tunnel:      h.conversation.Tunnel,
 			})
 			if err != nil {
-				sshLog.Error("failed to flush ssh audit record", zap.Error(err))
+				h.decoder.log.Error("failed to flush ssh audit record", zap.Error(err))
 			}
 
-			atomic.AddInt64(&Decoder.NumRecordsWritten, 1)
+			atomic.AddInt64(&h.decoder.NumRecordsWritten, 1)
 
 			h.serverKexInit = &init
 
-			sshLog.Info("found serverKexInit", zap.String("ident", h.conversation.Ident))
+			h.decoder.log.Info("found serverKexInit", zap.String("ident", h.conversation.Ident))
 		}
 
 		// TODO fetch device profile
@@ -275,8 +350,9 @@ func (h *sshReader) searchKexInit(r *bufio.Reader, dir reassembly.TCPFlowDirecti
 				SourceData: hash,
 				Service:    serviceSSH,
 				// DPIResults:     protos,
-				Flows: []string{h.conversation.Ident},
-				Notes: "Likelihood: " + soft.Likelihood + " Possible OS: " + os + "SSH Version: " + sshVersion,
+				Flows:  []string{h.conversation.Ident},
+				Notes:  "Likelihood: " + soft.Likelihood + " Possible OS: " + os + "SSH Version: " + sshVersion,
+				Tunnel: h.conversation.Tunnel,
 			})
 		}
 
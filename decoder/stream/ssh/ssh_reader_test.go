@@ -99,3 +99,20 @@ func TestParseSSHKexInitMsgPadding(t *testing.T) {
 		t.Fatal("the value should be 6")
 	}
 }
+
+func TestKexInitComplete(t *testing.T) {
+	ident := []byte("SSH-2.0-OpenSSH_8.2p1 Ubuntu-4ubuntu0.1\r\n")
+	packet := append([]byte{0x00, 0x00, 0x00, 0x05, 0x04, 0x14}, make([]byte, 3)...)
+
+	if kexInitComplete(ident[:10]) {
+		t.Fatal("expected incomplete ident")
+	}
+
+	if kexInitComplete(append(ident, packet[:6]...)) {
+		t.Fatal("expected incomplete packet")
+	}
+
+	if !kexInitComplete(append(ident, packet...)) {
+		t.Fatal("expected complete packet")
+	}
+}
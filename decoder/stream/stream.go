@@ -6,13 +6,9 @@ import (
 	"log"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
-	"github.com/dreadl0ck/netcap/decoder/stream/http"
-	"github.com/dreadl0ck/netcap/decoder/stream/pop3"
-	"github.com/dreadl0ck/netcap/decoder/stream/smtp"
-	"github.com/dreadl0ck/netcap/decoder/stream/ssh"
-
 	"github.com/mgutz/ansi"
 	"github.com/pkg/errors"
 
@@ -27,23 +23,21 @@ import (
 // errInvalidStreamDecoder occurs when a decoder name is unknown during initialization.
 var errInvalidStreamDecoder = errors.New("invalid stream decoder")
 
-// Debug controls debug log messages and behavior
-var Debug bool
+// defaultDecoders are used for listing the available decoders,
+// collectors create their own instances with NewDecoders.
+var defaultDecoders = NewDecoders(config.DefaultConfig, nil)
 
-// DefaultStreamDecoders contains stream decoders mapped to their protocols default port
-// int32 is used to avoid casting when looking up values
-var DefaultStreamDecoders = map[int32]core.StreamDecoderAPI{
-	80:  http.Decoder,
-	110: pop3.Decoder,
-	22:  ssh.Decoder,
-	25:  smtp.Decoder,
-} // contains all available stream decoders
+// DefaultStreamDecoders contains all available stream decoders,
+// if no decoder is mapped to the port of a conversation they are tried in this order.
+// The ports for each decoder are configured with the StreamDecoderPorts of the decoder config.
+var DefaultStreamDecoders = defaultDecoders.streamDecoders()
 
 // package level init.
 func init() {
 	// collect all names for stream decoders on startup
 	for _, d := range DefaultStreamDecoders {
 		decoderutils.AllDecoderNames[d.GetName()] = struct{}{}
+		streamDecoderNames[d.GetName()] = struct{}{}
 	}
 }
 
@@ -56,13 +50,6 @@ func ApplyActionToStreamDecoders(action func(api core.StreamDecoderAPI)) {
 
 // ApplyActionToStreamDecodersAsync can be used to run custom code for all gopacket decoders asynchronously.
 func ApplyActionToStreamDecodersAsync(action func(api core.StreamDecoderAPI)) {
-
-	// when debugging, enforce sequential processing so the logs are in order
-	if Debug {
-		ApplyActionToStreamDecoders(action)
-		return
-	}
-
 	wg := sync.WaitGroup{}
 	for _, d := range DefaultStreamDecoders {
 		wg.Add(1)
@@ -74,9 +61,14 @@ func ApplyActionToStreamDecodersAsync(action func(api core.StreamDecoderAPI)) {
 	wg.Wait()
 }
 
-// InitDecoders initializes all stream decoders.
-func InitDecoders(c *config.Config) (decoders []core.StreamDecoderAPI, err error) {
+// InitStreamDecoders initializes the stream decoders selected in the configuration.
+func (sd *Decoders) InitStreamDecoders() (decoders []core.StreamDecoderAPI, err error) {
 	var (
+		c = sd.conf
+
+		// stream decoders of the instance
+		loaded = sd.streamDecoders()
+
 		// values from command-line flags
 		in = strings.Split(c.IncludeDecoders, ",")
 		ex = strings.Split(c.ExcludeDecoders, ",")
@@ -85,7 +77,7 @@ func InitDecoders(c *config.Config) (decoders []core.StreamDecoderAPI, err error
 		inMap = make(map[string]bool)
 
 		// new selection
-		selection = make(map[int32]core.StreamDecoderAPI)
+		selection []core.StreamDecoderAPI
 	)
 
 	// if there are includes and the first item is not an empty string
@@ -102,14 +94,14 @@ func InitDecoders(c *config.Config) (decoders []core.StreamDecoderAPI, err error
 		}
 
 		// iterate over packet decoders and collect those that are named in the includeMap
-		for port, dec := range DefaultStreamDecoders {
+		for _, dec := range loaded {
 			if _, ok := inMap[dec.GetName()]; ok {
-				selection[port] = dec
+				selection = append(selection, dec)
 			}
 		}
 
 		// update packet decoders to new selection
-		DefaultStreamDecoders = selection
+		loaded = selection
 	}
 
 	// iterate over excluded decoders
@@ -120,10 +112,10 @@ func InitDecoders(c *config.Config) (decoders []core.StreamDecoderAPI, err error
 			}
 
 			// remove named decoder from defaultPacketDecoders
-			for port, dec := range DefaultStreamDecoders {
+			for i, dec := range loaded {
 				if name == dec.GetName() {
 					// remove decoder
-					delete(DefaultStreamDecoders, port)
+					loaded = append(loaded[:i:i], loaded[i+1:]...)
 
 					break
 				}
@@ -131,13 +123,34 @@ func InitDecoders(c *config.Config) (decoders []core.StreamDecoderAPI, err error
 		}
 	}
 
+	// map the decoders to the ports and servers they are tried for first
+	sd.portMappings, err = parsePortMappings(c.StreamDecoderPorts, loaded)
+	if err != nil {
+		return nil, err
+	}
+
+	sd.forcedDecoders, err = parseForcedDecoders(c.StreamDecoderForce, loaded)
+	if err != nil {
+		return nil, err
+	}
+
+	// reset match stats in case the decoders are reinitialized at runtime.
+	sd.matchCounters = make(map[core.StreamDecoderAPI]*matchCounter, len(loaded))
+	for _, d := range loaded {
+		sd.matchCounters[d] = new(matchCounter)
+	}
+
+	atomic.StoreInt64(&sd.numUnmatched, 0)
+
+	sd.loaded = loaded
+
 	var (
 		wg sync.WaitGroup
 		mu sync.Mutex
 	)
 
 	// initialize decoders
-	for _, d := range DefaultStreamDecoders {
+	for _, d := range loaded {
 
 		// reset decoder stat in case it is reinitialized at runtime.
 		d.(*decoder.StreamDecoder).NumRecordsWritten = 0
@@ -151,6 +164,7 @@ func InitDecoders(c *config.Config) (decoders []core.StreamDecoderAPI, err error
 				Label:   c.Label,
 				Proto:   c.Proto,
 				JSON:    c.JSON,
+				Parquet: c.Parquet,
 				Name:    dec.GetName(),
 				Type:    dec.GetType(),
 				Null:    c.Null,
@@ -167,6 +181,7 @@ func InitDecoders(c *config.Config) (decoders []core.StreamDecoderAPI, err error
 				Out:                  c.Out,
 				Chan:                 c.Chan,
 				ChanSize:             c.ChanSize,
+				Records:              c.Records,
 				MemBufferSize:        c.MemBufferSize,
 				Source:               c.Source,
 				Version:              netcap.Version,
@@ -174,6 +189,32 @@ func InitDecoders(c *config.Config) (decoders []core.StreamDecoderAPI, err error
 				StartTime:            time.Now(),
 				CompressionBlockSize: c.CompressionBlockSize,
 				CompressionLevel:     c.CompressionLevel,
+				CompressionCodec:     c.CompressionCodec,
+				Outputs:              c.Outputs,
+				OutputBufferSize:     c.OutputBufferSize,
+				ParquetRowGroupSize:  c.ParquetRowGroupSize,
+				ParquetCompression:   c.ParquetCompression,
+				Zeek:                 c.Zeek,
+				ZeekJSON:             c.ZeekJSON,
+				Eve:                  c.Eve,
+				CommunityIDSeed:      c.CommunityIDSeed,
+
+				IPFIX:                  c.IPFIX,
+				IPFIXCollector:         c.IPFIXCollector,
+				IPFIXVersion:           c.IPFIXVersion,
+				IPFIXEnterpriseNumber:  c.IPFIXEnterpriseNumber,
+				IPFIXObservationDomain: c.IPFIXObservationDomain,
+				ConnTimeOut:            c.ConnTimeOut,
+
+				SQLite:          c.SQLite,
+				SQLiteBatchSize: c.SQLiteBatchSize,
+
+				RotateSize:     c.RotateSize,
+				RotateInterval: c.RotateInterval,
+				RetentionSize:  c.RetentionSize,
+
+				Index:          c.Index,
+				IndexBlockSize: c.IndexBlockSize,
 			})
 			dec.SetWriter(w)
 
@@ -208,14 +249,3 @@ func InitDecoders(c *config.Config) (decoders []core.StreamDecoderAPI, err error
 
 	return decoders, nil
 }
-
-// isStreamDecoderLoaded checks if an abstract decoder is loaded.
-func isStreamDecoderLoaded(name string) bool {
-	for _, e := range DefaultStreamDecoders {
-		if e.GetName() == name {
-			return true
-		}
-	}
-
-	return false
-}
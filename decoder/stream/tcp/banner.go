@@ -3,17 +3,16 @@ package tcp
 import (
 	"strconv"
 
-	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
 	"github.com/dreadl0ck/netcap/decoder/stream/service"
-	streamutils "github.com/dreadl0ck/netcap/decoder/stream/utils"
 	"github.com/dreadl0ck/netcap/resolvers"
 	"github.com/dreadl0ck/netcap/utils"
 )
 
 // saveTCPServiceBanner saves the banner for a TCP service to the filesystem
 // and limits the length of the saved data to the BannerSize value from the config.
-func saveTCPServiceBanner(s streamReader) {
-	if service.Decoder.Writer == nil {
+func (factory *StreamFactory) saveTCPServiceBanner(s streamReader) {
+	services := factory.decoders.Service
+	if services.Writer == nil {
 		return
 	}
 
@@ -25,8 +24,8 @@ func saveTCPServiceBanner(s streamReader) {
 	banner := s.ServiceBanner()
 
 	// limit length of data
-	if len(banner) >= decoderconfig.Instance.BannerSize {
-		banner = banner[:decoderconfig.Instance.BannerSize]
+	if len(banner) >= factory.conf.BannerSize {
+		banner = banner[:factory.conf.BannerSize]
 	}
 
 	ident := s.Ident()
@@ -34,13 +33,13 @@ func saveTCPServiceBanner(s streamReader) {
 	// check if we already have a banner for the IP + Port combination
 	// if multiple services have communicated with the service, we will just add the current flow
 	// we will keep the first banner that reaches the size configured in c.BannerSize
-	service.Store.Lock()
-	if sv, ok := service.Store.Items[s.ServiceIdent()]; ok {
-		defer service.Store.Unlock()
+	services.Store.Lock()
+	if sv, ok := services.Store.Items[s.ServiceIdent()]; ok {
+		defer services.Store.Unlock()
 
 		// invoke the service probe matching on all streams towards this service
 		// TODO: make matching more banners than the first one configurable
-		service.MatchServiceProbes(sv, banner, s.Ident())
+		services.MatchServiceProbes(sv, banner, s.Ident())
 
 		// ensure we don't duplicate any flows
 		for _, f := range sv.Flows {
@@ -62,13 +61,14 @@ func saveTCPServiceBanner(s streamReader) {
 
 		return
 	}
-	service.Store.Unlock()
+	services.Store.Unlock()
 
 	// nope. lets create a new one
 	serv := service.NewService(s.FirstPacket().UnixNano(), s.NumBytes(), s.Client().NumBytes(), s.Network().Dst().String())
 	serv.Banner = string(banner)
 	serv.IP = s.Network().Dst().String()
 	serv.Port = utils.DecodePort(s.Transport().Dst().Raw())
+	serv.Tunnel = s.Tunnel()
 
 	// set flow ident, h.parent.ident is the client flow
 	serv.Flows = []string{s.Ident()}
@@ -79,14 +79,14 @@ func saveTCPServiceBanner(s streamReader) {
 		serv.Name = resolvers.LookupServiceByPort(dst, "TCP")
 	}
 
-	service.MatchServiceProbes(serv, banner, s.Ident())
+	services.MatchServiceProbes(serv, banner, s.Ident())
 
 	// add new service
-	service.Store.Lock()
-	service.Store.Items[s.ServiceIdent()] = serv
-	service.Store.Unlock()
+	services.Store.Lock()
+	services.Store.Items[s.ServiceIdent()] = serv
+	services.Store.Unlock()
 
-	streamutils.Stats.Lock()
-	streamutils.Stats.NumServices++
-	streamutils.Stats.Unlock()
+	factory.decoders.Stats.Lock()
+	factory.decoders.Stats.NumServices++
+	factory.decoders.Stats.Unlock()
 }
@@ -0,0 +1,57 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package tcp
+
+import (
+	"github.com/dreadl0ck/gopacket"
+	"github.com/dreadl0ck/gopacket/layers"
+	"go.uber.org/zap"
+)
+
+// DefragIPv4 passes a fragmented IPv4 packet to the defragmenter.
+// It returns the reassembled packet, or nil if the datagram is not yet complete or has been discarded.
+// Packets that are not fragmented are returned unchanged.
+// The defragmenter is shared by the shards of the factory, fragments of a datagram may be passed in from different goroutines.
+func (factory *StreamFactory) DefragIPv4(packet gopacket.Packet) gopacket.Packet {
+	ip4, ok := packet.Layer(layers.LayerTypeIPv4).(*layers.IPv4)
+	if !ok || (ip4.Flags&layers.IPv4MoreFragments == 0 && ip4.FragOffset == 0) {
+		return packet
+	}
+
+	newip4, err := factory.defragger.DefragIPv4WithTimestamp(ip4, packet.Metadata().Timestamp)
+	if err != nil {
+		reassemblyLog.Debug("discarded IPv4 fragments", zap.Error(err))
+
+		return nil
+	} else if newip4 == nil {
+		reassemblyLog.Debug("fragment received...")
+
+		return nil
+	}
+
+	factory.decoders.Stats.Lock()
+	factory.decoders.Stats.IPdefrag++
+	factory.decoders.Stats.Unlock()
+
+	reassemblyLog.Debug("decoding re-assembled packet", zap.String("layer", newip4.NextLayerType().String()))
+
+	p, err := newDatagramPacket(newip4, newip4.Payload, layers.LayerTypeIPv4, packet.Metadata().CaptureInfo)
+	if err != nil {
+		reassemblyLog.Error("failed to decode re-assembled IPv4 packet", zap.Error(err))
+
+		return nil
+	}
+
+	return p
+}
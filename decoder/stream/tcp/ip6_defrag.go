@@ -0,0 +1,424 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package tcp
+
+import (
+	"bytes"
+	"container/list"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dreadl0ck/gopacket"
+	"github.com/dreadl0ck/gopacket/layers"
+	"go.uber.org/zap"
+
+	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
+	"github.com/dreadl0ck/netcap/defaults"
+	"github.com/dreadl0ck/netcap/types"
+)
+
+// ipv6MaximumSize is the maximum size of a reassembled datagram payload.
+// Reference RFC 8200, section 4.5
+const ipv6MaximumSize = 65535
+
+// errors for fragments that are typical for evasion attempts, the affected datagram is discarded.
+var (
+	errIPv6FragmentOverlap  = errors.New("overlapping IPv6 fragments")
+	errIPv6TinyFragment     = errors.New("tiny IPv6 fragment")
+	errIPv6FragmentTooLarge = errors.New("reassembled IPv6 datagram exceeds maximum size")
+	errIPv6FragmentLength   = errors.New("IPv6 fragment length is not a multiple of 8")
+	errIPv6FragmentTooMany  = errors.New("too many IPv6 fragments for datagram")
+	errIPv6FragmentEnd      = errors.New("IPv6 fragment exceeds the end of the datagram")
+)
+
+// ipv6FragmentKey identifies a fragmented datagram.
+type ipv6FragmentKey struct {
+	src, dst [16]byte
+	id       uint32
+}
+
+// ipv6Fragment is a single fragment of a datagram.
+type ipv6Fragment struct {
+	offset int
+	data   []byte
+}
+
+// ipv6Datagram holds the fragments received so far for a datagram.
+type ipv6Datagram struct {
+	key        ipv6FragmentKey
+	fragments  []ipv6Fragment
+	nextHeader layers.IPProtocol
+	header     layers.IPv6
+
+	// total size of the payload, known once the last fragment has been received
+	total int
+
+	// number of bytes buffered for this datagram
+	size int
+
+	firstSeen time.Time
+	element   *list.Element
+}
+
+// ipv6Defragmenter reassembles fragmented IPv6 datagrams,
+// it is safe for concurrent use.
+type ipv6Defragmenter struct {
+	sync.Mutex
+
+	datagrams map[ipv6FragmentKey]*ipv6Datagram
+
+	// datagrams ordered by their first seen timestamp, used for expiry and eviction
+	order *list.List
+
+	// number of bytes buffered for all datagrams
+	size int
+
+	// time after which incomplete datagrams are discarded
+	timeout time.Duration
+
+	// back out if we get more than this many fragments for a single datagram
+	maxFragments int
+
+	// maximum number of datagrams that are reassembled at the same time
+	maxDatagrams int
+
+	// maximum number of bytes buffered for all incomplete datagrams
+	maxBufferedBytes int
+
+	// fragments except the last one smaller than this are considered tiny,
+	// legit senders fragment to at least the IPv6 minimum MTU of 1280 bytes.
+	tinyFragmentSize int
+
+	// number of datagrams dropped due to timeouts or memory limits
+	numExpired int64
+	numEvicted int64
+}
+
+// newIPv6Defragmenter creates a defragmenter with the limits from the configuration,
+// limits that are not set use the defaults.
+func newIPv6Defragmenter(conf *decoderconfig.Config) *ipv6Defragmenter {
+	d := &ipv6Defragmenter{
+		datagrams:        make(map[ipv6FragmentKey]*ipv6Datagram),
+		order:            list.New(),
+		timeout:          conf.DefragIPv6Timeout,
+		maxFragments:     conf.DefragIPv6MaxFragments,
+		maxDatagrams:     conf.DefragIPv6MaxDatagrams,
+		maxBufferedBytes: conf.DefragIPv6BufferSize,
+		tinyFragmentSize: conf.DefragIPv6TinyFragmentSize,
+	}
+
+	if d.timeout <= 0 {
+		d.timeout = defaults.DefragIPv6Timeout
+	}
+
+	if d.maxFragments <= 0 {
+		d.maxFragments = defaults.DefragIPv6MaxFragments
+	}
+
+	if d.maxDatagrams <= 0 {
+		d.maxDatagrams = defaults.DefragIPv6MaxDatagrams
+	}
+
+	if d.maxBufferedBytes <= 0 {
+		d.maxBufferedBytes = defaults.DefragIPv6BufferSize
+	}
+
+	if d.tinyFragmentSize <= 0 {
+		d.tinyFragmentSize = defaults.DefragIPv6TinyFragmentSize
+	}
+
+	return d
+}
+
+// defragIPv6 takes an IPv6 packet and its fragment header.
+//
+// If the fragment completes a datagram, a new IPv6 layer carrying the reassembled payload is returned,
+// with the next header set to the protocol of the payload.
+// If more fragments are needed, nil is returned and the fragment is buffered.
+// An error is returned for fragments that are invalid or typical for evasion attempts,
+// in this case all fragments of the datagram are discarded.
+func (d *ipv6Defragmenter) defragIPv6(ip6 *layers.IPv6, frag *layers.IPv6Fragment, ts time.Time) (*layers.IPv6, error) {
+	var (
+		offset = int(frag.FragmentOffset) * 8
+		data   = frag.Payload
+	)
+
+	// atomic fragments are processed in isolation, see RFC 6946
+	if offset == 0 && !frag.MoreFragments {
+		return reassembledIPv6(ip6, frag.NextHeader, data), nil
+	}
+
+	if frag.MoreFragments && len(data)%8 != 0 {
+		return nil, errIPv6FragmentLength
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	d.expire(ts)
+
+	key := ipv6FragmentKey{id: frag.Identification}
+	copy(key.src[:], ip6.SrcIP.To16())
+	copy(key.dst[:], ip6.DstIP.To16())
+
+	dg, ok := d.datagrams[key]
+	if !ok {
+		if len(d.datagrams) >= d.maxDatagrams {
+			d.evictOldest()
+		}
+
+		dg = &ipv6Datagram{
+			key:       key,
+			total:     -1,
+			firstSeen: ts,
+		}
+		dg.element = d.order.PushBack(dg)
+		d.datagrams[key] = dg
+	}
+
+	// tiny fragments are used to split the upper layer header across fragments
+	if frag.MoreFragments && len(data) < d.tinyFragmentSize {
+		d.remove(dg)
+
+		return nil, errIPv6TinyFragment
+	}
+
+	if offset+len(data) > ipv6MaximumSize {
+		d.remove(dg)
+
+		return nil, errIPv6FragmentTooLarge
+	}
+
+	if len(dg.fragments) >= d.maxFragments {
+		d.remove(dg)
+
+		return nil, errIPv6FragmentTooMany
+	}
+
+	// overlapping fragments must lead to discarding the datagram, see RFC 5722
+	for _, f := range dg.fragments {
+		if offset < f.offset+len(f.data) && f.offset < offset+len(data) {
+			// exact duplicates are retransmissions and can be ignored
+			if offset == f.offset && bytes.Equal(data, f.data) {
+				return nil, nil
+			}
+
+			d.remove(dg)
+
+			return nil, errIPv6FragmentOverlap
+		}
+	}
+
+	// no fragment may exceed the end of the datagram, as indicated by the last fragment
+	end := offset + len(data)
+	if !frag.MoreFragments {
+		for _, f := range dg.fragments {
+			if f.offset+len(f.data) > end {
+				d.remove(dg)
+
+				return nil, errIPv6FragmentEnd
+			}
+		}
+
+		if dg.total >= 0 && dg.total != end {
+			d.remove(dg)
+
+			return nil, errIPv6FragmentEnd
+		}
+
+		dg.total = end
+	} else if dg.total >= 0 && end > dg.total {
+		d.remove(dg)
+
+		return nil, errIPv6FragmentEnd
+	}
+
+	// the first fragment determines the header and the protocol of the reassembled payload
+	if offset == 0 {
+		dg.header = *ip6
+		dg.nextHeader = frag.NextHeader
+	}
+
+	// fragment data must be copied, since the packet buffer might be reused
+	dg.fragments = append(dg.fragments, ipv6Fragment{
+		offset: offset,
+		data:   append([]byte(nil), data...),
+	})
+	dg.size += len(data)
+	d.size += len(data)
+
+	for d.size > d.maxBufferedBytes && d.order.Len() > 1 {
+		d.evictOldest()
+	}
+
+	if _, ok = d.datagrams[key]; !ok {
+		// datagram was evicted to stay within the memory limit
+		return nil, nil
+	}
+
+	return d.assemble(dg), nil
+}
+
+// assemble returns the reassembled datagram if all fragments have been received.
+func (d *ipv6Defragmenter) assemble(dg *ipv6Datagram) *layers.IPv6 {
+	if dg.total < 0 || dg.size != dg.total {
+		return nil
+	}
+
+	sort.Slice(dg.fragments, func(i, j int) bool {
+		return dg.fragments[i].offset < dg.fragments[j].offset
+	})
+
+	// fragments do not overlap and do not exceed the end of the datagram,
+	// so matching sizes mean there are no holes.
+	payload := make([]byte, 0, dg.total)
+	for _, f := range dg.fragments {
+		payload = append(payload, f.data...)
+	}
+
+	d.remove(dg)
+
+	return reassembledIPv6(&dg.header, dg.nextHeader, payload)
+}
+
+// expire discards all datagrams that have not been completed within the timeout.
+func (d *ipv6Defragmenter) expire(ts time.Time) {
+	for e := d.order.Front(); e != nil; e = d.order.Front() {
+		dg := e.Value.(*ipv6Datagram)
+		if ts.Sub(dg.firstSeen) < d.timeout {
+			return
+		}
+
+		d.numExpired++
+		d.remove(dg)
+	}
+}
+
+// evictOldest discards the datagram that has been pending for the longest time.
+func (d *ipv6Defragmenter) evictOldest() {
+	if e := d.order.Front(); e != nil {
+		d.numEvicted++
+		d.remove(e.Value.(*ipv6Datagram))
+	}
+}
+
+func (d *ipv6Defragmenter) remove(dg *ipv6Datagram) {
+	d.order.Remove(dg.element)
+	delete(d.datagrams, dg.key)
+	d.size -= dg.size
+}
+
+// reassembledIPv6 returns a copy of the header, that carries the provided payload.
+func reassembledIPv6(ip6 *layers.IPv6, next layers.IPProtocol, payload []byte) *layers.IPv6 {
+	return &layers.IPv6{
+		BaseLayer:    layers.BaseLayer{Payload: payload},
+		Version:      ip6.Version,
+		TrafficClass: ip6.TrafficClass,
+		FlowLabel:    ip6.FlowLabel,
+		Length:       uint16(len(payload)),
+		NextHeader:   next,
+		HopLimit:     ip6.HopLimit,
+		SrcIP:        ip6.SrcIP,
+		DstIP:        ip6.DstIP,
+	}
+}
+
+// newDatagramPacket serializes the reassembled IP datagram and decodes it as a new packet,
+// that keeps the capture information of the packet that completed the datagram.
+func newDatagramPacket(ip gopacket.SerializableLayer, payload []byte, first gopacket.LayerType, ci gopacket.CaptureInfo) (gopacket.Packet, error) {
+	buf := gopacket.NewSerializeBuffer()
+
+	err := gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}, ip, gopacket.Payload(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	p := gopacket.NewPacket(buf.Bytes(), first, gopacket.Default)
+
+	md := p.Metadata()
+	md.CaptureInfo = ci
+	md.CaptureLength = len(buf.Bytes())
+	md.Length = len(buf.Bytes())
+
+	return p, nil
+}
+
+// DefragIPv6 passes a fragmented IPv6 packet to the defragmenter.
+// It returns the reassembled packet, or nil if the datagram is not yet complete or has been discarded.
+// Packets that are not fragmented are returned unchanged.
+// The defragmenter is shared by the shards of the factory, fragments of a datagram may be passed in from different goroutines.
+func (factory *StreamFactory) DefragIPv6(packet gopacket.Packet) gopacket.Packet {
+	frag, ok := packet.Layer(layers.LayerTypeIPv6Fragment).(*layers.IPv6Fragment)
+	if !ok {
+		return packet
+	}
+
+	ip6, ok := packet.Layer(layers.LayerTypeIPv6).(*layers.IPv6)
+	if !ok {
+		return nil
+	}
+
+	ts := packet.Metadata().Timestamp
+
+	newip6, err := factory.defragger6.defragIPv6(ip6, frag, ts)
+	if err != nil {
+		reassemblyLog.Debug("discarded IPv6 fragments", zap.Error(err))
+		factory.writeIPv6FragmentAlert(err, ip6, ts)
+
+		return nil
+	} else if newip6 == nil {
+		reassemblyLog.Debug("IPv6 fragment received...")
+
+		return nil
+	}
+
+	factory.decoders.Stats.Lock()
+	factory.decoders.Stats.IP6defrag++
+	factory.decoders.Stats.Unlock()
+
+	reassemblyLog.Debug("decoding re-assembled IPv6 packet", zap.String("layer", newip6.NextHeader.String()))
+
+	p, err := newDatagramPacket(newip6, newip6.Payload, layers.LayerTypeIPv6, packet.Metadata().CaptureInfo)
+	if err != nil {
+		reassemblyLog.Error("failed to decode re-assembled IPv6 packet", zap.Error(err))
+
+		return nil
+	}
+
+	return p
+}
+
+// writeIPv6FragmentAlert emits an alert for a fragment that was discarded by the defragmenter.
+func (factory *StreamFactory) writeIPv6FragmentAlert(err error, ip6 *layers.IPv6, ts time.Time) {
+	factory.decoders.Stats.Lock()
+	factory.decoders.Stats.IP6FragmentAnomalies++
+	factory.decoders.Stats.Unlock()
+
+	// alert decoder has been disabled
+	if factory.decoders.Alert.Writer == nil {
+		return
+	}
+
+	factory.decoders.Alert.WriteAlert(&types.Alert{
+		Timestamp:   ts.UnixNano(),
+		Name:        "IPv6 Fragmentation Anomaly",
+		Description: "discarded fragmented datagram: " + err.Error(),
+		SrcIP:       ip6.SrcIP.String(),
+		DstIP:       ip6.DstIP.String(),
+		Protocol:    layers.LayerTypeIPv6Fragment.String(),
+		Notes:       "overlapping or tiny fragments are typical for IDS evasion attempts",
+	})
+}
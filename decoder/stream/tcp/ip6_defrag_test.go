@@ -0,0 +1,142 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package tcp
+
+import (
+	"bytes"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/dreadl0ck/gopacket/layers"
+
+	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
+)
+
+var testIPv6 = &layers.IPv6{
+	Version:  6,
+	HopLimit: 64,
+	SrcIP:    net.ParseIP("2001:db8::1"),
+	DstIP:    net.ParseIP("2001:db8::2"),
+}
+
+func ipv6Frag(id uint32, offset int, more bool, data []byte) *layers.IPv6Fragment {
+	return &layers.IPv6Fragment{
+		BaseLayer:      layers.BaseLayer{Payload: data},
+		NextHeader:     layers.IPProtocolUDP,
+		FragmentOffset: uint16(offset / 8),
+		MoreFragments:  more,
+		Identification: id,
+	}
+}
+
+func TestIPv6DefragOutOfOrder(t *testing.T) {
+	var (
+		d      = newIPv6Defragmenter(&decoderconfig.Config{})
+		ts     = time.Now()
+		first  = bytes.Repeat([]byte{'a'}, 512)
+		second = bytes.Repeat([]byte{'b'}, 512)
+		last   = []byte("end")
+	)
+
+	for _, f := range []*layers.IPv6Fragment{
+		ipv6Frag(1, 1024, false, last),
+		ipv6Frag(1, 0, true, first),
+	} {
+		out, err := d.defragIPv6(testIPv6, f, ts)
+		if err != nil || out != nil {
+			t.Fatal("unexpected result for incomplete datagram", out, err)
+		}
+	}
+
+	out, err := d.defragIPv6(testIPv6, ipv6Frag(1, 512, true, second), ts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if out == nil {
+		t.Fatal("expected reassembled datagram")
+	}
+
+	if out.NextHeader != layers.IPProtocolUDP || int(out.Length) != len(out.Payload) {
+		t.Fatal("unexpected header for reassembled datagram", out.NextHeader, out.Length)
+	}
+
+	expected := append(append(append([]byte{}, first...), second...), last...)
+	if !bytes.Equal(out.Payload, expected) {
+		t.Fatal("unexpected payload for reassembled datagram")
+	}
+
+	if len(d.datagrams) != 0 || d.size != 0 {
+		t.Fatal("expected defragmenter state to be cleared")
+	}
+}
+
+func TestIPv6DefragEvasion(t *testing.T) {
+	var (
+		d  = newIPv6Defragmenter(&decoderconfig.Config{})
+		ts = time.Now()
+	)
+
+	// tiny fragment
+	if _, err := d.defragIPv6(testIPv6, ipv6Frag(1, 0, true, make([]byte, 8)), ts); err != errIPv6TinyFragment {
+		t.Fatal("expected tiny fragment error, got", err)
+	}
+
+	// overlapping fragments
+	if _, err := d.defragIPv6(testIPv6, ipv6Frag(2, 0, true, make([]byte, 512)), ts); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := d.defragIPv6(testIPv6, ipv6Frag(2, 256, false, make([]byte, 512)), ts); err != errIPv6FragmentOverlap {
+		t.Fatal("expected overlap error, got", err)
+	}
+
+	if len(d.datagrams) != 0 {
+		t.Fatal("expected datagram to be discarded")
+	}
+
+	// exact duplicates are ignored
+	if _, err := d.defragIPv6(testIPv6, ipv6Frag(3, 0, true, make([]byte, 512)), ts); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := d.defragIPv6(testIPv6, ipv6Frag(3, 0, true, make([]byte, 512)), ts); err != nil {
+		t.Fatal("expected duplicate to be ignored, got", err)
+	}
+}
+
+func TestIPv6DefragTimeout(t *testing.T) {
+	var (
+		d  = newIPv6Defragmenter(&decoderconfig.Config{DefragIPv6Timeout: time.Second})
+		ts = time.Now()
+	)
+
+	if _, err := d.defragIPv6(testIPv6, ipv6Frag(1, 0, true, make([]byte, 512)), ts); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := d.defragIPv6(testIPv6, ipv6Frag(1, 512, false, []byte("x")), ts.Add(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if out != nil {
+		t.Fatal("expected expired datagram not to be reassembled")
+	}
+
+	if d.numExpired != 1 {
+		t.Fatal("expected 1 expired datagram, got", d.numExpired)
+	}
+}
@@ -0,0 +1,73 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package tcp
+
+import (
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/dreadl0ck/netcap/reassembly"
+	"github.com/dreadl0ck/netcap/types"
+)
+
+// NewAssembler creates a new assembler with its own shard of the stream pool,
+// that applies the configured overlap policies and reports inconsistent retransmissions.
+func (factory *StreamFactory) NewAssembler() *reassembly.Assembler {
+	a := reassembly.NewAssembler(factory.StreamPool.Shard())
+	a.OverlapPolicies = factory.OverlapPolicies
+	a.OnInconsistentRetransmission = factory.writeInconsistentRetransmissionAlert
+
+	return a
+}
+
+// writeInconsistentRetransmissionAlert emits an alert for a retransmitted segment,
+// that carries different data than the segment that was buffered for the same sequence numbers.
+func (factory *StreamFactory) writeInconsistentRetransmissionAlert(r *reassembly.InconsistentRetransmission) {
+	factory.decoders.Stats.Lock()
+	factory.decoders.Stats.InconsistentRetransmissions++
+	factory.decoders.Stats.Unlock()
+
+	reassemblyLog.Debug("inconsistent retransmission",
+		zap.String("net", r.NetFlow.String()),
+		zap.String("transport", r.TCPFlow.String()),
+		zap.Int64("seq", int64(r.Seq)),
+		zap.Int("length", len(r.Original)),
+		zap.String("policy", r.Policy.String()),
+	)
+
+	// alert decoder has been disabled
+	if factory.decoders.Alert.Writer == nil {
+		return
+	}
+
+	kept := "original"
+	if r.Replaced {
+		kept = "retransmitted"
+	}
+
+	factory.decoders.Alert.WriteAlert(&types.Alert{
+		Timestamp:            r.CaptureInfo.Timestamp.UnixNano(),
+		Name:                 "Inconsistent TCP Retransmission",
+		Description:          "retransmitted segment carries different data than the buffered segment",
+		SrcIP:                r.NetFlow.Src().String(),
+		SrcPort:              r.TCPFlow.Src().String(),
+		DstIP:                r.NetFlow.Dst().String(),
+		DstPort:              r.TCPFlow.Dst().String(),
+		Protocol:             "TCP",
+		Notes:                "seq " + strconv.FormatInt(int64(r.Seq), 10) + ", " + strconv.Itoa(len(r.Original)) + " bytes, overlap policy " + r.Policy.String() + " kept the " + kept + " data",
+		OriginalPayload:      r.Original,
+		RetransmittedPayload: r.Retransmitted,
+	})
+}
@@ -0,0 +1,45 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package tcp
+
+import (
+	"github.com/dreadl0ck/netcap/decoder/stream/udp"
+	"github.com/dreadl0ck/netcap/reassembly"
+)
+
+// Shard holds the reassembly state owned by a single worker.
+// All packets of a flow must be passed to the same shard,
+// since the connection tables are not shared between shards.
+// A shard must only be used from a single goroutine.
+type Shard struct {
+	// Assembler for TCP, with its own connection table
+	Assembler *reassembly.Assembler
+
+	// UDPStreams tracks the UDP conversations of the shard
+	UDPStreams *udp.StreamPool
+
+	// number of TCP packets seen by the shard, used to flush in intervals
+	count int64
+
+	factory *StreamFactory
+}
+
+// NewShard creates a new shard with its own assembler and UDP stream pool.
+func (factory *StreamFactory) NewShard() *Shard {
+	return &Shard{
+		Assembler:  factory.NewAssembler(),
+		UDPStreams: factory.udp.NewStreamPool(),
+		factory:    factory,
+	}
+}
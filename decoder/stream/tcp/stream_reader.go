@@ -19,6 +19,7 @@ import (
 	"github.com/dreadl0ck/gopacket"
 
 	"github.com/dreadl0ck/netcap/decoder/core"
+	"github.com/dreadl0ck/netcap/types"
 )
 
 // streamReader is an interface for processing a uni-directional stream of TCP network data
@@ -30,7 +31,7 @@ type streamReader interface {
 	Read(p []byte) (int, error)
 
 	// Run starts processing the stream.
-	Run(f *connectionFactory)
+	Run(f *StreamFactory)
 
 	// DataChan returns a channel for sending stream data.
 	DataChan() chan *core.StreamData
@@ -39,7 +40,7 @@ type streamReader interface {
 	DataSlice() core.DataFragments
 
 	// Cleanup will tear down the stream processing.
-	Cleanup(f *connectionFactory)
+	Cleanup(f *StreamFactory)
 
 	// ClientStream will return the client side of the stream.
 	ClientStream() []byte
@@ -68,6 +69,9 @@ type streamReader interface {
 	// FirstPacket returns the timestamp of the first packet seen.
 	FirstPacket() time.Time
 
+	// Tunnel returns the tunnel the stream was decapsulated from, if any.
+	Tunnel() *types.Tunnel
+
 	// Saved indicates whether the stream has already been persisted on disk.
 	Saved() bool
 
@@ -23,6 +23,7 @@ import (
 	"sort"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
@@ -31,14 +32,12 @@ import (
 	"github.com/evilsocket/islazy/tui"
 	"go.uber.org/zap"
 
-	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
 	"github.com/dreadl0ck/netcap/decoder/core"
-	"github.com/dreadl0ck/netcap/decoder/stream"
-	"github.com/dreadl0ck/netcap/decoder/stream/udp"
 	streamutils "github.com/dreadl0ck/netcap/decoder/stream/utils"
 	"github.com/dreadl0ck/netcap/defaults"
 	"github.com/dreadl0ck/netcap/dpi"
 	"github.com/dreadl0ck/netcap/reassembly"
+	"github.com/dreadl0ck/netcap/types"
 	"github.com/dreadl0ck/netcap/utils"
 )
 
@@ -49,14 +48,6 @@ var (
 	reassemblyLogFileHandle *os.File
 )
 
-// NumSavedTCPConns returns the number of saved TCP connections.
-func NumSavedTCPConns() int64 {
-	streamutils.Stats.Lock()
-	defer streamutils.Stats.Unlock()
-
-	return streamutils.Stats.SavedTCPConnections
-}
-
 /*
  * TCP Connection
  */
@@ -74,35 +65,59 @@ type tcpConnection struct {
 	merged      core.DataFragments
 	firstPacket time.Time
 
+	// tunnel the connection was decapsulated from, if any
+	tunnel *types.Tunnel
+
+	// number of packets with data reassembled for the connection, modified atomically
+	numPackets int64
+
 	client streamReader
 	server streamReader
 
+	// tracks fragments passed to the stream readers, that have not been added to their data yet
+	fragments sync.WaitGroup
+
 	ident    string
+	factory  *StreamFactory
 	decoder  core.StreamDecoderInterface
 	tcpstate *reassembly.TCPSimpleFSM
 
+	// incremental decoding: fragments are held back until both sides sent data and a decoder was selected
+	pending     []*core.StreamData
+	pendingSize int
+	incremental core.IncrementalStreamDecoderInterface
+
 	wasMerged bool
 	fsmerr    bool
+
+	// set once the decision for incremental decoding has been made
+	selected bool
+
+	// application protocol identified by the pure Go classifier
+	protocol   dpi.Result
+	classified bool
 }
 
 // Accept decides whether the TCP packet should be accepted
 // start could be modified to force a start even if no SYN have been seen.
 func (t *tcpConnection) Accept(tcp *layers.TCP, dir reassembly.TCPFlowDirection, nextSeq reassembly.Sequence) bool {
+	stats := t.factory.decoders.Stats
+
 	// Finite State Machine
 	if !t.tcpstate.CheckState(tcp, dir) {
 
 		reassemblyLog.Debug("packet rejected by FSM", zap.String("ident", t.ident), zap.String("state", t.tcpstate.String()))
 
-		streamutils.Stats.Lock()
-		streamutils.Stats.RejectFsm++
+		stats.Lock()
+		stats.RejectFsm++
 
 		if !t.fsmerr {
 			t.fsmerr = true
-			streamutils.Stats.RejectConnFsm++
+			stats.RejectConnFsm++
 		}
-		streamutils.Stats.Unlock()
+		stats.Unlock()
 
-		if !decoderconfig.Instance.IgnoreFSMerr {
+		if !t.factory.conf.IgnoreFSMerr {
 			return false
 		}
 	}
@@ -111,11 +126,11 @@ func (t *tcpConnection) Accept(tcp *layers.TCP, dir reassembly.TCPFlowDirection,
 	err := t.optchecker.Accept(tcp, dir, nextSeq)
 	if err != nil {
 		reassemblyLog.Debug("packet rejected by OptionChecker", zap.String("ident", t.ident), zap.Error(err))
-		streamutils.Stats.Lock()
-		streamutils.Stats.RejectOpt++
-		streamutils.Stats.Unlock()
+		stats.Lock()
+		stats.RejectOpt++
+		stats.Unlock()
 
-		if !decoderconfig.Instance.NoOptCheck {
+		if !t.factory.conf.NoOptCheck {
 			return false
 		}
 	}
@@ -123,7 +138,7 @@ func (t *tcpConnection) Accept(tcp *layers.TCP, dir reassembly.TCPFlowDirection,
 	// TCP Checksum
 	accept := true
 
-	if decoderconfig.Instance.Checksum {
+	if t.factory.conf.Checksum {
 		chk, errChk := tcp.ComputeChecksum()
 		if errChk != nil {
 			reassemblyLog.Debug("error computing checksum", zap.String("ident", t.ident), zap.Error(errChk))
@@ -138,36 +153,40 @@ func (t *tcpConnection) Accept(tcp *layers.TCP, dir reassembly.TCPFlowDirection,
 
 	// stats
 	if !accept {
-		streamutils.Stats.Lock()
-		streamutils.Stats.RejectOpt++
-		streamutils.Stats.Unlock()
+		stats.Lock()
+		stats.RejectOpt++
+		stats.Unlock()
 	}
 
 	return accept
 }
 
 func (t *tcpConnection) updateStats(sg reassembly.ScatterGather, skip int, length int, saved int, start bool, end bool, dir reassembly.TCPFlowDirection) {
+	stats := t.factory.decoders.Stats
+
 	sgStats := sg.Stats()
 
-	streamutils.Stats.Lock()
+	atomic.AddInt64(&t.numPackets, int64(sgStats.Packets))
+
+	stats.Lock()
 	if skip > 0 {
-		streamutils.Stats.MissedBytes += int64(skip)
+		stats.MissedBytes += int64(skip)
 	}
 
-	streamutils.Stats.Sz += int64(length - saved)
-	streamutils.Stats.Pkt += int64(sgStats.Packets)
+	stats.Sz += int64(length - saved)
+	stats.Pkt += int64(sgStats.Packets)
 	if sgStats.Chunks > 1 {
-		streamutils.Stats.Reassembled++
+		stats.Reassembled++
 	}
-	streamutils.Stats.OutOfOrderPackets += int64(sgStats.QueuedPackets)
-	streamutils.Stats.OutOfOrderBytes += int64(sgStats.QueuedBytes)
+	stats.OutOfOrderPackets += int64(sgStats.QueuedPackets)
+	stats.OutOfOrderBytes += int64(sgStats.QueuedBytes)
 
-	if int64(length) > streamutils.Stats.BiggestChunkBytes {
-		streamutils.Stats.BiggestChunkBytes = int64(length)
+	if int64(length) > stats.BiggestChunkBytes {
+		stats.BiggestChunkBytes = int64(length)
 	}
 
-	if int64(sgStats.Packets) > streamutils.Stats.BiggestChunkPackets {
-		streamutils.Stats.BiggestChunkPackets = int64(sgStats.Packets)
+	if int64(sgStats.Packets) > stats.BiggestChunkPackets {
+		stats.BiggestChunkPackets = int64(sgStats.Packets)
 	}
 
 	if sgStats.OverlapBytes != 0 && sgStats.OverlapPackets == 0 {
@@ -177,9 +196,9 @@ func (t *tcpConnection) updateStats(sg reassembly.ScatterGather, skip int, lengt
 		)
 	}
 
-	streamutils.Stats.OverlapBytes += int64(sgStats.OverlapBytes)
-	streamutils.Stats.OverlapPackets += int64(sgStats.OverlapPackets)
-	streamutils.Stats.Unlock()
+	stats.OverlapBytes += int64(sgStats.OverlapBytes)
+	stats.OverlapPackets += int64(sgStats.OverlapPackets)
+	stats.Unlock()
 
 	var ident string
 	if dir == reassembly.TCPDirClientToServer {
@@ -217,24 +236,95 @@ func (t *tcpConnection) feedData(dir reassembly.TCPFlowDirection, data []byte, a
 
 	ti := time.Now()
 
+	sd := &core.StreamData{
+		RawData:          dataCpy,
+		AssemblerContext: ac,
+		Dir:              dir,
+	}
+
+	t.fragments.Add(1)
+
 	// pass data either to client or server
 	if dir == reassembly.TCPDirClientToServer {
-		t.client.DataChan() <- &core.StreamData{
-			RawData:          dataCpy,
-			AssemblerContext: ac,
-			Dir:              dir,
-		}
+		t.client.DataChan() <- sd
 	} else {
-		t.server.DataChan() <- &core.StreamData{
-			RawData:          dataCpy,
-			AssemblerContext: ac,
-			Dir:              dir,
-		}
+		t.server.DataChan() <- sd
+	}
+
+	if t.factory.conf.IncrementalStreamDecoding {
+		t.consume(sd)
 	}
 
 	tcpStreamFeedDataTime.WithLabelValues(dir.String()).Set(float64(time.Since(ti).Nanoseconds()))
 }
 
+// consume passes the data fragment to an incremental stream decoder.
+// The fragments are buffered until both sides of the connection sent data,
+// since the decoder is selected based on the first client and server fragment.
+// If the selected decoder does not support incremental decoding,
+// or the other side did not respond before the buffer size is exceeded,
+// the connection is decoded once it has been closed.
+func (t *tcpConnection) consume(data *core.StreamData) {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.incremental != nil {
+		t.incremental.Consume(data)
+
+		return
+	}
+
+	if t.selected {
+		return
+	}
+
+	t.pending = append(t.pending, data)
+	t.pendingSize += len(data.RawData)
+
+	var client, server *core.StreamData
+
+	for _, d := range t.pending {
+		if d.Dir == reassembly.TCPDirClientToServer {
+			if client == nil {
+				client = d
+			}
+		} else if server == nil {
+			server = d
+		}
+	}
+
+	if client == nil || server == nil {
+		if t.pendingSize > t.factory.conf.IncrementalStreamBufferSize {
+			t.selected = true
+			t.pending = nil
+		}
+
+		return
+	}
+
+	t.selected = true
+
+	conv := t.conversationInfo(nil, client.AssemblerContext.GetCaptureInfo().Timestamp, server.AssemblerContext.GetCaptureInfo().Timestamp)
+
+	// classify before the decoder consumes the data, the result is added to the records it produces
+	pending := make(core.DataFragments, len(t.pending))
+	for i, p := range t.pending {
+		pending[i] = p
+	}
+
+	t.classify(conv, pending)
+	if d, ok := t.selectStreamDecoder(conv, client.RawData, server.RawData).(core.IncrementalStreamDecoderInterface); ok {
+		t.decoder = d
+		t.incremental = d
+
+		for _, p := range t.pending {
+			d.Consume(p)
+		}
+	}
+
+	t.pending = nil
+}
+
 // ReassembledSG is called zero or more times and delivers the data for a stream
 // The ScatterGather buffer is reused after each Reassembled call
 // so it's important to copy anything you need out of it (or use KeepFrom()).
@@ -245,7 +335,7 @@ func (t *tcpConnection) ReassembledSG(sg reassembly.ScatterGather, ac reassembly
 	// update stats
 	t.updateStats(sg, skip, length, saved, startTime, end, dir)
 
-	if skip == -1 && decoderconfig.Instance.AllowMissingInit {
+	if skip == -1 && t.factory.conf.AllowMissingInit {
 		// this is allowed
 	} else if skip != 0 {
 		// Missing bytes in stream: do not even try to parse it
@@ -257,7 +347,7 @@ func (t *tcpConnection) ReassembledSG(sg reassembly.ScatterGather, ac reassembly
 	// fmt.Println("got raw data:", len(data), ac.GetCaptureInfo().Timestamp, "\n", hex.Dump(data))
 
 	if length > 0 {
-		if decoderconfig.Instance.HexDump {
+		if t.factory.conf.HexDump {
 			reassemblyLog.Debug("feeding stream reader",
 				zap.String("data", hex.Dump(data)),
 			)
@@ -327,6 +417,9 @@ func (t *tcpConnection) ReassemblyComplete(ac reassembly.AssemblerContext, first
 
 	ti := time.Now()
 
+	// the stream readers receive the data asynchronously, wait until they collected all fragments
+	t.fragments.Wait()
+
 	// save data for the current stream
 	if t.server != nil && !t.client.Saved() {
 		t.client.MarkSaved()
@@ -334,7 +427,7 @@ func (t *tcpConnection) ReassemblyComplete(ac reassembly.AssemblerContext, first
 		t.sortAndMergeFragments()
 
 		// save the full conversation to disk if enabled
-		err := streamutils.SaveConversation("TCP", t.merged, t.client.Ident(), t.client.FirstPacket(), t.client.Transport())
+		err := streamutils.SaveConversation(t.factory.conf, t.factory.decoders.Credentials, t.factory.decoders.Stats, "TCP", t.merged, t.client.Ident(), t.client.FirstPacket(), t.client.Transport(), t.tunnel)
 		if err != nil {
 			reassemblyLog.Error("failed to save stream", zap.Error(err), zap.String("ident", t.client.Ident()))
 		}
@@ -352,7 +445,7 @@ func (t *tcpConnection) ReassemblyComplete(ac reassembly.AssemblerContext, first
 		t.sortAndMergeFragments()
 
 		// server
-		saveTCPServiceBanner(t.server)
+		t.factory.saveTCPServiceBanner(t.server)
 		tcpStreamProcessingTime.WithLabelValues(reassembly.TCPDirServerToClient.String()).Set(float64(time.Since(ti).Nanoseconds()))
 	}
 
@@ -361,7 +454,7 @@ func (t *tcpConnection) ReassemblyComplete(ac reassembly.AssemblerContext, first
 	)
 
 	// optionally, do not remove the connection to allow last ACK
-	return decoderconfig.Instance.RemoveClosedStreams
+	return t.factory.conf.RemoveClosedStreams
 }
 
 func (t *tcpConnection) decode() {
@@ -369,44 +462,16 @@ func (t *tcpConnection) decode() {
 	t.Lock()
 	defer t.Unlock()
 
-	// choose the decoder to run against the data stream
-	var (
-		cr, sr = t.client.DataSlice().First(), t.server.DataSlice().First()
-		found  bool
-	)
+	conv := t.conversationInfo(t.merged, t.client.FirstPacket(), t.server.FirstPacket())
 
-	conv := &core.ConversationInfo{
-		Data:              t.merged,
-		Ident:             t.ident,
-		FirstClientPacket: t.client.FirstPacket(),
-		FirstServerPacket: t.server.FirstPacket(),
-		ClientIP:          t.client.Network().Src().String(),
-		ServerIP:          t.client.Network().Dst().String(),
-		ClientPort:        utils.DecodePort(t.client.Transport().Src().Raw()),
-		ServerPort:        utils.DecodePort(t.client.Transport().Dst().Raw()),
+	if !t.classified {
+		t.classify(conv, t.merged)
 	}
 
-	// make a good first guess based on the destination port of the connection
-	if sd, exists := stream.DefaultStreamDecoders[utils.DecodePort(t.server.Transport().Dst().Raw())]; exists {
-		if sd.Transport() == core.TCP || sd.Transport() == core.All {
-			if sd.GetReaderFactory() != nil && sd.CanDecodeStream(cr, sr) {
-				t.decoder = sd.GetReaderFactory().New(conv)
-				found = true
-			}
-		}
-	}
-
-	// if no stream decoder for the port was found, or the stream decoder did not match
-	// try all available decoders and use the first one that matches
-	if !found {
-		for _, sd := range stream.DefaultStreamDecoders {
-			if sd.Transport() == core.TCP || sd.Transport() == core.All {
-				if sd.GetReaderFactory() != nil && sd.CanDecodeStream(cr, sr) {
-					t.decoder = sd.GetReaderFactory().New(conv)
-					break
-				}
-			}
-		}
+	// the decoder has already been selected and consumed the data while the connection was reassembled
+	if t.incremental == nil {
+		// choose the decoder to run against the data stream
+		t.decoder = t.selectStreamDecoder(conv, t.client.DataSlice().First(), t.server.DataSlice().First())
 	}
 
 	// call the decoder if one was found
@@ -418,12 +483,52 @@ func (t *tcpConnection) decode() {
 
 		tcpStreamDecodeTime.WithLabelValues(reflect.TypeOf(t.decoder).String()).Set(float64(time.Since(ti).Nanoseconds()))
 	}
+
+	if t.protocol.Protocol != "" {
+		// the merged fragments are not collected when decoding incrementally, use the packet counter instead
+		numPackets := uint64(atomic.LoadInt64(&t.numPackets))
+
+		t.factory.packets.AddProtocol(conv.ClientIP, conv.Tunnel, t.protocol.Protocol, t.protocol.Category, numPackets)
+		t.factory.packets.AddProtocol(conv.ServerIP, conv.Tunnel, t.protocol.Protocol, t.protocol.Category, numPackets)
+		t.factory.decoders.Conversations.Remove(t.ident)
+	}
+}
+
+// classify identifies the application protocol of the conversation with the pure Go classifier, if it is enabled.
+func (t *tcpConnection) classify(conv *core.ConversationInfo, data core.DataFragments) {
+	t.classified = true
+	t.protocol, _ = streamutils.ClassifyConversation(t.factory.decoders.Conversations, t.factory.decoders.Stats, conv, dpi.TransportTCP, data)
+}
+
+// conversationInfo returns the conversation info passed to stream decoders.
+func (t *tcpConnection) conversationInfo(data core.DataFragments, firstClientPacket, firstServerPacket time.Time) *core.ConversationInfo {
+	return &core.ConversationInfo{
+		Data:              data,
+		Ident:             t.ident,
+		FirstClientPacket: firstClientPacket,
+		FirstServerPacket: firstServerPacket,
+		ClientIP:          t.client.Network().Src().String(),
+		ServerIP:          t.client.Network().Dst().String(),
+		ClientPort:        utils.DecodePort(t.client.Transport().Src().Raw()),
+		ServerPort:        utils.DecodePort(t.client.Transport().Dst().Raw()),
+		Tunnel:            t.tunnel,
+	}
 }
 
-var aMu sync.Mutex
+// selectStreamDecoder returns a new instance of the stream decoder for the conversation
+// based on the first client and server data fragments, or nil if none matched.
+func (t *tcpConnection) selectStreamDecoder(conv *core.ConversationInfo, cr, sr []byte) core.StreamDecoderInterface {
+	if sd := t.factory.decoders.SelectDecoder(conv, core.TCP, cr, sr); sd != nil {
+		return sd.GetReaderFactory().New(conv)
+	}
+
+	return nil
+}
 
-// ReassemblePacket takes care of submitting a TCP / UDP packet to the reassembly.
-func ReassemblePacket(packet gopacket.Packet, assembler *reassembly.Assembler) {
+// ReassemblePacket takes care of submitting a TCP / UDP packet to the reassembly state of the shard.
+// All packets of a flow must be passed to the same shard.
+func ReassemblePacket(packet gopacket.Packet, shard *Shard) {
+	stats := shard.factory.decoders.Stats
 
 	// TODO: make transport layer reassembler configurable
 	// prevent passing any non TCP packets in here
@@ -433,113 +538,65 @@ func ReassemblePacket(packet gopacket.Packet, assembler *reassembly.Assembler) {
 		// handle UDP stream reconstruction
 		udpLayer := packet.Layer(layers.LayerTypeUDP)
 		if udpLayer != nil {
-			udp.Streams.HandleUDP(packet, udpLayer)
+			shard.UDPStreams.HandleUDP(packet, udpLayer)
 		}
 
 		return
 	}
 
 	// lock to sync with read on destroy
-	streamutils.Stats.Lock()
-	streamutils.Stats.Count++
-	streamutils.Stats.DataBytes += int64(len(packet.Data()))
-	streamutils.Stats.Unlock()
-
-	// defrag the IPv4 packet if desired
-	ip4Layer := packet.Layer(layers.LayerTypeIPv4)
-	if ip4Layer != nil && decoderconfig.Instance.DefragIPv4 {
-
-		var (
-			ip4         = ip4Layer.(*layers.IPv4)
-			l           = ip4.Length
-			newip4, err = StreamFactory.defragger.DefragIPv4(ip4)
-		)
+	stats.Lock()
+	stats.Count++
+	stats.DataBytes += int64(len(packet.Data()))
+	stats.Unlock()
 
-		if err != nil {
-			log.Fatalln("error while de-fragmenting", err)
-		} else if newip4 == nil {
-			reassemblyLog.Debug("fragment received...")
-
-			return
-		}
-
-		if newip4.Length != l {
-			streamutils.Stats.IPdefrag++
-
-			reassemblyLog.Debug("decoding re-assembled packet", zap.String("layer", newip4.NextLayerType().String()))
-
-			pb, ok := packet.(gopacket.PacketBuilder)
-			if !ok {
-				panic("Not a PacketBuilder")
-			}
-
-			nextDecoder := newip4.NextLayerType()
-			if err = nextDecoder.Decode(newip4.Payload, pb); err != nil {
-				fmt.Println("failed to decode ipv4:", err)
-			}
-		}
-	}
+	shard.count++
 
 	tcp := tcpLayer.(*layers.TCP)
 
-	if decoderconfig.Instance.Checksum {
+	if shard.factory.conf.Checksum {
 		err := tcp.SetNetworkLayerForChecksum(packet.NetworkLayer())
 		if err != nil {
 			log.Fatalf("Failed to set network layer for checksum: %s\n", err)
 		}
 	}
 
-	streamutils.Stats.Lock()
-	streamutils.Stats.Totalsz += int64(len(tcp.Payload))
-	streamutils.Stats.Unlock()
+	stats.Lock()
+	stats.Totalsz += int64(len(tcp.Payload))
+	stats.Unlock()
 
 	// for debugging:
-	// assembleWithContextTimeout(packet, assembler, tcp)
-	aMu.Lock()
-	assembler.AssembleWithContext(packet.NetworkLayer().NetworkFlow(), tcp, &context{
-		CaptureInfo: packet.Metadata().CaptureInfo,
-	})
-	aMu.Unlock()
+	// assembleWithContextTimeout(packet, shard.Assembler, tcp)
+	shard.Assembler.AssembleWithContext(packet.NetworkLayer().NetworkFlow(), tcp, newContext(packet))
 
 	// TODO: refactor and use a ticker model in a goroutine, similar to progress reporting
-	if decoderconfig.Instance.FlushEvery > 0 {
-		streamutils.Stats.Lock()
-		doFlush := streamutils.Stats.Count%int64(decoderconfig.Instance.FlushEvery) == 0
-		streamutils.Stats.Unlock()
-
-		// flush connections in interval
-		if doFlush {
-			ref := packet.Metadata().CaptureInfo.Timestamp
-			aMu.Lock()
-			flushed, closed := assembler.FlushWithOptions(
-				reassembly.FlushOptions{
-					T:  ref.Add(-decoderconfig.Instance.ClosePendingTimeOut),
-					TC: ref.Add(-decoderconfig.Instance.CloseInactiveTimeOut),
-				},
-			)
-			aMu.Unlock()
-			reassemblyLog.Debug("forced flush",
-				zap.Int("flushed", flushed),
-				zap.Int("closed", closed),
-				zap.Time("ref", ref),
-			)
-		}
+	// flush connections of the shard in interval
+	if shard.factory.conf.FlushEvery > 0 && shard.count%int64(shard.factory.conf.FlushEvery) == 0 {
+		ref := packet.Metadata().CaptureInfo.Timestamp
+		flushed, closed := shard.Assembler.FlushWithOptions(
+			reassembly.FlushOptions{
+				T:  ref.Add(-shard.factory.conf.ClosePendingTimeOut),
+				TC: ref.Add(-shard.factory.conf.CloseInactiveTimeOut),
+			},
+		)
+		reassemblyLog.Debug("forced flush",
+			zap.Int("flushed", flushed),
+			zap.Int("closed", closed),
+			zap.Time("ref", ref),
+		)
 	}
 }
 
 // assembleWithContextTimeout is a function that times out with a log message after a specified interval
 // when the stream reassembly gets stuck
 // used for debugging.
+//
 //goland:noinspection GoUnusedFunction
 func assembleWithContextTimeout(packet gopacket.Packet, assembler *reassembly.Assembler, tcp *layers.TCP) {
 	done := make(chan bool, 1)
 
 	go func() {
-		aMu.Lock()
-		assembler.AssembleWithContext(packet.NetworkLayer().NetworkFlow(), tcp, &context{
-			CaptureInfo: packet.Metadata().CaptureInfo,
-		})
-		aMu.Unlock()
+		assembler.AssembleWithContext(packet.NetworkLayer().NetworkFlow(), tcp, newContext(packet))
 		done <- true
 	}()
 
@@ -553,30 +610,34 @@ func assembleWithContextTimeout(packet gopacket.Packet, assembler *reassembly.As
 }
 
 // CleanupReassembly will shutdown the reassembly.
-func CleanupReassembly(wait bool, assemblers []*reassembly.Assembler) {
-	decoderconfig.Instance.Lock()
-	if decoderconfig.Instance.Debug {
-		reassemblyLog.Info("streamPool:")
-		reassemblyLog.Sugar().Info(StreamFactory.StreamPool.DumpString())
+func (factory *StreamFactory) CleanupReassembly(wait bool, assemblers []*reassembly.Assembler) {
+	stats := factory.decoders.Stats
+
+	factory.conf.Lock()
+	if factory.conf.Debug {
+		for i, a := range assemblers {
+			reassemblyLog.Info("streamPool:", zap.Int("shard", i))
+			reassemblyLog.Sugar().Info(a.StreamPool().DumpString())
+		}
 	}
-	decoderconfig.Instance.Unlock()
+	factory.conf.Unlock()
 
 	// wait for stream reassembly to finish
-	if decoderconfig.Instance.WaitForConnections || wait {
+	if factory.conf.WaitForConnections || wait {
 
 		reassemblyLog.Info("waiting for last streams to finish processing...")
 
 		// wait for remaining connections to finish processing
 		// will wait forever if there are streams that are never shutdown via FIN/RST
 		select {
-		case <-waitForConns():
+		case <-factory.waitForConns():
 		case <-time.After(defaults.ReassemblyTimeout):
-			if !decoderconfig.Instance.Quiet {
+			if !factory.conf.Quiet {
 				reassemblyLog.Info(" timeout after", zap.Duration("reassembly_timeout", defaults.ReassemblyTimeout))
 			}
 		}
 
-		if !decoderconfig.Instance.Quiet {
+		if !factory.conf.Quiet {
 			fmt.Println("\nprocessing last TCP streams")
 		}
 
@@ -588,24 +649,24 @@ func CleanupReassembly(wait bool, assemblers []*reassembly.Assembler) {
 				zap.Int("numAssemblers", len(assemblers)),
 			)
 
-			if i == 0 && (!decoderconfig.Instance.Quiet || decoderconfig.Instance.PrintProgress) {
-				// only display progress bar for the first flush, since all following ones will be instant.
+			if !factory.conf.Quiet || factory.conf.PrintProgress {
+				// each assembler owns its own connections, display the progress for all of them.
 				reassemblyLog.Info("assembler flush", zap.Int("closed", a.FlushAllProgress()))
 			} else {
 				reassemblyLog.Info("assembler flush", zap.Int("closed", a.FlushAll()))
 			}
 		}
 
-		StreamFactory.Lock()
-		numTotal := len(StreamFactory.streamReaders)
-		StreamFactory.Unlock()
+		factory.Lock()
+		numTotal := len(factory.streamReaders)
+		factory.Unlock()
 
 		startFlush := time.Now()
 		reassemblyLog.Info("flushTCPStreams", zap.Int("numTotal", numTotal))
-		flushTCPStreams(numTotal)
+		factory.flushTCPStreams(numTotal)
 		reassemblyLog.Info("flushTCPStreams DONE", zap.String("delta", time.Since(startFlush).String()))
 
-		udp.FlushUDPStreams()
+		factory.udp.FlushUDPStreams()
 	}
 
 	if dpi.IsEnabled() {
@@ -614,8 +675,8 @@ func CleanupReassembly(wait bool, assemblers []*reassembly.Assembler) {
 	}
 
 	// create a memory snapshot for debugging
-	if decoderconfig.Instance.MemProfile != "" {
-		f, err := os.Create(decoderconfig.Instance.MemProfile)
+	if factory.conf.MemProfile != "" {
+		f, err := os.Create(factory.conf.MemProfile)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -630,71 +691,117 @@ func CleanupReassembly(wait bool, assemblers []*reassembly.Assembler) {
 	}
 
 	// print stats if not quiet
-	if !decoderconfig.Instance.Quiet {
+	if !factory.conf.Quiet {
 		errorsMapMutex.Lock()
-		streamutils.Stats.Lock()
+		stats.Lock()
 		reassemblyLog.Info("HTTPDecoder stats",
-			zap.Int64("packets", streamutils.Stats.Count),
-			zap.Int64("bytes", streamutils.Stats.DataBytes),
+			zap.Int64("packets", stats.Count),
+			zap.Int64("bytes", stats.DataBytes),
 			zap.Duration("duration", time.Since(start)),
-			zap.Uint("numErrors", streamutils.Stats.NumErrors),
+			zap.Uint("numErrors", stats.NumErrors),
 			zap.Int("len(errorsMap)", len(errorsMap)),
-			zap.Int64("requests", streamutils.Stats.Requests),
-			zap.Int64("responses", streamutils.Stats.Responses),
+			zap.Int64("requests", stats.Requests),
+			zap.Int64("responses", stats.Responses),
 		)
-		streamutils.Stats.Unlock()
+		stats.Unlock()
 		errorsMapMutex.Unlock()
 
 		// print configuration
 		// print configuration as table
 		tui.Table(reassemblyLogFileHandle, []string{"Reassembly Setting", "Value"}, [][]string{
-			{"FlushEvery", strconv.Itoa(decoderconfig.Instance.FlushEvery)},
-			{"CloseInactiveTimeout", decoderconfig.Instance.CloseInactiveTimeOut.String()},
-			{"ClosePendingTimeout", decoderconfig.Instance.ClosePendingTimeOut.String()},
-			{"AllowMissingInit", strconv.FormatBool(decoderconfig.Instance.AllowMissingInit)},
-			{"IgnoreFsmErr", strconv.FormatBool(decoderconfig.Instance.IgnoreFSMerr)},
-			{"NoOptCheck", strconv.FormatBool(decoderconfig.Instance.NoOptCheck)},
-			{"Checksum", strconv.FormatBool(decoderconfig.Instance.Checksum)},
-			{"DefragIPv4", strconv.FormatBool(decoderconfig.Instance.DefragIPv4)},
-			{"WriteIncomplete", strconv.FormatBool(decoderconfig.Instance.WriteIncomplete)},
+			{"FlushEvery", strconv.Itoa(factory.conf.FlushEvery)},
+			{"CloseInactiveTimeout", factory.conf.CloseInactiveTimeOut.String()},
+			{"ClosePendingTimeout", factory.conf.ClosePendingTimeOut.String()},
+			{"AllowMissingInit", strconv.FormatBool(factory.conf.AllowMissingInit)},
+			{"IgnoreFsmErr", strconv.FormatBool(factory.conf.IgnoreFSMerr)},
+			{"NoOptCheck", strconv.FormatBool(factory.conf.NoOptCheck)},
+			{"Checksum", strconv.FormatBool(factory.conf.Checksum)},
+			{"DefragIPv4", strconv.FormatBool(factory.conf.DefragIPv4)},
+			{"DefragIPv6", strconv.FormatBool(factory.conf.DefragIPv6)},
+			{"OverlapPolicy", factory.conf.OverlapPolicy},
+			{"ReassemblyPageBudget", strconv.Itoa(factory.conf.ReassemblyPageBudget)},
+			{"ReassemblyEviction", factory.conf.ReassemblyEviction},
+			{"WriteIncomplete", strconv.FormatBool(factory.conf.WriteIncomplete)},
+			{"UDPStreamIdleTimeout", factory.conf.UDPStreamIdleTimeout.String()},
+			{"UDPStreamMaxAge", factory.conf.UDPStreamMaxAge.String()},
+			{"UDPStreamMemoryBudget", strconv.Itoa(factory.conf.UDPStreamMemoryBudget)},
+			{"StreamDecoderPorts", factory.conf.StreamDecoderPorts},
+			{"StreamDecoderForce", factory.conf.StreamDecoderForce},
+			{"CommunityIDSeed", strconv.Itoa(factory.conf.CommunityIDSeed)},
 		})
 
 		printProgress(1, 1)
 
-		streamutils.Stats.Lock()
+		stats.Lock()
 
 		var rows [][]string
-		if decoderconfig.Instance.DefragIPv4 {
-			rows = append(rows, []string{"IPv4 defragmentation", strconv.FormatInt(streamutils.Stats.IPdefrag, 10)})
+		if factory.conf.DefragIPv4 {
+			rows = append(rows, []string{"IPv4 defragmentation", strconv.FormatInt(stats.IPdefrag, 10)})
+		}
+		if factory.conf.DefragIPv6 {
+			rows = append(rows,
+				[]string{"IPv6 defragmentation", strconv.FormatInt(stats.IP6defrag, 10)},
+				[]string{"IPv6 fragment anomalies", strconv.FormatInt(stats.IP6FragmentAnomalies, 10)},
+			)
+		}
+
+		if factory.conf.IncrementalStreamDecoding {
+			rows = append(rows, []string{"incremental buffer overflows", strconv.FormatInt(stats.IncrementalBufferOverflows, 10)})
 		}
 
+		memStats := factory.StreamPool.MemoryStats()
+
 		rows = append(rows,
-			[]string{"missed bytes", strconv.FormatInt(streamutils.Stats.MissedBytes, 10)},
-			[]string{"total packets", strconv.FormatInt(streamutils.Stats.Pkt, 10)},
-			[]string{"rejected FSM", strconv.FormatInt(streamutils.Stats.RejectFsm, 10)},
-			[]string{"rejected Options", strconv.FormatInt(streamutils.Stats.RejectOpt, 10)},
-			[]string{"reassembled bytes", strconv.FormatInt(streamutils.Stats.Sz, 10)},
-			[]string{"total TCP bytes", strconv.FormatInt(streamutils.Stats.Totalsz, 10)},
-			[]string{"connection rejected FSM", strconv.FormatInt(streamutils.Stats.RejectConnFsm, 10)},
-			[]string{"reassembled chunks", strconv.FormatInt(streamutils.Stats.Reassembled, 10)},
-			[]string{"out-of-order packets", strconv.FormatInt(streamutils.Stats.OutOfOrderPackets, 10)},
-			[]string{"out-of-order bytes", strconv.FormatInt(streamutils.Stats.OutOfOrderBytes, 10)},
-			[]string{"biggest-chunk packets", strconv.FormatInt(streamutils.Stats.BiggestChunkPackets, 10)},
-			[]string{"biggest-chunk bytes", strconv.FormatInt(streamutils.Stats.BiggestChunkBytes, 10)},
-			[]string{"overlap packets", strconv.FormatInt(streamutils.Stats.OverlapPackets, 10)},
-			[]string{"overlap bytes", strconv.FormatInt(streamutils.Stats.OverlapBytes, 10)},
-			[]string{"saved TCP connections", strconv.FormatInt(streamutils.Stats.SavedTCPConnections, 10)},
-			[]string{"saved UDP conversations", strconv.FormatInt(streamutils.Stats.SavedUDPConnections, 10)},
-			[]string{"numSoftware", strconv.FormatInt(streamutils.Stats.NumSoftware, 10)},
-			[]string{"numServices", strconv.FormatInt(streamutils.Stats.NumServices, 10)},
+			[]string{"missed bytes", strconv.FormatInt(stats.MissedBytes, 10)},
+			[]string{"total packets", strconv.FormatInt(stats.Pkt, 10)},
+			[]string{"rejected FSM", strconv.FormatInt(stats.RejectFsm, 10)},
+			[]string{"rejected Options", strconv.FormatInt(stats.RejectOpt, 10)},
+			[]string{"reassembled bytes", strconv.FormatInt(stats.Sz, 10)},
+			[]string{"total TCP bytes", strconv.FormatInt(stats.Totalsz, 10)},
+			[]string{"connection rejected FSM", strconv.FormatInt(stats.RejectConnFsm, 10)},
+			[]string{"reassembled chunks", strconv.FormatInt(stats.Reassembled, 10)},
+			[]string{"out-of-order packets", strconv.FormatInt(stats.OutOfOrderPackets, 10)},
+			[]string{"out-of-order bytes", strconv.FormatInt(stats.OutOfOrderBytes, 10)},
+			[]string{"biggest-chunk packets", strconv.FormatInt(stats.BiggestChunkPackets, 10)},
+			[]string{"biggest-chunk bytes", strconv.FormatInt(stats.BiggestChunkBytes, 10)},
+			[]string{"overlap packets", strconv.FormatInt(stats.OverlapPackets, 10)},
+			[]string{"overlap bytes", strconv.FormatInt(stats.OverlapBytes, 10)},
+			[]string{"inconsistent retransmissions", strconv.FormatInt(stats.InconsistentRetransmissions, 10)},
+			[]string{"evicted bytes", strconv.FormatInt(memStats.EvictedBytes, 10)},
+			[]string{"forcibly closed streams", strconv.FormatInt(memStats.ForcedCloses, 10)},
+			[]string{"saved TCP connections", strconv.FormatInt(stats.SavedTCPConnections, 10)},
+			[]string{"saved UDP conversations", strconv.FormatInt(stats.SavedUDPConnections, 10)},
+			[]string{"expired UDP streams", strconv.FormatInt(stats.ExpiredUDPStreams, 10)},
+			[]string{"evicted UDP streams", strconv.FormatInt(stats.EvictedUDPStreams, 10)},
+			[]string{"classified conversations", strconv.FormatInt(stats.ClassifiedConversations, 10)},
+			[]string{"numSoftware", strconv.FormatInt(stats.NumSoftware, 10)},
+			[]string{"numServices", strconv.FormatInt(stats.NumServices, 10)},
 		)
-		streamutils.Stats.Unlock()
+		stats.Unlock()
 
 		tui.Table(reassemblyLogFileHandle, []string{"TCP Stat", "Value"}, rows)
 
+		// show how the stream decoders have been selected for the conversations
+		matches, unmatched := factory.decoders.MatchStats()
+		rows = [][]string{}
+		for _, m := range matches {
+			reassemblyLog.Info("stream decoder matches",
+				zap.String("decoder", m.Name),
+				zap.Int64("forced", m.Forced),
+				zap.Int64("port", m.Port),
+				zap.Int64("content", m.Content),
+			)
+
+			rows = append(rows, []string{m.Name, strconv.FormatInt(m.Forced, 10), strconv.FormatInt(m.Port, 10), strconv.FormatInt(m.Content, 10)})
+		}
+		reassemblyLog.Info("stream decoder matches", zap.Int64("unmatched", unmatched))
+
+		rows = append(rows, []string{"unmatched", "", "", strconv.FormatInt(unmatched, 10)})
+		tui.Table(reassemblyLogFileHandle, []string{"Stream Decoder", "Forced", "Port", "Content"}, rows)
+
 		errorsMapMutex.Lock()
-		streamutils.Stats.Lock()
-		if streamutils.Stats.NumErrors != 0 {
+		stats.Lock()
+		if stats.NumErrors != 0 {
 			rows = [][]string{}
 			for e := range errorsMap {
 				rows = append(rows, []string{e, strconv.FormatUint(uint64(errorsMap[e]), 10)})
@@ -703,18 +810,18 @@ func CleanupReassembly(wait bool, assemblers []*reassembly.Assembler) {
 			tui.Table(reassemblyLogFileHandle, []string{"Error Subject", "Count"}, rows)
 		}
 
-		streamutils.Stats.Unlock()
+		stats.Unlock()
 		errorsMapMutex.Unlock()
 	}
 }
 
-func waitForConns() chan struct{} {
+func (factory *StreamFactory) waitForConns() chan struct{} {
 	out := make(chan struct{})
 
 	go func() {
 		// WaitGoRoutines waits until the goroutines launched to process TCP streams are done
 		// this will block forever if there are streams that are never shutdown (via RST or FIN flags)
-		StreamFactory.waitGoRoutines()
+		factory.waitGoRoutines()
 		out <- struct{}{}
 	}()
 
@@ -20,48 +20,102 @@ import (
 	"github.com/dreadl0ck/gopacket/ip4defrag"
 	"go.uber.org/zap"
 
+	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
+	"github.com/dreadl0ck/netcap/decoder/packet"
+	"github.com/dreadl0ck/netcap/decoder/stream"
+	"github.com/dreadl0ck/netcap/decoder/stream/udp"
 	"github.com/dreadl0ck/netcap/reassembly"
+	"github.com/dreadl0ck/netcap/types"
 	"github.com/dreadl0ck/netcap/utils"
 )
 
-// StreamFactory is a structure that manages TCP stream reassembly
-var StreamFactory = newStreamFactory()
-
-func newStreamFactory() *connectionFactory {
-	f := &connectionFactory{
+// NewStreamFactory creates the factory that manages the TCP stream reassembly for a collector.
+// The conversations are passed to the given stream decoders,
+// and the identified protocols are added to the IP profiles of the packet decoder state.
+func NewStreamFactory(conf *decoderconfig.Config, decoders *stream.Decoders, packets *packet.State) (*StreamFactory, error) {
+	f := &StreamFactory{
+		conf:       conf,
+		decoders:   decoders,
+		packets:    packets,
+		udp:        udp.NewPools(conf, decoders, packets),
 		defragger:  ip4defrag.NewIPv4Defragmenter(),
-		FSMOptions: reassembly.TCPSimpleFSMOptions{},
+		defragger6: newIPv6Defragmenter(conf),
+		FSMOptions: reassembly.TCPSimpleFSMOptions{
+			SupportMissingEstablishment: conf.AllowMissingInit,
+		},
 	}
 	f.StreamPool = reassembly.NewStreamPool(f)
 
-	return f
+	// parse overlap policies for the assemblers
+	var err error
+
+	f.OverlapPolicies, err = reassembly.ParseOverlapPolicies(conf.OverlapPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	// limit the memory used by the assemblers
+	eviction, err := reassembly.ParseEvictionPolicy(conf.ReassemblyEviction)
+	if err != nil {
+		return nil, err
+	}
+
+	f.StreamPool.UseMemoryBudget(memoryBudget)
+	memoryBudget.SetLimit(int64(conf.ReassemblyPageBudget), eviction)
+
+	return f, nil
+}
+
+// memoryBudget limits the memory used by the TCP reassembly of all collectors in the process,
+// the limit of the collector that has been created last applies.
+var memoryBudget = reassembly.NewMemoryBudget()
+
+// MemoryStats returns the memory accounting of the TCP reassembly of all collectors in the process.
+func MemoryStats() reassembly.MemoryStats {
+	return memoryBudget.Stats()
 }
 
-// GetStreamPool returns the stream pool.
-func GetStreamPool() *reassembly.StreamPool {
-	return StreamFactory.StreamPool
+// ReleaseMemoryBudget removes the connection tables of the factory and the given assemblers from the memory budget,
+// once the reassembly of the collector has been torn down.
+func (factory *StreamFactory) ReleaseMemoryBudget(assemblers []*reassembly.Assembler) {
+	for _, a := range assemblers {
+		a.StreamPool().ReleaseMemoryBudget()
+	}
+
+	factory.StreamPool.ReleaseMemoryBudget()
 }
 
 /*
  * The TCP factory: returns a new Connection
  */
 
-// TCPConnectionFactory internal data structure to handle new network streams
+// StreamFactory is the internal data structure to handle new network streams
 // and spawn the stream decoder routines for processing the data.
-type connectionFactory struct {
+type StreamFactory struct {
 	sync.Mutex
 	streamReaders []streamReader
 	numActive     int64
 	defragger     *ip4defrag.IPv4Defragmenter
+	defragger6    *ipv6Defragmenter
 	StreamPool    *reassembly.StreamPool
 	wg            sync.WaitGroup
 	FSMOptions    reassembly.TCPSimpleFSMOptions
+
+	// OverlapPolicies for the assemblers created with NewAssembler
+	OverlapPolicies *reassembly.OverlapPolicies
+
+	conf     *decoderconfig.Config
+	decoders *stream.Decoders
+	packets  *packet.State
+
+	// UDP stream pools of the shards
+	udp *udp.Pools
 }
 
 // New handles a new stream received from the assembler
 // this is the entry point for new network streams
 // a dedicated stream reader instance will be started and subsequently fed with new data from the stream.
-func (factory *connectionFactory) New(net, transport gopacket.Flow, ac reassembly.AssemblerContext) reassembly.Stream {
+func (factory *StreamFactory) New(net, transport gopacket.Flow, ac reassembly.AssemblerContext) reassembly.Stream {
 	reassemblyLog.Debug("new stream",
 		zap.String("net", net.String()),
 		zap.String("transport", transport.String()),
@@ -69,6 +123,7 @@ func (factory *connectionFactory) New(net, transport gopacket.Flow, ac reassembl
 
 	// parent structure for tracking the bidirectional connection
 	str := &tcpConnection{
+		factory:     factory,
 		net:         net,
 		transport:   transport,
 		tcpstate:    reassembly.NewTCPSimpleFSM(factory.FSMOptions),
@@ -77,6 +132,11 @@ func (factory *connectionFactory) New(net, transport gopacket.Flow, ac reassembl
 		firstPacket: ac.GetCaptureInfo().Timestamp,
 	}
 
+	// all packets of the connection are transported in the same tunnel
+	if c, ok := ac.(*context); ok {
+		str.tunnel = c.tunnel
+	}
+
 	str.decoder = &tcpReader{
 		parent: str,
 	}
@@ -103,7 +163,7 @@ func (factory *connectionFactory) New(net, transport gopacket.Flow, ac reassembl
 
 // waitGoRoutines waits until the goroutines launched to process TCP streams are done
 // this will block forever if there are streams that are never shutdown (via RST or FIN flags).
-func (factory *connectionFactory) waitGoRoutines() {
+func (factory *StreamFactory) waitGoRoutines() {
 	factory.Lock()
 	reassemblyLog.Info("waiting for last TCP streams to process", zap.Int64("num", factory.numActive))
 	factory.Unlock()
@@ -114,9 +174,29 @@ func (factory *connectionFactory) waitGoRoutines() {
 // context is the assembler context.
 type context struct {
 	CaptureInfo gopacket.CaptureInfo
+
+	// identifies the tunnel of decapsulated packets
+	Tunnel uint64
+
+	// tunnel the packet was decapsulated from, if any
+	tunnel *types.Tunnel
+}
+
+// newContext creates the assembler context for a packet.
+func newContext(p gopacket.Packet) *context {
+	return &context{
+		CaptureInfo: p.Metadata().CaptureInfo,
+		Tunnel:      packet.TunnelID(p),
+		tunnel:      packet.GetTunnel(p),
+	}
 }
 
 // GetCaptureInfo returns the gopacket.CaptureInfo from the context.
 func (c *context) GetCaptureInfo() gopacket.CaptureInfo {
 	return c.CaptureInfo
 }
+
+// TunnelID returns the identifier of the tunnel the packet was decapsulated from, or 0.
+func (c *context) TunnelID() uint64 {
+	return c.Tunnel
+}
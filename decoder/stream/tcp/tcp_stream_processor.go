@@ -20,7 +20,6 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 
-	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
 	streamutils "github.com/dreadl0ck/netcap/decoder/stream/utils"
 	"github.com/dreadl0ck/netcap/reassembly"
 	"github.com/dreadl0ck/netcap/utils"
@@ -58,17 +57,17 @@ func init() {
 	)
 }
 
-func flushTCPStreams(numTotal int) {
-	sp := new(tcpStreamProcessor)
-	if numTotal < decoderconfig.Instance.NumStreamWorkers {
-		sp.initWorkers(decoderconfig.Instance.StreamBufferSize, numTotal)
+func (factory *StreamFactory) flushTCPStreams(numTotal int) {
+	sp := &tcpStreamProcessor{factory: factory}
+	if numTotal < factory.conf.NumStreamWorkers {
+		sp.initWorkers(factory.conf.StreamBufferSize, numTotal)
 	} else {
-		sp.initWorkers(decoderconfig.Instance.StreamBufferSize, decoderconfig.Instance.NumStreamWorkers)
+		sp.initWorkers(factory.conf.StreamBufferSize, factory.conf.NumStreamWorkers)
 	}
 	sp.numTotal = numTotal
 
 	// flush the remaining streams to disk
-	for _, s := range StreamFactory.streamReaders {
+	for _, s := range factory.streamReaders {
 		if s != nil { // never feed a nil stream
 			sp.handleStream(s)
 		}
@@ -94,6 +93,7 @@ type tcpStreamProcessor struct {
 	numDone          int
 	numTotal         int
 	streamBufferSize int
+	factory          *StreamFactory
 }
 
 // to process the streams in parallel
@@ -148,7 +148,7 @@ func (tsp *tcpStreamProcessor) streamWorker(wg *sync.WaitGroup) chan streamReade
 			if s.IsClient() {
 				// save the entire conversation.
 				// we only need to do this once, when the client part of the connection is closed
-				err := streamutils.SaveConversation("TCP", s.Merged(), s.Ident(), s.FirstPacket(), s.Transport())
+				err := streamutils.SaveConversation(tsp.factory.conf, tsp.factory.decoders.Credentials, tsp.factory.decoders.Stats, "TCP", s.Merged(), s.Ident(), s.FirstPacket(), s.Transport(), s.Tunnel())
 				if err != nil {
 					fmt.Println("failed to save connection", err)
 				}
@@ -163,7 +163,7 @@ func (tsp *tcpStreamProcessor) streamWorker(wg *sync.WaitGroup) chan streamReade
 				s.SortAndMergeFragments()
 
 				// save the service banner
-				saveTCPServiceBanner(s)
+				tsp.factory.saveTCPServiceBanner(s)
 
 				tcpStreamProcessingTime.WithLabelValues(reassembly.TCPDirServerToClient.String()).Set(float64(time.Since(t).Nanoseconds()))
 			}
@@ -171,7 +171,7 @@ func (tsp *tcpStreamProcessor) streamWorker(wg *sync.WaitGroup) chan streamReade
 			tsp.Lock()
 			tsp.numDone++
 
-			if !decoderconfig.Instance.Quiet {
+			if !tsp.factory.conf.Quiet {
 				utils.ClearLine()
 				fmt.Print("processing remaining open TCP streams... ", "(", tsp.numDone, "/", tsp.numTotal, ")")
 			}
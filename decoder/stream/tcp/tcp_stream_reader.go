@@ -26,8 +26,8 @@ import (
 	"github.com/dreadl0ck/gopacket"
 	"go.uber.org/zap"
 
-	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
 	"github.com/dreadl0ck/netcap/decoder/core"
+	"github.com/dreadl0ck/netcap/types"
 )
 
 var reassemblyLog = zap.NewNop()
@@ -55,9 +55,9 @@ type tcpStreamReader struct {
 
 func (t *tcpConnection) newTCPStreamReader(client bool) *tcpStreamReader {
 	return &tcpStreamReader{
-		dataChan: make(chan *core.StreamData, decoderconfig.Instance.StreamDecoderBufSize),
+		dataChan: make(chan *core.StreamData, t.factory.conf.StreamDecoderBufSize),
 		ident:    t.ident,
-		hexdump:  decoderconfig.Instance.HexDump,
+		hexdump:  t.factory.conf.HexDump,
 		parent:   t,
 		isClient: client,
 	}
@@ -74,10 +74,16 @@ func (t *tcpStreamReader) Read(p []byte) (int, error) {
 	l := copy(p, data.RawData)
 
 	t.parent.Lock()
-	t.data = append(t.data, data)
-	t.numBytes += l
+	// once an incremental decoder has been selected, it consumes the data while the connection is reassembled,
+	// keeping it would let the memory grow with the size of long lived connections
+	if t.parent.incremental == nil {
+		t.data = append(t.data, data)
+		t.numBytes += l
+	}
 	t.parent.Unlock()
 
+	t.parent.fragments.Done()
+
 	return l, nil
 }
 
@@ -87,7 +93,7 @@ func (t *tcpStreamReader) DataChan() chan *core.StreamData {
 }
 
 // Cleanup will tear down the stream processing.
-func (t *tcpStreamReader) Cleanup(f *connectionFactory) {
+func (t *tcpStreamReader) Cleanup(f *StreamFactory) {
 	// signal wait group
 	f.wg.Done()
 	f.Lock()
@@ -96,6 +102,7 @@ func (t *tcpStreamReader) Cleanup(f *connectionFactory) {
 }
 
 // DataSlice will return all gathered data fragments.
+// Once an incremental stream decoder has been selected, no further fragments are gathered.
 // CAUTION: underlying tcpConnection needs to be locked when calling this, and working with the result!
 func (t *tcpStreamReader) DataSlice() core.DataFragments {
 	return t.data
@@ -167,6 +174,11 @@ func (t *tcpStreamReader) FirstPacket() time.Time {
 	return t.parent.firstPacket
 }
 
+// Tunnel returns the tunnel the stream was decapsulated from, if any.
+func (t *tcpStreamReader) Tunnel() *types.Tunnel {
+	return t.parent.tunnel
+}
+
 // Saved indicates whether the stream has already been persisted on disk.
 func (t *tcpStreamReader) Saved() bool {
 	t.parent.Lock()
@@ -176,6 +188,7 @@ func (t *tcpStreamReader) Saved() bool {
 }
 
 // NumBytes returns the number of bytes processed.
+// Data consumed by an incremental stream decoder is not included.
 func (t *tcpStreamReader) NumBytes() int {
 	t.parent.Lock()
 	defer t.parent.Unlock()
@@ -223,7 +236,7 @@ func (t *tcpStreamReader) ServiceBanner() []byte {
 				t.serviceBanner.WriteByte(b)
 				t.serviceBannerBytes++
 
-				if t.serviceBannerBytes == decoderconfig.Instance.BannerSize {
+				if t.serviceBannerBytes == t.parent.factory.conf.BannerSize {
 					return t.serviceBanner.Bytes()
 				}
 			}
@@ -234,7 +247,7 @@ func (t *tcpStreamReader) ServiceBanner() []byte {
 }
 
 // Run starts reading TCP traffic in a single direction.
-func (t *tcpStreamReader) Run(f *connectionFactory) {
+func (t *tcpStreamReader) Run(f *StreamFactory) {
 	// defer a cleanup func to flush the requests and responses once the stream encounters an EOF
 	defer t.Cleanup(f)
 
@@ -0,0 +1,66 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package tcp
+
+import (
+	"testing"
+
+	"github.com/dreadl0ck/netcap/decoder/core"
+)
+
+// incrementalTestDecoder counts the fragments passed to it.
+type incrementalTestDecoder struct {
+	consumed int
+}
+
+func (d *incrementalTestDecoder) Decode() {}
+
+func (d *incrementalTestDecoder) Consume(*core.StreamData) {
+	d.consumed++
+}
+
+func TestStreamReaderIncrementalData(t *testing.T) {
+	var (
+		conn = &tcpConnection{}
+		r    = &tcpStreamReader{dataChan: make(chan *core.StreamData, 1), parent: conn}
+		buf  = make([]byte, 1024)
+	)
+
+	read := func(n int) {
+		for i := 0; i < n; i++ {
+			conn.fragments.Add(1)
+			r.dataChan <- &core.StreamData{RawData: make([]byte, 100)}
+
+			if _, err := r.Read(buf); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	// data received before the decoder has been selected is kept
+	read(2)
+
+	conn.incremental = &incrementalTestDecoder{}
+
+	// a long lived connection
+	read(10000)
+
+	if n := len(r.DataSlice()); n != 2 {
+		t.Fatal("expected only the fragments before the decoder selection to be kept, got", n)
+	}
+
+	if n := r.NumBytes(); n != 200 {
+		t.Fatal("unexpected number of bytes", n)
+	}
+}
@@ -15,24 +15,104 @@ package udp
 
 import (
 	"reflect"
+	"sort"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/dreadl0ck/gopacket"
 
 	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
 	"github.com/dreadl0ck/netcap/decoder/core"
+	"github.com/dreadl0ck/netcap/decoder/packet"
 	"github.com/dreadl0ck/netcap/decoder/stream"
 	"github.com/dreadl0ck/netcap/decoder/stream/service"
 	streamutils "github.com/dreadl0ck/netcap/decoder/stream/utils"
+	decoderutils "github.com/dreadl0ck/netcap/decoder/utils"
+	"github.com/dreadl0ck/netcap/dpi"
 	"github.com/dreadl0ck/netcap/reassembly"
 	"github.com/dreadl0ck/netcap/resolvers"
+	"github.com/dreadl0ck/netcap/types"
 	"github.com/dreadl0ck/netcap/utils"
 )
 
-// Streams contains a pool of UDP data streams
-var Streams = newUDPStreamPool()
+// Pools holds the UDP stream pools of a collector, their streams are merged when flushing.
+type Pools struct {
+	// number of payload bytes buffered in all pools, limited by the UDPStreamMemoryBudget
+	bufferedBytes int64
+
+	// number of pools that buffer data, the budget is divided among them
+	activePools int64
+
+	sync.Mutex
+	pools []*StreamPool
+
+	conf     *decoderconfig.Config
+	decoders *stream.Decoders
+	packets  *packet.State
+
+	// processes the streams that expired during capture, started on first use
+	expiryMu        sync.Mutex
+	expiryProcessor *udpStreamProcessor
+
+	// expires idle and old streams in the background, also when no more packets arrive
+	clock  decoderutils.CaptureClock
+	expiry *decoderutils.Ticker
+}
+
+// NewPools creates the UDP stream pools for a collector.
+// The conversations are passed to the given stream decoders,
+// and the identified protocols are added to the IP profiles of the packet decoder state.
+// If an idle timeout or maximum age is configured for UDP streams,
+// expired streams are processed in intervals until FlushUDPStreams is called.
+func NewPools(conf *decoderconfig.Config, decoders *stream.Decoders, packets *packet.State) *Pools {
+	p := &Pools{
+		conf:     conf,
+		decoders: decoders,
+		packets:  packets,
+	}
+
+	if timeout := expiryTimeout(conf); timeout > 0 {
+		p.expiry = decoderutils.StartTicker(decoderutils.ExpiryInterval(timeout), func() {
+			p.expire(p.clock.Now())
+		})
+	}
+
+	return p
+}
+
+// expiryTimeout returns the shortest of the configured UDP stream timeouts, or zero if none is set.
+func expiryTimeout(conf *decoderconfig.Config) time.Duration {
+	timeout := conf.UDPStreamIdleTimeout
+	if maxAge := conf.UDPStreamMaxAge; maxAge > 0 && (timeout <= 0 || maxAge < timeout) {
+		timeout = maxAge
+	}
+
+	return timeout
+}
+
+// expire passes the streams of all pools that expired at the capture time now to the stream processor.
+func (p *Pools) expire(now time.Time) {
+	// no packet has been seen yet
+	if now.IsZero() {
+		return
+	}
+
+	p.Lock()
+	pools := append([]*StreamPool(nil), p.pools...)
+	p.Unlock()
+
+	for _, u := range pools {
+		u.Lock()
+		expired := u.expire(now)
+		u.Unlock()
+
+		for _, s := range expired {
+			p.processExpired(s)
+		}
+	}
+}
 
 const typeUDP = "udp"
 
@@ -41,21 +121,49 @@ type udpStream struct {
 	sync.Mutex
 	data    core.DataFragments
 	decoder core.StreamDecoderInterface
+
+	// capture timestamps of the first and last packet
+	firstSeen time.Time
+	lastSeen  time.Time
+
+	// number of payload bytes
+	size int
+
+	// tunnel the stream was decapsulated from, if any
+	tunnel *types.Tunnel
 }
 
-// udpStreamPool holds a pool of UDP streams.
-type udpStreamPool struct {
+// once the memory budget is exceeded, streams are evicted until the pool uses less than this percentage of its share.
+const evictionLowWatermark = 90
+
+// StreamPool holds a pool of UDP streams.
+type StreamPool struct {
+	// number of payload bytes buffered in the pool, modified atomically while holding the lock of the pool
+	bufferedBytes int64
+
 	sync.Mutex
 	streams map[uint64]*udpStream
+
+	parent *Pools
 }
 
-func newUDPStreamPool() *udpStreamPool {
-	return &udpStreamPool{
+// NewStreamPool creates a new pool for UDP streams.
+// Workers can use separate pools, as long as all packets of a flow are passed to the same pool,
+// the streams of all pools are merged when calling FlushUDPStreams.
+func (p *Pools) NewStreamPool() *StreamPool {
+	u := &StreamPool{
 		streams: make(map[uint64]*udpStream),
+		parent:  p,
 	}
+
+	p.Lock()
+	p.pools = append(p.pools, u)
+	p.Unlock()
+
+	return u
 }
 
-func (u *udpStreamPool) size() int {
+func (u *StreamPool) size() int {
 	u.Lock()
 	defer u.Unlock()
 
@@ -63,45 +171,197 @@ func (u *udpStreamPool) size() int {
 }
 
 // HandleUDP takes an UDP packet and tracks the data seen for the conversation.
-func (u *udpStreamPool) HandleUDP(packet gopacket.Packet, udpLayer gopacket.Layer) {
-	u.Lock()
-	if s, ok := u.streams[packet.TransportLayer().TransportFlow().FastHash()]; ok {
-		u.Unlock()
+// Streams that have been evicted to stay within the memory budget
+// are passed to the stream processor, instead of waiting for FlushUDPStreams.
+func (u *StreamPool) HandleUDP(p gopacket.Packet, udpLayer gopacket.Layer) {
+	var (
+		ts      = p.Metadata().Timestamp
+		payload = udpLayer.LayerPayload()
+		data    = &core.StreamData{
+			RawData:            payload,
+			CaptureInformation: p.Metadata().CaptureInfo,
+			Trans:              p.TransportLayer().TransportFlow(),
+			Net:                p.NetworkLayer().NetworkFlow(),
+		}
+		id      = streamID(p)
+		evicted []*udpStream
+	)
+
+	u.parent.clock.Observe(ts)
 
+	u.Lock()
+	if s, ok := u.streams[id]; ok {
 		s.Lock()
-		s.data = append(s.data, &core.StreamData{
-			RawData:            udpLayer.LayerPayload(),
-			CaptureInformation: packet.Metadata().CaptureInfo,
-			Trans:              packet.TransportLayer().TransportFlow(),
-			Net:                packet.NetworkLayer().NetworkFlow(),
-		})
+		s.data = append(s.data, data)
+		s.size += len(payload)
+		if ts.After(s.lastSeen) {
+			s.lastSeen = ts
+		}
 		s.Unlock()
 	} else {
 		// add new
-		str := new(udpStream)
-		str.data = append(str.data, &core.StreamData{
-			RawData:            udpLayer.LayerPayload(),
-			CaptureInformation: packet.Metadata().CaptureInfo,
-			Trans:              packet.TransportLayer().TransportFlow(),
-			Net:                packet.NetworkLayer().NetworkFlow(),
-		})
-		u.streams[packet.TransportLayer().TransportFlow().FastHash()] = str
-		u.Unlock()
+		u.streams[id] = &udpStream{
+			data:      core.DataFragments{data},
+			firstSeen: ts,
+			lastSeen:  ts,
+			size:      len(payload),
+			tunnel:    packet.GetTunnel(p),
+		}
+	}
+
+	u.addBufferedBytes(int64(len(payload)))
+	total := atomic.AddInt64(&u.parent.bufferedBytes, int64(len(payload)))
+
+	if budget := int64(u.parent.conf.UDPStreamMemoryBudget); budget > 0 && total > budget {
+		if share := u.parent.share(budget); atomic.LoadInt64(&u.bufferedBytes) > share {
+			evicted = u.evict(share / 100 * evictionLowWatermark)
+		}
+	}
+	u.Unlock()
+
+	for _, s := range evicted {
+		u.parent.processExpired(s)
+	}
+}
+
+// streamID returns the identifier of the stream for a packet.
+// Decapsulated packets from different tunnels belong to different streams, even if their ports match.
+func streamID(p gopacket.Packet) uint64 {
+	id := p.TransportLayer().TransportFlow().FastHash()
+	if tunnel := packet.TunnelID(p); tunnel != 0 {
+		// mix in the tunnel with the FNV prime
+		id = (id ^ tunnel) * 1099511628211
+	}
+
+	return id
+}
+
+// expire removes the streams that have been idle for longer than the UDPStreamIdleTimeout,
+// or that are older than the UDPStreamMaxAge, relative to the capture timestamp ref.
+// The pool must be locked by the caller.
+func (u *StreamPool) expire(ref time.Time) (expired []*udpStream) {
+	var (
+		idle   = u.parent.conf.UDPStreamIdleTimeout
+		maxAge = u.parent.conf.UDPStreamMaxAge
+	)
+
+	if idle <= 0 && maxAge <= 0 {
+		return nil
+	}
+
+	for id, s := range u.streams {
+		if (idle > 0 && ref.Sub(s.lastSeen) > idle) || (maxAge > 0 && ref.Sub(s.firstSeen) > maxAge) {
+			expired = append(expired, u.remove(id, s))
+		}
+	}
+
+	if len(expired) > 0 {
+		u.parent.decoders.Stats.Lock()
+		u.parent.decoders.Stats.ExpiredUDPStreams += int64(len(expired))
+		u.parent.decoders.Stats.Unlock()
+	}
+
+	return expired
+}
+
+// share returns the part of the budget available to a single pool.
+// The budget is divided evenly among the pools that buffer data.
+func (p *Pools) share(budget int64) int64 {
+	if active := atomic.LoadInt64(&p.activePools); active > 1 {
+		return budget / active
+	}
+
+	return budget
+}
+
+// addBufferedBytes accounts for data added to or removed from the pool,
+// and updates the number of active pools when the pool starts or stops buffering data.
+// The pool must be locked by the caller.
+func (u *StreamPool) addBufferedBytes(n int64) {
+	size := atomic.AddInt64(&u.bufferedBytes, n)
+
+	switch {
+	case n > 0 && size == n:
+		atomic.AddInt64(&u.parent.activePools, 1)
+	case n < 0 && size == 0:
+		atomic.AddInt64(&u.parent.activePools, -1)
 	}
 }
 
+// evict removes the oldest streams of the pool, until the data buffered in the pool drops to the target.
+// Each pool only evicts its own streams once it exceeds its share of the budget,
+// pools holding less than their share are left alone. Since the pool is drained below its share,
+// the streams are only sorted once the pool exceeds its share again, not for every packet.
+// The pool must be locked by the caller.
+func (u *StreamPool) evict(target int64) (evicted []*udpStream) {
+	var (
+		ids     = make([]uint64, 0, len(u.streams))
+		streams = make([]*udpStream, 0, len(u.streams))
+	)
+
+	for id, s := range u.streams {
+		ids = append(ids, id)
+		streams = append(streams, s)
+	}
+
+	sort.Sort(byFirstSeen{ids: ids, streams: streams})
+
+	for i, s := range streams {
+		if atomic.LoadInt64(&u.bufferedBytes) <= target {
+			break
+		}
+
+		evicted = append(evicted, u.remove(ids[i], s))
+	}
+
+	if len(evicted) > 0 {
+		u.parent.decoders.Stats.Lock()
+		u.parent.decoders.Stats.EvictedUDPStreams += int64(len(evicted))
+		u.parent.decoders.Stats.Unlock()
+	}
+
+	return evicted
+}
+
+// remove deletes the stream from the pool and releases its data from the memory budget.
+// The pool must be locked by the caller.
+func (u *StreamPool) remove(id uint64, s *udpStream) *udpStream {
+	delete(u.streams, id)
+	u.addBufferedBytes(-int64(s.size))
+	atomic.AddInt64(&u.parent.bufferedBytes, -int64(s.size))
+
+	return s
+}
+
+// byFirstSeen sorts streams and their identifiers by the time of their first packet.
+type byFirstSeen struct {
+	ids     []uint64
+	streams []*udpStream
+}
+
+func (b byFirstSeen) Len() int { return len(b.streams) }
+
+func (b byFirstSeen) Less(i, j int) bool {
+	return b.streams[i].firstSeen.Before(b.streams[j].firstSeen)
+}
+
+func (b byFirstSeen) Swap(i, j int) {
+	b.ids[i], b.ids[j] = b.ids[j], b.ids[i]
+	b.streams[i], b.streams[j] = b.streams[j], b.streams[i]
+}
+
 // saves the banner for a UDP service to the filesystem
 // and limits the length of the saved data to the BannerSize value from the config.
-func saveUDPServiceBanner(banner []byte, flowIdent string, serviceIdent string, firstPacket time.Time, serverBytes int, clientBytes int, net gopacket.Flow, transport gopacket.Flow) {
+func (p *Pools) saveUDPServiceBanner(banner []byte, flowIdent string, serviceIdent string, firstPacket time.Time, serverBytes int, clientBytes int, net gopacket.Flow, transport gopacket.Flow, tunnel *types.Tunnel) {
 	// limit length of data
-	if len(banner) >= decoderconfig.Instance.BannerSize {
-		banner = banner[:decoderconfig.Instance.BannerSize]
+	if len(banner) >= p.conf.BannerSize {
+		banner = banner[:p.conf.BannerSize]
 	}
 
 	// check if we already have a banner for the IP + Port combination
-	service.Store.Lock()
-	if serv, ok := service.Store.Items[serviceIdent]; ok {
-		defer service.Store.Unlock()
+	p.decoders.Service.Store.Lock()
+	if serv, ok := p.decoders.Service.Store.Items[serviceIdent]; ok {
+		defer p.decoders.Service.Store.Unlock()
 
 		for _, f := range serv.Flows {
 			if f == flowIdent {
@@ -112,13 +372,14 @@ func saveUDPServiceBanner(banner []byte, flowIdent string, serviceIdent string,
 		serv.Flows = append(serv.Flows, flowIdent)
 		return
 	}
-	service.Store.Unlock()
+	p.decoders.Service.Store.Unlock()
 
 	// nope. lets create a new one
 	serv := service.NewService(firstPacket.UnixNano(), serverBytes, clientBytes, net.Dst().String())
 	serv.Banner = string(banner)
 	serv.IP = net.Dst().String()
 	serv.Port = utils.DecodePort(transport.Dst().Raw())
+	serv.Tunnel = tunnel
 
 	// set flow ident, h.parent.ident is the client flow
 	serv.Flows = []string{flowIdent}
@@ -129,26 +390,25 @@ func saveUDPServiceBanner(banner []byte, flowIdent string, serviceIdent string,
 		serv.Name = resolvers.LookupServiceByPort(dst, typeUDP)
 	}
 
-	service.MatchServiceProbes(serv, banner, flowIdent)
+	p.decoders.Service.MatchServiceProbes(serv, banner, flowIdent)
 
 	// add new service
-	service.Store.Lock()
-	service.Store.Items[serviceIdent] = serv
-	service.Store.Unlock()
+	p.decoders.Service.Store.Lock()
+	p.decoders.Service.Store.Items[serviceIdent] = serv
+	p.decoders.Service.Store.Unlock()
 
-	streamutils.Stats.Lock()
-	streamutils.Stats.NumServices++
-	streamutils.Stats.Unlock()
+	p.decoders.Stats.Lock()
+	p.decoders.Stats.NumServices++
+	p.decoders.Stats.Unlock()
 }
 
 // TODO: ensure that only decoders of protocols are called that actually support being transported via UDP
 // TODO: specify transport protocols during decoder creation
-func (u *udpStream) decode() {
+func (u *udpStream) decode(p *Pools) {
 	// choose the decoder to run against the data stream
 	var (
 		cr               = u.data[0].Raw()
 		sr               []byte
-		found            bool
 		serverFirstReply time.Time
 	)
 
@@ -186,29 +446,14 @@ func (u *udpStream) decode() {
 		ServerIP:          u.data[0].Network().Dst().String(),
 		ClientPort:        utils.DecodePort(u.data[0].Transport().Src().Raw()),
 		ServerPort:        utils.DecodePort(u.data[0].Transport().Dst().Raw()),
+		Tunnel:            u.tunnel,
 	}
 
-	// make a good first guess based on the destination port of the connection
-	if sd, exists := stream.DefaultStreamDecoders[utils.DecodePort(u.data[0].Transport().Dst().Raw())]; exists {
-		if sd.Transport() == core.UDP || sd.Transport() == core.All {
-			if sd.GetReaderFactory() != nil && sd.CanDecodeStream(cr, sr) {
-				u.decoder = sd.GetReaderFactory().New(conv)
-				found = true
-			}
-		}
-	}
+	// classify before decoding, the result is added to the records produced by the decoder
+	protocol, classified := streamutils.ClassifyConversation(p.decoders.Conversations, p.decoders.Stats, conv, dpi.TransportUDP, u.data)
 
-	// if no stream decoder for the port was found, or the stream decoder did not match
-	// try all available decoders and use the first one that matches
-	if !found {
-		for _, sd := range stream.DefaultStreamDecoders {
-			if sd.Transport() == core.UDP || sd.Transport() == core.All {
-				if sd.GetReaderFactory() != nil && sd.CanDecodeStream(cr, sr) {
-					u.decoder = sd.GetReaderFactory().New(conv)
-					break
-				}
-			}
-		}
+	if sd := p.decoders.SelectDecoder(conv, core.UDP, cr, sr); sd != nil {
+		u.decoder = sd.GetReaderFactory().New(conv)
 	}
 
 	// call the decoder if one was found
@@ -220,12 +465,10 @@ func (u *udpStream) decode() {
 
 		udpStreamDecodeTime.WithLabelValues(reflect.TypeOf(u.decoder).String()).Set(float64(time.Since(ti).Nanoseconds()))
 	}
-}
-
-// NumSavedUDPConns returns the number of saved UDP conversations.
-func NumSavedUDPConns() int64 {
-	streamutils.Stats.Lock()
-	defer streamutils.Stats.Unlock()
 
-	return streamutils.Stats.SavedUDPConnections
+	if classified {
+		p.packets.AddProtocol(conv.ClientIP, conv.Tunnel, protocol.Protocol, protocol.Category, uint64(len(u.data)))
+		p.packets.AddProtocol(conv.ServerIP, conv.Tunnel, protocol.Protocol, protocol.Category, uint64(len(u.data)))
+		p.decoders.Conversations.Remove(conv.Ident)
+	}
 }
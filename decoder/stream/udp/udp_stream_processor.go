@@ -23,7 +23,6 @@ import (
 	"github.com/dreadl0ck/gopacket"
 	"github.com/prometheus/client_golang/prometheus"
 
-	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
 	streamutils "github.com/dreadl0ck/netcap/decoder/stream/utils"
 	"github.com/dreadl0ck/netcap/utils"
 )
@@ -52,29 +51,58 @@ func init() {
 	)
 }
 
+// processExpired passes a stream that expired during capture to the stream processor for expired streams,
+// which is started on first use.
+func (p *Pools) processExpired(s *udpStream) {
+	p.expiryMu.Lock()
+	defer p.expiryMu.Unlock()
+
+	if p.expiryProcessor == nil {
+		p.expiryProcessor = &udpStreamProcessor{pools: p, background: true}
+		p.expiryProcessor.initWorkers(p.conf.StreamBufferSize, p.conf.NumStreamWorkers)
+	}
+
+	p.expiryProcessor.handleStream(s)
+}
+
 // FlushUDPStreams will flush all collected UDP streams to disk.
-func FlushUDPStreams() {
-	numTotal := Streams.size()
+// Streams of the same conversation that have been collected in different pools are merged.
+func (p *Pools) FlushUDPStreams() {
+	// no streams expire after this point, the remaining ones are processed below
+	p.expiry.Stop()
+
+	// wait until the streams that expired during capture have been processed
+	p.expiryMu.Lock()
+	expired := p.expiryProcessor
+	p.expiryProcessor = nil
+	p.expiryMu.Unlock()
+
+	if expired != nil {
+		expired.wg.Wait()
+
+		for _, w := range expired.workers {
+			w <- nil
+		}
+	}
+
+	streams := p.mergeStreams()
+	numTotal := len(streams)
 
-	sp := new(udpStreamProcessor)
-	if numTotal < decoderconfig.Instance.NumStreamWorkers {
-		sp.initWorkers(decoderconfig.Instance.StreamBufferSize, numTotal)
+	sp := &udpStreamProcessor{pools: p}
+	if numTotal < p.conf.NumStreamWorkers {
+		sp.initWorkers(p.conf.StreamBufferSize, numTotal)
 	} else {
-		sp.initWorkers(decoderconfig.Instance.StreamBufferSize, decoderconfig.Instance.NumStreamWorkers)
+		sp.initWorkers(p.conf.StreamBufferSize, p.conf.NumStreamWorkers)
 	}
 	sp.numTotal = numTotal
 
-	Streams.Lock()
-
 	// flush the remaining streams to disk
-	for _, s := range Streams.streams {
+	for _, s := range streams {
 		if s != nil { // never feed a nil stream
 			sp.handleStream(s)
 		}
 	}
 
-	Streams.Unlock()
-
 	// reassemblyLog.Info("waiting for stream processor wait group... ")
 	sp.wg.Wait()
 
@@ -84,6 +112,43 @@ func FlushUDPStreams() {
 	}
 }
 
+// mergeStreams collects the streams of all pools.
+// If a conversation has been tracked in several pools, the data is combined and sorted by time.
+func (p *Pools) mergeStreams() map[uint64]*udpStream {
+	p.Lock()
+	defer p.Unlock()
+
+	if len(p.pools) == 1 {
+		return p.pools[0].streams
+	}
+
+	var (
+		merged   = make(map[uint64]*udpStream)
+		unsorted = make(map[uint64]struct{})
+	)
+
+	for _, u := range p.pools {
+		u.Lock()
+		for id, s := range u.streams {
+			if m, ok := merged[id]; ok {
+				m.data = append(m.data, s.data...)
+				unsorted[id] = struct{}{}
+
+				continue
+			}
+
+			merged[id] = s
+		}
+		u.Unlock()
+	}
+
+	for id := range unsorted {
+		sort.Sort(merged[id].data)
+	}
+
+	return merged
+}
+
 // internal data structure to parallelize processing of tcp streams
 // when the core engine is stopped and the remaining open connections are processed.
 type udpStreamProcessor struct {
@@ -95,6 +160,10 @@ type udpStreamProcessor struct {
 	numDone          int
 	numTotal         int
 	streamBufferSize int
+	pools            *Pools
+
+	// processes streams that expired during capture, instead of the remaining streams at teardown
+	background bool
 }
 
 // to process the streams in parallel
@@ -108,8 +177,8 @@ func (usp *udpStreamProcessor) handleStream(s *udpStream) {
 	//	return
 	//}
 
-	// send the packetInfo to the decoder routine
-	usp.workers[usp.next] <- s
+	usp.Lock()
+	next := usp.next
 
 	// increment or reset next
 	if usp.numWorkers == usp.next+1 {
@@ -118,6 +187,10 @@ func (usp *udpStreamProcessor) handleStream(s *udpStream) {
 	} else {
 		usp.next++
 	}
+	usp.Unlock()
+
+	// send the packetInfo to the decoder routine
+	usp.workers[next] <- s
 }
 
 // worker spawns a new worker goroutine
@@ -156,6 +229,9 @@ func (usp *udpStreamProcessor) streamWorker(wg *sync.WaitGroup) chan *udpStream
 				ident = utils.CreateFlowIdentFromLayerFlows(clientNetwork, clientTransport)
 			} else {
 				// skip empty conns
+				s.Unlock()
+				wg.Done()
+
 				continue
 			}
 
@@ -168,7 +244,7 @@ func (usp *udpStreamProcessor) streamWorker(wg *sync.WaitGroup) chan *udpStream
 					// server
 					serverBytes += len(d.Raw())
 					for _, b := range d.Raw() {
-						if serverBanner.Len() == decoderconfig.Instance.BannerSize {
+						if serverBanner.Len() == usp.pools.conf.BannerSize {
 							break
 						}
 						serverBanner.WriteByte(b)
@@ -178,16 +254,16 @@ func (usp *udpStreamProcessor) streamWorker(wg *sync.WaitGroup) chan *udpStream
 			s.Unlock()
 
 			// call stream decoders
-			s.decode()
+			s.decode(usp.pools)
 
 			// save stream data
-			err := streamutils.SaveConversation("UDP", s.data, ident, firstPacket, clientTransport)
+			err := streamutils.SaveConversation(usp.pools.conf, usp.pools.decoders.Credentials, usp.pools.decoders.Stats, "UDP", s.data, ident, firstPacket, clientTransport, s.tunnel)
 			if err != nil {
 				fmt.Println("failed to save UDP conversation:", err)
 			}
 
 			// save service banner
-			saveUDPServiceBanner(
+			usp.pools.saveUDPServiceBanner(
 				serverBanner.Bytes(),
 				ident,
 				clientNetwork.Dst().String()+":"+clientTransport.Dst().String(),
@@ -196,12 +272,13 @@ func (usp *udpStreamProcessor) streamWorker(wg *sync.WaitGroup) chan *udpStream
 				clientBytes,
 				clientNetwork,
 				clientTransport,
+				s.tunnel,
 			)
 
 			usp.Lock()
 			usp.numDone++
 
-			if !decoderconfig.Instance.Quiet {
+			if !usp.pools.conf.Quiet && !usp.background {
 				utils.ClearLine()
 				fmt.Print("processing UDP streams... ", "(", usp.numDone, "/", usp.numTotal, ")")
 			}
@@ -0,0 +1,71 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package udp
+
+import (
+	"testing"
+	"time"
+
+	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
+	"github.com/dreadl0ck/netcap/decoder/stream"
+	streamutils "github.com/dreadl0ck/netcap/decoder/stream/utils"
+)
+
+func TestStreamPoolShare(t *testing.T) {
+	var (
+		p = &Pools{
+			conf:     &decoderconfig.Config{UDPStreamMemoryBudget: 1000},
+			decoders: &stream.Decoders{Stats: &streamutils.Stats{}},
+		}
+		a     = p.NewStreamPool()
+		b     = p.NewStreamPool()
+		start = time.Unix(1600000000, 0)
+	)
+
+	add := func(u *StreamPool, id uint64, size int, seconds int) {
+		u.streams[id] = &udpStream{firstSeen: start.Add(time.Duration(seconds) * time.Second), size: size}
+		u.addBufferedBytes(int64(size))
+	}
+
+	add(a, 1, 200, 1)
+	add(a, 2, 200, 2)
+	add(a, 3, 200, 3)
+
+	if share := p.share(1000); share != 1000 {
+		t.Fatal("expected the full budget for a single pool, got", share)
+	}
+
+	add(b, 4, 100, 0)
+
+	if share := p.share(1000); share != 500 {
+		t.Fatal("expected the budget to be divided among the pools buffering data, got", share)
+	}
+
+	// only the streams of the pool are evicted, starting with the oldest one
+	evicted := a.evict(350)
+	if len(evicted) != 2 || evicted[0].size != 200 || !evicted[0].firstSeen.Equal(start.Add(time.Second)) {
+		t.Fatal("unexpected evicted streams", evicted)
+	}
+
+	if a.size() != 1 || b.size() != 1 || a.bufferedBytes != 200 || b.bufferedBytes != 100 {
+		t.Fatal("unexpected pools after eviction", a.size(), b.size(), a.bufferedBytes, b.bufferedBytes)
+	}
+
+	// a pool that no longer buffers data does not take a share of the budget
+	b.remove(4, b.streams[4])
+
+	if share := p.share(1000); share != 1000 || p.activePools != 1 {
+		t.Fatal("unexpected share after removing the last stream of a pool", share, p.activePools)
+	}
+}
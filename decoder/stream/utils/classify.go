@@ -0,0 +1,97 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package utils
+
+import (
+	"github.com/dreadl0ck/netcap/decoder/core"
+	"github.com/dreadl0ck/netcap/dpi"
+	"github.com/dreadl0ck/netcap/reassembly"
+)
+
+// number of messages at the beginning of a conversation whose sizes are passed to the classifier.
+const numClassifierMessages = 8
+
+// ClassifyConversation identifies the application protocol of a conversation with the pure Go classifier, if it is enabled.
+// The result is stored for the flow identifier of the conversation, so that it can be added to the audit records produced while decoding it.
+// It must be removed from the conversations once the conversation has been decoded.
+func ClassifyConversation(conversations *dpi.Conversations, stats *Stats, conv *core.ConversationInfo, transport string, data core.DataFragments) (dpi.Result, bool) {
+	if !dpi.ClassifierEnabled() || len(data) == 0 {
+		return dpi.Result{}, false
+	}
+
+	c := &dpi.Conversation{
+		Transport:  transport,
+		ClientPort: conv.ClientPort,
+		ServerPort: conv.ServerPort,
+	}
+
+	for _, d := range data {
+		if d.Direction() == reassembly.TCPDirClientToServer {
+			if c.Client == nil {
+				c.Client = d.Raw()
+			}
+		} else if c.Server == nil {
+			c.Server = d.Raw()
+		}
+
+		if c.Client != nil && c.Server != nil {
+			break
+		}
+	}
+
+	c.Sizes = messageSizes(data, transport == dpi.TransportTCP)
+
+	res, ok := dpi.Classify(c)
+	if !ok {
+		return res, false
+	}
+
+	conversations.Add(conv.Ident, res)
+
+	stats.Lock()
+	stats.ClassifiedConversations++
+	stats.Unlock()
+
+	return res, true
+}
+
+// messageSizes returns the sizes of the first messages of a conversation,
+// positive for data sent by the client and negative for data sent by the server.
+// TCP does not preserve message boundaries, if mergeFragments is set,
+// consecutive fragments sent in the same direction are counted as a single message.
+func messageSizes(data core.DataFragments, mergeFragments bool) (sizes []int) {
+	var last reassembly.TCPFlowDirection
+
+	for _, d := range data {
+		size := len(d.Raw())
+		if d.Direction() != reassembly.TCPDirClientToServer {
+			size = -size
+		}
+
+		if mergeFragments && len(sizes) > 0 && d.Direction() == last {
+			sizes[len(sizes)-1] += size
+
+			continue
+		}
+
+		if len(sizes) == numClassifierMessages {
+			break
+		}
+
+		sizes = append(sizes, size)
+		last = d.Direction()
+	}
+
+	return sizes
+}
@@ -0,0 +1,61 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dreadl0ck/netcap/decoder/core"
+	"github.com/dreadl0ck/netcap/reassembly"
+)
+
+func TestMessageSizes(t *testing.T) {
+	fragment := func(dir reassembly.TCPFlowDirection, size int) *core.StreamData {
+		return &core.StreamData{RawData: make([]byte, size), Dir: dir}
+	}
+
+	data := core.DataFragments{
+		fragment(reassembly.TCPDirClientToServer, 100),
+		fragment(reassembly.TCPDirClientToServer, 50),
+		fragment(reassembly.TCPDirServerToClient, 1460),
+		fragment(reassembly.TCPDirServerToClient, 1460),
+		fragment(reassembly.TCPDirServerToClient, 80),
+		fragment(reassembly.TCPDirClientToServer, 10),
+	}
+
+	if sizes := messageSizes(data, true); !reflect.DeepEqual(sizes, []int{150, -3000, 10}) {
+		t.Fatal("expected fragments of the same direction to be merged, got", sizes)
+	}
+
+	if sizes := messageSizes(data, false); !reflect.DeepEqual(sizes, []int{100, 50, -1460, -1460, -80, 10}) {
+		t.Fatal("expected a size per fragment, got", sizes)
+	}
+
+	// the last message is complete, even if it spans several fragments
+	var many core.DataFragments
+	for i := 0; i < numClassifierMessages; i++ {
+		dir := reassembly.TCPDirClientToServer
+		if i%2 == 1 {
+			dir = reassembly.TCPDirServerToClient
+		}
+
+		many = append(many, fragment(dir, 10), fragment(dir, 10))
+	}
+
+	sizes := messageSizes(append(many, fragment(reassembly.TCPDirClientToServer, 10)), true)
+	if len(sizes) != numClassifierMessages || sizes[numClassifierMessages-1] != -20 {
+		t.Fatal("unexpected sizes", sizes)
+	}
+}
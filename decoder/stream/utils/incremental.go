@@ -0,0 +1,217 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/dreadl0ck/netcap/reassembly"
+)
+
+// ErrIncomplete can be returned by the parse functions of an IncrementalDecoder,
+// to signal that the message is not yet complete and parsing should be retried once more data arrived.
+// When the conversation is finished, the parse functions are invoked with the final flag set,
+// and incomplete messages should be processed as good as possible.
+var ErrIncomplete = errors.New("incomplete message")
+
+// bufferedSegment marks the end offset of a data fragment in the buffer and its timestamp.
+type bufferedSegment struct {
+	end int
+	ts  time.Time
+}
+
+// incrementalBuffer holds the unparsed data of one direction of a conversation.
+type incrementalBuffer struct {
+	data     bytes.Buffer
+	segments []bufferedSegment
+	parse    func(buf *bufio.Reader) error
+
+	// number of bytes required before parsing the incomplete message again
+	need int
+}
+
+// IncrementalDecoder is a Transport layer agnostic util to decode client / server data streams,
+// while they are being reassembled. The data of each direction is buffered
+// and parsed as soon as it arrives. Incomplete messages stay in the buffer,
+// until the next fragment arrived or the conversation has been finished.
+// The buffer size of each direction is limited, if a message exceeds it, the buffered data is discarded.
+type IncrementalDecoder struct {
+	ident   string
+	maxSize int
+
+	// statistics of the collector, counts buffer overflows
+	stats *Stats
+
+	client incrementalBuffer
+	server incrementalBuffer
+
+	// the buffer that is currently parsed and its readers
+	current *incrementalBuffer
+	reader  *bytes.Reader
+	buf     *bufio.Reader
+
+	// set when the conversation is finished and remaining data is parsed for the last time
+	final bool
+}
+
+// NewIncrementalDecoder returns a new decoder that invokes client and server for the messages of each direction.
+// The parse functions must return io.EOF, io.ErrUnexpectedEOF or ErrIncomplete without modifying state,
+// if the message in the buffer is incomplete.
+func NewIncrementalDecoder(ident string, maxSize int, stats *Stats, client, server func(buf *bufio.Reader) error) *IncrementalDecoder {
+	return &IncrementalDecoder{
+		ident:   ident,
+		maxSize: maxSize,
+		stats:   stats,
+		client:  incrementalBuffer{parse: client},
+		server:  incrementalBuffer{parse: server},
+	}
+}
+
+// Consume appends the data to the buffer of the given direction and parses all complete messages.
+func (d *IncrementalDecoder) Consume(dir reassembly.TCPFlowDirection, data []byte, ts time.Time) {
+	b := &d.client
+	if dir == reassembly.TCPDirServerToClient {
+		b = &d.server
+	}
+
+	b.data.Write(data)
+	b.segments = append(b.segments, bufferedSegment{end: b.data.Len(), ts: ts})
+
+	d.parse(b)
+
+	if d.maxSize > 0 && b.data.Len() > d.maxSize {
+		reassemblyLog.Debug("incremental decoding buffer exceeded, discarding data",
+			zap.String("ident", d.ident),
+			zap.String("direction", dir.String()),
+			zap.Int("size", b.data.Len()),
+		)
+
+		d.stats.Lock()
+		d.stats.IncrementalBufferOverflows++
+		d.stats.Unlock()
+
+		b.reset()
+	}
+}
+
+// Finish parses the remaining buffered data of both directions,
+// parse functions can check Final to process incomplete messages.
+func (d *IncrementalDecoder) Finish() {
+	d.final = true
+
+	d.parse(&d.client)
+	d.parse(&d.server)
+}
+
+// Final returns true if the conversation has been finished and there will be no more data.
+func (d *IncrementalDecoder) Final() bool {
+	return d.final
+}
+
+// Timestamp returns the time of the fragment that contains the beginning of the message that is currently parsed.
+func (d *IncrementalDecoder) Timestamp() time.Time {
+	if d.current == nil || len(d.current.segments) == 0 {
+		return time.Time{}
+	}
+
+	return d.current.segments[0].ts
+}
+
+// Need can be called by the parse functions, if the message requires at least n more bytes
+// after the current position of the reader, e.g. for a body with known length.
+// If the message is incomplete, it will not be parsed again until enough data has been buffered.
+func (d *IncrementalDecoder) Need(n int) {
+	if d.current == nil {
+		return
+	}
+
+	d.current.need = d.current.data.Len() - d.reader.Len() - d.buf.Buffered() + n
+}
+
+// parse invokes the parse function until all complete messages in the buffer have been consumed.
+func (d *IncrementalDecoder) parse(b *incrementalBuffer) {
+	// wait until the incomplete message can be parsed
+	if !d.final && b.data.Len() < b.need {
+		return
+	}
+
+	d.current = b
+	defer func() {
+		d.current, d.reader, d.buf = nil, nil, nil
+	}()
+
+	for b.data.Len() > 0 {
+		b.need = 0
+
+		d.reader = bytes.NewReader(b.data.Bytes())
+		d.buf = bufio.NewReader(d.reader)
+
+		var (
+			r   = d.reader
+			br  = d.buf
+			err = b.parse(br)
+		)
+
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, ErrIncomplete) {
+				reassemblyLog.Error("error reading stream",
+					zap.Error(err),
+					zap.String("ident", d.ident),
+				)
+
+				// the stream can not be parsed any further
+				b.reset()
+			} else if d.final {
+				b.reset()
+			}
+
+			return
+		}
+
+		consumed := b.data.Len() - r.Len() - br.Buffered()
+		if consumed <= 0 {
+			return
+		}
+
+		b.advance(consumed)
+	}
+}
+
+// reset discards all buffered data.
+func (b *incrementalBuffer) reset() {
+	b.data.Reset()
+	b.segments = b.segments[:0]
+	b.need = 0
+}
+
+// advance removes n bytes from the beginning of the buffer and drops the segments that have been consumed entirely.
+func (b *incrementalBuffer) advance(n int) {
+	b.data.Next(n)
+
+	var i int
+	for i < len(b.segments) && b.segments[i].end <= n {
+		i++
+	}
+
+	b.segments = b.segments[i:]
+	for j := range b.segments {
+		b.segments[j].end -= n
+	}
+}
@@ -0,0 +1,132 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package utils
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dreadl0ck/netcap/reassembly"
+)
+
+func TestIncrementalDecoder(t *testing.T) {
+	var (
+		lines []string
+		times []time.Time
+		d     *IncrementalDecoder
+	)
+
+	readLine := func(b *bufio.Reader) error {
+		line, err := b.ReadString('\n')
+		if err != nil {
+			if d.Final() && line != "" {
+				lines = append(lines, line)
+
+				return nil
+			}
+
+			return err
+		}
+
+		lines = append(lines, strings.TrimSpace(line))
+		times = append(times, d.Timestamp())
+
+		return nil
+	}
+
+	stats := new(Stats)
+
+	d = NewIncrementalDecoder("test", 16, stats, readLine, func(b *bufio.Reader) error {
+		_, err := io.Copy(ioutil.Discard, b)
+
+		return err
+	})
+
+	t1 := time.Unix(1, 0)
+	t2 := time.Unix(2, 0)
+
+	d.Consume(reassembly.TCPDirClientToServer, []byte("first\nsec"), t1)
+
+	if len(lines) != 1 || lines[0] != "first" || !times[0].Equal(t1) {
+		t.Fatal("unexpected lines after first fragment", lines, times)
+	}
+
+	d.Consume(reassembly.TCPDirClientToServer, []byte("ond\n"), t2)
+
+	// the message started in the first fragment
+	if len(lines) != 2 || lines[1] != "second" || !times[1].Equal(t1) {
+		t.Fatal("unexpected lines after second fragment", lines, times)
+	}
+
+	// exceeds the buffer size and is discarded
+	d.Consume(reassembly.TCPDirClientToServer, []byte("this line is way too long"), t2)
+
+	if d.client.data.Len() != 0 || stats.IncrementalBufferOverflows != 1 {
+		t.Fatal("expected buffer to be discarded")
+	}
+
+	d.Consume(reassembly.TCPDirClientToServer, []byte("last"), t2)
+	d.Finish()
+
+	if len(lines) != 3 || lines[2] != "last" {
+		t.Fatal("expected incomplete message to be passed on finish", lines)
+	}
+}
+
+func TestIncrementalDecoderNeed(t *testing.T) {
+	var (
+		calls int
+		body  string
+		d     *IncrementalDecoder
+	)
+
+	// messages are a single digit length header followed by the body
+	d = NewIncrementalDecoder("test", 0, new(Stats), func(b *bufio.Reader) error {
+		calls++
+
+		l, err := b.ReadByte()
+		if err != nil {
+			return err
+		}
+
+		d.Need(int(l - '0'))
+
+		buf := make([]byte, int(l-'0'))
+		if _, err = io.ReadFull(b, buf); err != nil {
+			return err
+		}
+
+		body = string(buf)
+
+		return nil
+	}, nil)
+
+	d.Consume(reassembly.TCPDirClientToServer, []byte("5a"), time.Time{})
+	d.Consume(reassembly.TCPDirClientToServer, []byte("b"), time.Time{})
+	d.Consume(reassembly.TCPDirClientToServer, []byte("c"), time.Time{})
+
+	if calls != 1 {
+		t.Fatal("expected incomplete message not to be parsed again, got calls:", calls)
+	}
+
+	d.Consume(reassembly.TCPDirClientToServer, []byte("de"), time.Time{})
+
+	if calls != 2 || body != "abcde" {
+		t.Fatal("unexpected result", calls, body)
+	}
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/dreadl0ck/netcap/decoder/stream/credentials"
 	"github.com/dreadl0ck/netcap/defaults"
 	"github.com/dreadl0ck/netcap/reassembly"
+	"github.com/dreadl0ck/netcap/types"
 	"github.com/dreadl0ck/netcap/utils"
 )
 
@@ -41,8 +42,8 @@ const (
 )
 
 // SaveConversation will save TCP / UDP conversations to disk
-// this also invokes the harvesters on the conversation banner
-func SaveConversation(proto string, conversation core.DataFragments, ident string, firstPacket time.Time, transport gopacket.Flow) error {
+// this also invokes the harvesters of the credentials decoder on the conversation banner
+func SaveConversation(conf *decoderconfig.Config, creds *credentials.Decoder, stats *Stats, proto string, conversation core.DataFragments, ident string, firstPacket time.Time, transport gopacket.Flow, tunnel *types.Tunnel) error {
 	// prevent processing zero bytes
 	if len(conversation) == 0 || conversation.Size() == 0 {
 		return nil
@@ -50,10 +51,10 @@ func SaveConversation(proto string, conversation core.DataFragments, ident strin
 
 	// fmt.Println("saving conv", conversation.size(), ident)
 
-	banner := createBannerFromConversation(conversation)
-	credentials.RunHarvesters(banner, transport, ident, firstPacket)
+	banner := createBannerFromConversation(conversation, conf.HarvesterBannerSize)
+	creds.RunHarvesters(banner, transport, ident, firstPacket, tunnel)
 
-	if !decoderconfig.Instance.SaveConns {
+	if !conf.SaveConns {
 		return nil
 	}
 
@@ -61,7 +62,7 @@ func SaveConversation(proto string, conversation core.DataFragments, ident strin
 		typ = getServiceName(banner, transport, proto)
 
 		// path for storing the data
-		root = filepath.Join(decoderconfig.Instance.Out, strings.ToLower(proto), typ)
+		root = filepath.Join(conf.Out, strings.ToLower(proto), typ)
 
 		// file basename
 		base = filepath.Clean(path.Base(utils.CleanIdent(ident))) + binaryFileExtension
@@ -80,14 +81,14 @@ func SaveConversation(proto string, conversation core.DataFragments, ident strin
 
 	reassemblyLog.Info("saveConversation", zap.String("base", base))
 
-	Stats.Lock()
+	stats.Lock()
 	switch proto {
 	case protoTCP:
-		Stats.SavedTCPConnections++
+		stats.SavedTCPConnections++
 	case protoUDP:
-		Stats.SavedUDPConnections++
+		stats.SavedUDPConnections++
 	}
-	Stats.Unlock()
+	stats.Unlock()
 
 retry:
 	// append to files
@@ -127,7 +128,7 @@ retry:
 				_, _ = w.WriteString(ansi.Reset)
 			}
 
-			if decoderconfig.Instance.Debug {
+			if conf.Debug {
 				var ts string
 				if d.Context() != nil {
 					ts = "\n[" + d.Context().GetCaptureInfo().Timestamp.String() + "]\n"
@@ -150,7 +151,7 @@ retry:
 				_, _ = w.Write(d.Raw())
 				_, _ = w.WriteString(ansi.Reset)
 			}
-			if decoderconfig.Instance.Debug {
+			if conf.Debug {
 				_, _ = w.WriteString("\n[" + d.CaptureInfo().Timestamp.String() + "]\n")
 			}
 		}
@@ -196,9 +197,9 @@ retry:
 	return nil
 }
 
-func createBannerFromConversation(conversation core.DataFragments) []byte {
+func createBannerFromConversation(conversation core.DataFragments, size int) []byte {
 	var (
-		banner    = make([]byte, 0, decoderconfig.Instance.HarvesterBannerSize)
+		banner    = make([]byte, 0, size)
 		processed int
 	)
 
@@ -206,7 +207,7 @@ func createBannerFromConversation(conversation core.DataFragments) []byte {
 	// to use for the credential harvesters
 	for _, d := range conversation {
 		for _, b := range d.Raw() {
-			if processed >= decoderconfig.Instance.HarvesterBannerSize {
+			if processed >= size {
 				break
 			}
 
@@ -46,8 +46,9 @@ func SetLogger(l *zap.Logger) {
 	reassemblyLog = l
 }
 
-// SaveFile TODO: create a structure for passing all the args
-func SaveFile(conv *core.ConversationInfo, source, name string, err error, body []byte, encoding []string, host string, contentType string) error {
+// SaveFile stores an extracted file in the file storage of the configuration and writes its audit record with the file decoder.
+// TODO: create a structure for passing all the args
+func SaveFile(conf *decoderconfig.Config, files *file.Decoder, conv *core.ConversationInfo, source, name string, err error, body []byte, encoding []string, host string, contentType string) error {
 	reassemblyLog.Info("SaveFile",
 		zap.String("source", source),
 		zap.String("name", name),
@@ -73,7 +74,7 @@ func SaveFile(conv *core.ConversationInfo, source, name string, err error, body
 		cType = trimEncoding(http.DetectContentType(body))
 
 		// root path
-		root = path.Join(decoderconfig.Instance.Out, decoderconfig.Instance.FileStorage, cType)
+		root = path.Join(conf.Out, conf.FileStorage, cType)
 
 		// file extension
 		ext = file.ExtensionForContentType(cType)
@@ -107,8 +108,8 @@ func SaveFile(conv *core.ConversationInfo, source, name string, err error, body
 		base = base[:250] + "..."
 	}
 
-	if base == decoderconfig.Instance.FileStorage {
-		base = path.Join(decoderconfig.Instance.Out, decoderconfig.Instance.FileStorage, "noname")
+	if base == conf.FileStorage {
+		base = path.Join(conf.Out, conf.FileStorage, "noname")
 	}
 
 	var (
@@ -225,7 +226,7 @@ func SaveFile(conv *core.ConversationInfo, source, name string, err error, body
 			cTypeDetected = trimEncoding(http.DetectContentType(body))
 
 			// make sure root path exists
-			createContentTypePathIfRequired(path.Join(decoderconfig.Instance.Out, decoderconfig.Instance.FileStorage, cTypeDetected))
+			createContentTypePathIfRequired(path.Join(conf.Out, conf.FileStorage, cTypeDetected))
 
 			// switch the file extension and the path for the updated content type
 			ext = filepath.Ext(target)
@@ -253,7 +254,7 @@ func SaveFile(conv *core.ConversationInfo, source, name string, err error, body
 	}
 
 	// write file to disk
-	file.WriteFile(&types.File{
+	files.WriteFile(&types.File{
 		// TODO: use the actual timestamp when file has been transferred
 		Timestamp:           conv.FirstClientPacket.UnixNano(),
 		Name:                fileName,
@@ -270,6 +271,7 @@ func SaveFile(conv *core.ConversationInfo, source, name string, err error, body
 		SrcPort: conv.ServerPort,
 		DstPort: conv.ClientPort,
 		Host:    host,
+		Tunnel:  conv.Tunnel,
 	})
 
 	return nil
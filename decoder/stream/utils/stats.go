@@ -2,29 +2,44 @@ package utils
 
 import "sync"
 
-// Stats contains statistics about the stream reassembly.
-var Stats struct {
+// Stats contains statistics about the stream reassembly and the stream decoders of a collector.
+type Stats struct {
 	sync.Mutex
 
-	IPdefrag            int64
-	MissedBytes         int64
-	Pkt                 int64
-	Sz                  int64
-	Totalsz             int64
-	RejectFsm           int64
-	RejectOpt           int64
-	RejectConnFsm       int64
-	Reassembled         int64
-	OutOfOrderBytes     int64
-	OutOfOrderPackets   int64
-	BiggestChunkBytes   int64
-	BiggestChunkPackets int64
-	OverlapBytes        int64
-	OverlapPackets      int64
-	SavedTCPConnections int64
-	SavedUDPConnections int64
-	NumSoftware         int64
-	NumServices         int64
+	IPdefrag             int64
+	IP6defrag            int64
+	IP6FragmentAnomalies int64
+	MissedBytes          int64
+	Pkt                  int64
+	Sz                   int64
+	Totalsz              int64
+	RejectFsm            int64
+	RejectOpt            int64
+	RejectConnFsm        int64
+	Reassembled          int64
+	OutOfOrderBytes      int64
+	OutOfOrderPackets    int64
+	BiggestChunkBytes    int64
+	BiggestChunkPackets  int64
+	OverlapBytes         int64
+	OverlapPackets       int64
+	SavedTCPConnections  int64
+	SavedUDPConnections  int64
+	NumSoftware          int64
+	NumServices          int64
+
+	// overlapping segments with different data
+	InconsistentRetransmissions int64
+
+	// data discarded by incremental stream decoders, because a message exceeded the buffer size
+	IncrementalBufferOverflows int64
+
+	// UDP streams processed during capture, after they expired or to stay within the memory budget
+	ExpiredUDPStreams int64
+	EvictedUDPStreams int64
+
+	// conversations labeled by the pure Go application protocol classifier
+	ClassifiedConversations int64
 
 	Requests  int64
 	Responses int64
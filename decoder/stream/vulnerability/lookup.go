@@ -15,7 +15,6 @@ package vulnerability
 
 import (
 	"github.com/blevesearch/bleve"
-	"github.com/dreadl0ck/netcap/decoder/db"
 	"github.com/dreadl0ck/netcap/types"
 	"go.uber.org/zap"
 )
@@ -24,7 +23,7 @@ import (
 // TODO:
 // - Make the threshold configurable on the commandline
 // - add caching layer to avoid repeating matching operations.
-func VulnerabilitiesLookup(software *types.Software) {
+func (d *Decoder) VulnerabilitiesLookup(software *types.Software) {
 
 	if software == nil {
 		return
@@ -33,7 +32,7 @@ func VulnerabilitiesLookup(software *types.Software) {
 		return
 	}
 
-	if db.VulnerabilitiesIndex == nil {
+	if d.index == nil {
 		return
 	}
 
@@ -41,21 +40,21 @@ func VulnerabilitiesLookup(software *types.Software) {
 		queryTerm          = buildNVDQuery(software.Vendor, software.Product, software.Version)
 		query              = bleve.NewQueryStringQuery(queryTerm)
 		search             = bleve.NewSearchRequest(query)
-		searchResults, err = db.VulnerabilitiesIndex.Search(search)
+		searchResults, err = d.index.Search(search)
 	)
 
 	if err != nil {
-		vulnLog.Error("failed to search for vulnerable software", zap.Error(err))
+		d.log.Error("failed to search for vulnerable software", zap.Error(err))
 
 		return
 	}
 
-	vulnLog.Info("query vuln database", zap.String("query", queryTerm))
+	d.log.Info("query vuln database", zap.String("query", queryTerm))
 
 	for _, v := range searchResults.Hits {
 		if v.Score > ThresholdNVD {
-			doc, _ := db.VulnerabilitiesIndex.Document(v.ID)
-			writeVuln(software, doc)
+			doc, _ := d.index.Document(v.ID)
+			d.writeVuln(software, doc)
 		}
 	}
 }
@@ -159,22 +159,18 @@ type vulnerabilityStore struct {
 	items map[string]struct{}
 }
 
-var vulnStore = vulnerabilityStore{
-	items: make(map[string]struct{}),
-}
-
-func writeVuln(software *types.Software, doc *document.Document) {
+func (d *Decoder) writeVuln(software *types.Software, doc *document.Document) {
 	// use CVE as map index to deduplicate
 	id := string(doc.Fields[0].Value())
 
-	vulnStore.Lock()
-	if _, ok := vulnStore.items[id]; ok {
-		vulnStore.Unlock()
+	d.store.Lock()
+	if _, ok := d.store.items[id]; ok {
+		d.store.Unlock()
 		// exists, exit.
 		return
 	}
-	vulnStore.items[id] = struct{}{}
-	vulnStore.Unlock()
+	d.store.items[id] = struct{}{}
+	d.store.Unlock()
 
 	var score, vector string
 	if len(doc.Fields) > 3 {
@@ -182,10 +178,10 @@ func writeVuln(software *types.Software, doc *document.Document) {
 		vector = string(doc.Fields[4].Value())
 	}
 
-	atomic.AddInt64(&Decoder.NumRecordsWritten, 1)
+	atomic.AddInt64(&d.NumRecordsWritten, 1)
 
-	if Decoder.Writer != nil { // during unit tests, the vulnerability decoder might not be initialized
-		err := Decoder.Writer.Write(&types.Vulnerability{
+	if d.Writer != nil { // during unit tests, the vulnerability decoder might not be initialized
+		err := d.Writer.Write(&types.Vulnerability{
 			Timestamp:    software.Timestamp,
 			ID:           id,
 			Description:  strings.Trim(string(doc.Fields[1].Value()), "\""),
@@ -195,7 +191,7 @@ func writeVuln(software *types.Software, doc *document.Document) {
 			Software:     software,
 		})
 		if err != nil {
-			vulnLog.Error("failed to flush vulnerability audit record", zap.Error(err))
+			d.log.Error("failed to flush vulnerability audit record", zap.Error(err))
 		}
 	}
 }
@@ -14,30 +14,79 @@
 package vulnerability
 
 import (
+	"path/filepath"
+
+	"github.com/blevesearch/bleve"
+	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
 	"github.com/dreadl0ck/netcap/decoder"
 	decoderconfig "github.com/dreadl0ck/netcap/decoder/config"
+	"github.com/dreadl0ck/netcap/decoder/db"
 	logging "github.com/dreadl0ck/netcap/logger"
+	"github.com/dreadl0ck/netcap/resolvers"
 	"github.com/dreadl0ck/netcap/types"
 )
 
-var vulnLog = zap.NewNop()
-
 // Decoder for protocol analysis and writing audit records to disk.
-var Decoder = &decoder.AbstractDecoder{
-	Type:        types.Type_NC_Vulnerability,
-	Name:        "Vulnerability",
-	Description: "A vulnerability associated with a software product observed on the network",
-	PostInit: func(d *decoder.AbstractDecoder) (err error) {
-		vulnLog, _, err = logging.InitZapLogger(
-			decoderconfig.Instance.Out,
-			"vulnerability",
-			decoderconfig.Instance.Debug,
-		)
-		return err
-	},
-	DeInit: func(sd *decoder.AbstractDecoder) error {
-		return vulnLog.Sync()
-	},
+type Decoder struct {
+	*decoder.AbstractDecoder
+
+	conf *decoderconfig.Config
+	log  *zap.Logger
+
+	// vulnerability database, opened by the software decoder
+	index bleve.Index
+
+	// deduplicates the written vulnerabilities
+	store vulnerabilityStore
+}
+
+// NewDecoder returns a new vulnerability decoder that writes its audit records according to the configuration.
+func NewDecoder(conf *decoderconfig.Config) *Decoder {
+	d := &Decoder{
+		conf: conf,
+		log:  zap.NewNop(),
+		store: vulnerabilityStore{
+			items: make(map[string]struct{}),
+		},
+	}
+
+	d.AbstractDecoder = &decoder.AbstractDecoder{
+		Type:        types.Type_NC_Vulnerability,
+		Name:        "Vulnerability",
+		Description: "A vulnerability associated with a software product observed on the network",
+		PostInit: func(*decoder.AbstractDecoder) (err error) {
+			d.log, _, err = logging.InitZapLogger(
+				d.conf.Out,
+				"vulnerability",
+				d.conf.Debug,
+			)
+			return err
+		},
+		DeInit: func(*decoder.AbstractDecoder) error {
+			return d.log.Sync()
+		},
+	}
+
+	return d
+}
+
+// OpenIndex opens the vulnerability database used for the lookups.
+func (d *Decoder) OpenIndex() error {
+	indexName := filepath.Join(resolvers.DataBaseFolderPath, db.VulnerabilityDBName)
+
+	index, err := db.AcquireBleve(indexName)
+	if err != nil {
+		return errors.Wrap(err, "failed to open vulnerability bleve index at: "+indexName)
+	}
+
+	d.index = index
+
+	return nil
+}
+
+// CloseIndex releases the vulnerability database.
+func (d *Decoder) CloseIndex() {
+	db.ReleaseBleve(d.index)
 }
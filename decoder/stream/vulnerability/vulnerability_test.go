@@ -28,6 +28,9 @@ import (
 
 const debugVulnerabilityTests = false
 
+// vulnerabilitiesIndex is the vulnerability database used by the tests.
+var vulnerabilitiesIndex bleve.Index
+
 var testSoftware = []*types.Software{
 	{
 		Product: "PostgreSQL",
@@ -152,7 +155,7 @@ func vulnerabilitiesLookupTest(software *types.Software) *bleve.SearchResult {
 		queryTerm          = software.Product + " " + software.Version
 		query              = bleve.NewMatchQuery(queryTerm)
 		search             = bleve.NewSearchRequest(query)
-		searchResults, err = db.VulnerabilitiesIndex.Search(search)
+		searchResults, err = vulnerabilitiesIndex.Search(search)
 	)
 	if debugVulnerabilityTests {
 		fmt.Println("searching for", queryTerm)
@@ -225,7 +228,7 @@ func nvdVulnerabilitiesLookupTest(soft *types.Software) *bleve.SearchResult {
 		query = bleve.NewQueryStringQuery(queryTerm)
 		// query = bleve.NewMatchQuery("Google Chrome")
 		search             = bleve.NewSearchRequest(query)
-		searchResults, err = db.VulnerabilitiesIndex.Search(search)
+		searchResults, err = vulnerabilitiesIndex.Search(search)
 	)
 	if debugVulnerabilityTests {
 		fmt.Println("searching for", queryTerm)
@@ -248,11 +251,11 @@ func TestNVDVulnSearchIndexV2(t *testing.T) {
 			Version: "9.1.0",
 		}
 	)
-	db.VulnerabilitiesIndex, err = db.OpenBleve(indexName)
+	vulnerabilitiesIndex, err = db.OpenBleve(indexName)
 	if err != nil {
 		t.Fatal(err)
 	}
-	defer db.CloseBleve(db.VulnerabilitiesIndex)
+	defer db.CloseBleve(vulnerabilitiesIndex)
 
 	if debugVulnerabilityTests {
 		fmt.Println("lookup software", soft.Vendor, soft.Product, soft.Version)
@@ -268,7 +271,7 @@ func TestNVDVulnSearchIndexV2(t *testing.T) {
 		for _, v := range searchResults.Hits {
 
 			var color string
-			doc, _ := db.VulnerabilitiesIndex.Document(v.ID)
+			doc, _ := vulnerabilitiesIndex.Document(v.ID)
 			if v.Score > ThresholdNVD {
 				color = ansi.Red
 			}
@@ -287,11 +290,11 @@ func TestNVDVulnSearch(t *testing.T) {
 		indexName = filepath.Join(resolvers.DataBaseFolderPath, "nvd.bleve")
 		err       error
 	)
-	db.VulnerabilitiesIndex, err = db.OpenBleve(indexName)
+	vulnerabilitiesIndex, err = db.OpenBleve(indexName)
 	if err != nil {
 		t.Fatal(err)
 	}
-	defer db.CloseBleve(db.VulnerabilitiesIndex)
+	defer db.CloseBleve(vulnerabilitiesIndex)
 
 	for _, s := range testSoftware {
 
@@ -309,7 +312,7 @@ func TestNVDVulnSearch(t *testing.T) {
 			for _, v := range searchResults.Hits {
 
 				var color string
-				doc, _ := db.VulnerabilitiesIndex.Document(v.ID)
+				doc, _ := vulnerabilitiesIndex.Document(v.ID)
 				if v.Score > ThresholdNVD {
 					color = ansi.Red
 				}
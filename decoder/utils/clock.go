@@ -0,0 +1,110 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package utils
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// CaptureClock keeps track of the capture time of the processed packets,
+// so that timeouts can be checked in the background for pcap files and live captures alike.
+type CaptureClock struct {
+	// latest capture timestamp and the wall clock time when it was observed, in nanoseconds
+	capture int64
+	wall    int64
+}
+
+// Observe records the capture timestamp of a packet.
+func (c *CaptureClock) Observe(ts time.Time) {
+	if ns := ts.UnixNano(); ns > atomic.LoadInt64(&c.capture) {
+		atomic.StoreInt64(&c.capture, ns)
+		atomic.StoreInt64(&c.wall, time.Now().UnixNano())
+	}
+}
+
+// Now returns the latest capture timestamp, advanced by the wall clock time that passed since it was observed.
+// This lets idle flows expire when no more packets arrive, while pcap files are processed in capture time.
+// The zero time is returned before the first packet has been observed.
+func (c *CaptureClock) Now() time.Time {
+	capture := atomic.LoadInt64(&c.capture)
+	if capture == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, capture).Add(time.Since(time.Unix(0, atomic.LoadInt64(&c.wall))))
+}
+
+// Ticker calls a function in intervals from a background goroutine.
+type Ticker struct {
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+// ExpiryInterval returns the interval to check for the given timeout:
+// half of the timeout, between one and ten seconds.
+func ExpiryInterval(timeout time.Duration) time.Duration {
+	interval := timeout / 2
+	if interval < time.Second {
+		return time.Second
+	}
+
+	if interval > 10*time.Second {
+		return 10 * time.Second
+	}
+
+	return interval
+}
+
+// StartTicker calls f every interval until the ticker is stopped.
+func StartTicker(interval time.Duration, f func()) *Ticker {
+	t := &Ticker{
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+
+	go func() {
+		defer close(t.done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				f()
+			case <-t.stop:
+				return
+			}
+		}
+	}()
+
+	return t
+}
+
+// Stop stops the ticker and waits for a running call to return.
+// It is safe to call Stop on a nil Ticker and multiple times.
+func (t *Ticker) Stop() {
+	if t == nil {
+		return
+	}
+
+	t.stopOnce.Do(func() {
+		close(t.stop)
+	})
+
+	<-t.done
+}
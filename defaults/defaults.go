@@ -23,6 +23,31 @@ const (
 	// BufferSize is the size for memory buffering before feeding data into compressor.
 	BufferSize = 1024 * 1024 * 12 // 12 MB
 
+	// OutputBufferSize is the number of audit records buffered for each output when writing to multiple outputs.
+	OutputBufferSize = 10000
+
+	// ParquetRowGroupSize is the number of audit records per row group for parquet files.
+	ParquetRowGroupSize = 100000
+
+	// ParquetCompression is the compression codec for the pages of parquet files.
+	ParquetCompression = "snappy"
+
+	// IPFIXVersion is the version of the exported flow records, 10 for IPFIX or 9 for NetFlow version 9.
+	IPFIXVersion = 10
+
+	// IPFIXEnterpriseNumber is the private enterprise number of the netcap information elements in IPFIX templates.
+	// 32473 is reserved for documentation by RFC 5612 and should be replaced with the number of the organization.
+	IPFIXEnterpriseNumber = 32473
+
+	// IPFIXTemplateRefresh is the number of messages after which the templates are resent to a collector.
+	IPFIXTemplateRefresh = 20
+
+	// SQLiteBatchSize is the number of audit records inserted per sqlite transaction.
+	SQLiteBatchSize = 10000
+
+	// IndexBlockSize is the number of audit records per block of the time index.
+	IndexBlockSize = 10000
+
 	// PacketBuffer is the size of the channel for feeding packets into workers.
 	PacketBuffer = 1000
 
@@ -34,6 +59,9 @@ const (
 	// TODO: refactor to flush periodically, instead of every n packets?
 	ConnFlushInterval = 1000
 
+	// ConnectionFeaturesPackets is the number of packets whose lengths and inter-arrival times are collected for Connection audit records.
+	ConnectionFeaturesPackets = 20
+
 	// FlowFlushInterval configures how often the connections are flushed for Flow and Connection audit record generation.
 	// TODO: refactor to flush periodically, instead of every n packets?
 	FlowFlushInterval = 1000
@@ -50,6 +78,9 @@ const (
 	// CompressionLevel is the compression level to use by default.
 	CompressionLevel = flate.BestSpeed
 
+	// CompressionCodec is the codec for compressed audit record files: gzip or zstd.
+	CompressionCodec = "gzip"
+
 	// TCP Stream Reassembly:
 	// default settings are meant to be forgiving in terms of TCP state machine correctness
 	// in order to capture as much information as possible.
@@ -73,6 +104,62 @@ const (
 	// DefragIPv4 controls defragmentation for IPv4.
 	DefragIPv4 = true
 
+	// DefragIPv6 controls defragmentation for IPv6.
+	DefragIPv6 = false
+
+	// DefragIPv6Timeout incomplete IPv6 datagrams are discarded after this duration.
+	DefragIPv6Timeout = 60 * time.Second
+
+	// DefragIPv6MaxFragments datagrams with more fragments are discarded.
+	DefragIPv6MaxFragments = 256
+
+	// DefragIPv6MaxDatagrams limits the number of IPv6 datagrams that are reassembled at the same time.
+	DefragIPv6MaxDatagrams = 4096
+
+	// DefragIPv6BufferSize limits the fragment data buffered for incomplete IPv6 datagrams in bytes.
+	DefragIPv6BufferSize = 32 << 20
+
+	// DefragIPv6TinyFragmentSize fragments except the last one smaller than this are discarded as tiny fragments.
+	DefragIPv6TinyFragmentSize = 256
+
+	// ReassemblyPageBudget limits the memory for out-of-order TCP data buffered by the reassembly and for connection state in bytes,
+	// data passed to the stream decoders is not included, 0 disables the limit.
+	// The budget is shared by all collectors in the process.
+	ReassemblyPageBudget = 0
+
+	// ReassemblyEviction selects the connections that are closed first, once the memory budget is exceeded.
+	ReassemblyEviction = "oldest"
+
+	// IncrementalStreamDecoding controls whether stream decoders process TCP connections while they are reassembled.
+	IncrementalStreamDecoding = false
+
+	// IncrementalStreamBufferSize limits the buffered data per connection direction for incremental decoding in bytes.
+	IncrementalStreamBufferSize = 10 << 20
+
+	// UDPStreamIdleTimeout UDP streams that received no packets for this duration are processed during capture.
+	UDPStreamIdleTimeout = 1 * time.Minute
+
+	// UDPStreamMaxAge UDP streams older than this are processed during capture, even if they are still active.
+	UDPStreamMaxAge = 10 * time.Minute
+
+	// UDPStreamMemoryBudget limits the memory for buffered UDP stream data in bytes, 0 disables the limit.
+	UDPStreamMemoryBudget = 0
+
+	// SCTPIdleTimeout SCTP associations that received no packets for this duration are written during capture.
+	SCTPIdleTimeout = 5 * time.Minute
+
+	// StreamDecoderPorts contains the ports each stream decoder is tried on first, before falling back to all decoders.
+	StreamDecoderPorts = "HTTP:80,8000,8080;POP3:110;SSH:22;SMTP:25,587,2525"
+
+	// StreamDecoderForce contains the stream decoders used for a server IP:port, without checking the conversation content.
+	StreamDecoderForce = ""
+
+	// CommunityIDSeed is the seed for the Community ID flow hashes.
+	CommunityIDSeed = 0
+
+	// Decapsulate controls whether packets transported in tunnels are decoded as separate packets.
+	Decapsulate = false
+
 	// NoOptCheck controls TCP option checking for the reassembly state machine.
 	NoOptCheck = true
 
@@ -97,6 +184,9 @@ const (
 	// FileExtensionCompressed of gzipped netcap files.
 	FileExtensionCompressed = ".ncap.gz"
 
+	// FileExtensionZstd of zstd compressed netcap files.
+	FileExtensionZstd = ".ncap.zst"
+
 	// ElasticLimitTotalFields is the maximum number of fields allowed per batch of audit records.
 	ElasticLimitTotalFields = 1000000
 
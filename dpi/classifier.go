@@ -0,0 +1,184 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dpi
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// The pure Go classifier labels reassembled conversations with their application protocol,
+// based on payload signatures, the sizes of the first messages and the server port.
+// It does not require the native nDPI and libprotoident libraries,
+// and is used instead of them in builds with the nodpi tag.
+
+// sources of a classification result.
+const (
+	// the payload matched a signature of the protocol
+	sourcePayload = "payload"
+
+	// the sizes of the first messages are characteristic for the protocol
+	sourceSize = "size"
+
+	// no signature matched, the protocol is guessed from the server port
+	sourcePort = "port"
+)
+
+// Transport protocols of a conversation.
+const (
+	TransportTCP = "TCP"
+	TransportUDP = "UDP"
+)
+
+// Conversation contains the information about a reassembled conversation used for classification.
+type Conversation struct {
+
+	// TCP or UDP
+	Transport string
+
+	ClientPort int32
+	ServerPort int32
+
+	// first data sent by the client and the server
+	Client []byte
+	Server []byte
+
+	// sizes of the first messages, positive for data sent by the client and negative for data sent by the server
+	Sizes []int
+}
+
+// Result is the application protocol identified for a conversation.
+type Result struct {
+	Protocol string
+	Category string
+
+	// how the protocol has been identified: payload, size or port
+	Source string
+}
+
+// signature describes how to identify an application protocol.
+type signature struct {
+	protocol string
+	category string
+
+	// transport protocol, empty if the protocol can be transported via TCP and UDP
+	transport string
+
+	// ports the protocol is commonly served on
+	ports []int32
+
+	// source of the result if match returned true
+	source string
+
+	// checks the conversation, nil if the protocol can only be guessed from the port
+	match func(c *Conversation) bool
+}
+
+// classifierEnabled is set to 1 once the classifier has been enabled for the process.
+var classifierEnabled int32
+
+// EnableClassifier enables the classification of reassembled conversations with the pure Go classifier.
+func EnableClassifier() {
+	atomic.StoreInt32(&classifierEnabled, 1)
+}
+
+// ClassifierEnabled returns true if reassembled conversations shall be classified with the pure Go classifier.
+func ClassifierEnabled() bool {
+	return atomic.LoadInt32(&classifierEnabled) == 1
+}
+
+// Conversations contains the results for the conversations that are currently decoded by a collector, by flow identifier,
+// so that they can be added to the audit records produced for them.
+type Conversations struct {
+	sync.Mutex
+	items map[string]Result
+}
+
+// NewConversations returns an empty store for classification results.
+func NewConversations() *Conversations {
+	return &Conversations{
+		items: make(map[string]Result),
+	}
+}
+
+// Classify returns the application protocol of the conversation.
+// Signatures of protocols served on the server port are checked first,
+// if no signature matched, the protocol is guessed from the server port.
+func Classify(c *Conversation) (Result, bool) {
+	for _, s := range signatures {
+		if s.match != nil && s.supports(c.Transport) && s.hasPort(c.ServerPort) && s.match(c) {
+			return s.result(s.source), true
+		}
+	}
+
+	for _, s := range signatures {
+		if s.match != nil && s.supports(c.Transport) && !s.hasPort(c.ServerPort) && s.match(c) {
+			return s.result(s.source), true
+		}
+	}
+
+	for _, s := range signatures {
+		if s.supports(c.Transport) && s.hasPort(c.ServerPort) {
+			return s.result(sourcePort), true
+		}
+	}
+
+	return Result{}, false
+}
+
+// Add stores the result for a conversation that is currently decoded.
+func (c *Conversations) Add(ident string, res Result) {
+	c.Lock()
+	c.items[ident] = res
+	c.Unlock()
+}
+
+// Lookup returns the result for a conversation that is currently decoded.
+func (c *Conversations) Lookup(ident string) (Result, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	res, ok := c.items[ident]
+
+	return res, ok
+}
+
+// Remove removes the result for a conversation once it has been decoded.
+func (c *Conversations) Remove(ident string) {
+	c.Lock()
+	delete(c.items, ident)
+	c.Unlock()
+}
+
+func (s *signature) supports(transport string) bool {
+	return s.transport == "" || s.transport == transport
+}
+
+func (s *signature) hasPort(port int32) bool {
+	for _, p := range s.ports {
+		if p == port {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *signature) result(source string) Result {
+	return Result{
+		Protocol: s.protocol,
+		Category: s.category,
+		Source:   source,
+	}
+}
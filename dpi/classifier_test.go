@@ -0,0 +1,141 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dpi
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestClassify(t *testing.T) {
+	var (
+		wgInit = append([]byte{0x01, 0x00, 0x00, 0x00}, make([]byte, 144)...)
+		wgResp = append([]byte{0x02, 0x00, 0x00, 0x00}, make([]byte, 88)...)
+		ntp    = append([]byte{0x23}, make([]byte, 47)...)
+		dnsReq = []byte{0xab, 0xcd, 0x01, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 'f', 'o', 'o', 0x00, 0x00, 0x01, 0x00, 0x01}
+		dnsRes = []byte{0xab, 0xcd, 0x81, 0x80, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 'f', 'o', 'o', 0x00, 0x00, 0x01, 0x00, 0x01}
+	)
+
+	for _, c := range []struct {
+		name     string
+		conv     *Conversation
+		protocol string
+		source   string
+	}{
+		{
+			name: "HTTP on a custom port",
+			conv: &Conversation{
+				Transport:  TransportTCP,
+				ServerPort: 1234,
+				Client:     []byte("GET / HTTP/1.1\r\nHost: example.com\r\n\r\n"),
+				Server:     []byte("HTTP/1.1 200 OK\r\n\r\n"),
+			},
+			protocol: "HTTP",
+			source:   sourcePayload,
+		},
+		{
+			name: "TLS client hello",
+			conv: &Conversation{
+				Transport:  TransportTCP,
+				ServerPort: 4443,
+				Client:     []byte{0x16, 0x03, 0x01, 0x00, 0x05, 0x01, 0x00, 0x00, 0x01, 0x03},
+			},
+			protocol: "TLS",
+			source:   sourcePayload,
+		},
+		{
+			name: "SSH banner",
+			conv: &Conversation{
+				Transport:  TransportTCP,
+				ServerPort: 22,
+				Server:     []byte("SSH-2.0-OpenSSH_8.2\r\n"),
+			},
+			protocol: "SSH",
+			source:   sourcePayload,
+		},
+		{
+			name: "DNS over UDP",
+			conv: &Conversation{
+				Transport:  TransportUDP,
+				ServerPort: 53,
+				Client:     dnsReq,
+				Server:     dnsRes,
+			},
+			protocol: "DNS",
+			source:   sourcePayload,
+		},
+		{
+			name: "DNS over TCP",
+			conv: &Conversation{
+				Transport:  TransportTCP,
+				ServerPort: 53,
+				Client:     append([]byte{0x00, byte(len(dnsReq))}, dnsReq...),
+				Server:     append([]byte{0x00, byte(len(dnsRes))}, dnsRes...),
+			},
+			protocol: "DNS",
+			source:   sourcePayload,
+		},
+		{
+			name: "NTP client request",
+			conv: &Conversation{
+				Transport:  TransportUDP,
+				ServerPort: 123,
+				Client:     ntp,
+			},
+			protocol: "NTP",
+			source:   sourceSize,
+		},
+		{
+			name: "WireGuard handshake on a custom port",
+			conv: &Conversation{
+				Transport:  TransportUDP,
+				ServerPort: 40000,
+				Client:     wgInit,
+				Server:     wgResp,
+				Sizes:      []int{148, -92},
+			},
+			protocol: "WIREGUARD",
+			source:   sourceSize,
+		},
+		{
+			name: "unknown payload on a well known port",
+			conv: &Conversation{
+				Transport:  TransportTCP,
+				ServerPort: 443,
+				Client:     bytes.Repeat([]byte{0x42}, 32),
+			},
+			protocol: "TLS",
+			source:   sourcePort,
+		},
+	} {
+		res, ok := Classify(c.conv)
+		if !ok {
+			t.Error(c.name, ": no result")
+
+			continue
+		}
+
+		if res.Protocol != c.protocol || res.Source != c.source {
+			t.Error(c.name, ": expected", c.protocol, "from", c.source, "got", res.Protocol, "from", res.Source)
+		}
+	}
+
+	if res, ok := Classify(&Conversation{
+		Transport:  TransportUDP,
+		ServerPort: 40000,
+		Client:     bytes.Repeat([]byte{0x42}, 32),
+	}); ok {
+		t.Error("expected no result for unknown payload on an unknown port, got", res.Protocol)
+	}
+}
@@ -27,7 +27,10 @@ func IsEnabled() bool {
 	return false
 }
 
-func Init() {}
+// Init enables the pure Go classifier for reassembled conversations, since the native libraries are not available.
+func Init() {
+	EnableClassifier()
+}
 
 func Destroy() {}
 
@@ -0,0 +1,606 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dpi
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// categories, named like the ones reported by nDPI and libprotoident.
+const (
+	categoryWeb          = "WEB"
+	categoryMail         = "MAIL"
+	categoryChat         = "CHAT"
+	categoryP2P          = "P2P"
+	categoryEncrypt      = "ENCRYPT"
+	categoryRemote       = "REMOTE"
+	categoryFiles        = "FILES"
+	categoryDatabases    = "DATABASES"
+	categoryServices     = "SERVICES"
+	categoryMonitoring   = "MONITORING"
+	categoryLogging      = "LOGGING"
+	categoryVoIP         = "VOIP"
+	categoryStreaming    = "STREAMING"
+	categoryTunnelling   = "TUNNELLING"
+	categorySecurity     = "SECURITY"
+	categoryMessageQueue = "MESSAGE_QUEUE"
+	categoryICS          = "ICS"
+)
+
+var httpMethods = [][]byte{
+	[]byte("GET "),
+	[]byte("POST "),
+	[]byte("HEAD "),
+	[]byte("PUT "),
+	[]byte("DELETE "),
+	[]byte("OPTIONS "),
+	[]byte("CONNECT "),
+	[]byte("PATCH "),
+	[]byte("TRACE "),
+}
+
+// signatures of the supported protocols,
+// protocols with more specific signatures must be listed before those they could be confused with.
+var signatures = []*signature{
+	{
+		protocol: "SIP",
+		category: categoryVoIP,
+		ports:    []int32{5060, 5061},
+		source:   sourcePayload,
+		match: func(c *Conversation) bool {
+			return hasPrefix(c.Server, "SIP/2.0 ") || bytes.Contains(firstLine(c.Client), []byte(" sip:"))
+		},
+	},
+	{
+		protocol:  "RTSP",
+		category:  categoryStreaming,
+		transport: TransportTCP,
+		ports:     []int32{554, 8554},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			return hasPrefix(c.Server, "RTSP/1.0 ") || bytes.Contains(firstLine(c.Client), []byte(" rtsp://"))
+		},
+	},
+	{
+		protocol:  "SSDP",
+		category:  categoryServices,
+		transport: TransportUDP,
+		ports:     []int32{1900},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			return hasPrefix(c.Client, "M-SEARCH * HTTP/1.1") || hasPrefix(c.Client, "NOTIFY * HTTP/1.1")
+		},
+	},
+	{
+		protocol:  "HTTP2",
+		category:  categoryWeb,
+		transport: TransportTCP,
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			return hasPrefix(c.Client, "PRI * HTTP/2.0\r\n\r\nSM\r\n\r\n")
+		},
+	},
+	{
+		protocol:  "HTTP",
+		category:  categoryWeb,
+		transport: TransportTCP,
+		ports:     []int32{80, 8000, 8008, 8080, 8888},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			if hasPrefix(c.Server, "HTTP/1.") {
+				return true
+			}
+
+			for _, m := range httpMethods {
+				if bytes.HasPrefix(c.Client, m) && bytes.Contains(firstLine(c.Client), []byte(" HTTP/1.")) {
+					return true
+				}
+			}
+
+			return false
+		},
+	},
+	{
+		protocol:  "TLS",
+		category:  categoryEncrypt,
+		transport: TransportTCP,
+		ports:     []int32{443, 465, 636, 853, 993, 995, 8443},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			return isTLSHandshake(c.Client, 0x01) || isTLSHandshake(c.Server, 0x02)
+		},
+	},
+	{
+		protocol:  "QUIC",
+		category:  categoryWeb,
+		transport: TransportUDP,
+		ports:     []int32{443, 80},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			// long header packet with a known version, initial packets of the client are padded to at least 1200 bytes
+			if len(c.Client) < 1200 || c.Client[0]&0xc0 != 0xc0 {
+				return false
+			}
+
+			switch binary.BigEndian.Uint32(c.Client[1:5]) {
+			case 0x00000001, 0x6b3343cf, 0xff00001d:
+				return true
+			}
+
+			return false
+		},
+	},
+	{
+		protocol:  "SSH",
+		category:  categoryRemote,
+		transport: TransportTCP,
+		ports:     []int32{22, 2222},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			return hasPrefix(c.Client, "SSH-") || hasPrefix(c.Server, "SSH-")
+		},
+	},
+	{
+		protocol:  "MAIL_SMTP",
+		category:  categoryMail,
+		transport: TransportTCP,
+		ports:     []int32{25, 587, 2525},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			return hasPrefix(c.Server, "220") && (hasPrefixFold(c.Client, "EHLO ") || hasPrefixFold(c.Client, "HELO "))
+		},
+	},
+	{
+		protocol:  "FTP",
+		category:  categoryFiles,
+		transport: TransportTCP,
+		ports:     []int32{21},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			return hasPrefix(c.Server, "220") && (hasPrefixFold(c.Client, "USER ") || hasPrefixFold(c.Client, "AUTH TLS") || hasPrefixFold(c.Client, "FEAT"))
+		},
+	},
+	{
+		protocol:  "MAIL_POP",
+		category:  categoryMail,
+		transport: TransportTCP,
+		ports:     []int32{110},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			return hasPrefix(c.Server, "+OK") && (hasPrefixFold(c.Client, "USER ") || hasPrefixFold(c.Client, "CAPA") ||
+				hasPrefixFold(c.Client, "APOP ") || hasPrefixFold(c.Client, "AUTH") || hasPrefixFold(c.Client, "STLS"))
+		},
+	},
+	{
+		protocol:  "MAIL_IMAP",
+		category:  categoryMail,
+		transport: TransportTCP,
+		ports:     []int32{143},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			return hasPrefix(c.Server, "* OK") || hasPrefix(c.Server, "* PREAUTH")
+		},
+	},
+	{
+		protocol:  "TELNET",
+		category:  categoryRemote,
+		transport: TransportTCP,
+		ports:     []int32{23},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			return isTelnetNegotiation(c.Server) || isTelnetNegotiation(c.Client)
+		},
+	},
+	{
+		protocol:  "RDP",
+		category:  categoryRemote,
+		transport: TransportTCP,
+		ports:     []int32{3389},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			// TPKT header followed by a X.224 connection request
+			return len(c.Client) >= 11 && c.Client[0] == 0x03 && c.Client[1] == 0x00 &&
+				int(binary.BigEndian.Uint16(c.Client[2:4])) == len(c.Client) && c.Client[5] == 0xe0
+		},
+	},
+	{
+		protocol:  "VNC",
+		category:  categoryRemote,
+		transport: TransportTCP,
+		ports:     []int32{5900, 5901},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			return hasPrefix(c.Server, "RFB 0")
+		},
+	},
+	{
+		protocol:  "SMBV1",
+		category:  categoryFiles,
+		transport: TransportTCP,
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			return len(c.Client) >= 8 && c.Client[0] == 0x00 && bytes.Equal(c.Client[4:8], []byte("\xffSMB"))
+		},
+	},
+	{
+		protocol:  "SMBV23",
+		category:  categoryFiles,
+		transport: TransportTCP,
+		ports:     []int32{445, 139},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			return len(c.Client) >= 8 && c.Client[0] == 0x00 && bytes.Equal(c.Client[4:8], []byte("\xfeSMB"))
+		},
+	},
+	{
+		protocol:  "MYSQL",
+		category:  categoryDatabases,
+		transport: TransportTCP,
+		ports:     []int32{3306},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			// initial handshake of the server: 3 byte length, sequence id 0 and protocol version 10
+			return len(c.Server) >= 5 && c.Server[3] == 0x00 && c.Server[4] == 0x0a &&
+				int(c.Server[0])|int(c.Server[1])<<8|int(c.Server[2])<<16 == len(c.Server)-4
+		},
+	},
+	{
+		protocol:  "POSTGRES",
+		category:  categoryDatabases,
+		transport: TransportTCP,
+		ports:     []int32{5432},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			// startup message or SSL request
+			if len(c.Client) < 8 || int(binary.BigEndian.Uint32(c.Client[0:4])) != len(c.Client) {
+				return false
+			}
+
+			switch binary.BigEndian.Uint32(c.Client[4:8]) {
+			case 0x00030000, 80877103:
+				return true
+			}
+
+			return false
+		},
+	},
+	{
+		protocol:  "REDIS",
+		category:  categoryDatabases,
+		transport: TransportTCP,
+		ports:     []int32{6379},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			return len(c.Client) > 4 && c.Client[0] == '*' && c.Client[1] >= '0' && c.Client[1] <= '9' &&
+				bytes.Contains(c.Client, []byte("\r\n$"))
+		},
+	},
+	{
+		protocol:  "MQTT",
+		category:  categoryMessageQueue,
+		transport: TransportTCP,
+		ports:     []int32{1883, 8883},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			if len(c.Client) < 10 || c.Client[0] != 0x10 {
+				return false
+			}
+
+			head := c.Client[:minInt(len(c.Client), 16)]
+
+			return bytes.Contains(head, []byte("MQTT")) || bytes.Contains(head, []byte("MQIsdp"))
+		},
+	},
+	{
+		protocol:  "BITTORRENT",
+		category:  categoryP2P,
+		transport: TransportTCP,
+		ports:     []int32{6881, 6882, 6883, 6884, 6885, 6886, 6887, 6888, 6889},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			return hasPrefix(c.Client, "\x13BitTorrent protocol")
+		},
+	},
+	{
+		protocol:  "JABBER",
+		category:  categoryChat,
+		transport: TransportTCP,
+		ports:     []int32{5222, 5269},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			return bytes.Contains(c.Client[:minInt(len(c.Client), 128)], []byte("<stream:stream"))
+		},
+	},
+	{
+		protocol:  "IRC",
+		category:  categoryChat,
+		transport: TransportTCP,
+		ports:     []int32{6667, 6697},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			return hasPrefix(c.Client, "NICK ") || hasPrefix(c.Client, "CAP LS")
+		},
+	},
+	{
+		protocol:  "MODBUS",
+		category:  categoryICS,
+		transport: TransportTCP,
+		ports:     []int32{502},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			// protocol identifier zero and a length field that covers the rest of the message
+			return len(c.Client) >= 8 && c.Client[2] == 0 && c.Client[3] == 0 &&
+				int(binary.BigEndian.Uint16(c.Client[4:6])) == len(c.Client)-6
+		},
+	},
+	{
+		protocol:  "DHCP",
+		category:  categoryServices,
+		transport: TransportUDP,
+		ports:     []int32{67, 68},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			// magic cookie after the fixed size BOOTP header
+			return len(c.Client) >= 240 && binary.BigEndian.Uint32(c.Client[236:240]) == 0x63825363
+		},
+	},
+	{
+		protocol:  "MDNS",
+		category:  categoryServices,
+		transport: TransportUDP,
+		ports:     []int32{5353},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			return (c.ServerPort == 5353 || c.ClientPort == 5353) && isDNSMessage(c.Client)
+		},
+	},
+	{
+		protocol: "DNS",
+		category: categoryServices,
+		ports:    []int32{53},
+		source:   sourcePayload,
+		match: func(c *Conversation) bool {
+			client, server := c.Client, c.Server
+
+			// DNS messages over TCP are prefixed with their length
+			if c.Transport == TransportTCP {
+				client, server = trimLengthPrefix(client, 2), trimLengthPrefix(server, 2)
+			}
+
+			if !isDNSMessage(client) || client[2]&0x80 != 0 {
+				return false
+			}
+
+			// the response has the same ID as the query
+			if len(c.Server) > 0 {
+				return isDNSMessage(server) && server[2]&0x80 != 0 && bytes.Equal(client[:2], server[:2])
+			}
+
+			return c.ServerPort == 53
+		},
+	},
+	{
+		protocol:  "NTP",
+		category:  categoryServices,
+		transport: TransportUDP,
+		ports:     []int32{123},
+		source:    sourceSize,
+		match: func(c *Conversation) bool {
+			// 48 byte messages with version 1-4 and client mode, the server replies in server mode
+			if len(c.Client) != 48 || !isNTPMode(c.Client, 3) {
+				return false
+			}
+
+			return c.Server == nil || (len(c.Server) >= 48 && isNTPMode(c.Server, 4))
+		},
+	},
+	{
+		protocol:  "WIREGUARD",
+		category:  categoryTunnelling,
+		transport: TransportUDP,
+		ports:     []int32{51820},
+		source:    sourceSize,
+		match: func(c *Conversation) bool {
+			// handshake initiation of 148 bytes, answered by a handshake response of 92 bytes
+			if len(c.Sizes) == 0 || c.Sizes[0] != 148 || !hasPrefix(c.Client, "\x01\x00\x00\x00") {
+				return false
+			}
+
+			return len(c.Sizes) == 1 || (c.Sizes[1] == -92 && hasPrefix(c.Server, "\x02\x00\x00\x00"))
+		},
+	},
+	{
+		protocol:  "STUN",
+		category:  categoryServices,
+		transport: TransportUDP,
+		ports:     []int32{3478, 19302},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			// magic cookie of RFC 5389 and a length that covers the attributes
+			return len(c.Client) >= 20 && c.Client[0]&0xc0 == 0 &&
+				binary.BigEndian.Uint32(c.Client[4:8]) == 0x2112a442 &&
+				int(binary.BigEndian.Uint16(c.Client[2:4])) == len(c.Client)-20
+		},
+	},
+	{
+		protocol:  "SNMP",
+		category:  categoryMonitoring,
+		transport: TransportUDP,
+		ports:     []int32{161, 162},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			// BER encoded sequence, followed by the version as integer: 0 (v1), 1 (v2c) or 3 (v3)
+			if len(c.Client) < 7 || c.Client[0] != 0x30 {
+				return false
+			}
+
+			offset := 2
+			if c.Client[1]&0x80 != 0 {
+				offset += int(c.Client[1] & 0x7f)
+			}
+
+			return len(c.Client) > offset+2 && c.Client[offset] == 0x02 && c.Client[offset+1] == 0x01 &&
+				(c.Client[offset+2] <= 1 || c.Client[offset+2] == 3)
+		},
+	},
+	{
+		protocol: "KERBEROS",
+		category: categorySecurity,
+		ports:    []int32{88},
+		source:   sourcePayload,
+		match: func(c *Conversation) bool {
+			// messages over TCP are prefixed with their length
+			client := c.Client
+			if c.Transport == TransportTCP {
+				client = trimLengthPrefix(client, 4)
+			}
+
+			// application tag of an AS-REQ or TGS-REQ
+			return len(client) > 4 && (client[0] == 0x6a || client[0] == 0x6c) && c.ServerPort == 88
+		},
+	},
+	{
+		protocol:  "TFTP",
+		category:  categoryFiles,
+		transport: TransportUDP,
+		ports:     []int32{69},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			// read or write request with a file name and a transfer mode
+			if len(c.Client) < 4 || c.Client[0] != 0 || (c.Client[1] != 1 && c.Client[1] != 2) {
+				return false
+			}
+
+			parts := bytes.Split(c.Client[2:], []byte{0})
+			if len(parts) < 2 {
+				return false
+			}
+
+			mode := bytes.ToLower(parts[1])
+
+			return bytes.Equal(mode, []byte("octet")) || bytes.Equal(mode, []byte("netascii")) || bytes.Equal(mode, []byte("mail"))
+		},
+	},
+	{
+		protocol:  "SYSLOG",
+		category:  categoryLogging,
+		transport: TransportUDP,
+		ports:     []int32{514},
+		source:    sourcePayload,
+		match: func(c *Conversation) bool {
+			// priority value in angle brackets, at most 3 digits
+			if len(c.Client) < 4 || c.Client[0] != '<' {
+				return false
+			}
+
+			end := bytes.IndexByte(c.Client[:minInt(len(c.Client), 5)], '>')
+			if end < 2 {
+				return false
+			}
+
+			for _, b := range c.Client[1:end] {
+				if b < '0' || b > '9' {
+					return false
+				}
+			}
+
+			return true
+		},
+	},
+
+	// protocols that are only guessed from the port
+	{protocol: "LDAP", category: categoryServices, ports: []int32{389}},
+	{protocol: "MSSQL_TDS", category: categoryDatabases, transport: TransportTCP, ports: []int32{1433}},
+	{protocol: "MONGODB", category: categoryDatabases, transport: TransportTCP, ports: []int32{27017}},
+	{protocol: "OPENVPN", category: categoryTunnelling, ports: []int32{1194}},
+	{protocol: "NETBIOS", category: categoryServices, transport: TransportUDP, ports: []int32{137, 138}},
+	{protocol: "LLMNR", category: categoryServices, transport: TransportUDP, ports: []int32{5355}},
+	{protocol: "RADIUS", category: categorySecurity, transport: TransportUDP, ports: []int32{1812, 1813}},
+}
+
+func hasPrefix(data []byte, prefix string) bool {
+	return len(data) >= len(prefix) && string(data[:len(prefix)]) == prefix
+}
+
+func hasPrefixFold(data []byte, prefix string) bool {
+	return len(data) >= len(prefix) && bytes.EqualFold(data[:len(prefix)], []byte(prefix))
+}
+
+// firstLine returns the data up to the first line break.
+func firstLine(data []byte) []byte {
+	if i := bytes.IndexByte(data, '\n'); i != -1 {
+		return data[:i]
+	}
+
+	return data
+}
+
+// isTLSHandshake checks for a TLS record that contains a handshake message of the given type.
+func isTLSHandshake(data []byte, msgType byte) bool {
+	return len(data) >= 6 && data[0] == 0x16 && data[1] == 0x03 && data[2] <= 0x04 && data[5] == msgType
+}
+
+// isTelnetNegotiation checks for the interpret as command byte, followed by a WILL, WONT, DO or DONT option code.
+func isTelnetNegotiation(data []byte) bool {
+	return len(data) >= 3 && data[0] == 0xff && data[1] >= 0xfb && data[1] <= 0xfe
+}
+
+// isDNSMessage checks whether the data starts with a plausible DNS header.
+func isDNSMessage(data []byte) bool {
+	if len(data) < 12 {
+		return false
+	}
+
+	var (
+		opcode  = (data[2] >> 3) & 0x0f
+		qdCount = binary.BigEndian.Uint16(data[4:6])
+	)
+
+	return opcode <= 5 && qdCount >= 1 && qdCount <= 16
+}
+
+// isNTPMode checks the version and mode of a NTP message.
+func isNTPMode(data []byte, mode byte) bool {
+	version := (data[0] >> 3) & 0x07
+
+	return version >= 1 && version <= 4 && data[0]&0x07 == mode
+}
+
+// trimLengthPrefix removes the length prefix of the given size used by protocols transported over TCP,
+// nil is returned if it does not match the length of the data.
+func trimLengthPrefix(data []byte, size int) []byte {
+	if len(data) < size {
+		return nil
+	}
+
+	var length int
+	for _, b := range data[:size] {
+		length = length<<8 | int(b)
+	}
+
+	if length != len(data)-size {
+		return nil
+	}
+
+	return data[size:]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
@@ -139,7 +139,7 @@ func (w *chanWriter) Close(numRecords int64) (name string, size int64) {
 	}
 
 	if w.wc.Compress {
-		closeGzipWriters(w.gWriter)
+		closeCompressors(w.gWriter)
 	}
 
 	return closeFile(w.wc.Out, w.file, w.wc.Name, numRecords)
@@ -0,0 +1,196 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/klauspost/pgzip"
+)
+
+// Compression codecs for audit record files.
+const (
+	CompressionGzip = "gzip"
+	CompressionZstd = "zstd"
+)
+
+// file extensions of the compression codecs.
+const (
+	extensionGzip = ".gz"
+	extensionZstd = ".zst"
+)
+
+// magic bytes at the start of compressed files, used to detect the codec independent of the file name.
+var (
+	magicGzip = []byte{0x1f, 0x8b}
+	magicZstd = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// errInvalidCompressionCodec occurs when an unknown compression codec has been configured.
+var errInvalidCompressionCodec = errors.New("invalid compression codec")
+
+// compressor is implemented by the writers of the supported compression codecs.
+type compressor interface {
+	io.WriteCloser
+	Flush() error
+
+	// Reset discards the state and continues writing a new stream to w.
+	Reset(w io.Writer)
+}
+
+// ParseCompressionCodec validates the name of a compression codec, an empty name selects gzip.
+func ParseCompressionCodec(codec string) (string, error) {
+	switch strings.ToLower(codec) {
+	case "", CompressionGzip:
+		return CompressionGzip, nil
+	case CompressionZstd:
+		return CompressionZstd, nil
+	default:
+		return "", fmt.Errorf("%w: %q, expected gzip or zstd", errInvalidCompressionCodec, codec)
+	}
+}
+
+// compressedExtension returns the file extension of the configured compression codec.
+func compressedExtension(wc *WriterConfig) string {
+	if codec, _ := ParseCompressionCodec(wc.CompressionCodec); codec == CompressionZstd {
+		return extensionZstd
+	}
+
+	return extensionGzip
+}
+
+// isCompressed returns whether the file name has the extension of a supported compression codec.
+func isCompressed(name string) bool {
+	return strings.HasSuffix(name, extensionGzip) || strings.HasSuffix(name, extensionZstd)
+}
+
+// newCompressor creates a writer for the configured compression codec.
+func newCompressor(w io.Writer, wc *WriterConfig) (compressor, error) {
+	codec, err := ParseCompressionCodec(wc.CompressionCodec)
+	if err != nil {
+		return nil, err
+	}
+
+	if codec == CompressionZstd {
+		return zstd.NewWriter(w, zstd.WithEncoderLevel(zstdLevel(wc.CompressionLevel)))
+	}
+
+	g, err := pgzip.NewWriterLevel(w, wc.CompressionLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &gzipCompressor{Writer: g, blockSize: wc.CompressionBlockSize}
+
+	// To get any performance gains, you should at least be compressing more than 1 megabyte of data at the time.
+	// You should at least have a block size of 100k and at least a number of blocks that match the number of cores
+	// you would like to utilize, but about twice the number of blocks would be the best.
+	if err = g.SetConcurrency(c.blockSize, runtime.GOMAXPROCS(0)*2); err != nil {
+		return nil, fmt.Errorf("failed to configure compression package: %w", err)
+	}
+
+	return c, nil
+}
+
+// zstdLevel maps the gzip compression levels to the zstd encoder levels,
+// the default and no compression levels of gzip use the default zstd level.
+func zstdLevel(level int) zstd.EncoderLevel {
+	if level <= 0 {
+		return zstd.SpeedDefault
+	}
+
+	return zstd.EncoderLevelFromZstd(level)
+}
+
+// gzipCompressor restores the concurrency settings of the parallel gzip writer when it is reset.
+type gzipCompressor struct {
+	*pgzip.Writer
+	blockSize int
+}
+
+// Reset continues writing a new gzip member to w.
+// The block size has been validated when the writer was created, so configuring it again cannot fail.
+func (c *gzipCompressor) Reset(w io.Writer) {
+	c.Writer.Reset(w)
+	_ = c.SetConcurrency(c.blockSize, runtime.GOMAXPROCS(0)*2)
+}
+
+// decompressor is implemented by the readers of the supported compression codecs.
+type decompressor interface {
+	io.Reader
+
+	// Reset continues reading a new stream from r.
+	Reset(r io.Reader) error
+
+	// Close releases the resources of the reader.
+	Close() error
+}
+
+// newDecompressor creates a reader for the compression codec of the data in r.
+// The codec is detected from the magic bytes at the start of the data, for empty files the name decides.
+// It returns nil if the data is not compressed.
+func newDecompressor(r peekReader, name string) (decompressor, error) {
+	magic, _ := r.Peek(len(magicZstd))
+
+	switch {
+	case bytes.HasPrefix(magic, magicZstd):
+		return newZstdDecompressor(r)
+	case bytes.HasPrefix(magic, magicGzip):
+		return newGzipDecompressor(r)
+	case len(magic) == 0 && strings.HasSuffix(name, extensionZstd):
+		return newZstdDecompressor(r)
+	case len(magic) == 0 && strings.HasSuffix(name, extensionGzip):
+		return newGzipDecompressor(r)
+	default:
+		return nil, nil
+	}
+}
+
+// peekReader is a reader that allows to look at the next bytes without consuming them, e.g. a bufio.Reader.
+type peekReader interface {
+	io.Reader
+	Peek(n int) ([]byte, error)
+}
+
+func newGzipDecompressor(r io.Reader) (decompressor, error) {
+	return gzip.NewReader(r)
+}
+
+func newZstdDecompressor(r io.Reader) (decompressor, error) {
+	d, err := zstd.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return zstdDecompressor{d}, nil
+}
+
+// zstdDecompressor adapts the Close method of the zstd decoder, which does not return an error.
+type zstdDecompressor struct {
+	*zstd.Decoder
+}
+
+// Close releases the resources of the decoder.
+func (d zstdDecompressor) Close() error {
+	d.Decoder.Close()
+
+	return nil
+}
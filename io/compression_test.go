@@ -0,0 +1,258 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/dreadl0ck/netcap/defaults"
+	"github.com/dreadl0ck/netcap/types"
+)
+
+func TestZstdAuditRecords(t *testing.T) {
+	file := writeIndexed(t, true, CompressionZstd)
+	if !strings.HasSuffix(file, ".ncap.zst") {
+		t.Fatal("unexpected file name", file)
+	}
+
+	if ids := readIDs(t, file, time.Time{}, time.Time{}); len(ids) != 100 || ids[99] != 99 {
+		t.Fatal("unexpected records", ids)
+	}
+
+	// each block of the time index is a separate zstd frame
+	if ids := readIDs(t, file, indexStart.Add(42*time.Second), indexStart.Add(57*time.Second)); len(ids) != 16 || ids[0] != 42 {
+		t.Error("unexpected records in time range", ids)
+	}
+
+	// the codec is detected by the magic bytes, independent of the file name
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.HasPrefix(data, magicZstd) {
+		t.Fatal("missing zstd magic bytes")
+	}
+
+	renamed := filepath.Join(filepath.Dir(file), "DNS.ncap")
+	if err = os.WriteFile(renamed, data, defaults.FilePermission); err != nil {
+		t.Fatal(err)
+	}
+
+	if ids := readIDs(t, renamed, time.Time{}, time.Time{}); len(ids) != 100 {
+		t.Error("unexpected records in renamed file", len(ids))
+	}
+}
+
+func TestZstdNewlineDelimited(t *testing.T) {
+	for _, wc := range []*WriterConfig{
+		{CSV: true, Buffer: false},
+		{JSON: true, Buffer: true},
+	} {
+		wc.Compress = true
+		wc.CompressionCodec = CompressionZstd
+		wc.Name = "DNS"
+		wc.Out = t.TempDir()
+
+		w := NewAuditRecordWriter(wc)
+		if err := w.WriteHeader(types.Type_NC_DNS); err != nil {
+			t.Fatal(err)
+		}
+
+		for i := 0; i < 10; i++ {
+			if err := w.Write(&types.DNS{Timestamp: indexStart.UnixNano(), ID: int32(i)}); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		name, _ := w.Close(10)
+		if !strings.HasSuffix(name, compressedExtension(wc)) || !strings.HasSuffix(name, ".zst") {
+			t.Fatal("unexpected file name", name)
+		}
+
+		f, err := os.Open(filepath.Join(wc.Out, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		d, err := zstd.NewReader(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data, err := io.ReadAll(d)
+		if err != nil {
+			t.Fatal(name, err)
+		}
+
+		d.Close()
+		_ = f.Close()
+
+		if lines := strings.Split(strings.TrimSpace(string(data)), "\n"); len(lines) != 11 {
+			t.Errorf("expected a header and 10 records in %s, got %d lines", name, len(lines))
+		}
+	}
+}
+
+func TestParseCompressionCodec(t *testing.T) {
+	for in, expected := range map[string]string{"": CompressionGzip, "gzip": CompressionGzip, "ZSTD": CompressionZstd} {
+		if codec, err := ParseCompressionCodec(in); err != nil || codec != expected {
+			t.Error("unexpected codec", in, codec, err)
+		}
+	}
+
+	if _, err := ParseCompressionCodec("lz4"); !errors.Is(err, errInvalidCompressionCodec) {
+		t.Error("expected an error for an unknown codec", err)
+	}
+}
+
+// referenceAuditRecords are created by the collector tests from the reference pcap.
+const referenceAuditRecords = "../tests/collector-test"
+
+// BenchmarkCompression compares the size and throughput of the compression codecs
+// for the audit records of the reference pcap.
+func BenchmarkCompression(b *testing.B) {
+	files, _ := filepath.Glob(filepath.Join(referenceAuditRecords, "*"+defaults.FileExtensionCompressed))
+	if len(files) == 0 {
+		b.Skipf("no audit records in %s, run the collector tests to create them", referenceAuditRecords)
+	}
+
+	var (
+		records = make(map[types.Type][]proto.Message)
+		size    int64
+	)
+
+	for _, f := range files {
+		r, err := Open(f, 0)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		h, err := r.ReadHeader()
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		for {
+			msg := InitRecord(h.Type)
+
+			err = r.Next(msg)
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			} else if err != nil {
+				b.Fatal(err)
+			}
+
+			records[h.Type] = append(records[h.Type], msg)
+			size += int64(proto.Size(msg))
+		}
+
+		_ = r.Close()
+	}
+
+	// write writes all audit records into the directory and returns the size of the files
+	write := func(b *testing.B, out, codec string) (total int64) {
+		b.Helper()
+
+		for typ, msgs := range records {
+			w := NewAuditRecordWriter(&WriterConfig{
+				Proto:                true,
+				Compress:             true,
+				Buffer:               true,
+				CompressionBlockSize: defaults.CompressionBlockSize,
+				CompressionLevel:     defaults.CompressionLevel,
+				CompressionCodec:     codec,
+				Name:                 strings.TrimPrefix(typ.String(), defaults.NetcapTypePrefix),
+				Out:                  out,
+			})
+
+			if err := w.WriteHeader(typ); err != nil {
+				b.Fatal(err)
+			}
+
+			for _, msg := range msgs {
+				if err := w.Write(msg); err != nil {
+					b.Fatal(err)
+				}
+			}
+
+			_, s := w.Close(int64(len(msgs)))
+			total += s
+		}
+
+		return total
+	}
+
+	for _, codec := range []string{CompressionGzip, CompressionZstd} {
+		b.Run(codec+"/write", func(b *testing.B) {
+			var compressed int64
+
+			b.SetBytes(size)
+
+			for n := 0; n < b.N; n++ {
+				compressed = write(b, b.TempDir(), codec)
+			}
+
+			b.ReportMetric(float64(compressed), "bytes")
+			b.ReportMetric(float64(size)/float64(compressed), "ratio")
+		})
+
+		b.Run(codec+"/read", func(b *testing.B) {
+			out := b.TempDir()
+			write(b, out, codec)
+
+			files, _ = filepath.Glob(filepath.Join(out, "*"))
+
+			b.SetBytes(size)
+			b.ResetTimer()
+
+			for n := 0; n < b.N; n++ {
+				for _, f := range files {
+					r, err := Open(f, 0)
+					if err != nil {
+						b.Fatal(err)
+					}
+
+					h, err := r.ReadHeader()
+					if err != nil {
+						b.Fatal(err)
+					}
+
+					msg := InitRecord(h.Type)
+
+					for {
+						err = r.Next(msg)
+						if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+							break
+						} else if err != nil {
+							b.Fatal(err)
+						}
+					}
+
+					_ = r.Close()
+				}
+			}
+		})
+	}
+}
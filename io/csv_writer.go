@@ -16,12 +16,9 @@ package io
 import (
 	"bufio"
 	"github.com/gogo/protobuf/proto"
-	"github.com/klauspost/pgzip"
 	"go.uber.org/zap"
-	"log"
 	"os"
 	"path/filepath"
-	"runtime"
 
 	"github.com/dreadl0ck/netcap/defaults"
 	"github.com/dreadl0ck/netcap/types"
@@ -30,8 +27,9 @@ import (
 // csvWriter is a structure that supports writing CSV audit records to disk.
 type csvWriter struct {
 	bWriter   *bufio.Writer
-	gWriter   *pgzip.Writer
+	cWriter   compressor
 	csvWriter *csvProtoWriter
+	counter   *countingWriter
 
 	file *os.File
 	wc   *WriterConfig
@@ -48,7 +46,7 @@ func newCSVWriter(wc *WriterConfig) *csvWriter {
 
 	// create file
 	if wc.Compress {
-		w.file = createFile(filepath.Join(wc.Out, w.wc.Name), ".csv.gz")
+		w.file = createFile(filepath.Join(wc.Out, w.wc.Name), ".csv"+compressedExtension(wc))
 	} else {
 		w.file = createFile(filepath.Join(wc.Out, w.wc.Name), ".csv")
 	}
@@ -58,36 +56,31 @@ func newCSVWriter(wc *WriterConfig) *csvWriter {
 		w.bWriter = bufio.NewWriterSize(w.file, wc.MemBufferSize)
 
 		if wc.Compress {
-			var errGzipWriter error
-			w.gWriter, errGzipWriter = pgzip.NewWriterLevel(w.bWriter, wc.CompressionLevel)
+			var errCompressor error
+			w.cWriter, errCompressor = newCompressor(w.bWriter, wc)
 
-			if errGzipWriter != nil {
-				panic(errGzipWriter)
+			if errCompressor != nil {
+				panic(errCompressor)
 			}
 
-			w.csvWriter = newCSVProtoWriter(w.gWriter, wc.Encode, wc.Label)
+			w.counter = newCountingWriter(w.cWriter)
+			w.csvWriter = newCSVProtoWriter(w.counter, wc.Encode, wc.Label)
 		} else {
-			w.csvWriter = newCSVProtoWriter(w.bWriter, wc.Encode, wc.Label)
+			w.counter = newCountingWriter(w.bWriter)
+			w.csvWriter = newCSVProtoWriter(w.counter, wc.Encode, wc.Label)
 		}
 	} else {
 		if wc.Compress {
-			var errGzipWriter error
-			w.gWriter, errGzipWriter = pgzip.NewWriterLevel(w.file, wc.CompressionLevel)
-			if errGzipWriter != nil {
-				panic(errGzipWriter)
+			var errCompressor error
+			w.cWriter, errCompressor = newCompressor(w.file, wc)
+			if errCompressor != nil {
+				panic(errCompressor)
 			}
-			w.csvWriter = newCSVProtoWriter(w.gWriter, wc.Encode, wc.Label)
+			w.counter = newCountingWriter(w.cWriter)
+			w.csvWriter = newCSVProtoWriter(w.counter, wc.Encode, wc.Label)
 		} else {
-			w.csvWriter = newCSVProtoWriter(w.file, wc.Encode, wc.Label)
-		}
-	}
-
-	if w.gWriter != nil {
-		// To get any performance gains, you should at least be compressing more than 1 megabyte of data at the time.
-		// You should at least have a block size of 100k and at least a number of blocks that match the number of cores
-		// you would like to utilize, but about twice the number of blocks would be the best.
-		if err := w.gWriter.SetConcurrency(wc.CompressionBlockSize, runtime.GOMAXPROCS(0)*2); err != nil {
-			log.Fatal("failed to configure compression package: ", err)
+			w.counter = newCountingWriter(w.file)
+			w.csvWriter = newCSVProtoWriter(w.counter, wc.Encode, wc.Label)
 		}
 	}
 
@@ -113,13 +106,19 @@ func (w *csvWriter) WriteHeader(t types.Type) error {
 // Close flushes and closes the writer and the associated file handles.
 func (w *csvWriter) Close(numRecords int64) (name string, size int64) {
 
-	if w.wc.Buffer {
-		flushWriters(w.bWriter)
+	// the compressor writes into the buffer, so it must be closed first
+	if w.wc.Compress {
+		closeCompressors(w.cWriter)
 	}
 
-	if w.wc.Compress {
-		closeGzipWriters(w.gWriter)
+	if w.wc.Buffer {
+		flushWriters(w.bWriter)
 	}
 
 	return closeFile(w.wc.Out, w.file, w.wc.Name, numRecords)
 }
+
+// uncompressedSize returns the number of bytes that have been written into the file before compression.
+func (w *csvWriter) uncompressedSize() int64 {
+	return w.counter.n
+}
@@ -535,12 +535,13 @@ var typeMapping = map[string]string{
 	"TimestampLast":      "date",
 	"ReferenceTimestamp": "date",
 
-	"Duration":    "long",
-	"Bytes":       "long",
-	"SeqNum":      "long",
-	"AckNum":      "long",
-	"ReferenceID": "long",
-	"Xid":         "long",
+	"Duration":     "long",
+	"HandshakeRTT": "long",
+	"Bytes":        "long",
+	"SeqNum":       "long",
+	"AckNum":       "long",
+	"ReferenceID":  "long",
+	"Xid":          "long",
 
 	"SrcIP":        "ip",
 	"DstIP":        "ip",
@@ -629,6 +630,9 @@ var typeMapping = map[string]string{
 	"EnvelopeTo":                  "keyword",
 	"Label":                       "keyword",
 	"Password":                    "keyword",
+	"CommunityID":                 "keyword",
+	"CommunityIDs":                "keyword",
+	"TerminationReason":           "keyword",
 
 	"Answers":   "object",
 	"Questions": "object",
@@ -0,0 +1,527 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"crypto/sha1"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dreadl0ck/gopacket/layers"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/dreadl0ck/netcap/types"
+	"github.com/dreadl0ck/netcap/utils"
+)
+
+// eveConverter creates the EVE event for an audit record, nil if the record has no equivalent event.
+type eveConverter func(msg proto.Message, seed uint16) *eveEvent
+
+// eveConverters contains the audit record types that can be written as EVE events.
+var eveConverters = map[types.Type]eveConverter{
+	types.Type_NC_Connection:     eveFlow,
+	types.Type_NC_HTTP:           eveHTTP,
+	types.Type_NC_DNS:            eveDNS,
+	types.Type_NC_TLSClientHello: eveTLSClientHello,
+	types.Type_NC_TLSServerHello: eveTLSServerHello,
+	types.Type_NC_File:           eveFileInfo,
+	types.Type_NC_SMTP:           eveSMTP,
+	types.Type_NC_SSH:            eveSSH,
+	types.Type_NC_Alert:          eveAlert,
+}
+
+// eveFlowID derives the flow id from the community id, see flowCommunityID.
+// The id is limited to 52 bits, to be represented exactly by JSON parsers using floating point numbers.
+func eveFlowID(communityID string) int64 {
+	h := sha1.Sum([]byte(communityID))
+
+	return int64(binary.BigEndian.Uint64(h[:8]) & (1<<52 - 1))
+}
+
+// setFlow sets the flow fields of the event.
+func (e *eveEvent) setFlow(seed uint16, communityID, srcIP, srcPort, dstIP, dstPort, proto string) {
+	if communityID = flowCommunityID(seed, communityID, srcIP, srcPort, dstIP, dstPort, protoNumber(proto)); communityID != "" {
+		e.set("flow_id", eveFlowID(communityID))
+		e.set("community_id", communityID)
+	}
+
+	e.set("src_ip", srcIP)
+	e.setPort("src_port", srcPort)
+	e.set("dest_ip", dstIP)
+	e.setPort("dest_port", dstPort)
+	e.set("proto", strings.ToUpper(proto))
+}
+
+func (e *eveEvent) setPort(key, port string) {
+	if p, err := strconv.Atoi(port); err == nil && p != 0 {
+		e.set(key, p)
+	}
+}
+
+// object is a nested EVE object that omits empty strings.
+type object map[string]interface{}
+
+func (o object) set(key string, v interface{}) {
+	if s, ok := v.(string); ok && s == "" {
+		return
+	}
+
+	o[key] = v
+}
+
+func eveFlow(msg proto.Message, seed uint16) *eveEvent {
+	c, ok := msg.(*types.Connection)
+	if !ok {
+		return nil
+	}
+
+	ts := c.TimestampLast
+	if ts == 0 {
+		ts = c.TimestampFirst
+	}
+
+	e := newEveEvent(ts, "flow")
+	e.setFlow(seed, c.CommunityID, c.SrcIP, c.SrcPort, c.DstIP, c.DstPort, c.TransportProto)
+	// payload is set when the application protocol could not be identified
+	if c.ApplicationProto != "payload" {
+		e.set("app_proto", strings.ToLower(c.ApplicationProto))
+	}
+
+	state, reason := "established", "shutdown"
+
+	switch c.TerminationReason {
+	case "Closed", "ClientReset", "ServerReset", "Rejected":
+		state, reason = "closed", "timeout"
+	}
+
+	flow := object{
+		"bytes_toserver": c.BytesClientToServer,
+		"bytes_toclient": c.BytesServerToClient,
+		"start":          formatEveTime(c.TimestampFirst),
+		"end":            formatEveTime(ts),
+		"age":            c.Duration / 1e9,
+		"state":          state,
+		"reason":         reason,
+		"alerted":        false,
+	}
+
+	e.set("flow", map[string]interface{}(flow))
+
+	if strings.EqualFold(c.TransportProto, "tcp") {
+		var (
+			tcp   = object{}
+			flags byte
+		)
+
+		for _, f := range []struct {
+			name  string
+			count int32
+			bit   byte
+		}{
+			{"fin", c.NumFINFlags, 0x01},
+			{"syn", c.NumSYNFlags, 0x02},
+			{"rst", c.NumRSTFlags, 0x04},
+			{"psh", c.NumPSHFlags, 0x08},
+			{"ack", c.NumACKFlags, 0x10},
+			{"urg", c.NumURGFlags, 0x20},
+			{"ecn", c.NumECEFlags, 0x40},
+			{"cwr", c.NumCWRFlags, 0x80},
+		} {
+			if f.count > 0 {
+				tcp[f.name] = true
+				flags |= f.bit
+			}
+		}
+
+		tcp["tcp_flags"] = fmt.Sprintf("%02x", flags)
+		tcp["state"] = state
+
+		e.set("tcp", map[string]interface{}(tcp))
+	}
+
+	return e
+}
+
+func eveHTTP(msg proto.Message, seed uint16) *eveEvent {
+	h, ok := msg.(*types.HTTP)
+	if !ok {
+		return nil
+	}
+
+	e := newEveEvent(h.Timestamp, "http")
+	e.setFlow(seed, h.CommunityID, h.SrcIP, "", h.DstIP, "", "TCP")
+	e.set("app_proto", "http")
+
+	http := object{}
+	http.set("hostname", h.Host)
+	http.set("url", h.URL)
+	http.set("http_user_agent", h.UserAgent)
+	http.set("http_content_type", firstNonEmpty(h.ResContentType, h.ResContentTypeDetected))
+	http.set("http_refer", h.Referer)
+	http.set("http_method", h.Method)
+	http.set("protocol", h.Proto)
+
+	if h.StatusCode != 0 {
+		http["status"] = h.StatusCode
+	}
+
+	http["length"] = h.ResContentLength
+
+	if len(h.RequestHeader) > 0 {
+		http["request_headers"] = eveHeaders(h.RequestHeader)
+	}
+
+	if len(h.ResponseHeader) > 0 {
+		http["response_headers"] = eveHeaders(h.ResponseHeader)
+	}
+
+	e.set("http", map[string]interface{}(http))
+
+	return e
+}
+
+// eveHeaders converts http headers into the EVE name and value list, sorted by name.
+func eveHeaders(header map[string]string) []object {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	headers := make([]object, len(names))
+	for i, name := range names {
+		headers[i] = object{"name": name, "value": header[name]}
+	}
+
+	return headers
+}
+
+func eveDNS(msg proto.Message, seed uint16) *eveEvent {
+	d, ok := msg.(*types.DNS)
+	if !ok {
+		return nil
+	}
+
+	e := newEveEvent(d.Timestamp, "dns")
+	e.setFlow(seed, d.CommunityID, d.SrcIP, strconv.Itoa(int(d.SrcPort)), d.DstIP, strconv.Itoa(int(d.DstPort)), "UDP")
+	e.set("app_proto", "dns")
+
+	dns := object{
+		"id": d.ID,
+	}
+
+	if len(d.Questions) > 0 {
+		dns.set("rrname", d.Questions[0].Name)
+		dns.set("rrtype", layers.DNSType(d.Questions[0].Type).String())
+	}
+
+	if !d.QR {
+		dns["type"] = "query"
+		dns["tx_id"] = 0
+		e.set("dns", map[string]interface{}(dns))
+
+		return e
+	}
+
+	dns["version"] = 2
+	dns["type"] = "answer"
+	dns["flags"] = fmt.Sprintf("%x", eveDNSFlags(d))
+	dns["qr"] = true
+
+	for _, f := range []struct {
+		name string
+		set  bool
+	}{{"aa", d.AA}, {"tc", d.TC}, {"rd", d.RD}, {"ra", d.RA}} {
+		if f.set {
+			dns[f.name] = true
+		}
+	}
+
+	if name, ok := dnsRcodeNames[d.ResponseCode]; ok {
+		dns["rcode"] = name
+	} else {
+		dns["rcode"] = strconv.Itoa(int(d.ResponseCode))
+	}
+
+	var answers []object
+
+	for _, a := range d.Answers {
+		answers = append(answers, object{
+			"rrname": a.Name,
+			"rrtype": layers.DNSType(a.Type).String(),
+			"ttl":    a.TTL,
+			"rdata":  dnsAnswerData(a),
+		})
+	}
+
+	if len(answers) > 0 {
+		dns["answers"] = answers
+	}
+
+	e.set("dns", map[string]interface{}(dns))
+
+	return e
+}
+
+// eveDNSFlags returns the flags of the dns header.
+func eveDNSFlags(d *types.DNS) uint16 {
+	flags := uint16(d.OpCode&0xf)<<11 | uint16(d.Z&0x7)<<4 | uint16(d.ResponseCode&0xf)
+
+	for _, f := range []struct {
+		set bool
+		bit uint16
+	}{{d.QR, 1 << 15}, {d.AA, 1 << 10}, {d.TC, 1 << 9}, {d.RD, 1 << 8}, {d.RA, 1 << 7}} {
+		if f.set {
+			flags |= f.bit
+		}
+	}
+
+	return flags
+}
+
+func eveTLSClientHello(msg proto.Message, seed uint16) *eveEvent {
+	h, ok := msg.(*types.TLSClientHello)
+	if !ok {
+		return nil
+	}
+
+	e := newEveEvent(h.Timestamp, "tls")
+	e.setFlow(seed, h.CommunityID, h.SrcIP, strconv.Itoa(int(h.SrcPort)), h.DstIP, strconv.Itoa(int(h.DstPort)), "TCP")
+	e.set("app_proto", "tls")
+
+	tls := object{}
+	tls.set("sni", h.SNI)
+
+	if h.Ja3 != "" {
+		tls["ja3"] = object{"hash": h.Ja3}
+	}
+
+	e.set("tls", map[string]interface{}(tls))
+
+	e.key, e.part = eveHandshakeKey(e), evePartClient
+
+	return e
+}
+
+func eveTLSServerHello(msg proto.Message, seed uint16) *eveEvent {
+	h, ok := msg.(*types.TLSServerHello)
+	if !ok {
+		return nil
+	}
+
+	version := h.Version
+
+	// TLS 1.3 is negotiated with the supported versions extension
+	if h.SupportedVersion != 0 {
+		version = h.SupportedVersion
+	}
+
+	// the event is logged from the perspective of the client
+	e := newEveEvent(h.Timestamp, "tls")
+	e.setFlow(seed, h.CommunityID, h.DstIP, strconv.Itoa(int(h.DstPort)), h.SrcIP, strconv.Itoa(int(h.SrcPort)), "TCP")
+	e.set("app_proto", "tls")
+
+	tls := object{}
+	tls.set("version", eveTLSVersion(version))
+
+	if h.Ja3S != "" {
+		tls["ja3s"] = object{"hash": h.Ja3S}
+	}
+
+	e.set("tls", map[string]interface{}(tls))
+
+	e.key, e.part = eveHandshakeKey(e), evePartServer
+
+	return e
+}
+
+// eveHandshakeKey returns the key to merge both sides of a handshake, empty if the flow is unknown.
+func eveHandshakeKey(e *eveEvent) string {
+	id, ok := e.fields["community_id"].(string)
+	if !ok {
+		return ""
+	}
+
+	return e.fields["event_type"].(string) + "/" + id
+}
+
+func eveTLSVersion(v int32) string {
+	switch v {
+	case 0x0300:
+		return "SSLv3"
+	case 0x0301:
+		return "TLS 1.0"
+	case 0x0302:
+		return "TLS 1.1"
+	case 0x0303:
+		return "TLS 1.2"
+	case 0x0304:
+		return "TLS 1.3"
+	case 0:
+		return ""
+	default:
+		return "UNDETERMINED"
+	}
+}
+
+func eveFileInfo(msg proto.Message, seed uint16) *eveEvent {
+	f, ok := msg.(*types.File)
+	if !ok {
+		return nil
+	}
+
+	e := newEveEvent(f.Timestamp, "fileinfo")
+	e.setFlow(seed, f.CommunityID, f.SrcIP, strconv.Itoa(int(f.SrcPort)), f.DstIP, strconv.Itoa(int(f.DstPort)), "TCP")
+	e.set("app_proto", strings.ToLower(f.Source))
+
+	if f.Host != "" {
+		e.set("http", map[string]interface{}{"hostname": f.Host})
+	}
+
+	info := object{
+		"gaps":   false,
+		"state":  "CLOSED",
+		"stored": f.Location != "",
+		"size":   f.Length,
+		"tx_id":  0,
+	}
+
+	info.set("filename", f.Name)
+	info.set("magic", firstNonEmpty(f.ContentTypeDetected, f.ContentType))
+	info.set("md5", f.Hash)
+
+	e.set("fileinfo", map[string]interface{}(info))
+
+	return e
+}
+
+func eveSMTP(msg proto.Message, seed uint16) *eveEvent {
+	s, ok := msg.(*types.SMTP)
+	if !ok {
+		return nil
+	}
+
+	e := newEveEvent(s.Timestamp, "smtp")
+	e.setFlow(seed, "", s.SrcIP, strconv.Itoa(int(s.SrcPort)), s.DstIP, strconv.Itoa(int(s.DstPort)), "TCP")
+	e.set("app_proto", "smtp")
+
+	// the parameters of the commands are not part of the audit record,
+	// the commands and the ids of the extracted mails are added instead.
+	smtp := object{}
+	if len(s.Commands) > 0 {
+		smtp["commands"] = s.Commands
+	}
+
+	if len(s.MailIDs) > 0 {
+		smtp["mail_ids"] = s.MailIDs
+	}
+
+	e.set("smtp", map[string]interface{}(smtp))
+
+	return e
+}
+
+func eveSSH(msg proto.Message, seed uint16) *eveEvent {
+	s, ok := msg.(*types.SSH)
+	if !ok {
+		return nil
+	}
+
+	// the flow of the server side is reversed
+	flow := s.Flow
+	if !s.IsClient {
+		flow = utils.ReverseFlowIdent(flow)
+	}
+
+	srcIP, srcPort, dstIP, dstPort := utils.ParseFlowIdent(flow)
+
+	e := newEveEvent(s.Timestamp, "ssh")
+	e.setFlow(seed, s.CommunityID, srcIP, srcPort, dstIP, dstPort, "TCP")
+	e.set("app_proto", "ssh")
+
+	// e.g. SSH-2.0-OpenSSH_7.4
+	side := object{}
+	if arr := strings.SplitN(s.Ident, "-", 3); len(arr) == 3 {
+		side["proto_version"] = arr[1]
+		side["software_version"] = arr[2]
+	}
+
+	if s.HASSH != "" {
+		side["hassh"] = object{"hash": s.HASSH, "string": s.Algorithms}
+	}
+
+	if s.IsClient {
+		e.set("ssh", map[string]interface{}{"client": side})
+		e.part = evePartClient
+	} else {
+		e.set("ssh", map[string]interface{}{"server": side})
+		e.part = evePartServer
+	}
+
+	e.key = eveHandshakeKey(e)
+
+	return e
+}
+
+func eveAlert(msg proto.Message, seed uint16) *eveEvent {
+	a, ok := msg.(*types.Alert)
+	if !ok {
+		return nil
+	}
+
+	e := newEveEvent(a.Timestamp, "alert")
+	e.setFlow(seed, "", a.SrcIP, a.SrcPort, a.DstIP, a.DstPort, a.Protocol)
+
+	// netcap alerts have no signature ids, a stable id is derived from the name
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(a.Name))
+
+	alert := object{
+		"action":       "allowed",
+		"gid":          1,
+		"signature_id": h.Sum32() & 0x7fffffff,
+		"rev":          1,
+		"severity":     2,
+	}
+
+	alert.set("signature", a.Name)
+	alert.set("category", a.Description)
+
+	metadata := object{}
+	if a.MITRE != "" {
+		metadata["mitre_technique_id"] = []string{a.MITRE}
+	}
+
+	if a.Notes != "" {
+		metadata["notes"] = []string{a.Notes}
+	}
+
+	if len(metadata) > 0 {
+		alert["metadata"] = metadata
+	}
+
+	e.set("alert", map[string]interface{}(alert))
+
+	return e
+}
+
+func formatEveTime(ns int64) string {
+	return time.Unix(0, ns).UTC().Format(eveTimeFormat)
+}
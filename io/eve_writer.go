@@ -0,0 +1,320 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/dreadl0ck/netcap/defaults"
+	"github.com/dreadl0ck/netcap/types"
+)
+
+const (
+	// name of the consolidated EVE file in the output directory.
+	eveFileName = "eve.json"
+
+	// timestamp format of the EVE events.
+	eveTimeFormat = "2006-01-02T15:04:05.000000-0700"
+)
+
+// leading keys of an EVE event, in the order used by suricata.
+// the remaining keys follow in alphabetical order.
+var eveKeyOrder = []string{
+	"timestamp",
+	"flow_id",
+	"event_type",
+	"src_ip",
+	"src_port",
+	"dest_ip",
+	"dest_port",
+	"proto",
+	"app_proto",
+	"community_id",
+}
+
+// eveFormat encodes the events of the EVE file as JSON lines.
+type eveFormat struct{}
+
+// eveWriter is a structure that supports writing audit records as suricata EVE JSON events.
+type eveWriter struct {
+	mu      sync.Mutex
+	wc      *WriterConfig
+	convert eveConverter
+	file    *mergedFile
+}
+
+// newEveWriter initializes and configures a new eveWriter instance.
+func newEveWriter(wc *WriterConfig) *eveWriter {
+	if wc.MemBufferSize <= 0 {
+		wc.MemBufferSize = defaults.BufferSize
+	}
+
+	w := &eveWriter{
+		wc: wc,
+	}
+
+	// the type is not known yet for the gopacket decoders, the file is opened when the header is written
+	w.init(wc.Type)
+
+	return w
+}
+
+// init opens the EVE file for the audit record type,
+// audit record types that have no EVE event type are discarded.
+func (w *eveWriter) init(t types.Type) {
+	if w.file != nil {
+		return
+	}
+
+	convert, ok := eveConverters[t]
+	if !ok {
+		return
+	}
+
+	w.convert = convert
+	w.file = openMergedFile(filepath.Join(w.wc.Out, eveFileName), eveFormat{}, w.wc.MemBufferSize)
+}
+
+// Write converts the audit record into an EVE event.
+func (w *eveWriter) Write(msg proto.Message) error {
+	w.mu.Lock()
+	convert, f := w.convert, w.file
+	w.mu.Unlock()
+
+	if f == nil {
+		return nil
+	}
+
+	e := convert(msg, uint16(w.wc.CommunityIDSeed))
+	if e == nil {
+		return nil
+	}
+
+	return f.add(e)
+}
+
+// WriteHeader opens the EVE file if the audit record type has an EVE event type.
+func (w *eveWriter) WriteHeader(t types.Type) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.init(t)
+
+	if w.file == nil {
+		ioLog.Info("no eve event type for audit record type", zap.String("type", t.String()))
+	}
+
+	return nil
+}
+
+// Close releases the EVE file, the file is closed once all writers have been closed.
+func (w *eveWriter) Close(_ int64) (name string, size int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		return "", 0
+	}
+
+	return w.file.release()
+}
+
+func (eveFormat) parts() int {
+	return evePartsBoth
+}
+
+func (eveFormat) writeHeader(*bufio.Writer) {}
+
+func (eveFormat) writeRow(w *bufio.Writer, row mergedRow) error {
+	data, err := row.(*eveEvent).marshal()
+	if err != nil {
+		return err
+	}
+
+	if _, err = w.Write(data); err != nil {
+		return err
+	}
+
+	_, err = w.WriteString("\n")
+
+	return err
+}
+
+func (eveFormat) writeFooter(*bufio.Writer) {}
+
+// sides of a handshake for merged events.
+const (
+	evePartClient = 1 << iota
+	evePartServer
+
+	evePartsBoth = evePartClient | evePartServer
+)
+
+// eveEvent is an EVE event, the timestamp is stored in nanoseconds and formatted when the event is written.
+type eveEvent struct {
+	ts     int64
+	fields map[string]interface{}
+
+	// key of the handshake and the side contained in the event, if the event needs to be merged
+	key  string
+	part int
+}
+
+func newEveEvent(ts int64, eventType string) *eveEvent {
+	return &eveEvent{
+		ts: ts,
+		fields: map[string]interface{}{
+			"event_type": eventType,
+		},
+	}
+}
+
+// set sets a field, empty strings are omitted.
+func (e *eveEvent) set(key string, v interface{}) {
+	if s, ok := v.(string); ok && s == "" {
+		return
+	}
+
+	e.fields[key] = v
+}
+
+// conversation returns the key of the handshake and the side contained in the event.
+func (e *eveEvent) conversation() (key string, part int) {
+	return e.key, e.part
+}
+
+// merge adds the fields of the other side of a handshake,
+// the nested objects of the event type are merged and the earliest timestamp is kept.
+func (e *eveEvent) merge(row mergedRow) {
+	o := row.(*eveEvent)
+
+	for k, v := range o.fields {
+		existing, ok := e.fields[k]
+		if !ok {
+			e.fields[k] = v
+
+			continue
+		}
+
+		// merge nested objects
+		if dst, isMap := existing.(map[string]interface{}); isMap {
+			if src, isSrcMap := v.(map[string]interface{}); isSrcMap {
+				for nk, nv := range src {
+					if _, exists := dst[nk]; !exists {
+						dst[nk] = nv
+					}
+				}
+			}
+		}
+	}
+
+	if o.ts < e.ts {
+		e.ts = o.ts
+	}
+
+	e.part |= o.part
+}
+
+// marshal encodes the event as JSON, with the leading keys in suricata order.
+func (e *eveEvent) marshal() ([]byte, error) {
+	e.fields["timestamp"] = formatEveTime(e.ts)
+
+	var (
+		keys    []string
+		leading = make(map[string]bool, len(eveKeyOrder))
+	)
+
+	for _, k := range eveKeyOrder {
+		leading[k] = true
+
+		if _, ok := e.fields[k]; ok {
+			keys = append(keys, k)
+		}
+	}
+
+	var rest []string
+
+	for k := range e.fields {
+		if !leading[k] {
+			rest = append(rest, k)
+		}
+	}
+
+	sort.Strings(rest)
+
+	var b strings.Builder
+
+	b.WriteString("{")
+
+	for i, k := range append(keys, rest...) {
+		if i > 0 {
+			b.WriteString(",")
+		}
+
+		v, err := json.Marshal(e.fields[k])
+		if err != nil {
+			return nil, err
+		}
+
+		b.WriteString(`"` + k + `":`)
+		b.Write(v)
+	}
+
+	b.WriteString("}")
+
+	return []byte(b.String()), nil
+}
+
+// dumpEve converts the audit records from the reader into EVE events that are written to w.
+func dumpEve(w io.Writer, r *Reader, header *types.Header, record proto.Message, c DumpConfig) error {
+	convert, ok := eveConverters[header.Type]
+	if !ok {
+		return fmt.Errorf("%w: no eve event type for %s", errInvalidOutput, header.Type)
+	}
+
+	size := c.MemBufferSize
+	if size <= 0 {
+		size = defaults.BufferSize
+	}
+
+	f := newMergedFile(eveFormat{}, bufio.NewWriterSize(w, size))
+
+	for {
+		err := r.Next(record)
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("failed to read next audit record: %w", err)
+		}
+
+		if e := convert(record, uint16(c.CommunityIDSeed)); e != nil {
+			if err = f.add(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	return f.finish()
+}
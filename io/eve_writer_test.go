@@ -0,0 +1,154 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/dreadl0ck/netcap/types"
+	"github.com/dreadl0ck/netcap/utils"
+)
+
+func TestEveWriter(t *testing.T) {
+	var (
+		out     = t.TempDir()
+		writers = make(map[types.Type]AuditRecordWriter)
+		counts  = make(map[types.Type]int64)
+		records = append([]proto.Message{
+			&types.Alert{
+				Timestamp:   1505838534000000000,
+				Name:        "Suspicious user agent",
+				Description: "policy",
+				SrcIP:       "192.168.1.47",
+				SrcPort:     "53032",
+				DstIP:       "165.227.109.154",
+				DstPort:     "443",
+				Protocol:    "TCP",
+				MITRE:       "T1071",
+			},
+		}, zeekRecords...)
+	)
+
+	for _, r := range records {
+		ncType := types.Type(types.Type_value["NC_"+reflect.TypeOf(r).Elem().Name()])
+		if _, ok := writers[ncType]; !ok {
+			writers[ncType] = NewAuditRecordWriter(&WriterConfig{
+				Eve:  true,
+				Name: strings.TrimPrefix(ncType.String(), "NC_"),
+				Out:  out,
+			})
+
+			if err := writers[ncType].WriteHeader(ncType); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if err := writers[ncType].Write(r); err != nil {
+			t.Fatal(err)
+		}
+
+		counts[ncType]++
+	}
+
+	for typ, w := range writers {
+		w.Close(counts[typ])
+	}
+
+	f, err := os.Open(filepath.Join(out, eveFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer f.Close()
+
+	events := make(map[string][]map[string]interface{})
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e map[string]interface{}
+		if err = json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			t.Fatal(err, scanner.Text())
+		}
+
+		typ, _ := e["event_type"].(string)
+		events[typ] = append(events[typ], e)
+
+		if !strings.HasPrefix(scanner.Text(), `{"timestamp":"`) {
+			t.Error("expected the timestamp as first key", scanner.Text())
+		}
+	}
+
+	for typ, n := range map[string]int{"flow": 1, "tls": 1, "http": 1, "dns": 2, "alert": 1} {
+		if len(events[typ]) != n {
+			t.Fatal("unexpected number of events for", typ, len(events[typ]))
+		}
+	}
+
+	flow := events["flow"][0]
+	if flow["timestamp"] != "2017-09-19T16:28:53.449164+0000" || flow["src_port"] != 53032.0 || flow["proto"] != "TCP" ||
+		flow["community_id"] != zeekCommunityID || flow["flow_id"] == nil {
+		t.Error("unexpected flow event", flow)
+	}
+
+	// all events of the connection share the flow id
+	for _, typ := range []string{"tls", "http", "alert"} {
+		if e := events[typ][0]; e["flow_id"] != flow["flow_id"] || e["community_id"] != zeekCommunityID {
+			t.Error("unexpected flow id", typ, e["flow_id"], flow["flow_id"])
+		}
+	}
+
+	tls := events["tls"][0]
+	details, _ := tls["tls"].(map[string]interface{})
+	if tls["timestamp"] != flow["timestamp"] || tls["src_ip"] != "192.168.1.47" || tls["dest_port"] != 443.0 ||
+		details["sni"] != "example.com" || details["version"] != "TLS 1.2" ||
+		!reflect.DeepEqual(details["ja3"], map[string]interface{}{"hash": "ja3"}) ||
+		!reflect.DeepEqual(details["ja3s"], map[string]interface{}{"hash": "ja3s"}) {
+		t.Error("expected the hellos to be merged into one event", tls)
+	}
+
+	query, _ := events["dns"][0]["dns"].(map[string]interface{})
+	answer, _ := events["dns"][1]["dns"].(map[string]interface{})
+
+	if query["type"] != "query" || query["rrname"] != "example.com" || query["rrtype"] != "A" {
+		t.Error("unexpected dns query", query)
+	}
+
+	answers, _ := answer["answers"].([]interface{})
+	if answer["type"] != "answer" || answer["rcode"] != "NOERROR" || answer["flags"] != "8180" || len(answers) != 2 ||
+		!reflect.DeepEqual(answers[0], map[string]interface{}{"rrname": "example.com", "rrtype": "A", "ttl": 300.0, "rdata": "93.184.216.34"}) {
+		t.Error("unexpected dns answer", answer)
+	}
+
+	alert, _ := events["alert"][0]["alert"].(map[string]interface{})
+	if alert["signature"] != "Suspicious user agent" || alert["category"] != "policy" || alert["signature_id"] == nil {
+		t.Error("unexpected alert", alert)
+	}
+}
+
+func TestEveCommunityIDSeed(t *testing.T) {
+	a := &types.Alert{SrcIP: "192.168.1.47", SrcPort: "53032", DstIP: "165.227.109.154", DstPort: "443", Protocol: "TCP"}
+
+	e := eveAlert(a, 7)
+	if id := utils.CommunityIDFromStrings(7, a.SrcIP, a.SrcPort, a.DstIP, a.DstPort, utils.ProtocolTCP); e.fields["community_id"] != id || id == zeekCommunityID {
+		t.Error("expected the community id for the seed", e.fields["community_id"], id)
+	}
+}
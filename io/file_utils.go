@@ -22,8 +22,6 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/klauspost/pgzip"
-
 	"github.com/dreadl0ck/netcap/defaults"
 )
 
@@ -44,7 +42,7 @@ func flushWriters(writers ...flushableWriter) {
 	}
 }
 
-func closeGzipWriters(writers ...*pgzip.Writer) {
+func closeCompressors(writers ...compressor) {
 	for _, w := range writers {
 		err := w.Flush()
 		if err != nil {
@@ -111,11 +109,11 @@ func removeAuditRecordFileIfEmpty(name string, numRecords int64) (size int64) {
 }
 
 func isCSV(name string) bool {
-	return strings.HasSuffix(name, ".csv") || strings.HasSuffix(name, ".csv.gz")
+	return strings.HasSuffix(name, ".csv") || strings.HasSuffix(name, ".csv"+extensionGzip) || strings.HasSuffix(name, ".csv"+extensionZstd)
 }
 
 func isJSON(name string) bool {
-	return strings.HasSuffix(name, ".json") || strings.HasSuffix(name, ".json.gz")
+	return strings.HasSuffix(name, ".json") || strings.HasSuffix(name, ".json"+extensionGzip) || strings.HasSuffix(name, ".json"+extensionZstd)
 }
 
 func removeEmptyNewlineDelimitedFile(name string) (size int64) {
@@ -0,0 +1,166 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/dreadl0ck/netcap/defaults"
+)
+
+/*
+ * Time Index
+ * The proto writer splits the audit records into blocks that can be decoded independently,
+ * for compressed files each block is a separate gzip member.
+ * The offset, number of records and time range of each block are appended to a sidecar index file,
+ * so that readers can seek to the blocks for a time range without decompressing the whole file.
+ */
+
+// IndexExtension is appended to the name of an audit record file for its time index.
+const IndexExtension = ".idx"
+
+// the index starts with a magic value including the version of the format, followed by the blocks.
+var indexMagic = []byte("NCIDX001")
+
+// size of an encoded IndexBlock.
+const indexBlockSize = 32
+
+// errInvalidIndex occurs when a file is not a netcap time index.
+var errInvalidIndex = errors.New("invalid time index")
+
+// IndexBlock describes a block of audit records in an audit record file.
+type IndexBlock struct {
+	// Offset of the block in the file
+	Offset int64
+
+	// NumRecords in the block
+	NumRecords int64
+
+	// First and Last are the smallest and largest timestamp of the audit records in the block, in nanoseconds
+	First int64
+	Last  int64
+}
+
+// overlaps returns whether the block contains audit records in the time range, a zero end means no limit.
+func (b IndexBlock) overlaps(start, end int64) bool {
+	return b.Last >= start && (end == 0 || b.First <= end)
+}
+
+// ReadIndex reads the time index of the audit record file.
+// A truncated block at the end, e.g. while the file is still written, is ignored.
+func ReadIndex(file string) ([]IndexBlock, error) {
+	data, err := os.ReadFile(file + IndexExtension)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.HasPrefix(data, indexMagic) {
+		return nil, fmt.Errorf("%w: %s", errInvalidIndex, file+IndexExtension)
+	}
+
+	data = data[len(indexMagic):]
+
+	blocks := make([]IndexBlock, 0, len(data)/indexBlockSize)
+
+	for ; len(data) >= indexBlockSize; data = data[indexBlockSize:] {
+		blocks = append(blocks, IndexBlock{
+			Offset:     int64(binary.BigEndian.Uint64(data)),
+			NumRecords: int64(binary.BigEndian.Uint64(data[8:])),
+			First:      int64(binary.BigEndian.Uint64(data[16:])),
+			Last:       int64(binary.BigEndian.Uint64(data[24:])),
+		})
+	}
+
+	return blocks, nil
+}
+
+// indexWriter appends the blocks of an audit record file to its time index.
+type indexWriter struct {
+	file *os.File
+
+	// block that is currently written
+	current IndexBlock
+}
+
+// newIndexWriter creates the time index for the audit record file.
+func newIndexWriter(file string) (*indexWriter, error) {
+	f, err := os.OpenFile(file+IndexExtension, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, defaults.FilePermission)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = f.Write(indexMagic); err != nil {
+		_ = f.Close()
+
+		return nil, err
+	}
+
+	return &indexWriter{file: f}, nil
+}
+
+// add adds an audit record with the timestamp to the current block.
+func (w *indexWriter) add(ts int64) {
+	if w.current.NumRecords == 0 || ts < w.current.First {
+		w.current.First = ts
+	}
+
+	if ts > w.current.Last {
+		w.current.Last = ts
+	}
+
+	w.current.NumRecords++
+}
+
+// next appends the current block to the index, if it contains audit records,
+// and starts a new block at the offset.
+func (w *indexWriter) next(offset int64) error {
+	if w.current.NumRecords > 0 {
+		buf := make([]byte, indexBlockSize)
+
+		binary.BigEndian.PutUint64(buf, uint64(w.current.Offset))
+		binary.BigEndian.PutUint64(buf[8:], uint64(w.current.NumRecords))
+		binary.BigEndian.PutUint64(buf[16:], uint64(w.current.First))
+		binary.BigEndian.PutUint64(buf[24:], uint64(w.current.Last))
+
+		if _, err := w.file.Write(buf); err != nil {
+			return err
+		}
+	}
+
+	w.current = IndexBlock{Offset: offset}
+
+	return nil
+}
+
+// close appends the last block and closes the index,
+// the index is removed together with the audit record file if it does not contain audit records.
+func (w *indexWriter) close(remove bool) error {
+	if err := w.next(0); err != nil {
+		return err
+	}
+
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+
+	if remove {
+		return os.Remove(w.file.Name())
+	}
+
+	return nil
+}
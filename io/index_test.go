@@ -0,0 +1,168 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dreadl0ck/netcap/defaults"
+	"github.com/dreadl0ck/netcap/types"
+)
+
+var indexStart = time.Date(2020, 12, 28, 14, 0, 0, 0, time.UTC)
+
+// writeIndexed writes 100 DNS audit records one second apart, with 10 records per block.
+func writeIndexed(t *testing.T, compress bool, codec string) string {
+	t.Helper()
+
+	out := t.TempDir()
+
+	w := NewAuditRecordWriter(&WriterConfig{
+		Proto:                true,
+		Compress:             compress,
+		Buffer:               true,
+		CompressionBlockSize: defaults.CompressionBlockSize,
+		CompressionLevel:     defaults.CompressionLevel,
+		CompressionCodec:     codec,
+		Index:                true,
+		IndexBlockSize:       10,
+		Name:                 "DNS",
+		Out:                  out,
+	})
+
+	if err := w.WriteHeader(types.Type_NC_DNS); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 100; i++ {
+		if err := w.Write(&types.DNS{Timestamp: indexStart.Add(time.Duration(i) * time.Second).UnixNano(), ID: int32(i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	name, _ := w.Close(100)
+
+	return filepath.Join(out, name)
+}
+
+// readIDs returns the ids of the DNS audit records in the time range.
+func readIDs(t *testing.T, file string, start, end time.Time) []int32 {
+	t.Helper()
+
+	r, err := Open(file, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer r.Close()
+
+	if _, err = r.ReadHeader(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !start.IsZero() || !end.IsZero() {
+		if err = r.SetTimeRange(start, end); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var ids []int32
+
+	for {
+		var d types.DNS
+
+		err = r.Next(&d)
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+
+		ids = append(ids, d.ID)
+	}
+
+	return ids
+}
+
+func TestTimeIndex(t *testing.T) {
+	for _, compress := range []bool{true, false} {
+		file := writeIndexed(t, compress, CompressionGzip)
+
+		blocks, err := ReadIndex(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(blocks) != 10 {
+			t.Fatal("unexpected number of blocks", len(blocks))
+		}
+
+		for i, b := range blocks {
+			if b.NumRecords != 10 || b.First != indexStart.Add(time.Duration(i*10)*time.Second).UnixNano() ||
+				b.Last != indexStart.Add(time.Duration(i*10+9)*time.Second).UnixNano() ||
+				(i > 0 && b.Offset <= blocks[i-1].Offset) {
+				t.Error("unexpected block", i, b)
+			}
+		}
+
+		// the blocks form a valid file when read from the start
+		if ids := readIDs(t, file, time.Time{}, time.Time{}); len(ids) != 100 || ids[99] != 99 {
+			t.Fatal("unexpected records", compress, ids)
+		}
+
+		// the blocks before the time range are not decoded
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i := blocks[0].Offset; i < blocks[2].Offset; i++ {
+			data[i] = 0xff
+		}
+
+		if err = os.WriteFile(file, data, defaults.FilePermission); err != nil {
+			t.Fatal(err)
+		}
+
+		ids := readIDs(t, file, indexStart.Add(25*time.Second), indexStart.Add(34*time.Second))
+		if len(ids) != 10 || ids[0] != 25 || ids[9] != 34 {
+			t.Error("unexpected records in time range", compress, ids)
+		}
+
+		// blocks that do not overlap the range are skipped
+		ids = readIDs(t, file, indexStart.Add(95*time.Second), time.Time{})
+		if len(ids) != 5 || ids[0] != 95 {
+			t.Error("unexpected records after start", compress, ids)
+		}
+	}
+}
+
+func TestTimeRangeWithoutIndex(t *testing.T) {
+	file := writeIndexed(t, true, CompressionGzip)
+
+	if err := os.Remove(file + IndexExtension); err != nil {
+		t.Fatal(err)
+	}
+
+	// the audit records are filtered while reading the whole file
+	ids := readIDs(t, file, time.Time{}, indexStart.Add(4*time.Second))
+	if len(ids) != 5 || ids[4] != 4 {
+		t.Error("unexpected records", ids)
+	}
+}
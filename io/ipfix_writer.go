@@ -0,0 +1,635 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/dreadl0ck/netcap/defaults"
+	"github.com/dreadl0ck/netcap/ipfix"
+	"github.com/dreadl0ck/netcap/types"
+)
+
+const (
+	// interval for sending pending records to the collector, so that they are not delayed until a message is full.
+	ipfixFlushInterval = time.Second
+
+	// maximum number of JA3 hashes waiting for the Connection audit record of their flow.
+	ipfixMaxFingerprints = 100000
+)
+
+// ipfixFingerprint is the JA3 hash of a TLS handshake and the capture time of the handshake.
+type ipfixFingerprint struct {
+	hash string
+	ts   int64
+}
+
+// information elements, see https://www.iana.org/assignments/ipfix/ipfix.xhtml.
+const (
+	ieOctetDeltaCount          uint16 = 1
+	iePacketDeltaCount         uint16 = 2
+	ieProtocolIdentifier       uint16 = 4
+	ieTCPControlBits           uint16 = 6
+	ieSourceTransportPort      uint16 = 7
+	ieSourceIPv4Address        uint16 = 8
+	ieDestinationTransportPort uint16 = 11
+	ieDestinationIPv4Address   uint16 = 12
+	ieSourceIPv6Address        uint16 = 27
+	ieDestinationIPv6Address   uint16 = 28
+	ieSourceMacAddress         uint16 = 56
+	ieDestinationMacAddress    uint16 = 80
+	ieFlowStartMilliseconds    uint16 = 152
+	ieFlowEndMilliseconds      uint16 = 153
+)
+
+// enterprise-specific information elements for the netcap extras.
+const (
+	ieNetcapApplicationProtocol uint16 = iota + 1
+	ieNetcapJA3
+	ieNetcapJA3S
+	ieNetcapCommunityID
+	ieNetcapBytesClientToServer
+	ieNetcapBytesServerToClient
+	ieNetcapTerminationReason
+)
+
+// template ids for connections over IPv4 and IPv6.
+const (
+	ipfixTemplateIPv4 uint16 = 256
+	ipfixTemplateIPv6 uint16 = 257
+)
+
+// ipfixExporters contains the exporters that are currently open, by collector address or file path.
+// The exporter is shared by the writers of the Connection and TLS audit records,
+// to add the JA3 hashes of the TLS handshakes to the flow records.
+var ipfixExporters = struct {
+	sync.Mutex
+	m map[string]*ipfixExporter
+}{
+	m: make(map[string]*ipfixExporter),
+}
+
+// ipfixExporter exports Connection audit records to a collector or a file.
+type ipfixExporter struct {
+	sync.Mutex
+
+	target string
+	refs   int
+
+	exporter *ipfix.Exporter
+	ipv4     *ipfix.Template
+	ipv6     *ipfix.Template
+
+	// set when writing to a file
+	file    *os.File
+	bWriter *bufio.Writer
+
+	// set when sending to a collector
+	conn net.Conn
+	done chan struct{}
+
+	// fingerprints of the TLS handshakes, by community id
+	ja3  map[string]ipfixFingerprint
+	ja3s map[string]ipfixFingerprint
+
+	// fingerprints of connections that have not been exported expire after the timeout,
+	// the latest handshake and the last check are tracked in capture time
+	timeout    int64
+	latest     int64
+	lastExpiry int64
+
+	numRecords int64
+}
+
+// ipfixWriter is a structure that supports exporting Connection audit records as IPFIX or NetFlow version 9 flow records.
+type ipfixWriter struct {
+	mu       sync.Mutex
+	wc       *WriterConfig
+	exporter *ipfixExporter
+}
+
+// newIPFIXWriter initializes and configures a new ipfixWriter instance.
+func newIPFIXWriter(wc *WriterConfig) *ipfixWriter {
+	if wc.MemBufferSize <= 0 {
+		wc.MemBufferSize = defaults.BufferSize
+	}
+
+	w := &ipfixWriter{
+		wc: wc,
+	}
+
+	// the type is not known yet for the gopacket decoders, the exporter is opened when the header is written
+	w.init(wc.Type)
+
+	return w
+}
+
+// init opens the exporter for the audit record type, other audit record types than Connection,
+// TLSClientHello and TLSServerHello are discarded.
+func (w *ipfixWriter) init(t types.Type) {
+	if w.exporter != nil {
+		return
+	}
+
+	switch t {
+	case types.Type_NC_Connection, types.Type_NC_TLSClientHello, types.Type_NC_TLSServerHello:
+	default:
+		return
+	}
+
+	w.exporter = openIPFIXExporter(w.wc)
+}
+
+// Write exports a Connection audit record, or keeps the fingerprint of a TLS handshake until its connection is exported.
+func (w *ipfixWriter) Write(msg proto.Message) error {
+	w.mu.Lock()
+	e := w.exporter
+	w.mu.Unlock()
+
+	if e == nil {
+		return nil
+	}
+
+	e.Lock()
+	defer e.Unlock()
+
+	switch r := msg.(type) {
+	case *types.Connection:
+		return e.export(r)
+	case *types.TLSClientHello:
+		e.addFingerprint(e.ja3, r.CommunityID, r.Ja3, r.Timestamp)
+	case *types.TLSServerHello:
+		e.addFingerprint(e.ja3s, r.CommunityID, r.Ja3S, r.Timestamp)
+	}
+
+	return nil
+}
+
+// WriteHeader opens the exporter if the audit record type can be exported.
+func (w *ipfixWriter) WriteHeader(t types.Type) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.init(t)
+
+	if w.exporter == nil {
+		ioLog.Info("ipfix only exports Connection audit records", zap.String("type", t.String()))
+	}
+
+	return nil
+}
+
+// Close releases the exporter, the pending records are sent once all writers have been closed.
+func (w *ipfixWriter) Close(_ int64) (name string, size int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.exporter == nil {
+		return "", 0
+	}
+
+	return w.exporter.release()
+}
+
+// openIPFIXExporter returns the exporter for the configured collector or output file, and creates it if necessary.
+func openIPFIXExporter(wc *WriterConfig) *ipfixExporter {
+	version, err := ipfixVersion(wc.IPFIXVersion)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	target := wc.IPFIXCollector
+	if target == "" {
+		target = filepath.Join(wc.Out, "Connection"+ipfixExtension(version))
+	}
+
+	ipfixExporters.Lock()
+	defer ipfixExporters.Unlock()
+
+	if e, ok := ipfixExporters.m[target]; ok {
+		e.Lock()
+		e.refs++
+		e.Unlock()
+
+		return e
+	}
+
+	e := &ipfixExporter{
+		target: target,
+		refs:   1,
+	}
+	e.initFingerprints(wc.ConnTimeOut)
+
+	var (
+		out io.Writer
+		c   = ipfix.ExporterConfig{
+			Version:             version,
+			ObservationDomainID: wc.IPFIXObservationDomain,
+		}
+	)
+
+	if wc.IPFIXCollector != "" {
+		e.conn, err = net.Dial("udp", wc.IPFIXCollector)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// the templates are repeated, because messages can get lost over UDP
+		c.TemplateRefresh = defaults.IPFIXTemplateRefresh
+		out = &ipfixCollectorWriter{conn: e.conn}
+	} else {
+		e.file = createFile(strings.TrimSuffix(target, filepath.Ext(target)), filepath.Ext(target))
+		e.bWriter = bufio.NewWriterSize(e.file, wc.MemBufferSize)
+		c.MaxMessageSize = 65535
+		out = e.bWriter
+	}
+
+	e.exporter, e.ipv4, e.ipv6 = newIPFIXConnectionExporter(out, c, wc.IPFIXEnterpriseNumber)
+
+	ioLog.Info("create ipfixWriter", zap.String("target", target), zap.Uint16("version", uint16(version)))
+
+	if e.conn != nil {
+		e.done = make(chan struct{})
+		go e.flushPeriodically()
+	}
+
+	ipfixExporters.m[target] = e
+
+	return e
+}
+
+// newIPFIXConnectionExporter creates the exporter with the templates for Connection audit records.
+func newIPFIXConnectionExporter(out io.Writer, c ipfix.ExporterConfig, pen uint32) (e *ipfix.Exporter, ipv4, ipv6 *ipfix.Template) {
+	if pen == 0 {
+		pen = defaults.IPFIXEnterpriseNumber
+	}
+
+	fields := func(src, dst ipfix.Field) []ipfix.Field {
+		return []ipfix.Field{
+			{ID: ieFlowStartMilliseconds, Length: 8},
+			{ID: ieFlowEndMilliseconds, Length: 8},
+			{ID: ieSourceMacAddress, Length: 6},
+			{ID: ieDestinationMacAddress, Length: 6},
+			src,
+			dst,
+			{ID: ieSourceTransportPort, Length: 2},
+			{ID: ieDestinationTransportPort, Length: 2},
+			{ID: ieProtocolIdentifier, Length: 1},
+			// reduced size encoding, to use the same template for NetFlow version 9
+			{ID: ieTCPControlBits, Length: 1},
+			{ID: ieOctetDeltaCount, Length: 8},
+			{ID: iePacketDeltaCount, Length: 8},
+			{ID: ieNetcapApplicationProtocol, Length: ipfix.VariableLength, EnterpriseNumber: pen},
+			{ID: ieNetcapJA3, Length: ipfix.VariableLength, EnterpriseNumber: pen},
+			{ID: ieNetcapJA3S, Length: ipfix.VariableLength, EnterpriseNumber: pen},
+			{ID: ieNetcapCommunityID, Length: ipfix.VariableLength, EnterpriseNumber: pen},
+			{ID: ieNetcapBytesClientToServer, Length: 8, EnterpriseNumber: pen},
+			{ID: ieNetcapBytesServerToClient, Length: 8, EnterpriseNumber: pen},
+			{ID: ieNetcapTerminationReason, Length: ipfix.VariableLength, EnterpriseNumber: pen},
+		}
+	}
+
+	// the ids are valid, errors cannot occur
+	ipv4, _ = ipfix.NewTemplate(ipfixTemplateIPv4, fields(
+		ipfix.Field{ID: ieSourceIPv4Address, Length: 4},
+		ipfix.Field{ID: ieDestinationIPv4Address, Length: 4},
+	)...)
+	ipv6, _ = ipfix.NewTemplate(ipfixTemplateIPv6, fields(
+		ipfix.Field{ID: ieSourceIPv6Address, Length: 16},
+		ipfix.Field{ID: ieDestinationIPv6Address, Length: 16},
+	)...)
+
+	e, err := ipfix.NewExporter(out, c, ipv4, ipv6)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return e, ipv4, ipv6
+}
+
+// ipfixVersion returns the version for the configuration, IPFIX if not set.
+func ipfixVersion(v int) (ipfix.Version, error) {
+	if v == 0 {
+		return ipfix.IPFIX, nil
+	}
+
+	return ipfix.ParseVersion(v)
+}
+
+// ipfixCollectorWriter sends the messages to the collector,
+// errors are logged instead of returned, so that an unreachable collector does not stop the capture.
+type ipfixCollectorWriter struct {
+	conn net.Conn
+}
+
+func (w *ipfixCollectorWriter) Write(b []byte) (int, error) {
+	if _, err := w.conn.Write(b); err != nil {
+		ioLog.Error("failed to send ipfix message", zap.Error(err), zap.String("collector", w.conn.RemoteAddr().String()))
+	}
+
+	return len(b), nil
+}
+
+func ipfixExtension(v ipfix.Version) string {
+	if v == ipfix.NetFlow9 {
+		return ".netflow"
+	}
+
+	return ".ipfix"
+}
+
+// export adds the flow record for the connection,
+// connections without IP addresses can not be represented and are skipped.
+func (e *ipfixExporter) export(c *types.Connection) error {
+	src, dst := net.ParseIP(c.SrcIP), net.ParseIP(c.DstIP)
+	if src == nil || dst == nil {
+		return nil
+	}
+
+	tmpl := e.ipv6
+	if src.To4() != nil && dst.To4() != nil {
+		tmpl = e.ipv4
+	}
+
+	var (
+		srcMAC, _  = net.ParseMAC(c.SrcMAC)
+		dstMAC, _  = net.ParseMAC(c.DstMAC)
+		srcPort, _ = strconv.ParseUint(c.SrcPort, 10, 16)
+		dstPort, _ = strconv.ParseUint(c.DstPort, 10, 16)
+		ja3        = e.fingerprint(e.ja3, c)
+		ja3s       = e.fingerprint(e.ja3s, c)
+	)
+
+	appProto := c.ApplicationProto
+	if appProto == "payload" {
+		appProto = ""
+	}
+
+	e.numRecords++
+
+	// the counters contain both directions of the connection
+	return e.exporter.Add(tmpl,
+		uint64(c.TimestampFirst/int64(time.Millisecond)),
+		uint64(c.TimestampLast/int64(time.Millisecond)),
+		srcMAC,
+		dstMAC,
+		src,
+		dst,
+		uint16(srcPort),
+		uint16(dstPort),
+		protoNumber(c.TransportProto),
+		tcpControlBits(c),
+		uint64(c.BytesClientToServer+c.BytesServerToClient),
+		uint64(c.NumPackets),
+		appProto,
+		ja3,
+		ja3s,
+		c.CommunityID,
+		uint64(c.BytesClientToServer),
+		uint64(c.BytesServerToClient),
+		c.TerminationReason,
+	)
+}
+
+// tcpControlBits returns the TCP flags that have been seen in the connection.
+func tcpControlBits(c *types.Connection) uint8 {
+	var bits uint8
+
+	for i, n := range []int32{
+		c.NumFINFlags,
+		c.NumSYNFlags,
+		c.NumRSTFlags,
+		c.NumPSHFlags,
+		c.NumACKFlags,
+		c.NumURGFlags,
+		c.NumECEFlags,
+		c.NumCWRFlags,
+	} {
+		if n > 0 {
+			bits |= 1 << i
+		}
+	}
+
+	return bits
+}
+
+// initFingerprints creates the fingerprint maps, fingerprints expire after the connection timeout.
+func (e *ipfixExporter) initFingerprints(timeout time.Duration) {
+	if timeout <= 0 {
+		timeout = defaults.ConnTimeOut
+	}
+
+	e.ja3 = make(map[string]ipfixFingerprint)
+	e.ja3s = make(map[string]ipfixFingerprint)
+	e.timeout = int64(timeout)
+}
+
+// addFingerprint keeps the fingerprint of a TLS handshake until the connection is exported.
+// Fingerprints older than the timeout are removed once per timeout, or when the limit has been reached.
+func (e *ipfixExporter) addFingerprint(m map[string]ipfixFingerprint, communityID, hash string, ts int64) {
+	if communityID == "" || hash == "" {
+		return
+	}
+
+	if ts > e.latest {
+		e.latest = ts
+	}
+
+	if e.latest-e.lastExpiry >= e.timeout || len(m) >= ipfixMaxFingerprints {
+		e.expireFingerprints(e.latest - e.timeout)
+	}
+
+	if len(m) >= ipfixMaxFingerprints {
+		return
+	}
+
+	m[communityID] = ipfixFingerprint{hash: hash, ts: ts}
+}
+
+// fingerprint returns the JA3 hash of the handshake of the connection and removes it.
+// The community id is the same for all connections with the same 5-tuple,
+// a fingerprint is only used if the handshake happened during the connection.
+func (e *ipfixExporter) fingerprint(m map[string]ipfixFingerprint, c *types.Connection) string {
+	f, ok := m[c.CommunityID]
+	if !ok {
+		return ""
+	}
+
+	end := c.TimestampLast
+	if end == 0 {
+		end = c.TimestampFirst + c.Duration
+	}
+
+	// the handshake belongs to a later connection
+	if f.ts > end {
+		return ""
+	}
+
+	delete(m, c.CommunityID)
+
+	if f.ts < c.TimestampFirst {
+		return ""
+	}
+
+	return f.hash
+}
+
+// expireFingerprints removes the fingerprints of handshakes before the deadline.
+func (e *ipfixExporter) expireFingerprints(deadline int64) {
+	for _, m := range []map[string]ipfixFingerprint{e.ja3, e.ja3s} {
+		for id, f := range m {
+			if f.ts < deadline {
+				delete(m, id)
+			}
+		}
+	}
+
+	e.lastExpiry = e.latest
+}
+
+// flushPeriodically sends the pending records to the collector, until the exporter is released.
+func (e *ipfixExporter) flushPeriodically() {
+	ticker := time.NewTicker(ipfixFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-e.done:
+			return
+		case <-ticker.C:
+			e.Lock()
+			_ = e.exporter.Flush()
+			e.Unlock()
+		}
+	}
+}
+
+// release decrements the reference count of the exporter, and sends the pending records after the last writer is done.
+func (e *ipfixExporter) release() (name string, size int64) {
+	ipfixExporters.Lock()
+	defer ipfixExporters.Unlock()
+
+	e.Lock()
+	defer e.Unlock()
+
+	e.refs--
+	if e.refs > 0 {
+		return filepath.Base(e.target), 0
+	}
+
+	delete(ipfixExporters.m, e.target)
+
+	if err := e.exporter.Flush(); err != nil {
+		fmt.Println("failed to export ipfix message:", err, "target", e.target)
+	}
+
+	if e.conn != nil {
+		close(e.done)
+
+		if err := e.conn.Close(); err != nil {
+			fmt.Println("failed to close connection to ipfix collector:", err)
+		}
+
+		return e.target, 0
+	}
+
+	flushWriters(e.bWriter)
+
+	return closeFile(filepath.Dir(e.target), e.file, filepath.Base(e.target), e.numRecords)
+}
+
+// dumpIPFIX exports the Connection audit records from the reader as flow records,
+// to the configured collector or to w.
+func dumpIPFIX(w io.Writer, r *Reader, header *types.Header, record proto.Message, c DumpConfig) error {
+	if header.Type != types.Type_NC_Connection {
+		return fmt.Errorf("%w: ipfix only exports Connection audit records, got %s", errInvalidOutput, header.Type)
+	}
+
+	version, err := ipfixVersion(c.IPFIXVersion)
+	if err != nil {
+		return err
+	}
+
+	size := c.MemBufferSize
+	if size <= 0 {
+		size = defaults.BufferSize
+	}
+
+	var (
+		bWriter *bufio.Writer
+		cfg     = ipfix.ExporterConfig{
+			Version:             version,
+			ObservationDomainID: c.IPFIXObservationDomain,
+		}
+	)
+
+	if c.IPFIXCollector != "" {
+		conn, errDial := net.Dial("udp", c.IPFIXCollector)
+		if errDial != nil {
+			return errDial
+		}
+
+		defer conn.Close()
+
+		cfg.TemplateRefresh = defaults.IPFIXTemplateRefresh
+		w = conn
+	} else {
+		bWriter = bufio.NewWriterSize(w, size)
+		cfg.MaxMessageSize = 65535
+		w = bWriter
+	}
+
+	e := &ipfixExporter{}
+	e.initFingerprints(defaults.ConnTimeOut)
+	e.exporter, e.ipv4, e.ipv6 = newIPFIXConnectionExporter(w, cfg, c.IPFIXEnterpriseNumber)
+
+	for {
+		err = r.Next(record)
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("failed to read next audit record: %w", err)
+		}
+
+		if conn, ok := record.(*types.Connection); ok {
+			if err = e.export(conn); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err = e.exporter.Flush(); err != nil {
+		return err
+	}
+
+	if bWriter != nil {
+		return bWriter.Flush()
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/dreadl0ck/netcap/types"
+)
+
+// writeTestRecords writes the test records with a writer per audit record type.
+func writeTestRecords(t *testing.T, wc WriterConfig, input []proto.Message) {
+	t.Helper()
+
+	var (
+		writers = make(map[types.Type]AuditRecordWriter)
+		counts  = make(map[types.Type]int64)
+		records []proto.Message
+	)
+
+	// the connection is written last, like at the end of a capture
+	for _, r := range input {
+		if _, ok := r.(*types.Connection); ok {
+			records = append(records, r)
+		} else {
+			records = append([]proto.Message{r}, records...)
+		}
+	}
+
+	for _, r := range records {
+		ncType := types.Type(types.Type_value["NC_"+reflect.TypeOf(r).Elem().Name()])
+		if _, ok := writers[ncType]; !ok {
+			c := wc
+			c.Name = strings.TrimPrefix(ncType.String(), "NC_")
+
+			writers[ncType] = NewAuditRecordWriter(&c)
+			if err := writers[ncType].WriteHeader(ncType); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if err := writers[ncType].Write(r); err != nil {
+			t.Fatal(err)
+		}
+
+		counts[ncType]++
+	}
+
+	for typ, w := range writers {
+		w.Close(counts[typ])
+	}
+}
+
+// ipfixSets returns the sets of the IPFIX messages by set id.
+func ipfixSets(t *testing.T, data []byte) map[uint16][][]byte {
+	t.Helper()
+
+	sets := make(map[uint16][][]byte)
+
+	for len(data) > 0 {
+		if len(data) < 16 || binary.BigEndian.Uint16(data) != 10 {
+			t.Fatal("invalid ipfix message")
+		}
+
+		msg := data[16:binary.BigEndian.Uint16(data[2:])]
+		data = data[binary.BigEndian.Uint16(data[2:]):]
+
+		for len(msg) > 0 {
+			length := binary.BigEndian.Uint16(msg[2:])
+			sets[binary.BigEndian.Uint16(msg)] = append(sets[binary.BigEndian.Uint16(msg)], msg[4:length])
+			msg = msg[length:]
+		}
+	}
+
+	return sets
+}
+
+func TestIPFIXWriter(t *testing.T) {
+	out := t.TempDir()
+
+	writeTestRecords(t, WriterConfig{IPFIX: true, Out: out}, zeekRecords)
+
+	data, err := os.ReadFile(filepath.Join(out, "Connection.ipfix"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sets := ipfixSets(t, data)
+	if len(sets[2]) != 1 || len(sets[ipfixTemplateIPv4]) != 1 || len(sets[ipfixTemplateIPv6]) != 0 {
+		t.Fatal("unexpected sets", sets)
+	}
+
+	record := sets[ipfixTemplateIPv4][0]
+
+	// flowStartMilliseconds, flowEndMilliseconds, MAC addresses, IPv4 addresses and ports
+	if binary.BigEndian.Uint64(record) != 1505838533449 ||
+		!bytes.Equal(record[28:40], []byte{192, 168, 1, 47, 165, 227, 109, 154, 0xcf, 0x28, 1, 0xbb}) ||
+		record[40] != 6 || binary.BigEndian.Uint64(record[42:]) != 517+4096 {
+		t.Errorf("unexpected record %x", record)
+	}
+
+	// the fingerprints of the handshake are added to the connection
+	for _, s := range []string{"\x03TLS", "\x03ja3", "\x04ja3s", string(rune(len(zeekCommunityID))) + zeekCommunityID, "\x06Closed"} {
+		if !bytes.Contains(record, []byte(s)) {
+			t.Errorf("expected %q in record %x", s, record)
+		}
+	}
+}
+
+func TestIPFIXWriterCollector(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer conn.Close()
+
+	writeTestRecords(t, WriterConfig{
+		IPFIX:          true,
+		IPFIXCollector: conn.LocalAddr().String(),
+		IPFIXVersion:   9,
+		Out:            t.TempDir(),
+	}, zeekRecords)
+
+	if err = conn.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, 65535)
+
+	n, _, err := conn.ReadFrom(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// netflow v9 header with a template and a data record
+	if binary.BigEndian.Uint16(buf) != 9 || binary.BigEndian.Uint16(buf[2:]) != 3 || n < 20 {
+		t.Errorf("unexpected netflow message %x", buf[:n])
+	}
+
+	// the enterprise-specific fields are not part of netflow v9 records
+	if bytes.Contains(buf[:n], []byte("ja3")) {
+		t.Errorf("unexpected enterprise fields in netflow message %x", buf[:n])
+	}
+}
+
+func TestIPFIXFingerprints(t *testing.T) {
+	e := &ipfixExporter{}
+	e.initFingerprints(time.Minute)
+
+	conn := func(first, last time.Duration) *types.Connection {
+		return &types.Connection{CommunityID: "a", TimestampFirst: int64(first), TimestampLast: int64(last)}
+	}
+
+	// the handshake of an earlier connection with the same 5-tuple is discarded
+	e.addFingerprint(e.ja3, "a", "old", int64(10*time.Second))
+	if h := e.fingerprint(e.ja3, conn(20*time.Second, 30*time.Second)); h != "" || len(e.ja3) != 0 {
+		t.Fatal("unexpected fingerprint", h)
+	}
+
+	// the handshake of a later connection is kept for it
+	e.addFingerprint(e.ja3, "a", "new", int64(40*time.Second))
+	if h := e.fingerprint(e.ja3, conn(20*time.Second, 30*time.Second)); h != "" || len(e.ja3) != 1 {
+		t.Fatal("unexpected fingerprint", h)
+	}
+
+	if h := e.fingerprint(e.ja3, conn(35*time.Second, 45*time.Second)); h != "new" || len(e.ja3) != 0 {
+		t.Fatal("unexpected fingerprint", h)
+	}
+
+	// fingerprints of connections that are not exported expire after the timeout
+	e.addFingerprint(e.ja3, "b", "b", int64(50*time.Second))
+	e.addFingerprint(e.ja3s, "c", "c", int64(200*time.Second))
+
+	if _, ok := e.ja3["b"]; ok || len(e.ja3s) != 1 {
+		t.Fatal("expected the fingerprint to expire", e.ja3, e.ja3s)
+	}
+}
@@ -19,15 +19,12 @@ import (
 	"fmt"
 	"go.uber.org/zap"
 	"io"
-	"log"
 	"os"
 	"path/filepath"
-	"runtime"
 	"sync"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/gogo/protobuf/proto"
-	"github.com/klauspost/pgzip"
 
 	"github.com/dreadl0ck/netcap/defaults"
 	"github.com/dreadl0ck/netcap/delimited"
@@ -38,9 +35,10 @@ import (
 type jsonWriter struct {
 	mu      sync.Mutex
 	bWriter *bufio.Writer
-	gWriter *pgzip.Writer
+	cWriter compressor
 	dWriter *delimited.Writer
 	jWriter *jsonProtoWriter
+	counter *countingWriter
 
 	file *os.File
 	wc   *WriterConfig
@@ -57,7 +55,7 @@ func newJSONWriter(wc *WriterConfig) *jsonWriter {
 
 	// create file
 	if wc.Compress {
-		w.file = createFile(filepath.Join(wc.Out, w.wc.Name), ".json.gz")
+		w.file = createFile(filepath.Join(wc.Out, w.wc.Name), ".json"+compressedExtension(wc))
 	} else {
 		w.file = createFile(filepath.Join(wc.Out, w.wc.Name), ".json")
 	}
@@ -67,36 +65,31 @@ func newJSONWriter(wc *WriterConfig) *jsonWriter {
 		w.bWriter = bufio.NewWriterSize(w.file, wc.MemBufferSize)
 
 		if wc.Compress {
-			var errGzipWriter error
-			w.gWriter, errGzipWriter = pgzip.NewWriterLevel(w.bWriter, wc.CompressionLevel)
+			var errCompressor error
+			w.cWriter, errCompressor = newCompressor(w.bWriter, wc)
 
-			if errGzipWriter != nil {
-				panic(errGzipWriter)
+			if errCompressor != nil {
+				panic(errCompressor)
 			}
 
-			w.jWriter = newJSONProtoWriter(w.gWriter)
+			w.counter = newCountingWriter(w.cWriter)
+			w.jWriter = newJSONProtoWriter(w.counter)
 		} else {
-			w.jWriter = newJSONProtoWriter(w.bWriter)
+			w.counter = newCountingWriter(w.bWriter)
+			w.jWriter = newJSONProtoWriter(w.counter)
 		}
 	} else {
 		if wc.Compress {
-			var errGzipWriter error
-			w.gWriter, errGzipWriter = pgzip.NewWriterLevel(w.file, wc.CompressionLevel)
-			if errGzipWriter != nil {
-				panic(errGzipWriter)
+			var errCompressor error
+			w.cWriter, errCompressor = newCompressor(w.file, wc)
+			if errCompressor != nil {
+				panic(errCompressor)
 			}
-			w.jWriter = newJSONProtoWriter(w.gWriter)
+			w.counter = newCountingWriter(w.cWriter)
+			w.jWriter = newJSONProtoWriter(w.counter)
 		} else {
-			w.jWriter = newJSONProtoWriter(w.file)
-		}
-	}
-
-	if w.gWriter != nil {
-		// To get any performance gains, you should at least be compressing more than 1 megabyte of data at the time.
-		// You should at least have a block size of 100k and at least a number of blocks that match the number of cores
-		// you would like to utilize, but about twice the number of blocks would be the best.
-		if err := w.gWriter.SetConcurrency(wc.CompressionBlockSize, runtime.GOMAXPROCS(0)*2); err != nil {
-			log.Fatal("failed to configure compression package: ", err)
+			w.counter = newCountingWriter(w.file)
+			w.jWriter = newJSONProtoWriter(w.counter)
 		}
 	}
 
@@ -128,17 +121,23 @@ func (w *jsonWriter) Close(numRecords int64) (name string, size int64) {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
-	if w.wc.Buffer {
-		flushWriters(w.bWriter)
+	// the compressor writes into the buffer, so it must be closed first
+	if w.wc.Compress {
+		closeCompressors(w.cWriter)
 	}
 
-	if w.wc.Compress {
-		closeGzipWriters(w.gWriter)
+	if w.wc.Buffer {
+		flushWriters(w.bWriter)
 	}
 
 	return closeFile(w.wc.Out, w.file, w.wc.Name, numRecords)
 }
 
+// uncompressedSize returns the number of bytes that have been written into the file before compression.
+func (w *jsonWriter) uncompressedSize() int64 {
+	return w.counter.n
+}
+
 // jsonProtoWriter implements writing audit records to disk in the JSON format.
 type jsonProtoWriter struct {
 	sync.Mutex
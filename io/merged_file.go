@@ -0,0 +1,211 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// maximum number of incomplete rows per file, that are waiting for the other parts of a conversation.
+// once reached, the incomplete rows are written as they are.
+const maxPendingRows = 10000
+
+// mergedFiles contains the merged files that are currently open, by path.
+// A file can be fed by the writers for several audit record types, e.g. ssl.log by both TLS hellos,
+// or the EVE file by all audit record types.
+var mergedFiles = struct {
+	sync.Mutex
+	m map[string]*mergedFile
+}{
+	m: make(map[string]*mergedFile),
+}
+
+// mergedRow is a line of a merged file.
+type mergedRow interface {
+	// conversation returns the key of the conversation and the part of it that is contained in the row,
+	// the key is empty if the row does not need to be merged.
+	conversation() (key string, part int)
+
+	// merge adds the values of another part of the conversation.
+	merge(o mergedRow)
+}
+
+// mergedFormat encodes the rows of a merged file.
+type mergedFormat interface {
+	// parts returns the bit mask of the parts that form a complete row,
+	// e.g. the client and server side of a handshake. zero if every row is complete.
+	parts() int
+
+	writeHeader(w *bufio.Writer)
+	writeRow(w *bufio.Writer, row mergedRow) error
+	writeFooter(w *bufio.Writer)
+}
+
+// mergedFile is an output file that is shared by all writers for the same path,
+// the parts of a conversation are merged into a single row before they are written.
+type mergedFile struct {
+	sync.Mutex
+
+	format mergedFormat
+	path   string
+	refs   int
+
+	file    *os.File
+	bWriter *bufio.Writer
+
+	// rows waiting to be merged with the other parts of a conversation, by key
+	pending map[string]mergedRow
+	numRows int64
+}
+
+// newMergedFile creates a merged file that writes to the buffered writer.
+func newMergedFile(format mergedFormat, bWriter *bufio.Writer) *mergedFile {
+	f := &mergedFile{
+		format:  format,
+		bWriter: bWriter,
+		pending: make(map[string]mergedRow),
+	}
+
+	format.writeHeader(bWriter)
+
+	return f
+}
+
+// openMergedFile returns the merged file at path, and creates it with the format if necessary.
+func openMergedFile(path string, format mergedFormat, bufferSize int) *mergedFile {
+	mergedFiles.Lock()
+	defer mergedFiles.Unlock()
+
+	if f, ok := mergedFiles.m[path]; ok {
+		f.Lock()
+		f.refs++
+		f.Unlock()
+
+		return f
+	}
+
+	file := createFile(strings.TrimSuffix(path, filepath.Ext(path)), filepath.Ext(path))
+
+	f := newMergedFile(format, bufio.NewWriterSize(file, bufferSize))
+	f.path = path
+	f.refs = 1
+	f.file = file
+
+	ioLog.Info("create merged file", zap.String("path", path))
+
+	mergedFiles.m[path] = f
+
+	return f
+}
+
+// add writes a row, or merges it with the other parts of the conversation.
+func (f *mergedFile) add(row mergedRow) error {
+	f.Lock()
+	defer f.Unlock()
+
+	var (
+		parts     = f.format.parts()
+		key, part = row.conversation()
+	)
+
+	if parts == 0 || key == "" {
+		return f.write(row)
+	}
+
+	p, ok := f.pending[key]
+	if !ok {
+		if part == parts {
+			return f.write(row)
+		}
+
+		if len(f.pending) >= maxPendingRows {
+			if err := f.flushPending(); err != nil {
+				return err
+			}
+		}
+
+		f.pending[key] = row
+
+		return nil
+	}
+
+	p.merge(row)
+
+	if _, part = p.conversation(); part == parts {
+		delete(f.pending, key)
+
+		return f.write(p)
+	}
+
+	return nil
+}
+
+// flushPending writes all incomplete rows.
+func (f *mergedFile) flushPending() error {
+	for k, row := range f.pending {
+		if err := f.write(row); err != nil {
+			return err
+		}
+
+		delete(f.pending, k)
+	}
+
+	return nil
+}
+
+func (f *mergedFile) write(row mergedRow) error {
+	f.numRows++
+
+	return f.format.writeRow(f.bWriter, row)
+}
+
+// finish writes the incomplete rows and the footer, and flushes the buffered writer.
+func (f *mergedFile) finish() error {
+	if err := f.flushPending(); err != nil {
+		return err
+	}
+
+	f.format.writeFooter(f.bWriter)
+
+	return f.bWriter.Flush()
+}
+
+// release decrements the reference count of the file, and closes it after the last writer is done.
+func (f *mergedFile) release() (name string, size int64) {
+	mergedFiles.Lock()
+	defer mergedFiles.Unlock()
+
+	f.Lock()
+	defer f.Unlock()
+
+	f.refs--
+	if f.refs > 0 {
+		return filepath.Base(f.path), 0
+	}
+
+	delete(mergedFiles.m, f.path)
+
+	if err := f.finish(); err != nil {
+		fmt.Println("failed to write merged file:", err, "path", f.path)
+	}
+
+	return closeFile(filepath.Dir(f.path), f.file, filepath.Base(f.path), f.numRows)
+}
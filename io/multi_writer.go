@@ -0,0 +1,341 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/dreadl0ck/netcap/defaults"
+	"github.com/dreadl0ck/netcap/types"
+)
+
+// Outputs that can be combined with the multiWriter.
+const (
+	OutputProto   = "proto"
+	OutputCSV     = "csv"
+	OutputJSON    = "json"
+	OutputElastic = "elastic"
+	OutputUnix    = "unix"
+	OutputParquet = "parquet"
+	OutputZeek    = "zeek"
+	OutputEve     = "eve"
+	OutputIPFIX   = "ipfix"
+	OutputSQLite  = "sqlite"
+)
+
+var (
+	outputRecordsDropped = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "nc_output_records_dropped_total",
+			Help: "Number of audit records dropped for an output, because its buffer was full",
+		},
+		[]string{"Type", "Output"},
+	)
+	outputWriteErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "nc_output_write_errors_total",
+			Help: "Number of audit records that could not be written to an output",
+		},
+		[]string{"Type", "Output"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		outputRecordsDropped,
+		outputWriteErrors,
+	)
+}
+
+// dropWhenFull returns true for outputs that send the audit records to another service.
+// Audit records are dropped for them when their buffer is full, instead of stalling the capture
+// while the service is slow or unavailable. Writing to the other outputs blocks until there is room in the buffer.
+func dropWhenFull(name string) bool {
+	return name == OutputElastic || name == OutputUnix
+}
+
+// errInvalidOutput occurs when an unknown output has been configured.
+var errInvalidOutput = errors.New("invalid output")
+
+// ParseOutputs parses a comma separated list of outputs, e.g. proto,csv,elastic.
+func ParseOutputs(s string) ([]string, error) {
+	var outputs []string
+
+	for _, o := range strings.Split(s, ",") {
+		o = strings.ToLower(strings.TrimSpace(o))
+		if o == "" {
+			continue
+		}
+
+		switch o {
+		case OutputProto, OutputCSV, OutputJSON, OutputElastic, OutputUnix, OutputParquet, OutputZeek, OutputEve, OutputIPFIX, OutputSQLite:
+		default:
+			return nil, fmt.Errorf("%w: %s", errInvalidOutput, o)
+		}
+
+		for _, existing := range outputs {
+			if existing == o {
+				return nil, fmt.Errorf("%w: %s configured twice", errInvalidOutput, o)
+			}
+		}
+
+		outputs = append(outputs, o)
+	}
+
+	return outputs, nil
+}
+
+// OutputStats contains the counters for an output of a multiWriter.
+type OutputStats struct {
+	Output  string
+	Written int64
+	Errors  int64
+	Dropped int64
+}
+
+// MultiAuditRecordWriter extends the AuditRecordWriter
+// by offering a function to get the counters for each output.
+type MultiAuditRecordWriter interface {
+	AuditRecordWriter
+	Stats() []OutputStats
+}
+
+// output is a writer that receives audit records from the multiWriter through a buffered channel,
+// so that a slow writer does not block the others.
+type output struct {
+	name    string
+	typ     string
+	writer  AuditRecordWriter
+	records chan proto.Message
+	done    chan struct{}
+
+	// drop audit records when the buffer is full, instead of waiting
+	dropWhenFull bool
+
+	written int64
+	errors  int64
+	dropped int64
+}
+
+func (o *output) run() {
+	defer close(o.done)
+
+	for msg := range o.records {
+		if err := o.writer.Write(msg); err != nil {
+			outputWriteErrors.WithLabelValues(o.typ, o.name).Inc()
+
+			if atomic.AddInt64(&o.errors, 1) == 1 {
+				ioLog.Error("failed to write audit record", zap.String("output", o.name), zap.Error(err))
+			}
+
+			continue
+		}
+
+		atomic.AddInt64(&o.written, 1)
+	}
+}
+
+// multiWriter writes the audit records to several outputs at once.
+// Each output has its own buffer, audit records are only dropped for outputs that send them to another service,
+// if their buffer is full. Writing to all other outputs blocks until there is room in their buffer.
+type multiWriter struct {
+	// held for reading while writing, so that writes waiting for a full buffer do not block each other,
+	// and for writing when the outputs are closed
+	mu      sync.RWMutex
+	closed  bool
+	outputs []*output
+	wc      *WriterConfig
+}
+
+// newMultiWriter initializes and configures a new multiWriter instance for the configured outputs,
+// a single output is returned without the multiWriter.
+func newMultiWriter(wc *WriterConfig) AuditRecordWriter {
+	outputs, err := ParseOutputs(wc.Outputs)
+	if err != nil || len(outputs) == 0 {
+		panic(fmt.Sprint("invalid WriterConfig outputs: ", wc.Outputs, " ", err))
+	}
+
+	if len(outputs) == 1 {
+		return NewAuditRecordWriter(outputConfig(wc, outputs[0]))
+	}
+
+	bufferSize := wc.OutputBufferSize
+	if bufferSize <= 0 {
+		bufferSize = defaults.OutputBufferSize
+	}
+
+	w := &multiWriter{
+		wc: wc,
+	}
+
+	for _, name := range outputs {
+		o := &output{
+			name:         name,
+			typ:          wc.Type.String(),
+			writer:       NewAuditRecordWriter(outputConfig(wc, name)),
+			records:      make(chan proto.Message, bufferSize),
+			done:         make(chan struct{}),
+			dropWhenFull: dropWhenFull(name),
+		}
+
+		go o.run()
+
+		w.outputs = append(w.outputs, o)
+	}
+
+	ioLog.Info("create multiWriter", zap.String("type", wc.Type.String()), zap.Strings("outputs", outputs))
+
+	return w
+}
+
+// outputConfig returns a copy of the writer config that only enables the given output.
+func outputConfig(wc *WriterConfig, name string) *WriterConfig {
+	c := *wc
+
+	c.Outputs = ""
+	c.Proto = name == OutputProto
+	c.CSV = name == OutputCSV
+	c.JSON = name == OutputJSON
+	c.Elastic = name == OutputElastic
+	c.UnixSocket = name == OutputUnix
+	c.Parquet = name == OutputParquet
+	c.Zeek = name == OutputZeek
+	c.Eve = name == OutputEve
+	c.IPFIX = name == OutputIPFIX
+	c.SQLite = name == OutputSQLite
+	c.Chan = false
+	c.Null = false
+
+	return &c
+}
+
+// Write passes a copy of the audit record to each output,
+// the callers may modify the record once this function returned and the writers may modify their copy.
+// The outputs that drop audit records are served first, so that they do not wait for a full buffer of another output.
+func (w *multiWriter) Write(msg proto.Message) error {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if w.closed {
+		return nil
+	}
+
+	for _, o := range w.outputs {
+		if o.dropWhenFull {
+			o.offer(msg)
+		}
+	}
+
+	for _, o := range w.outputs {
+		if !o.dropWhenFull {
+			o.records <- proto.Clone(msg)
+		}
+	}
+
+	return nil
+}
+
+// offer passes a copy of the audit record to the output if there is room in its buffer, otherwise it is dropped.
+// The record is only copied if it is likely to be accepted.
+func (o *output) offer(msg proto.Message) {
+	if len(o.records) < cap(o.records) {
+		select {
+		case o.records <- proto.Clone(msg):
+			return
+		default:
+		}
+	}
+
+	atomic.AddInt64(&o.dropped, 1)
+	outputRecordsDropped.WithLabelValues(o.typ, o.name).Inc()
+}
+
+// WriteHeader writes the header for all outputs,
+// it must be called before writing the first audit record.
+func (w *multiWriter) WriteHeader(t types.Type) error {
+	var errs []string
+
+	for _, o := range w.outputs {
+		if err := o.writer.WriteHeader(t); err != nil {
+			errs = append(errs, o.name+": "+err.Error())
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// Close flushes the buffered audit records and closes all outputs.
+// The name of the first output that created a file and the total size of all outputs are returned.
+func (w *multiWriter) Close(_ int64) (name string, size int64) {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+
+		return "", 0
+	}
+	w.closed = true
+	w.mu.Unlock()
+
+	for _, o := range w.outputs {
+		close(o.records)
+	}
+
+	for _, o := range w.outputs {
+		<-o.done
+
+		n, s := o.writer.Close(atomic.LoadInt64(&o.written))
+		if name == "" {
+			name = n
+		}
+		size += s
+
+		ioLog.Info("closed output",
+			zap.String("type", w.wc.Type.String()),
+			zap.String("output", o.name),
+			zap.Int64("written", atomic.LoadInt64(&o.written)),
+			zap.Int64("errors", atomic.LoadInt64(&o.errors)),
+			zap.Int64("dropped", atomic.LoadInt64(&o.dropped)),
+		)
+	}
+
+	return name, size
+}
+
+// Stats returns the counters for each output.
+func (w *multiWriter) Stats() []OutputStats {
+	stats := make([]OutputStats, len(w.outputs))
+
+	for i, o := range w.outputs {
+		stats[i] = OutputStats{
+			Output:  o.name,
+			Written: atomic.LoadInt64(&o.written),
+			Errors:  atomic.LoadInt64(&o.errors),
+			Dropped: atomic.LoadInt64(&o.dropped),
+		}
+	}
+
+	return stats
+}
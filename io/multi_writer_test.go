@@ -0,0 +1,220 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/dreadl0ck/netcap"
+	"github.com/dreadl0ck/netcap/defaults"
+	"github.com/dreadl0ck/netcap/types"
+)
+
+func TestParseOutputs(t *testing.T) {
+	outputs, err := ParseOutputs(" proto, CSV,,elastic")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(outputs, []string{OutputProto, OutputCSV, OutputElastic}) {
+		t.Error("unexpected outputs", outputs)
+	}
+
+	for _, invalid := range []string{"proto,xml", "csv,csv"} {
+		if _, err = ParseOutputs(invalid); err == nil {
+			t.Error("expected an error for", invalid)
+		}
+	}
+}
+
+func TestMultiWriter(t *testing.T) {
+	out := t.TempDir()
+
+	w := NewAuditRecordWriter(&WriterConfig{
+		Outputs:              "proto,csv,json",
+		Name:                 "TCP",
+		Type:                 types.Type_NC_TCP,
+		Buffer:               true,
+		Compress:             true,
+		Out:                  out,
+		MemBufferSize:        defaults.BufferSize,
+		Source:               "unit tests",
+		Version:              netcap.Version,
+		StartTime:            time.Now(),
+		CompressionBlockSize: defaults.CompressionBlockSize,
+	})
+
+	mw, ok := w.(MultiAuditRecordWriter)
+	if !ok {
+		t.Fatalf("expected a multi writer, got %T", w)
+	}
+
+	if err := mw.WriteHeader(types.Type_NC_TCP); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tcp := range tcps {
+		if err := mw.Write(tcp); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, size := mw.Close(int64(len(tcps))); size == 0 {
+		t.Fatal("no bytes written")
+	}
+
+	for _, s := range mw.Stats() {
+		if s.Written != int64(len(tcps)) || s.Errors != 0 || s.Dropped != 0 {
+			t.Error("unexpected stats", s)
+		}
+	}
+
+	for _, name := range []string{"TCP.ncap.gz", "TCP.csv.gz", "TCP.json.gz"} {
+		if _, err := os.Stat(filepath.Join(out, name)); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+// blockingWriter blocks all writes until it is closed.
+type blockingWriter struct {
+	nullWriter
+	release chan struct{}
+}
+
+func (w *blockingWriter) Write(_ proto.Message) error {
+	<-w.release
+
+	return nil
+}
+
+func TestMultiWriterDrops(t *testing.T) {
+	var (
+		slow = &output{
+			name:         "slow",
+			writer:       &blockingWriter{release: make(chan struct{})},
+			records:      make(chan proto.Message, 1),
+			done:         make(chan struct{}),
+			dropWhenFull: true,
+		}
+		fast = &output{
+			name:         "fast",
+			writer:       &nullWriter{},
+			records:      make(chan proto.Message, len(tcps)),
+			done:         make(chan struct{}),
+			dropWhenFull: true,
+		}
+		// writing to a file waits until there is room in the buffer
+		file = &output{
+			name:    "file",
+			writer:  &nullWriter{},
+			records: make(chan proto.Message, 1),
+			done:    make(chan struct{}),
+		}
+		w = &multiWriter{
+			outputs: []*output{slow, fast, file},
+			wc:      &WriterConfig{Type: types.Type_NC_TCP},
+		}
+	)
+
+	go file.run()
+
+	// the slow output only buffers the first record while nothing is being written
+	for _, tcp := range tcps {
+		if err := w.Write(tcp); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	go slow.run()
+	go fast.run()
+
+	close(slow.writer.(*blockingWriter).release)
+	w.Close(0)
+
+	stats := w.Stats()
+	if stats[0].Written != 1 || stats[0].Dropped != int64(len(tcps)-1) {
+		t.Error("unexpected stats for slow output", stats[0])
+	}
+
+	if stats[1].Written != int64(len(tcps)) || stats[1].Dropped != 0 {
+		t.Error("unexpected stats for fast output", stats[1])
+	}
+
+	if stats[2].Written != int64(len(tcps)) || stats[2].Dropped != 0 {
+		t.Error("unexpected stats for file output", stats[2])
+	}
+
+	if !dropWhenFull(OutputElastic) || !dropWhenFull(OutputUnix) || dropWhenFull(OutputProto) {
+		t.Error("unexpected output policy")
+	}
+}
+
+func TestMultiWriterBlockedOutput(t *testing.T) {
+	var (
+		fast = &output{
+			name:         "fast",
+			writer:       &nullWriter{},
+			records:      make(chan proto.Message, len(tcps)),
+			done:         make(chan struct{}),
+			dropWhenFull: true,
+		}
+		// the file output is not written until the end of the test
+		file = &output{
+			name:    "file",
+			writer:  &nullWriter{},
+			records: make(chan proto.Message, 1),
+			done:    make(chan struct{}),
+		}
+		w = &multiWriter{
+			outputs: []*output{file, fast},
+			wc:      &WriterConfig{Type: types.Type_NC_TCP},
+		}
+		n = 3
+	)
+
+	// the first record fills the buffer of the file output, the following writes wait for it
+	for i := 0; i < n; i++ {
+		go func(i int) {
+			if err := w.Write(tcps[i]); err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(fast.records) != n {
+		if time.Now().After(deadline) {
+			t.Fatal("writes waiting for the file output stalled the fast output, records:", len(fast.records))
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	go file.run()
+	go fast.run()
+
+	w.Close(0)
+
+	stats := w.Stats()
+	if stats[0].Written != int64(n) || stats[1].Written != int64(n) || stats[1].Dropped != 0 {
+		t.Error("unexpected stats", stats)
+	}
+}
@@ -171,6 +171,8 @@ func InitRecord(typ types.Type) (record proto.Message) {
 		record = new(types.Mail)
 	case types.Type_NC_Alert:
 		record = new(types.Alert)
+	case types.Type_NC_SCTPAssociation:
+		record = new(types.SCTPAssociation)
 	default:
 		panic("InitRecord: unknown type: " + typ.String())
 	}
@@ -0,0 +1,362 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/compress"
+	"go.uber.org/zap"
+
+	"github.com/dreadl0ck/netcap"
+	"github.com/dreadl0ck/netcap/defaults"
+	"github.com/dreadl0ck/netcap/types"
+)
+
+// keys for the netcap header information in the parquet file metadata.
+const (
+	ParquetKeyType             = "netcap.type"
+	ParquetKeyCreated          = "netcap.created"
+	ParquetKeySource           = "netcap.source"
+	ParquetKeyVersion          = "netcap.version"
+	ParquetKeyContainsPayloads = "netcap.payloads"
+)
+
+// parquetWriter is a structure that supports writing audit records to disk in the apache parquet format.
+// The pages are compressed by the parquet writer, so the Compress option is ignored.
+type parquetWriter struct {
+	mu      sync.Mutex
+	bWriter *bufio.Writer
+	pWriter *parquetRecordWriter
+
+	file *os.File
+	wc   *WriterConfig
+}
+
+// newParquetWriter initializes and configures a new parquetWriter instance.
+func newParquetWriter(wc *WriterConfig) *parquetWriter {
+	w := &parquetWriter{}
+	w.wc = wc
+
+	if wc.MemBufferSize <= 0 {
+		wc.MemBufferSize = defaults.BufferSize
+	}
+
+	w.file = createFile(filepath.Join(wc.Out, w.wc.Name), ".parquet")
+	ioLog.Info("create parquetWriter", zap.String("base", filepath.Join(wc.Out, wc.Name)), zap.String("type", wc.Type.String()))
+
+	if wc.Buffer {
+		w.bWriter = bufio.NewWriterSize(w.file, wc.MemBufferSize)
+	}
+
+	return w
+}
+
+// Write writes an audit record into the current row group.
+func (w *parquetWriter) Write(msg proto.Message) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.pWriter == nil {
+		if err := w.init(msg, w.wc.Type); err != nil {
+			return err
+		}
+	}
+
+	return w.pWriter.Write(msg)
+}
+
+// WriteHeader creates the parquet schema for the audit record type and adds the netcap header to the file metadata.
+func (w *parquetWriter) WriteHeader(t types.Type) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.pWriter != nil {
+		return nil
+	}
+
+	return w.init(InitRecord(t), t)
+}
+
+func (w *parquetWriter) init(record proto.Message, t types.Type) error {
+	var out io.Writer = w.file
+	if w.bWriter != nil {
+		out = w.bWriter
+	}
+
+	var err error
+
+	w.pWriter, err = newParquetRecordWriter(out, record, NewHeader(t, w.wc.Source, w.wc.Version, w.wc.IncludesPayloads, w.wc.StartTime), w.wc.ParquetRowGroupSize, w.wc.ParquetCompression)
+
+	return err
+}
+
+// Close writes the remaining row group and the file metadata, and closes the associated file handles.
+func (w *parquetWriter) Close(numRecords int64) (name string, size int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.pWriter != nil {
+		if err := w.pWriter.Close(); err != nil {
+			fmt.Println("failed to close parquet writer:", err, "type", w.wc.Name)
+		}
+	}
+
+	if w.wc.Buffer {
+		flushWriters(w.bWriter)
+	}
+
+	return closeFile(w.wc.Out, w.file, w.wc.Name, numRecords)
+}
+
+// errInvalidParquetCompression occurs when an unknown parquet compression codec has been configured.
+var errInvalidParquetCompression = errors.New("invalid parquet compression codec")
+
+// ParseParquetCompression returns the parquet page compression codec for the given name, an empty name selects snappy.
+func ParseParquetCompression(name string) (compress.Codec, error) {
+	switch strings.ToLower(name) {
+	case "", "snappy":
+		return &parquet.Snappy, nil
+	case "gzip":
+		return &parquet.Gzip, nil
+	case "zstd":
+		return &parquet.Zstd, nil
+	case "none", "uncompressed":
+		return &parquet.Uncompressed, nil
+	default:
+		return nil, fmt.Errorf("%w: %q, expected snappy, gzip, zstd or none", errInvalidParquetCompression, name)
+	}
+}
+
+// parquetCreatedBy sets the application that created the parquet file,
+// parquet.CreatedBy would append an empty build identifier.
+type parquetCreatedBy string
+
+// ConfigureWriter implements the parquet.WriterOption interface.
+func (c parquetCreatedBy) ConfigureWriter(config *parquet.WriterConfig) {
+	config.CreatedBy = string(c)
+}
+
+// parquetRecordWriter writes audit records of a single type as parquet rows.
+type parquetRecordWriter struct {
+	w *parquet.Writer
+
+	// rowType is the struct type of the parquet schema,
+	// it differs from the audit record type only if the record contains recursive messages.
+	rowType    reflect.Type
+	recordType reflect.Type
+}
+
+// newParquetRecordWriter creates a parquet writer for audit records of the given type,
+// the netcap header is stored in the file metadata.
+func newParquetRecordWriter(out io.Writer, record proto.Message, h *types.Header, rowGroupSize int, compression string) (*parquetRecordWriter, error) {
+	codec, err := ParseParquetCompression(compression)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		recordType = reflect.TypeOf(record).Elem()
+		rowType, _ = parquetRowType(recordType, map[reflect.Type]bool{})
+	)
+
+	return &parquetRecordWriter{
+		w: parquet.NewWriter(out,
+			parquet.SchemaOf(reflect.New(rowType).Interface()),
+			parquet.Compression(codec),
+			parquet.MaxRowsPerRowGroup(int64(rowGroupSize)),
+			parquetCreatedBy("netcap version "+netcap.Version),
+			parquet.KeyValueMetadata(ParquetKeyType, h.Type.String()),
+			parquet.KeyValueMetadata(ParquetKeyCreated, strconv.FormatInt(h.Created, 10)),
+			parquet.KeyValueMetadata(ParquetKeySource, h.InputSource),
+			parquet.KeyValueMetadata(ParquetKeyVersion, h.Version),
+			parquet.KeyValueMetadata(ParquetKeyContainsPayloads, strconv.FormatBool(h.ContainsPayloads)),
+		),
+		rowType:    rowType,
+		recordType: recordType,
+	}, nil
+}
+
+// Write appends the audit record to the current row group.
+func (p *parquetRecordWriter) Write(record proto.Message) error {
+	if p.rowType == p.recordType {
+		return p.w.Write(record)
+	}
+
+	row := reflect.New(p.rowType)
+	copyParquetValue(row.Elem(), reflect.ValueOf(record).Elem())
+
+	return p.w.Write(row.Interface())
+}
+
+// Close writes the remaining row group and the file metadata.
+func (p *parquetRecordWriter) Close() error {
+	return p.w.Close()
+}
+
+// parquetRowType returns the type used for the parquet schema of the given audit record type.
+// Parquet cannot represent recursive messages, so fields that refer to an enclosing message type,
+// like the Next field of the GRERouting, are excluded from the schema.
+// The second return value reports whether t itself is one of the enclosing message types.
+func parquetRowType(t reflect.Type, path map[reflect.Type]bool) (reflect.Type, bool) {
+	switch t.Kind() {
+	case reflect.Ptr:
+		elem, recursive := parquetRowType(t.Elem(), path)
+		if recursive || elem == t.Elem() {
+			return t, recursive
+		}
+
+		return reflect.PointerTo(elem), false
+	case reflect.Slice:
+		elem, recursive := parquetRowType(t.Elem(), path)
+		if recursive || elem == t.Elem() {
+			return t, recursive
+		}
+
+		return reflect.SliceOf(elem), false
+	case reflect.Map:
+		elem, recursive := parquetRowType(t.Elem(), path)
+		if recursive || elem == t.Elem() {
+			return t, recursive
+		}
+
+		return reflect.MapOf(t.Key(), elem), false
+	case reflect.Struct:
+		if path[t] {
+			return t, true
+		}
+
+		path[t] = true
+		defer delete(path, t)
+
+		var (
+			fields  = make([]reflect.StructField, t.NumField())
+			changed bool
+		)
+
+		for i := range fields {
+			f := t.Field(i)
+
+			typ, recursive := parquetRowType(f.Type, path)
+			if recursive {
+				f.Tag = `parquet:"-"`
+				changed = true
+			} else if typ != f.Type {
+				f.Type = typ
+				changed = true
+			}
+
+			fields[i] = f
+		}
+
+		if !changed {
+			return t, false
+		}
+
+		return reflect.StructOf(fields), false
+	default:
+		return t, false
+	}
+}
+
+// copyParquetValue copies src into dst, the types of both values must have been derived from each other by parquetRowType.
+func copyParquetValue(dst, src reflect.Value) {
+	if dst.Type() == src.Type() {
+		dst.Set(src)
+
+		return
+	}
+
+	switch src.Kind() {
+	case reflect.Ptr:
+		if src.IsNil() {
+			return
+		}
+
+		dst.Set(reflect.New(dst.Type().Elem()))
+		copyParquetValue(dst.Elem(), src.Elem())
+	case reflect.Slice:
+		if src.IsNil() {
+			return
+		}
+
+		dst.Set(reflect.MakeSlice(dst.Type(), src.Len(), src.Len()))
+
+		for i := 0; i < src.Len(); i++ {
+			copyParquetValue(dst.Index(i), src.Index(i))
+		}
+	case reflect.Map:
+		if src.IsNil() {
+			return
+		}
+
+		dst.Set(reflect.MakeMapWithSize(dst.Type(), src.Len()))
+
+		iter := src.MapRange()
+		for iter.Next() {
+			v := reflect.New(dst.Type().Elem()).Elem()
+			copyParquetValue(v, iter.Value())
+			dst.SetMapIndex(iter.Key(), v)
+		}
+	case reflect.Struct:
+		for i := 0; i < src.NumField(); i++ {
+			copyParquetValue(dst.Field(i), src.Field(i))
+		}
+	}
+}
+
+// dumpParquet converts the audit records from the reader into a parquet file that is written to w.
+func dumpParquet(w io.Writer, r *Reader, header *types.Header, record proto.Message, c DumpConfig) error {
+	size := c.MemBufferSize
+	if size <= 0 {
+		size = defaults.BufferSize
+	}
+
+	bw := bufio.NewWriterSize(w, size)
+
+	pw, err := newParquetRecordWriter(bw, record, header, c.ParquetRowGroupSize, c.ParquetCompression)
+	if err != nil {
+		return err
+	}
+
+	for {
+		err = r.Next(record)
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("failed to read next audit record: %w", err)
+		}
+
+		if err = pw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	if err = pw.Close(); err != nil {
+		return err
+	}
+
+	return bw.Flush()
+}
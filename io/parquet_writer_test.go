@@ -0,0 +1,182 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/parquet-go/parquet-go"
+
+	"github.com/dreadl0ck/netcap/types"
+)
+
+// fillRecord sets all fields of the audit record to values derived from seed.
+// Recursive messages are left empty, since they are not part of the parquet schema.
+func fillRecord(v reflect.Value, seed int, seen map[reflect.Type]bool) {
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(seed%2 == 0)
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int:
+		v.SetInt(int64(seed))
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint:
+		v.SetUint(uint64(seed))
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(float64(seed) + 0.5)
+	case reflect.String:
+		v.SetString("value-" + strconv.Itoa(seed))
+	case reflect.Slice:
+		s := reflect.MakeSlice(v.Type(), 2, 2)
+		for i := 0; i < s.Len(); i++ {
+			fillRecord(s.Index(i), seed+i, seen)
+		}
+
+		v.Set(s)
+	case reflect.Ptr:
+		if seen[v.Type().Elem()] {
+			return
+		}
+
+		p := reflect.New(v.Type().Elem())
+		fillRecord(p.Elem(), seed, seen)
+		v.Set(p)
+	case reflect.Struct:
+		seen[v.Type()] = true
+		defer delete(seen, v.Type())
+
+		for i := 0; i < v.NumField(); i++ {
+			if strings.HasPrefix(v.Type().Field(i).Name, "XXX_") {
+				continue
+			}
+
+			fillRecord(v.Field(i), seed+i, seen)
+		}
+	case reflect.Map:
+		m := reflect.MakeMap(v.Type())
+		for i := 0; i < 2; i++ {
+			key := reflect.New(v.Type().Key()).Elem()
+			value := reflect.New(v.Type().Elem()).Elem()
+
+			fillRecord(key, seed+i, seen)
+			fillRecord(value, seed+i, seen)
+			m.SetMapIndex(key, value)
+		}
+
+		v.Set(m)
+	}
+}
+
+// auditRecord returns a new audit record for the type, or nil for types that only describe nested messages.
+func auditRecord(typ types.Type) (record proto.Message) {
+	defer func() {
+		if recover() != nil {
+			record = nil
+		}
+	}()
+
+	return InitRecord(typ)
+}
+
+func TestParquetRoundTrip(t *testing.T) {
+	for i := range types.Type_name {
+		typ := types.Type(i)
+		if typ == types.Type_NC_Header {
+			continue
+		}
+
+		record := auditRecord(typ)
+		if record == nil {
+			continue
+		}
+
+		var (
+			buf     bytes.Buffer
+			records []proto.Message
+		)
+
+		w, err := newParquetRecordWriter(&buf, record, NewHeader(typ, "test.pcap", "v0.0.0", true, time.Unix(0, tcps[0].Timestamp)), 2, "zstd")
+		if err != nil {
+			t.Fatal(typ, err)
+		}
+
+		// an empty record and several filled ones, to span multiple row groups
+		for seed := 0; seed < 5; seed++ {
+			r := reflect.New(reflect.TypeOf(record).Elem())
+			if seed > 0 {
+				fillRecord(r.Elem(), seed, map[reflect.Type]bool{})
+			}
+
+			records = append(records, r.Interface().(proto.Message))
+
+			if err = w.Write(records[len(records)-1]); err != nil {
+				t.Fatal(typ, err)
+			}
+		}
+
+		if err = w.Close(); err != nil {
+			t.Fatal(typ, err)
+		}
+
+		f, err := parquet.OpenFile(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		if err != nil {
+			t.Fatal(typ, err)
+		}
+
+		if v, _ := f.Lookup(ParquetKeyType); v != typ.String() {
+			t.Error(typ, "unexpected type in metadata", v)
+		}
+
+		if v, _ := f.Lookup(ParquetKeySource); v != "test.pcap" {
+			t.Error(typ, "unexpected source in metadata", v)
+		}
+
+		if len(f.RowGroups()) != 3 {
+			t.Error(typ, "unexpected number of row groups", len(f.RowGroups()))
+		}
+
+		var (
+			pr    = parquet.NewReader(f)
+			index int
+		)
+
+		for {
+			row := reflect.New(w.rowType)
+			if err = pr.Read(row.Interface()); errors.Is(err, io.EOF) {
+				break
+			} else if err != nil {
+				t.Fatal(typ, err)
+			}
+
+			got := reflect.New(w.recordType)
+			copyParquetValue(got.Elem(), row.Elem())
+
+			if index < len(records) && !proto.Equal(got.Interface().(proto.Message), records[index]) {
+				t.Errorf("%s: record %d differs\ngot:      %+v\nexpected: %+v", typ, index, got.Interface(), records[index])
+			}
+
+			index++
+		}
+
+		if index != len(records) {
+			t.Error(typ, "unexpected number of records", index)
+		}
+	}
+}
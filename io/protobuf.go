@@ -15,15 +15,14 @@ package io
 
 import (
 	"bufio"
+	"fmt"
 	"go.uber.org/zap"
-	"log"
+	"io"
 	"os"
 	"path/filepath"
-	"runtime"
 	"sync"
 
 	"github.com/gogo/protobuf/proto"
-	"github.com/klauspost/pgzip"
 
 	"github.com/dreadl0ck/netcap/defaults"
 	"github.com/dreadl0ck/netcap/delimited"
@@ -35,10 +34,14 @@ type protoWriter struct {
 	mu sync.Mutex
 
 	bWriter *bufio.Writer
-	gWriter *pgzip.Writer
+	cWriter compressor
 	dWriter *delimited.Writer
+	counter *countingWriter
 	pWriter *delimitedProtoWriter
 
+	// time index, nil if disabled
+	index *indexWriter
+
 	file *os.File
 	wc   *WriterConfig
 }
@@ -52,8 +55,12 @@ func newProtoWriter(wc *WriterConfig) *protoWriter {
 		wc.MemBufferSize = defaults.BufferSize
 	}
 
+	if wc.IndexBlockSize <= 0 {
+		wc.IndexBlockSize = defaults.IndexBlockSize
+	}
+
 	if wc.Compress {
-		w.file = createFile(filepath.Join(wc.Out, wc.Name), defaults.FileExtensionCompressed)
+		w.file = createFile(filepath.Join(wc.Out, wc.Name), defaults.FileExtension+compressedExtension(wc))
 	} else {
 		w.file = createFile(filepath.Join(wc.Out, wc.Name), defaults.FileExtension)
 	}
@@ -62,42 +69,46 @@ func newProtoWriter(wc *WriterConfig) *protoWriter {
 	// buffer data?
 	if wc.Buffer {
 		if wc.Compress {
-			// experiment: pgzip -> file
-			var errGzipWriter error
-			w.gWriter, errGzipWriter = pgzip.NewWriterLevel(w.file, wc.CompressionLevel)
+			// experiment: compressor -> file
+			var errCompressor error
+			w.cWriter, errCompressor = newCompressor(w.file, wc)
 
-			if errGzipWriter != nil {
-				panic(errGzipWriter)
+			if errCompressor != nil {
+				panic(errCompressor)
 			}
-			// experiment: buffer -> pgzip
-			w.bWriter = bufio.NewWriterSize(w.gWriter, wc.MemBufferSize)
+			// experiment: buffer -> compressor
+			w.bWriter = bufio.NewWriterSize(w.cWriter, wc.MemBufferSize)
 			// experiment: delimited -> buffer
-			w.dWriter = delimited.NewWriter(w.bWriter)
+			w.counter = newCountingWriter(w.bWriter)
+			w.dWriter = delimited.NewWriter(w.counter)
 		} else {
 			w.bWriter = bufio.NewWriterSize(w.file, wc.MemBufferSize)
-			w.dWriter = delimited.NewWriter(w.bWriter)
+			w.counter = newCountingWriter(w.bWriter)
+			w.dWriter = delimited.NewWriter(w.counter)
 		}
 	} else {
 		if w.wc.Compress {
-			var errGzipWriter error
-			w.gWriter, errGzipWriter = pgzip.NewWriterLevel(w.file, wc.CompressionLevel)
-			if errGzipWriter != nil {
-				panic(errGzipWriter)
+			var errCompressor error
+			w.cWriter, errCompressor = newCompressor(w.file, wc)
+			if errCompressor != nil {
+				panic(errCompressor)
 			}
-			w.dWriter = delimited.NewWriter(w.gWriter)
+			w.counter = newCountingWriter(w.cWriter)
+			w.dWriter = delimited.NewWriter(w.counter)
 		} else {
-			w.dWriter = delimited.NewWriter(w.file)
+			w.counter = newCountingWriter(w.file)
+			w.dWriter = delimited.NewWriter(w.counter)
 		}
 	}
 
 	w.pWriter = newDelimitedProtoWriter(w.dWriter)
 
-	if w.gWriter != nil {
-		// To get any performance gains, you should at least be compressing more than 1 megabyte of data at the time.
-		// You should at least have a block size of 100k and at least a number of blocks that match the number of cores
-		// you would like to utilize, but about twice the number of blocks would be the best.
-		if err := w.gWriter.SetConcurrency(wc.CompressionBlockSize, runtime.GOMAXPROCS(0)*2); err != nil {
-			log.Fatal("failed to configure compression package: ", err)
+	if wc.Index {
+		var err error
+
+		w.index, err = newIndexWriter(w.file.Name())
+		if err != nil {
+			panic(err)
 		}
 	}
 
@@ -109,12 +120,74 @@ func (w *protoWriter) Write(msg proto.Message) error {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
-	return w.pWriter.putProto(msg)
+	if err := w.pWriter.putProto(msg); err != nil {
+		return err
+	}
+
+	if w.index != nil {
+		var ts int64
+		if r, ok := msg.(types.AuditRecord); ok {
+			ts = r.Time()
+		}
+
+		w.index.add(ts)
+
+		if w.index.current.NumRecords >= int64(w.wc.IndexBlockSize) {
+			return w.nextBlock()
+		}
+	}
+
+	return nil
 }
 
 // WriteHeader writes a netcap file header for protobuf encoded audit record files.
 func (w *protoWriter) WriteHeader(t types.Type) error {
-	return w.pWriter.putProto(NewHeader(t, w.wc.Source, w.wc.Version, w.wc.IncludesPayloads, w.wc.StartTime))
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	h := NewHeader(t, w.wc.Source, w.wc.Version, w.wc.IncludesPayloads, w.wc.StartTime)
+
+	// the schema allows to read the file after the types have changed
+	if err := AddSchema(h); err != nil {
+		return err
+	}
+
+	if err := w.pWriter.putProto(h); err != nil {
+		return err
+	}
+
+	// the first block starts after the header
+	if w.index != nil {
+		return w.nextBlock()
+	}
+
+	return nil
+}
+
+// nextBlock completes the current block of the time index,
+// so that the following audit records can be decoded starting at the offset of the next block.
+func (w *protoWriter) nextBlock() error {
+	if w.bWriter != nil {
+		if err := w.bWriter.Flush(); err != nil {
+			return err
+		}
+	}
+
+	// each block is a separate gzip member or zstd frame, readers decode the concatenated streams as a single stream
+	if w.cWriter != nil {
+		if err := w.cWriter.Close(); err != nil {
+			return err
+		}
+
+		w.cWriter.Reset(w.file)
+	}
+
+	offset, err := w.file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+
+	return w.index.next(offset)
 }
 
 // Close flushes and closes the writer and the associated file handles.
@@ -127,8 +200,19 @@ func (w *protoWriter) Close(numRecords int64) (name string, size int64) {
 	}
 
 	if w.wc.Compress {
-		closeGzipWriters(w.gWriter)
+		closeCompressors(w.cWriter)
+	}
+
+	if w.index != nil {
+		if err := w.index.close(numRecords == 0); err != nil {
+			fmt.Println("failed to close time index:", err, "type", w.wc.Name)
+		}
 	}
 
 	return closeFile(w.wc.Out, w.file, w.wc.Name, numRecords)
 }
+
+// uncompressedSize returns the number of bytes that have been written into the file before compression.
+func (w *protoWriter) uncompressedSize() int64 {
+	return w.counter.n
+}
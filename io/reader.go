@@ -15,9 +15,9 @@ package io
 
 import (
 	"bufio"
-	"compress/gzip"
+	"io"
 	"os"
-	"path/filepath"
+	"time"
 
 	"github.com/go-errors/errors"
 	"github.com/gogo/protobuf/proto"
@@ -31,11 +31,35 @@ import (
 type Reader struct {
 	file    *os.File
 	bReader *bufio.Reader
-	gReader *gzip.Reader
+	cReader decompressor
 	dReader *delimited.Reader
+
+	// time range set with SetTimeRange, in nanoseconds
+	filter bool
+	start  int64
+	end    int64
+
+	// time index of the file, the indices of the blocks that overlap the time range
+	// and the position of the reader in blocks, with the number of audit records left in the current block
+	index     []IndexBlock
+	selected  []int
+	block     int
+	remaining int64
+}
+
+// timestamped is implemented by the audit records and the DynamicRecord.
+type timestamped interface {
+	Time() int64
+}
+
+// optionallyTimestamped is implemented by the DynamicRecord, whose schema might not have a timestamp field.
+// Audit records without a timestamp are not filtered by the time range.
+type optionallyTimestamped interface {
+	HasTime() bool
 }
 
 // Open a netcap audit record file for reading.
+// Files compressed with gzip or zstd are detected by their magic bytes and decompressed transparently.
 func Open(file string, memBufSize int) (*Reader, error) {
 	r := &Reader{}
 
@@ -51,13 +75,13 @@ func Open(file string, memBufSize int) (*Reader, error) {
 	r.file = h
 	r.bReader = bufio.NewReaderSize(h, memBufSize)
 
-	if filepath.Ext(file) == ".gz" {
-		r.gReader, err = gzip.NewReader(r.bReader)
-		if err != nil {
-			return nil, err
-		}
+	r.cReader, err = newDecompressor(r.bReader, file)
+	if err != nil {
+		return nil, err
+	}
 
-		r.dReader = delimited.NewReader(r.gReader)
+	if r.cReader != nil {
+		r.dReader = delimited.NewReader(r.cReader)
 	} else {
 		r.dReader = delimited.NewReader(r.bReader)
 	}
@@ -67,8 +91,8 @@ func Open(file string, memBufSize int) (*Reader, error) {
 
 // Close the file.
 func (r *Reader) Close() error {
-	if r.gReader != nil {
-		err := r.gReader.Close()
+	if r.cReader != nil {
+		err := r.cReader.Close()
 		if err != nil {
 			return err
 		}
@@ -83,8 +107,101 @@ func (r *Reader) Close() error {
 }
 
 // Next Message.
+// If a time range has been set, audit records with timestamps outside of the range are skipped.
 func (r *Reader) Next(msg proto.Message) error {
-	return r.dReader.NextProto(msg)
+	if !r.filter {
+		return r.dReader.NextProto(msg)
+	}
+
+	for {
+		if r.index != nil && r.remaining == 0 {
+			if len(r.selected) == 0 {
+				return io.EOF
+			}
+
+			// consecutive blocks are read without seeking
+			if r.selected[0] != r.block {
+				if err := r.seek(r.index[r.selected[0]].Offset); err != nil {
+					return err
+				}
+			}
+
+			r.block = r.selected[0] + 1
+			r.remaining = r.index[r.selected[0]].NumRecords
+			r.selected = r.selected[1:]
+		}
+
+		if err := r.dReader.NextProto(msg); err != nil {
+			return err
+		}
+
+		r.remaining--
+
+		if o, ok := msg.(optionallyTimestamped); ok && !o.HasTime() {
+			return nil
+		}
+
+		if a, ok := msg.(timestamped); ok {
+			if ts := a.Time(); ts < r.start || (r.end != 0 && ts > r.end) {
+				continue
+			}
+		}
+
+		return nil
+	}
+}
+
+// SetTimeRange limits the audit records returned by Next to the time range, a zero time means no limit.
+// If the file has a time index, the reader seeks directly to the blocks that overlap the range,
+// otherwise all audit records are read and filtered. It must be called after reading the header.
+func (r *Reader) SetTimeRange(start, end time.Time) error {
+	r.filter = true
+
+	if !start.IsZero() {
+		r.start = start.UnixNano()
+	}
+
+	if !end.IsZero() {
+		r.end = end.UnixNano()
+	}
+
+	index, err := ReadIndex(r.file.Name())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	r.index = index
+
+	for i, b := range index {
+		if b.overlaps(r.start, r.end) {
+			r.selected = append(r.selected, i)
+		}
+	}
+
+	return nil
+}
+
+// seek continues reading at the offset of a block.
+func (r *Reader) seek(offset int64) error {
+	if _, err := r.file.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	r.bReader.Reset(r.file)
+
+	if r.cReader != nil {
+		if err := r.cReader.Reset(r.bReader); err != nil {
+			return err
+		}
+
+		r.dReader = delimited.NewReader(r.cReader)
+	} else {
+		r.dReader = delimited.NewReader(r.bReader)
+	}
+
+	return nil
 }
 
 // ReadHeader reads the file header.
@@ -0,0 +1,64 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/dreadl0ck/netcap/types"
+)
+
+// errNotAnAuditRecord occurs when a message passed to the recordWriter does not implement the types.AuditRecord interface.
+var errNotAnAuditRecord = errors.New("message is not an audit record")
+
+// recordWriter delivers audit records in memory, by sending them into a channel.
+// Closing the channel is left to the owner, since it is shared by the writers for all audit record types.
+type recordWriter struct {
+	records chan<- types.AuditRecord
+}
+
+// newRecordWriter initializes and configures a new recordWriter instance.
+func newRecordWriter(wc *WriterConfig) *recordWriter {
+	ioLog.Info("create recordWriter", zap.String("type", wc.Type.String()))
+
+	return &recordWriter{
+		records: wc.Records,
+	}
+}
+
+// Write sends the audit record into the channel, this blocks if the channel is full.
+func (w *recordWriter) Write(msg proto.Message) error {
+	r, ok := msg.(types.AuditRecord)
+	if !ok {
+		return fmt.Errorf("%w: %T", errNotAnAuditRecord, msg)
+	}
+
+	w.records <- r
+
+	return nil
+}
+
+// WriteHeader does nothing, since no header is needed for records in memory.
+func (w *recordWriter) WriteHeader(_ types.Type) error {
+	return nil
+}
+
+// Close does nothing, since no data needs to be flushed.
+func (w *recordWriter) Close(_ int64) (name string, size int64) {
+	return "", 0
+}
@@ -0,0 +1,349 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/dreadl0ck/netcap/types"
+)
+
+// rotationTimeFormat is used for the time range in the names of rotated files.
+const rotationTimeFormat = "20060102T150405Z"
+
+// rotatedFile matches the names of rotated audit record files, e.g. DNS-20201228T120000Z-20201228T130000Z.ncap.gz.
+var rotatedFile = regexp.MustCompile(`^[A-Za-z0-9]+-\d{8}T\d{6}Z-\d{8}T\d{6}Z(_\d+)?\.(ncap|csv|json)(\.gz|\.zst)?$`)
+
+// sizedWriter is implemented by the writers that support rotation by size.
+// The size is counted before compression, because the compressor and the buffers
+// hold back data, so the size of the file on disk lags behind the written audit records.
+type sizedWriter interface {
+	uncompressedSize() int64
+}
+
+// countingWriter counts the bytes that are written to the underlying writer.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func newCountingWriter(w io.Writer) *countingWriter {
+	return &countingWriter{w: w}
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+
+	return n, err
+}
+
+// retentionMu synchronizes the retention of the writers, they share the quota for the output directory.
+var retentionMu sync.Mutex
+
+// rotatingWriter writes the audit records into a sequence of files,
+// a new file is started when the current file exceeds the configured size or on interval boundaries.
+// For compressed files, the size limit applies to the data before compression.
+// Each file is closed completely and starts with its own header, so it can be read while the capture continues.
+type rotatingWriter struct {
+	mu   sync.Mutex
+	wc   *WriterConfig
+	open func(wc *WriterConfig) AuditRecordWriter
+
+	// writer of the current file
+	w      AuditRecordWriter
+	typ    types.Type
+	header bool
+
+	// records in the current file and their time range, in nanoseconds
+	numRecords int64
+	first      int64
+	last       int64
+
+	// name and accumulated size of the rotated files
+	name string
+	size int64
+
+	done chan struct{}
+	now  func() time.Time
+}
+
+// newRotatingWriter returns a rotatingWriter if rotation has been configured, otherwise the writer created by open.
+func newRotatingWriter(wc *WriterConfig, open func(wc *WriterConfig) AuditRecordWriter) AuditRecordWriter {
+	if wc.RotateSize <= 0 && wc.RotateInterval <= 0 {
+		return open(wc)
+	}
+
+	w := &rotatingWriter{
+		wc:   wc,
+		open: open,
+		w:    open(wc),
+		done: make(chan struct{}),
+		now:  time.Now,
+	}
+
+	ioLog.Info("create rotatingWriter",
+		zap.String("base", filepath.Join(wc.Out, wc.Name)),
+		zap.Int64("size", wc.RotateSize),
+		zap.Duration("interval", wc.RotateInterval),
+	)
+
+	if wc.RotateInterval > 0 {
+		go w.rotatePeriodically()
+	}
+
+	return w
+}
+
+// Write writes the audit record into the current file, and starts a new file if it exceeds the size limit.
+func (w *rotatingWriter) Write(msg proto.Message) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	// the timestamp is taken before writing, the json writer converts it to milliseconds
+	var ts int64
+	if r, ok := msg.(types.AuditRecord); ok {
+		ts = r.Time()
+	}
+
+	if err := w.w.Write(msg); err != nil {
+		return err
+	}
+
+	w.numRecords++
+
+	if ts > 0 {
+		if w.first == 0 || ts < w.first {
+			w.first = ts
+		}
+
+		if ts > w.last {
+			w.last = ts
+		}
+	}
+
+	if s, ok := w.w.(sizedWriter); ok && w.wc.RotateSize > 0 && s.uncompressedSize() >= w.wc.RotateSize {
+		return w.rotate()
+	}
+
+	return nil
+}
+
+// WriteHeader writes the header into the current file, it is repeated for each new file.
+func (w *rotatingWriter) WriteHeader(t types.Type) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.typ = t
+	w.header = true
+
+	return w.w.WriteHeader(t)
+}
+
+// Close closes the current file, the returned size includes all files that have been written.
+func (w *rotatingWriter) Close(_ int64) (name string, size int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.wc.RotateInterval > 0 {
+		close(w.done)
+	}
+
+	if err := w.finish(); err != nil {
+		fmt.Println("failed to rotate audit record file:", err, "type", w.wc.Name)
+	}
+
+	return w.name, w.size
+}
+
+// rotatePeriodically starts a new file on each interval boundary, if the current file contains audit records.
+func (w *rotatingWriter) rotatePeriodically() {
+	for {
+		next := w.now().Truncate(w.wc.RotateInterval).Add(w.wc.RotateInterval)
+
+		select {
+		case <-w.done:
+			return
+		case <-time.After(next.Sub(w.now())):
+		}
+
+		w.mu.Lock()
+
+		select {
+		case <-w.done:
+			w.mu.Unlock()
+
+			return
+		default:
+		}
+
+		if w.numRecords > 0 {
+			if err := w.rotate(); err != nil {
+				ioLog.Error("failed to rotate audit record file", zap.Error(err), zap.String("type", w.wc.Name))
+			}
+		}
+
+		w.mu.Unlock()
+	}
+}
+
+// rotate closes the current file and continues with a new one.
+func (w *rotatingWriter) rotate() error {
+	if err := w.finish(); err != nil {
+		return err
+	}
+
+	w.w = w.open(w.wc)
+	w.numRecords = 0
+	w.first = 0
+	w.last = 0
+
+	if w.header {
+		return w.w.WriteHeader(w.typ)
+	}
+
+	return nil
+}
+
+// finish closes the current file and renames it to include the time range of its audit records,
+// files without audit records are removed when they are closed.
+func (w *rotatingWriter) finish() error {
+	name, size := w.w.Close(w.numRecords)
+	if w.numRecords == 0 || name == "" {
+		if w.name == "" {
+			w.name = name
+		}
+
+		return nil
+	}
+
+	end := w.now()
+	if w.last > 0 {
+		end = time.Unix(0, w.last)
+	}
+
+	start := end
+	if w.first > 0 {
+		start = time.Unix(0, w.first)
+	}
+
+	var (
+		ext     = strings.TrimPrefix(name, w.wc.Name)
+		base    = w.wc.Name + "-" + start.UTC().Format(rotationTimeFormat) + "-" + end.UTC().Format(rotationTimeFormat)
+		rotated = base + ext
+	)
+
+	// files with the same time range get a numeric suffix
+	for i := 1; ; i++ {
+		if _, err := os.Stat(filepath.Join(w.wc.Out, rotated)); errors.Is(err, os.ErrNotExist) {
+			break
+		}
+
+		rotated = base + "_" + strconv.Itoa(i) + ext
+	}
+
+	if err := os.Rename(filepath.Join(w.wc.Out, name), filepath.Join(w.wc.Out, rotated)); err != nil {
+		return err
+	}
+
+	// the time index is renamed together with the file
+	if w.wc.Index && w.wc.Proto {
+		if err := os.Rename(filepath.Join(w.wc.Out, name+IndexExtension), filepath.Join(w.wc.Out, rotated+IndexExtension)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	w.name = rotated
+	w.size += size
+
+	if w.wc.RetentionSize > 0 {
+		enforceRetention(w.wc.Out, w.wc.RetentionSize)
+	}
+
+	return nil
+}
+
+// enforceRetention removes the oldest rotated files in the directory, until their total size is below the quota.
+func enforceRetention(dir string, quota int64) {
+	retentionMu.Lock()
+	defer retentionMu.Unlock()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		ioLog.Error("failed to read output directory for retention", zap.Error(err))
+
+		return
+	}
+
+	var (
+		files []os.FileInfo
+		total int64
+	)
+
+	for _, e := range entries {
+		if e.IsDir() || !rotatedFile.MatchString(e.Name()) {
+			continue
+		}
+
+		i, errInfo := e.Info()
+		if errInfo != nil {
+			continue
+		}
+
+		files = append(files, i)
+		total += i.Size()
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].ModTime().Equal(files[j].ModTime()) {
+			return files[i].Name() < files[j].Name()
+		}
+
+		return files[i].ModTime().Before(files[j].ModTime())
+	})
+
+	for _, f := range files {
+		if total <= quota {
+			return
+		}
+
+		if err = os.Remove(filepath.Join(dir, f.Name())); err != nil {
+			ioLog.Error("failed to remove file for retention", zap.Error(err), zap.String("name", f.Name()))
+
+			continue
+		}
+
+		// remove the time index of the file as well
+		if err = os.Remove(filepath.Join(dir, f.Name()+IndexExtension)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			ioLog.Error("failed to remove time index for retention", zap.Error(err), zap.String("name", f.Name()))
+		}
+
+		ioLog.Info("removed file for retention", zap.String("name", f.Name()), zap.Int64("size", f.Size()))
+
+		total -= f.Size()
+	}
+}
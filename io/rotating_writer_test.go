@@ -0,0 +1,284 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dreadl0ck/netcap/defaults"
+	"github.com/dreadl0ck/netcap/types"
+)
+
+// writeRotated writes DNS audit records with one second between their timestamps.
+func writeRotated(t *testing.T, wc *WriterConfig, numRecords int) {
+	t.Helper()
+
+	w := NewAuditRecordWriter(wc)
+	if err := w.WriteHeader(types.Type_NC_DNS); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < numRecords; i++ {
+		if err := w.Write(&types.DNS{Timestamp: time.Date(2020, 12, 28, 12, 0, i, 0, time.UTC).UnixNano(), ID: int32(i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	w.Close(int64(numRecords))
+}
+
+// rotatedFiles returns the names of the files in the directory.
+func rotatedFiles(t *testing.T, dir string) []string {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func TestRotatingWriterSize(t *testing.T) {
+	const rotateSize = 4096
+
+	out := t.TempDir()
+
+	// the compressed data is held back by the buffer and the compressor,
+	// the size limit applies to the audit records before compression
+	writeRotated(t, &WriterConfig{
+		Proto:                true,
+		Buffer:               true,
+		MemBufferSize:        defaults.BufferSize,
+		Compress:             true,
+		CompressionBlockSize: defaults.CompressionBlockSize,
+		CompressionLevel:     defaults.CompressionLevel,
+		Index:                true,
+		Name:                 "DNS",
+		Out:                  out,
+		RotateSize:           rotateSize,
+	}, 1000)
+
+	var names []string
+
+	for _, name := range rotatedFiles(t, out) {
+		if !strings.HasSuffix(name, IndexExtension) {
+			names = append(names, name)
+		}
+	}
+
+	if len(names) < 2 {
+		t.Fatal("expected the file to be rotated", names)
+	}
+
+	var next int32
+
+	for i, name := range names {
+		if !rotatedFile.MatchString(name) {
+			t.Error("unexpected file", name)
+		}
+
+		// the time index is renamed with the file
+		if blocks, err := ReadIndex(filepath.Join(out, name)); err != nil || len(blocks) != 1 {
+			t.Error("unexpected time index", name, blocks, err)
+		}
+
+		// each file is complete and starts with a header
+		r, err := Open(filepath.Join(out, name), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		h, err := r.ReadHeader()
+		if err != nil || h.Type != types.Type_NC_DNS {
+			t.Fatal("unexpected header", h, err)
+		}
+
+		for {
+			var d types.DNS
+
+			err = r.Next(&d)
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			if d.ID != next {
+				t.Fatal("unexpected record", d.ID, "expected", next)
+			}
+
+			next++
+		}
+
+		_ = r.Close()
+
+		// all but the last file are rotated by the first record that reaches the limit
+		size := uncompressedFileSize(t, filepath.Join(out, name))
+		if size >= rotateSize+64 || (i < len(names)-1 && size < rotateSize) {
+			t.Error("unexpected uncompressed size", name, size)
+		}
+	}
+
+	if next != 1000 {
+		t.Error("unexpected number of records", next)
+	}
+}
+
+// uncompressedFileSize returns the size of the gzip compressed file after decompression.
+func uncompressedFileSize(t *testing.T, path string) int64 {
+	t.Helper()
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := io.Copy(io.Discard, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return n
+}
+
+func TestRotatingWriterInterval(t *testing.T) {
+	out := t.TempDir()
+
+	wc := &WriterConfig{CSV: true, Name: "DNS", Out: out, RotateInterval: 50 * time.Millisecond}
+
+	w := NewAuditRecordWriter(wc)
+	if err := w.WriteHeader(types.Type_NC_DNS); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := w.Write(&types.DNS{Timestamp: time.Date(2020, 12, 28, 12, 0, 0, 0, time.UTC).UnixNano()}); err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(150 * time.Millisecond)
+
+	if err := w.Write(&types.DNS{Timestamp: time.Date(2020, 12, 28, 13, 0, 0, 0, time.UTC).UnixNano()}); err != nil {
+		t.Fatal(err)
+	}
+
+	name, size := w.Close(2)
+	if name != "DNS-20201228T130000Z-20201228T130000Z.csv" || size == 0 {
+		t.Error("unexpected name or size", name, size)
+	}
+
+	// the idle intervals do not create empty files
+	names := rotatedFiles(t, out)
+	if len(names) != 2 || names[0] != "DNS-20201228T120000Z-20201228T120000Z.csv" {
+		t.Fatal("unexpected files", names)
+	}
+
+	for _, n := range names {
+		data, err := os.ReadFile(filepath.Join(out, n))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if lines := strings.Split(strings.TrimSpace(string(data)), "\n"); len(lines) != 2 {
+			t.Errorf("expected a header and a record in %s, got %d lines", n, len(lines))
+		}
+	}
+}
+
+func TestRotatingWriterRetention(t *testing.T) {
+	out := t.TempDir()
+
+	// unrelated files in the output directory are kept
+	if err := os.WriteFile(filepath.Join(out, "DNS.log"), make([]byte, 4096), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	writeRotated(t, &WriterConfig{JSON: true, Name: "DNS", Out: out, RotateSize: 2048}, 640)
+
+	var (
+		rotated []string
+		sizes   = make(map[string]int64)
+		total   int64
+	)
+
+	for _, n := range rotatedFiles(t, out) {
+		if !rotatedFile.MatchString(n) {
+			continue
+		}
+
+		i, err := os.Stat(filepath.Join(out, n))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rotated = append(rotated, n)
+		sizes[n] = i.Size()
+		total += i.Size()
+	}
+
+	if len(rotated) < 10 || total == 0 {
+		t.Fatal("unexpected files", rotated)
+	}
+
+	out = t.TempDir()
+	if err := os.WriteFile(filepath.Join(out, "DNS.log"), make([]byte, 4096), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	writeRotated(t, &WriterConfig{JSON: true, Name: "DNS", Out: out, RotateSize: 2048, RetentionSize: total / 2}, 640)
+
+	// the newest files are kept
+	var (
+		names = rotatedFiles(t, out)
+		kept  = rotated[len(rotated)-len(names)+1:]
+		size  int64
+	)
+
+	if len(names) < 2 || names[len(names)-1] != "DNS.log" {
+		t.Fatal("unexpected files", names)
+	}
+
+	for i, n := range kept {
+		if names[i] != n {
+			t.Fatal("unexpected files", names, "expected", kept)
+		}
+
+		size += sizes[n]
+	}
+
+	if size > total/2 || size+sizes[rotated[len(rotated)-len(kept)-1]] <= total/2 {
+		t.Error("unexpected size of the kept files", size, "quota", total/2)
+	}
+}
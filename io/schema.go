@@ -0,0 +1,438 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/dreadl0ck/netcap/types"
+)
+
+/*
+ * Embedded Schema
+ * The header of proto audit record files contains the protobuf schema of the audit record type,
+ * as a serialized FileDescriptorProto with the message of the type and the messages and enums it depends on.
+ * It allows to check whether a file is compatible with the compiled types of this build,
+ * and to decode files written by any netcap version dynamically.
+ */
+
+var (
+	// errMissingSchema occurs when dynamic decoding is requested for a file without an embedded schema.
+	errMissingSchema = errors.New("audit record file has no embedded schema, it was written by a netcap version before schemas were added")
+
+	// errInvalidSchema occurs when the embedded schema cannot be parsed.
+	errInvalidSchema = errors.New("invalid embedded schema")
+
+	// errIncompatibleSchema occurs when a file cannot be decoded with the compiled types.
+	errIncompatibleSchema = errors.New("audit record schema of the file is incompatible with this version")
+)
+
+var (
+	// netcap.proto as compiled into this build, parsed once
+	compiledSchemaOnce sync.Once
+	compiledSchema     *descriptorpb.FileDescriptorProto
+	errCompiledSchema  error
+
+	// serialized schemas by audit record type
+	schemas sync.Map
+)
+
+// describable is implemented by generated protobuf messages, it returns the gzipped FileDescriptorProto of their file.
+type describable interface {
+	Descriptor() ([]byte, []int)
+}
+
+// loadCompiledSchema decodes the FileDescriptorProto of netcap.proto that is registered by the generated code.
+func loadCompiledSchema() (*descriptorpb.FileDescriptorProto, error) {
+	compiledSchemaOnce.Do(func() {
+		gz, _ := (&types.Header{}).Descriptor()
+
+		r, err := gzip.NewReader(bytes.NewReader(gz))
+		if err != nil {
+			errCompiledSchema = err
+
+			return
+		}
+
+		data, err := io.ReadAll(r)
+		if err != nil {
+			errCompiledSchema = err
+
+			return
+		}
+
+		compiledSchema = new(descriptorpb.FileDescriptorProto)
+		errCompiledSchema = proto.Unmarshal(data, compiledSchema)
+	})
+
+	return compiledSchema, errCompiledSchema
+}
+
+// Schema returns the serialized schema of the audit record type and the fully qualified name of its message.
+func Schema(t types.Type) (schema []byte, message string, err error) {
+	record := InitRecord(t)
+	if _, ok := record.(describable); !ok {
+		return nil, "", fmt.Errorf("no schema for audit record type %s", t)
+	}
+
+	message = gogoproto.MessageName(record)
+
+	if s, ok := schemas.Load(t); ok {
+		return s.([]byte), message, nil
+	}
+
+	file, err := loadCompiledSchema()
+	if err != nil {
+		return nil, "", err
+	}
+
+	schema, err = proto.MarshalOptions{Deterministic: true}.Marshal(pruneSchema(file, message))
+	if err != nil {
+		return nil, "", err
+	}
+
+	schemas.Store(t, schema)
+
+	return schema, message, nil
+}
+
+// AddSchema embeds the schema of the audit record type into the header.
+func AddSchema(h *types.Header) error {
+	schema, message, err := Schema(h.Type)
+	if err != nil {
+		return err
+	}
+
+	h.Schema = schema
+	h.SchemaMessage = message
+
+	return nil
+}
+
+// pruneSchema returns a copy of the file that only contains the message and the messages and enums it references.
+func pruneSchema(file *descriptorpb.FileDescriptorProto, message string) *descriptorpb.FileDescriptorProto {
+	var (
+		prefix   = "." + file.GetPackage() + "."
+		messages = make(map[string]*descriptorpb.DescriptorProto)
+		used     = make(map[string]bool)
+		queue    = []string{strings.TrimPrefix("."+message, prefix)}
+	)
+
+	for _, m := range file.MessageType {
+		messages[m.GetName()] = m
+	}
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		if used[name] {
+			continue
+		}
+
+		used[name] = true
+
+		m, ok := messages[name]
+		if !ok {
+			continue
+		}
+
+		for _, f := range m.Field {
+			if f.TypeName != nil {
+				queue = append(queue, strings.TrimPrefix(f.GetTypeName(), prefix))
+			}
+		}
+	}
+
+	pruned := &descriptorpb.FileDescriptorProto{
+		Name:    file.Name,
+		Package: file.Package,
+		Syntax:  file.Syntax,
+		Options: file.Options,
+	}
+
+	// the order of the file is kept
+	for _, m := range file.MessageType {
+		if used[m.GetName()] {
+			pruned.MessageType = append(pruned.MessageType, m)
+		}
+	}
+
+	for _, e := range file.EnumType {
+		if used[e.GetName()] {
+			pruned.EnumType = append(pruned.EnumType, e)
+		}
+	}
+
+	return pruned
+}
+
+// parseSchema parses the schema embedded in the header and returns the descriptor of the audit record message.
+func parseSchema(h *types.Header) (*descriptorpb.FileDescriptorProto, protoreflect.MessageDescriptor, error) {
+	if len(h.Schema) == 0 {
+		return nil, nil, errMissingSchema
+	}
+
+	file := new(descriptorpb.FileDescriptorProto)
+	if err := proto.Unmarshal(h.Schema, file); err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", errInvalidSchema, err)
+	}
+
+	fd, err := protodesc.NewFile(file, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", errInvalidSchema, err)
+	}
+
+	md := fd.Messages().ByName(protoreflect.FullName(h.SchemaMessage).Name())
+	if md == nil {
+		return nil, nil, fmt.Errorf("%w: message %s not found", errInvalidSchema, h.SchemaMessage)
+	}
+
+	return file, md, nil
+}
+
+// SchemaDiff contains the differences between the schema embedded in a file and the compiled types of this build.
+// Fields are described as Message.Field of the file, they are matched by their field numbers, so renamed fields are compatible.
+type SchemaDiff struct {
+	// Added fields are contained in the file but unknown to this build, they are skipped when decoding with the compiled types
+	Added []string
+
+	// Removed fields of this build are not contained in the file, they remain empty
+	Removed []string
+
+	// Changed fields use the same number with a different type or label, they are decoded incorrectly with the compiled types
+	Changed []string
+}
+
+// Equal returns whether the schemas are identical.
+func (d *SchemaDiff) Equal() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Compatible returns whether the file can be decoded with the compiled types,
+// added and removed fields are compatible.
+func (d *SchemaDiff) Compatible() bool {
+	return len(d.Changed) == 0
+}
+
+// String returns a summary of the differences.
+func (d *SchemaDiff) String() string {
+	var parts []string
+
+	for _, s := range []struct {
+		label  string
+		fields []string
+	}{
+		{"added", d.Added},
+		{"removed", d.Removed},
+		{"changed", d.Changed},
+	} {
+		if len(s.fields) > 0 {
+			parts = append(parts, s.label+": "+strings.Join(s.fields, ", "))
+		}
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+// CheckSchema compares the schema embedded in the header with the compiled types of this build.
+// Enums are not compared, unknown enum values are decoded as numbers.
+func CheckSchema(h *types.Header) (*SchemaDiff, error) {
+	embedded, _, err := parseSchema(h)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := loadCompiledSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		compiled = pruneSchema(file, h.SchemaMessage)
+		diff     = new(SchemaDiff)
+		old      = make(map[string]*descriptorpb.DescriptorProto)
+		current  = make(map[string]*descriptorpb.DescriptorProto)
+	)
+
+	for _, m := range embedded.MessageType {
+		old[m.GetName()] = m
+	}
+
+	for _, m := range compiled.MessageType {
+		current[m.GetName()] = m
+	}
+
+	for name, m := range old {
+		fields := make(map[int32]*descriptorpb.FieldDescriptorProto)
+		if c, ok := current[name]; ok {
+			for _, f := range c.Field {
+				fields[f.GetNumber()] = f
+			}
+		}
+
+		for _, f := range m.Field {
+			c, ok := fields[f.GetNumber()]
+
+			switch {
+			case !ok:
+				diff.Added = append(diff.Added, name+"."+f.GetName())
+			case c.GetType() != f.GetType() || c.GetTypeName() != f.GetTypeName() || c.GetLabel() != f.GetLabel():
+				diff.Changed = append(diff.Changed, name+"."+f.GetName())
+			}
+		}
+	}
+
+	for name, c := range current {
+		fields := make(map[int32]bool)
+		if m, ok := old[name]; ok {
+			for _, f := range m.Field {
+				fields[f.GetNumber()] = true
+			}
+		}
+
+		for _, f := range c.Field {
+			if !fields[f.GetNumber()] {
+				diff.Removed = append(diff.Removed, name+"."+f.GetName())
+			}
+		}
+	}
+
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Strings(diff.Changed)
+
+	return diff, nil
+}
+
+// DynamicRecord is an audit record that is decoded with the schema embedded in the file header,
+// instead of the compiled types. It can be passed to Reader.Next to read files written by any netcap version.
+type DynamicRecord struct {
+	*dynamicpb.Message
+
+	// field holding the timestamp of the audit record, nil if the schema has none
+	timestamp protoreflect.FieldDescriptor
+}
+
+// timestampFields are the names of the fields holding the timestamp of an audit record, in order of preference.
+// Audit records for flows use the timestamp of their first packet, like the Time methods of the compiled types.
+var timestampFields = []protoreflect.Name{"Timestamp", "TimestampFirst"}
+
+// NewDynamicRecord creates an empty audit record for the schema embedded in the header.
+func NewDynamicRecord(h *types.Header) (*DynamicRecord, error) {
+	_, md, err := parseSchema(h)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &DynamicRecord{Message: dynamicpb.NewMessage(md)}
+
+	for _, name := range timestampFields {
+		if f := md.Fields().ByName(name); f != nil && f.Kind() == protoreflect.Int64Kind {
+			r.timestamp = f
+
+			break
+		}
+	}
+
+	return r, nil
+}
+
+// Unmarshal decodes the audit record, it is called by the delimited reader.
+func (r *DynamicRecord) Unmarshal(data []byte) error {
+	return proto.Unmarshal(data, r.Message)
+}
+
+// Time returns the value of the Timestamp or TimestampFirst field, or zero if the message has neither.
+func (r *DynamicRecord) Time() int64 {
+	if r.timestamp == nil {
+		return 0
+	}
+
+	return r.Get(r.timestamp).Int()
+}
+
+// HasTime returns whether the schema of the audit record has a timestamp field.
+func (r *DynamicRecord) HasTime() bool {
+	return r.timestamp != nil
+}
+
+// JSON returns the audit record as JSON, with the field names of the schema.
+func (r *DynamicRecord) JSON() ([]byte, error) {
+	return protojson.MarshalOptions{UseProtoNames: true}.Marshal(r.Message)
+}
+
+// Text returns the audit record in the protobuf text format.
+func (r *DynamicRecord) Text() string {
+	return prototext.MarshalOptions{Multiline: true}.Format(r.Message)
+}
+
+// dumpDynamic prints the audit records decoded with the schema embedded in the header,
+// as JSON lines if configured, otherwise in the protobuf text format.
+func dumpDynamic(w io.Writer, r *Reader, h *types.Header, c DumpConfig) error {
+	record, err := NewDynamicRecord(h)
+	if err != nil {
+		return err
+	}
+
+	count := 0
+
+	for {
+		err = r.Next(record)
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("failed to read next audit record: %w", err)
+		}
+
+		count++
+
+		if c.JSON {
+			data, errJSON := record.JSON()
+			if errJSON != nil {
+				return fmt.Errorf("failed to marshal json: %w", errJSON)
+			}
+
+			_, _ = w.Write(data)
+			_, _ = io.WriteString(w, newline)
+
+			continue
+		}
+
+		_, _ = io.WriteString(w, h.SchemaMessage+newline+record.Text()+newline)
+	}
+
+	if !c.JSON {
+		_, _ = io.WriteString(w, strconv.Itoa(count)+" records.\n")
+	}
+
+	return nil
+}
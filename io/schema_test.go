@@ -0,0 +1,308 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/dreadl0ck/netcap/defaults"
+	"github.com/dreadl0ck/netcap/delimited"
+	"github.com/dreadl0ck/netcap/types"
+)
+
+// readHeader returns the header of the audit record file.
+func readHeader(t *testing.T, file string) *types.Header {
+	t.Helper()
+
+	r, err := Open(file, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer r.Close()
+
+	h, err := r.ReadHeader()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return h
+}
+
+// modifySchema returns a copy of the header with a modified schema.
+func modifySchema(t *testing.T, h *types.Header, modify func(dns *descriptorpb.DescriptorProto)) *types.Header {
+	t.Helper()
+
+	file := new(descriptorpb.FileDescriptorProto)
+	if err := proto.Unmarshal(h.Schema, file); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, m := range file.MessageType {
+		if m.GetName() == "DNS" {
+			modify(m)
+		}
+	}
+
+	schema, err := proto.Marshal(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &types.Header{Type: h.Type, Schema: schema, SchemaMessage: h.SchemaMessage}
+}
+
+// field returns the field of the message with the name.
+func field(m *descriptorpb.DescriptorProto, name string) *descriptorpb.FieldDescriptorProto {
+	for _, f := range m.Field {
+		if f.GetName() == name {
+			return f
+		}
+	}
+
+	return nil
+}
+
+func TestSchemaEmbedded(t *testing.T) {
+	file := writeIndexed(t, true, CompressionGzip)
+
+	h := readHeader(t, file)
+	if h.SchemaMessage != "types.DNS" || len(h.Schema) == 0 {
+		t.Fatal("missing schema", h.SchemaMessage, len(h.Schema))
+	}
+
+	diff, err := CheckSchema(h)
+	if err != nil || !diff.Equal() {
+		t.Fatal("unexpected schema difference", diff, err)
+	}
+
+	// the schema only contains the record and its dependencies
+	s := new(descriptorpb.FileDescriptorProto)
+	if err = proto.Unmarshal(h.Schema, s); err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, m := range s.MessageType {
+		names = append(names, m.GetName())
+	}
+
+	if strings.Join(names, ",") != "Tunnel,DNS,DNSResourceRecord,DNSSOA,DNSSRV,DNSMX,DNSQuestion" {
+		t.Error("unexpected messages in schema", names)
+	}
+
+	// the audit records can be decoded dynamically, the time range uses the Timestamp field
+	r, err := Open(file, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer r.Close()
+
+	if _, err = r.ReadHeader(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = r.SetTimeRange(indexStart.Add(90*time.Second), time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+
+	record, err := NewDynamicRecord(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var count int
+
+	for {
+		err = r.Next(record)
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+
+		if count == 0 {
+			if data, errJSON := record.JSON(); errJSON != nil || !strings.Contains(string(data), `"ID":90`) {
+				t.Error("unexpected record", string(data), errJSON)
+			}
+		}
+
+		count++
+	}
+
+	if count != 10 {
+		t.Error("unexpected number of records", count)
+	}
+}
+
+func TestDynamicRecordTime(t *testing.T) {
+	var (
+		file  = writeIndexed(t, true, CompressionGzip)
+		h     = readHeader(t, file)
+		start = indexStart.Add(95 * time.Second)
+	)
+
+	// readDynamic returns the number of audit records in the time range, decoded with the schema
+	readDynamic := func(schema *types.Header) int {
+		r, err := Open(file, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		defer r.Close()
+
+		if _, err = r.ReadHeader(); err != nil {
+			t.Fatal(err)
+		}
+
+		if err = r.SetTimeRange(start, time.Time{}); err != nil {
+			t.Fatal(err)
+		}
+
+		record, err := NewDynamicRecord(schema)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var count int
+
+		for {
+			err = r.Next(record)
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			count++
+		}
+
+		return count
+	}
+
+	// audit records for flows only have a timestamp for their first packet
+	if n := readDynamic(modifySchema(t, h, func(dns *descriptorpb.DescriptorProto) {
+		field(dns, "Timestamp").Name = proto.String("TimestampFirst")
+	})); n != 5 {
+		t.Error("unexpected number of records filtered by the TimestampFirst field", n)
+	}
+
+	// audit records without a timestamp are not filtered, only the block of the time index is read
+	if n := readDynamic(modifySchema(t, h, func(dns *descriptorpb.DescriptorProto) {
+		field(dns, "Timestamp").Name = proto.String("Time")
+	})); n != 10 {
+		t.Error("unexpected number of records without a timestamp field", n)
+	}
+}
+
+func TestCheckSchema(t *testing.T) {
+	h := readHeader(t, writeIndexed(t, false, CompressionGzip))
+
+	// a newer version added a field
+	diff, err := CheckSchema(modifySchema(t, h, func(dns *descriptorpb.DescriptorProto) {
+		dns.Field = append(dns.Field, &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String("Future"),
+			Number: proto.Int32(1000),
+			Type:   descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		})
+
+		field(dns, "ID").Name = proto.String("Identifier")
+	}))
+	if err != nil || !diff.Compatible() || diff.Equal() || strings.Join(diff.Added, ",") != "DNS.Future" || len(diff.Changed) != 0 {
+		t.Error("unexpected difference for added and renamed fields", diff, err)
+	}
+
+	// an older version did not contain a field
+	diff, err = CheckSchema(modifySchema(t, h, func(dns *descriptorpb.DescriptorProto) {
+		dns.Field = dns.Field[1:]
+	}))
+	if err != nil || !diff.Compatible() || strings.Join(diff.Removed, ",") != "DNS.Timestamp" {
+		t.Error("unexpected difference for removed field", diff, err)
+	}
+
+	// the type of a field has changed
+	diff, err = CheckSchema(modifySchema(t, h, func(dns *descriptorpb.DescriptorProto) {
+		field(dns, "ID").Type = descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()
+	}))
+	if err != nil || diff.Compatible() || strings.Join(diff.Changed, ",") != "DNS.ID" {
+		t.Error("unexpected difference for changed field", diff, err)
+	}
+
+	if _, err = CheckSchema(&types.Header{Type: types.Type_NC_DNS}); !errors.Is(err, errMissingSchema) {
+		t.Error("expected an error for a missing schema", err)
+	}
+}
+
+func TestDumpSchema(t *testing.T) {
+	h := readHeader(t, writeIndexed(t, false, CompressionGzip))
+
+	// a file written by a version where the ID field had a different name and type
+	file := filepath.Join(t.TempDir(), "DNS"+defaults.FileExtension)
+
+	f, err := os.Create(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := delimited.NewWriter(f)
+
+	if err = w.PutProto(modifySchema(t, h, func(dns *descriptorpb.DescriptorProto) {
+		id := field(dns, "ID")
+		id.Name = proto.String("Identifier")
+		id.Type = descriptorpb.FieldDescriptorProto_TYPE_UINT32.Enum()
+	})); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = w.PutProto(&types.DNS{Timestamp: indexStart.UnixNano(), ID: 7}); err != nil {
+		t.Fatal(err)
+	}
+
+	_ = f.Close()
+
+	out, err := os.Create(filepath.Join(t.TempDir(), "out"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer out.Close()
+
+	if err = Dump(out, DumpConfig{Path: file, Structured: true}); !errors.Is(err, errIncompatibleSchema) {
+		t.Fatal("expected an error for an incompatible schema", err)
+	}
+
+	if err = Dump(out, DumpConfig{Path: file, JSON: true, Dynamic: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(out.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(data), `"Identifier":7`) {
+		t.Error("unexpected output", string(data))
+	}
+}
@@ -0,0 +1,434 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// columns that are added to each table, prefixed to avoid clashes with the fields of the audit records.
+const (
+	sqliteColumnID     = "_id"
+	sqliteColumnParent = "_parent"
+	sqliteColumnIndex  = "_index"
+	sqliteColumnKey    = "_key"
+	sqliteColumnValue  = "_value"
+)
+
+// sqliteTableKind describes how the rows of a table are derived from the audit records.
+type sqliteTableKind int
+
+const (
+	// one row per audit record.
+	sqliteTableRecord sqliteTableKind = iota
+
+	// one row per element of a repeated scalar field.
+	sqliteTableRepeatedScalar
+
+	// one row per element of a repeated message field.
+	sqliteTableRepeatedMessage
+
+	// one row per entry of a map with scalar values.
+	sqliteTableMapScalar
+
+	// one row per entry of a map with message values.
+	sqliteTableMapMessage
+)
+
+// sqliteColumn is a column for a scalar field,
+// singular nested messages are flattened into the table of their parent, e.g. Tunnel_VNI.
+type sqliteColumn struct {
+	name  string
+	typ   string
+	index []int
+
+	// name of the field in the message, used to select the indexed columns
+	field string
+}
+
+// sqliteTable is the table of an audit record type, or a child table for a repeated field or map.
+// Rows of child tables reference the row of their parent in the _parent column.
+type sqliteTable struct {
+	name    string
+	kind    sqliteTableKind
+	columns []sqliteColumn
+
+	// type of the values for scalar child tables
+	valueType string
+
+	// field of the child table in the struct of the parent table
+	index []int
+
+	parent   *sqliteTable
+	children []*sqliteTable
+
+	// id of the last inserted row
+	lastID int64
+}
+
+// newSQLiteTable derives the table and the child tables from a pointer to a generated protocol buffer struct.
+// Fields starting with XXX_ are ignored, as well as recursive messages.
+func newSQLiteTable(name string, msg interface{}) *sqliteTable {
+	t := reflect.TypeOf(msg).Elem()
+
+	table := &sqliteTable{
+		name: name,
+		kind: sqliteTableRecord,
+	}
+
+	table.addFields(t, "", nil, map[reflect.Type]bool{t: true})
+
+	return table
+}
+
+// addFields adds the fields of a struct to the table, prefix and index describe the path to a flattened nested message.
+func (t *sqliteTable) addFields(st reflect.Type, prefix string, index []int, seen map[reflect.Type]bool) {
+	for i := 0; i < st.NumField(); i++ {
+		f := st.Field(i)
+		if f.PkgPath != "" || strings.HasPrefix(f.Name, "XXX_") {
+			continue
+		}
+
+		var (
+			name = prefix + f.Name
+			path = append(append([]int{}, index...), i)
+			ft   = f.Type
+		)
+
+		switch {
+		case isSQLiteScalar(ft):
+			t.columns = append(t.columns, sqliteColumn{name: name, typ: sqliteType(ft), index: path, field: f.Name})
+		case ft.Kind() == reflect.Ptr && ft.Elem().Kind() == reflect.Struct:
+			if seen[ft.Elem()] {
+				continue
+			}
+
+			seen[ft.Elem()] = true
+			t.addFields(ft.Elem(), name+"_", path, seen)
+			delete(seen, ft.Elem())
+		case ft.Kind() == reflect.Slice && isSQLiteScalar(ft.Elem()):
+			t.addChild(name, sqliteTableRepeatedScalar, ft.Elem(), path, seen)
+		case ft.Kind() == reflect.Slice && isMessagePointer(ft.Elem()):
+			t.addChild(name, sqliteTableRepeatedMessage, ft.Elem(), path, seen)
+		case ft.Kind() == reflect.Map && isSQLiteScalar(ft.Key()) && isSQLiteScalar(ft.Elem()):
+			t.addChild(name, sqliteTableMapScalar, ft.Elem(), path, seen)
+		case ft.Kind() == reflect.Map && isSQLiteScalar(ft.Key()) && isMessagePointer(ft.Elem()):
+			t.addChild(name, sqliteTableMapMessage, ft.Elem(), path, seen)
+		}
+	}
+}
+
+// addChild adds the child table for a repeated field or a map, elem is the type of the elements or map values.
+func (t *sqliteTable) addChild(name string, kind sqliteTableKind, elem reflect.Type, index []int, seen map[reflect.Type]bool) {
+	child := &sqliteTable{
+		name:   t.name + "_" + name,
+		kind:   kind,
+		index:  index,
+		parent: t,
+	}
+
+	switch kind {
+	case sqliteTableRepeatedScalar, sqliteTableMapScalar:
+		child.valueType = sqliteType(elem)
+	default:
+		if seen[elem.Elem()] {
+			return
+		}
+
+		seen[elem.Elem()] = true
+		child.addFields(elem.Elem(), "", nil, seen)
+		delete(seen, elem.Elem())
+	}
+
+	t.children = append(t.children, child)
+}
+
+func isMessagePointer(t reflect.Type) bool {
+	return t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct
+}
+
+func isSQLiteScalar(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool, reflect.String, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	case reflect.Slice:
+		return t.Elem().Kind() == reflect.Uint8
+	default:
+		return false
+	}
+}
+
+// sqliteType returns the column type for a scalar go type.
+func sqliteType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "TEXT"
+	case reflect.Float32, reflect.Float64:
+		return "REAL"
+	case reflect.Slice:
+		return "BLOB"
+	default:
+		return "INTEGER"
+	}
+}
+
+// sqliteValue converts a scalar value into the value passed to the database driver.
+func sqliteValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return int64(1)
+		}
+
+		return int64(0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		// values above the int64 range wrap around, sqlite integers are signed
+		return int64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.String:
+		return v.String()
+	case reflect.Slice:
+		return v.Bytes()
+	default:
+		return nil
+	}
+}
+
+// fieldByIndex returns the field at the index path, the bool is false if a nested message on the path is nil.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, idx := range index {
+		if i > 0 {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+
+			v = v.Elem()
+		}
+
+		v = v.Field(idx)
+	}
+
+	return v, true
+}
+
+// create returns the statements to create the table, its child tables and their indices.
+func (t *sqliteTable) create() []string {
+	var (
+		defs    = []string{quote(sqliteColumnID) + " INTEGER PRIMARY KEY"}
+		indexed []string
+	)
+
+	if t.parent != nil {
+		defs = append(defs, quote(sqliteColumnParent)+" INTEGER NOT NULL REFERENCES "+quote(t.parent.name)+"("+quote(sqliteColumnID)+")")
+		indexed = append(indexed, sqliteColumnParent)
+
+		switch t.kind {
+		case sqliteTableRepeatedScalar, sqliteTableRepeatedMessage:
+			defs = append(defs, quote(sqliteColumnIndex)+" INTEGER")
+		case sqliteTableMapScalar, sqliteTableMapMessage:
+			defs = append(defs, quote(sqliteColumnKey)+" TEXT")
+		}
+	}
+
+	if t.valueType != "" {
+		defs = append(defs, quote(sqliteColumnValue)+" "+t.valueType)
+	}
+
+	for _, c := range t.columns {
+		defs = append(defs, quote(c.name)+" "+c.typ)
+
+		if isSQLiteIndexed(c) {
+			indexed = append(indexed, c.name)
+		}
+	}
+
+	stmts := []string{"CREATE TABLE IF NOT EXISTS " + quote(t.name) + " (" + strings.Join(defs, ", ") + ")"}
+
+	for _, c := range indexed {
+		stmts = append(stmts, "CREATE INDEX IF NOT EXISTS "+quote("idx_"+t.name+"_"+c)+" ON "+quote(t.name)+" ("+quote(c)+")")
+	}
+
+	for _, child := range t.children {
+		stmts = append(stmts, child.create()...)
+	}
+
+	return stmts
+}
+
+// isSQLiteIndexed returns whether the column holds a timestamp, an IP address or a flow identifier.
+func isSQLiteIndexed(c sqliteColumn) bool {
+	switch c.field {
+	case "Timestamp", "TimestampFirst", "TimestampLast", "Flow", "CommunityID":
+		return true
+	}
+
+	return c.typ == "TEXT" && strings.HasSuffix(c.field, "IP")
+}
+
+// insertStatement returns the statement to insert a row.
+func (t *sqliteTable) insertStatement() string {
+	columns := []string{quote(sqliteColumnID)}
+
+	if t.parent != nil {
+		columns = append(columns, quote(sqliteColumnParent))
+
+		switch t.kind {
+		case sqliteTableRepeatedScalar, sqliteTableRepeatedMessage:
+			columns = append(columns, quote(sqliteColumnIndex))
+		case sqliteTableMapScalar, sqliteTableMapMessage:
+			columns = append(columns, quote(sqliteColumnKey))
+		}
+	}
+
+	if t.valueType != "" {
+		columns = append(columns, quote(sqliteColumnValue))
+	}
+
+	for _, c := range t.columns {
+		columns = append(columns, quote(c.name))
+	}
+
+	return "INSERT INTO " + quote(t.name) + " (" + strings.Join(columns, ", ") + ") VALUES (" +
+		strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ") + ")"
+}
+
+// tables returns the table and all child tables.
+func (t *sqliteTable) tables() []*sqliteTable {
+	tables := []*sqliteTable{t}
+	for _, child := range t.children {
+		tables = append(tables, child.tables()...)
+	}
+
+	return tables
+}
+
+// sqliteStatements returns the prepared insert statement of a table.
+type sqliteStatements func(t *sqliteTable) (*sql.Stmt, error)
+
+// insert inserts a row for v and the rows of the child tables,
+// v is a struct for record and message tables and a scalar for scalar tables.
+// The key is the index or map key of the row in a child table.
+func (t *sqliteTable) insert(stmts sqliteStatements, v reflect.Value, parentID int64, key interface{}) error {
+	t.lastID++
+
+	args := []interface{}{t.lastID}
+
+	if t.parent != nil {
+		args = append(args, parentID, key)
+	}
+
+	if t.valueType != "" {
+		args = append(args, sqliteValue(v))
+	}
+
+	for _, c := range t.columns {
+		f, ok := fieldByIndex(v, c.index)
+		if !ok {
+			args = append(args, nil)
+
+			continue
+		}
+
+		args = append(args, sqliteValue(f))
+	}
+
+	stmt, err := stmts(t)
+	if err != nil {
+		return err
+	}
+
+	if _, err = stmt.Exec(args...); err != nil {
+		return fmt.Errorf("failed to insert into %s: %w", t.name, err)
+	}
+
+	if t.valueType != "" {
+		return nil
+	}
+
+	id := t.lastID
+
+	for _, child := range t.children {
+		if err = child.insertChildren(stmts, v, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertChildren inserts the rows for the elements of the repeated field or map in the parent struct.
+func (t *sqliteTable) insertChildren(stmts sqliteStatements, parent reflect.Value, parentID int64) error {
+	f, ok := fieldByIndex(parent, t.index)
+	if !ok {
+		return nil
+	}
+
+	elem := func(v reflect.Value) (reflect.Value, bool) {
+		if v.Kind() != reflect.Ptr {
+			return v, true
+		}
+
+		if v.IsNil() {
+			return v, false
+		}
+
+		return v.Elem(), true
+	}
+
+	switch t.kind {
+	case sqliteTableRepeatedScalar, sqliteTableRepeatedMessage:
+		for i := 0; i < f.Len(); i++ {
+			v, ok := elem(f.Index(i))
+			if !ok {
+				continue
+			}
+
+			if err := t.insert(stmts, v, parentID, int64(i)); err != nil {
+				return err
+			}
+		}
+	case sqliteTableMapScalar, sqliteTableMapMessage:
+		keys := f.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+
+		for _, k := range keys {
+			v, ok := elem(f.MapIndex(k))
+			if !ok {
+				continue
+			}
+
+			if err := t.insert(stmts, v, parentID, fmt.Sprint(k.Interface())); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// quote quotes an identifier.
+func quote(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
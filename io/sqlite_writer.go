@@ -0,0 +1,364 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+
+	// pure go sqlite driver, so that static builds do not need cgo for the database.
+	_ "modernc.org/sqlite"
+
+	"github.com/dreadl0ck/netcap/defaults"
+	"github.com/dreadl0ck/netcap/types"
+)
+
+// name of the sqlite database in the output directory.
+const sqliteFileName = "netcap.sqlite"
+
+// sqliteDatabases contains the databases that are currently open, by path.
+// All audit record types are written into a single database per output directory.
+var sqliteDatabases = struct {
+	sync.Mutex
+	m map[string]*sqliteDB
+}{
+	m: make(map[string]*sqliteDB),
+}
+
+// sqliteDB is the database that is shared by the writers of all audit record types.
+// Records are inserted in transactions of batchSize records.
+type sqliteDB struct {
+	sync.Mutex
+
+	path string
+	refs int
+	db   *sql.DB
+
+	// current transaction and its prepared statements
+	tx    *sql.Tx
+	stmts map[*sqliteTable]*sql.Stmt
+
+	// tables of the audit record types, by type
+	tables map[types.Type]*sqliteTable
+
+	batchSize  int
+	numPending int
+	numRecords int64
+
+	// set if the database has been created by the writer, it is removed again if no records have been written
+	created bool
+}
+
+// sqliteWriter is a structure that supports writing audit records into a sqlite database.
+type sqliteWriter struct {
+	mu  sync.Mutex
+	wc  *WriterConfig
+	typ types.Type
+	db  *sqliteDB
+}
+
+// newSQLiteWriter initializes and configures a new sqliteWriter instance.
+func newSQLiteWriter(wc *WriterConfig) *sqliteWriter {
+	w := &sqliteWriter{
+		wc:  wc,
+		typ: wc.Type,
+	}
+
+	// the database is opened by all writers, the tables are only created for types that have audit records
+	db, err := openSQLiteDB(filepath.Join(wc.Out, sqliteFileName), wc.SQLiteBatchSize, true)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	w.db = db
+
+	return w
+}
+
+// Write inserts the audit record.
+func (w *sqliteWriter) Write(msg proto.Message) error {
+	w.mu.Lock()
+	typ := w.typ
+	w.mu.Unlock()
+
+	return w.db.insert(typ, msg)
+}
+
+// WriteHeader sets the audit record type, the table is created for the first audit record.
+func (w *sqliteWriter) WriteHeader(t types.Type) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.typ = t
+
+	return nil
+}
+
+// Close releases the database, the last transaction is committed once all writers have been closed.
+func (w *sqliteWriter) Close(_ int64) (name string, size int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.db.release()
+}
+
+// openSQLiteDB returns the database at path and creates it if necessary.
+// If truncate is set, an existing database file is replaced when it is opened for the first time.
+func openSQLiteDB(path string, batchSize int, truncate bool) (*sqliteDB, error) {
+	sqliteDatabases.Lock()
+	defer sqliteDatabases.Unlock()
+
+	if d, ok := sqliteDatabases.m[path]; ok {
+		d.Lock()
+		d.refs++
+		d.Unlock()
+
+		return d, nil
+	}
+
+	if truncate {
+		for _, suffix := range []string{"", "-wal", "-shm"} {
+			if err := os.Remove(path + suffix); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return nil, err
+			}
+		}
+	}
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+
+	// a single connection, the records of all writers are inserted in the same transaction
+	db.SetMaxOpenConns(1)
+
+	for _, pragma := range []string{
+		"PRAGMA journal_mode = WAL",
+		"PRAGMA synchronous = NORMAL",
+		"PRAGMA foreign_keys = OFF",
+	} {
+		if _, err = db.Exec(pragma); err != nil {
+			_ = db.Close()
+
+			return nil, fmt.Errorf("failed to configure sqlite database: %w", err)
+		}
+	}
+
+	if batchSize <= 0 {
+		batchSize = defaults.SQLiteBatchSize
+	}
+
+	d := &sqliteDB{
+		path:      path,
+		refs:      1,
+		db:        db,
+		stmts:     make(map[*sqliteTable]*sql.Stmt),
+		tables:    make(map[types.Type]*sqliteTable),
+		batchSize: batchSize,
+		created:   truncate,
+	}
+
+	ioLog.Info("create sqliteWriter", zap.String("path", path))
+
+	sqliteDatabases.m[path] = d
+
+	return d, nil
+}
+
+// insert inserts an audit record, the table for the type is created for the first record.
+func (d *sqliteDB) insert(t types.Type, msg proto.Message) error {
+	d.Lock()
+	defer d.Unlock()
+
+	table, ok := d.tables[t]
+	if !ok {
+		var err error
+
+		table, err = d.createTable(t, msg)
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.tx == nil {
+		tx, err := d.db.Begin()
+		if err != nil {
+			return err
+		}
+
+		d.tx = tx
+	}
+
+	if err := table.insert(d.statement, reflect.ValueOf(msg).Elem(), 0, nil); err != nil {
+		return err
+	}
+
+	d.numRecords++
+	d.numPending++
+
+	if d.numPending >= d.batchSize {
+		return d.commit()
+	}
+
+	return nil
+}
+
+// createTable creates the tables for the audit record type,
+// the ids continue after the existing rows, if the tables exist already.
+func (d *sqliteDB) createTable(t types.Type, msg proto.Message) (*sqliteTable, error) {
+	table := newSQLiteTable(strings.TrimPrefix(t.String(), "NC_"), msg)
+
+	// the schema is changed outside of the batch transaction
+	if err := d.commit(); err != nil {
+		return nil, err
+	}
+
+	for _, stmt := range table.create() {
+		if _, err := d.db.Exec(stmt); err != nil {
+			return nil, fmt.Errorf("failed to create table %s: %w", table.name, err)
+		}
+	}
+
+	for _, tbl := range table.tables() {
+		err := d.db.QueryRow("SELECT COALESCE(MAX(" + quote(sqliteColumnID) + "), 0) FROM " + quote(tbl.name)).Scan(&tbl.lastID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	d.tables[t] = table
+
+	return table, nil
+}
+
+// statement returns the prepared insert statement of the table for the current transaction.
+func (d *sqliteDB) statement(t *sqliteTable) (*sql.Stmt, error) {
+	if stmt, ok := d.stmts[t]; ok {
+		return stmt, nil
+	}
+
+	stmt, err := d.tx.Prepare(t.insertStatement())
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare insert into %s: %w", t.name, err)
+	}
+
+	d.stmts[t] = stmt
+
+	return stmt, nil
+}
+
+// commit commits the current transaction, the statements are prepared again for the next one.
+func (d *sqliteDB) commit() error {
+	if d.tx == nil {
+		return nil
+	}
+
+	for t, stmt := range d.stmts {
+		_ = stmt.Close()
+
+		delete(d.stmts, t)
+	}
+
+	err := d.tx.Commit()
+	d.tx = nil
+	d.numPending = 0
+
+	return err
+}
+
+// release decrements the reference count of the database, and closes it after the last writer is done.
+func (d *sqliteDB) release() (name string, size int64) {
+	sqliteDatabases.Lock()
+	defer sqliteDatabases.Unlock()
+
+	d.Lock()
+	defer d.Unlock()
+
+	d.refs--
+	if d.refs > 0 {
+		return sqliteFileName, 0
+	}
+
+	delete(sqliteDatabases.m, d.path)
+
+	if err := d.commit(); err != nil {
+		fmt.Println("failed to commit sqlite transaction:", err, "path", d.path)
+	}
+
+	if err := d.db.Close(); err != nil {
+		fmt.Println("failed to close sqlite database:", err, "path", d.path)
+	}
+
+	// remove the database if no audit records have been written
+	if d.created && d.numRecords == 0 {
+		if err := os.Remove(d.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			fmt.Println("failed to remove empty sqlite database:", err)
+		}
+
+		return filepath.Base(d.path), 0
+	}
+
+	if i, err := os.Stat(d.path); err == nil {
+		size = i.Size()
+	}
+
+	return filepath.Base(d.path), size
+}
+
+// dumpSQLite inserts the audit records from the reader into the sqlite database configured in c,
+// the tables are appended to if they exist, so that several audit record files can be added to one database.
+func dumpSQLite(w io.Writer, r *Reader, header *types.Header, record proto.Message, c DumpConfig) error {
+	d, err := openSQLiteDB(c.SQLite, c.SQLiteBatchSize, false)
+	if err != nil {
+		return err
+	}
+
+	var count int
+
+	for {
+		err = r.Next(record)
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		} else if err != nil {
+			d.release()
+
+			return fmt.Errorf("failed to read next audit record: %w", err)
+		}
+
+		if err = d.insert(header.Type, record); err != nil {
+			d.release()
+
+			return err
+		}
+
+		count++
+	}
+
+	d.release()
+
+	_, err = fmt.Fprintln(w, "inserted", count, strings.TrimPrefix(header.Type.String(), "NC_"), "audit records into", c.SQLite)
+
+	return err
+}
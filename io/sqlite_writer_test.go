@@ -0,0 +1,113 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/dreadl0ck/netcap/types"
+)
+
+func TestSQLiteWriter(t *testing.T) {
+	out := t.TempDir()
+
+	writeTestRecords(t, WriterConfig{SQLite: true, SQLiteBatchSize: 2, Out: out}, append([]proto.Message{
+		&types.HTTP{
+			Timestamp:  1505838533449164000,
+			Method:     "POST",
+			SrcIP:      "192.168.1.47",
+			DstIP:      "165.227.109.154",
+			ReqCookies: []*types.HTTPCookie{{Name: "session", Value: "1234"}, {Name: "lang", Value: "en"}},
+			Parameters: map[string]string{"b": "2", "a": "1"},
+		},
+	}, zeekRecords...))
+
+	db, err := sql.Open("sqlite", filepath.Join(out, sqliteFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	count := func(query string, args ...interface{}) (n int) {
+		t.Helper()
+
+		if err = db.QueryRow(query, args...).Scan(&n); err != nil {
+			t.Fatal(err)
+		}
+
+		return n
+	}
+
+	// one row per audit record, in tables named after the type
+	for table, expected := range map[string]int{
+		"Connection":      1,
+		"DNS":             2,
+		"HTTP":            2,
+		"DNS_Answers":     2,
+		"DNS_Questions":   2,
+		"HTTP_ReqCookies": 2,
+		"HTTP_Parameters": 2,
+	} {
+		if n := count(`SELECT COUNT(*) FROM "` + table + `"`); n != expected {
+			t.Errorf("expected %d rows in %s, got %d", expected, table, n)
+		}
+	}
+
+	// the answers reference the response
+	if n := count(`SELECT COUNT(*) FROM "DNS_Answers" a JOIN "DNS" d ON a."_parent" = d."_id" WHERE d."QR" = 1 AND a."IP" LIKE '93.184.216.%'`); n != 2 {
+		t.Error("unexpected answers of the dns response", n)
+	}
+
+	var cookie, param string
+
+	err = db.QueryRow(`SELECT c."Value" FROM "HTTP_ReqCookies" c JOIN "HTTP" h ON c."_parent" = h."_id" WHERE h."Method" = 'POST' AND c."_index" = 1`).Scan(&cookie)
+	if err != nil || cookie != "en" {
+		t.Error("unexpected cookie", cookie, err)
+	}
+
+	err = db.QueryRow(`SELECT "_value" FROM "HTTP_Parameters" WHERE "_key" = 'a'`).Scan(&param)
+	if err != nil || param != "1" {
+		t.Error("unexpected parameter", param, err)
+	}
+
+	// timestamps, addresses, flow identifiers and parent references are indexed
+	for _, index := range []string{"idx_DNS_Timestamp", "idx_DNS_SrcIP", "idx_Connection_CommunityID", "idx_DNS_Answers__parent", "idx_DNS_Answers_IP"} {
+		if count(`SELECT COUNT(*) FROM sqlite_master WHERE type = 'index' AND name = ?`, index) != 1 {
+			t.Error("missing index", index)
+		}
+	}
+}
+
+func TestSQLiteWriterEmpty(t *testing.T) {
+	out := t.TempDir()
+
+	w := NewAuditRecordWriter(&WriterConfig{SQLite: true, Out: out})
+	if err := w.WriteHeader(types.Type_NC_DNS); err != nil {
+		t.Fatal(err)
+	}
+
+	// the database is removed if no audit records have been written
+	if name, size := w.Close(0); name != sqliteFileName || size != 0 {
+		t.Error("unexpected database", name, size)
+	}
+
+	if matches, _ := filepath.Glob(filepath.Join(out, sqliteFileName+"*")); len(matches) != 0 {
+		t.Error("unexpected files", matches)
+	}
+}
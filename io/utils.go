@@ -104,6 +104,39 @@ type DumpConfig struct {
 	Structured    bool
 	CSV           bool
 	ForceColors   bool
+
+	// convert the audit records into an apache parquet file
+	Parquet             bool
+	ParquetRowGroupSize int
+	ParquetCompression  string
+
+	// convert the audit records into a zeek log
+	Zeek     bool
+	ZeekJSON bool
+
+	// convert the audit records into suricata EVE JSON events
+	Eve bool
+
+	// seed for the Community IDs calculated for zeek logs and EVE events of audit records without one
+	CommunityIDSeed int
+
+	// export the Connection audit records as IPFIX or NetFlow version 9 flow records
+	IPFIX                  bool
+	IPFIXCollector         string
+	IPFIXVersion           int
+	IPFIXEnterpriseNumber  uint32
+	IPFIXObservationDomain uint32
+
+	// insert the audit records into the sqlite database at the path
+	SQLite          string
+	SQLiteBatchSize int
+
+	// only dump the audit records in the time range, a zero time means no limit
+	Start time.Time
+	End   time.Time
+
+	// decode the audit records with the schema embedded in the file instead of the compiled types
+	Dynamic bool
 }
 
 // Dump reads the specified netcap file
@@ -136,6 +169,32 @@ func Dump(w *os.File, c DumpConfig) error {
 		return errFileHeader
 	}
 
+	if !c.Start.IsZero() || !c.End.IsZero() {
+		if err = r.SetTimeRange(c.Start, c.End); err != nil {
+			return fmt.Errorf("failed to read time index: %w", err)
+		}
+	}
+
+	if c.Dynamic {
+		return dumpDynamic(w, r, header, c)
+	}
+
+	// files written with different audit record types are checked before decoding them
+	if len(header.Schema) > 0 {
+		diff, errSchema := CheckSchema(header)
+		if errSchema != nil {
+			return errSchema
+		}
+
+		if !diff.Compatible() {
+			return fmt.Errorf("%w: %s, use dynamic decoding to read the file with its embedded schema", errIncompatibleSchema, diff)
+		}
+
+		if !diff.Equal() {
+			_, _ = fmt.Fprintln(os.Stderr, "the audit record schema of the file differs from this version:", diff)
+		}
+	}
+
 	var (
 		record = InitRecord(header.Type)
 		// rows for table print
@@ -143,6 +202,26 @@ func Dump(w *os.File, c DumpConfig) error {
 		colorMap map[string]string
 	)
 
+	if c.Parquet {
+		return dumpParquet(w, r, header, record, c)
+	}
+
+	if c.Zeek {
+		return dumpZeek(w, r, header, record, c)
+	}
+
+	if c.Eve {
+		return dumpEve(w, r, header, record, c)
+	}
+
+	if c.IPFIX {
+		return dumpIPFIX(w, r, header, record, c)
+	}
+
+	if c.SQLite != "" {
+		return dumpSQLite(w, r, header, record, c)
+	}
+
 	// disable structured dumping explicitly, since its enabled by default.
 	if c.CSV || c.JSON || c.Table {
 		c.Structured = false
@@ -37,22 +37,36 @@ type ChannelAuditRecordWriter interface {
 // NewAuditRecordWriter will return a new writer for netcap audit records.
 func NewAuditRecordWriter(wc *WriterConfig) AuditRecordWriter {
 	switch {
+	case wc.Records != nil:
+		return newRecordWriter(wc)
+	case wc.Outputs != "":
+		return newMultiWriter(wc)
 	case wc.UnixSocket:
 		return newUnixSocketWriter(wc)
 	case wc.CSV:
-		return newCSVWriter(wc)
+		return newRotatingWriter(wc, func(c *WriterConfig) AuditRecordWriter { return newCSVWriter(c) })
 	case wc.Chan:
 		return newChanWriter(wc)
 	case wc.JSON:
-		return newJSONWriter(wc)
+		return newRotatingWriter(wc, func(c *WriterConfig) AuditRecordWriter { return newJSONWriter(c) })
 	case wc.Null:
 		return newNullWriter(wc)
 	case wc.Elastic:
 		return newElasticWriter(wc)
+	case wc.Parquet:
+		return newParquetWriter(wc)
+	case wc.Zeek:
+		return newZeekWriter(wc)
+	case wc.Eve:
+		return newEveWriter(wc)
+	case wc.IPFIX:
+		return newIPFIXWriter(wc)
+	case wc.SQLite:
+		return newSQLiteWriter(wc)
 
 	// proto is the default, so this option should be checked last to allow overwriting it
 	case wc.Proto:
-		return newProtoWriter(wc)
+		return newRotatingWriter(wc, func(c *WriterConfig) AuditRecordWriter { return newProtoWriter(c) })
 	default:
 		spew.Dump(wc)
 		panic("invalid WriterConfig")
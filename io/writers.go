@@ -38,18 +38,89 @@ type WriterConfig struct {
 	// ChanSize is the size of chunks sent through the channel
 	ChanSize int
 
+	// Records writer, delivers the audit records in memory by sending them into the channel
+	Records chan<- types.AuditRecord
+
 	// Elastic db writer
 	Elastic bool
 
 	// UnixSocket writer
 	UnixSocket bool
 
+	// Parquet writer
+	Parquet bool
+
+	// ParquetRowGroupSize is the number of audit records per parquet row group
+	ParquetRowGroupSize int
+
+	// ParquetCompression is the compression codec for parquet pages: snappy, gzip, zstd or none
+	ParquetCompression string
+
+	// Zeek writer, maps audit records to zeek logs like conn.log or http.log
+	Zeek bool
+
+	// ZeekJSON writes the zeek logs as JSON instead of tab separated values
+	ZeekJSON bool
+
+	// Eve writer, maps audit records to suricata EVE JSON events in a single eve.json file
+	Eve bool
+
+	// CommunityIDSeed is the seed for the Community IDs that the zeek and eve writers calculate for audit records without one
+	CommunityIDSeed int
+
+	// IPFIX writer, exports Connection audit records as IPFIX or NetFlow version 9 flow records
+	IPFIX bool
+
+	// IPFIXCollector is the UDP address of the flow collector, the records are written to a file in the output directory if not set
+	IPFIXCollector string
+
+	// IPFIXVersion is 10 for IPFIX or 9 for NetFlow version 9
+	IPFIXVersion int
+
+	// IPFIXEnterpriseNumber is the private enterprise number of the netcap information elements
+	IPFIXEnterpriseNumber uint32
+
+	// IPFIXObservationDomain is the observation domain id of the exporter
+	IPFIXObservationDomain uint32
+
+	// ConnTimeOut is the connection timeout, the IPFIX writer discards the JA3 hashes of connections that have not been exported after it
+	ConnTimeOut time.Duration
+
+	// SQLite writer, inserts the audit records into a sqlite database with one table per audit record type
+	SQLite bool
+
+	// SQLiteBatchSize is the number of audit records inserted per transaction
+	SQLiteBatchSize int
+
+	// RotateSize is the size in bytes after which the proto, csv and json writers start a new file, 0 disables rotation by size.
+	// For compressed files the size before compression is used.
+	RotateSize int64
+
+	// RotateInterval is the interval for starting new files, the files are rotated on multiples of the interval
+	RotateInterval time.Duration
+
+	// RetentionSize is the quota in bytes for rotated files in the output directory, the oldest files are removed when it is exceeded
+	RetentionSize int64
+
+	// Index writes a time index next to protobuf audit record files, for seeking to the audit records of a time range
+	Index bool
+
+	// IndexBlockSize is the number of audit records per block of the time index
+	IndexBlockSize int
+
 	// ElasticConfig allows to overwrite elastic defaults
 	ElasticConfig
 
 	// The Null writer will write nothing to disk and discard all data.
 	Null bool
 
+	// Outputs is a comma separated list of writers that all receive the audit records,
+	// e.g. proto,csv,elastic. Overrides the writer type flags if set.
+	Outputs string
+
+	// OutputBufferSize is the number of audit records buffered for each output
+	OutputBufferSize int
+
 	// Netcap header information
 	Name          string
 	Type          types.Type
@@ -67,6 +138,7 @@ type WriterConfig struct {
 	// compression
 	CompressionBlockSize int
 	CompressionLevel     int
+	CompressionCodec     string
 
 	// Encode data on the fly
 	Encode bool
@@ -0,0 +1,691 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"crypto/tls"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dreadl0ck/gopacket/layers"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/dreadl0ck/netcap/types"
+	"github.com/dreadl0ck/netcap/utils"
+)
+
+// zeekConverter maps an audit record type to a zeek log.
+type zeekConverter struct {
+	log *zeekLogDef
+
+	// convert creates the log row for an audit record, nil if the record has no equivalent in the log
+	convert func(msg proto.Message, seed uint16) *zeekRow
+}
+
+// conversation parts for merged rows.
+const (
+	zeekPartClient = 1 << iota
+	zeekPartServer
+
+	zeekPartsBoth = zeekPartClient | zeekPartServer
+)
+
+// connection identifier fields, shared by all logs of a connection.
+var zeekConnID = []zeekField{
+	{"ts", "time"},
+	{"uid", "string"},
+	{"id.orig_h", "addr"},
+	{"id.orig_p", "port"},
+	{"id.resp_h", "addr"},
+	{"id.resp_p", "port"},
+}
+
+func withConnID(fields ...zeekField) []zeekField {
+	return append(append([]zeekField{}, zeekConnID...), fields...)
+}
+
+var (
+	zeekConnLog = newZeekLogDef("conn", 0, withConnID(
+		zeekField{"proto", "enum"},
+		zeekField{"service", "string"},
+		zeekField{"duration", "interval"},
+		zeekField{"orig_bytes", "count"},
+		zeekField{"resp_bytes", "count"},
+		zeekField{"conn_state", "string"},
+		zeekField{"local_orig", "bool"},
+		zeekField{"local_resp", "bool"},
+		zeekField{"missed_bytes", "count"},
+		zeekField{"history", "string"},
+		zeekField{"orig_pkts", "count"},
+		zeekField{"orig_ip_bytes", "count"},
+		zeekField{"resp_pkts", "count"},
+		zeekField{"resp_ip_bytes", "count"},
+		zeekField{"tunnel_parents", "set[string]"},
+		zeekField{"community_id", "string"},
+	)...)
+
+	zeekHTTPLog = newZeekLogDef("http", 0, withConnID(
+		zeekField{"trans_depth", "count"},
+		zeekField{"method", "string"},
+		zeekField{"host", "string"},
+		zeekField{"uri", "string"},
+		zeekField{"referrer", "string"},
+		zeekField{"version", "string"},
+		zeekField{"user_agent", "string"},
+		zeekField{"origin", "string"},
+		zeekField{"request_body_len", "count"},
+		zeekField{"response_body_len", "count"},
+		zeekField{"status_code", "count"},
+		zeekField{"status_msg", "string"},
+		zeekField{"info_code", "count"},
+		zeekField{"info_msg", "string"},
+		zeekField{"tags", "set[enum]"},
+		zeekField{"username", "string"},
+		zeekField{"password", "string"},
+		zeekField{"proxied", "set[string]"},
+		zeekField{"orig_fuids", "vector[string]"},
+		zeekField{"orig_filenames", "vector[string]"},
+		zeekField{"orig_mime_types", "vector[string]"},
+		zeekField{"resp_fuids", "vector[string]"},
+		zeekField{"resp_filenames", "vector[string]"},
+		zeekField{"resp_mime_types", "vector[string]"},
+	)...)
+
+	zeekDNSLog = newZeekLogDef("dns", zeekPartsBoth, withConnID(
+		zeekField{"proto", "enum"},
+		zeekField{"trans_id", "count"},
+		zeekField{"rtt", "interval"},
+		zeekField{"query", "string"},
+		zeekField{"qclass", "count"},
+		zeekField{"qclass_name", "string"},
+		zeekField{"qtype", "count"},
+		zeekField{"qtype_name", "string"},
+		zeekField{"rcode", "count"},
+		zeekField{"rcode_name", "string"},
+		zeekField{"AA", "bool"},
+		zeekField{"TC", "bool"},
+		zeekField{"RD", "bool"},
+		zeekField{"RA", "bool"},
+		zeekField{"Z", "count"},
+		zeekField{"answers", "vector[string]"},
+		zeekField{"TTLs", "vector[interval]"},
+		zeekField{"rejected", "bool"},
+	)...)
+
+	zeekSSLLog = newZeekLogDef("ssl", zeekPartsBoth, withConnID(
+		zeekField{"version", "string"},
+		zeekField{"cipher", "string"},
+		zeekField{"curve", "string"},
+		zeekField{"server_name", "string"},
+		zeekField{"resumed", "bool"},
+		zeekField{"next_protocol", "string"},
+		zeekField{"established", "bool"},
+		zeekField{"ja3", "string"},
+		zeekField{"ja3s", "string"},
+	)...)
+
+	zeekSSHLog = newZeekLogDef("ssh", zeekPartsBoth, withConnID(
+		zeekField{"version", "count"},
+		zeekField{"client", "string"},
+		zeekField{"server", "string"},
+		zeekField{"hasshVersion", "string"},
+		zeekField{"hassh", "string"},
+		zeekField{"hasshServer", "string"},
+		zeekField{"hasshAlgorithms", "string"},
+		zeekField{"hasshServerAlgorithms", "string"},
+	)...)
+
+	zeekFilesLog = newZeekLogDef("files", 0,
+		zeekField{"ts", "time"},
+		zeekField{"fuid", "string"},
+		zeekField{"uid", "string"},
+		zeekField{"id.orig_h", "addr"},
+		zeekField{"id.orig_p", "port"},
+		zeekField{"id.resp_h", "addr"},
+		zeekField{"id.resp_p", "port"},
+		zeekField{"source", "string"},
+		zeekField{"depth", "count"},
+		zeekField{"analyzers", "set[string]"},
+		zeekField{"mime_type", "string"},
+		zeekField{"filename", "string"},
+		zeekField{"duration", "interval"},
+		zeekField{"is_orig", "bool"},
+		zeekField{"seen_bytes", "count"},
+		zeekField{"total_bytes", "count"},
+		zeekField{"missing_bytes", "count"},
+		zeekField{"md5", "string"},
+		zeekField{"extracted", "string"},
+		zeekField{"extracted_size", "count"},
+	)
+
+	zeekSoftwareLog = newZeekLogDef("software", 0,
+		zeekField{"ts", "time"},
+		zeekField{"host", "addr"},
+		zeekField{"host_p", "port"},
+		zeekField{"software_type", "enum"},
+		zeekField{"name", "string"},
+		zeekField{"version.major", "count"},
+		zeekField{"version.minor", "count"},
+		zeekField{"version.minor2", "count"},
+		zeekField{"version.minor3", "count"},
+		zeekField{"version.addl", "string"},
+		zeekField{"unparsed_version", "string"},
+	)
+)
+
+// zeekConverters contains the audit record types that can be written as zeek logs.
+var zeekConverters = map[types.Type]*zeekConverter{
+	types.Type_NC_Connection:     {log: zeekConnLog, convert: zeekConn},
+	types.Type_NC_HTTP:           {log: zeekHTTPLog, convert: zeekHTTP},
+	types.Type_NC_DNS:            {log: zeekDNSLog, convert: zeekDNS},
+	types.Type_NC_TLSClientHello: {log: zeekSSLLog, convert: zeekTLSClientHello},
+	types.Type_NC_TLSServerHello: {log: zeekSSLLog, convert: zeekTLSServerHello},
+	types.Type_NC_SSH:            {log: zeekSSHLog, convert: zeekSSH},
+	types.Type_NC_File:           {log: zeekFilesLog, convert: zeekFile},
+	types.Type_NC_Software:       {log: zeekSoftwareLog, convert: zeekSoftware},
+}
+
+func init() {
+	// the response of a dns query is preferred, only the timestamp is taken from the query to calculate the round trip time.
+	zeekDNSLog.merge = func(dst, src *zeekRow) {
+		query, response := dst, src
+		if src.part == zeekPartClient {
+			query, response = src, dst
+		}
+
+		values := append([]interface{}{}, response.values...)
+
+		qts, okQuery := query.get("ts").(int64)
+		rts, okResponse := response.get("ts").(int64)
+
+		dst.values = values
+
+		if okQuery && okResponse {
+			dst.set("ts", qts)
+			dst.set("rtt", rts-qts)
+		}
+	}
+}
+
+// zeekUID returns the zeek identifier for the community id of a flow, see flowCommunityID.
+func zeekUID(seed uint16, communityID, srcIP, srcPort, dstIP, dstPort string, proto uint8) string {
+	if communityID = flowCommunityID(seed, communityID, srcIP, srcPort, dstIP, dstPort, proto); communityID == "" {
+		return ""
+	}
+
+	return zeekID("C", communityID)
+}
+
+// setConnID sets the connection identifier fields.
+func (r *zeekRow) setConnID(uid, origIP, origPort, respIP, respPort string) {
+	r.set("uid", uid)
+	r.set("id.orig_h", origIP)
+	r.setInt("id.orig_p", origPort)
+	r.set("id.resp_h", respIP)
+	r.setInt("id.resp_p", respPort)
+}
+
+// setInt sets a count or port field from its string representation.
+func (r *zeekRow) setInt(field, value string) {
+	if i, err := strconv.Atoi(value); err == nil {
+		r.set(field, i)
+	}
+}
+
+// protoNumber returns the IP protocol number for the transport protocol name.
+func protoNumber(name string) uint8 {
+	switch strings.ToLower(name) {
+	case "tcp":
+		return utils.ProtocolTCP
+	case "udp":
+		return utils.ProtocolUDP
+	case "sctp":
+		return utils.ProtocolSCTP
+	default:
+		return 0
+	}
+}
+
+// flowCommunityID returns the community id the zeek uid and the EVE flow id of a flow are derived from.
+// The audit records of the application layer protocols do not contain the start of their connection,
+// so the identifiers are derived from the community id alone, in order to be the same for all records of a connection.
+// Connections reusing the same 5-tuple therefore get the same identifier, they can be told apart by their timestamp.
+// If the community id is not set, it is calculated from the flow with the configured seed.
+func flowCommunityID(seed uint16, communityID, srcIP, srcPort, dstIP, dstPort string, proto uint8) string {
+	if communityID == "" {
+		return utils.CommunityIDFromStrings(seed, srcIP, srcPort, dstIP, dstPort, proto)
+	}
+
+	return communityID
+}
+
+func zeekConn(msg proto.Message, seed uint16) *zeekRow {
+	c, ok := msg.(*types.Connection)
+	if !ok {
+		return nil
+	}
+
+	r := zeekConnLog.newRow()
+
+	r.set("ts", c.TimestampFirst)
+	r.setConnID(zeekUID(seed, c.CommunityID, c.SrcIP, c.SrcPort, c.DstIP, c.DstPort, protoNumber(c.TransportProto)), c.SrcIP, c.SrcPort, c.DstIP, c.DstPort)
+	r.set("proto", strings.ToLower(c.TransportProto))
+	r.set("service", strings.ToLower(c.ApplicationProto))
+	r.set("duration", c.Duration)
+	r.set("orig_bytes", c.BytesClientToServer)
+	r.set("resp_bytes", c.BytesServerToClient)
+	r.set("conn_state", zeekConnState(c))
+
+	r.set("community_id", c.CommunityID)
+
+	return r
+}
+
+// zeekConnState maps the termination reason of a tcp connection to the zeek connection state.
+// The direction of a half closed connection is not known, so it is reported as established.
+func zeekConnState(c *types.Connection) string {
+	switch c.TerminationReason {
+	case "Closed":
+		return "SF"
+	case "ClientReset":
+		return "RSTO"
+	case "ServerReset":
+		return "RSTR"
+	case "Rejected":
+		return "REJ"
+	case "HalfClosed":
+		return "S1"
+	case "Open":
+		if c.NumSYNFlags > 0 {
+			return "S1"
+		}
+
+		return "OTH"
+	default:
+		return ""
+	}
+}
+
+func zeekHTTP(msg proto.Message, seed uint16) *zeekRow {
+	h, ok := msg.(*types.HTTP)
+	if !ok {
+		return nil
+	}
+
+	r := zeekHTTPLog.newRow()
+
+	r.set("ts", h.Timestamp)
+	r.setConnID(zeekUID(seed, h.CommunityID, "", "", "", "", 0), h.SrcIP, "", h.DstIP, "")
+	r.set("method", h.Method)
+	r.set("host", h.Host)
+	r.set("uri", h.URL)
+	r.set("referrer", h.Referer)
+	r.set("version", strings.TrimPrefix(h.Proto, "HTTP/"))
+	r.set("user_agent", h.UserAgent)
+	r.set("origin", h.RequestHeader["Origin"])
+	r.set("request_body_len", h.ReqContentLength)
+	r.set("response_body_len", h.ResContentLength)
+
+	if h.StatusCode != 0 {
+		r.set("status_code", h.StatusCode)
+		r.set("status_msg", strings.ToUpper(http.StatusText(int(h.StatusCode))))
+	}
+
+	r.set("tags", []string{})
+
+	if ct := firstNonEmpty(h.ContentTypeDetected, h.ContentType); ct != "" {
+		r.set("orig_mime_types", []string{ct})
+	}
+
+	if ct := firstNonEmpty(h.ResContentTypeDetected, h.ResContentType); ct != "" {
+		r.set("resp_mime_types", []string{ct})
+	}
+
+	return r
+}
+
+// dns response code names, as used by zeek and suricata.
+var dnsRcodeNames = map[int32]string{
+	0: "NOERROR",
+	1: "FORMERR",
+	2: "SERVFAIL",
+	3: "NXDOMAIN",
+	4: "NOTIMP",
+	5: "REFUSED",
+}
+
+func zeekDNS(msg proto.Message, seed uint16) *zeekRow {
+	d, ok := msg.(*types.DNS)
+	if !ok {
+		return nil
+	}
+
+	var (
+		r                                  = zeekDNSLog.newRow()
+		origIP, origPort, respIP, respPort = d.SrcIP, strconv.Itoa(int(d.SrcPort)), d.DstIP, strconv.Itoa(int(d.DstPort))
+	)
+
+	// responses are sent by the server
+	if d.QR {
+		origIP, origPort, respIP, respPort = respIP, respPort, origIP, origPort
+	}
+
+	uid := zeekUID(seed, d.CommunityID, origIP, origPort, respIP, respPort, utils.ProtocolUDP)
+
+	r.set("ts", d.Timestamp)
+	r.setConnID(uid, origIP, origPort, respIP, respPort)
+	r.set("proto", "udp")
+	r.set("trans_id", d.ID)
+
+	if len(d.Questions) > 0 {
+		q := d.Questions[0]
+
+		r.set("query", q.Name)
+		r.set("qclass", q.Class)
+		r.set("qclass_name", zeekDNSClass(q.Class))
+		r.set("qtype", q.Type)
+		r.set("qtype_name", layers.DNSType(q.Type).String())
+	}
+
+	r.set("AA", d.AA)
+	r.set("TC", d.TC)
+	r.set("RD", d.RD)
+	r.set("RA", d.RA)
+	r.set("Z", d.Z)
+
+	if d.QR {
+		r.part = zeekPartServer
+
+		r.set("rcode", d.ResponseCode)
+		r.set("rcode_name", dnsRcodeNames[d.ResponseCode])
+		r.set("rejected", d.ResponseCode == 5)
+
+		var (
+			answers []string
+			ttls    []int64
+		)
+
+		for _, a := range d.Answers {
+			answers = append(answers, dnsAnswerData(a))
+			ttls = append(ttls, int64(a.TTL)*1e9)
+		}
+
+		r.set("answers", answers)
+		r.set("TTLs", ttls)
+	} else {
+		r.part = zeekPartClient
+	}
+
+	if uid != "" {
+		r.key = uid + "/" + strconv.Itoa(int(d.ID))
+	}
+
+	return r
+}
+
+func zeekDNSClass(class int32) string {
+	if class == int32(layers.DNSClassIN) {
+		return "C_INTERNET"
+	}
+
+	return layers.DNSClass(class).String()
+}
+
+// dnsAnswerData returns the decoded value of a resource record.
+func dnsAnswerData(a *types.DNSResourceRecord) string {
+	switch {
+	// the address of records without one is stored as <nil>
+	case a.IP != "" && a.IP != "<nil>":
+		return a.IP
+	case len(a.CNAME) > 0:
+		return string(a.CNAME)
+	case len(a.NS) > 0:
+		return string(a.NS)
+	case len(a.PTR) > 0:
+		return string(a.PTR)
+	case a.MX != nil:
+		return a.MX.Name
+	case len(a.TXTs) > 0:
+		txts := make([]string, len(a.TXTs))
+		for i, t := range a.TXTs {
+			txts[i] = string(t)
+		}
+
+		return "TXT " + strings.Join(txts, " ")
+	default:
+		return "<" + layers.DNSType(a.Type).String() + ">"
+	}
+}
+
+func zeekTLSClientHello(msg proto.Message, seed uint16) *zeekRow {
+	h, ok := msg.(*types.TLSClientHello)
+	if !ok {
+		return nil
+	}
+
+	var (
+		r       = zeekSSLLog.newRow()
+		srcPort = strconv.Itoa(int(h.SrcPort))
+		dstPort = strconv.Itoa(int(h.DstPort))
+		uid     = zeekUID(seed, h.CommunityID, h.SrcIP, srcPort, h.DstIP, dstPort, utils.ProtocolTCP)
+	)
+
+	r.set("ts", h.Timestamp)
+	r.setConnID(uid, h.SrcIP, srcPort, h.DstIP, dstPort)
+	r.set("server_name", h.SNI)
+	r.set("ja3", h.Ja3)
+
+	r.key, r.part = uid, zeekPartClient
+
+	return r
+}
+
+func zeekTLSServerHello(msg proto.Message, seed uint16) *zeekRow {
+	h, ok := msg.(*types.TLSServerHello)
+	if !ok {
+		return nil
+	}
+
+	var (
+		r       = zeekSSLLog.newRow()
+		srcPort = strconv.Itoa(int(h.SrcPort))
+		dstPort = strconv.Itoa(int(h.DstPort))
+		uid     = zeekUID(seed, h.CommunityID, h.SrcIP, srcPort, h.DstIP, dstPort, utils.ProtocolTCP)
+		version = h.Version
+	)
+
+	// TLS 1.3 is negotiated with the supported versions extension
+	if h.SupportedVersion != 0 {
+		version = h.SupportedVersion
+	}
+
+	// the server hello is sent by the responder
+	r.set("ts", h.Timestamp)
+	r.setConnID(uid, h.DstIP, dstPort, h.SrcIP, srcPort)
+	r.set("version", zeekTLSVersion(version))
+	r.set("cipher", tls.CipherSuiteName(uint16(h.CipherSuite)))
+	r.set("curve", zeekCurves[h.SelectedGroup])
+	r.set("next_protocol", h.AlpnProtocol)
+	r.set("established", true)
+	r.set("ja3s", h.Ja3S)
+
+	r.key, r.part = uid, zeekPartServer
+
+	return r
+}
+
+// zeek names for the elliptic curves of the key exchange.
+var zeekCurves = map[int32]string{
+	23: "secp256r1",
+	24: "secp384r1",
+	25: "secp521r1",
+	29: "x25519",
+	30: "x448",
+}
+
+func zeekTLSVersion(v int32) string {
+	switch v {
+	case 0x0300:
+		return "SSLv3"
+	case 0x0301:
+		return "TLSv10"
+	case 0x0302:
+		return "TLSv11"
+	case 0x0303:
+		return "TLSv12"
+	case 0x0304:
+		return "TLSv13"
+	case 0:
+		return ""
+	default:
+		return "unknown-" + strconv.Itoa(int(v))
+	}
+}
+
+func zeekSSH(msg proto.Message, seed uint16) *zeekRow {
+	s, ok := msg.(*types.SSH)
+	if !ok {
+		return nil
+	}
+
+	// the flow of the server side is reversed
+	flow := s.Flow
+	if !s.IsClient {
+		flow = utils.ReverseFlowIdent(flow)
+	}
+
+	var (
+		r                                  = zeekSSHLog.newRow()
+		origIP, origPort, respIP, respPort = utils.ParseFlowIdent(flow)
+		uid                                = zeekUID(seed, s.CommunityID, origIP, origPort, respIP, respPort, utils.ProtocolTCP)
+	)
+
+	r.set("ts", s.Timestamp)
+	r.setConnID(uid, origIP, origPort, respIP, respPort)
+
+	switch {
+	case strings.HasPrefix(s.Ident, "SSH-2.0"), strings.HasPrefix(s.Ident, "SSH-1.99"):
+		r.set("version", 2)
+	case strings.HasPrefix(s.Ident, "SSH-1."):
+		r.set("version", 1)
+	}
+
+	r.set("hasshVersion", "1.1")
+
+	if s.IsClient {
+		r.set("client", s.Ident)
+		r.set("hassh", s.HASSH)
+		r.set("hasshAlgorithms", s.Algorithms)
+		r.part = zeekPartClient
+	} else {
+		r.set("server", s.Ident)
+		r.set("hasshServer", s.HASSH)
+		r.set("hasshServerAlgorithms", s.Algorithms)
+		r.part = zeekPartServer
+	}
+
+	r.key = uid
+
+	return r
+}
+
+func zeekFile(msg proto.Message, seed uint16) *zeekRow {
+	f, ok := msg.(*types.File)
+	if !ok {
+		return nil
+	}
+
+	var (
+		r       = zeekFilesLog.newRow()
+		srcPort = strconv.Itoa(int(f.SrcPort))
+		dstPort = strconv.Itoa(int(f.DstPort))
+	)
+
+	r.set("ts", f.Timestamp)
+	r.set("fuid", zeekID("F", f.Ident+"/"+f.Name+"/"+strconv.FormatInt(f.Timestamp, 10)))
+	r.set("uid", zeekUID(seed, f.CommunityID, f.SrcIP, srcPort, f.DstIP, dstPort, utils.ProtocolTCP))
+	r.set("id.orig_h", f.SrcIP)
+	r.setInt("id.orig_p", srcPort)
+	r.set("id.resp_h", f.DstIP)
+	r.setInt("id.resp_p", dstPort)
+	r.set("source", f.Source)
+	r.set("depth", 0)
+	r.set("mime_type", firstNonEmpty(f.ContentTypeDetected, f.ContentType))
+	r.set("filename", f.Name)
+	r.set("seen_bytes", f.Length)
+	r.set("total_bytes", f.Length)
+	r.set("missing_bytes", 0)
+
+	if f.Hash != "" {
+		r.set("analyzers", []string{"MD5"})
+		r.set("md5", f.Hash)
+	}
+
+	if f.Location != "" {
+		r.set("extracted", filepath.Base(f.Location))
+		r.set("extracted_size", f.Length)
+	}
+
+	return r
+}
+
+// version numbers of a software version string, e.g. 7.4p1 or 2.4.41-beta.
+var zeekVersionRegex = regexp.MustCompile(`^v?(\d+)(?:\.(\d+))?(?:\.(\d+))?(?:\.(\d+))?(.*)$`)
+
+func zeekSoftware(msg proto.Message, seed uint16) *zeekRow {
+	s, ok := msg.(*types.Software)
+	if !ok {
+		return nil
+	}
+
+	r := zeekSoftwareLog.newRow()
+
+	r.set("ts", s.Timestamp)
+
+	// the software is attributed to the source of the first flow it was seen in
+	if len(s.Flows) > 0 {
+		host, port, _, _ := utils.ParseFlowIdent(s.Flows[0])
+		r.set("host", host)
+		r.setInt("host_p", port)
+	}
+
+	r.set("software_type", firstNonEmpty(s.Service, s.SourceName))
+	r.set("name", strings.TrimSpace(s.Vendor+" "+s.Product))
+	r.set("unparsed_version", s.Version)
+
+	if m := zeekVersionRegex.FindStringSubmatch(s.Version); m != nil {
+		for i, field := range []string{"version.major", "version.minor", "version.minor2", "version.minor3"} {
+			if m[i+1] != "" {
+				r.setInt(field, m[i+1])
+			}
+		}
+
+		r.set("version.addl", strings.TrimLeft(m[5], "-_. "))
+	}
+
+	return r
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
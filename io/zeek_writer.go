@@ -0,0 +1,559 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/dreadl0ck/netcap/defaults"
+	"github.com/dreadl0ck/netcap/types"
+)
+
+// zeek log format constants.
+const (
+	zeekSeparator    = "\t"
+	zeekSetSeparator = ","
+	zeekEmptyField   = "(empty)"
+	zeekUnsetField   = "-"
+	zeekTimeFormat   = "2006-01-02-15-04-05"
+	zeekDir          = "zeek"
+)
+
+// zeekLog encodes the rows of a zeek log file, as TSV with the zeek header or as JSON lines.
+type zeekLog struct {
+	def  *zeekLogDef
+	json bool
+}
+
+// zeekWriter is a structure that supports writing audit records as zeek logs.
+// The logs are written into the zeek subdirectory of the output directory,
+// to avoid clashes with the netcap logfiles, e.g. http.log.
+type zeekWriter struct {
+	mu   sync.Mutex
+	wc   *WriterConfig
+	conv *zeekConverter
+	log  *mergedFile
+}
+
+// newZeekWriter initializes and configures a new zeekWriter instance.
+func newZeekWriter(wc *WriterConfig) *zeekWriter {
+	if wc.MemBufferSize <= 0 {
+		wc.MemBufferSize = defaults.BufferSize
+	}
+
+	w := &zeekWriter{
+		wc: wc,
+	}
+
+	// the type is not known yet for the gopacket decoders, the log is opened when the header is written
+	w.init(wc.Type)
+
+	return w
+}
+
+// init opens the zeek log for the audit record type,
+// audit record types that have no zeek equivalent are discarded.
+func (w *zeekWriter) init(t types.Type) {
+	if w.log != nil {
+		return
+	}
+
+	conv, ok := zeekConverters[t]
+	if !ok {
+		return
+	}
+
+	w.conv = conv
+	w.log = openZeekLog(w.wc, conv.log)
+}
+
+// Write converts the audit record into a zeek log row.
+func (w *zeekWriter) Write(msg proto.Message) error {
+	w.mu.Lock()
+	conv, l := w.conv, w.log
+	w.mu.Unlock()
+
+	if l == nil {
+		return nil
+	}
+
+	row := conv.convert(msg, uint16(w.wc.CommunityIDSeed))
+	if row == nil {
+		return nil
+	}
+
+	return l.add(row)
+}
+
+// WriteHeader opens the zeek log for the audit record type, the zeek header is written when the log is created.
+func (w *zeekWriter) WriteHeader(t types.Type) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.init(t)
+
+	if w.log == nil {
+		ioLog.Info("no zeek log for audit record type", zap.String("type", t.String()))
+	}
+
+	return nil
+}
+
+// Close releases the zeek log, the file is closed once all writers for the log have been closed.
+func (w *zeekWriter) Close(_ int64) (name string, size int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.log == nil {
+		return "", 0
+	}
+
+	return w.log.release()
+}
+
+// openZeekLog returns the zeek log in the output directory, and creates it if necessary.
+func openZeekLog(wc *WriterConfig, def *zeekLogDef) *mergedFile {
+	dir := filepath.Join(wc.Out, zeekDir)
+
+	if err := os.MkdirAll(dir, defaults.DirectoryPermission); err != nil {
+		panic(err)
+	}
+
+	return openMergedFile(filepath.Join(dir, def.path+".log"), zeekLog{def: def, json: wc.ZeekJSON}, wc.MemBufferSize)
+}
+
+func (l zeekLog) parts() int {
+	return l.def.parts
+}
+
+// writeHeader writes the zeek TSV header with the field names and types.
+func (l zeekLog) writeHeader(w *bufio.Writer) {
+	if l.json {
+		return
+	}
+
+	var (
+		names = make([]string, len(l.def.fields))
+		typs  = make([]string, len(l.def.fields))
+	)
+
+	for i, f := range l.def.fields {
+		names[i], typs[i] = f.name, f.typ
+	}
+
+	fmt.Fprintf(w, "#separator \\x%02x\n", zeekSeparator[0])
+	fmt.Fprintf(w, "#set_separator%s%s\n", zeekSeparator, zeekSetSeparator)
+	fmt.Fprintf(w, "#empty_field%s%s\n", zeekSeparator, zeekEmptyField)
+	fmt.Fprintf(w, "#unset_field%s%s\n", zeekSeparator, zeekUnsetField)
+	fmt.Fprintf(w, "#path%s%s\n", zeekSeparator, l.def.path)
+	fmt.Fprintf(w, "#open%s%s\n", zeekSeparator, time.Now().Format(zeekTimeFormat))
+	fmt.Fprintf(w, "#fields%s%s\n", zeekSeparator, strings.Join(names, zeekSeparator))
+	fmt.Fprintf(w, "#types%s%s\n", zeekSeparator, strings.Join(typs, zeekSeparator))
+}
+
+func (l zeekLog) writeRow(w *bufio.Writer, r mergedRow) error {
+	row := r.(*zeekRow)
+
+	if l.json {
+		return l.writeJSON(w, row)
+	}
+
+	for i, f := range l.def.fields {
+		if i > 0 {
+			if _, err := w.WriteString(zeekSeparator); err != nil {
+				return err
+			}
+		}
+
+		if _, err := w.WriteString(formatZeekField(f.typ, row.values[i])); err != nil {
+			return err
+		}
+	}
+
+	_, err := w.WriteString("\n")
+
+	return err
+}
+
+// writeJSON writes the row as a JSON object, unset fields are omitted.
+func (l zeekLog) writeJSON(w *bufio.Writer, row *zeekRow) error {
+	var b strings.Builder
+
+	b.WriteString("{")
+
+	for i, f := range l.def.fields {
+		if row.values[i] == nil {
+			continue
+		}
+
+		if b.Len() > 1 {
+			b.WriteString(",")
+		}
+
+		b.WriteString(strconv.Quote(f.name))
+		b.WriteString(":")
+		b.WriteString(formatZeekJSON(f.typ, row.values[i]))
+	}
+
+	b.WriteString("}\n")
+
+	_, err := w.WriteString(b.String())
+
+	return err
+}
+
+// writeFooter writes the close time of the zeek TSV log.
+func (l zeekLog) writeFooter(w *bufio.Writer) {
+	if !l.json {
+		fmt.Fprintf(w, "#close%s%s\n", zeekSeparator, time.Now().Format(zeekTimeFormat))
+	}
+}
+
+// zeekField is a column of a zeek log.
+type zeekField struct {
+	name string
+	typ  string
+}
+
+// zeekLogDef describes the columns of a zeek log.
+type zeekLogDef struct {
+	path   string
+	fields []zeekField
+	index  map[string]int
+
+	// bit mask of the parts that form a complete row, e.g. the client and server side of a conversation.
+	// zero if every audit record is a complete row.
+	parts int
+
+	// merge combines the values of src into the pending row dst.
+	merge func(dst, src *zeekRow)
+}
+
+// newZeekLogDef creates a log definition from the field names and types,
+// by default rows are merged by filling the unset fields and keeping the earliest timestamp.
+func newZeekLogDef(path string, parts int, fields ...zeekField) *zeekLogDef {
+	d := &zeekLogDef{
+		path:   path,
+		fields: fields,
+		index:  make(map[string]int, len(fields)),
+		parts:  parts,
+	}
+
+	for i, f := range fields {
+		d.index[f.name] = i
+	}
+
+	d.merge = func(dst, src *zeekRow) {
+		for i, v := range src.values {
+			if dst.values[i] == nil {
+				dst.values[i] = v
+			}
+		}
+
+		if ts, ok := src.get("ts").(int64); ok {
+			if first, ok := dst.get("ts").(int64); ok && ts < first {
+				dst.set("ts", ts)
+			}
+		}
+	}
+
+	return d
+}
+
+// zeekRow contains the values of a log line, unset values are nil.
+type zeekRow struct {
+	def    *zeekLogDef
+	values []interface{}
+
+	// key of the conversation and the part of it that is contained in the row, if the row needs to be merged
+	key  string
+	part int
+}
+
+func (d *zeekLogDef) newRow() *zeekRow {
+	return &zeekRow{
+		def:    d,
+		values: make([]interface{}, len(d.fields)),
+	}
+}
+
+// conversation returns the key of the conversation and the part contained in the row.
+func (r *zeekRow) conversation() (key string, part int) {
+	return r.key, r.part
+}
+
+// merge combines the values of another part of the conversation into the row.
+func (r *zeekRow) merge(o mergedRow) {
+	src := o.(*zeekRow)
+
+	r.def.merge(r, src)
+	r.part |= src.part
+}
+
+// set sets the value of a field, integers are stored as int64.
+// Empty strings and nil slices are treated as unset, empty slices are written as empty containers.
+func (r *zeekRow) set(field string, v interface{}) {
+	i, ok := r.def.index[field]
+	if !ok {
+		panic("unknown zeek field " + r.def.path + "." + field)
+	}
+
+	switch x := v.(type) {
+	case string:
+		if x == "" {
+			return
+		}
+	case []string:
+		if x == nil {
+			return
+		}
+	case []int64:
+		if x == nil {
+			return
+		}
+	case int:
+		v = int64(x)
+	case int32:
+		v = int64(x)
+	case uint32:
+		v = int64(x)
+	case uint64:
+		v = int64(x)
+	}
+
+	r.values[i] = v
+}
+
+func (r *zeekRow) get(field string) interface{} {
+	return r.values[r.def.index[field]]
+}
+
+// formatZeekField formats a value for the TSV log.
+func formatZeekField(typ string, v interface{}) string {
+	if v == nil {
+		return zeekUnsetField
+	}
+
+	elemTyp := zeekElemType(typ)
+	if elemTyp == "" {
+		s := formatZeekValue(typ, v)
+		if typ == "string" || typ == "enum" {
+			s = escapeZeek(s, false)
+		}
+
+		if s == zeekUnsetField || s == zeekEmptyField {
+			return escapeZeekAll(s)
+		}
+
+		return s
+	}
+
+	var elems []string
+
+	switch x := v.(type) {
+	case []string:
+		for _, e := range x {
+			elems = append(elems, escapeZeek(e, true))
+		}
+	case []int64:
+		for _, e := range x {
+			elems = append(elems, formatZeekValue(elemTyp, e))
+		}
+	}
+
+	if len(elems) == 0 {
+		return zeekEmptyField
+	}
+
+	return strings.Join(elems, zeekSetSeparator)
+}
+
+// formatZeekJSON formats a value for the JSON log.
+func formatZeekJSON(typ string, v interface{}) string {
+	elemTyp := zeekElemType(typ)
+	if elemTyp == "" {
+		return formatZeekJSONValue(typ, v)
+	}
+
+	var elems []string
+
+	switch x := v.(type) {
+	case []string:
+		for _, e := range x {
+			elems = append(elems, formatZeekJSONValue(elemTyp, e))
+		}
+	case []int64:
+		for _, e := range x {
+			elems = append(elems, formatZeekJSONValue(elemTyp, e))
+		}
+	}
+
+	return "[" + strings.Join(elems, ",") + "]"
+}
+
+func formatZeekJSONValue(typ string, v interface{}) string {
+	switch typ {
+	case "bool":
+		return strconv.FormatBool(v.(bool))
+	case "time", "interval", "count", "int", "port", "double":
+		return formatZeekValue(typ, v)
+	default:
+		b, err := json.Marshal(formatZeekValue(typ, v))
+		if err != nil {
+			return `""`
+		}
+
+		return string(b)
+	}
+}
+
+// formatZeekValue formats a scalar value, times and intervals are stored in nanoseconds.
+func formatZeekValue(typ string, v interface{}) string {
+	switch x := v.(type) {
+	case bool:
+		if x {
+			return "T"
+		}
+
+		return "F"
+	case int64:
+		if typ == "time" || typ == "interval" {
+			return formatZeekSeconds(x)
+		}
+
+		return strconv.FormatInt(x, 10)
+	case float64:
+		return strconv.FormatFloat(x, 'f', 6, 64)
+	case string:
+		return x
+	default:
+		return fmt.Sprint(x)
+	}
+}
+
+// formatZeekSeconds formats nanoseconds as seconds with microsecond precision.
+func formatZeekSeconds(ns int64) string {
+	sign := ""
+	if ns < 0 {
+		sign, ns = "-", -ns
+	}
+
+	return fmt.Sprintf("%s%d.%06d", sign, ns/int64(time.Second), ns%int64(time.Second)/int64(time.Microsecond))
+}
+
+// zeekElemType returns the element type of a set or vector type, or an empty string for scalar types.
+func zeekElemType(typ string) string {
+	for _, prefix := range []string{"set[", "vector["} {
+		if strings.HasPrefix(typ, prefix) {
+			return strings.TrimSuffix(strings.TrimPrefix(typ, prefix), "]")
+		}
+	}
+
+	return ""
+}
+
+// escapeZeek escapes the separators, backslashes and non printable characters in a string.
+func escapeZeek(s string, inSet bool) string {
+	var b strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < 0x20 || c >= 0x7f || c == '\\' || c == zeekSeparator[0] || (inSet && c == zeekSetSeparator[0]) {
+			fmt.Fprintf(&b, "\\x%02x", c)
+		} else {
+			b.WriteByte(c)
+		}
+	}
+
+	return b.String()
+}
+
+// escapeZeekAll escapes all characters, used for values that could be confused with the unset or empty field.
+func escapeZeekAll(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		fmt.Fprintf(&b, "\\x%02x", s[i])
+	}
+
+	return b.String()
+}
+
+// base62 alphabet for zeek identifiers.
+const zeekIDAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// zeekID creates a zeek style identifier from a prefix and the 96 bit hash of the data,
+// e.g. C4J4Th3PJpwUYZZ6gc for connections and F for files.
+func zeekID(prefix, data string) string {
+	var (
+		h    = sha1.Sum([]byte(data))
+		n    = new(big.Int).SetBytes(h[:12])
+		base = big.NewInt(int64(len(zeekIDAlphabet)))
+		mod  = new(big.Int)
+		id   []byte
+	)
+
+	for n.Sign() > 0 {
+		n.DivMod(n, base, mod)
+		id = append(id, zeekIDAlphabet[mod.Int64()])
+	}
+
+	return prefix + string(id)
+}
+
+// dumpZeek converts the audit records from the reader into a zeek log that is written to w.
+func dumpZeek(w io.Writer, r *Reader, header *types.Header, record proto.Message, c DumpConfig) error {
+	conv, ok := zeekConverters[header.Type]
+	if !ok {
+		return fmt.Errorf("%w: no zeek log for %s", errInvalidOutput, header.Type)
+	}
+
+	size := c.MemBufferSize
+	if size <= 0 {
+		size = defaults.BufferSize
+	}
+
+	l := newMergedFile(zeekLog{def: conv.log, json: c.ZeekJSON}, bufio.NewWriterSize(w, size))
+
+	for {
+		err := r.Next(record)
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("failed to read next audit record: %w", err)
+		}
+
+		if row := conv.convert(record, uint16(c.CommunityIDSeed)); row != nil {
+			if err = l.add(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	return l.finish()
+}
@@ -0,0 +1,337 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package io
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/dreadl0ck/netcap/types"
+	"github.com/dreadl0ck/netcap/utils"
+)
+
+var zeekCommunityID = utils.CommunityIDFromStrings(0, "192.168.1.47", "53032", "165.227.109.154", "443", utils.ProtocolTCP)
+
+var zeekRecords = []proto.Message{
+	&types.Connection{
+		TimestampFirst:      1505838533449164000,
+		TransportProto:      "TCP",
+		ApplicationProto:    "TLS",
+		SrcIP:               "192.168.1.47",
+		SrcPort:             "53032",
+		DstIP:               "165.227.109.154",
+		DstPort:             "443",
+		Duration:            1500000000,
+		BytesClientToServer: 517,
+		BytesServerToClient: 4096,
+		TerminationReason:   "Closed",
+		CommunityID:         zeekCommunityID,
+	},
+	// the server hello is written before the client hello, to test merging in both orders
+	&types.TLSServerHello{
+		Timestamp:   1505838533549164000,
+		Version:     0x0303,
+		CipherSuite: 0xc02f,
+		SrcIP:       "165.227.109.154",
+		SrcPort:     443,
+		DstIP:       "192.168.1.47",
+		DstPort:     53032,
+		Ja3S:        "ja3s",
+		CommunityID: zeekCommunityID,
+	},
+	&types.TLSClientHello{
+		Timestamp:   1505838533449164000,
+		SNI:         "example.com",
+		Ja3:         "ja3",
+		SrcIP:       "192.168.1.47",
+		SrcPort:     53032,
+		DstIP:       "165.227.109.154",
+		DstPort:     443,
+		CommunityID: zeekCommunityID,
+	},
+	&types.HTTP{
+		Timestamp:   1505838533449164000,
+		Proto:       "HTTP/1.1",
+		Method:      "GET",
+		Host:        "example.com",
+		URL:         "/index.html\twith\ttabs",
+		StatusCode:  404,
+		SrcIP:       "192.168.1.47",
+		DstIP:       "165.227.109.154",
+		CommunityID: zeekCommunityID,
+	},
+	&types.DNS{
+		Timestamp: 1505838533000000000,
+		ID:        42,
+		RD:        true,
+		Questions: []*types.DNSQuestion{{Name: "example.com", Type: 1, Class: 1}},
+		SrcIP:     "192.168.1.47",
+		SrcPort:   50000,
+		DstIP:     "8.8.8.8",
+		DstPort:   53,
+	},
+	&types.DNS{
+		Timestamp: 1505838533020000000,
+		ID:        42,
+		QR:        true,
+		RD:        true,
+		RA:        true,
+		Questions: []*types.DNSQuestion{{Name: "example.com", Type: 1, Class: 1}},
+		Answers: []*types.DNSResourceRecord{
+			{Name: "example.com", Type: 1, Class: 1, TTL: 300, IP: "93.184.216.34"},
+			{Name: "example.com", Type: 1, Class: 1, TTL: 60, IP: "93.184.216.35"},
+		},
+		SrcIP:   "8.8.8.8",
+		SrcPort: 53,
+		DstIP:   "192.168.1.47",
+		DstPort: 50000,
+	},
+}
+
+// writeZeekLogs writes the test records into zeek logs in a temporary directory.
+func writeZeekLogs(t *testing.T, asJSON bool) string {
+	t.Helper()
+
+	var (
+		out     = t.TempDir()
+		writers = make(map[types.Type]AuditRecordWriter)
+		counts  = make(map[types.Type]int64)
+	)
+
+	for _, r := range zeekRecords {
+		ncType := types.Type(types.Type_value["NC_"+reflect.TypeOf(r).Elem().Name()])
+		if _, ok := writers[ncType]; !ok {
+			// the type is only passed to the header, like for the gopacket decoders
+			writers[ncType] = NewAuditRecordWriter(&WriterConfig{
+				Zeek:     true,
+				ZeekJSON: asJSON,
+				Name:     strings.TrimPrefix(ncType.String(), "NC_"),
+				Out:      out,
+			})
+
+			if err := writers[ncType].WriteHeader(ncType); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if err := writers[ncType].Write(r); err != nil {
+			t.Fatal(err)
+		}
+
+		counts[ncType]++
+	}
+
+	for typ, w := range writers {
+		w.Close(counts[typ])
+	}
+
+	return out
+}
+
+// readZeekLog returns the header lines and the rows of a TSV zeek log as maps from field name to value.
+func readZeekLog(t *testing.T, path string) (header map[string]string, rows []map[string]string) {
+	t.Helper()
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var (
+		fields []string
+		s      = bufio.NewScanner(f)
+	)
+
+	header = make(map[string]string)
+
+	for s.Scan() {
+		line := s.Text()
+		if strings.HasPrefix(line, "#") {
+			arr := strings.SplitN(line, "\t", 2)
+			if len(arr) == 2 {
+				header[arr[0]] = arr[1]
+			}
+
+			if arr[0] == "#fields" {
+				fields = strings.Split(arr[1], "\t")
+			}
+
+			continue
+		}
+
+		values := strings.Split(line, "\t")
+		if len(values) != len(fields) {
+			t.Fatal("unexpected number of values", len(values), len(fields), line)
+		}
+
+		row := make(map[string]string, len(fields))
+		for i, name := range fields {
+			row[name] = values[i]
+		}
+
+		rows = append(rows, row)
+	}
+
+	return header, rows
+}
+
+func TestZeekWriter(t *testing.T) {
+	out := writeZeekLogs(t, false)
+
+	header, conns := readZeekLog(t, filepath.Join(out, zeekDir, "conn.log"))
+	if header["#path"] != "conn" || !strings.HasPrefix(header["#fields"], "ts\tuid\tid.orig_h\tid.orig_p\tid.resp_h\tid.resp_p\tproto") ||
+		!strings.HasPrefix(header["#types"], "time\tstring\taddr\tport\taddr\tport\tenum") || header["#close"] == "" {
+		t.Fatal("unexpected header", header)
+	}
+
+	if len(conns) != 1 {
+		t.Fatal("unexpected number of connections", len(conns))
+	}
+
+	conn := conns[0]
+	if conn["ts"] != "1505838533.449164" || conn["proto"] != "tcp" || conn["service"] != "tls" || conn["duration"] != "1.500000" ||
+		conn["orig_bytes"] != "517" || conn["resp_bytes"] != "4096" || conn["conn_state"] != "SF" || conn["history"] != zeekUnsetField {
+		t.Error("unexpected connection", conn)
+	}
+
+	uid := conn["uid"]
+	if !strings.HasPrefix(uid, "C") || len(uid) < 10 {
+		t.Fatal("unexpected uid", uid)
+	}
+
+	_, ssl := readZeekLog(t, filepath.Join(out, zeekDir, "ssl.log"))
+	if len(ssl) != 1 {
+		t.Fatal("expected the hellos to be merged into one row", ssl)
+	}
+
+	// all logs of a connection share its uid
+	if s := ssl[0]; s["uid"] != uid || s["ts"] != "1505838533.449164" || s["id.orig_h"] != "192.168.1.47" || s["id.resp_p"] != "443" ||
+		s["version"] != "TLSv12" || s["cipher"] != "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256" || s["server_name"] != "example.com" ||
+		s["established"] != "T" || s["ja3"] != "ja3" || s["ja3s"] != "ja3s" {
+		t.Error("unexpected ssl row", s)
+	}
+
+	_, http := readZeekLog(t, filepath.Join(out, zeekDir, "http.log"))
+	if len(http) != 1 {
+		t.Fatal("unexpected number of http rows", len(http))
+	}
+
+	if h := http[0]; h["uid"] != uid || h["uri"] != `/index.html\x09with\x09tabs` || h["version"] != "1.1" ||
+		h["status_code"] != "404" || h["status_msg"] != "NOT FOUND" || h["tags"] != zeekEmptyField || h["id.orig_p"] != zeekUnsetField {
+		t.Error("unexpected http row", h)
+	}
+
+	_, dns := readZeekLog(t, filepath.Join(out, zeekDir, "dns.log"))
+	if len(dns) != 1 {
+		t.Fatal("expected the query and response to be merged into one row", dns)
+	}
+
+	if d := dns[0]; d["ts"] != "1505838533.000000" || d["rtt"] != "0.020000" || d["id.orig_h"] != "192.168.1.47" || d["id.resp_p"] != "53" ||
+		d["query"] != "example.com" || d["qtype_name"] != "A" || d["qclass_name"] != "C_INTERNET" || d["rcode_name"] != "NOERROR" ||
+		d["answers"] != "93.184.216.34,93.184.216.35" || d["TTLs"] != "300.000000,60.000000" || d["RA"] != "T" || d["trans_id"] != "42" {
+		t.Error("unexpected dns row", d)
+	}
+
+	// no audit records, no log
+	if _, err := os.Stat(filepath.Join(out, zeekDir, "ssh.log")); !os.IsNotExist(err) {
+		t.Error("expected no ssh log", err)
+	}
+}
+
+func TestZeekWriterJSON(t *testing.T) {
+	out := writeZeekLogs(t, true)
+
+	data, err := os.ReadFile(filepath.Join(out, zeekDir, "dns.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var row map[string]interface{}
+	if err = json.Unmarshal(data, &row); err != nil {
+		t.Fatal(err, string(data))
+	}
+
+	if row["ts"] != 1505838533.0 || row["query"] != "example.com" || row["RA"] != true || row["id.resp_p"] != 53.0 || row["rejected"] != false {
+		t.Error("unexpected dns row", row)
+	}
+
+	if answers, ok := row["answers"].([]interface{}); !ok || len(answers) != 2 {
+		t.Error("unexpected answers", row["answers"])
+	}
+
+	if _, ok := row["history"]; ok {
+		t.Error("unset fields must be omitted")
+	}
+}
+
+func TestZeekID(t *testing.T) {
+	if a, b := zeekID("C", "1:abc"), zeekID("C", "1:abc"); a != b {
+		t.Error("identifiers must be deterministic", a, b)
+	}
+
+	if a, b := zeekID("C", "1:abc"), zeekID("C", "1:abd"); a == b {
+		t.Error("identifiers must differ", a, b)
+	}
+}
+
+func TestZeekSoftware(t *testing.T) {
+	r := zeekSoftware(&types.Software{
+		Timestamp: 1505838533449164000,
+		Product:   "OpenSSH",
+		Version:   "7.4p1",
+		Service:   "SSH",
+		Flows:     []string{"192.168.1.47:53032->165.227.109.154:22"},
+	}, 0)
+
+	for field, expected := range map[string]string{
+		"host":             "192.168.1.47",
+		"host_p":           "53032",
+		"software_type":    "SSH",
+		"name":             "OpenSSH",
+		"version.major":    "7",
+		"version.minor":    "4",
+		"version.minor2":   zeekUnsetField,
+		"version.addl":     "p1",
+		"unparsed_version": "7.4p1",
+	} {
+		i := zeekSoftwareLog.index[field]
+		if v := formatZeekField(zeekSoftwareLog.fields[i].typ, r.values[i]); v != expected {
+			t.Errorf("unexpected value for %s: %s, expected %s", field, v, expected)
+		}
+	}
+}
+
+func TestZeekSSH(t *testing.T) {
+	client := zeekSSH(&types.SSH{Flow: "192.168.1.47:53032->165.227.109.154:22", Ident: "SSH-2.0-OpenSSH_7.4", HASSH: "client", IsClient: true}, 0)
+	server := zeekSSH(&types.SSH{Flow: "165.227.109.154:22->192.168.1.47:53032", Ident: "SSH-2.0-OpenSSH_8.0", HASSH: "server"}, 0)
+
+	if client.key == "" || client.key != server.key || client.part|server.part != zeekSSHLog.parts {
+		t.Fatal("client and server must be merged", client.key, server.key)
+	}
+
+	zeekSSHLog.merge(client, server)
+
+	if client.get("client") != "SSH-2.0-OpenSSH_7.4" || client.get("server") != "SSH-2.0-OpenSSH_8.0" ||
+		client.get("hassh") != "client" || client.get("hasshServer") != "server" || client.get("id.resp_p") != int64(22) {
+		t.Error("unexpected ssh row", client.values)
+	}
+}
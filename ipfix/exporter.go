@@ -0,0 +1,261 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package ipfix
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// DefaultMaxMessageSize keeps the messages below the common path MTU, to avoid fragmentation when sent over UDP.
+const DefaultMaxMessageSize = 1400
+
+// maximum size of a message, limited by the 16 bit length field of the IPFIX header.
+const maxMessageSize = 65535
+
+// ExporterConfig configures an Exporter.
+type ExporterConfig struct {
+	// Version of the exported messages
+	Version Version
+
+	// ObservationDomainID is the observation domain for IPFIX and the source id for NetFlow version 9
+	ObservationDomainID uint32
+
+	// MaxMessageSize is the maximum size of a message in bytes, DefaultMaxMessageSize if not set
+	MaxMessageSize int
+
+	// TemplateRefresh resends the templates every N messages, they are only sent with the first message if not set
+	TemplateRefresh int
+}
+
+// Exporter collects data records and writes them as messages to the underlying writer,
+// each message is passed to a single Write call, so that a UDP connection sends one datagram per message.
+// The exporter is not safe for concurrent use.
+type Exporter struct {
+	w io.Writer
+	c ExporterConfig
+
+	templates []*Template
+
+	// template set of the version, sent with the first message and on every refresh
+	templateSet []byte
+
+	// data records waiting to be sent, by template id, in the order of the first record
+	sets       map[uint16][]byte
+	order      []uint16
+	numPending int
+
+	numMessages int
+
+	// number of data records for IPFIX, number of messages for NetFlow version 9
+	sequence uint32
+
+	// start of the exporter, used for the system uptime of NetFlow version 9
+	start time.Time
+
+	// returns the export time, can be replaced for testing
+	now func() time.Time
+}
+
+// NewExporter returns an exporter writing messages with the templates to w.
+func NewExporter(w io.Writer, c ExporterConfig, templates ...*Template) (*Exporter, error) {
+	if _, err := ParseVersion(int(c.Version)); err != nil {
+		return nil, err
+	}
+
+	if c.MaxMessageSize <= 0 {
+		c.MaxMessageSize = DefaultMaxMessageSize
+	}
+
+	if c.MaxMessageSize > maxMessageSize {
+		c.MaxMessageSize = maxMessageSize
+	}
+
+	e := &Exporter{
+		w:         w,
+		c:         c,
+		templates: templates,
+		sets:      make(map[uint16][]byte),
+		start:     time.Now(),
+		now:       time.Now,
+	}
+
+	var records []byte
+	for _, t := range templates {
+		records = t.appendTemplate(records, c.Version)
+	}
+
+	setID := ipfixTemplateSetID
+	if c.Version == NetFlow9 {
+		setID = netflow9TemplateSetID
+	}
+
+	e.templateSet = e.appendSet(nil, setID, records)
+
+	if e.headerSize()+len(e.templateSet) > c.MaxMessageSize {
+		return nil, fmt.Errorf("%w: the templates need %d bytes", errRecordTooLarge, len(e.templateSet))
+	}
+
+	return e, nil
+}
+
+// Add encodes a data record with one value per field of the template,
+// a message is written if the record does not fit into the pending message.
+func (e *Exporter) Add(t *Template, values ...interface{}) error {
+	record, err := t.appendRecord(nil, e.c.Version, values)
+	if err != nil {
+		return err
+	}
+
+	if e.size()+len(record)+setHeaderSize+3 > e.c.MaxMessageSize {
+		if e.numPending == 0 {
+			return fmt.Errorf("%w: %d bytes", errRecordTooLarge, len(record))
+		}
+
+		if err = e.Flush(); err != nil {
+			return err
+		}
+
+		if e.size()+len(record)+setHeaderSize+3 > e.c.MaxMessageSize {
+			return fmt.Errorf("%w: %d bytes", errRecordTooLarge, len(record))
+		}
+	}
+
+	if _, ok := e.sets[t.ID]; !ok {
+		e.order = append(e.order, t.ID)
+	}
+
+	e.sets[t.ID] = append(e.sets[t.ID], record...)
+	e.numPending++
+
+	return nil
+}
+
+// Flush writes the pending data records as a message.
+func (e *Exporter) Flush() error {
+	if e.numPending == 0 {
+		return nil
+	}
+
+	var (
+		now       = e.now()
+		templates = e.sendTemplates()
+		msg       = make([]byte, e.headerSize(), e.size())
+		count     = e.numPending
+	)
+
+	if templates {
+		msg = append(msg, e.templateSet...)
+		count += len(e.templates)
+	}
+
+	for _, id := range e.order {
+		msg = e.appendSet(msg, id, e.sets[id])
+	}
+
+	// the header is written last, when the length of the message is known
+	switch e.c.Version {
+	case IPFIX:
+		putUint16(msg[0:], uint16(IPFIX))
+		putUint16(msg[2:], uint16(len(msg)))
+		putUint32(msg[4:], uint32(now.Unix()))
+		putUint32(msg[8:], e.sequence)
+		putUint32(msg[12:], e.c.ObservationDomainID)
+
+		e.sequence += uint32(e.numPending)
+	case NetFlow9:
+		putUint16(msg[0:], uint16(NetFlow9))
+		putUint16(msg[2:], uint16(count))
+		putUint32(msg[4:], uint32(now.Sub(e.start).Milliseconds()))
+		putUint32(msg[8:], uint32(now.Unix()))
+		putUint32(msg[12:], e.sequence)
+		putUint32(msg[16:], e.c.ObservationDomainID)
+
+		e.sequence++
+	}
+
+	e.numMessages++
+	e.numPending = 0
+	e.order = e.order[:0]
+
+	for id := range e.sets {
+		delete(e.sets, id)
+	}
+
+	_, err := e.w.Write(msg)
+
+	return err
+}
+
+// sendTemplates returns whether the templates are sent with the next message.
+func (e *Exporter) sendTemplates() bool {
+	if e.numMessages == 0 {
+		return true
+	}
+
+	return e.c.TemplateRefresh > 0 && e.numMessages%e.c.TemplateRefresh == 0
+}
+
+// size returns the size of the pending message.
+func (e *Exporter) size() int {
+	size := e.headerSize()
+
+	if e.sendTemplates() {
+		size += len(e.templateSet)
+	}
+
+	for _, id := range e.order {
+		size += setHeaderSize + len(e.sets[id]) + e.padding(len(e.sets[id]))
+	}
+
+	return size
+}
+
+func (e *Exporter) headerSize() int {
+	if e.c.Version == NetFlow9 {
+		return netflow9HeaderSize
+	}
+
+	return ipfixHeaderSize
+}
+
+// padding returns the number of bytes to align a NetFlow version 9 set to 32 bits,
+// IPFIX sets are not padded.
+func (e *Exporter) padding(n int) int {
+	if e.c.Version != NetFlow9 {
+		return 0
+	}
+
+	return (4 - (setHeaderSize+n)%4) % 4
+}
+
+// appendSet appends a set with the records.
+func (e *Exporter) appendSet(b []byte, id uint16, records []byte) []byte {
+	padding := e.padding(len(records))
+
+	b = appendUint16(b, id)
+	b = appendUint16(b, uint16(setHeaderSize+len(records)+padding))
+	b = append(b, records...)
+
+	return append(b, make([]byte, padding)...)
+}
+
+func putUint16(b []byte, v uint16) {
+	b[0], b[1] = byte(v>>8), byte(v)
+}
+
+func putUint32(b []byte, v uint32) {
+	b[0], b[1], b[2], b[3] = byte(v>>24), byte(v>>16), byte(v>>8), byte(v)
+}
@@ -0,0 +1,251 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+// Package ipfix implements an exporter for IPFIX (RFC 7011) and NetFlow version 9 (RFC 3954) messages.
+//
+// Records are described by templates, which are sent at the start of the export
+// and repeated periodically, so that collectors receiving the messages over UDP can recover from losses.
+// Enterprise-specific information elements and variable length fields are only supported by IPFIX,
+// they are left out of the templates for NetFlow version 9.
+package ipfix
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+)
+
+// Version is the protocol version of the exported messages.
+type Version uint16
+
+// Supported versions.
+const (
+	NetFlow9 Version = 9
+	IPFIX    Version = 10
+)
+
+// set ids of the template sets.
+const (
+	netflow9TemplateSetID uint16 = 0
+	ipfixTemplateSetID    uint16 = 2
+
+	// the first id that can be used for templates
+	minTemplateID uint16 = 256
+)
+
+// header sizes.
+const (
+	ipfixHeaderSize    = 16
+	netflow9HeaderSize = 20
+	setHeaderSize      = 4
+)
+
+// VariableLength is the length of fields whose values are prefixed by their length, only supported by IPFIX.
+const VariableLength uint16 = 65535
+
+// enterpriseBit marks information elements with an enterprise number.
+const enterpriseBit uint16 = 0x8000
+
+// ReverseEnterpriseNumber is used for the reverse direction of biflows, see RFC 5103.
+const ReverseEnterpriseNumber uint32 = 29305
+
+var (
+	errInvalidVersion    = errors.New("invalid ipfix version")
+	errInvalidTemplateID = errors.New("invalid template id")
+	errNumValues         = errors.New("number of values does not match the template")
+	errValueType         = errors.New("unsupported value type")
+	errRecordTooLarge    = errors.New("record exceeds the maximum message size")
+)
+
+// ParseVersion returns the version for 9 or 10.
+func ParseVersion(v int) (Version, error) {
+	switch Version(v) {
+	case NetFlow9, IPFIX:
+		return Version(v), nil
+	default:
+		return 0, fmt.Errorf("%w: %d, supported versions are 9 and 10", errInvalidVersion, v)
+	}
+}
+
+// Field is an information element of a template.
+type Field struct {
+	// ID of the information element
+	ID uint16
+
+	// Length of the value in bytes, or VariableLength
+	Length uint16
+
+	// EnterpriseNumber is set for enterprise-specific information elements
+	EnterpriseNumber uint32
+}
+
+// Template describes the fields of the data records with the template ID.
+type Template struct {
+	ID     uint16
+	Fields []Field
+}
+
+// NewTemplate returns a template with the given id and fields.
+func NewTemplate(id uint16, fields ...Field) (*Template, error) {
+	if id < minTemplateID {
+		return nil, fmt.Errorf("%w: %d, template ids start at %d", errInvalidTemplateID, id, minTemplateID)
+	}
+
+	return &Template{
+		ID:     id,
+		Fields: fields,
+	}, nil
+}
+
+// supported returns whether the field can be exported with the version.
+func (f Field) supported(v Version) bool {
+	return v == IPFIX || (f.EnterpriseNumber == 0 && f.Length != VariableLength)
+}
+
+// appendTemplate appends the template record for the version.
+func (t *Template) appendTemplate(b []byte, v Version) []byte {
+	var fields []Field
+
+	for _, f := range t.Fields {
+		if f.supported(v) {
+			fields = append(fields, f)
+		}
+	}
+
+	b = appendUint16(b, t.ID)
+	b = appendUint16(b, uint16(len(fields)))
+
+	for _, f := range fields {
+		if f.EnterpriseNumber != 0 {
+			b = appendUint16(b, f.ID|enterpriseBit)
+			b = appendUint16(b, f.Length)
+			b = appendUint32(b, f.EnterpriseNumber)
+
+			continue
+		}
+
+		b = appendUint16(b, f.ID)
+		b = appendUint16(b, f.Length)
+	}
+
+	return b
+}
+
+// appendRecord appends the data record for the version, with one value per field of the template.
+// Values are encoded according to their type:
+//   - unsigned integers in network byte order, truncated to the length of the field
+//   - net.IP as IPv4 or IPv6 address, depending on the length of the field
+//   - net.HardwareAddr and []byte as they are, padded with zeros to the length of the field
+//   - strings as UTF-8, prefixed with their length for variable length fields
+func (t *Template) appendRecord(b []byte, v Version, values []interface{}) ([]byte, error) {
+	if len(values) != len(t.Fields) {
+		return nil, fmt.Errorf("%w: template %d has %d fields, got %d values", errNumValues, t.ID, len(t.Fields), len(values))
+	}
+
+	for i, f := range t.Fields {
+		if !f.supported(v) {
+			continue
+		}
+
+		var data []byte
+
+		switch val := values[i].(type) {
+		case uint64:
+			data = appendUint64(nil, val)
+		case uint32:
+			data = appendUint32(nil, val)
+		case uint16:
+			data = appendUint16(nil, val)
+		case uint8:
+			data = []byte{val}
+		case net.IP:
+			if f.Length == 4 {
+				data = val.To4()
+			} else {
+				data = val.To16()
+			}
+		case net.HardwareAddr:
+			data = val
+		case []byte:
+			data = val
+		case string:
+			data = []byte(val)
+		default:
+			return nil, fmt.Errorf("%w: %T for field %d of template %d", errValueType, val, f.ID, t.ID)
+		}
+
+		b = appendValue(b, f, data, isUnsigned(values[i]))
+	}
+
+	return b, nil
+}
+
+// appendValue appends the data with the length of the field.
+func appendValue(b []byte, f Field, data []byte, unsigned bool) []byte {
+	if f.Length == VariableLength {
+		if len(data) > 65535 {
+			data = data[:65535]
+		}
+
+		if len(data) < 255 {
+			b = append(b, byte(len(data)))
+		} else {
+			b = append(b, 255)
+			b = appendUint16(b, uint16(len(data)))
+		}
+
+		return append(b, data...)
+	}
+
+	length := int(f.Length)
+
+	switch {
+	case len(data) == length:
+		return append(b, data...)
+	case unsigned && len(data) > length:
+		// reduced size encoding keeps the least significant bytes
+		return append(b, data[len(data)-length:]...)
+	case unsigned:
+		b = append(b, make([]byte, length-len(data))...)
+
+		return append(b, data...)
+	case len(data) > length:
+		return append(b, data[:length]...)
+	default:
+		b = append(b, data...)
+
+		return append(b, make([]byte, length-len(data))...)
+	}
+}
+
+func isUnsigned(v interface{}) bool {
+	switch v.(type) {
+	case uint64, uint32, uint16, uint8:
+		return true
+	default:
+		return false
+	}
+}
+
+func appendUint16(b []byte, v uint16) []byte {
+	return append(b, byte(v>>8), byte(v))
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	return binary.BigEndian.AppendUint32(b, v)
+}
+
+func appendUint64(b []byte, v uint64) []byte {
+	return binary.BigEndian.AppendUint64(b, v)
+}
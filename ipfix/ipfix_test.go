@@ -0,0 +1,286 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package ipfix
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// messageRecorder keeps the messages passed to each Write call.
+type messageRecorder struct {
+	messages [][]byte
+}
+
+func (m *messageRecorder) Write(b []byte) (int, error) {
+	m.messages = append(m.messages, append([]byte(nil), b...))
+
+	return len(b), nil
+}
+
+// set is a decoded set of a message.
+type set struct {
+	id   uint16
+	data []byte
+}
+
+// splitMessage checks the header of the message and returns its sets.
+func splitMessage(t *testing.T, v Version, msg []byte) (header []byte, sets []set) {
+	t.Helper()
+
+	size := ipfixHeaderSize
+	if v == NetFlow9 {
+		size = netflow9HeaderSize
+	}
+
+	if binary.BigEndian.Uint16(msg) != uint16(v) {
+		t.Fatal("unexpected version", binary.BigEndian.Uint16(msg))
+	}
+
+	if v == IPFIX && int(binary.BigEndian.Uint16(msg[2:])) != len(msg) {
+		t.Fatal("unexpected message length", binary.BigEndian.Uint16(msg[2:]), len(msg))
+	}
+
+	header, msg = msg[:size], msg[size:]
+
+	for len(msg) > 0 {
+		length := int(binary.BigEndian.Uint16(msg[2:]))
+		if length < setHeaderSize || length > len(msg) {
+			t.Fatal("invalid set length", length)
+		}
+
+		if v == NetFlow9 && length%4 != 0 {
+			t.Fatal("netflow v9 set is not padded", length)
+		}
+
+		sets = append(sets, set{id: binary.BigEndian.Uint16(msg), data: msg[setHeaderSize:length]})
+		msg = msg[length:]
+	}
+
+	return header, sets
+}
+
+func testTemplate(t *testing.T) *Template {
+	t.Helper()
+
+	tmpl, err := NewTemplate(256,
+		Field{ID: 8, Length: 4},
+		Field{ID: 7, Length: 2},
+		Field{ID: 1, Length: 8},
+		Field{ID: 56, Length: 6},
+		Field{ID: 1, Length: VariableLength, EnterpriseNumber: 32473},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tmpl
+}
+
+func TestExporterIPFIX(t *testing.T) {
+	var (
+		rec  = &messageRecorder{}
+		tmpl = testTemplate(t)
+		mac  = net.HardwareAddr{0, 1, 2, 3, 4, 5}
+	)
+
+	e, err := NewExporter(rec, ExporterConfig{Version: IPFIX, ObservationDomainID: 7}, tmpl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e.now = func() time.Time { return time.Unix(1600000000, 0) }
+
+	if err = e.Add(tmpl, net.ParseIP("192.168.1.1"), uint16(443), uint64(1234), mac, "http"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = e.Add(tmpl, net.ParseIP("10.0.0.1"), uint16(80), uint32(5), mac, strings.Repeat("a", 300)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = e.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rec.messages) != 1 {
+		t.Fatal("unexpected number of messages", len(rec.messages))
+	}
+
+	header, sets := splitMessage(t, IPFIX, rec.messages[0])
+	if binary.BigEndian.Uint32(header[4:]) != 1600000000 || binary.BigEndian.Uint32(header[8:]) != 0 ||
+		binary.BigEndian.Uint32(header[12:]) != 7 {
+		t.Error("unexpected header", header)
+	}
+
+	if len(sets) != 2 || sets[0].id != ipfixTemplateSetID || sets[1].id != 256 {
+		t.Fatal("unexpected sets", sets)
+	}
+
+	expectedTemplate := []byte{
+		1, 0, 0, 5,
+		0, 8, 0, 4,
+		0, 7, 0, 2,
+		0, 1, 0, 8,
+		0, 56, 0, 6,
+		0x80, 1, 0xff, 0xff, 0, 0, 0x7e, 0xd9,
+	}
+	if !bytes.Equal(sets[0].data, expectedTemplate) {
+		t.Errorf("unexpected template %x", sets[0].data)
+	}
+
+	expectedFirst := []byte{
+		192, 168, 1, 1,
+		1, 0xbb,
+		0, 0, 0, 0, 0, 0, 0x04, 0xd2,
+		0, 1, 2, 3, 4, 5,
+		4, 'h', 't', 't', 'p',
+	}
+	if !bytes.HasPrefix(sets[1].data, expectedFirst) {
+		t.Errorf("unexpected record %x", sets[1].data)
+	}
+
+	// long strings use the three byte length prefix
+	second := sets[1].data[len(expectedFirst):]
+	if !bytes.HasPrefix(second, []byte{10, 0, 0, 1, 0, 80, 0, 0, 0, 0, 0, 0, 0, 5}) ||
+		!bytes.Equal(second[20:23], []byte{255, 1, 44}) || len(second) != 23+300 {
+		t.Errorf("unexpected record %x", second)
+	}
+
+	// the sequence number counts the data records, the templates are only sent once
+	if err = e.Add(tmpl, net.ParseIP("10.0.0.2"), uint16(80), uint64(1), mac, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = e.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	header, sets = splitMessage(t, IPFIX, rec.messages[1])
+	if binary.BigEndian.Uint32(header[8:]) != 2 || len(sets) != 1 || sets[0].id != 256 {
+		t.Error("unexpected second message", header, sets)
+	}
+}
+
+func TestExporterNetFlow9(t *testing.T) {
+	var (
+		rec  = &messageRecorder{}
+		tmpl = testTemplate(t)
+	)
+
+	e, err := NewExporter(rec, ExporterConfig{Version: NetFlow9, ObservationDomainID: 1, TemplateRefresh: 2}, tmpl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if err = e.Add(tmpl, net.ParseIP("192.168.1.1"), uint16(443), uint64(i), net.HardwareAddr{0, 1, 2, 3, 4, 5}, "not exported"); err != nil {
+			t.Fatal(err)
+		}
+
+		if err = e.Flush(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(rec.messages) != 3 {
+		t.Fatal("unexpected number of messages", len(rec.messages))
+	}
+
+	for i, msg := range rec.messages {
+		header, sets := splitMessage(t, NetFlow9, msg)
+
+		// the templates are refreshed every second message
+		numSets, count := 1, 1
+		if i%2 == 0 {
+			numSets, count = 2, 2
+
+			if sets[0].id != netflow9TemplateSetID || !bytes.Equal(sets[0].data, []byte{
+				1, 0, 0, 4,
+				0, 8, 0, 4,
+				0, 7, 0, 2,
+				0, 1, 0, 8,
+				0, 56, 0, 6,
+			}) {
+				t.Errorf("unexpected template set %x", sets[0].data)
+			}
+		}
+
+		if len(sets) != numSets || int(binary.BigEndian.Uint16(header[2:])) != count ||
+			binary.BigEndian.Uint32(header[12:]) != uint32(i) || binary.BigEndian.Uint32(header[16:]) != 1 {
+			t.Fatal("unexpected message", i, header, sets)
+		}
+
+		// the variable length enterprise field is left out
+		data := sets[len(sets)-1].data
+		if len(data) != 20 || data[13] != byte(i) {
+			t.Errorf("unexpected data set %x", data)
+		}
+	}
+}
+
+func TestExporterMaxMessageSize(t *testing.T) {
+	var (
+		rec  = &messageRecorder{}
+		tmpl = testTemplate(t)
+	)
+
+	e, err := NewExporter(rec, ExporterConfig{Version: IPFIX, MaxMessageSize: 200}, tmpl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 20; i++ {
+		if err = e.Add(tmpl, net.ParseIP("192.168.1.1"), uint16(443), uint64(i), net.HardwareAddr{}, "value"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = e.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	var numRecords int
+
+	for _, msg := range rec.messages {
+		if len(msg) > 200 {
+			t.Error("message exceeds the maximum size", len(msg))
+		}
+
+		_, sets := splitMessage(t, IPFIX, msg)
+		numRecords += len(sets[len(sets)-1].data) / 26
+	}
+
+	if len(rec.messages) < 2 || numRecords != 20 {
+		t.Error("unexpected number of messages or records", len(rec.messages), numRecords)
+	}
+
+	if err = e.Add(tmpl, net.ParseIP("192.168.1.1"), uint16(443), uint64(1), net.HardwareAddr{}, strings.Repeat("a", 300)); err == nil {
+		t.Error("expected an error for a record exceeding the maximum size")
+	}
+}
+
+func TestNewTemplate(t *testing.T) {
+	if _, err := NewTemplate(255); err == nil {
+		t.Error("expected an error for a reserved template id")
+	}
+
+	if _, err := ParseVersion(5); err == nil {
+		t.Error("expected an error for netflow version 5")
+	}
+}
@@ -42,6 +42,14 @@ type assemblerOptions struct {
 	// particular connection, the smallest sequence number will be flushed, along
 	// with any contiguous data.  If <= 0, this is ignored.
 	MaxBufferedPagesPerConnection int
+	// OverlapPolicies selects how segments overlapping buffered data are resolved,
+	// based on the receiver of the segment.  If nil, the most recent data is used.
+	OverlapPolicies *OverlapPolicies
+	// OnInconsistentRetransmission is called when a segment overlaps buffered data
+	// with different bytes.  If nil, inconsistent retransmissions are not reported.
+	// Data that has already been passed to the Stream is not kept for comparison,
+	// segments overlapping it are trimmed without being checked.
+	OnInconsistentRetransmission func(r *InconsistentRetransmission)
 }
 
 // Assembler handles reassembling TCP streams.  It is not safe for
@@ -99,6 +107,12 @@ type assemblerOptions struct {
 // is done there, then very little allocation is done ever, mostly to handle
 // large increases in bandwidth or numbers of connections.
 //
+// The memory used for buffered pages and connections by all Assemblers of the
+// StreamPools sharing a MemoryBudget can be limited with MemoryBudget.SetLimit.
+// Each Assembler only flushes and closes connections of its own pool, once the
+// pool holds more than its share of the budget, in the order given by the
+// configured EvictionPolicy.
+//
 // TODO:  The page caches used by an Assembler will grow to the size necessary
 // to handle a workload, and currently will never shrink.  This means that
 // traffic spikes can result in large memory usage which isn't garbage
@@ -112,6 +126,9 @@ type Assembler struct {
 	cacheLP  livePacket
 	cacheSG  reassemblyObject
 	start    bool
+
+	// transport flow of the packet that is currently assembled
+	tcpFlow gopacket.Flow
 }
 
 // NewAssembler creates a new assembler.  Pass in the StreamPool
@@ -126,7 +143,7 @@ func NewAssembler(pool *StreamPool) *Assembler {
 
 	return &Assembler{
 		ret:              make([]byteContainer, 0, assemblerReturnValueInitialSize),
-		pc:               newPageCache(),
+		pc:               newPageCache(pool),
 		connPool:         pool,
 		assemblerOptions: defaultAssemblerOptions,
 	}
@@ -140,11 +157,23 @@ func (a *Assembler) Dump() string {
 	return s
 }
 
+// StreamPool returns the StreamPool used by the assembler.
+func (a *Assembler) StreamPool() *StreamPool {
+	return a.connPool
+}
+
 // AssemblerContext provides method to get metadata.
 type AssemblerContext interface {
 	GetCaptureInfo() gopacket.CaptureInfo
 }
 
+// TunnelContext can be implemented by an AssemblerContext for decapsulated packets,
+// the tunnel identifier becomes part of the connection key, so that flows with identical addresses
+// in different virtual networks are reassembled separately.
+type TunnelContext interface {
+	TunnelID() uint64
+}
+
 // Implements AssemblerContext for Assemble().
 type assemblerSimpleContext gopacket.CaptureInfo
 
@@ -183,9 +212,16 @@ func (a *Assembler) AssembleWithContext(netFlow gopacket.Flow, t *layers.TCP, ac
 		conn    *connection
 		half    *halfconnection
 		rev     *halfconnection
-		flowKey = &key{netFlow, t.TransportFlow()}
+		flowKey = &key{net: netFlow, transport: t.TransportFlow()}
 	)
 
+	if tc, ok := ac.(TunnelContext); ok {
+		flowKey.tunnel = tc.TunnelID()
+	}
+
+	// evict connections before locking the connection for this packet
+	a.enforceMemoryBudget()
+
 	// RACE
 	a.Lock()
 	a.ret = a.ret[:0]
@@ -203,6 +239,8 @@ func (a *Assembler) AssembleWithContext(netFlow gopacket.Flow, t *layers.TCP, ac
 	conn.mu.Lock()
 	defer conn.mu.Unlock()
 
+	a.tcpFlow = flowKey.transport
+
 	if half.lastSeen.Before(ac.GetCaptureInfo().Timestamp) {
 		half.lastSeen = ac.GetCaptureInfo().Timestamp
 	}
@@ -314,17 +352,18 @@ func (a *Assembler) AssembleWithContext(netFlow gopacket.Flow, t *layers.TCP, ac
 //  - new packet overlaps existing queued packets:
 //	a) consider "age" by timestamp (TODO)
 //	b) consider "age" by being present
-//	Then
+//	Then, depending on the OverlapPolicy for the receiver
 //      1) discard new overlapping part
 //      2) overwrite queued part
 
 func (a *Assembler) checkOverlap(half *halfconnection, queue bool, ac AssemblerContext) {
 	var (
-		next  *page
-		cur   = half.last
-		bytes = a.cacheLP.bytes
-		start = a.cacheLP.seq
-		end   = start.add(len(bytes))
+		next   *page
+		cur    = half.last
+		bytes  = a.cacheLP.bytes
+		start  = a.cacheLP.seq
+		end    = start.add(len(bytes))
+		copied bool
 	)
 
 	a.dump("before checkOverlap", half)
@@ -358,6 +397,9 @@ func (a *Assembler) checkOverlap(half *halfconnection, queue bool, ac AssemblerC
 			break
 		}
 
+		// apply the overlap policy and report inconsistent retransmissions
+		bytes = a.resolveOverlap(half, cur, start, bytes, &copied, ac)
+
 		var (
 			diffStart = start.difference(cur.seq)
 			diffEnd   = end.difference(curEnd)
@@ -0,0 +1,360 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package reassembly
+
+import (
+	"errors"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+)
+
+// EvictionPolicy determines which connections are closed first,
+// when a MemoryBudget is exceeded.
+type EvictionPolicy int
+
+// Eviction policies.
+const (
+	// EvictOldest closes the connections that have been created first.
+	EvictOldest EvictionPolicy = iota
+
+	// EvictLargest closes the connections with the most buffered data first.
+	EvictLargest
+
+	// EvictLeastRecentlyActive closes the connections that have not seen a packet for the longest time first.
+	EvictLeastRecentlyActive
+)
+
+var evictionPolicyNames = map[EvictionPolicy]string{
+	EvictOldest:              "oldest",
+	EvictLargest:             "largest",
+	EvictLeastRecentlyActive: "lru",
+}
+
+// ErrInvalidEvictionPolicy is returned when parsing an unknown eviction policy.
+var ErrInvalidEvictionPolicy = errors.New("invalid eviction policy")
+
+// String returns the name of the eviction policy.
+func (p EvictionPolicy) String() string {
+	if name, ok := evictionPolicyNames[p]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+// ParseEvictionPolicy returns the policy for the given name.
+// An empty name selects EvictOldest.
+func ParseEvictionPolicy(name string) (EvictionPolicy, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return EvictOldest, nil
+	}
+
+	for p, n := range evictionPolicyNames {
+		if n == name {
+			return p, nil
+		}
+	}
+
+	return EvictOldest, ErrInvalidEvictionPolicy
+}
+
+// memory accounted for a buffered page and for the state of a connection.
+// The memory used by the Stream implementations is not included.
+var (
+	pageMemory       = int64(unsafe.Sizeof(page{}))
+	connectionMemory = int64(unsafe.Sizeof(connection{}))
+)
+
+// once the budget is exceeded, connections are evicted until the usage drops below this percentage.
+const evictionLowWatermark = 90
+
+// MemoryStats contains the memory accounting of a MemoryBudget.
+type MemoryStats struct {
+	// bytes currently accounted for buffered pages and connections
+	UsedBytes int64
+
+	// number of pages in use by all assemblers sharing the budget
+	BufferedPages int64
+
+	// number of live connections
+	Connections int64
+
+	// bytes released by evicting connections
+	EvictedBytes int64
+
+	// number of connections that were closed to stay within the budget
+	ForcedCloses int64
+}
+
+// MemoryBudget limits the memory used by all assemblers of the StreamPools sharing it.
+// Once the budget is exceeded, each pool that holds more than its share of the budget
+// is asked to evict connections, by the assemblers that own the pool.
+// Connections are never flushed by an assembler of another pool,
+// so that their streams are only called by the worker the flow belongs to.
+// All counters are modified atomically.
+type MemoryBudget struct {
+	maxBytes int64
+	policy   int32
+
+	pages        int64
+	connections  int64
+	evictedBytes int64
+	forcedCloses int64
+
+	// pools sharing the budget
+	mu    sync.Mutex
+	pools map[*StreamPool]struct{}
+}
+
+// NewMemoryBudget creates a new budget without a limit.
+func NewMemoryBudget() *MemoryBudget {
+	return &MemoryBudget{
+		pools: make(map[*StreamPool]struct{}),
+	}
+}
+
+// SetLimit limits the memory used for buffered out-of-order data and connection state
+// of all pools sharing the budget to maxBytes. Once the budget is exceeded,
+// connections are flushed and closed in the order given by the eviction policy.
+// A maxBytes value <= 0 disables the limit.
+// Data that has been passed to the Stream implementations is not accounted for.
+// The limit can be changed while the budget is in use.
+func (b *MemoryBudget) SetLimit(maxBytes int64, policy EvictionPolicy) {
+	atomic.StoreInt32(&b.policy, int32(policy))
+	atomic.StoreInt64(&b.maxBytes, maxBytes)
+}
+
+// Stats returns the current memory accounting of the budget.
+func (b *MemoryBudget) Stats() MemoryStats {
+	return MemoryStats{
+		UsedBytes:     b.used(),
+		BufferedPages: atomic.LoadInt64(&b.pages),
+		Connections:   atomic.LoadInt64(&b.connections),
+		EvictedBytes:  atomic.LoadInt64(&b.evictedBytes),
+		ForcedCloses:  atomic.LoadInt64(&b.forcedCloses),
+	}
+}
+
+func (b *MemoryBudget) used() int64 {
+	return atomic.LoadInt64(&b.pages)*pageMemory + atomic.LoadInt64(&b.connections)*connectionMemory
+}
+
+func (b *MemoryBudget) add(p *StreamPool) {
+	b.mu.Lock()
+	b.pools[p] = struct{}{}
+	b.mu.Unlock()
+}
+
+func (b *MemoryBudget) remove(p *StreamPool) {
+	b.mu.Lock()
+	delete(b.pools, p)
+	b.mu.Unlock()
+}
+
+// SetMemoryBudget limits the memory used by all pools sharing the budget of p, see MemoryBudget.SetLimit.
+// The budget is shared with all shards of the pool.
+func (p *StreamPool) SetMemoryBudget(maxBytes int64, policy EvictionPolicy) {
+	p.budget.SetLimit(maxBytes, policy)
+}
+
+// UseMemoryBudget replaces the budget of the pool with b, which may be shared with the pools of other collectors.
+// Shards created afterwards share b as well.
+// It must be called before the pool is used by any assembler.
+func (p *StreamPool) UseMemoryBudget(b *MemoryBudget) {
+	p.budget.remove(p)
+	p.budget = b
+	b.add(p)
+}
+
+// ReleaseMemoryBudget removes the pool from its budget,
+// the memory of its remaining connections is no longer accounted for.
+// It must be called once the assemblers using the pool are done, the pool must not be used afterwards.
+func (p *StreamPool) ReleaseMemoryBudget() {
+	p.budget.remove(p)
+
+	atomic.AddInt64(&p.budget.pages, -atomic.SwapInt64(&p.numPages, 0))
+	atomic.AddInt64(&p.budget.connections, -atomic.SwapInt64(&p.numConns, 0))
+}
+
+// MemoryStats returns the current memory accounting of the budget used by the pool.
+func (p *StreamPool) MemoryStats() MemoryStats {
+	return p.budget.Stats()
+}
+
+func (p *StreamPool) addPages(n int64) {
+	atomic.AddInt64(&p.numPages, n)
+	atomic.AddInt64(&p.budget.pages, n)
+}
+
+func (p *StreamPool) addConnections(n int64) {
+	atomic.AddInt64(&p.numConns, n)
+	atomic.AddInt64(&p.budget.connections, n)
+}
+
+// used returns the memory accounted for the pages and connections of the pool.
+func (p *StreamPool) used() int64 {
+	return atomic.LoadInt64(&p.numPages)*pageMemory + atomic.LoadInt64(&p.numConns)*connectionMemory
+}
+
+// share returns the part of the budget available to a single pool.
+// The budget is divided evenly among the pools that hold connections.
+func (b *MemoryBudget) share(maxBytes int64) int64 {
+	var active int64
+
+	b.mu.Lock()
+	for p := range b.pools {
+		if atomic.LoadInt64(&p.numConns) > 0 {
+			active++
+		}
+	}
+	b.mu.Unlock()
+
+	if active == 0 {
+		return maxBytes
+	}
+
+	return maxBytes / active
+}
+
+type evictionCandidate struct {
+	conn  *connection
+	key   *key
+	pages int
+	time  time.Time
+}
+
+// candidates returns the connections of the pool, in the order they should be evicted.
+func (p *StreamPool) candidates(policy EvictionPolicy) []evictionCandidate {
+	var (
+		conns      = p.connections()
+		candidates = make([]evictionCandidate, 0, len(conns))
+	)
+
+	for _, conn := range conns {
+		conn.mu.Lock()
+		c := evictionCandidate{
+			conn:  conn,
+			key:   conn.key,
+			pages: numPages(&conn.c2s) + numPages(&conn.s2c),
+		}
+
+		switch policy {
+		case EvictLeastRecentlyActive:
+			c.time = conn.lastSeen()
+		default:
+			c.time = conn.c2s.created
+		}
+		conn.mu.Unlock()
+
+		candidates = append(candidates, c)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if policy == EvictLargest {
+			return candidates[i].pages > candidates[j].pages
+		}
+
+		return candidates[i].time.Before(candidates[j].time)
+	})
+
+	return candidates
+}
+
+// enforceMemoryBudget evicts connections of the assemblers pool,
+// if the memory budget is exceeded and the pool holds more than its share of the budget.
+// It must be called without holding the lock of any connection,
+// only one assembler of a pool evicts at a time, the others continue processing.
+// Pools holding less than their share are left alone,
+// the connections of the other pools are evicted by their own assemblers once they receive a packet.
+func (a *Assembler) enforceMemoryBudget() {
+	var (
+		p        = a.connPool
+		b        = p.budget
+		maxBytes = atomic.LoadInt64(&b.maxBytes)
+	)
+
+	if maxBytes <= 0 || b.used() <= maxBytes {
+		return
+	}
+
+	share := b.share(maxBytes)
+	if p.used() <= share {
+		return
+	}
+
+	if !atomic.CompareAndSwapInt32(&p.evicting, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&p.evicting, 0)
+
+	target := share / 100 * evictionLowWatermark
+
+	for _, c := range p.candidates(EvictionPolicy(atomic.LoadInt32(&b.policy))) {
+		if p.used() <= target {
+			break
+		}
+
+		a.evict(c)
+	}
+
+	if Debug {
+		log.Printf("memory budget enforced: %+v", b.Stats())
+	}
+}
+
+// evict flushes the buffered data of the connection to its stream and closes it.
+func (a *Assembler) evict(c evictionCandidate) {
+	c.conn.mu.Lock()
+
+	// connection has been closed and reused in the meantime
+	if c.conn.key != c.key {
+		c.conn.mu.Unlock()
+
+		return
+	}
+
+	freed := int64(numPages(&c.conn.c2s)+numPages(&c.conn.s2c))*pageMemory + connectionMemory
+
+	for _, half := range []*halfconnection{&c.conn.s2c, &c.conn.c2s} {
+		for !half.closed {
+			a.skipFlush(c.conn, half)
+		}
+	}
+	c.conn.mu.Unlock()
+
+	// remove the connection even if the stream asked to keep it
+	a.connPool.remove(c.conn)
+
+	atomic.AddInt64(&a.connPool.budget.evictedBytes, freed)
+	atomic.AddInt64(&a.connPool.budget.forcedCloses, 1)
+}
+
+// numPages returns the number of pages queued or saved by the halfconnection.
+func numPages(half *halfconnection) (n int) {
+	for p := half.first; p != nil; p = p.next {
+		n++
+	}
+
+	for p := half.saved; p != nil; p = p.next {
+		n++
+	}
+
+	return n
+}
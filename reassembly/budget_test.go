@@ -0,0 +1,214 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package reassembly
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/dreadl0ck/gopacket"
+	"github.com/dreadl0ck/gopacket/layers"
+)
+
+func TestParseEvictionPolicy(t *testing.T) {
+	for name, want := range map[string]EvictionPolicy{
+		"":        EvictOldest,
+		"oldest":  EvictOldest,
+		"Largest": EvictLargest,
+		"lru":     EvictLeastRecentlyActive,
+	} {
+		p, err := ParseEvictionPolicy(name)
+		if err != nil || p != want {
+			t.Errorf("%q: want %s, got %s (%v)", name, want, p, err)
+		}
+	}
+
+	if _, err := ParseEvictionPolicy("random"); err != ErrInvalidEvictionPolicy {
+		t.Fatal("expected invalid eviction policy error, got", err)
+	}
+}
+
+// budgetPacket is an out-of-order packet for the connection from host src.
+type budgetPacket struct {
+	src     byte
+	payload int
+}
+
+func budgetFlow(src byte) gopacket.Flow {
+	flow, _ := gopacket.FlowFromEndpoints(
+		layers.NewIPEndpoint(net.IP{10, 0, 0, src}),
+		layers.NewIPEndpoint(net.IP{10, 0, 0, 255}))
+
+	return flow
+}
+
+func testMemoryBudget(t *testing.T, policy EvictionPolicy, packets []budgetPacket, remaining []byte) {
+	t.Helper()
+
+	var (
+		pool = NewStreamPool(&testFactory{})
+		a    = NewAssembler(pool)
+		ts   = time.Unix(1000, 0)
+	)
+
+	// room for three connections with a single buffered page
+	pool.SetMemoryBudget(3*(pageMemory+connectionMemory), policy)
+
+	for i, p := range packets {
+		ctx := assemblerSimpleContext(gopacket.CaptureInfo{Timestamp: ts.Add(time.Duration(i) * time.Second)})
+		a.AssembleWithContext(budgetFlow(p.src), &layers.TCP{
+			Seq:       1000,
+			BaseLayer: layers.BaseLayer{Payload: make([]byte, p.payload)},
+		}, &ctx)
+	}
+
+	stats := pool.MemoryStats()
+	if stats.UsedBytes > 3*(pageMemory+connectionMemory) {
+		t.Fatalf("memory budget exceeded: %+v", stats)
+	}
+
+	if stats.ForcedCloses == 0 || stats.EvictedBytes == 0 {
+		t.Fatalf("expected evictions: %+v", stats)
+	}
+
+	conns := pool.connections()
+	if len(conns) != len(remaining) {
+		t.Fatalf("expected %d connections, got %d", len(remaining), len(conns))
+	}
+
+	for _, src := range remaining {
+		k := key{net: budgetFlow(src), transport: (&layers.TCP{}).TransportFlow()}
+		if conn, _, _ := pool.getHalf(&k); conn == nil {
+			t.Errorf("expected connection from host %d to remain", src)
+		}
+	}
+}
+
+func TestMemoryBudgetEvictOldest(t *testing.T) {
+	testMemoryBudget(t, EvictOldest, []budgetPacket{
+		{1, 10}, {2, 10}, {3, 10}, {1, 10}, {4, 10}, {5, 0},
+	}, []byte{3, 4, 5})
+}
+
+func TestMemoryBudgetEvictLargest(t *testing.T) {
+	testMemoryBudget(t, EvictLargest, []budgetPacket{
+		{1, 10}, {2, 2 * pageBytes}, {3, 10}, {4, 10},
+	}, []byte{1, 3, 4})
+}
+
+func TestMemoryBudgetEvictLeastRecentlyActive(t *testing.T) {
+	testMemoryBudget(t, EvictLeastRecentlyActive, []budgetPacket{
+		{1, 10}, {2, 10}, {3, 10}, {1, 10}, {4, 10}, {5, 0},
+	}, []byte{1, 4, 5})
+}
+
+func TestMemoryBudgetDisabled(t *testing.T) {
+	var (
+		pool = NewStreamPool(&testFactory{})
+		a    = NewAssembler(pool)
+	)
+
+	for src := byte(1); src <= 10; src++ {
+		a.assemble(budgetFlow(src), &layers.TCP{Seq: 1000, BaseLayer: layers.BaseLayer{Payload: []byte{1}}})
+	}
+
+	stats := pool.MemoryStats()
+	if stats.Connections != 10 || stats.BufferedPages != 10 || stats.ForcedCloses != 0 {
+		t.Fatalf("unexpected memory stats: %+v", stats)
+	}
+
+	if stats.UsedBytes != 10*(pageMemory+connectionMemory) {
+		t.Fatal("unexpected memory usage", stats.UsedBytes)
+	}
+}
+
+// tunnelContext is the context of a packet decapsulated from a tunnel.
+type tunnelContext struct {
+	assemblerSimpleContext
+	tunnel uint64
+}
+
+func (c *tunnelContext) TunnelID() uint64 {
+	return c.tunnel
+}
+
+func TestTunnelKey(t *testing.T) {
+	var (
+		pool = NewStreamPool(&testFactory{})
+		a    = NewAssembler(pool)
+	)
+
+	// the same flow in two virtual networks and outside of a tunnel
+	for _, tunnel := range []uint64{0, 42 << 32, 43 << 32, 42 << 32} {
+		ctx := &tunnelContext{assemblerSimpleContext: assemblerSimpleContext(gopacket.CaptureInfo{Timestamp: time.Unix(1000, 0)}), tunnel: tunnel}
+		a.AssembleWithContext(budgetFlow(1), &layers.TCP{Seq: 1000, BaseLayer: layers.BaseLayer{Payload: []byte{1}}}, ctx)
+	}
+
+	if n := len(pool.connections()); n != 3 {
+		t.Fatal("expected a connection per tunnel, got", n)
+	}
+}
+
+func TestMemoryBudgetShared(t *testing.T) {
+	var (
+		budget = NewMemoryBudget()
+		pool   = NewStreamPool(&testFactory{})
+		other  = NewStreamPool(&testFactory{})
+		ts     = time.Unix(1000, 0)
+	)
+
+	// the pools of two collectors share the budget
+	pool.UseMemoryBudget(budget)
+	other.UseMemoryBudget(budget)
+	budget.SetLimit(4*(pageMemory+connectionMemory), EvictOldest)
+
+	assemblers := []*Assembler{
+		NewAssembler(pool.Shard()),
+		NewAssembler(other.Shard()),
+	}
+
+	// the second shard stays within its share of the budget,
+	// only the connections of the first shard are evicted, by its own assembler
+	for i, p := range []struct {
+		shard int
+		src   byte
+	}{
+		{0, 1}, {0, 2}, {0, 3}, {1, 4}, {1, 5}, {0, 6},
+	} {
+		ctx := assemblerSimpleContext(gopacket.CaptureInfo{Timestamp: ts.Add(time.Duration(i) * time.Second)})
+		assemblers[p.shard].AssembleWithContext(budgetFlow(p.src), &layers.TCP{
+			Seq:       1000,
+			BaseLayer: layers.BaseLayer{Payload: []byte{1}},
+		}, &ctx)
+	}
+
+	stats := budget.Stats()
+	if stats.UsedBytes > 4*(pageMemory+connectionMemory) || stats.ForcedCloses != 2 {
+		t.Fatalf("unexpected memory stats: %+v", stats)
+	}
+
+	for i, want := range []int{2, 2} {
+		if n := len(assemblers[i].StreamPool().connections()); n != want {
+			t.Errorf("expected %d connections in shard %d, got %d", want, i, n)
+		}
+	}
+
+	// the connections of a released pool are no longer accounted for
+	assemblers[0].StreamPool().ReleaseMemoryBudget()
+
+	if stats = budget.Stats(); stats.Connections != 2 || stats.BufferedPages != 2 {
+		t.Fatalf("unexpected memory stats after release: %+v", stats)
+	}
+}
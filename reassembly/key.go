@@ -6,15 +6,28 @@ import (
 	"github.com/dreadl0ck/gopacket"
 )
 
-type key [2]gopacket.Flow
+// key identifies a connection by its network and transport flow.
+type key struct {
+	net       gopacket.Flow
+	transport gopacket.Flow
+
+	// identifies the tunnel of decapsulated packets,
+	// to keep flows with the same addresses in different virtual networks apart.
+	tunnel uint64
+}
 
 func (k *key) String() string {
-	return fmt.Sprintf("%s:%s", k[0], k[1])
+	if k.tunnel != 0 {
+		return fmt.Sprintf("%s:%s-%d", k.net, k.transport, k.tunnel)
+	}
+
+	return fmt.Sprintf("%s:%s", k.net, k.transport)
 }
 
 func (k *key) reverse() key {
 	return key{
-		k[0].Reverse(),
-		k[1].Reverse(),
+		net:       k.net.Reverse(),
+		transport: k.transport.Reverse(),
+		tunnel:    k.tunnel,
 	}
 }
@@ -23,14 +23,18 @@ type pageCache struct {
 	pageRequests int64
 	ops          int
 	nextShrink   int
+
+	// stream pool of the assembler, accounts for the pages in use
+	pool *StreamPool
 }
 
 const initialAllocSize = 1024
 
-func newPageCache() *pageCache {
+func newPageCache(pool *StreamPool) *pageCache {
 	pc := &pageCache{
 		free:   make([]*page, 0, initialAllocSize),
 		pcSize: initialAllocSize,
+		pool:   pool,
 	}
 	pc.grow()
 	return pc
@@ -90,6 +94,7 @@ func (c *pageCache) next(ts time.Time) (p *page) {
 	p.seen = ts
 	p.bytes = p.buf[:0]
 	c.used++
+	c.pool.addPages(1)
 	if Debug {
 		log.Printf("allocator returns %s\n", p)
 	}
@@ -105,6 +110,7 @@ func (c *pageCache) next(ts time.Time) (p *page) {
 // replace replaces a page into the pageCache.
 func (c *pageCache) replace(p *page) {
 	c.used--
+	c.pool.addPages(-1)
 	if Debug {
 		log.Printf("replacing %s\n", p)
 	}
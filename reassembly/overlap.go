@@ -0,0 +1,293 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package reassembly
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/dreadl0ck/gopacket"
+)
+
+// OverlapPolicy determines which data is used when a TCP segment overlaps data that is already buffered.
+// Operating systems resolve overlaps differently, which can be abused to evade inspection.
+// Choosing the policy that matches the receiving host makes the reassembled stream match what the host sees.
+//
+// Data that has already been passed to the Stream can not be replaced anymore,
+// so overlaps with it always keep the original data, regardless of the policy.
+type OverlapPolicy int
+
+// Overlap policies, see "Target-Based TCP Stream Reassembly" (Novak, Sturges).
+const (
+	// OverlapPolicyLast always favors the data of the most recent segment.
+	OverlapPolicyLast OverlapPolicy = iota
+
+	// OverlapPolicyFirst always favors the data that was received first.
+	OverlapPolicyFirst
+
+	// OverlapPolicyBSD favors the original data, unless the new segment begins before it.
+	OverlapPolicyBSD
+
+	// OverlapPolicyLinux favors the original data, unless the new segment begins before it,
+	// or begins at the same sequence number and ends after it.
+	OverlapPolicyLinux
+
+	// OverlapPolicyWindows favors the original data, unless the new segment begins before it and completely covers it.
+	OverlapPolicyWindows
+)
+
+var overlapPolicyNames = map[OverlapPolicy]string{
+	OverlapPolicyLast:    "last",
+	OverlapPolicyFirst:   "first",
+	OverlapPolicyBSD:     "bsd",
+	OverlapPolicyLinux:   "linux",
+	OverlapPolicyWindows: "windows",
+}
+
+// ErrInvalidOverlapPolicy is returned when parsing an unknown overlap policy.
+var ErrInvalidOverlapPolicy = errors.New("invalid overlap policy")
+
+// String returns the name of the overlap policy.
+func (p OverlapPolicy) String() string {
+	if name, ok := overlapPolicyNames[p]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+// ParseOverlapPolicy returns the policy for the given name.
+func ParseOverlapPolicy(name string) (OverlapPolicy, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for p, n := range overlapPolicyNames {
+		if n == name {
+			return p, nil
+		}
+	}
+
+	return OverlapPolicyLast, ErrInvalidOverlapPolicy
+}
+
+// newDataWins decides whether the data of a new segment [start:end) replaces
+// the overlapping part of a buffered segment [curStart:curEnd).
+func (p OverlapPolicy) newDataWins(start, end, curStart, curEnd Sequence) bool {
+	var (
+		startsBefore = start.difference(curStart) > 0
+		sameStart    = start.difference(curStart) == 0
+		endsAfter    = curEnd.difference(end) > 0
+		covers       = curEnd.difference(end) >= 0
+	)
+
+	switch p {
+	case OverlapPolicyFirst:
+		return false
+	case OverlapPolicyBSD:
+		return startsBefore
+	case OverlapPolicyLinux:
+		return startsBefore || (sameStart && endsAfter)
+	case OverlapPolicyWindows:
+		return startsBefore && covers
+	default:
+		return true
+	}
+}
+
+type overlapPolicyNet struct {
+	net    *net.IPNet
+	policy OverlapPolicy
+}
+
+// OverlapPolicies selects the overlap policy based on the receiver of a segment.
+type OverlapPolicies struct {
+	// Default is used for hosts that are not contained in any of the configured networks.
+	Default OverlapPolicy
+
+	// sorted by prefix length, most specific network first
+	nets []overlapPolicyNet
+}
+
+// ParseOverlapPolicies parses a comma separated list of policies for hosts or subnets,
+// for example: "default=bsd,10.0.0.0/8=windows,192.168.1.5=linux".
+// An empty string results in OverlapPolicyLast for all hosts.
+func ParseOverlapPolicies(spec string) (*OverlapPolicies, error) {
+	o := &OverlapPolicies{
+		Default: OverlapPolicyLast,
+	}
+
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 {
+			return nil, errors.New("invalid overlap policy entry, expected target=policy: " + entry)
+		}
+
+		policy, err := ParseOverlapPolicy(parts[1])
+		if err != nil {
+			return nil, errors.New(err.Error() + ": " + parts[1])
+		}
+
+		target := strings.TrimSpace(parts[0])
+		if target == "default" {
+			o.Default = policy
+
+			continue
+		}
+
+		// single hosts are treated as networks with the full prefix length
+		if !strings.Contains(target, "/") {
+			ip := net.ParseIP(target)
+			if ip == nil {
+				return nil, errors.New("invalid overlap policy target: " + target)
+			}
+
+			if ip.To4() != nil {
+				target += "/32"
+			} else {
+				target += "/128"
+			}
+		}
+
+		_, ipNet, err := net.ParseCIDR(target)
+		if err != nil {
+			return nil, err
+		}
+
+		o.nets = append(o.nets, overlapPolicyNet{
+			net:    ipNet,
+			policy: policy,
+		})
+	}
+
+	sort.SliceStable(o.nets, func(i, j int) bool {
+		a, _ := o.nets[i].net.Mask.Size()
+		b, _ := o.nets[j].net.Mask.Size()
+
+		return a > b
+	})
+
+	return o, nil
+}
+
+// Lookup returns the overlap policy for the given host.
+func (o *OverlapPolicies) Lookup(ip net.IP) OverlapPolicy {
+	if o == nil {
+		return OverlapPolicyLast
+	}
+
+	for _, n := range o.nets {
+		if n.net.Contains(ip) {
+			return n.policy
+		}
+	}
+
+	return o.Default
+}
+
+// InconsistentRetransmission describes a segment that overlaps buffered data,
+// but carries different bytes for the same sequence numbers.
+// Only out-of-order data that is still buffered is compared, retransmissions of data
+// that has already been passed to the Stream are not detected.
+type InconsistentRetransmission struct {
+	// network and transport flows of the retransmitted segment
+	NetFlow gopacket.Flow
+	TCPFlow gopacket.Flow
+
+	// capture information of the retransmitted segment
+	CaptureInfo gopacket.CaptureInfo
+
+	// sequence number of the first overlapping byte
+	Seq Sequence
+
+	// data that was buffered and the data of the retransmitted segment for the overlapping range
+	Original      []byte
+	Retransmitted []byte
+
+	// policy that was applied to resolve the overlap
+	Policy OverlapPolicy
+
+	// whether the retransmitted data replaced the buffered data
+	Replaced bool
+}
+
+// resolveOverlap compares the new segment with the overlapping part of the buffered page cur.
+// Inconsistent retransmissions are reported and the overlap policy is applied:
+// if the buffered data takes precedence, it is copied into the new segment,
+// so that it is kept when the new segment replaces the buffered page.
+// The segment is copied before it is modified, since it points into the packet buffer.
+func (a *Assembler) resolveOverlap(half *halfconnection, cur *page, start Sequence, data []byte, copied *bool, ac AssemblerContext) []byte {
+	var (
+		diffStart      = start.difference(cur.seq)
+		offNew, offOld int
+	)
+
+	if diffStart > 0 {
+		offNew = diffStart
+	} else {
+		offOld = -diffStart
+	}
+
+	length := len(data) - offNew
+	if l := len(cur.bytes) - offOld; l < length {
+		length = l
+	}
+
+	if length <= 0 {
+		return data
+	}
+
+	var (
+		original      = cur.bytes[offOld : offOld+length]
+		retransmitted = data[offNew : offNew+length]
+	)
+
+	if bytes.Equal(original, retransmitted) {
+		return data
+	}
+
+	var (
+		policy   = a.OverlapPolicies.Lookup(net.IP(half.flow.Dst().Raw()))
+		replaced = policy.newDataWins(start, start.add(len(data)), cur.seq, cur.seq.add(len(cur.bytes)))
+	)
+
+	if a.OnInconsistentRetransmission != nil {
+		a.OnInconsistentRetransmission(&InconsistentRetransmission{
+			NetFlow:       half.flow,
+			TCPFlow:       a.tcpFlow,
+			CaptureInfo:   ac.GetCaptureInfo(),
+			Seq:           cur.seq.add(offOld),
+			Original:      append([]byte(nil), original...),
+			Retransmitted: append([]byte(nil), retransmitted...),
+			Policy:        policy,
+			Replaced:      replaced,
+		})
+	}
+
+	if !replaced {
+		if !*copied {
+			data = append([]byte(nil), data...)
+			*copied = true
+		}
+
+		copy(data[offNew:offNew+length], original)
+	}
+
+	return data
+}
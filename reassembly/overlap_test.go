@@ -0,0 +1,153 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package reassembly
+
+import (
+	"bytes"
+	"net"
+	"testing"
+
+	"github.com/dreadl0ck/gopacket/layers"
+)
+
+func TestParseOverlapPolicies(t *testing.T) {
+	o, err := ParseOverlapPolicies("default=bsd, 10.0.0.0/8=windows, 10.1.0.0/16=first, 10.1.2.3=linux, 2001:db8::/32=first")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for ip, want := range map[string]OverlapPolicy{
+		"192.168.1.1": OverlapPolicyBSD,
+		"10.2.0.1":    OverlapPolicyWindows,
+		"10.1.0.1":    OverlapPolicyFirst,
+		"10.1.2.3":    OverlapPolicyLinux,
+		"2001:db8::1": OverlapPolicyFirst,
+	} {
+		if got := o.Lookup(net.ParseIP(ip)); got != want {
+			t.Errorf("%s: want %s, got %s", ip, want, got)
+		}
+	}
+
+	for _, spec := range []string{"default=solaris", "10.0.0.0/8", "not-an-ip=bsd", "10.0.0.0/33=bsd"} {
+		if _, err := ParseOverlapPolicies(spec); err == nil {
+			t.Errorf("expected error for %q", spec)
+		}
+	}
+
+	empty, err := ParseOverlapPolicies("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p := empty.Lookup(net.IP{1, 2, 3, 4}); p != OverlapPolicyLast {
+		t.Fatal("expected last policy for empty spec, got", p)
+	}
+}
+
+func TestOverlapPolicyNewDataWins(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end Sequence
+		want       map[OverlapPolicy]bool
+	}{
+		{
+			name:  "starts before",
+			start: 5, end: 15,
+			want: map[OverlapPolicy]bool{OverlapPolicyLast: true, OverlapPolicyFirst: false, OverlapPolicyBSD: true, OverlapPolicyLinux: true, OverlapPolicyWindows: false},
+		},
+		{
+			name:  "starts before and covers",
+			start: 5, end: 25,
+			want: map[OverlapPolicy]bool{OverlapPolicyLast: true, OverlapPolicyFirst: false, OverlapPolicyBSD: true, OverlapPolicyLinux: true, OverlapPolicyWindows: true},
+		},
+		{
+			name:  "same start, ends after",
+			start: 10, end: 25,
+			want: map[OverlapPolicy]bool{OverlapPolicyLast: true, OverlapPolicyFirst: false, OverlapPolicyBSD: false, OverlapPolicyLinux: true, OverlapPolicyWindows: false},
+		},
+		{
+			name:  "same range",
+			start: 10, end: 20,
+			want: map[OverlapPolicy]bool{OverlapPolicyLast: true, OverlapPolicyFirst: false, OverlapPolicyBSD: false, OverlapPolicyLinux: false, OverlapPolicyWindows: false},
+		},
+	}
+
+	for _, test := range tests {
+		for p, want := range test.want {
+			if got := p.newDataWins(test.start, test.end, 10, 20); got != want {
+				t.Errorf("%s: policy %s: want %v, got %v", test.name, p, want, got)
+			}
+		}
+	}
+}
+
+func testOverlapPolicy(t *testing.T, spec string, want []byte, wantReplaced bool) {
+	t.Helper()
+
+	var (
+		fact     = &testFactory{}
+		a        = NewAssembler(NewStreamPool(fact))
+		err      error
+		reported []*InconsistentRetransmission
+	)
+
+	a.OverlapPolicies, err = ParseOverlapPolicies(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a.OnInconsistentRetransmission = func(r *InconsistentRetransmission) {
+		reported = append(reported, r)
+	}
+
+	for _, tcp := range []*layers.TCP{
+		{SrcPort: 1, DstPort: 2, Seq: 1004, BaseLayer: layers.BaseLayer{Payload: []byte{4, 5, 6}}},
+		{SrcPort: 1, DstPort: 2, Seq: 1004, BaseLayer: layers.BaseLayer{Payload: []byte{9, 9, 9}}},
+		{SrcPort: 1, DstPort: 2, Seq: 1000, SYN: true, BaseLayer: layers.BaseLayer{Payload: []byte{1, 2, 3}}},
+	} {
+		a.assemble(netFlow, tcp)
+	}
+
+	var got []byte
+	for _, r := range fact.reassembly {
+		got = append(got, r.Bytes...)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Fatalf("%s: want %v, got %v", spec, want, got)
+	}
+
+	if len(reported) != 1 {
+		t.Fatalf("%s: expected 1 inconsistent retransmission, got %d", spec, len(reported))
+	}
+
+	r := reported[0]
+	if r.Seq != 1004 || !bytes.Equal(r.Original, []byte{4, 5, 6}) || !bytes.Equal(r.Retransmitted, []byte{9, 9, 9}) || r.Replaced != wantReplaced {
+		t.Fatalf("%s: unexpected inconsistent retransmission: %+v", spec, r)
+	}
+}
+
+func TestOverlapPolicyLast(t *testing.T) {
+	testOverlapPolicy(t, "", []byte{1, 2, 3, 9, 9, 9}, true)
+}
+
+func TestOverlapPolicyFirst(t *testing.T) {
+	testOverlapPolicy(t, "default=first", []byte{1, 2, 3, 4, 5, 6}, false)
+}
+
+func TestOverlapPolicyPerHost(t *testing.T) {
+	// netFlow is 1.2.3.4 -> 5.6.7.8
+	testOverlapPolicy(t, "default=last,5.6.7.8=linux", []byte{1, 2, 3, 4, 5, 6}, false)
+	testOverlapPolicy(t, "default=last,1.2.3.4=linux", []byte{1, 2, 3, 9, 9, 9}, true)
+}
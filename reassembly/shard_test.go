@@ -0,0 +1,183 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package reassembly
+
+import (
+	"net"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/dreadl0ck/gopacket"
+	"github.com/dreadl0ck/gopacket/layers"
+)
+
+// number of concurrent connections in the sharding benchmarks.
+const benchFlows = 1024
+
+var benchPayload = make([]byte, 512)
+
+type benchSegment struct {
+	flow gopacket.Flow
+	tcp  layers.TCP
+}
+
+// benchSegments returns n segments, distributed in order over benchFlows connections.
+// The connections are identified by their network flow,
+// since the transport flow of a TCP layer that was not decoded is empty.
+func benchSegments(n int) []benchSegment {
+	var (
+		segs  = make([]benchSegment, n)
+		seq   = make([]uint32, benchFlows)
+		flows = make([]gopacket.Flow, benchFlows)
+	)
+
+	for f := range flows {
+		flows[f], _ = gopacket.FlowFromEndpoints(
+			layers.NewIPEndpoint(net.IP{10, 0, byte(f >> 8), byte(f)}),
+			layers.NewIPEndpoint(net.IP{10, 1, 0, 1}))
+	}
+
+	for i := range segs {
+		f := i % benchFlows
+		segs[i] = benchSegment{
+			flow: flows[f],
+			tcp: layers.TCP{
+				SrcPort:   layers.TCPPort(1024 + f),
+				DstPort:   80,
+				Seq:       seq[f],
+				SYN:       seq[f] == 0,
+				BaseLayer: layers.BaseLayer{Payload: benchPayload},
+			},
+		}
+
+		if seq[f] == 0 {
+			seq[f]++
+		}
+		seq[f] += uint32(len(benchPayload))
+	}
+
+	return segs
+}
+
+// benchmarkWorkers passes the segments to one goroutine per CPU.
+// route selects the goroutine for a segment, handle is called on the selected goroutine.
+func benchmarkWorkers(b *testing.B, route func(i int, s *benchSegment) int, handle func(w int, s *benchSegment)) {
+	var (
+		numWorkers = runtime.GOMAXPROCS(0)
+		workers    = make([]chan *benchSegment, numWorkers)
+		segs       = benchSegments(b.N)
+		wg         sync.WaitGroup
+	)
+
+	for w := range workers {
+		workers[w] = make(chan *benchSegment, 1024)
+
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+
+			for s := range workers[w] {
+				handle(w, s)
+			}
+		}(w)
+	}
+
+	b.SetBytes(int64(len(benchPayload)))
+	b.ResetTimer()
+
+	for i := range segs {
+		workers[route(i, &segs[i])] <- &segs[i]
+	}
+
+	for _, w := range workers {
+		close(w)
+	}
+
+	wg.Wait()
+}
+
+// BenchmarkAssembleRoundRobin distributes the packets round robin to assemblers sharing a single pool,
+// access to the assemblers is serialized with a global lock.
+func BenchmarkAssembleRoundRobin(b *testing.B) {
+	var (
+		pool       = NewStreamPool(&testFactoryBench{})
+		assemblers = make([]*Assembler, runtime.GOMAXPROCS(0))
+		mu         sync.Mutex
+	)
+
+	for i := range assemblers {
+		assemblers[i] = NewAssembler(pool)
+	}
+
+	benchmarkWorkers(b,
+		func(i int, _ *benchSegment) int {
+			return i % len(assemblers)
+		},
+		func(w int, s *benchSegment) {
+			mu.Lock()
+			assemblers[w].assemble(s.flow, &s.tcp)
+			mu.Unlock()
+		},
+	)
+}
+
+// BenchmarkAssembleSharded distributes the packets by flow to assemblers,
+// that each own a shard of the pool and do not require a global lock.
+func BenchmarkAssembleSharded(b *testing.B) {
+	var (
+		pool       = NewStreamPool(&testFactoryBench{})
+		assemblers = make([]*Assembler, runtime.GOMAXPROCS(0))
+	)
+
+	for i := range assemblers {
+		assemblers[i] = NewAssembler(pool.Shard())
+	}
+
+	benchmarkWorkers(b,
+		func(_ int, s *benchSegment) int {
+			return int(s.flow.FastHash() % uint64(len(assemblers)))
+		},
+		func(w int, s *benchSegment) {
+			assemblers[w].assemble(s.flow, &s.tcp)
+		},
+	)
+}
+
+func TestStreamPoolShard(t *testing.T) {
+	pool := NewStreamPool(&testFactoryBench{})
+	pool.SetMemoryBudget(1<<20, EvictOldest)
+
+	var (
+		a = NewAssembler(pool.Shard())
+		b = NewAssembler(pool.Shard())
+	)
+
+	a.assemble(budgetFlow(1), &layers.TCP{SYN: true})
+	b.assemble(budgetFlow(2), &layers.TCP{SYN: true})
+	b.assemble(budgetFlow(3), &layers.TCP{SYN: true})
+
+	if n := len(a.StreamPool().connections()); n != 1 {
+		t.Fatal("expected 1 connection in first shard, got", n)
+	}
+
+	if n := len(b.StreamPool().connections()); n != 2 {
+		t.Fatal("expected 2 connections in second shard, got", n)
+	}
+
+	// the memory budget is shared
+	if n := pool.MemoryStats().Connections; n != 3 {
+		t.Fatal("expected 3 connections accounted in the pool, got", n)
+	}
+}
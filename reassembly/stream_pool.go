@@ -36,6 +36,16 @@ type StreamPool struct {
 	all                [][]connection
 	nextAlloc          int
 	newConnectionCount int64
+
+	// limit for the memory used by all assemblers sharing the budget with the pool and its shards
+	budget *MemoryBudget
+
+	// pages and connections of the pool, accounted for in the budget as well
+	numPages int64
+	numConns int64
+
+	// set while an assembler of the pool is evicting connections
+	evicting int32
 }
 
 func (p *StreamPool) grow() {
@@ -76,9 +86,10 @@ func (p *StreamPool) DumpString() string {
 
 func (p *StreamPool) remove(conn *connection) {
 	p.mu.Lock()
-	if _, ok := p.conns[*conn.key]; ok {
+	if c, ok := p.conns[*conn.key]; ok && c == conn {
 		delete(p.conns, *conn.key)
 		p.free = append(p.free, conn)
+		p.addConnections(-1)
 	}
 	p.mu.Unlock()
 }
@@ -86,12 +97,27 @@ func (p *StreamPool) remove(conn *connection) {
 // NewStreamPool creates a new connection pool.  Streams will
 // be created as necessary using the passed-in streamFactory.
 func NewStreamPool(factory streamFactory) *StreamPool {
-	return &StreamPool{
+	p := &StreamPool{
 		conns:     make(map[key]*connection, initialAllocSize),
 		free:      make([]*connection, 0, initialAllocSize),
 		factory:   factory,
 		nextAlloc: initialAllocSize,
+		budget:    NewMemoryBudget(),
 	}
+	p.budget.add(p)
+
+	return p
+}
+
+// Shard returns a new, empty StreamPool that creates streams with the same streamFactory
+// and shares the memory budget with p.
+// Each shard has its own connection table, so assemblers using different shards do not contend for locks,
+// as long as all packets of a connection are passed to assemblers of the same shard.
+func (p *StreamPool) Shard() *StreamPool {
+	s := NewStreamPool(p.factory)
+	s.UseMemoryBudget(p.budget)
+
+	return s
 }
 
 func (p *StreamPool) connections() []*connection {
@@ -157,7 +183,7 @@ func (p *StreamPool) getConnection(k *key, end bool, ts time.Time, ac AssemblerC
 		return conn, half, rev
 	}
 
-	s := p.factory.New(k[0], k[1], ac)
+	s := p.factory.New(k.net, k.transport, ac)
 
 	conn, half, rev = p.newConnection(k, s, ts)
 
@@ -173,6 +199,7 @@ func (p *StreamPool) getConnection(k *key, end bool, ts time.Time, ac AssemblerC
 	}
 
 	p.conns[*k] = conn
+	p.addConnections(1)
 
 	return conn, half, rev
 }
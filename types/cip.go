@@ -48,6 +48,7 @@ var fieldsCIP = []string{
 	fieldDstIP,
 	fieldSrcPort,
 	fieldDstPort,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -77,6 +78,7 @@ func (c *CIP) CSVRecord() []string {
 		c.DstIP,
 		formatInt32(c.SrcPort),
 		formatInt32(c.DstPort),
+		c.Tunnel.toString(),
 	})
 }
 
@@ -112,6 +114,7 @@ func (c *CIP) SetPacketContext(ctx *PacketContext) {
 	c.DstIP = ctx.DstIP
 	c.SrcPort = ctx.SrcPort
 	c.DstPort = ctx.DstPort
+	c.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -149,6 +152,7 @@ func (c *CIP) Encode() []string {
 		cipEncoder.String(fieldDstIP, c.DstIP),
 		cipEncoder.Int32(fieldSrcPort, c.SrcPort),
 		cipEncoder.Int32(fieldDstPort, c.DstPort),
+		cipEncoder.String(fieldTunnel, c.Tunnel.toString()),
 	})
 }
 
@@ -23,29 +23,53 @@ import (
 )
 
 const (
-	fieldTimestampFirst      = "TimestampFirst"
-	fieldLinkProto           = "LinkProto"
-	fieldNetworkProto        = "NetworkProto"
-	fieldTransportProto      = "TransportProto"
-	fieldApplicationProto    = "ApplicationProto"
-	fieldTotalSize           = "TotalSize"
-	fieldAppPayloadSize      = "AppPayloadSize"
-	fieldNumPackets          = "NumPackets"
-	fieldUID                 = "UID"
-	fieldDuration            = "Duration"
-	fieldTimestampLast       = "TimestampLast"
-	fieldBytesClientToServer = "BytesClientToServer"
-	fieldBytesServerToClient = "BytesServerToClient"
-	fieldNumFINFlags         = "NumFINFlags"
-	fieldNumRSTFlags         = "NumRSTFlags"
-	fieldNumACKFlags         = "NumACKFlags"
-	fieldNumSYNFlags         = "NumSYNFlags"
-	fieldNumURGFlags         = "NumURGFlags"
-	fieldNumECEFlags         = "NumECEFlags"
-	fieldNumPSHFlags         = "NumPSHFlags"
-	fieldNumCWRFlags         = "NumCWRFlags"
-	fieldNumNSFlags          = "NumNSFlags"
-	fieldMeanWindowSize      = "MeanWindowSize"
+	fieldTimestampFirst                  = "TimestampFirst"
+	fieldLinkProto                       = "LinkProto"
+	fieldNetworkProto                    = "NetworkProto"
+	fieldTransportProto                  = "TransportProto"
+	fieldApplicationProto                = "ApplicationProto"
+	fieldTotalSize                       = "TotalSize"
+	fieldAppPayloadSize                  = "AppPayloadSize"
+	fieldNumPackets                      = "NumPackets"
+	fieldUID                             = "UID"
+	fieldDuration                        = "Duration"
+	fieldTimestampLast                   = "TimestampLast"
+	fieldBytesClientToServer             = "BytesClientToServer"
+	fieldBytesServerToClient             = "BytesServerToClient"
+	fieldNumFINFlags                     = "NumFINFlags"
+	fieldNumRSTFlags                     = "NumRSTFlags"
+	fieldNumACKFlags                     = "NumACKFlags"
+	fieldNumSYNFlags                     = "NumSYNFlags"
+	fieldNumURGFlags                     = "NumURGFlags"
+	fieldNumECEFlags                     = "NumECEFlags"
+	fieldNumPSHFlags                     = "NumPSHFlags"
+	fieldNumCWRFlags                     = "NumCWRFlags"
+	fieldNumNSFlags                      = "NumNSFlags"
+	fieldMeanWindowSize                  = "MeanWindowSize"
+	fieldTunnel                          = "Tunnel"
+	fieldCommunityID                     = "CommunityID"
+	fieldCommunityIDs                    = "CommunityIDs"
+	fieldHandshakeRTT                    = "HandshakeRTT"
+	fieldRetransmissionsClientToServer   = "RetransmissionsClientToServer"
+	fieldRetransmissionsServerToClient   = "RetransmissionsServerToClient"
+	fieldDupACKsClientToServer           = "DupACKsClientToServer"
+	fieldDupACKsServerToClient           = "DupACKsServerToClient"
+	fieldOutOfOrderClientToServer        = "OutOfOrderClientToServer"
+	fieldOutOfOrderServerToClient        = "OutOfOrderServerToClient"
+	fieldZeroWindowsClientToServer       = "ZeroWindowsClientToServer"
+	fieldZeroWindowsServerToClient       = "ZeroWindowsServerToClient"
+	fieldWindowScaleClient               = "WindowScaleClient"
+	fieldWindowScaleServer               = "WindowScaleServer"
+	fieldMSSClient                       = "MSSClient"
+	fieldMSSServer                       = "MSSServer"
+	fieldTerminationReason               = "TerminationReason"
+	fieldSPLTLengths                     = "SPLTLengths"
+	fieldSPLTIntervals                   = "SPLTIntervals"
+	fieldByteDistribution                = "ByteDistribution"
+	fieldPacketSizesClientToServer       = "PacketSizesClientToServer"
+	fieldPacketSizesServerToClient       = "PacketSizesServerToClient"
+	fieldInterArrivalTimesClientToServer = "InterArrivalTimesClientToServer"
+	fieldInterArrivalTimesServerToClient = "InterArrivalTimesServerToClient"
 )
 
 var fieldsConnection = []string{
@@ -78,6 +102,29 @@ var fieldsConnection = []string{
 	fieldNumCWRFlags,
 	fieldNumNSFlags,
 	fieldMeanWindowSize,
+	fieldTunnel,
+	fieldCommunityID,
+	fieldHandshakeRTT,
+	fieldRetransmissionsClientToServer,
+	fieldRetransmissionsServerToClient,
+	fieldDupACKsClientToServer,
+	fieldDupACKsServerToClient,
+	fieldOutOfOrderClientToServer,
+	fieldOutOfOrderServerToClient,
+	fieldZeroWindowsClientToServer,
+	fieldZeroWindowsServerToClient,
+	fieldWindowScaleClient,
+	fieldWindowScaleServer,
+	fieldMSSClient,
+	fieldMSSServer,
+	fieldTerminationReason,
+	fieldSPLTLengths,
+	fieldSPLTIntervals,
+	fieldByteDistribution,
+	fieldPacketSizesClientToServer,
+	fieldPacketSizesServerToClient,
+	fieldInterArrivalTimesClientToServer,
+	fieldInterArrivalTimesServerToClient,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -117,9 +164,76 @@ func (c *Connection) CSVRecord() []string {
 		formatInt32(c.NumCWRFlags),
 		formatInt32(c.NumNSFlags),
 		formatInt32(c.MeanWindowSize),
+		c.Tunnel.toString(),
+		c.CommunityID,
+		formatInt64(c.HandshakeRTT),
+		formatInt32(c.RetransmissionsClientToServer),
+		formatInt32(c.RetransmissionsServerToClient),
+		formatInt32(c.DupACKsClientToServer),
+		formatInt32(c.DupACKsServerToClient),
+		formatInt32(c.OutOfOrderClientToServer),
+		formatInt32(c.OutOfOrderServerToClient),
+		formatInt32(c.ZeroWindowsClientToServer),
+		formatInt32(c.ZeroWindowsServerToClient),
+		formatInt32(c.WindowScaleClient),
+		formatInt32(c.WindowScaleServer),
+		formatInt32(c.MSSClient),
+		formatInt32(c.MSSServer),
+		c.TerminationReason,
+		joinInts(c.SPLTLengths),
+		joinInt64s(c.SPLTIntervals),
+		joinInts(c.ByteDistribution),
+		c.PacketSizesClientToServer.toString(),
+		c.PacketSizesServerToClient.toString(),
+		c.InterArrivalTimesClientToServer.toString(),
+		c.InterArrivalTimesServerToClient.toString(),
 	})
 }
 
+func (t *Tunnel) toString() string {
+	if t == nil {
+		return ""
+	}
+
+	var b strings.Builder
+
+	b.WriteString(StructureBegin)
+	b.WriteString(t.Type)
+	b.WriteString(FieldSeparator)
+	b.WriteString(t.OuterSrcIP)
+	b.WriteString(FieldSeparator)
+	b.WriteString(t.OuterDstIP)
+	b.WriteString(FieldSeparator)
+	b.WriteString(formatUint32(t.VNI))
+	b.WriteString(FieldSeparator)
+	b.WriteString(formatUint32(t.GREKey))
+	b.WriteString(FieldSeparator)
+	b.WriteString(formatUint32(t.SessionID))
+	b.WriteString(StructureEnd)
+
+	return b.String()
+}
+
+func (s *Stats) toString() string {
+	if s == nil {
+		return ""
+	}
+
+	var b strings.Builder
+
+	b.WriteString(StructureBegin)
+	b.WriteString(formatFloat64(s.Min))
+	b.WriteString(FieldSeparator)
+	b.WriteString(formatFloat64(s.Max))
+	b.WriteString(FieldSeparator)
+	b.WriteString(formatFloat64(s.Mean))
+	b.WriteString(FieldSeparator)
+	b.WriteString(formatFloat64(s.StdDev))
+	b.WriteString(StructureEnd)
+
+	return b.String()
+}
+
 // Time returns the timestamp associated with the audit record.
 func (c *Connection) Time() int64 {
 	return c.TimestampFirst
@@ -260,6 +374,29 @@ func (c *Connection) Encode() []string {
 		connectionEncoder.Int32(fieldNumCWRFlags, c.NumCWRFlags),
 		connectionEncoder.Int32(fieldNumNSFlags, c.NumNSFlags),
 		connectionEncoder.Int32(fieldMeanWindowSize, c.MeanWindowSize),
+		connectionEncoder.String(fieldTunnel, c.Tunnel.toString()),
+		connectionEncoder.String(fieldCommunityID, c.CommunityID),
+		connectionEncoder.Int64(fieldHandshakeRTT, c.HandshakeRTT),
+		connectionEncoder.Int32(fieldRetransmissionsClientToServer, c.RetransmissionsClientToServer),
+		connectionEncoder.Int32(fieldRetransmissionsServerToClient, c.RetransmissionsServerToClient),
+		connectionEncoder.Int32(fieldDupACKsClientToServer, c.DupACKsClientToServer),
+		connectionEncoder.Int32(fieldDupACKsServerToClient, c.DupACKsServerToClient),
+		connectionEncoder.Int32(fieldOutOfOrderClientToServer, c.OutOfOrderClientToServer),
+		connectionEncoder.Int32(fieldOutOfOrderServerToClient, c.OutOfOrderServerToClient),
+		connectionEncoder.Int32(fieldZeroWindowsClientToServer, c.ZeroWindowsClientToServer),
+		connectionEncoder.Int32(fieldZeroWindowsServerToClient, c.ZeroWindowsServerToClient),
+		connectionEncoder.Int32(fieldWindowScaleClient, c.WindowScaleClient),
+		connectionEncoder.Int32(fieldWindowScaleServer, c.WindowScaleServer),
+		connectionEncoder.Int32(fieldMSSClient, c.MSSClient),
+		connectionEncoder.Int32(fieldMSSServer, c.MSSServer),
+		connectionEncoder.String(fieldTerminationReason, c.TerminationReason),
+		connectionEncoder.String(fieldSPLTLengths, joinInts(c.SPLTLengths)),
+		connectionEncoder.String(fieldSPLTIntervals, joinInt64s(c.SPLTIntervals)),
+		connectionEncoder.String(fieldByteDistribution, joinInts(c.ByteDistribution)),
+		connectionEncoder.String(fieldPacketSizesClientToServer, c.PacketSizesClientToServer.toString()),
+		connectionEncoder.String(fieldPacketSizesServerToClient, c.PacketSizesServerToClient.toString()),
+		connectionEncoder.String(fieldInterArrivalTimesClientToServer, c.InterArrivalTimesClientToServer.toString()),
+		connectionEncoder.String(fieldInterArrivalTimesServerToClient, c.InterArrivalTimesServerToClient.toString()),
 	})
 }
 
@@ -36,6 +36,8 @@ var fieldsCredentials = []string{
 	fieldUser,     // string
 	fieldPassword, // string
 	fieldNotes,    // string
+	fieldCommunityID,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -52,6 +54,8 @@ func (c *Credentials) CSVRecord() []string {
 		c.User,
 		c.Password,
 		c.Notes,
+		c.CommunityID,
+		c.Tunnel.toString(),
 	})
 }
 
@@ -107,6 +111,8 @@ func (c *Credentials) Encode() []string {
 		credentialsEncoder.String(fieldUser, c.User),
 		credentialsEncoder.String(fieldPassword, c.Password),
 		credentialsEncoder.String(fieldNotes, c.Notes),
+		credentialsEncoder.String(fieldCommunityID, c.CommunityID),
+		credentialsEncoder.String(fieldTunnel, c.Tunnel.toString()),
 	})
 }
 
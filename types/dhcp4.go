@@ -60,6 +60,7 @@ var fieldsDHCPv4 = []string{
 	fieldDstIP,
 	fieldSrcPort,
 	fieldDstPort,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -95,6 +96,7 @@ func (d *DHCPv4) CSVRecord() []string {
 		d.DstIP,
 		formatInt32(d.SrcPort),
 		formatInt32(d.DstPort),
+		d.Tunnel.toString(),
 	})
 }
 
@@ -182,6 +184,7 @@ func (d *DHCPv4) SetPacketContext(ctx *PacketContext) {
 	d.DstIP = ctx.DstIP
 	d.SrcPort = ctx.SrcPort
 	d.DstPort = ctx.DstPort
+	d.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -226,6 +229,7 @@ func (d *DHCPv4) Encode() []string {
 		dhcp4Encoder.String(fieldDstIP, d.DstIP),
 		dhcp4Encoder.Int32(fieldSrcPort, d.SrcPort),
 		dhcp4Encoder.Int32(fieldDstPort, d.DstPort),
+		dhcp4Encoder.String(fieldTunnel, d.Tunnel.toString()),
 	})
 }
 
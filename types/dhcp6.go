@@ -42,6 +42,7 @@ var fieldsDHCPv6 = []string{
 	fieldDstIP,
 	fieldSrcPort,
 	fieldDstPort,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -68,6 +69,7 @@ func (d *DHCPv6) CSVRecord() []string {
 		d.DstIP,
 		formatInt32(d.SrcPort),
 		formatInt32(d.DstPort),
+		d.Tunnel.toString(),
 	})
 }
 
@@ -139,6 +141,7 @@ func (d *DHCPv6) SetPacketContext(ctx *PacketContext) {
 	d.DstIP = ctx.DstIP
 	d.SrcPort = ctx.SrcPort
 	d.DstPort = ctx.DstPort
+	d.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -172,6 +175,7 @@ func (d *DHCPv6) Encode() []string {
 		dhcp4Encoder.String(fieldDstIP, d.DstIP),
 		dhcp4Encoder.Int32(fieldSrcPort, d.SrcPort),
 		dhcp4Encoder.Int32(fieldDstPort, d.DstPort),
+		dhcp6Encoder.String(fieldTunnel, d.Tunnel.toString()),
 	})
 }
 
@@ -44,6 +44,7 @@ var fieldsDiameter = []string{
 	fieldDstIP,
 	fieldSrcPort,
 	fieldDstPort,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -73,6 +74,7 @@ func (d *Diameter) CSVRecord() []string {
 		d.DstIP,
 		formatInt32(d.SrcPort),
 		formatInt32(d.DstPort),
+		d.Tunnel.toString(),
 	})
 }
 
@@ -108,6 +110,7 @@ func (d *Diameter) SetPacketContext(ctx *PacketContext) {
 	d.DstIP = ctx.DstIP
 	d.SrcPort = ctx.SrcPort
 	d.DstPort = ctx.DstPort
+	d.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -139,6 +142,7 @@ func (d *Diameter) Encode() []string {
 		diameterEncoder.String(fieldDstIP, d.DstIP),
 		diameterEncoder.Int32(fieldSrcPort, d.SrcPort),
 		diameterEncoder.Int32(fieldDstPort, d.DstPort),
+		diameterEncoder.String(fieldTunnel, d.Tunnel.toString()),
 	})
 }
 
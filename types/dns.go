@@ -66,6 +66,8 @@ var fieldsDNS = []string{
 	fieldDstIP,
 	fieldSrcPort,
 	fieldDstPort,
+	fieldCommunityID,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -117,6 +119,8 @@ func (d *DNS) CSVRecord() []string {
 		d.DstIP,
 		formatInt32(d.SrcPort),
 		formatInt32(d.DstPort),
+		d.CommunityID,
+		d.Tunnel.toString(),
 	})
 }
 
@@ -246,6 +250,7 @@ func (d *DNS) SetPacketContext(ctx *PacketContext) {
 	d.DstIP = ctx.DstIP
 	d.SrcPort = ctx.SrcPort
 	d.DstPort = ctx.DstPort
+	d.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -305,6 +310,8 @@ func (d *DNS) Encode() []string {
 		dnsEncoder.String(fieldDstIP, d.DstIP),
 		dnsEncoder.Int32(fieldSrcPort, d.SrcPort),
 		dnsEncoder.Int32(fieldDstPort, d.DstPort),
+		dnsEncoder.String(fieldCommunityID, d.CommunityID),
+		dnsEncoder.String(fieldTunnel, d.Tunnel.toString()),
 	})
 }
 
@@ -42,6 +42,7 @@ var fieldsENIP = []string{
 	fieldDstIP,
 	fieldSrcPort,
 	fieldDstPort,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -65,6 +66,7 @@ func (en *ENIP) CSVRecord() []string {
 		en.DstIP,
 		formatInt32(en.SrcPort),
 		formatInt32(en.DstPort),
+		en.Tunnel.toString(),
 	})
 }
 
@@ -100,6 +102,7 @@ func (en *ENIP) SetPacketContext(ctx *PacketContext) {
 	en.DstIP = ctx.DstIP
 	en.SrcPort = ctx.SrcPort
 	en.DstPort = ctx.DstPort
+	en.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -130,6 +133,7 @@ func (en *ENIP) Encode() []string {
 		enipEncoder.String(fieldDstIP, en.DstIP),
 		enipEncoder.Int32(fieldSrcPort, en.SrcPort),
 		enipEncoder.Int32(fieldDstPort, en.DstPort),
+		enipEncoder.String(fieldTunnel, en.Tunnel.toString()),
 	})
 }
 
@@ -42,6 +42,8 @@ var fieldsFile = []string{
 	fieldDstIP,
 	fieldSrcPort,
 	fieldDstPort,
+	fieldCommunityID,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -64,6 +66,8 @@ func (a *File) CSVRecord() []string {
 		a.DstIP,
 		formatInt32(a.SrcPort),
 		formatInt32(a.DstPort),
+		a.CommunityID,
+		a.Tunnel.toString(),
 	})
 }
 
@@ -154,6 +158,8 @@ func (a *File) Encode() []string {
 		fileEncoder.String(fieldDstIP, a.DstIP),
 		fileEncoder.Int32(fieldSrcPort, a.SrcPort),
 		fileEncoder.Int32(fieldDstPort, a.DstPort),
+		fileEncoder.String(fieldCommunityID, a.CommunityID),
+		fileEncoder.String(fieldTunnel, a.Tunnel.toString()),
 	})
 }
 
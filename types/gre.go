@@ -60,6 +60,7 @@ var fieldsGRE = []string{
 	fieldDstIP,
 	fieldSrcPort,
 	fieldDstPort,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -89,6 +90,7 @@ func (a *GRE) CSVRecord() []string {
 		a.Routing.getString(),                   // *GRERouting
 		a.SrcIP,
 		a.DstIP,
+		a.Tunnel.toString(),
 	})
 }
 
@@ -144,6 +146,7 @@ func (a *GRE) Inc() {
 func (a *GRE) SetPacketContext(ctx *PacketContext) {
 	a.SrcIP = ctx.SrcIP
 	a.DstIP = ctx.DstIP
+	a.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -180,6 +183,7 @@ func (a *GRE) Encode() []string {
 		greEncoder.String(fieldRouting, a.Routing.getString()),      // *GRERouting
 		dhcp4Encoder.String(fieldSrcIP, a.SrcIP),
 		dhcp4Encoder.String(fieldDstIP, a.DstIP),
+		greEncoder.String(fieldTunnel, a.Tunnel.toString()),
 	})
 }
 
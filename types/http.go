@@ -56,6 +56,8 @@ var fieldsHTTP = []string{
 	fieldReqContentEncoding,
 	fieldResContentEncoding,
 	fieldServerName,
+	fieldCommunityID,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -92,6 +94,8 @@ func (h *HTTP) CSVRecord() []string {
 		h.ReqContentEncoding,
 		h.ResContentEncoding,
 		h.ServerName,
+		h.CommunityID,
+		h.Tunnel.toString(),
 	})
 }
 
@@ -186,6 +190,8 @@ func (h *HTTP) Encode() []string {
 		httpEncoder.String(fieldReqContentEncoding, h.ReqContentEncoding),
 		httpEncoder.String(fieldResContentEncoding, h.ResContentEncoding),
 		httpEncoder.String(fieldServerName, h.ServerName),
+		httpEncoder.String(fieldCommunityID, h.CommunityID),
+		httpEncoder.String(fieldTunnel, h.Tunnel.toString()),
 	})
 }
 
@@ -33,6 +33,7 @@ var fieldsICMPv4 = []string{
 	fieldSeq,      // int32
 	fieldSrcIP,
 	fieldDstIP,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -50,6 +51,7 @@ func (i *ICMPv4) CSVRecord() []string {
 		formatInt32(i.Seq),
 		i.SrcIP,
 		i.DstIP,
+		i.Tunnel.toString(),
 	})
 }
 
@@ -83,6 +85,7 @@ func (i *ICMPv4) Inc() {
 func (i *ICMPv4) SetPacketContext(ctx *PacketContext) {
 	i.SrcIP = ctx.SrcIP
 	i.DstIP = ctx.DstIP
+	i.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -107,6 +110,7 @@ func (i *ICMPv4) Encode() []string {
 		icmp4Encoder.Int32(fieldSeq, i.Seq),
 		icmp4Encoder.String(fieldSrcIP, i.SrcIP),
 		icmp4Encoder.String(fieldDstIP, i.DstIP),
+		icmp4Encoder.String(fieldTunnel, i.Tunnel.toString()),
 	})
 }
 
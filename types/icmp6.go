@@ -27,6 +27,7 @@ var fieldsICMPv6 = []string{
 	fieldChecksum, // int32
 	fieldSrcIP,
 	fieldDstIP,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -42,6 +43,7 @@ func (i *ICMPv6) CSVRecord() []string {
 		formatInt32(i.Checksum),
 		i.SrcIP,
 		i.DstIP,
+		i.Tunnel.toString(),
 	})
 }
 
@@ -75,6 +77,7 @@ func (i *ICMPv6) Inc() {
 func (i *ICMPv6) SetPacketContext(ctx *PacketContext) {
 	i.SrcIP = ctx.SrcIP
 	i.DstIP = ctx.DstIP
+	i.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -97,6 +100,7 @@ func (i *ICMPv6) Encode() []string {
 		icmp6Encoder.Int32(fieldChecksum, i.Checksum),
 		icmp6Encoder.String(fieldSrcIP, i.SrcIP),
 		icmp6Encoder.String(fieldDstIP, i.DstIP),
+		icmp6Encoder.String(fieldTunnel, i.Tunnel.toString()),
 	})
 }
 
@@ -32,6 +32,7 @@ var fieldsICMPv6Echo = []string{
 	fieldSeqNumber,  //  int32
 	fieldSrcIP,
 	fieldDstIP,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -47,6 +48,7 @@ func (i *ICMPv6Echo) CSVRecord() []string {
 		formatInt32(i.SeqNumber),
 		i.SrcIP,
 		i.DstIP,
+		i.Tunnel.toString(),
 	})
 }
 
@@ -80,6 +82,7 @@ func (i *ICMPv6Echo) Inc() {
 func (i *ICMPv6Echo) SetPacketContext(ctx *PacketContext) {
 	i.SrcIP = ctx.SrcIP
 	i.DstIP = ctx.DstIP
+	i.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -102,6 +105,7 @@ func (i *ICMPv6Echo) Encode() []string {
 		icmp6eEncoder.Int32(fieldSeqNumber, i.SeqNumber),
 		icmp6eEncoder.String(fieldSrcIP, i.SrcIP),
 		icmp6eEncoder.String(fieldDstIP, i.DstIP),
+		icmp6eEncoder.String(fieldTunnel, i.Tunnel.toString()),
 	})
 }
 
@@ -32,6 +32,7 @@ var fieldsICMPv6NeighborAdvertisement = []string{
 	fieldOptions,       // []*ICMPv6Option
 	fieldSrcIP,
 	fieldDstIP,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -53,6 +54,7 @@ func (i *ICMPv6NeighborAdvertisement) CSVRecord() []string {
 		strings.Join(opts, ""),
 		i.SrcIP,
 		i.DstIP,
+		i.Tunnel.toString(),
 	})
 }
 
@@ -86,6 +88,7 @@ func (i *ICMPv6NeighborAdvertisement) Inc() {
 func (i *ICMPv6NeighborAdvertisement) SetPacketContext(ctx *PacketContext) {
 	i.SrcIP = ctx.SrcIP
 	i.DstIP = ctx.DstIP
+	i.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -113,6 +116,7 @@ func (i *ICMPv6NeighborAdvertisement) Encode() []string {
 		icmp6naEncoder.String(fieldOptions, strings.Join(opts, "")),
 		icmp6naEncoder.String(fieldSrcIP, i.SrcIP),
 		icmp6naEncoder.String(fieldDstIP, i.DstIP),
+		icmp6naEncoder.String(fieldTunnel, i.Tunnel.toString()),
 	})
 }
 
@@ -27,6 +27,7 @@ var fieldsICMPv6NeighborSolicitation = []string{
 	fieldOptions,       // []*ICMPv6Option
 	fieldSrcIP,
 	fieldDstIP,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -47,6 +48,7 @@ func (i *ICMPv6NeighborSolicitation) CSVRecord() []string {
 		strings.Join(opts, ""),
 		i.SrcIP,
 		i.DstIP,
+		i.Tunnel.toString(),
 	})
 }
 
@@ -80,6 +82,7 @@ func (i *ICMPv6NeighborSolicitation) Inc() {
 func (i *ICMPv6NeighborSolicitation) SetPacketContext(ctx *PacketContext) {
 	i.SrcIP = ctx.SrcIP
 	i.DstIP = ctx.DstIP
+	i.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -106,6 +109,7 @@ func (i *ICMPv6NeighborSolicitation) Encode() []string {
 		icmp6nsEncoder.String(fieldOptions, strings.Join(opts, "")),
 		icmp6nsEncoder.String(fieldSrcIP, i.SrcIP),
 		icmp6nsEncoder.String(fieldDstIP, i.DstIP),
+		icmp6nsEncoder.String(fieldTunnel, i.Tunnel.toString()),
 	})
 }
 
@@ -27,6 +27,7 @@ var fieldsICMPv6RouterSolicitation = []string{
 	fieldOptions,
 	fieldSrcIP,
 	fieldDstIP,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -46,6 +47,7 @@ func (i *ICMPv6RouterSolicitation) CSVRecord() []string {
 		strings.Join(opts, ""),
 		i.SrcIP,
 		i.DstIP,
+		i.Tunnel.toString(),
 	})
 }
 
@@ -90,6 +92,7 @@ func (i *ICMPv6RouterSolicitation) Inc() {
 func (i *ICMPv6RouterSolicitation) SetPacketContext(ctx *PacketContext) {
 	i.SrcIP = ctx.SrcIP
 	i.DstIP = ctx.DstIP
+	i.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -115,6 +118,7 @@ func (i *ICMPv6RouterSolicitation) Encode() []string {
 		icmp6rsEncoder.String(fieldOptions, strings.Join(opts, "")),
 		icmp6rsEncoder.String(fieldSrcIP, i.SrcIP),
 		icmp6rsEncoder.String(fieldDstIP, i.DstIP),
+		icmp6rsEncoder.String(fieldTunnel, i.Tunnel.toString()),
 	})
 }
 
@@ -50,6 +50,7 @@ var fieldsIGMP = []string{
 	fieldVersion,                 // int32
 	fieldSrcIP,
 	fieldDstIP,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -80,6 +81,7 @@ func (i *IGMP) CSVRecord() []string {
 		formatInt32(i.Version),                        // int32
 		i.SrcIP,
 		i.DstIP,
+		i.Tunnel.toString(),
 	})
 }
 
@@ -130,6 +132,7 @@ func (i *IGMP) Inc() {
 func (i *IGMP) SetPacketContext(ctx *PacketContext) {
 	i.SrcIP = ctx.SrcIP
 	i.DstIP = ctx.DstIP
+	i.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -166,6 +169,7 @@ func (i *IGMP) Encode() []string {
 		igmpEncoder.Int32(fieldVersion, i.Version),                           // int32
 		igmpEncoder.String(fieldSrcIP, i.SrcIP),
 		igmpEncoder.String(fieldDstIP, i.DstIP),
+		igmpEncoder.String(fieldTunnel, i.Tunnel.toString()),
 	})
 }
 
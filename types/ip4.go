@@ -48,6 +48,7 @@ var fieldsIPv4 = []string{
 	//fieldOptions,        // []*IPv4Option
 	fieldPayloadEntropy, // float64
 	fieldPayloadSize,    // int32
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -79,6 +80,7 @@ func (i *IPv4) CSVRecord() []string {
 		//strings.Join(opts, ""),        // []*IPv4Option
 		strconv.FormatFloat(i.PayloadEntropy, 'f', 6, 64), // float64
 		formatInt32(i.PayloadSize),                        // int32
+		i.Tunnel.toString(),
 	})
 }
 
@@ -177,6 +179,7 @@ func (i *IPv4) Inc() {
 func (i *IPv4) SetPacketContext(ctx *PacketContext) {
 	i.SrcPort = ctx.SrcPort
 	i.DstPort = ctx.DstPort
+	i.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -214,6 +217,7 @@ func (i *IPv4) Encode() []string {
 		//ipv4Encoder.String(fieldOptions, strings.Join(opts, "")),   // []*IPv4Option
 		ipv4Encoder.Float64(fieldPayloadEntropy, i.PayloadEntropy), // float64
 		ipv4Encoder.Int32(fieldPayloadSize, i.PayloadSize),         // int32
+		ipv4Encoder.String(fieldTunnel, i.Tunnel.toString()),
 	})
 }
 
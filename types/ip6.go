@@ -42,6 +42,7 @@ var fieldsIPv6 = []string{
 	fieldPayloadEntropy, // float64
 	fieldPayloadSize,    // int32
 	//fieldHopByHop,       // *IPv6HopByHop
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -64,6 +65,7 @@ func (i *IPv6) CSVRecord() []string {
 		strconv.FormatFloat(i.PayloadEntropy, 'f', 6, 64), // float64
 		formatInt32(i.PayloadSize),                        // int32
 		//hop,                                               // *IPv6HopByHop
+		i.Tunnel.toString(),
 	})
 }
 
@@ -150,6 +152,7 @@ func (i *IPv6) Inc() {
 func (i *IPv6) SetPacketContext(ctx *PacketContext) {
 	i.SrcPort = ctx.SrcPort
 	i.DstPort = ctx.DstPort
+	i.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -180,6 +183,7 @@ func (i *IPv6) Encode() []string {
 		ipv6Encoder.Int32(fieldPayloadSize, i.PayloadSize),         // int32
 		// TODO: flatten
 		//hop,                                               // *IPv6HopByHop
+		ipv6Encoder.String(fieldTunnel, i.Tunnel.toString()),
 	})
 }
 
@@ -27,6 +27,7 @@ var fieldsIPv6HopByHop = []string{
 	fieldOptions,
 	fieldSrcIP, // string
 	fieldDstIP, // string
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -46,6 +47,7 @@ func (l *IPv6HopByHop) CSVRecord() []string {
 		strings.Join(opts, ""),
 		l.SrcIP,
 		l.DstIP,
+		l.Tunnel.toString(),
 	})
 }
 
@@ -95,6 +97,7 @@ func (l *IPv6HopByHop) Inc() {
 func (l *IPv6HopByHop) SetPacketContext(ctx *PacketContext) {
 	l.SrcIP = ctx.SrcIP
 	l.DstIP = ctx.DstIP
+	l.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -121,6 +124,7 @@ func (l *IPv6HopByHop) Encode() []string {
 		ip6hopEncoder.String(fieldOptions, strings.Join(opts, "")),
 		ip6hopEncoder.String(fieldSrcIP, l.SrcIP),
 		ip6hopEncoder.String(fieldDstIP, l.DstIP),
+		ip6hopEncoder.String(fieldTunnel, l.Tunnel.toString()),
 	})
 }
 
@@ -34,6 +34,7 @@ var fieldsIPSecAH = []string{
 	fieldSeq,
 	fieldSrcIP, // string
 	fieldDstIP, // string
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -50,6 +51,7 @@ func (a *IPSecAH) CSVRecord() []string {
 		formatInt32(a.Seq),
 		a.SrcIP,
 		a.DstIP,
+		a.Tunnel.toString(),
 	})
 }
 
@@ -83,6 +85,7 @@ func (a *IPSecAH) Inc() {
 func (a *IPSecAH) SetPacketContext(ctx *PacketContext) {
 	a.SrcIP = ctx.SrcIP
 	a.DstIP = ctx.DstIP
+	a.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -106,6 +109,7 @@ func (a *IPSecAH) Encode() []string {
 		ipsecahEncoder.Int32(fieldSeq, a.Seq),
 		ipsecahEncoder.String(fieldSrcIP, a.SrcIP),
 		ipsecahEncoder.String(fieldDstIP, a.DstIP),
+		ipsecahEncoder.String(fieldTunnel, a.Tunnel.toString()),
 	})
 }
 
@@ -30,6 +30,7 @@ var fieldsIPSecESP = []string{
 	fieldLenEncrypted,
 	fieldSrcIP, // string
 	fieldDstIP, // string
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -46,6 +47,7 @@ func (a *IPSecESP) CSVRecord() []string {
 		formatInt32(a.LenEncrypted),
 		a.SrcIP,
 		a.DstIP,
+		a.Tunnel.toString(),
 	})
 }
 
@@ -79,6 +81,7 @@ func (a *IPSecESP) Inc() {
 func (a *IPSecESP) SetPacketContext(ctx *PacketContext) {
 	a.SrcIP = ctx.SrcIP
 	a.DstIP = ctx.DstIP
+	a.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -102,6 +105,7 @@ func (a *IPSecESP) Encode() []string {
 		ipsecespEncoder.Int32(fieldLenEncrypted, a.LenEncrypted),
 		ipsecespEncoder.String(fieldSrcIP, a.SrcIP),
 		ipsecespEncoder.String(fieldDstIP, a.DstIP),
+		ipsecespEncoder.String(fieldTunnel, a.Tunnel.toString()),
 	})
 }
 
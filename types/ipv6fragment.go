@@ -42,6 +42,7 @@ var fieldsIPv6Fragment = []string{
 	fieldDstIP,
 	fieldSrcPort,
 	fieldDstPort,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -63,6 +64,7 @@ func (a *IPv6Fragment) CSVRecord() []string {
 		a.DstIP,
 		formatInt32(a.SrcPort),
 		formatInt32(a.DstPort),
+		a.Tunnel.toString(),
 	})
 }
 
@@ -98,6 +100,7 @@ func (a *IPv6Fragment) SetPacketContext(ctx *PacketContext) {
 	a.DstIP = ctx.DstIP
 	a.SrcPort = ctx.SrcPort
 	a.DstPort = ctx.DstPort
+	a.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -126,6 +129,7 @@ func (a *IPv6Fragment) Encode() []string {
 		ipv6fragmentEncoder.String(fieldDstIP, a.DstIP),
 		ipv6fragmentEncoder.Int32(fieldSrcPort, a.SrcPort),
 		ipv6fragmentEncoder.Int32(fieldDstPort, a.DstPort),
+		ipv6fragmentEncoder.String(fieldTunnel, a.Tunnel.toString()),
 	})
 }
 
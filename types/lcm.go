@@ -43,6 +43,7 @@ var fieldsLCM = []string{
 	fieldDstIP,
 	fieldSrcPort,
 	fieldDstPort,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -66,6 +67,7 @@ func (a *LCM) CSVRecord() []string {
 		a.DstIP,
 		formatInt32(a.SrcPort),
 		formatInt32(a.DstPort),
+		a.Tunnel.toString(),
 	})
 }
 
@@ -101,6 +103,7 @@ func (a *LCM) SetPacketContext(ctx *PacketContext) {
 	a.DstIP = ctx.DstIP
 	a.SrcPort = ctx.SrcPort
 	a.DstPort = ctx.DstPort
+	a.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -131,6 +134,7 @@ func (a *LCM) Encode() []string {
 		lcmEncoder.String(fieldDstIP, a.DstIP),
 		lcmEncoder.Int32(fieldSrcPort, a.SrcPort),
 		lcmEncoder.Int32(fieldDstPort, a.DstPort),
+		lcmEncoder.String(fieldTunnel, a.Tunnel.toString()),
 	})
 }
 
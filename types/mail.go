@@ -57,6 +57,7 @@ var fieldsMail = []string{
 	fieldClientIP, // string
 	fieldServerIP, // string
 	fieldID,       // string
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -86,6 +87,7 @@ func (d *Mail) CSVRecord() []string {
 		d.ClientIP, // string
 		d.ServerIP, // string
 		d.ID,       // string
+		d.Tunnel.toString(),
 	})
 }
 
@@ -142,6 +144,7 @@ func (d *Mail) Encode() []string {
 		mailEncoder.String(fieldClientIP, d.ClientIP), // string
 		mailEncoder.String(fieldServerIP, d.ServerIP), // string
 		mailEncoder.String(fieldID, d.ID),             // string
+		mailEncoder.String(fieldTunnel, d.Tunnel.toString()),
 	})
 }
 
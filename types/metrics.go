@@ -24,6 +24,7 @@ var Metrics = []prometheus.Collector{
 	tlsServerMetric,
 	ntpMetric,
 	sctpMetric,
+	sctpAssociationMetric,
 	ciscoDiscoveryMetric,
 	usbRequestBlockSetupMetric,
 	mplsMetric,
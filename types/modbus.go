@@ -44,6 +44,7 @@ var fieldsModbus = []string{
 	fieldDstIP,
 	fieldSrcPort,
 	fieldDstPort,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -66,6 +67,7 @@ func (a *Modbus) CSVRecord() []string {
 		a.DstIP,
 		formatInt32(a.SrcPort),
 		formatInt32(a.DstPort),
+		a.Tunnel.toString(),
 	})
 }
 
@@ -101,6 +103,7 @@ func (a *Modbus) SetPacketContext(ctx *PacketContext) {
 	a.DstIP = ctx.DstIP
 	a.SrcPort = ctx.SrcPort
 	a.DstPort = ctx.DstPort
+	a.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -130,6 +133,7 @@ func (a *Modbus) Encode() []string {
 		modbusEncoder.String(fieldDstIP, a.DstIP),
 		modbusEncoder.Int32(fieldSrcPort, a.SrcPort),
 		modbusEncoder.Int32(fieldDstPort, a.DstPort),
+		modbusEncoder.String(fieldTunnel, a.Tunnel.toString()),
 	})
 }
 
@@ -128,6 +128,7 @@ const (
 	Type_NC_IPProfile                   Type = 101
 	Type_NC_Mail                        Type = 102
 	Type_NC_Alert                       Type = 103
+	Type_NC_SCTPAssociation             Type = 104
 )
 
 var Type_name = map[int32]string{
@@ -231,6 +232,7 @@ var Type_name = map[int32]string{
 	101: "NC_IPProfile",
 	102: "NC_Mail",
 	103: "NC_Alert",
+	104: "NC_SCTPAssociation",
 }
 
 var Type_value = map[string]int32{
@@ -334,6 +336,7 @@ var Type_value = map[string]int32{
 	"NC_IPProfile":                   101,
 	"NC_Mail":                        102,
 	"NC_Alert":                       103,
+	"NC_SCTPAssociation":             104,
 }
 
 func (x Type) String() string {
@@ -350,6 +353,8 @@ type Header struct {
 	Type             Type   `protobuf:"varint,3,opt,name=Type,proto3,enum=types.Type" json:"Type,omitempty"`
 	Version          string `protobuf:"bytes,4,opt,name=Version,proto3" json:"Version,omitempty"`
 	ContainsPayloads bool   `protobuf:"varint,5,opt,name=ContainsPayloads,proto3" json:"ContainsPayloads,omitempty"`
+	Schema           []byte `protobuf:"bytes,6,opt,name=Schema,proto3" json:"Schema,omitempty"`
+	SchemaMessage    string `protobuf:"bytes,7,opt,name=SchemaMessage,proto3" json:"SchemaMessage,omitempty"`
 }
 
 func (m *Header) Reset()         { *m = Header{} }
@@ -420,6 +425,20 @@ func (m *Header) GetContainsPayloads() bool {
 	return false
 }
 
+func (m *Header) GetSchema() []byte {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *Header) GetSchemaMessage() string {
+	if m != nil {
+		return m.SchemaMessage
+	}
+	return ""
+}
+
 type Batch struct {
 	ClientID         string `protobuf:"bytes,1,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
 	MessageType      Type   `protobuf:"varint,2,opt,name=MessageType,proto3,enum=types.Type" json:"MessageType,omitempty"`
@@ -501,6 +520,8 @@ type PacketContext struct {
 	DstIP   string `protobuf:"bytes,2,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
 	SrcPort int32  `protobuf:"varint,3,opt,name=SrcPort,proto3" json:"SrcPort,omitempty"`
 	DstPort int32  `protobuf:"varint,4,opt,name=DstPort,proto3" json:"DstPort,omitempty"`
+	// tunnel the packet was decapsulated from, if any
+	Tunnel *Tunnel `protobuf:"bytes,5,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *PacketContext) Reset()         { *m = PacketContext{} }
@@ -564,6 +585,13 @@ func (m *PacketContext) GetDstPort() int32 {
 	return 0
 }
 
+func (m *PacketContext) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 // a connection has the following attributes:
 // Mac <-> Mac bidirectional Mac
 // IP <-> IP bidirectional IP
@@ -600,6 +628,33 @@ type Connection struct {
 	NumNSFlags  int32 `protobuf:"varint,28,opt,name=NumNSFlags,proto3" json:"NumNSFlags,omitempty"`
 	// tcp window size
 	MeanWindowSize int32 `protobuf:"varint,29,opt,name=MeanWindowSize,proto3" json:"MeanWindowSize,omitempty"`
+	// tunnel the connection was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,30,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
+	// Community ID flow hash
+	CommunityID string `protobuf:"bytes,31,opt,name=CommunityID,proto3" json:"CommunityID,omitempty"`
+	// tcp performance analysis
+	HandshakeRTT                  int64  `protobuf:"varint,32,opt,name=HandshakeRTT,proto3" json:"HandshakeRTT,omitempty"`
+	RetransmissionsClientToServer int32  `protobuf:"varint,33,opt,name=RetransmissionsClientToServer,proto3" json:"RetransmissionsClientToServer,omitempty"`
+	RetransmissionsServerToClient int32  `protobuf:"varint,34,opt,name=RetransmissionsServerToClient,proto3" json:"RetransmissionsServerToClient,omitempty"`
+	DupACKsClientToServer         int32  `protobuf:"varint,35,opt,name=DupACKsClientToServer,proto3" json:"DupACKsClientToServer,omitempty"`
+	DupACKsServerToClient         int32  `protobuf:"varint,36,opt,name=DupACKsServerToClient,proto3" json:"DupACKsServerToClient,omitempty"`
+	OutOfOrderClientToServer      int32  `protobuf:"varint,37,opt,name=OutOfOrderClientToServer,proto3" json:"OutOfOrderClientToServer,omitempty"`
+	OutOfOrderServerToClient      int32  `protobuf:"varint,38,opt,name=OutOfOrderServerToClient,proto3" json:"OutOfOrderServerToClient,omitempty"`
+	ZeroWindowsClientToServer     int32  `protobuf:"varint,39,opt,name=ZeroWindowsClientToServer,proto3" json:"ZeroWindowsClientToServer,omitempty"`
+	ZeroWindowsServerToClient     int32  `protobuf:"varint,40,opt,name=ZeroWindowsServerToClient,proto3" json:"ZeroWindowsServerToClient,omitempty"`
+	WindowScaleClient             int32  `protobuf:"varint,41,opt,name=WindowScaleClient,proto3" json:"WindowScaleClient,omitempty"`
+	WindowScaleServer             int32  `protobuf:"varint,42,opt,name=WindowScaleServer,proto3" json:"WindowScaleServer,omitempty"`
+	MSSClient                     int32  `protobuf:"varint,43,opt,name=MSSClient,proto3" json:"MSSClient,omitempty"`
+	MSSServer                     int32  `protobuf:"varint,44,opt,name=MSSServer,proto3" json:"MSSServer,omitempty"`
+	TerminationReason             string `protobuf:"bytes,45,opt,name=TerminationReason,proto3" json:"TerminationReason,omitempty"`
+	// encrypted traffic analysis features, only collected if enabled in the config
+	SPLTLengths                     []int32 `protobuf:"varint,46,rep,packed,name=SPLTLengths,proto3" json:"SPLTLengths,omitempty"`
+	SPLTIntervals                   []int64 `protobuf:"varint,47,rep,packed,name=SPLTIntervals,proto3" json:"SPLTIntervals,omitempty"`
+	ByteDistribution                []int32 `protobuf:"varint,48,rep,packed,name=ByteDistribution,proto3" json:"ByteDistribution,omitempty"`
+	PacketSizesClientToServer       *Stats  `protobuf:"bytes,49,opt,name=PacketSizesClientToServer,proto3" json:"PacketSizesClientToServer,omitempty"`
+	PacketSizesServerToClient       *Stats  `protobuf:"bytes,50,opt,name=PacketSizesServerToClient,proto3" json:"PacketSizesServerToClient,omitempty"`
+	InterArrivalTimesClientToServer *Stats  `protobuf:"bytes,51,opt,name=InterArrivalTimesClientToServer,proto3" json:"InterArrivalTimesClientToServer,omitempty"`
+	InterArrivalTimesServerToClient *Stats  `protobuf:"bytes,52,opt,name=InterArrivalTimesServerToClient,proto3" json:"InterArrivalTimesServerToClient,omitempty"`
 }
 
 func (m *Connection) Reset()         { *m = Connection{} }
@@ -838,6 +893,322 @@ func (m *Connection) GetMeanWindowSize() int32 {
 	return 0
 }
 
+func (m *Connection) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
+func (m *Connection) GetCommunityID() string {
+	if m != nil {
+		return m.CommunityID
+	}
+	return ""
+}
+
+func (m *Connection) GetHandshakeRTT() int64 {
+	if m != nil {
+		return m.HandshakeRTT
+	}
+	return 0
+}
+
+func (m *Connection) GetRetransmissionsClientToServer() int32 {
+	if m != nil {
+		return m.RetransmissionsClientToServer
+	}
+	return 0
+}
+
+func (m *Connection) GetRetransmissionsServerToClient() int32 {
+	if m != nil {
+		return m.RetransmissionsServerToClient
+	}
+	return 0
+}
+
+func (m *Connection) GetDupACKsClientToServer() int32 {
+	if m != nil {
+		return m.DupACKsClientToServer
+	}
+	return 0
+}
+
+func (m *Connection) GetDupACKsServerToClient() int32 {
+	if m != nil {
+		return m.DupACKsServerToClient
+	}
+	return 0
+}
+
+func (m *Connection) GetOutOfOrderClientToServer() int32 {
+	if m != nil {
+		return m.OutOfOrderClientToServer
+	}
+	return 0
+}
+
+func (m *Connection) GetOutOfOrderServerToClient() int32 {
+	if m != nil {
+		return m.OutOfOrderServerToClient
+	}
+	return 0
+}
+
+func (m *Connection) GetZeroWindowsClientToServer() int32 {
+	if m != nil {
+		return m.ZeroWindowsClientToServer
+	}
+	return 0
+}
+
+func (m *Connection) GetZeroWindowsServerToClient() int32 {
+	if m != nil {
+		return m.ZeroWindowsServerToClient
+	}
+	return 0
+}
+
+func (m *Connection) GetWindowScaleClient() int32 {
+	if m != nil {
+		return m.WindowScaleClient
+	}
+	return 0
+}
+
+func (m *Connection) GetWindowScaleServer() int32 {
+	if m != nil {
+		return m.WindowScaleServer
+	}
+	return 0
+}
+
+func (m *Connection) GetMSSClient() int32 {
+	if m != nil {
+		return m.MSSClient
+	}
+	return 0
+}
+
+func (m *Connection) GetMSSServer() int32 {
+	if m != nil {
+		return m.MSSServer
+	}
+	return 0
+}
+
+func (m *Connection) GetTerminationReason() string {
+	if m != nil {
+		return m.TerminationReason
+	}
+	return ""
+}
+
+func (m *Connection) GetSPLTLengths() []int32 {
+	if m != nil {
+		return m.SPLTLengths
+	}
+	return nil
+}
+
+func (m *Connection) GetSPLTIntervals() []int64 {
+	if m != nil {
+		return m.SPLTIntervals
+	}
+	return nil
+}
+
+func (m *Connection) GetByteDistribution() []int32 {
+	if m != nil {
+		return m.ByteDistribution
+	}
+	return nil
+}
+
+func (m *Connection) GetPacketSizesClientToServer() *Stats {
+	if m != nil {
+		return m.PacketSizesClientToServer
+	}
+	return nil
+}
+
+func (m *Connection) GetPacketSizesServerToClient() *Stats {
+	if m != nil {
+		return m.PacketSizesServerToClient
+	}
+	return nil
+}
+
+func (m *Connection) GetInterArrivalTimesClientToServer() *Stats {
+	if m != nil {
+		return m.InterArrivalTimesClientToServer
+	}
+	return nil
+}
+
+func (m *Connection) GetInterArrivalTimesServerToClient() *Stats {
+	if m != nil {
+		return m.InterArrivalTimesServerToClient
+	}
+	return nil
+}
+
+// Stats summarizes a series of values.
+type Stats struct {
+	Min    float64 `protobuf:"fixed64,1,opt,name=Min,proto3" json:"Min,omitempty"`
+	Max    float64 `protobuf:"fixed64,2,opt,name=Max,proto3" json:"Max,omitempty"`
+	Mean   float64 `protobuf:"fixed64,3,opt,name=Mean,proto3" json:"Mean,omitempty"`
+	StdDev float64 `protobuf:"fixed64,4,opt,name=StdDev,proto3" json:"StdDev,omitempty"`
+}
+
+func (m *Stats) Reset()         { *m = Stats{} }
+func (m *Stats) String() string { return proto.CompactTextString(m) }
+func (*Stats) ProtoMessage()    {}
+func (*Stats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3068659fd5590671, []int{4}
+}
+func (m *Stats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Stats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Stats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Stats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stats.Merge(m, src)
+}
+func (m *Stats) XXX_Size() int {
+	return m.Size()
+}
+func (m *Stats) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stats proto.InternalMessageInfo
+
+func (m *Stats) GetMin() float64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *Stats) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *Stats) GetMean() float64 {
+	if m != nil {
+		return m.Mean
+	}
+	return 0
+}
+
+func (m *Stats) GetStdDev() float64 {
+	if m != nil {
+		return m.StdDev
+	}
+	return 0
+}
+
+// Tunnel contains the identifiers of the tunnel
+// a decapsulated packet has been transported in.
+type Tunnel struct {
+	Type       string `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	OuterSrcIP string `protobuf:"bytes,2,opt,name=OuterSrcIP,proto3" json:"OuterSrcIP,omitempty"`
+	OuterDstIP string `protobuf:"bytes,3,opt,name=OuterDstIP,proto3" json:"OuterDstIP,omitempty"`
+	VNI        uint32 `protobuf:"varint,4,opt,name=VNI,proto3" json:"VNI,omitempty"`
+	GREKey     uint32 `protobuf:"varint,5,opt,name=GREKey,proto3" json:"GREKey,omitempty"`
+	SessionID  uint32 `protobuf:"varint,6,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+}
+
+func (m *Tunnel) Reset()         { *m = Tunnel{} }
+func (m *Tunnel) String() string { return proto.CompactTextString(m) }
+func (*Tunnel) ProtoMessage()    {}
+func (*Tunnel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3068659fd5590671, []int{5}
+}
+func (m *Tunnel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Tunnel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Tunnel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Tunnel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tunnel.Merge(m, src)
+}
+func (m *Tunnel) XXX_Size() int {
+	return m.Size()
+}
+func (m *Tunnel) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tunnel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tunnel proto.InternalMessageInfo
+
+func (m *Tunnel) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Tunnel) GetOuterSrcIP() string {
+	if m != nil {
+		return m.OuterSrcIP
+	}
+	return ""
+}
+
+func (m *Tunnel) GetOuterDstIP() string {
+	if m != nil {
+		return m.OuterDstIP
+	}
+	return ""
+}
+
+func (m *Tunnel) GetVNI() uint32 {
+	if m != nil {
+		return m.VNI
+	}
+	return 0
+}
+
+func (m *Tunnel) GetGREKey() uint32 {
+	if m != nil {
+		return m.GREKey
+	}
+	return 0
+}
+
+func (m *Tunnel) GetSessionID() uint32 {
+	if m != nil {
+		return m.SessionID
+	}
+	return 0
+}
+
 // Ethernet is a family of computer networking technologies commonly used in local area networks (LAN), metropolitan area networks (MAN) and wide area networks (WAN).
 // It was commercially introduced in 1980 and first standardized in 1983 as IEEE 802.3.
 // Ethernet has since retained a good deal of backward compatibility and has been refined to support higher bit rates, a greater number of nodes, and longer link distances.
@@ -855,7 +1226,7 @@ func (m *Ethernet) Reset()         { *m = Ethernet{} }
 func (m *Ethernet) String() string { return proto.CompactTextString(m) }
 func (*Ethernet) ProtoMessage()    {}
 func (*Ethernet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{4}
+	return fileDescriptor_3068659fd5590671, []int{6}
 }
 func (m *Ethernet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -946,7 +1317,7 @@ func (m *ARP) Reset()         { *m = ARP{} }
 func (m *ARP) String() string { return proto.CompactTextString(m) }
 func (*ARP) ProtoMessage()    {}
 func (*ARP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{5}
+	return fileDescriptor_3068659fd5590671, []int{7}
 }
 func (m *ARP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1058,7 +1429,7 @@ func (m *Dot1Q) Reset()         { *m = Dot1Q{} }
 func (m *Dot1Q) String() string { return proto.CompactTextString(m) }
 func (*Dot1Q) ProtoMessage()    {}
 func (*Dot1Q) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{6}
+	return fileDescriptor_3068659fd5590671, []int{8}
 }
 func (m *Dot1Q) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1145,7 +1516,7 @@ func (m *Dot11) Reset()         { *m = Dot11{} }
 func (m *Dot11) String() string { return proto.CompactTextString(m) }
 func (*Dot11) ProtoMessage()    {}
 func (*Dot11) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{7}
+	return fileDescriptor_3068659fd5590671, []int{9}
 }
 func (m *Dot11) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1283,7 +1654,7 @@ func (m *Dot11QOS) Reset()         { *m = Dot11QOS{} }
 func (m *Dot11QOS) String() string { return proto.CompactTextString(m) }
 func (*Dot11QOS) ProtoMessage()    {}
 func (*Dot11QOS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{8}
+	return fileDescriptor_3068659fd5590671, []int{10}
 }
 func (m *Dot11QOS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1351,7 +1722,7 @@ func (m *Dot11HTControl) Reset()         { *m = Dot11HTControl{} }
 func (m *Dot11HTControl) String() string { return proto.CompactTextString(m) }
 func (*Dot11HTControl) ProtoMessage()    {}
 func (*Dot11HTControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{9}
+	return fileDescriptor_3068659fd5590671, []int{11}
 }
 func (m *Dot11HTControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1425,7 +1796,7 @@ func (m *Dot11HTControlVHT) Reset()         { *m = Dot11HTControlVHT{} }
 func (m *Dot11HTControlVHT) String() string { return proto.CompactTextString(m) }
 func (*Dot11HTControlVHT) ProtoMessage()    {}
 func (*Dot11HTControlVHT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{10}
+	return fileDescriptor_3068659fd5590671, []int{12}
 }
 func (m *Dot11HTControlVHT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1537,7 +1908,7 @@ func (m *Dot11HTControlHT) Reset()         { *m = Dot11HTControlHT{} }
 func (m *Dot11HTControlHT) String() string { return proto.CompactTextString(m) }
 func (*Dot11HTControlHT) ProtoMessage()    {}
 func (*Dot11HTControlHT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{11}
+	return fileDescriptor_3068659fd5590671, []int{13}
 }
 func (m *Dot11HTControlHT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1619,7 +1990,7 @@ func (m *Dot11HTControlMFB) Reset()         { *m = Dot11HTControlMFB{} }
 func (m *Dot11HTControlMFB) String() string { return proto.CompactTextString(m) }
 func (*Dot11HTControlMFB) ProtoMessage()    {}
 func (*Dot11HTControlMFB) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{12}
+	return fileDescriptor_3068659fd5590671, []int{14}
 }
 func (m *Dot11HTControlMFB) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1689,7 +2060,7 @@ func (m *Dot11LinkAdapationControl) Reset()         { *m = Dot11LinkAdapationCon
 func (m *Dot11LinkAdapationControl) String() string { return proto.CompactTextString(m) }
 func (*Dot11LinkAdapationControl) ProtoMessage()    {}
 func (*Dot11LinkAdapationControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{13}
+	return fileDescriptor_3068659fd5590671, []int{15}
 }
 func (m *Dot11LinkAdapationControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1769,7 +2140,7 @@ func (m *Dot11ASEL) Reset()         { *m = Dot11ASEL{} }
 func (m *Dot11ASEL) String() string { return proto.CompactTextString(m) }
 func (*Dot11ASEL) ProtoMessage()    {}
 func (*Dot11ASEL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{14}
+	return fileDescriptor_3068659fd5590671, []int{16}
 }
 func (m *Dot11ASEL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1830,7 +2201,7 @@ func (m *LinkLayerDiscovery) Reset()         { *m = LinkLayerDiscovery{} }
 func (m *LinkLayerDiscovery) String() string { return proto.CompactTextString(m) }
 func (*LinkLayerDiscovery) ProtoMessage()    {}
 func (*LinkLayerDiscovery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{15}
+	return fileDescriptor_3068659fd5590671, []int{17}
 }
 func (m *LinkLayerDiscovery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1903,7 +2274,7 @@ func (m *LLDPChassisID) Reset()         { *m = LLDPChassisID{} }
 func (m *LLDPChassisID) String() string { return proto.CompactTextString(m) }
 func (*LLDPChassisID) ProtoMessage()    {}
 func (*LLDPChassisID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{16}
+	return fileDescriptor_3068659fd5590671, []int{18}
 }
 func (m *LLDPChassisID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1955,7 +2326,7 @@ func (m *LLDPPortID) Reset()         { *m = LLDPPortID{} }
 func (m *LLDPPortID) String() string { return proto.CompactTextString(m) }
 func (*LLDPPortID) ProtoMessage()    {}
 func (*LLDPPortID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{17}
+	return fileDescriptor_3068659fd5590671, []int{19}
 }
 func (m *LLDPPortID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2008,7 +2379,7 @@ func (m *LinkLayerDiscoveryValue) Reset()         { *m = LinkLayerDiscoveryValue
 func (m *LinkLayerDiscoveryValue) String() string { return proto.CompactTextString(m) }
 func (*LinkLayerDiscoveryValue) ProtoMessage()    {}
 func (*LinkLayerDiscoveryValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{18}
+	return fileDescriptor_3068659fd5590671, []int{20}
 }
 func (m *LinkLayerDiscoveryValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2067,7 +2438,7 @@ func (m *EthernetCTP) Reset()         { *m = EthernetCTP{} }
 func (m *EthernetCTP) String() string { return proto.CompactTextString(m) }
 func (*EthernetCTP) ProtoMessage()    {}
 func (*EthernetCTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{19}
+	return fileDescriptor_3068659fd5590671, []int{21}
 }
 func (m *EthernetCTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2121,7 +2492,7 @@ func (m *EthernetCTPReply) Reset()         { *m = EthernetCTPReply{} }
 func (m *EthernetCTPReply) String() string { return proto.CompactTextString(m) }
 func (*EthernetCTPReply) ProtoMessage()    {}
 func (*EthernetCTPReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{20}
+	return fileDescriptor_3068659fd5590671, []int{22}
 }
 func (m *EthernetCTPReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2193,7 +2564,7 @@ func (m *LinkLayerDiscoveryInfo) Reset()         { *m = LinkLayerDiscoveryInfo{}
 func (m *LinkLayerDiscoveryInfo) String() string { return proto.CompactTextString(m) }
 func (*LinkLayerDiscoveryInfo) ProtoMessage()    {}
 func (*LinkLayerDiscoveryInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{21}
+	return fileDescriptor_3068659fd5590671, []int{23}
 }
 func (m *LinkLayerDiscoveryInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2287,7 +2658,7 @@ func (m *LLDPSysCapabilities) Reset()         { *m = LLDPSysCapabilities{} }
 func (m *LLDPSysCapabilities) String() string { return proto.CompactTextString(m) }
 func (*LLDPSysCapabilities) ProtoMessage()    {}
 func (*LLDPSysCapabilities) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{22}
+	return fileDescriptor_3068659fd5590671, []int{24}
 }
 func (m *LLDPSysCapabilities) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2348,7 +2719,7 @@ func (m *LLDPCapabilities) Reset()         { *m = LLDPCapabilities{} }
 func (m *LLDPCapabilities) String() string { return proto.CompactTextString(m) }
 func (*LLDPCapabilities) ProtoMessage()    {}
 func (*LLDPCapabilities) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{23}
+	return fileDescriptor_3068659fd5590671, []int{25}
 }
 func (m *LLDPCapabilities) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2466,7 +2837,7 @@ func (m *LLDPMgmtAddress) Reset()         { *m = LLDPMgmtAddress{} }
 func (m *LLDPMgmtAddress) String() string { return proto.CompactTextString(m) }
 func (*LLDPMgmtAddress) ProtoMessage()    {}
 func (*LLDPMgmtAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{24}
+	return fileDescriptor_3068659fd5590671, []int{26}
 }
 func (m *LLDPMgmtAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2540,7 +2911,7 @@ func (m *LLDPOrgSpecificTLV) Reset()         { *m = LLDPOrgSpecificTLV{} }
 func (m *LLDPOrgSpecificTLV) String() string { return proto.CompactTextString(m) }
 func (*LLDPOrgSpecificTLV) ProtoMessage()    {}
 func (*LLDPOrgSpecificTLV) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{25}
+	return fileDescriptor_3068659fd5590671, []int{27}
 }
 func (m *LLDPOrgSpecificTLV) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2616,13 +2987,15 @@ type IPv4 struct {
 	PayloadSize    int32         `protobuf:"varint,17,opt,name=PayloadSize,proto3" json:"PayloadSize,omitempty"`
 	SrcPort        int32         `protobuf:"varint,18,opt,name=SrcPort,proto3" json:"SrcPort,omitempty"`
 	DstPort        int32         `protobuf:"varint,19,opt,name=DstPort,proto3" json:"DstPort,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,20,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *IPv4) Reset()         { *m = IPv4{} }
 func (m *IPv4) String() string { return proto.CompactTextString(m) }
 func (*IPv4) ProtoMessage()    {}
 func (*IPv4) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{26}
+	return fileDescriptor_3068659fd5590671, []int{28}
 }
 func (m *IPv4) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2784,6 +3157,13 @@ func (m *IPv4) GetDstPort() int32 {
 	return 0
 }
 
+func (m *IPv4) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type IPv4Option struct {
 	OptionType   int32  `protobuf:"varint,1,opt,name=OptionType,proto3" json:"OptionType,omitempty"`
 	OptionLength int32  `protobuf:"varint,2,opt,name=OptionLength,proto3" json:"OptionLength,omitempty"`
@@ -2794,7 +3174,7 @@ func (m *IPv4Option) Reset()         { *m = IPv4Option{} }
 func (m *IPv4Option) String() string { return proto.CompactTextString(m) }
 func (*IPv4Option) ProtoMessage()    {}
 func (*IPv4Option) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{27}
+	return fileDescriptor_3068659fd5590671, []int{29}
 }
 func (m *IPv4Option) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2864,13 +3244,15 @@ type IPv6 struct {
 	HopByHop       *IPv6HopByHop `protobuf:"bytes,12,opt,name=HopByHop,proto3" json:"HopByHop,omitempty"`
 	SrcPort        int32         `protobuf:"varint,13,opt,name=SrcPort,proto3" json:"SrcPort,omitempty"`
 	DstPort        int32         `protobuf:"varint,14,opt,name=DstPort,proto3" json:"DstPort,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,15,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *IPv6) Reset()         { *m = IPv6{} }
 func (m *IPv6) String() string { return proto.CompactTextString(m) }
 func (*IPv6) ProtoMessage()    {}
 func (*IPv6) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{28}
+	return fileDescriptor_3068659fd5590671, []int{30}
 }
 func (m *IPv6) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2997,6 +3379,13 @@ func (m *IPv6) GetDstPort() int32 {
 	return 0
 }
 
+func (m *IPv6) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type IPv6Fragment struct {
 	Timestamp      int64  `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 	NextHeader     int32  `protobuf:"varint,2,opt,name=NextHeader,proto3" json:"NextHeader,omitempty"`
@@ -3009,13 +3398,15 @@ type IPv6Fragment struct {
 	DstPort        int32  `protobuf:"varint,9,opt,name=DstPort,proto3" json:"DstPort,omitempty"`
 	SrcIP          string `protobuf:"bytes,10,opt,name=SrcIP,proto3" json:"SrcIP,omitempty"`
 	DstIP          string `protobuf:"bytes,11,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,12,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *IPv6Fragment) Reset()         { *m = IPv6Fragment{} }
 func (m *IPv6Fragment) String() string { return proto.CompactTextString(m) }
 func (*IPv6Fragment) ProtoMessage()    {}
 func (*IPv6Fragment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{29}
+	return fileDescriptor_3068659fd5590671, []int{31}
 }
 func (m *IPv6Fragment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3121,6 +3512,13 @@ func (m *IPv6Fragment) GetDstIP() string {
 	return ""
 }
 
+func (m *IPv6Fragment) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type ICMPv4 struct {
 	Timestamp int64  `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 	TypeCode  int32  `protobuf:"varint,2,opt,name=TypeCode,proto3" json:"TypeCode,omitempty"`
@@ -3129,13 +3527,15 @@ type ICMPv4 struct {
 	Seq       int32  `protobuf:"varint,5,opt,name=Seq,proto3" json:"Seq,omitempty"`
 	SrcIP     string `protobuf:"bytes,6,opt,name=SrcIP,proto3" json:"SrcIP,omitempty"`
 	DstIP     string `protobuf:"bytes,7,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,8,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *ICMPv4) Reset()         { *m = ICMPv4{} }
 func (m *ICMPv4) String() string { return proto.CompactTextString(m) }
 func (*ICMPv4) ProtoMessage()    {}
 func (*ICMPv4) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{30}
+	return fileDescriptor_3068659fd5590671, []int{32}
 }
 func (m *ICMPv4) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3213,19 +3613,28 @@ func (m *ICMPv4) GetDstIP() string {
 	return ""
 }
 
+func (m *ICMPv4) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type ICMPv6 struct {
 	Timestamp int64  `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 	TypeCode  int32  `protobuf:"varint,2,opt,name=TypeCode,proto3" json:"TypeCode,omitempty"`
 	Checksum  int32  `protobuf:"varint,3,opt,name=Checksum,proto3" json:"Checksum,omitempty"`
 	SrcIP     string `protobuf:"bytes,4,opt,name=SrcIP,proto3" json:"SrcIP,omitempty"`
 	DstIP     string `protobuf:"bytes,5,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,6,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *ICMPv6) Reset()         { *m = ICMPv6{} }
 func (m *ICMPv6) String() string { return proto.CompactTextString(m) }
 func (*ICMPv6) ProtoMessage()    {}
 func (*ICMPv6) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{31}
+	return fileDescriptor_3068659fd5590671, []int{33}
 }
 func (m *ICMPv6) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3289,6 +3698,13 @@ func (m *ICMPv6) GetDstIP() string {
 	return ""
 }
 
+func (m *ICMPv6) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type ICMPv6NeighborAdvertisement struct {
 	Timestamp     int64           `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 	Flags         int32           `protobuf:"varint,2,opt,name=Flags,proto3" json:"Flags,omitempty"`
@@ -3296,13 +3712,15 @@ type ICMPv6NeighborAdvertisement struct {
 	Options       []*ICMPv6Option `protobuf:"bytes,4,rep,name=Options,proto3" json:"Options,omitempty"`
 	SrcIP         string          `protobuf:"bytes,5,opt,name=SrcIP,proto3" json:"SrcIP,omitempty"`
 	DstIP         string          `protobuf:"bytes,6,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,7,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *ICMPv6NeighborAdvertisement) Reset()         { *m = ICMPv6NeighborAdvertisement{} }
 func (m *ICMPv6NeighborAdvertisement) String() string { return proto.CompactTextString(m) }
 func (*ICMPv6NeighborAdvertisement) ProtoMessage()    {}
 func (*ICMPv6NeighborAdvertisement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{32}
+	return fileDescriptor_3068659fd5590671, []int{34}
 }
 func (m *ICMPv6NeighborAdvertisement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3373,6 +3791,13 @@ func (m *ICMPv6NeighborAdvertisement) GetDstIP() string {
 	return ""
 }
 
+func (m *ICMPv6NeighborAdvertisement) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type ICMPv6RouterAdvertisement struct {
 	Timestamp      int64           `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 	HopLimit       int32           `protobuf:"varint,2,opt,name=HopLimit,proto3" json:"HopLimit,omitempty"`
@@ -3383,13 +3808,15 @@ type ICMPv6RouterAdvertisement struct {
 	Options        []*ICMPv6Option `protobuf:"bytes,7,rep,name=Options,proto3" json:"Options,omitempty"`
 	SrcIP          string          `protobuf:"bytes,8,opt,name=SrcIP,proto3" json:"SrcIP,omitempty"`
 	DstIP          string          `protobuf:"bytes,9,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,10,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *ICMPv6RouterAdvertisement) Reset()         { *m = ICMPv6RouterAdvertisement{} }
 func (m *ICMPv6RouterAdvertisement) String() string { return proto.CompactTextString(m) }
 func (*ICMPv6RouterAdvertisement) ProtoMessage()    {}
 func (*ICMPv6RouterAdvertisement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{33}
+	return fileDescriptor_3068659fd5590671, []int{35}
 }
 func (m *ICMPv6RouterAdvertisement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3481,6 +3908,13 @@ func (m *ICMPv6RouterAdvertisement) GetDstIP() string {
 	return ""
 }
 
+func (m *ICMPv6RouterAdvertisement) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type ICMPv6Option struct {
 	Type int32  `protobuf:"varint,1,opt,name=Type,proto3" json:"Type,omitempty"`
 	Data []byte `protobuf:"bytes,2,opt,name=Data,proto3" json:"Data,omitempty"`
@@ -3490,7 +3924,7 @@ func (m *ICMPv6Option) Reset()         { *m = ICMPv6Option{} }
 func (m *ICMPv6Option) String() string { return proto.CompactTextString(m) }
 func (*ICMPv6Option) ProtoMessage()    {}
 func (*ICMPv6Option) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{34}
+	return fileDescriptor_3068659fd5590671, []int{36}
 }
 func (m *ICMPv6Option) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3549,13 +3983,17 @@ type UDP struct {
 	Payload        []byte  `protobuf:"bytes,8,opt,name=Payload,proto3" json:"Payload,omitempty"`
 	SrcIP          string  `protobuf:"bytes,9,opt,name=SrcIP,proto3" json:"SrcIP,omitempty"`
 	DstIP          string  `protobuf:"bytes,10,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
+	// Community ID flow hash
+	CommunityID string `protobuf:"bytes,11,opt,name=CommunityID,proto3" json:"CommunityID,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,12,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *UDP) Reset()         { *m = UDP{} }
 func (m *UDP) String() string { return proto.CompactTextString(m) }
 func (*UDP) ProtoMessage()    {}
 func (*UDP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{35}
+	return fileDescriptor_3068659fd5590671, []int{37}
 }
 func (m *UDP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3654,6 +4092,20 @@ func (m *UDP) GetDstIP() string {
 	return ""
 }
 
+func (m *UDP) GetCommunityID() string {
+	if m != nil {
+		return m.CommunityID
+	}
+	return ""
+}
+
+func (m *UDP) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 // The Transmission Control Protocol (TCP) is one of the main protocols of the Internet
 // protocol suite. It originated in the initial network implementation in which it
 // complemented the Internet Protocol (IP). Therefore, the entire suite is commonly
@@ -3688,13 +4140,17 @@ type TCP struct {
 	Payload        []byte       `protobuf:"bytes,23,opt,name=Payload,proto3" json:"Payload,omitempty"`
 	SrcIP          string       `protobuf:"bytes,24,opt,name=SrcIP,proto3" json:"SrcIP,omitempty"`
 	DstIP          string       `protobuf:"bytes,25,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
+	// Community ID flow hash
+	CommunityID string `protobuf:"bytes,26,opt,name=CommunityID,proto3" json:"CommunityID,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,27,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *TCP) Reset()         { *m = TCP{} }
 func (m *TCP) String() string { return proto.CompactTextString(m) }
 func (*TCP) ProtoMessage()    {}
 func (*TCP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{36}
+	return fileDescriptor_3068659fd5590671, []int{38}
 }
 func (m *TCP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3898,6 +4354,20 @@ func (m *TCP) GetDstIP() string {
 	return ""
 }
 
+func (m *TCP) GetCommunityID() string {
+	if m != nil {
+		return m.CommunityID
+	}
+	return ""
+}
+
+func (m *TCP) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type TCPOption struct {
 	OptionType   int32  `protobuf:"varint,1,opt,name=OptionType,proto3" json:"OptionType,omitempty"`
 	OptionLength int32  `protobuf:"varint,2,opt,name=OptionLength,proto3" json:"OptionLength,omitempty"`
@@ -3908,7 +4378,7 @@ func (m *TCPOption) Reset()         { *m = TCPOption{} }
 func (m *TCPOption) String() string { return proto.CompactTextString(m) }
 func (*TCPOption) ProtoMessage()    {}
 func (*TCPOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{37}
+	return fileDescriptor_3068659fd5590671, []int{39}
 }
 func (m *TCPOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3966,13 +4436,15 @@ type SCTP struct {
 	Checksum        uint32 `protobuf:"varint,5,opt,name=Checksum,proto3" json:"Checksum,omitempty"`
 	SrcIP           string `protobuf:"bytes,6,opt,name=SrcIP,proto3" json:"SrcIP,omitempty"`
 	DstIP           string `protobuf:"bytes,7,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,8,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *SCTP) Reset()         { *m = SCTP{} }
 func (m *SCTP) String() string { return proto.CompactTextString(m) }
 func (*SCTP) ProtoMessage()    {}
 func (*SCTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{38}
+	return fileDescriptor_3068659fd5590671, []int{40}
 }
 func (m *SCTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4050,6 +4522,260 @@ func (m *SCTP) GetDstIP() string {
 	return ""
 }
 
+func (m *SCTP) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
+// SCTPAssociation summarizes a tracked SCTP association between two endpoints,
+// including statistics about the user messages reassembled from its DATA chunks.
+type SCTPAssociation struct {
+	TimestampFirst      int64    `protobuf:"varint,1,opt,name=TimestampFirst,proto3" json:"TimestampFirst,omitempty"`
+	TimestampLast       int64    `protobuf:"varint,2,opt,name=TimestampLast,proto3" json:"TimestampLast,omitempty"`
+	Duration            int64    `protobuf:"varint,3,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	UID                 string   `protobuf:"bytes,4,opt,name=UID,proto3" json:"UID,omitempty"`
+	SrcIP               string   `protobuf:"bytes,5,opt,name=SrcIP,proto3" json:"SrcIP,omitempty"`
+	SrcPort             int32    `protobuf:"varint,6,opt,name=SrcPort,proto3" json:"SrcPort,omitempty"`
+	DstIP               string   `protobuf:"bytes,7,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
+	DstPort             int32    `protobuf:"varint,8,opt,name=DstPort,proto3" json:"DstPort,omitempty"`
+	SrcVerificationTag  uint32   `protobuf:"varint,9,opt,name=SrcVerificationTag,proto3" json:"SrcVerificationTag,omitempty"`
+	DstVerificationTag  uint32   `protobuf:"varint,10,opt,name=DstVerificationTag,proto3" json:"DstVerificationTag,omitempty"`
+	NumPackets          int32    `protobuf:"varint,11,opt,name=NumPackets,proto3" json:"NumPackets,omitempty"`
+	NumChunks           int32    `protobuf:"varint,12,opt,name=NumChunks,proto3" json:"NumChunks,omitempty"`
+	NumDataChunks       int32    `protobuf:"varint,13,opt,name=NumDataChunks,proto3" json:"NumDataChunks,omitempty"`
+	NumFragments        int32    `protobuf:"varint,14,opt,name=NumFragments,proto3" json:"NumFragments,omitempty"`
+	NumMessages         int32    `protobuf:"varint,15,opt,name=NumMessages,proto3" json:"NumMessages,omitempty"`
+	NumStreams          int32    `protobuf:"varint,16,opt,name=NumStreams,proto3" json:"NumStreams,omitempty"`
+	NumOutOfOrder       int32    `protobuf:"varint,17,opt,name=NumOutOfOrder,proto3" json:"NumOutOfOrder,omitempty"`
+	NumDuplicates       int32    `protobuf:"varint,18,opt,name=NumDuplicates,proto3" json:"NumDuplicates,omitempty"`
+	NumIncomplete       int32    `protobuf:"varint,19,opt,name=NumIncomplete,proto3" json:"NumIncomplete,omitempty"`
+	BytesClientToServer int64    `protobuf:"varint,20,opt,name=BytesClientToServer,proto3" json:"BytesClientToServer,omitempty"`
+	BytesServerToClient int64    `protobuf:"varint,21,opt,name=BytesServerToClient,proto3" json:"BytesServerToClient,omitempty"`
+	PayloadProtocols    []string `protobuf:"bytes,22,rep,name=PayloadProtocols,proto3" json:"PayloadProtocols,omitempty"`
+	Init                bool     `protobuf:"varint,23,opt,name=Init,proto3" json:"Init,omitempty"`
+	Shutdown            bool     `protobuf:"varint,24,opt,name=Shutdown,proto3" json:"Shutdown,omitempty"`
+	Abort               bool     `protobuf:"varint,25,opt,name=Abort,proto3" json:"Abort,omitempty"`
+	// tunnel the association was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,26,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
+}
+
+func (m *SCTPAssociation) Reset()         { *m = SCTPAssociation{} }
+func (m *SCTPAssociation) String() string { return proto.CompactTextString(m) }
+func (*SCTPAssociation) ProtoMessage()    {}
+func (*SCTPAssociation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3068659fd5590671, []int{41}
+}
+func (m *SCTPAssociation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SCTPAssociation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SCTPAssociation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SCTPAssociation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SCTPAssociation.Merge(m, src)
+}
+func (m *SCTPAssociation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SCTPAssociation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SCTPAssociation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SCTPAssociation proto.InternalMessageInfo
+
+func (m *SCTPAssociation) GetTimestampFirst() int64 {
+	if m != nil {
+		return m.TimestampFirst
+	}
+	return 0
+}
+
+func (m *SCTPAssociation) GetTimestampLast() int64 {
+	if m != nil {
+		return m.TimestampLast
+	}
+	return 0
+}
+
+func (m *SCTPAssociation) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *SCTPAssociation) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+func (m *SCTPAssociation) GetSrcIP() string {
+	if m != nil {
+		return m.SrcIP
+	}
+	return ""
+}
+
+func (m *SCTPAssociation) GetSrcPort() int32 {
+	if m != nil {
+		return m.SrcPort
+	}
+	return 0
+}
+
+func (m *SCTPAssociation) GetDstIP() string {
+	if m != nil {
+		return m.DstIP
+	}
+	return ""
+}
+
+func (m *SCTPAssociation) GetDstPort() int32 {
+	if m != nil {
+		return m.DstPort
+	}
+	return 0
+}
+
+func (m *SCTPAssociation) GetSrcVerificationTag() uint32 {
+	if m != nil {
+		return m.SrcVerificationTag
+	}
+	return 0
+}
+
+func (m *SCTPAssociation) GetDstVerificationTag() uint32 {
+	if m != nil {
+		return m.DstVerificationTag
+	}
+	return 0
+}
+
+func (m *SCTPAssociation) GetNumPackets() int32 {
+	if m != nil {
+		return m.NumPackets
+	}
+	return 0
+}
+
+func (m *SCTPAssociation) GetNumChunks() int32 {
+	if m != nil {
+		return m.NumChunks
+	}
+	return 0
+}
+
+func (m *SCTPAssociation) GetNumDataChunks() int32 {
+	if m != nil {
+		return m.NumDataChunks
+	}
+	return 0
+}
+
+func (m *SCTPAssociation) GetNumFragments() int32 {
+	if m != nil {
+		return m.NumFragments
+	}
+	return 0
+}
+
+func (m *SCTPAssociation) GetNumMessages() int32 {
+	if m != nil {
+		return m.NumMessages
+	}
+	return 0
+}
+
+func (m *SCTPAssociation) GetNumStreams() int32 {
+	if m != nil {
+		return m.NumStreams
+	}
+	return 0
+}
+
+func (m *SCTPAssociation) GetNumOutOfOrder() int32 {
+	if m != nil {
+		return m.NumOutOfOrder
+	}
+	return 0
+}
+
+func (m *SCTPAssociation) GetNumDuplicates() int32 {
+	if m != nil {
+		return m.NumDuplicates
+	}
+	return 0
+}
+
+func (m *SCTPAssociation) GetNumIncomplete() int32 {
+	if m != nil {
+		return m.NumIncomplete
+	}
+	return 0
+}
+
+func (m *SCTPAssociation) GetBytesClientToServer() int64 {
+	if m != nil {
+		return m.BytesClientToServer
+	}
+	return 0
+}
+
+func (m *SCTPAssociation) GetBytesServerToClient() int64 {
+	if m != nil {
+		return m.BytesServerToClient
+	}
+	return 0
+}
+
+func (m *SCTPAssociation) GetPayloadProtocols() []string {
+	if m != nil {
+		return m.PayloadProtocols
+	}
+	return nil
+}
+
+func (m *SCTPAssociation) GetInit() bool {
+	if m != nil {
+		return m.Init
+	}
+	return false
+}
+
+func (m *SCTPAssociation) GetShutdown() bool {
+	if m != nil {
+		return m.Shutdown
+	}
+	return false
+}
+
+func (m *SCTPAssociation) GetAbort() bool {
+	if m != nil {
+		return m.Abort
+	}
+	return false
+}
+
+func (m *SCTPAssociation) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 // The Domain Name System (DNS) is a hierarchical and decentralized naming system
 // for computers, services, or other resources connected to the Internet or a private
 // network. It associates various information with domain names assigned to each of
@@ -4083,13 +4809,17 @@ type DNS struct {
 	DstIP       string               `protobuf:"bytes,20,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
 	SrcPort     int32                `protobuf:"varint,21,opt,name=SrcPort,proto3" json:"SrcPort,omitempty"`
 	DstPort     int32                `protobuf:"varint,22,opt,name=DstPort,proto3" json:"DstPort,omitempty"`
+	// Community ID flow hash
+	CommunityID string `protobuf:"bytes,23,opt,name=CommunityID,proto3" json:"CommunityID,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,24,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *DNS) Reset()         { *m = DNS{} }
 func (m *DNS) String() string { return proto.CompactTextString(m) }
 func (*DNS) ProtoMessage()    {}
 func (*DNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{39}
+	return fileDescriptor_3068659fd5590671, []int{42}
 }
 func (m *DNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4272,6 +5002,20 @@ func (m *DNS) GetDstPort() int32 {
 	return 0
 }
 
+func (m *DNS) GetCommunityID() string {
+	if m != nil {
+		return m.CommunityID
+	}
+	return ""
+}
+
+func (m *DNS) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type DNSResourceRecord struct {
 	// Header
 	Name  string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
@@ -4296,7 +5040,7 @@ func (m *DNSResourceRecord) Reset()         { *m = DNSResourceRecord{} }
 func (m *DNSResourceRecord) String() string { return proto.CompactTextString(m) }
 func (*DNSResourceRecord) ProtoMessage()    {}
 func (*DNSResourceRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{40}
+	return fileDescriptor_3068659fd5590671, []int{43}
 }
 func (m *DNSResourceRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4439,7 +5183,7 @@ func (m *DNSSOA) Reset()         { *m = DNSSOA{} }
 func (m *DNSSOA) String() string { return proto.CompactTextString(m) }
 func (*DNSSOA) ProtoMessage()    {}
 func (*DNSSOA) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{41}
+	return fileDescriptor_3068659fd5590671, []int{44}
 }
 func (m *DNSSOA) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4529,7 +5273,7 @@ func (m *DNSSRV) Reset()         { *m = DNSSRV{} }
 func (m *DNSSRV) String() string { return proto.CompactTextString(m) }
 func (*DNSSRV) ProtoMessage()    {}
 func (*DNSSRV) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{42}
+	return fileDescriptor_3068659fd5590671, []int{45}
 }
 func (m *DNSSRV) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4596,7 +5340,7 @@ func (m *DNSMX) Reset()         { *m = DNSMX{} }
 func (m *DNSMX) String() string { return proto.CompactTextString(m) }
 func (*DNSMX) ProtoMessage()    {}
 func (*DNSMX) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{43}
+	return fileDescriptor_3068659fd5590671, []int{46}
 }
 func (m *DNSMX) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4650,7 +5394,7 @@ func (m *DNSQuestion) Reset()         { *m = DNSQuestion{} }
 func (m *DNSQuestion) String() string { return proto.CompactTextString(m) }
 func (*DNSQuestion) ProtoMessage()    {}
 func (*DNSQuestion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{44}
+	return fileDescriptor_3068659fd5590671, []int{47}
 }
 func (m *DNSQuestion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4722,13 +5466,15 @@ type DHCPv4 struct {
 	DstIP        string        `protobuf:"bytes,19,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
 	SrcPort      int32         `protobuf:"varint,20,opt,name=SrcPort,proto3" json:"SrcPort,omitempty"`
 	DstPort      int32         `protobuf:"varint,21,opt,name=DstPort,proto3" json:"DstPort,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,22,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *DHCPv4) Reset()         { *m = DHCPv4{} }
 func (m *DHCPv4) String() string { return proto.CompactTextString(m) }
 func (*DHCPv4) ProtoMessage()    {}
 func (*DHCPv4) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{45}
+	return fileDescriptor_3068659fd5590671, []int{48}
 }
 func (m *DHCPv4) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4904,6 +5650,13 @@ func (m *DHCPv4) GetDstPort() int32 {
 	return 0
 }
 
+func (m *DHCPv4) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type DHCPOption struct {
 	Type   int32  `protobuf:"varint,1,opt,name=Type,proto3" json:"Type,omitempty"`
 	Length int32  `protobuf:"varint,2,opt,name=Length,proto3" json:"Length,omitempty"`
@@ -4914,7 +5667,7 @@ func (m *DHCPOption) Reset()         { *m = DHCPOption{} }
 func (m *DHCPOption) String() string { return proto.CompactTextString(m) }
 func (*DHCPOption) ProtoMessage()    {}
 func (*DHCPOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{46}
+	return fileDescriptor_3068659fd5590671, []int{49}
 }
 func (m *DHCPOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4977,13 +5730,15 @@ type DHCPv6 struct {
 	DstIP         string          `protobuf:"bytes,10,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
 	SrcPort       int32           `protobuf:"varint,11,opt,name=SrcPort,proto3" json:"SrcPort,omitempty"`
 	DstPort       int32           `protobuf:"varint,12,opt,name=DstPort,proto3" json:"DstPort,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,13,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *DHCPv6) Reset()         { *m = DHCPv6{} }
 func (m *DHCPv6) String() string { return proto.CompactTextString(m) }
 func (*DHCPv6) ProtoMessage()    {}
 func (*DHCPv6) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{47}
+	return fileDescriptor_3068659fd5590671, []int{50}
 }
 func (m *DHCPv6) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5096,6 +5851,13 @@ func (m *DHCPv6) GetDstPort() int32 {
 	return 0
 }
 
+func (m *DHCPv6) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type DHCPv6Option struct {
 	Code   int32  `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
 	Length int32  `protobuf:"varint,2,opt,name=Length,proto3" json:"Length,omitempty"`
@@ -5106,7 +5868,7 @@ func (m *DHCPv6Option) Reset()         { *m = DHCPv6Option{} }
 func (m *DHCPv6Option) String() string { return proto.CompactTextString(m) }
 func (*DHCPv6Option) ProtoMessage()    {}
 func (*DHCPv6Option) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{48}
+	return fileDescriptor_3068659fd5590671, []int{51}
 }
 func (m *DHCPv6Option) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5171,7 +5933,7 @@ func (m *LLC) Reset()         { *m = LLC{} }
 func (m *LLC) String() string { return proto.CompactTextString(m) }
 func (*LLC) ProtoMessage()    {}
 func (*LLC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{49}
+	return fileDescriptor_3068659fd5590671, []int{52}
 }
 func (m *LLC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5266,13 +6028,15 @@ type NTP struct {
 	DstIP              string `protobuf:"bytes,17,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
 	SrcPort            int32  `protobuf:"varint,18,opt,name=SrcPort,proto3" json:"SrcPort,omitempty"`
 	DstPort            int32  `protobuf:"varint,19,opt,name=DstPort,proto3" json:"DstPort,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,20,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *NTP) Reset()         { *m = NTP{} }
 func (m *NTP) String() string { return proto.CompactTextString(m) }
 func (*NTP) ProtoMessage()    {}
 func (*NTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{50}
+	return fileDescriptor_3068659fd5590671, []int{53}
 }
 func (m *NTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5434,6 +6198,13 @@ func (m *NTP) GetDstPort() int32 {
 	return 0
 }
 
+func (m *NTP) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 // The Session Initiation Protocol (SIP) is a signalling protocol used for initiating, maintaining, and terminating real-time sessions that include voice, video and messaging applications
 type SIP struct {
 	Timestamp int64 `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
@@ -5450,13 +6221,15 @@ type SIP struct {
 	DstIP          string `protobuf:"bytes,9,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
 	SrcPort        int32  `protobuf:"varint,10,opt,name=SrcPort,proto3" json:"SrcPort,omitempty"`
 	DstPort        int32  `protobuf:"varint,11,opt,name=DstPort,proto3" json:"DstPort,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,12,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *SIP) Reset()         { *m = SIP{} }
 func (m *SIP) String() string { return proto.CompactTextString(m) }
 func (*SIP) ProtoMessage()    {}
 func (*SIP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{51}
+	return fileDescriptor_3068659fd5590671, []int{54}
 }
 func (m *SIP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5562,6 +6335,13 @@ func (m *SIP) GetDstPort() int32 {
 	return 0
 }
 
+func (m *SIP) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 // The Internet Group Management Protocol (IGMP) is a communications protocol
 // used by hosts and adjacent routers on IPv4 networks to establish multicast
 // group memberships. IGMP is an integral part of IP multicast.
@@ -5586,13 +6366,15 @@ type IGMP struct {
 	Version                 int32                `protobuf:"varint,13,opt,name=Version,proto3" json:"Version,omitempty"`
 	SrcIP                   string               `protobuf:"bytes,14,opt,name=SrcIP,proto3" json:"SrcIP,omitempty"`
 	DstIP                   string               `protobuf:"bytes,15,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,16,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *IGMP) Reset()         { *m = IGMP{} }
 func (m *IGMP) String() string { return proto.CompactTextString(m) }
 func (*IGMP) ProtoMessage()    {}
 func (*IGMP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{52}
+	return fileDescriptor_3068659fd5590671, []int{55}
 }
 func (m *IGMP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5726,6 +6508,13 @@ func (m *IGMP) GetDstIP() string {
 	return ""
 }
 
+func (m *IGMP) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type IGMPv3GroupRecord struct {
 	Type             int32    `protobuf:"varint,1,opt,name=Type,proto3" json:"Type,omitempty"`
 	AuxDataLen       int32    `protobuf:"varint,2,opt,name=AuxDataLen,proto3" json:"AuxDataLen,omitempty"`
@@ -5738,7 +6527,7 @@ func (m *IGMPv3GroupRecord) Reset()         { *m = IGMPv3GroupRecord{} }
 func (m *IGMPv3GroupRecord) String() string { return proto.CompactTextString(m) }
 func (*IGMPv3GroupRecord) ProtoMessage()    {}
 func (*IGMPv3GroupRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{53}
+	return fileDescriptor_3068659fd5590671, []int{56}
 }
 func (m *IGMPv3GroupRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5807,13 +6596,15 @@ type IPv6HopByHop struct {
 	Options   []*IPv6HopByHopOption `protobuf:"bytes,2,rep,name=Options,proto3" json:"Options,omitempty"`
 	SrcIP     string                `protobuf:"bytes,3,opt,name=SrcIP,proto3" json:"SrcIP,omitempty"`
 	DstIP     string                `protobuf:"bytes,4,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,5,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *IPv6HopByHop) Reset()         { *m = IPv6HopByHop{} }
 func (m *IPv6HopByHop) String() string { return proto.CompactTextString(m) }
 func (*IPv6HopByHop) ProtoMessage()    {}
 func (*IPv6HopByHop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{54}
+	return fileDescriptor_3068659fd5590671, []int{57}
 }
 func (m *IPv6HopByHop) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5870,6 +6661,13 @@ func (m *IPv6HopByHop) GetDstIP() string {
 	return ""
 }
 
+func (m *IPv6HopByHop) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type IPv6HopByHopOption struct {
 	OptionType      int32                        `protobuf:"varint,1,opt,name=OptionType,proto3" json:"OptionType,omitempty"`
 	OptionLength    int32                        `protobuf:"varint,2,opt,name=OptionLength,proto3" json:"OptionLength,omitempty"`
@@ -5882,7 +6680,7 @@ func (m *IPv6HopByHopOption) Reset()         { *m = IPv6HopByHopOption{} }
 func (m *IPv6HopByHopOption) String() string { return proto.CompactTextString(m) }
 func (*IPv6HopByHopOption) ProtoMessage()    {}
 func (*IPv6HopByHopOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{55}
+	return fileDescriptor_3068659fd5590671, []int{58}
 }
 func (m *IPv6HopByHopOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5955,7 +6753,7 @@ func (m *IPv6HopByHopOptionAlignment) Reset()         { *m = IPv6HopByHopOptionA
 func (m *IPv6HopByHopOptionAlignment) String() string { return proto.CompactTextString(m) }
 func (*IPv6HopByHopOptionAlignment) ProtoMessage()    {}
 func (*IPv6HopByHopOptionAlignment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{56}
+	return fileDescriptor_3068659fd5590671, []int{59}
 }
 func (m *IPv6HopByHopOptionAlignment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6012,7 +6810,7 @@ func (m *SNAP) Reset()         { *m = SNAP{} }
 func (m *SNAP) String() string { return proto.CompactTextString(m) }
 func (*SNAP) ProtoMessage()    {}
 func (*SNAP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{57}
+	return fileDescriptor_3068659fd5590671, []int{60}
 }
 func (m *SNAP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6068,13 +6866,15 @@ type ICMPv6Echo struct {
 	SeqNumber  int32  `protobuf:"varint,3,opt,name=SeqNumber,proto3" json:"SeqNumber,omitempty"`
 	SrcIP      string `protobuf:"bytes,4,opt,name=SrcIP,proto3" json:"SrcIP,omitempty"`
 	DstIP      string `protobuf:"bytes,5,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,6,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *ICMPv6Echo) Reset()         { *m = ICMPv6Echo{} }
 func (m *ICMPv6Echo) String() string { return proto.CompactTextString(m) }
 func (*ICMPv6Echo) ProtoMessage()    {}
 func (*ICMPv6Echo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{58}
+	return fileDescriptor_3068659fd5590671, []int{61}
 }
 func (m *ICMPv6Echo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6138,19 +6938,28 @@ func (m *ICMPv6Echo) GetDstIP() string {
 	return ""
 }
 
+func (m *ICMPv6Echo) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type ICMPv6NeighborSolicitation struct {
 	Timestamp     int64           `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 	TargetAddress string          `protobuf:"bytes,2,opt,name=TargetAddress,proto3" json:"TargetAddress,omitempty"`
 	Options       []*ICMPv6Option `protobuf:"bytes,3,rep,name=Options,proto3" json:"Options,omitempty"`
 	SrcIP         string          `protobuf:"bytes,4,opt,name=SrcIP,proto3" json:"SrcIP,omitempty"`
 	DstIP         string          `protobuf:"bytes,5,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,6,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *ICMPv6NeighborSolicitation) Reset()         { *m = ICMPv6NeighborSolicitation{} }
 func (m *ICMPv6NeighborSolicitation) String() string { return proto.CompactTextString(m) }
 func (*ICMPv6NeighborSolicitation) ProtoMessage()    {}
 func (*ICMPv6NeighborSolicitation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{59}
+	return fileDescriptor_3068659fd5590671, []int{62}
 }
 func (m *ICMPv6NeighborSolicitation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6214,18 +7023,27 @@ func (m *ICMPv6NeighborSolicitation) GetDstIP() string {
 	return ""
 }
 
+func (m *ICMPv6NeighborSolicitation) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type ICMPv6RouterSolicitation struct {
 	Timestamp int64           `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 	Options   []*ICMPv6Option `protobuf:"bytes,2,rep,name=Options,proto3" json:"Options,omitempty"`
 	SrcIP     string          `protobuf:"bytes,3,opt,name=SrcIP,proto3" json:"SrcIP,omitempty"`
 	DstIP     string          `protobuf:"bytes,4,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,5,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *ICMPv6RouterSolicitation) Reset()         { *m = ICMPv6RouterSolicitation{} }
 func (m *ICMPv6RouterSolicitation) String() string { return proto.CompactTextString(m) }
 func (*ICMPv6RouterSolicitation) ProtoMessage()    {}
 func (*ICMPv6RouterSolicitation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{60}
+	return fileDescriptor_3068659fd5590671, []int{63}
 }
 func (m *ICMPv6RouterSolicitation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6282,6 +7100,13 @@ func (m *ICMPv6RouterSolicitation) GetDstIP() string {
 	return ""
 }
 
+func (m *ICMPv6RouterSolicitation) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 // The Hypertext Transfer Protocol (HTTP) is an application protocol for distributed,
 // collaborative, hypermedia information systems. HTTP is the foundation of data
 // communication for the World Wide Web.
@@ -6318,13 +7143,17 @@ type HTTP struct {
 	Parameters             map[string]string `protobuf:"bytes,28,rep,name=Parameters,proto3" json:"Parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	RequestBody            []byte            `protobuf:"bytes,29,opt,name=RequestBody,proto3" json:"RequestBody,omitempty"`
 	ResponseBody           []byte            `protobuf:"bytes,30,opt,name=ResponseBody,proto3" json:"ResponseBody,omitempty"`
+	// Community ID flow hash
+	CommunityID string `protobuf:"bytes,31,opt,name=CommunityID,proto3" json:"CommunityID,omitempty"`
+	// tunnel the conversation was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,32,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *HTTP) Reset()         { *m = HTTP{} }
 func (m *HTTP) String() string { return proto.CompactTextString(m) }
 func (*HTTP) ProtoMessage()    {}
 func (*HTTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{61}
+	return fileDescriptor_3068659fd5590671, []int{64}
 }
 func (m *HTTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6563,6 +7392,20 @@ func (m *HTTP) GetResponseBody() []byte {
 	return nil
 }
 
+func (m *HTTP) GetCommunityID() string {
+	if m != nil {
+		return m.CommunityID
+	}
+	return ""
+}
+
+func (m *HTTP) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type HTTPCookie struct {
 	Name     string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
 	Value    string `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
@@ -6579,7 +7422,7 @@ func (m *HTTPCookie) Reset()         { *m = HTTPCookie{} }
 func (m *HTTPCookie) String() string { return proto.CompactTextString(m) }
 func (*HTTPCookie) ProtoMessage()    {}
 func (*HTTPCookie) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{62}
+	return fileDescriptor_3068659fd5590671, []int{65}
 }
 func (m *HTTPCookie) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6700,13 +7543,17 @@ type TLSClientHello struct {
 	SrcPort          int32    `protobuf:"varint,26,opt,name=SrcPort,proto3" json:"SrcPort,omitempty"`
 	DstPort          int32    `protobuf:"varint,27,opt,name=DstPort,proto3" json:"DstPort,omitempty"`
 	Extensions       []int32  `protobuf:"varint,28,rep,packed,name=Extensions,proto3" json:"Extensions,omitempty"`
+	// Community ID flow hash
+	CommunityID string `protobuf:"bytes,29,opt,name=CommunityID,proto3" json:"CommunityID,omitempty"`
+	// tunnel the client hello was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,30,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *TLSClientHello) Reset()         { *m = TLSClientHello{} }
 func (m *TLSClientHello) String() string { return proto.CompactTextString(m) }
 func (*TLSClientHello) ProtoMessage()    {}
 func (*TLSClientHello) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{63}
+	return fileDescriptor_3068659fd5590671, []int{66}
 }
 func (m *TLSClientHello) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6931,6 +7778,20 @@ func (m *TLSClientHello) GetExtensions() []int32 {
 	return nil
 }
 
+func (m *TLSClientHello) GetCommunityID() string {
+	if m != nil {
+		return m.CommunityID
+	}
+	return ""
+}
+
+func (m *TLSClientHello) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type TLSServerHello struct {
 	Timestamp                    int64    `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 	Version                      int32    `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
@@ -6961,13 +7822,17 @@ type TLSServerHello struct {
 	SrcPort                 int32   `protobuf:"varint,27,opt,name=SrcPort,proto3" json:"SrcPort,omitempty"`
 	DstPort                 int32   `protobuf:"varint,28,opt,name=DstPort,proto3" json:"DstPort,omitempty"`
 	Ja3S                    string  `protobuf:"bytes,29,opt,name=Ja3s,proto3" json:"Ja3s,omitempty"`
+	// Community ID flow hash
+	CommunityID string `protobuf:"bytes,30,opt,name=CommunityID,proto3" json:"CommunityID,omitempty"`
+	// tunnel the server hello was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,31,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *TLSServerHello) Reset()         { *m = TLSServerHello{} }
 func (m *TLSServerHello) String() string { return proto.CompactTextString(m) }
 func (*TLSServerHello) ProtoMessage()    {}
 func (*TLSServerHello) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{64}
+	return fileDescriptor_3068659fd5590671, []int{67}
 }
 func (m *TLSServerHello) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7192,6 +8057,20 @@ func (m *TLSServerHello) GetJa3S() string {
 	return ""
 }
 
+func (m *TLSServerHello) GetCommunityID() string {
+	if m != nil {
+		return m.CommunityID
+	}
+	return ""
+}
+
+func (m *TLSServerHello) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type IPSecAH struct {
 	Timestamp          int64  `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 	Reserved           int32  `protobuf:"varint,2,opt,name=Reserved,proto3" json:"Reserved,omitempty"`
@@ -7200,13 +8079,15 @@ type IPSecAH struct {
 	AuthenticationData []byte `protobuf:"bytes,5,opt,name=AuthenticationData,proto3" json:"AuthenticationData,omitempty"`
 	SrcIP              string `protobuf:"bytes,6,opt,name=SrcIP,proto3" json:"SrcIP,omitempty"`
 	DstIP              string `protobuf:"bytes,7,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,8,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *IPSecAH) Reset()         { *m = IPSecAH{} }
 func (m *IPSecAH) String() string { return proto.CompactTextString(m) }
 func (*IPSecAH) ProtoMessage()    {}
 func (*IPSecAH) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{65}
+	return fileDescriptor_3068659fd5590671, []int{68}
 }
 func (m *IPSecAH) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7284,6 +8165,13 @@ func (m *IPSecAH) GetDstIP() string {
 	return ""
 }
 
+func (m *IPSecAH) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type IPSecESP struct {
 	Timestamp    int64  `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 	SPI          int32  `protobuf:"varint,2,opt,name=SPI,proto3" json:"SPI,omitempty"`
@@ -7291,13 +8179,15 @@ type IPSecESP struct {
 	LenEncrypted int32  `protobuf:"varint,4,opt,name=LenEncrypted,proto3" json:"LenEncrypted,omitempty"`
 	SrcIP        string `protobuf:"bytes,5,opt,name=SrcIP,proto3" json:"SrcIP,omitempty"`
 	DstIP        string `protobuf:"bytes,6,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,7,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *IPSecESP) Reset()         { *m = IPSecESP{} }
 func (m *IPSecESP) String() string { return proto.CompactTextString(m) }
 func (*IPSecESP) ProtoMessage()    {}
 func (*IPSecESP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{66}
+	return fileDescriptor_3068659fd5590671, []int{69}
 }
 func (m *IPSecESP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7368,6 +8258,13 @@ func (m *IPSecESP) GetDstIP() string {
 	return ""
 }
 
+func (m *IPSecESP) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 // The Generic Network Virtualization Encapsulation (Geneve) protocol offers a new approach to encapsulation
 // designed to offer control-plane independence between tunnel endpoints.
 // The protocol specifies only a data-plane schema using a number of variable length options.
@@ -7386,7 +8283,7 @@ func (m *Geneve) Reset()         { *m = Geneve{} }
 func (m *Geneve) String() string { return proto.CompactTextString(m) }
 func (*Geneve) ProtoMessage()    {}
 func (*Geneve) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{67}
+	return fileDescriptor_3068659fd5590671, []int{70}
 }
 func (m *Geneve) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7483,7 +8380,7 @@ func (m *GeneveOption) Reset()         { *m = GeneveOption{} }
 func (m *GeneveOption) String() string { return proto.CompactTextString(m) }
 func (*GeneveOption) ProtoMessage()    {}
 func (*GeneveOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{68}
+	return fileDescriptor_3068659fd5590671, []int{71}
 }
 func (m *GeneveOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7562,7 +8459,7 @@ func (m *VXLAN) Reset()         { *m = VXLAN{} }
 func (m *VXLAN) String() string { return proto.CompactTextString(m) }
 func (*VXLAN) ProtoMessage()    {}
 func (*VXLAN) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{69}
+	return fileDescriptor_3068659fd5590671, []int{72}
 }
 func (m *VXLAN) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7672,7 +8569,7 @@ func (m *USB) Reset()         { *m = USB{} }
 func (m *USB) String() string { return proto.CompactTextString(m) }
 func (*USB) ProtoMessage()    {}
 func (*USB) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{70}
+	return fileDescriptor_3068659fd5590671, []int{73}
 }
 func (m *USB) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7854,7 +8751,7 @@ func (m *USBRequestBlockSetup) Reset()         { *m = USBRequestBlockSetup{} }
 func (m *USBRequestBlockSetup) String() string { return proto.CompactTextString(m) }
 func (*USBRequestBlockSetup) ProtoMessage()    {}
 func (*USBRequestBlockSetup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{71}
+	return fileDescriptor_3068659fd5590671, []int{74}
 }
 func (m *USBRequestBlockSetup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7946,13 +8843,15 @@ type LCM struct {
 	DstIP          string `protobuf:"bytes,11,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
 	SrcPort        int32  `protobuf:"varint,12,opt,name=SrcPort,proto3" json:"SrcPort,omitempty"`
 	DstPort        int32  `protobuf:"varint,13,opt,name=DstPort,proto3" json:"DstPort,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,14,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *LCM) Reset()         { *m = LCM{} }
 func (m *LCM) String() string { return proto.CompactTextString(m) }
 func (*LCM) ProtoMessage()    {}
 func (*LCM) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{72}
+	return fileDescriptor_3068659fd5590671, []int{75}
 }
 func (m *LCM) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8072,6 +8971,13 @@ func (m *LCM) GetDstPort() int32 {
 	return 0
 }
 
+func (m *LCM) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type MPLS struct {
 	Timestamp    int64 `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 	Label        int32 `protobuf:"varint,2,opt,name=Label,proto3" json:"Label,omitempty"`
@@ -8084,7 +8990,7 @@ func (m *MPLS) Reset()         { *m = MPLS{} }
 func (m *MPLS) String() string { return proto.CompactTextString(m) }
 func (*MPLS) ProtoMessage()    {}
 func (*MPLS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{73}
+	return fileDescriptor_3068659fd5590671, []int{76}
 }
 func (m *MPLS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8171,13 +9077,15 @@ type Modbus struct {
 	DstIP   string `protobuf:"bytes,10,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
 	SrcPort int32  `protobuf:"varint,11,opt,name=SrcPort,proto3" json:"SrcPort,omitempty"`
 	DstPort int32  `protobuf:"varint,12,opt,name=DstPort,proto3" json:"DstPort,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,13,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *Modbus) Reset()         { *m = Modbus{} }
 func (m *Modbus) String() string { return proto.CompactTextString(m) }
 func (*Modbus) ProtoMessage()    {}
 func (*Modbus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{74}
+	return fileDescriptor_3068659fd5590671, []int{77}
 }
 func (m *Modbus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8290,6 +9198,13 @@ func (m *Modbus) GetDstPort() int32 {
 	return 0
 }
 
+func (m *Modbus) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 // Open Shortest Path First (OSPF) is a routing protocol for Internet Protocol (IP) networks.
 // It uses a link state routing (LSR) algorithm and falls into the group of interior gateway protocols (IGPs),
 // operating within a single autonomous system (AS).
@@ -8312,13 +9227,15 @@ type OSPFv2 struct {
 	HelloV2 *HelloPkgV2  `protobuf:"bytes,14,opt,name=HelloV2,proto3" json:"HelloV2,omitempty"`
 	SrcIP   string       `protobuf:"bytes,15,opt,name=SrcIP,proto3" json:"SrcIP,omitempty"`
 	DstIP   string       `protobuf:"bytes,16,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,17,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *OSPFv2) Reset()         { *m = OSPFv2{} }
 func (m *OSPFv2) String() string { return proto.CompactTextString(m) }
 func (*OSPFv2) ProtoMessage()    {}
 func (*OSPFv2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{75}
+	return fileDescriptor_3068659fd5590671, []int{78}
 }
 func (m *OSPFv2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8459,6 +9376,13 @@ func (m *OSPFv2) GetDstIP() string {
 	return ""
 }
 
+func (m *OSPFv2) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type HelloPkg struct {
 	InterfaceID              uint32   `protobuf:"varint,1,opt,name=InterfaceID,proto3" json:"InterfaceID,omitempty"`
 	RtrPriority              int32    `protobuf:"varint,2,opt,name=RtrPriority,proto3" json:"RtrPriority,omitempty"`
@@ -8474,7 +9398,7 @@ func (m *HelloPkg) Reset()         { *m = HelloPkg{} }
 func (m *HelloPkg) String() string { return proto.CompactTextString(m) }
 func (*HelloPkg) ProtoMessage()    {}
 func (*HelloPkg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{76}
+	return fileDescriptor_3068659fd5590671, []int{79}
 }
 func (m *HelloPkg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8575,7 +9499,7 @@ func (m *HelloPkgV2) Reset()         { *m = HelloPkgV2{} }
 func (m *HelloPkgV2) String() string { return proto.CompactTextString(m) }
 func (*HelloPkgV2) ProtoMessage()    {}
 func (*HelloPkgV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{77}
+	return fileDescriptor_3068659fd5590671, []int{80}
 }
 func (m *HelloPkgV2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8679,7 +9603,7 @@ func (m *DbDescPkg) Reset()         { *m = DbDescPkg{} }
 func (m *DbDescPkg) String() string { return proto.CompactTextString(m) }
 func (*DbDescPkg) ProtoMessage()    {}
 func (*DbDescPkg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{78}
+	return fileDescriptor_3068659fd5590671, []int{81}
 }
 func (m *DbDescPkg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8765,13 +9689,15 @@ type OSPFv3 struct {
 	LSAs   []*LSAheader `protobuf:"bytes,14,rep,name=LSAs,proto3" json:"LSAs,omitempty"`
 	SrcIP  string       `protobuf:"bytes,15,opt,name=SrcIP,proto3" json:"SrcIP,omitempty"`
 	DstIP  string       `protobuf:"bytes,16,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,17,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *OSPFv3) Reset()         { *m = OSPFv3{} }
 func (m *OSPFv3) String() string { return proto.CompactTextString(m) }
 func (*OSPFv3) ProtoMessage()    {}
 func (*OSPFv3) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{79}
+	return fileDescriptor_3068659fd5590671, []int{82}
 }
 func (m *OSPFv3) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8912,6 +9838,13 @@ func (m *OSPFv3) GetDstIP() string {
 	return ""
 }
 
+func (m *OSPFv3) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type LSAheader struct {
 	LSAge       int32  `protobuf:"varint,1,opt,name=LSAge,proto3" json:"LSAge,omitempty"`
 	LSType      int32  `protobuf:"varint,2,opt,name=LSType,proto3" json:"LSType,omitempty"`
@@ -8927,7 +9860,7 @@ func (m *LSAheader) Reset()         { *m = LSAheader{} }
 func (m *LSAheader) String() string { return proto.CompactTextString(m) }
 func (*LSAheader) ProtoMessage()    {}
 func (*LSAheader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{80}
+	return fileDescriptor_3068659fd5590671, []int{83}
 }
 func (m *LSAheader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9037,7 +9970,7 @@ func (m *LSA) Reset()         { *m = LSA{} }
 func (m *LSA) String() string { return proto.CompactTextString(m) }
 func (*LSA) ProtoMessage()    {}
 func (*LSA) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{81}
+	return fileDescriptor_3068659fd5590671, []int{84}
 }
 func (m *LSA) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9160,7 +10093,7 @@ func (m *LSReq) Reset()         { *m = LSReq{} }
 func (m *LSReq) String() string { return proto.CompactTextString(m) }
 func (*LSReq) ProtoMessage()    {}
 func (*LSReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{82}
+	return fileDescriptor_3068659fd5590671, []int{85}
 }
 func (m *LSReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9219,7 +10152,7 @@ func (m *LSUpdate) Reset()         { *m = LSUpdate{} }
 func (m *LSUpdate) String() string { return proto.CompactTextString(m) }
 func (*LSUpdate) ProtoMessage()    {}
 func (*LSUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{83}
+	return fileDescriptor_3068659fd5590671, []int{86}
 }
 func (m *LSUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9274,7 +10207,7 @@ func (m *IntraAreaPrefixLSA) Reset()         { *m = IntraAreaPrefixLSA{} }
 func (m *IntraAreaPrefixLSA) String() string { return proto.CompactTextString(m) }
 func (*IntraAreaPrefixLSA) ProtoMessage()    {}
 func (*IntraAreaPrefixLSA) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{84}
+	return fileDescriptor_3068659fd5590671, []int{87}
 }
 func (m *IntraAreaPrefixLSA) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9354,7 +10287,7 @@ func (m *ASExternalLSA) Reset()         { *m = ASExternalLSA{} }
 func (m *ASExternalLSA) String() string { return proto.CompactTextString(m) }
 func (*ASExternalLSA) ProtoMessage()    {}
 func (*ASExternalLSA) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{85}
+	return fileDescriptor_3068659fd5590671, []int{88}
 }
 func (m *ASExternalLSA) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9457,7 +10390,7 @@ func (m *InterAreaPrefixLSA) Reset()         { *m = InterAreaPrefixLSA{} }
 func (m *InterAreaPrefixLSA) String() string { return proto.CompactTextString(m) }
 func (*InterAreaPrefixLSA) ProtoMessage()    {}
 func (*InterAreaPrefixLSA) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{86}
+	return fileDescriptor_3068659fd5590671, []int{89}
 }
 func (m *InterAreaPrefixLSA) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9524,7 +10457,7 @@ func (m *InterAreaRouterLSA) Reset()         { *m = InterAreaRouterLSA{} }
 func (m *InterAreaRouterLSA) String() string { return proto.CompactTextString(m) }
 func (*InterAreaRouterLSA) ProtoMessage()    {}
 func (*InterAreaRouterLSA) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{87}
+	return fileDescriptor_3068659fd5590671, []int{90}
 }
 func (m *InterAreaRouterLSA) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9586,7 +10519,7 @@ func (m *ASExternalLSAV2) Reset()         { *m = ASExternalLSAV2{} }
 func (m *ASExternalLSAV2) String() string { return proto.CompactTextString(m) }
 func (*ASExternalLSAV2) ProtoMessage()    {}
 func (*ASExternalLSAV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{88}
+	return fileDescriptor_3068659fd5590671, []int{91}
 }
 func (m *ASExternalLSAV2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9660,7 +10593,7 @@ func (m *RouterLSA) Reset()         { *m = RouterLSA{} }
 func (m *RouterLSA) String() string { return proto.CompactTextString(m) }
 func (*RouterLSA) ProtoMessage()    {}
 func (*RouterLSA) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{89}
+	return fileDescriptor_3068659fd5590671, []int{92}
 }
 func (m *RouterLSA) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9722,7 +10655,7 @@ func (m *Router) Reset()         { *m = Router{} }
 func (m *Router) String() string { return proto.CompactTextString(m) }
 func (*Router) ProtoMessage()    {}
 func (*Router) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{90}
+	return fileDescriptor_3068659fd5590671, []int{93}
 }
 func (m *Router) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9796,7 +10729,7 @@ func (m *RouterLSAV2) Reset()         { *m = RouterLSAV2{} }
 func (m *RouterLSAV2) String() string { return proto.CompactTextString(m) }
 func (*RouterLSAV2) ProtoMessage()    {}
 func (*RouterLSAV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{91}
+	return fileDescriptor_3068659fd5590671, []int{94}
 }
 func (m *RouterLSAV2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9857,7 +10790,7 @@ func (m *RouterV2) Reset()         { *m = RouterV2{} }
 func (m *RouterV2) String() string { return proto.CompactTextString(m) }
 func (*RouterV2) ProtoMessage()    {}
 func (*RouterV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{92}
+	return fileDescriptor_3068659fd5590671, []int{95}
 }
 func (m *RouterV2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9923,7 +10856,7 @@ func (m *NetworkLSA) Reset()         { *m = NetworkLSA{} }
 func (m *NetworkLSA) String() string { return proto.CompactTextString(m) }
 func (*NetworkLSA) ProtoMessage()    {}
 func (*NetworkLSA) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{93}
+	return fileDescriptor_3068659fd5590671, []int{96}
 }
 func (m *NetworkLSA) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9978,7 +10911,7 @@ func (m *LinkLSA) Reset()         { *m = LinkLSA{} }
 func (m *LinkLSA) String() string { return proto.CompactTextString(m) }
 func (*LinkLSA) ProtoMessage()    {}
 func (*LinkLSA) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{94}
+	return fileDescriptor_3068659fd5590671, []int{97}
 }
 func (m *LinkLSA) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10053,7 +10986,7 @@ func (m *LSAPrefix) Reset()         { *m = LSAPrefix{} }
 func (m *LSAPrefix) String() string { return proto.CompactTextString(m) }
 func (*LSAPrefix) ProtoMessage()    {}
 func (*LSAPrefix) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{95}
+	return fileDescriptor_3068659fd5590671, []int{98}
 }
 func (m *LSAPrefix) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10138,7 +11071,7 @@ func (m *BFD) Reset()         { *m = BFD{} }
 func (m *BFD) String() string { return proto.CompactTextString(m) }
 func (*BFD) ProtoMessage()    {}
 func (*BFD) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{96}
+	return fileDescriptor_3068659fd5590671, []int{99}
 }
 func (m *BFD) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10297,7 +11230,7 @@ func (m *BFDAuthHeader) Reset()         { *m = BFDAuthHeader{} }
 func (m *BFDAuthHeader) String() string { return proto.CompactTextString(m) }
 func (*BFDAuthHeader) ProtoMessage()    {}
 func (*BFDAuthHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{97}
+	return fileDescriptor_3068659fd5590671, []int{100}
 }
 func (m *BFDAuthHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10378,13 +11311,15 @@ type GRE struct {
 	Routing           *GRERouting `protobuf:"bytes,17,opt,name=Routing,proto3" json:"Routing,omitempty"`
 	SrcIP             string      `protobuf:"bytes,18,opt,name=SrcIP,proto3" json:"SrcIP,omitempty"`
 	DstIP             string      `protobuf:"bytes,19,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,20,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *GRE) Reset()         { *m = GRE{} }
 func (m *GRE) String() string { return proto.CompactTextString(m) }
 func (*GRE) ProtoMessage()    {}
 func (*GRE) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{98}
+	return fileDescriptor_3068659fd5590671, []int{101}
 }
 func (m *GRE) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10546,6 +11481,13 @@ func (m *GRE) GetDstIP() string {
 	return ""
 }
 
+func (m *GRE) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type GRERouting struct {
 	AddressFamily      int32       `protobuf:"varint,1,opt,name=AddressFamily,proto3" json:"AddressFamily,omitempty"`
 	SREOffset          int32       `protobuf:"varint,2,opt,name=SREOffset,proto3" json:"SREOffset,omitempty"`
@@ -10558,7 +11500,7 @@ func (m *GRERouting) Reset()         { *m = GRERouting{} }
 func (m *GRERouting) String() string { return proto.CompactTextString(m) }
 func (*GRERouting) ProtoMessage()    {}
 func (*GRERouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{99}
+	return fileDescriptor_3068659fd5590671, []int{102}
 }
 func (m *GRERouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10634,7 +11576,7 @@ func (m *FDDI) Reset()         { *m = FDDI{} }
 func (m *FDDI) String() string { return proto.CompactTextString(m) }
 func (*FDDI) ProtoMessage()    {}
 func (*FDDI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{100}
+	return fileDescriptor_3068659fd5590671, []int{103}
 }
 func (m *FDDI) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10712,7 +11654,7 @@ func (m *EAP) Reset()         { *m = EAP{} }
 func (m *EAP) String() string { return proto.CompactTextString(m) }
 func (*EAP) ProtoMessage()    {}
 func (*EAP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{101}
+	return fileDescriptor_3068659fd5590671, []int{104}
 }
 func (m *EAP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10795,7 +11737,7 @@ func (m *EAPOL) Reset()         { *m = EAPOL{} }
 func (m *EAPOL) String() string { return proto.CompactTextString(m) }
 func (*EAPOL) ProtoMessage()    {}
 func (*EAPOL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{102}
+	return fileDescriptor_3068659fd5590671, []int{105}
 }
 func (m *EAPOL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10882,7 +11824,7 @@ func (m *EAPOLKey) Reset()         { *m = EAPOLKey{} }
 func (m *EAPOLKey) String() string { return proto.CompactTextString(m) }
 func (*EAPOLKey) ProtoMessage()    {}
 func (*EAPOLKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{103}
+	return fileDescriptor_3068659fd5590671, []int{106}
 }
 func (m *EAPOLKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11083,13 +12025,15 @@ type VRRPv2 struct {
 	IPAddress    []string `protobuf:"bytes,10,rep,name=IPAddress,proto3" json:"IPAddress,omitempty"`
 	SrcIP        string   `protobuf:"bytes,11,opt,name=SrcIP,proto3" json:"SrcIP,omitempty"`
 	DstIP        string   `protobuf:"bytes,12,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,13,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *VRRPv2) Reset()         { *m = VRRPv2{} }
 func (m *VRRPv2) String() string { return proto.CompactTextString(m) }
 func (*VRRPv2) ProtoMessage()    {}
 func (*VRRPv2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{104}
+	return fileDescriptor_3068659fd5590671, []int{107}
 }
 func (m *VRRPv2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11202,6 +12146,13 @@ func (m *VRRPv2) GetDstIP() string {
 	return ""
 }
 
+func (m *VRRPv2) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 // Cisco Discovery Protocol is a proprietary Data Link Layer protocol
 // developed by Cisco Systems in 1994 by Keith McCloghrie and Dino Farinacci.
 // It is used to share information about other directly connected Cisco equipment,
@@ -11218,7 +12169,7 @@ func (m *CiscoDiscovery) Reset()         { *m = CiscoDiscovery{} }
 func (m *CiscoDiscovery) String() string { return proto.CompactTextString(m) }
 func (*CiscoDiscovery) ProtoMessage()    {}
 func (*CiscoDiscovery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{105}
+	return fileDescriptor_3068659fd5590671, []int{108}
 }
 func (m *CiscoDiscovery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11292,7 +12243,7 @@ func (m *CiscoDiscoveryValue) Reset()         { *m = CiscoDiscoveryValue{} }
 func (m *CiscoDiscoveryValue) String() string { return proto.CompactTextString(m) }
 func (*CiscoDiscoveryValue) ProtoMessage()    {}
 func (*CiscoDiscoveryValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{106}
+	return fileDescriptor_3068659fd5590671, []int{109}
 }
 func (m *CiscoDiscoveryValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11351,7 +12302,7 @@ func (m *CDPVLANDialogue) Reset()         { *m = CDPVLANDialogue{} }
 func (m *CDPVLANDialogue) String() string { return proto.CompactTextString(m) }
 func (*CDPVLANDialogue) ProtoMessage()    {}
 func (*CDPVLANDialogue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{107}
+	return fileDescriptor_3068659fd5590671, []int{110}
 }
 func (m *CDPVLANDialogue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11403,7 +12354,7 @@ func (m *CDPLocation) Reset()         { *m = CDPLocation{} }
 func (m *CDPLocation) String() string { return proto.CompactTextString(m) }
 func (*CDPLocation) ProtoMessage()    {}
 func (*CDPLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{108}
+	return fileDescriptor_3068659fd5590671, []int{111}
 }
 func (m *CDPLocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11456,7 +12407,7 @@ func (m *CDPPowerDialogue) Reset()         { *m = CDPPowerDialogue{} }
 func (m *CDPPowerDialogue) String() string { return proto.CompactTextString(m) }
 func (*CDPPowerDialogue) ProtoMessage()    {}
 func (*CDPPowerDialogue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{109}
+	return fileDescriptor_3068659fd5590671, []int{112}
 }
 func (m *CDPPowerDialogue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11517,7 +12468,7 @@ func (m *CDPSparePairPoE) Reset()         { *m = CDPSparePairPoE{} }
 func (m *CDPSparePairPoE) String() string { return proto.CompactTextString(m) }
 func (*CDPSparePairPoE) ProtoMessage()    {}
 func (*CDPSparePairPoE) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{110}
+	return fileDescriptor_3068659fd5590671, []int{113}
 }
 func (m *CDPSparePairPoE) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11608,7 +12559,7 @@ func (m *CiscoDiscoveryInfo) Reset()         { *m = CiscoDiscoveryInfo{} }
 func (m *CiscoDiscoveryInfo) String() string { return proto.CompactTextString(m) }
 func (*CiscoDiscoveryInfo) ProtoMessage()    {}
 func (*CiscoDiscoveryInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{111}
+	return fileDescriptor_3068659fd5590671, []int{114}
 }
 func (m *CiscoDiscoveryInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11845,7 +12796,7 @@ func (m *CDPHello) Reset()         { *m = CDPHello{} }
 func (m *CDPHello) String() string { return proto.CompactTextString(m) }
 func (*CDPHello) ProtoMessage()    {}
 func (*CDPHello) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{112}
+	return fileDescriptor_3068659fd5590671, []int{115}
 }
 func (m *CDPHello) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11981,7 +12932,7 @@ func (m *CDPEnergyWise) Reset()         { *m = CDPEnergyWise{} }
 func (m *CDPEnergyWise) String() string { return proto.CompactTextString(m) }
 func (*CDPEnergyWise) ProtoMessage()    {}
 func (*CDPEnergyWise) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{113}
+	return fileDescriptor_3068659fd5590671, []int{116}
 }
 func (m *CDPEnergyWise) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12138,7 +13089,7 @@ func (m *CDPCapabilities) Reset()         { *m = CDPCapabilities{} }
 func (m *CDPCapabilities) String() string { return proto.CompactTextString(m) }
 func (*CDPCapabilities) ProtoMessage()    {}
 func (*CDPCapabilities) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{114}
+	return fileDescriptor_3068659fd5590671, []int{117}
 }
 func (m *CDPCapabilities) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12239,7 +13190,7 @@ func (m *IPNet) Reset()         { *m = IPNet{} }
 func (m *IPNet) String() string { return proto.CompactTextString(m) }
 func (*IPNet) ProtoMessage()    {}
 func (*IPNet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{115}
+	return fileDescriptor_3068659fd5590671, []int{118}
 }
 func (m *IPNet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12300,7 +13251,7 @@ func (m *NortelDiscovery) Reset()         { *m = NortelDiscovery{} }
 func (m *NortelDiscovery) String() string { return proto.CompactTextString(m) }
 func (*NortelDiscovery) ProtoMessage()    {}
 func (*NortelDiscovery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{116}
+	return fileDescriptor_3068659fd5590671, []int{119}
 }
 func (m *NortelDiscovery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12409,13 +13360,15 @@ type CIP struct {
 	DstIP            string   `protobuf:"bytes,10,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
 	SrcPort          int32    `protobuf:"varint,11,opt,name=SrcPort,proto3" json:"SrcPort,omitempty"`
 	DstPort          int32    `protobuf:"varint,12,opt,name=DstPort,proto3" json:"DstPort,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,13,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *CIP) Reset()         { *m = CIP{} }
 func (m *CIP) String() string { return proto.CompactTextString(m) }
 func (*CIP) ProtoMessage()    {}
 func (*CIP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{117}
+	return fileDescriptor_3068659fd5590671, []int{120}
 }
 func (m *CIP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12528,6 +13481,13 @@ func (m *CIP) GetDstPort() int32 {
 	return 0
 }
 
+func (m *CIP) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 // ENIP implements decoding of EtherNet/IP, a protocol used to transport the
 // Common Industrial Protocol over standard OSI networks. EtherNet/IP transports
 // over both TCP and UDP.
@@ -12545,13 +13505,15 @@ type ENIP struct {
 	DstIP           string                   `protobuf:"bytes,10,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
 	SrcPort         int32                    `protobuf:"varint,11,opt,name=SrcPort,proto3" json:"SrcPort,omitempty"`
 	DstPort         int32                    `protobuf:"varint,12,opt,name=DstPort,proto3" json:"DstPort,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,13,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *ENIP) Reset()         { *m = ENIP{} }
 func (m *ENIP) String() string { return proto.CompactTextString(m) }
 func (*ENIP) ProtoMessage()    {}
 func (*ENIP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{118}
+	return fileDescriptor_3068659fd5590671, []int{121}
 }
 func (m *ENIP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12664,6 +13626,13 @@ func (m *ENIP) GetDstPort() int32 {
 	return 0
 }
 
+func (m *ENIP) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 // ENIPCommandSpecificData contains data specific to a command. This may
 // include another EtherNet/IP packet embedded within the Data structure.
 type ENIPCommandSpecificData struct {
@@ -12675,7 +13644,7 @@ func (m *ENIPCommandSpecificData) Reset()         { *m = ENIPCommandSpecificData
 func (m *ENIPCommandSpecificData) String() string { return proto.CompactTextString(m) }
 func (*ENIPCommandSpecificData) ProtoMessage()    {}
 func (*ENIPCommandSpecificData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{119}
+	return fileDescriptor_3068659fd5590671, []int{122}
 }
 func (m *ENIPCommandSpecificData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12733,7 +13702,7 @@ func (m *DeviceProfile) Reset()         { *m = DeviceProfile{} }
 func (m *DeviceProfile) String() string { return proto.CompactTextString(m) }
 func (*DeviceProfile) ProtoMessage()    {}
 func (*DeviceProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{120}
+	return fileDescriptor_3068659fd5590671, []int{123}
 }
 func (m *DeviceProfile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12822,7 +13791,7 @@ func (m *Port) Reset()         { *m = Port{} }
 func (m *Port) String() string { return proto.CompactTextString(m) }
 func (*Port) ProtoMessage()    {}
 func (*Port) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{121}
+	return fileDescriptor_3068659fd5590671, []int{124}
 }
 func (m *Port) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12885,7 +13854,7 @@ func (m *PortStats) Reset()         { *m = PortStats{} }
 func (m *PortStats) String() string { return proto.CompactTextString(m) }
 func (*PortStats) ProtoMessage()    {}
 func (*PortStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{122}
+	return fileDescriptor_3068659fd5590671, []int{125}
 }
 func (m *PortStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12943,13 +13912,15 @@ type IPProfile struct {
 	SrcPorts       []*Port              `protobuf:"bytes,12,rep,name=SrcPorts,proto3" json:"SrcPorts,omitempty"`
 	DstPorts       []*Port              `protobuf:"bytes,13,rep,name=DstPorts,proto3" json:"DstPorts,omitempty"`
 	ContactedPorts []*Port              `protobuf:"bytes,14,rep,name=ContactedPorts,proto3" json:"ContactedPorts,omitempty"`
+	// tunnel the profiled address was seen in, if any
+	Tunnel *Tunnel `protobuf:"bytes,15,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *IPProfile) Reset()         { *m = IPProfile{} }
 func (m *IPProfile) String() string { return proto.CompactTextString(m) }
 func (*IPProfile) ProtoMessage()    {}
 func (*IPProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{123}
+	return fileDescriptor_3068659fd5590671, []int{126}
 }
 func (m *IPProfile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -13076,6 +14047,13 @@ func (m *IPProfile) GetContactedPorts() []*Port {
 	return nil
 }
 
+func (m *IPProfile) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type Protocol struct {
 	Packets  uint64 `protobuf:"varint,1,opt,name=Packets,proto3" json:"Packets,omitempty"`
 	Category string `protobuf:"bytes,2,opt,name=Category,proto3" json:"Category,omitempty"`
@@ -13085,7 +14063,7 @@ func (m *Protocol) Reset()         { *m = Protocol{} }
 func (m *Protocol) String() string { return proto.CompactTextString(m) }
 func (*Protocol) ProtoMessage()    {}
 func (*Protocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{124}
+	return fileDescriptor_3068659fd5590671, []int{127}
 }
 func (m *Protocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -13143,13 +14121,17 @@ type File struct {
 	DstIP               string `protobuf:"bytes,12,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
 	SrcPort             int32  `protobuf:"varint,13,opt,name=SrcPort,proto3" json:"SrcPort,omitempty"`
 	DstPort             int32  `protobuf:"varint,14,opt,name=DstPort,proto3" json:"DstPort,omitempty"`
+	// Community ID flow hash
+	CommunityID string `protobuf:"bytes,15,opt,name=CommunityID,proto3" json:"CommunityID,omitempty"`
+	// tunnel the conversation was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,16,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *File) Reset()         { *m = File{} }
 func (m *File) String() string { return proto.CompactTextString(m) }
 func (*File) ProtoMessage()    {}
 func (*File) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{125}
+	return fileDescriptor_3068659fd5590671, []int{128}
 }
 func (m *File) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -13276,6 +14258,20 @@ func (m *File) GetDstPort() int32 {
 	return 0
 }
 
+func (m *File) GetCommunityID() string {
+	if m != nil {
+		return m.CommunityID
+	}
+	return ""
+}
+
+func (m *File) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 // SMTPResponse SMTP response type
 // with status code and parameter
 type SMTPResponse struct {
@@ -13288,7 +14284,7 @@ func (m *SMTPResponse) Reset()         { *m = SMTPResponse{} }
 func (m *SMTPResponse) String() string { return proto.CompactTextString(m) }
 func (*SMTPResponse) ProtoMessage()    {}
 func (*SMTPResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{126}
+	return fileDescriptor_3068659fd5590671, []int{129}
 }
 func (m *SMTPResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -13349,7 +14345,7 @@ func (m *SMTPRequest) Reset()         { *m = SMTPRequest{} }
 func (m *SMTPRequest) String() string { return proto.CompactTextString(m) }
 func (*SMTPRequest) ProtoMessage()    {}
 func (*SMTPRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{127}
+	return fileDescriptor_3068659fd5590671, []int{130}
 }
 func (m *SMTPRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -13409,7 +14405,7 @@ func (m *SMTPCommand) Reset()         { *m = SMTPCommand{} }
 func (m *SMTPCommand) String() string { return proto.CompactTextString(m) }
 func (*SMTPCommand) ProtoMessage()    {}
 func (*SMTPCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{128}
+	return fileDescriptor_3068659fd5590671, []int{131}
 }
 func (m *SMTPCommand) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -13463,13 +14459,15 @@ type SMTP struct {
 	DstPort     int32    `protobuf:"varint,9,opt,name=DstPort,proto3" json:"DstPort,omitempty"`
 	MailIDs     []string `protobuf:"bytes,10,rep,name=MailIDs,proto3" json:"MailIDs,omitempty"`
 	Commands    []string `protobuf:"bytes,11,rep,name=Commands,proto3" json:"Commands,omitempty"`
+	// tunnel the conversation was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,12,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *SMTP) Reset()         { *m = SMTP{} }
 func (m *SMTP) String() string { return proto.CompactTextString(m) }
 func (*SMTP) ProtoMessage()    {}
 func (*SMTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{129}
+	return fileDescriptor_3068659fd5590671, []int{132}
 }
 func (m *SMTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -13561,6 +14559,13 @@ func (m *SMTP) GetCommands() []string {
 	return nil
 }
 
+func (m *SMTP) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 // Diameter is an authentication, authorization, and accounting protocol for computer networks.
 // It evolved from the earlier RADIUS protocol.
 // It belongs to the application layer protocols in the internet protocol suite.
@@ -13580,13 +14585,15 @@ type Diameter struct {
 	DstIP   string `protobuf:"bytes,11,opt,name=DstIP,proto3" json:"DstIP,omitempty"`
 	SrcPort int32  `protobuf:"varint,12,opt,name=SrcPort,proto3" json:"SrcPort,omitempty"`
 	DstPort int32  `protobuf:"varint,13,opt,name=DstPort,proto3" json:"DstPort,omitempty"`
+	// tunnel the packet was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,14,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *Diameter) Reset()         { *m = Diameter{} }
 func (m *Diameter) String() string { return proto.CompactTextString(m) }
 func (*Diameter) ProtoMessage()    {}
 func (*Diameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{130}
+	return fileDescriptor_3068659fd5590671, []int{133}
 }
 func (m *Diameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -13706,6 +14713,13 @@ func (m *Diameter) GetDstPort() int32 {
 	return 0
 }
 
+func (m *Diameter) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 // Attribute Value Pair
 type AVP struct {
 	// Value in the header section of the AVP
@@ -13729,7 +14743,7 @@ func (m *AVP) Reset()         { *m = AVP{} }
 func (m *AVP) String() string { return proto.CompactTextString(m) }
 func (*AVP) ProtoMessage()    {}
 func (*AVP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{131}
+	return fileDescriptor_3068659fd5590671, []int{134}
 }
 func (m *AVP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -13858,13 +14872,15 @@ type POP3 struct {
 	Pass      string   `protobuf:"bytes,6,opt,name=Pass,proto3" json:"Pass,omitempty"`
 	MailIDs   []string `protobuf:"bytes,7,rep,name=MailIDs,proto3" json:"MailIDs,omitempty"`
 	Commands  []string `protobuf:"bytes,8,rep,name=Commands,proto3" json:"Commands,omitempty"`
+	// tunnel the conversation was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,9,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *POP3) Reset()         { *m = POP3{} }
 func (m *POP3) String() string { return proto.CompactTextString(m) }
 func (*POP3) ProtoMessage()    {}
 func (*POP3) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{132}
+	return fileDescriptor_3068659fd5590671, []int{135}
 }
 func (m *POP3) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -13949,6 +14965,13 @@ func (m *POP3) GetCommands() []string {
 	return nil
 }
 
+func (m *POP3) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type Mail struct {
 	Timestamp       int64       `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 	ReturnPath      string      `protobuf:"bytes,2,opt,name=ReturnPath,proto3" json:"ReturnPath,omitempty"`
@@ -13971,13 +14994,15 @@ type Mail struct {
 	ID              string      `protobuf:"bytes,19,opt,name=ID,proto3" json:"ID,omitempty"`
 	DeliveryDate    string      `protobuf:"bytes,20,opt,name=DeliveryDate,proto3" json:"DeliveryDate,omitempty"`
 	Origin          string      `protobuf:"bytes,21,opt,name=Origin,proto3" json:"Origin,omitempty"`
+	// tunnel the conversation was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,22,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *Mail) Reset()         { *m = Mail{} }
 func (m *Mail) String() string { return proto.CompactTextString(m) }
 func (*Mail) ProtoMessage()    {}
 func (*Mail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{133}
+	return fileDescriptor_3068659fd5590671, []int{136}
 }
 func (m *Mail) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -14153,6 +15178,13 @@ func (m *Mail) GetOrigin() string {
 	return ""
 }
 
+func (m *Mail) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type MailPart struct {
 	ID       string            `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	Header   map[string]string `protobuf:"bytes,2,rep,name=Header,proto3" json:"Header,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -14164,7 +15196,7 @@ func (m *MailPart) Reset()         { *m = MailPart{} }
 func (m *MailPart) String() string { return proto.CompactTextString(m) }
 func (*MailPart) ProtoMessage()    {}
 func (*MailPart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{134}
+	return fileDescriptor_3068659fd5590671, []int{137}
 }
 func (m *MailPart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -14230,7 +15262,7 @@ func (m *POP3Request) Reset()         { *m = POP3Request{} }
 func (m *POP3Request) String() string { return proto.CompactTextString(m) }
 func (*POP3Request) ProtoMessage()    {}
 func (*POP3Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{135}
+	return fileDescriptor_3068659fd5590671, []int{138}
 }
 func (m *POP3Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -14282,7 +15314,7 @@ func (m *POP3Response) Reset()         { *m = POP3Response{} }
 func (m *POP3Response) String() string { return proto.CompactTextString(m) }
 func (*POP3Response) ProtoMessage()    {}
 func (*POP3Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{136}
+	return fileDescriptor_3068659fd5590671, []int{139}
 }
 func (m *POP3Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -14339,13 +15371,17 @@ type Software struct {
 	Notes          string   `protobuf:"bytes,11,opt,name=Notes,proto3" json:"Notes,omitempty"`
 	Website        string   `protobuf:"bytes,12,opt,name=Website,proto3" json:"Website,omitempty"`
 	OS             string   `protobuf:"bytes,13,opt,name=OS,proto3" json:"OS,omitempty"`
+	// Community ID flow hashes for the Flows
+	CommunityIDs []string `protobuf:"bytes,14,rep,name=CommunityIDs,proto3" json:"CommunityIDs,omitempty"`
+	// tunnel of the flow the software was first seen in, if any
+	Tunnel *Tunnel `protobuf:"bytes,15,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *Software) Reset()         { *m = Software{} }
 func (m *Software) String() string { return proto.CompactTextString(m) }
 func (*Software) ProtoMessage()    {}
 func (*Software) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{137}
+	return fileDescriptor_3068659fd5590671, []int{140}
 }
 func (m *Software) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -14465,6 +15501,20 @@ func (m *Software) GetOS() string {
 	return ""
 }
 
+func (m *Software) GetCommunityIDs() []string {
+	if m != nil {
+		return m.CommunityIDs
+	}
+	return nil
+}
+
+func (m *Software) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type Service struct {
 	Timestamp   int64    `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 	IP          string   `protobuf:"bytes,2,opt,name=IP,proto3" json:"IP,omitempty"`
@@ -14481,13 +15531,17 @@ type Service struct {
 	BytesClient int32    `protobuf:"varint,13,opt,name=BytesClient,proto3" json:"BytesClient,omitempty"`
 	Hostname    string   `protobuf:"bytes,14,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
 	OS          string   `protobuf:"bytes,15,opt,name=OS,proto3" json:"OS,omitempty"`
+	// Community ID flow hashes for the Flows
+	CommunityIDs []string `protobuf:"bytes,16,rep,name=CommunityIDs,proto3" json:"CommunityIDs,omitempty"`
+	// tunnel the conversation was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,17,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *Service) Reset()         { *m = Service{} }
 func (m *Service) String() string { return proto.CompactTextString(m) }
 func (*Service) ProtoMessage()    {}
 func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{138}
+	return fileDescriptor_3068659fd5590671, []int{141}
 }
 func (m *Service) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -14621,6 +15675,20 @@ func (m *Service) GetOS() string {
 	return ""
 }
 
+func (m *Service) GetCommunityIDs() []string {
+	if m != nil {
+		return m.CommunityIDs
+	}
+	return nil
+}
+
+func (m *Service) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type Credentials struct {
 	Timestamp int64  `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 	Service   string `protobuf:"bytes,2,opt,name=Service,proto3" json:"Service,omitempty"`
@@ -14628,13 +15696,17 @@ type Credentials struct {
 	User      string `protobuf:"bytes,4,opt,name=User,proto3" json:"User,omitempty"`
 	Password  string `protobuf:"bytes,5,opt,name=Password,proto3" json:"Password,omitempty"`
 	Notes     string `protobuf:"bytes,6,opt,name=Notes,proto3" json:"Notes,omitempty"`
+	// Community ID flow hash
+	CommunityID string `protobuf:"bytes,7,opt,name=CommunityID,proto3" json:"CommunityID,omitempty"`
+	// tunnel the conversation was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,8,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *Credentials) Reset()         { *m = Credentials{} }
 func (m *Credentials) String() string { return proto.CompactTextString(m) }
 func (*Credentials) ProtoMessage()    {}
 func (*Credentials) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{139}
+	return fileDescriptor_3068659fd5590671, []int{142}
 }
 func (m *Credentials) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -14705,6 +15777,20 @@ func (m *Credentials) GetNotes() string {
 	return ""
 }
 
+func (m *Credentials) GetCommunityID() string {
+	if m != nil {
+		return m.CommunityID
+	}
+	return ""
+}
+
+func (m *Credentials) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type SSH struct {
 	Timestamp  int64  `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 	HASSH      string `protobuf:"bytes,2,opt,name=HASSH,proto3" json:"HASSH,omitempty"`
@@ -14713,13 +15799,17 @@ type SSH struct {
 	Ident      string `protobuf:"bytes,5,opt,name=Ident,proto3" json:"Ident,omitempty"`
 	Algorithms string `protobuf:"bytes,6,opt,name=Algorithms,proto3" json:"Algorithms,omitempty"`
 	IsClient   bool   `protobuf:"varint,7,opt,name=IsClient,proto3" json:"IsClient,omitempty"`
+	// Community ID flow hash
+	CommunityID string `protobuf:"bytes,8,opt,name=CommunityID,proto3" json:"CommunityID,omitempty"`
+	// tunnel the conversation was encapsulated in, if any
+	Tunnel *Tunnel `protobuf:"bytes,9,opt,name=Tunnel,proto3" json:"Tunnel,omitempty"`
 }
 
 func (m *SSH) Reset()         { *m = SSH{} }
 func (m *SSH) String() string { return proto.CompactTextString(m) }
 func (*SSH) ProtoMessage()    {}
 func (*SSH) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{140}
+	return fileDescriptor_3068659fd5590671, []int{143}
 }
 func (m *SSH) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -14797,6 +15887,20 @@ func (m *SSH) GetIsClient() bool {
 	return false
 }
 
+func (m *SSH) GetCommunityID() string {
+	if m != nil {
+		return m.CommunityID
+	}
+	return ""
+}
+
+func (m *SSH) GetTunnel() *Tunnel {
+	if m != nil {
+		return m.Tunnel
+	}
+	return nil
+}
+
 type Vulnerability struct {
 	Timestamp    int64     `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 	ID           string    `protobuf:"bytes,2,opt,name=ID,proto3" json:"ID,omitempty"`
@@ -14813,7 +15917,7 @@ func (m *Vulnerability) Reset()         { *m = Vulnerability{} }
 func (m *Vulnerability) String() string { return proto.CompactTextString(m) }
 func (*Vulnerability) ProtoMessage()    {}
 func (*Vulnerability) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{141}
+	return fileDescriptor_3068659fd5590671, []int{144}
 }
 func (m *Vulnerability) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -14923,7 +16027,7 @@ func (m *Exploit) Reset()         { *m = Exploit{} }
 func (m *Exploit) String() string { return proto.CompactTextString(m) }
 func (*Exploit) ProtoMessage()    {}
 func (*Exploit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{142}
+	return fileDescriptor_3068659fd5590671, []int{145}
 }
 func (m *Exploit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -15043,13 +16147,16 @@ type Alert struct {
 	Domain       string `protobuf:"bytes,10,opt,name=Domain,proto3" json:"Domain,omitempty"`
 	Protocol     string `protobuf:"bytes,11,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
 	Notes        string `protobuf:"bytes,12,opt,name=Notes,proto3" json:"Notes,omitempty"`
+	// payload versions for alerts on inconsistent TCP retransmissions
+	OriginalPayload      []byte `protobuf:"bytes,13,opt,name=OriginalPayload,proto3" json:"OriginalPayload,omitempty"`
+	RetransmittedPayload []byte `protobuf:"bytes,14,opt,name=RetransmittedPayload,proto3" json:"RetransmittedPayload,omitempty"`
 }
 
 func (m *Alert) Reset()         { *m = Alert{} }
 func (m *Alert) String() string { return proto.CompactTextString(m) }
 func (*Alert) ProtoMessage()    {}
 func (*Alert) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3068659fd5590671, []int{143}
+	return fileDescriptor_3068659fd5590671, []int{146}
 }
 func (m *Alert) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -15162,12 +16269,28 @@ func (m *Alert) GetNotes() string {
 	return ""
 }
 
+func (m *Alert) GetOriginalPayload() []byte {
+	if m != nil {
+		return m.OriginalPayload
+	}
+	return nil
+}
+
+func (m *Alert) GetRetransmittedPayload() []byte {
+	if m != nil {
+		return m.RetransmittedPayload
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("types.Type", Type_name, Type_value)
 	proto.RegisterType((*Header)(nil), "types.Header")
 	proto.RegisterType((*Batch)(nil), "types.Batch")
 	proto.RegisterType((*PacketContext)(nil), "types.PacketContext")
 	proto.RegisterType((*Connection)(nil), "types.Connection")
+	proto.RegisterType((*Stats)(nil), "types.Stats")
+	proto.RegisterType((*Tunnel)(nil), "types.Tunnel")
 	proto.RegisterType((*Ethernet)(nil), "types.Ethernet")
 	proto.RegisterType((*ARP)(nil), "types.ARP")
 	proto.RegisterType((*Dot1Q)(nil), "types.Dot1Q")
@@ -15203,6 +16326,7 @@ func init() {
 	proto.RegisterType((*TCP)(nil), "types.TCP")
 	proto.RegisterType((*TCPOption)(nil), "types.TCPOption")
 	proto.RegisterType((*SCTP)(nil), "types.SCTP")
+	proto.RegisterType((*SCTPAssociation)(nil), "types.SCTPAssociation")
 	proto.RegisterType((*DNS)(nil), "types.DNS")
 	proto.RegisterType((*DNSResourceRecord)(nil), "types.DNSResourceRecord")
 	proto.RegisterType((*DNSSOA)(nil), "types.DNSSOA")
@@ -15320,769 +16444,821 @@ func init() {
 func init() { proto.RegisterFile("netcap.proto", fileDescriptor_3068659fd5590671) }
 
 var fileDescriptor_3068659fd5590671 = []byte{
-	// 12191 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6f, 0x8c, 0x24, 0x49,
-	0x76, 0x10, 0x7e, 0xf5, 0xaf, 0xbb, 0x2a, 0xaa, 0xaa, 0x27, 0x27, 0x67, 0x76, 0xa6, 0x77, 0x76,
-	0x6e, 0x6e, 0x2e, 0xbd, 0xb7, 0xb7, 0x9e, 0xdb, 0x1b, 0xdf, 0xf6, 0xac, 0xd7, 0xf7, 0xf7, 0x67,
-	0x57, 0x57, 0x75, 0x4f, 0xd7, 0x4d, 0x55, 0x75, 0x4e, 0x64, 0x75, 0xcf, 0xde, 0xf9, 0x07, 0x4b,
-	0x4e, 0x55, 0x74, 0x77, 0xba, 0xab, 0x33, 0x6b, 0x33, 0xb3, 0x66, 0xa6, 0x2d, 0x21, 0x99, 0x0f,
-	0x87, 0x04, 0x92, 0x65, 0xc0, 0xfe, 0x80, 0xc0, 0x06, 0xf9, 0xab, 0xc1, 0xc0, 0x07, 0x83, 0x40,
-	0x96, 0x00, 0x09, 0x81, 0x91, 0x25, 0x84, 0x31, 0x7c, 0xb0, 0x84, 0xb0, 0x90, 0x8d, 0xb0, 0xf8,
-	0x2b, 0x21, 0x10, 0x92, 0x31, 0x42, 0x28, 0x5e, 0xbc, 0x88, 0x8c, 0xc8, 0xca, 0x9a, 0xee, 0x19,
-	0xdf, 0x22, 0x21, 0xf1, 0x2d, 0xdf, 0x8b, 0xc8, 0xcc, 0x88, 0x17, 0x2f, 0x5e, 0xbc, 0xf7, 0xe2,
-	0xc5, 0x0b, 0xd2, 0x0a, 0x59, 0x3a, 0xf1, 0xe7, 0xf7, 0xe7, 0x71, 0x94, 0x46, 0x76, 0x2d, 0x3d,
-	0x9f, 0xb3, 0xc4, 0xf9, 0x2b, 0x25, 0xb2, 0xb6, 0xc7, 0xfc, 0x29, 0x8b, 0xed, 0x4d, 0xb2, 0xde,
-	0x8d, 0x99, 0x9f, 0xb2, 0xe9, 0x66, 0xe9, 0x6e, 0xe9, 0xdd, 0x0a, 0x95, 0xa0, 0x7d, 0x97, 0x34,
-	0xfb, 0xe1, 0x7c, 0x91, 0x7a, 0xd1, 0x22, 0x9e, 0xb0, 0xcd, 0xf2, 0xdd, 0xd2, 0xbb, 0x0d, 0xaa,
-	0xa3, 0xec, 0xcf, 0x91, 0xea, 0xf8, 0x7c, 0xce, 0x36, 0x2b, 0x77, 0x4b, 0xef, 0x6e, 0x6c, 0x35,
-	0xef, 0xc3, 0xc7, 0xef, 0x73, 0x14, 0x85, 0x02, 0xfe, 0xf1, 0x43, 0x16, 0x27, 0x41, 0x14, 0x6e,
-	0x56, 0xe1, 0x75, 0x09, 0xda, 0xf7, 0x88, 0xd5, 0x8d, 0xc2, 0xd4, 0x0f, 0xc2, 0xc4, 0xf5, 0xcf,
-	0x67, 0x91, 0x3f, 0x4d, 0x36, 0x6b, 0x77, 0x4b, 0xef, 0xd6, 0xe9, 0x12, 0xde, 0xf9, 0x1b, 0x25,
-	0x52, 0xdb, 0xf6, 0xd3, 0xc9, 0x89, 0x7d, 0x8b, 0xd4, 0xbb, 0xb3, 0x80, 0x85, 0x69, 0xbf, 0x07,
-	0xad, 0x6d, 0x50, 0x05, 0xdb, 0x5f, 0x26, 0xcd, 0x21, 0x4b, 0x12, 0xff, 0x98, 0x41, 0x9b, 0xca,
-	0xcb, 0x6d, 0xd2, 0xcb, 0xed, 0xdb, 0xa4, 0x31, 0x8e, 0x52, 0x7f, 0xe6, 0x05, 0x3f, 0x29, 0x3a,
-	0x50, 0xa3, 0x19, 0xc2, 0xb6, 0x49, 0xb5, 0xe7, 0xa7, 0x3e, 0xb4, 0xba, 0x45, 0xe1, 0xf9, 0x95,
-	0x9a, 0x1c, 0x91, 0xb6, 0xeb, 0x4f, 0x4e, 0x59, 0xca, 0x4b, 0xd8, 0x8b, 0xd4, 0xbe, 0x4e, 0x6a,
-	0x5e, 0x3c, 0xe9, 0xbb, 0xd8, 0x6c, 0x01, 0x70, 0x6c, 0x2f, 0x49, 0xfb, 0x2e, 0x12, 0x57, 0x00,
-	0x9c, 0x6a, 0x5e, 0x3c, 0x71, 0xa3, 0x38, 0xc5, 0x86, 0x49, 0x90, 0x97, 0xf4, 0x92, 0x14, 0x4a,
-	0xaa, 0xa2, 0x04, 0x41, 0xe7, 0x37, 0xd6, 0x09, 0xe9, 0x46, 0x61, 0xc8, 0x26, 0x29, 0x27, 0xef,
-	0x3b, 0x64, 0x63, 0x1c, 0x9c, 0xb1, 0x24, 0xf5, 0xcf, 0xe6, 0xbb, 0x41, 0x9c, 0xa4, 0x38, 0xb8,
-	0x39, 0x2c, 0xa7, 0xc2, 0x20, 0x08, 0x4f, 0x5d, 0xce, 0x1c, 0xd8, 0x88, 0x0c, 0x61, 0x3b, 0xa4,
-	0x35, 0x62, 0xe9, 0xf3, 0x28, 0xc6, 0x0a, 0x15, 0xa8, 0x60, 0xe0, 0xe0, 0x4f, 0xb1, 0x1f, 0x26,
-	0xf3, 0x28, 0x4e, 0x45, 0x2d, 0x31, 0xd2, 0x39, 0x2c, 0xa7, 0x5e, 0x67, 0x3e, 0x9f, 0x05, 0x13,
-	0x9f, 0x37, 0x50, 0xd4, 0xac, 0x41, 0xcd, 0x25, 0xbc, 0x7d, 0x83, 0xac, 0x79, 0xf1, 0x64, 0xd8,
-	0xe9, 0x6e, 0xae, 0x41, 0x0d, 0x84, 0x38, 0xbe, 0x97, 0xa4, 0x1c, 0xbf, 0x2e, 0xf0, 0x02, 0xca,
-	0x88, 0x5b, 0xd7, 0x89, 0xab, 0x91, 0xb1, 0x21, 0x98, 0x4f, 0x92, 0x51, 0x91, 0x9d, 0xe4, 0xc8,
-	0x2e, 0x89, 0xdb, 0x14, 0xf5, 0x11, 0x34, 0x79, 0xa5, 0x95, 0xe7, 0x95, 0x77, 0xc8, 0x46, 0x67,
-	0x3e, 0xc7, 0xa1, 0x87, 0x2a, 0x6d, 0xa8, 0x92, 0xc3, 0xda, 0x77, 0x08, 0x19, 0x2d, 0xce, 0x04,
-	0x5b, 0x24, 0x9b, 0x1b, 0x50, 0x47, 0xc3, 0xd8, 0x16, 0xa9, 0x1c, 0xf4, 0x7b, 0x9b, 0x57, 0xe0,
-	0xdf, 0xfc, 0xd1, 0x7e, 0x9b, 0xb4, 0xd5, 0x78, 0x0d, 0xfc, 0x24, 0xdd, 0xb4, 0x60, 0x10, 0x4d,
-	0x24, 0x9f, 0x14, 0xbd, 0x45, 0x0c, 0xe4, 0xdb, 0xbc, 0x0a, 0x15, 0x14, 0x6c, 0x7f, 0x85, 0x5c,
-	0xdb, 0x3e, 0x4f, 0x59, 0xe2, 0xb1, 0xf8, 0x19, 0x8b, 0xc7, 0x91, 0x98, 0x2d, 0x9b, 0x36, 0x54,
-	0x2b, 0x2a, 0x52, 0x6f, 0x08, 0x70, 0x1c, 0x89, 0xe2, 0xcd, 0x6b, 0xda, 0x1b, 0x66, 0x11, 0x97,
-	0x13, 0xa3, 0xc5, 0xd9, 0x6e, 0x7f, 0xb4, 0x3b, 0xf3, 0x8f, 0x93, 0xcd, 0xeb, 0xd0, 0x31, 0x1d,
-	0x85, 0x35, 0xa8, 0x37, 0x16, 0x35, 0xde, 0x50, 0x35, 0x24, 0x0a, 0x6b, 0x74, 0xba, 0x8f, 0x44,
-	0x8d, 0x1b, 0xaa, 0x86, 0x44, 0x61, 0x0d, 0xef, 0x3b, 0xf8, 0x97, 0x9b, 0xaa, 0x86, 0x44, 0x61,
-	0x8d, 0x03, 0xfa, 0x50, 0xd4, 0xd8, 0x54, 0x35, 0x24, 0x0a, 0x6b, 0xec, 0x74, 0x77, 0x44, 0x8d,
-	0x37, 0x55, 0x0d, 0x89, 0xc2, 0x1a, 0xae, 0xb7, 0x27, 0x6a, 0xdc, 0x52, 0x35, 0x24, 0x0a, 0x6b,
-	0x74, 0x9f, 0x50, 0x51, 0xe3, 0x2d, 0x55, 0x43, 0xa2, 0x70, 0x9c, 0x47, 0x9e, 0xa8, 0x70, 0x5b,
-	0x8d, 0x33, 0x62, 0x38, 0xbf, 0x0c, 0x99, 0x1f, 0x3e, 0x09, 0xc2, 0x69, 0xf4, 0x1c, 0xf8, 0xe5,
-	0xb3, 0x82, 0x5f, 0x4c, 0xac, 0xf3, 0x8f, 0x4a, 0xa4, 0xbe, 0x93, 0x9e, 0xb0, 0x38, 0x64, 0x82,
-	0x05, 0xe5, 0xa8, 0xe3, 0x5c, 0xce, 0x10, 0xda, 0x84, 0x29, 0xaf, 0x98, 0x30, 0x15, 0x63, 0xc2,
-	0x38, 0xa4, 0x25, 0xbf, 0x0c, 0xc2, 0x52, 0x08, 0x13, 0x03, 0xc7, 0x9b, 0x89, 0xdc, 0xbb, 0x13,
-	0xa6, 0x71, 0x34, 0x3f, 0x87, 0xe9, 0x5a, 0xa2, 0x39, 0x2c, 0x27, 0x88, 0xce, 0xfb, 0x6b, 0x82,
-	0x20, 0x1a, 0xca, 0xf9, 0xfd, 0x32, 0xa9, 0x74, 0xa8, 0x7b, 0x41, 0x1f, 0x6e, 0x91, 0x7a, 0x67,
-	0x3a, 0x8d, 0x95, 0xf0, 0xae, 0x51, 0x05, 0xf3, 0x32, 0x90, 0x0c, 0x93, 0x68, 0x86, 0x22, 0x51,
-	0xc1, 0x7c, 0x92, 0xec, 0x3d, 0xe7, 0x35, 0x59, 0x92, 0x40, 0x0b, 0x44, 0x67, 0x4c, 0x24, 0x67,
-	0x6b, 0xf9, 0x86, 0x5e, 0xb7, 0x06, 0x75, 0x8b, 0x8a, 0x78, 0x6b, 0xf7, 0xe7, 0x0c, 0xe7, 0x95,
-	0xe8, 0x55, 0x86, 0xe0, 0x14, 0xf4, 0xe2, 0x89, 0xfa, 0x07, 0x0a, 0x24, 0x03, 0x67, 0xdf, 0x27,
-	0x36, 0x97, 0x38, 0xe6, 0xb7, 0x51, 0x46, 0x15, 0x94, 0xf0, 0x6f, 0xf6, 0x92, 0x34, 0xfb, 0xa6,
-	0x90, 0x5a, 0x06, 0x8e, 0x7f, 0x93, 0x4b, 0xa5, 0xdc, 0x37, 0x85, 0x1c, 0x2b, 0x28, 0x71, 0x7e,
-	0xb1, 0x44, 0x6a, 0xbd, 0x28, 0x7d, 0xff, 0xf1, 0xc5, 0xd4, 0x77, 0xe3, 0x20, 0x8a, 0x83, 0xf4,
-	0x5c, 0x52, 0x5f, 0xc2, 0xd0, 0xae, 0x38, 0x9a, 0xef, 0xcc, 0x82, 0xe3, 0xe0, 0xe9, 0x4c, 0xac,
-	0x96, 0x75, 0x6a, 0xe0, 0x38, 0xb7, 0x1c, 0x0e, 0x3a, 0xa3, 0xfe, 0x94, 0x85, 0x69, 0x70, 0x14,
-	0xb0, 0x18, 0x87, 0x21, 0x87, 0xe5, 0x0b, 0x2b, 0x8c, 0xb0, 0x20, 0x3c, 0x3c, 0x3b, 0x7f, 0xa7,
-	0x22, 0xda, 0xf8, 0xfe, 0x05, 0x6d, 0x94, 0xef, 0x96, 0xb3, 0x77, 0xb9, 0x28, 0xcf, 0xd6, 0xa6,
-	0x1a, 0x15, 0x00, 0xc7, 0x8a, 0xd9, 0x27, 0x1a, 0x51, 0x53, 0x13, 0x53, 0x0a, 0xc6, 0x7e, 0x0f,
-	0x5b, 0xa0, 0x61, 0x24, 0x07, 0xb2, 0x24, 0x79, 0x1f, 0x17, 0x1e, 0x05, 0x6b, 0x65, 0x5b, 0x38,
-	0xd6, 0x0a, 0xd6, 0xca, 0x1e, 0xe0, 0xe8, 0x2a, 0x58, 0x2b, 0xfb, 0x00, 0xc7, 0x53, 0xc1, 0x9c,
-	0x66, 0x1e, 0xfb, 0x64, 0xc1, 0xc2, 0x09, 0x1b, 0x2d, 0xce, 0x9e, 0xb2, 0x18, 0xc6, 0xb1, 0x46,
-	0x73, 0x58, 0x5e, 0x6f, 0x37, 0xf6, 0x8f, 0xcf, 0x58, 0x98, 0x62, 0xbd, 0xa6, 0xa8, 0x67, 0x62,
-	0x41, 0x3b, 0x3a, 0x61, 0x93, 0xd3, 0x64, 0x71, 0x06, 0xab, 0x54, 0x9b, 0x2a, 0xd8, 0xfe, 0x3c,
-	0xa9, 0x3c, 0xde, 0xf7, 0x60, 0x65, 0x6a, 0x6e, 0x5d, 0x41, 0xad, 0x08, 0x88, 0xfe, 0x78, 0xdf,
-	0xa3, 0xbc, 0xcc, 0x7e, 0x40, 0x1a, 0x7b, 0x63, 0xae, 0xaf, 0xc4, 0xd1, 0x0c, 0x96, 0xa7, 0xe6,
-	0xd6, 0x1b, 0x7a, 0x45, 0x55, 0x48, 0xb3, 0x7a, 0xce, 0x53, 0x52, 0x97, 0x5f, 0xe1, 0x0b, 0xd8,
-	0x18, 0x15, 0xb3, 0x1a, 0xe5, 0x8f, 0x7c, 0xc4, 0x76, 0xf6, 0x3d, 0xa1, 0xde, 0xd4, 0x29, 0x3c,
-	0xf3, 0x31, 0xee, 0x4c, 0x4e, 0xdd, 0x68, 0x16, 0x4c, 0xce, 0xa5, 0xe2, 0xa5, 0x10, 0x30, 0xc6,
-	0x1f, 0xed, 0xbb, 0x38, 0x70, 0xf0, 0xcc, 0xb5, 0xd5, 0x0d, 0xb3, 0x05, 0x9c, 0x25, 0x3b, 0xdd,
-	0x6e, 0x14, 0x26, 0x69, 0xec, 0x07, 0xa1, 0xd0, 0x6e, 0xea, 0xd4, 0xc0, 0x71, 0xc1, 0x44, 0x7b,
-	0x0f, 0x87, 0x51, 0xcc, 0x5c, 0xb7, 0x77, 0x80, 0x6d, 0xd0, 0x51, 0xf6, 0x3d, 0x52, 0x39, 0xdc,
-	0x1b, 0x43, 0x23, 0x9a, 0x5b, 0x9b, 0x85, 0x7d, 0x3d, 0xdc, 0x1b, 0x53, 0x5e, 0xc9, 0xfe, 0x22,
-	0x29, 0xef, 0x8d, 0xa1, 0x59, 0xcd, 0xad, 0x9b, 0x85, 0x55, 0xf7, 0xc6, 0xb4, 0xbc, 0x37, 0x76,
-	0x7e, 0xad, 0x4c, 0xae, 0x2e, 0x7d, 0x83, 0xd3, 0x66, 0x48, 0x1f, 0x63, 0x3b, 0xf9, 0x23, 0x1f,
-	0xd5, 0x83, 0x30, 0xe1, 0xbd, 0x0e, 0x52, 0x36, 0x1d, 0xee, 0x6e, 0x63, 0x0b, 0x73, 0x58, 0x78,
-	0xd3, 0xeb, 0x23, 0xa5, 0xf8, 0x23, 0x6f, 0x36, 0xaf, 0x5e, 0x7d, 0x49, 0xb3, 0x87, 0xbb, 0xdb,
-	0x94, 0x57, 0xe2, 0xd2, 0xb1, 0x1b, 0x9d, 0xcd, 0x39, 0xc3, 0xb1, 0x29, 0xff, 0x8e, 0x60, 0x7b,
-	0x13, 0x09, 0x9c, 0x38, 0xde, 0xee, 0xf6, 0xc3, 0x29, 0xea, 0x61, 0xc0, 0xff, 0x75, 0x9a, 0xc3,
-	0xf2, 0xd1, 0x19, 0xee, 0x7a, 0x7d, 0x98, 0x01, 0x35, 0x0a, 0xcf, 0xbc, 0x7d, 0x0f, 0xfb, 0x3d,
-	0x60, 0xfc, 0x1a, 0xe5, 0x8f, 0x7c, 0x9e, 0x75, 0xa3, 0x69, 0x10, 0x1e, 0xc3, 0x6c, 0x6d, 0x88,
-	0x79, 0x96, 0x61, 0x80, 0x9f, 0x9f, 0x8e, 0x3f, 0xda, 0x66, 0xfe, 0xd9, 0x51, 0x14, 0x9f, 0xb1,
-	0x29, 0xf0, 0x7d, 0x9d, 0xe6, 0xb0, 0xce, 0x2f, 0x95, 0x89, 0x95, 0x27, 0xb1, 0x3d, 0x26, 0xd7,
-	0xb9, 0x82, 0xda, 0x99, 0xfa, 0x73, 0x68, 0x93, 0x64, 0xd8, 0x12, 0x50, 0xe3, 0xae, 0x4e, 0x8d,
-	0xa2, 0x7a, 0xb4, 0xf0, 0x6d, 0xbe, 0x3c, 0x74, 0xfd, 0x59, 0xf0, 0x54, 0xc8, 0x02, 0x37, 0x4a,
-	0x02, 0xa0, 0x82, 0x90, 0x34, 0x45, 0x45, 0xb9, 0x37, 0xe4, 0x8c, 0xc5, 0x61, 0x2a, 0x2a, 0xe2,
-	0xfc, 0xd8, 0xf5, 0xfa, 0x5e, 0xca, 0x58, 0x1c, 0x84, 0xc7, 0xc8, 0xe1, 0x3a, 0xca, 0x7e, 0x97,
-	0x5c, 0x19, 0xf5, 0xdc, 0x4e, 0x18, 0x46, 0x8b, 0x70, 0xc2, 0xf8, 0xcc, 0x46, 0x03, 0x23, 0x8f,
-	0xe6, 0x44, 0xef, 0xed, 0xf4, 0x71, 0x94, 0xf8, 0xa3, 0xc3, 0xf2, 0x5c, 0xc7, 0x47, 0xff, 0x06,
-	0x59, 0xe3, 0x1a, 0xd2, 0xd8, 0xc3, 0x49, 0x89, 0x10, 0xc7, 0x1f, 0xee, 0x8d, 0x87, 0x5d, 0x0f,
-	0x7b, 0x88, 0x90, 0xbd, 0x41, 0xca, 0xdb, 0x4f, 0xb0, 0x0f, 0xe5, 0xed, 0x27, 0xfc, 0x37, 0xde,
-	0x88, 0x62, 0x53, 0xf9, 0xa3, 0xf3, 0x0b, 0x25, 0xf2, 0xe6, 0x4a, 0xe2, 0x82, 0x04, 0xc8, 0xb8,
-	0x7c, 0x4c, 0x1f, 0x4b, 0xbe, 0x2f, 0x67, 0x7c, 0xbf, 0xcc, 0xcf, 0x92, 0xab, 0xaa, 0x26, 0x57,
-	0x71, 0x1e, 0x5f, 0xc3, 0x5a, 0xc0, 0xc9, 0xd5, 0x8e, 0xb7, 0x33, 0x00, 0x8a, 0x34, 0xb7, 0x2c,
-	0x7d, 0xa0, 0x39, 0x9e, 0x42, 0xa9, 0xf3, 0x35, 0xd2, 0x50, 0x28, 0xb0, 0x6d, 0xa3, 0xb3, 0x33,
-	0x3f, 0x9c, 0x62, 0xff, 0x25, 0xa8, 0xec, 0x3b, 0x5c, 0x4a, 0xf8, 0xb3, 0xf3, 0x2f, 0x4b, 0xc4,
-	0xe6, 0xbd, 0x1a, 0xf8, 0xe7, 0x2c, 0xee, 0x05, 0xc9, 0x24, 0x7a, 0xc6, 0xe2, 0xf3, 0x0b, 0xd6,
-	0xa4, 0x2d, 0xd2, 0xe8, 0x9e, 0xf8, 0x49, 0x12, 0x24, 0xfd, 0x1e, 0x7c, 0xad, 0xb9, 0x75, 0x1d,
-	0x9b, 0x36, 0x18, 0xf4, 0x5c, 0x55, 0x46, 0xb3, 0x6a, 0xf6, 0x0f, 0x92, 0x35, 0x6e, 0x56, 0xf4,
-	0x7b, 0x28, 0x79, 0xae, 0x6a, 0x2f, 0x88, 0x02, 0x8a, 0x15, 0x80, 0xa0, 0xe3, 0x81, 0x1c, 0x80,
-	0xf1, 0x78, 0x60, 0x7f, 0x48, 0xd6, 0x0e, 0xfd, 0xd9, 0x82, 0x71, 0xdb, 0xb3, 0xf2, 0x6e, 0x73,
-	0xeb, 0x8e, 0x7c, 0x79, 0xa9, 0xe5, 0x50, 0x8d, 0x62, 0x6d, 0xe7, 0x6b, 0xa4, 0x6d, 0x34, 0x08,
-	0xcc, 0xa3, 0xc5, 0x53, 0xfe, 0xb2, 0x24, 0x0e, 0x82, 0x9c, 0x0b, 0xb0, 0x33, 0x2d, 0x5a, 0xee,
-	0xf7, 0x9c, 0x0f, 0x09, 0xc9, 0x9a, 0xf6, 0x0a, 0xef, 0xfd, 0x38, 0xb9, 0xb9, 0xa2, 0x55, 0x6a,
-	0x29, 0x2f, 0x69, 0x4b, 0xf9, 0x0d, 0xb2, 0x36, 0x60, 0xe1, 0x71, 0x7a, 0x22, 0x99, 0x52, 0x40,
-	0x7c, 0x31, 0x87, 0x97, 0x80, 0x5a, 0x2d, 0x2a, 0x00, 0xa7, 0x4f, 0x9a, 0x52, 0x5d, 0xed, 0x8e,
-	0x2f, 0xd2, 0x2d, 0x6f, 0x93, 0x86, 0x77, 0x1a, 0xcc, 0xbb, 0xd1, 0x22, 0x4c, 0xf1, 0xeb, 0x19,
-	0xc2, 0xf9, 0x93, 0x25, 0x62, 0x69, 0xdf, 0xa2, 0x6c, 0x3e, 0x3b, 0xbf, 0x58, 0x5d, 0xda, 0x5d,
-	0x84, 0x13, 0x4d, 0x48, 0x28, 0x98, 0x8b, 0x5c, 0xca, 0x26, 0x2c, 0x98, 0xcb, 0xd5, 0x5a, 0xb0,
-	0xba, 0x89, 0x2c, 0xf2, 0x30, 0x38, 0x7f, 0xb6, 0x42, 0x6e, 0x2c, 0x53, 0xac, 0x1f, 0x1e, 0x45,
-	0x17, 0x34, 0xe7, 0x5d, 0x72, 0x85, 0x8f, 0x4e, 0x8f, 0x25, 0x93, 0x38, 0x98, 0xab, 0x56, 0x35,
-	0x68, 0x1e, 0x0d, 0xa3, 0x77, 0x9e, 0x8c, 0xfc, 0x33, 0x86, 0x26, 0x81, 0x04, 0x61, 0x0d, 0x38,
-	0x4f, 0xf4, 0x4f, 0xa0, 0x21, 0x6f, 0x62, 0xed, 0x1e, 0xb9, 0xe2, 0x9d, 0x27, 0x5d, 0x7f, 0xee,
-	0x3f, 0x0d, 0x66, 0x41, 0x1a, 0xb0, 0x04, 0xa7, 0xe4, 0x2d, 0x8d, 0x8d, 0x73, 0x35, 0x68, 0xfe,
-	0x15, 0xfb, 0xab, 0xa4, 0x39, 0x3c, 0x3e, 0x4b, 0xa5, 0x02, 0xbb, 0x06, 0x5f, 0xb8, 0xa1, 0x7d,
-	0x41, 0x2b, 0xa5, 0x7a, 0x55, 0xfb, 0x01, 0x59, 0xdf, 0x8f, 0x8f, 0xc7, 0x83, 0x43, 0xae, 0x74,
-	0xf3, 0x19, 0xf0, 0xa6, 0xf6, 0xd6, 0x7e, 0x7c, 0xec, 0xcd, 0xd9, 0x24, 0x38, 0x0a, 0x26, 0xe3,
-	0xc1, 0x21, 0x95, 0x35, 0xed, 0xaf, 0x92, 0xf5, 0x83, 0xf0, 0x34, 0x8c, 0x9e, 0x87, 0x9b, 0xf5,
-	0x4b, 0x4d, 0x1b, 0x59, 0xdd, 0xf9, 0x5e, 0x89, 0x5c, 0x2b, 0xe8, 0x91, 0xfd, 0xc3, 0xa4, 0xe1,
-	0x9d, 0x27, 0x29, 0x3b, 0xeb, 0xfa, 0x73, 0x5c, 0x7c, 0x6e, 0xea, 0x13, 0x5f, 0xef, 0x7d, 0x56,
-	0xd3, 0xfe, 0x11, 0x42, 0x76, 0x42, 0xff, 0xe9, 0x8c, 0x4d, 0xf9, 0x7b, 0xe5, 0x97, 0xbf, 0xa7,
-	0x55, 0x75, 0x7e, 0xbe, 0x4c, 0xac, 0x7c, 0x05, 0x3e, 0x35, 0xf6, 0x39, 0xe3, 0xa2, 0xc4, 0x15,
-	0x00, 0x67, 0x4e, 0xca, 0xe6, 0xcc, 0x4f, 0x59, 0x8c, 0x82, 0x57, 0xc1, 0x7c, 0x92, 0x6d, 0xc7,
-	0xc1, 0xf4, 0x58, 0x6a, 0xf1, 0x08, 0x71, 0xfc, 0x93, 0x41, 0x67, 0xd4, 0x11, 0x9a, 0x57, 0x9d,
-	0x22, 0xc4, 0xf1, 0x34, 0x5a, 0xf0, 0x2f, 0x89, 0x95, 0x08, 0x21, 0xd0, 0xbb, 0x4f, 0xa2, 0x90,
-	0xe1, 0x12, 0x24, 0x00, 0xb0, 0x37, 0xa3, 0x89, 0x17, 0x08, 0x7b, 0xa8, 0x4e, 0x11, 0xe2, 0x4b,
-	0x9f, 0x97, 0xc2, 0x4a, 0xb1, 0x1f, 0xce, 0xce, 0x41, 0x57, 0xa8, 0x53, 0x1d, 0xc5, 0xbf, 0xd7,
-	0xe5, 0xa6, 0x02, 0xa8, 0x0b, 0x75, 0x2a, 0x00, 0x70, 0xec, 0x00, 0x56, 0x28, 0x08, 0x02, 0x00,
-	0xe1, 0x31, 0x74, 0x29, 0x68, 0xc1, 0x75, 0x0a, 0xcf, 0xce, 0x2f, 0x97, 0xc8, 0x95, 0x1c, 0xdb,
-	0xbc, 0x44, 0x52, 0x6d, 0x92, 0x75, 0xc9, 0x79, 0x42, 0x5c, 0x49, 0xd0, 0xbe, 0x47, 0xac, 0x7e,
-	0x98, 0xb2, 0xf8, 0xc8, 0x9f, 0x30, 0xf9, 0xb2, 0x98, 0xbf, 0x4b, 0x78, 0x3e, 0xeb, 0x14, 0x0e,
-	0xa7, 0x7a, 0x15, 0xd4, 0xee, 0x3c, 0x9a, 0x8b, 0xf1, 0x7d, 0x34, 0x39, 0x1a, 0x94, 0x3f, 0x3a,
-	0x63, 0x62, 0x2f, 0xf3, 0x2b, 0xd4, 0x3b, 0xe8, 0x43, 0x6b, 0xdb, 0x94, 0x3f, 0x62, 0x1f, 0x34,
-	0xb3, 0x47, 0x82, 0x9c, 0x0a, 0x5c, 0x32, 0xa0, 0x54, 0x84, 0x67, 0xe7, 0x0f, 0x2a, 0xa4, 0xda,
-	0x77, 0x9f, 0x7d, 0x70, 0x81, 0xb8, 0xd0, 0xdc, 0xb2, 0xf8, 0x51, 0xe9, 0x96, 0xb5, 0x48, 0xa5,
-	0xbf, 0x37, 0x90, 0x8b, 0x73, 0x7f, 0x6f, 0x00, 0x2b, 0xd0, 0xbe, 0xa7, 0x56, 0xa0, 0x7d, 0x4f,
-	0x93, 0xd3, 0x35, 0x43, 0x4e, 0x73, 0xf1, 0x3f, 0xc5, 0x15, 0xbb, 0xdc, 0x9f, 0x66, 0x46, 0xd8,
-	0x7a, 0xce, 0x08, 0xe3, 0x66, 0xcb, 0xfe, 0xd1, 0x51, 0xc2, 0x52, 0xd4, 0x1a, 0x35, 0x8c, 0x5c,
-	0xf1, 0x1a, 0xd9, 0x8a, 0xa7, 0x1b, 0xff, 0x24, 0x67, 0xfc, 0xeb, 0x26, 0x8f, 0x30, 0x8a, 0x32,
-	0x93, 0x47, 0x79, 0x05, 0x5b, 0x85, 0x2e, 0xd7, 0x76, 0xce, 0xf7, 0xe7, 0xfa, 0x53, 0xae, 0xa1,
-	0x82, 0xe5, 0xd3, 0xa2, 0x12, 0xb4, 0xbf, 0x44, 0xd6, 0xf7, 0x41, 0xf0, 0x25, 0x9b, 0x57, 0x40,
-	0x72, 0xc8, 0xd5, 0x9a, 0xd3, 0x59, 0x94, 0x50, 0x59, 0xa3, 0xc0, 0x67, 0x62, 0x5d, 0xc6, 0x67,
-	0x72, 0x75, 0xc9, 0x67, 0xa2, 0x3b, 0x2f, 0xed, 0x95, 0x3e, 0xe0, 0x6b, 0xa6, 0x0f, 0x78, 0x4e,
-	0x48, 0xd6, 0x28, 0x4e, 0x68, 0xf1, 0xa4, 0x2d, 0xb4, 0x1a, 0x86, 0x9b, 0x50, 0x02, 0x32, 0x16,
-	0x5d, 0x03, 0x97, 0x7d, 0x03, 0x96, 0x2a, 0xc1, 0x69, 0x1a, 0xc6, 0xf9, 0xeb, 0x82, 0xdf, 0x3e,
-	0x7c, 0x6d, 0x7e, 0x73, 0x48, 0x6b, 0x1c, 0xfb, 0x47, 0x47, 0xc1, 0xa4, 0x3b, 0xf3, 0x93, 0x04,
-	0x19, 0xcf, 0xc0, 0xf1, 0x6f, 0xef, 0xce, 0xa2, 0xe7, 0x03, 0xff, 0x29, 0x9b, 0xe1, 0x04, 0xcb,
-	0x10, 0x2b, 0xb9, 0xf1, 0x0e, 0x21, 0x23, 0xf6, 0x22, 0x15, 0xbb, 0x1c, 0xc8, 0x95, 0x1a, 0x86,
-	0x73, 0xce, 0x5e, 0x34, 0x1f, 0x04, 0x67, 0x41, 0x8a, 0x0c, 0xaa, 0xe0, 0x15, 0xfe, 0x64, 0xc5,
-	0x39, 0x0d, 0x9d, 0x73, 0x96, 0x87, 0x9c, 0x5c, 0x66, 0xc8, 0x9b, 0xcb, 0x43, 0xfe, 0x43, 0xd0,
-	0xa2, 0xed, 0xf3, 0xbd, 0x68, 0x0e, 0x2c, 0xdb, 0xdc, 0xba, 0x96, 0xb1, 0xda, 0x87, 0xb2, 0x88,
-	0xaa, 0x4a, 0x3a, 0x8f, 0xb4, 0x57, 0xf2, 0xc8, 0x86, 0xc9, 0x23, 0xbf, 0x5d, 0x26, 0x2d, 0xfe,
-	0x39, 0xe9, 0x3a, 0xb8, 0x60, 0xe4, 0x4c, 0x2a, 0x96, 0x97, 0xa8, 0x78, 0x9b, 0x34, 0x28, 0x4b,
-	0x58, 0xfc, 0x8c, 0x4d, 0xdf, 0x97, 0xc6, 0xbc, 0x42, 0xe8, 0x8e, 0x0b, 0x9c, 0xef, 0x55, 0xd3,
-	0x71, 0x81, 0x73, 0x5e, 0xfb, 0xca, 0x16, 0x0e, 0x63, 0x86, 0xe0, 0xfa, 0x14, 0xb7, 0xd8, 0xe5,
-	0x3b, 0x09, 0x2e, 0x39, 0x26, 0x92, 0xff, 0x4b, 0xba, 0x99, 0xd0, 0x84, 0x5d, 0x07, 0x56, 0xc9,
-	0x61, 0x75, 0xa2, 0xd5, 0x57, 0x12, 0xad, 0x61, 0x10, 0x2d, 0xe3, 0x07, 0x52, 0xc8, 0x0f, 0x4d,
-	0x8d, 0x1f, 0x9c, 0xbf, 0x5a, 0x22, 0x6b, 0xfd, 0xee, 0xf0, 0x62, 0x21, 0x7c, 0x8b, 0xd4, 0xf9,
-	0x3c, 0xec, 0x46, 0x53, 0xe5, 0xef, 0x94, 0xb0, 0x21, 0xd6, 0x2a, 0x39, 0xb1, 0x26, 0xc4, 0x6c,
-	0x55, 0x89, 0x59, 0x6e, 0xa3, 0xb1, 0x4f, 0x90, 0x6c, 0xfc, 0x31, 0x6b, 0xee, 0x5a, 0x61, 0x73,
-	0xd7, 0xf5, 0xe6, 0xfe, 0x69, 0xd9, 0xdc, 0x0f, 0x3f, 0xa5, 0xe6, 0xaa, 0xc6, 0x54, 0x0b, 0x1b,
-	0x53, 0xd3, 0x1b, 0xf3, 0x9b, 0x25, 0xf2, 0x96, 0x68, 0xcc, 0x88, 0x05, 0xc7, 0x27, 0x4f, 0xa3,
-	0xb8, 0x33, 0x7d, 0xc6, 0xe2, 0x34, 0x48, 0xd8, 0x25, 0x78, 0x55, 0xad, 0x37, 0x65, 0x7d, 0xbd,
-	0x79, 0x9b, 0xb4, 0xc7, 0x7e, 0x7c, 0xcc, 0x94, 0xaa, 0x29, 0xd4, 0x5e, 0x13, 0x69, 0x7f, 0x39,
-	0x93, 0xf2, 0x55, 0x90, 0xf2, 0x6a, 0xea, 0x41, 0x73, 0xf2, 0x72, 0x5e, 0x75, 0xaa, 0x56, 0xd8,
-	0xa9, 0x35, 0xbd, 0x53, 0x7f, 0xbb, 0x4c, 0xde, 0x14, 0x5f, 0x11, 0xaa, 0xd3, 0xab, 0x74, 0x49,
-	0x17, 0x52, 0xe5, 0x65, 0x21, 0x25, 0xba, 0x5b, 0xd1, 0xbb, 0xfb, 0x0e, 0xd9, 0x10, 0xbf, 0x19,
-	0x04, 0x47, 0x2c, 0x0d, 0xce, 0xa4, 0x3b, 0x3c, 0x87, 0x15, 0x46, 0x8a, 0x3f, 0x39, 0xe1, 0xfa,
-	0x25, 0xff, 0x1f, 0xf4, 0xa4, 0x4d, 0x4d, 0x24, 0x17, 0xcf, 0x94, 0xa5, 0xb1, 0x1f, 0x26, 0x1c,
-	0x14, 0x62, 0xb4, 0x4d, 0x0d, 0x9c, 0x4e, 0xba, 0xf5, 0x57, 0x21, 0xdd, 0xc5, 0xb2, 0xd5, 0xf9,
-	0x90, 0xb4, 0xf4, 0x8f, 0x14, 0x5a, 0x8d, 0xba, 0x25, 0x2f, 0xed, 0xa8, 0xbf, 0x58, 0x26, 0x95,
-	0x83, 0x9e, 0x7b, 0xf1, 0xaa, 0x24, 0x25, 0x41, 0x79, 0xa5, 0x24, 0xa8, 0x98, 0x92, 0x20, 0x5b,
-	0x6d, 0xaa, 0xc6, 0x6a, 0xa3, 0xcf, 0x80, 0x5a, 0x6e, 0x06, 0x2c, 0xaf, 0x10, 0x6b, 0x97, 0x59,
-	0x21, 0xd6, 0x0b, 0x95, 0x02, 0x04, 0x81, 0x7a, 0xa0, 0xa5, 0x00, 0x98, 0x51, 0xb5, 0x51, 0x48,
-	0x55, 0x7d, 0x9f, 0xd3, 0xf9, 0x77, 0x55, 0x52, 0x19, 0x77, 0x3f, 0x25, 0xea, 0x78, 0xec, 0x93,
-	0xd1, 0xe2, 0x0c, 0x97, 0x69, 0x84, 0x38, 0xbe, 0x33, 0x39, 0x1d, 0x21, 0x6d, 0xda, 0x14, 0x21,
-	0x70, 0xc8, 0xfb, 0xa9, 0x8f, 0x6b, 0x03, 0xae, 0xd1, 0x19, 0x86, 0x8b, 0xb6, 0xdd, 0xfe, 0x08,
-	0x6d, 0x09, 0xfe, 0x08, 0xc2, 0xee, 0x3b, 0x23, 0x34, 0x20, 0xf8, 0x23, 0xc7, 0x50, 0x6f, 0x8c,
-	0x66, 0x03, 0x7f, 0xe4, 0x18, 0xd7, 0xdb, 0x43, 0x93, 0x81, 0x3f, 0x72, 0x4c, 0xa7, 0xfb, 0x08,
-	0xed, 0x05, 0xfe, 0x08, 0x7b, 0xad, 0xf4, 0x21, 0x2c, 0xb3, 0x75, 0xca, 0x1f, 0x39, 0x66, 0xa7,
-	0xbb, 0x03, 0x0b, 0x69, 0x9d, 0xf2, 0x47, 0x8e, 0xe9, 0x3e, 0xa1, 0xb0, 0x80, 0xd6, 0x29, 0x7f,
-	0xe4, 0xa2, 0x77, 0xe4, 0xc1, 0x06, 0x6d, 0x9d, 0x96, 0x47, 0xa0, 0x09, 0x8b, 0xfd, 0x3a, 0x50,
-	0xf3, 0x6a, 0x14, 0x21, 0x83, 0x1b, 0xae, 0xe6, 0xb8, 0xe1, 0x06, 0x59, 0x3b, 0x88, 0x8f, 0xe5,
-	0x26, 0x6c, 0x8d, 0x22, 0xa4, 0x6b, 0xa0, 0xd7, 0x4c, 0x0d, 0xf4, 0x5e, 0x36, 0xc1, 0xae, 0xc3,
-	0x04, 0x93, 0xbe, 0xaf, 0x71, 0xd7, 0xbd, 0x58, 0x01, 0x7d, 0xe3, 0x32, 0xbc, 0x76, 0xe3, 0xa5,
-	0xbc, 0x76, 0x73, 0x05, 0xaf, 0x6d, 0x16, 0xf2, 0xda, 0x9b, 0x3a, 0xaf, 0x45, 0xa4, 0xa1, 0x5a,
-	0xf9, 0x7f, 0x44, 0x23, 0xfd, 0xf5, 0x12, 0xa9, 0x7a, 0x17, 0x3b, 0x84, 0x5e, 0x87, 0xbb, 0xdf,
-	0x25, 0x57, 0x0e, 0x59, 0xac, 0x34, 0x89, 0xb1, 0x7f, 0x2c, 0xcd, 0xbd, 0x1c, 0x7a, 0x49, 0x1a,
-	0xb4, 0x8b, 0xd6, 0xc3, 0x4b, 0x2c, 0xce, 0xff, 0xb5, 0x4a, 0x2a, 0xbd, 0x91, 0x77, 0x41, 0x5f,
-	0x32, 0xb7, 0x1b, 0x57, 0x08, 0x7a, 0x1c, 0x7e, 0x4c, 0xd1, 0xbc, 0x2f, 0x3f, 0xa6, 0x9c, 0xe3,
-	0xf6, 0xe7, 0xb0, 0x6e, 0xa3, 0xcc, 0x12, 0x10, 0xaf, 0xd7, 0xe9, 0xa0, 0x59, 0x5f, 0xee, 0x74,
-	0x38, 0x3c, 0xee, 0xa2, 0x72, 0x55, 0x1e, 0x77, 0x39, 0x4c, 0x7b, 0x38, 0xf9, 0xca, 0x14, 0xbe,
-	0x4b, 0x3b, 0x38, 0xf5, 0xca, 0xb4, 0x63, 0xb7, 0x48, 0xe9, 0xbb, 0xa8, 0x29, 0x95, 0xbe, 0x2b,
-	0x96, 0x8a, 0x64, 0x1e, 0x85, 0x89, 0xd0, 0x11, 0x84, 0xa5, 0x66, 0xe0, 0x38, 0x6d, 0x1f, 0xf7,
-	0x84, 0x13, 0x4e, 0xe8, 0xbf, 0x12, 0x04, 0x83, 0x7c, 0x24, 0x4a, 0x44, 0x7c, 0x85, 0x04, 0x79,
-	0xc9, 0xc8, 0x13, 0x25, 0xa8, 0xe4, 0x22, 0x08, 0xef, 0x50, 0x51, 0x82, 0x4a, 0x2e, 0x82, 0xf6,
-	0x57, 0x48, 0xe3, 0xf1, 0x82, 0x25, 0xba, 0xd5, 0x66, 0x4b, 0x7f, 0xf1, 0xc8, 0x93, 0x45, 0x34,
-	0xab, 0x64, 0x6f, 0x91, 0xf5, 0x4e, 0x98, 0x3c, 0x67, 0x71, 0xb2, 0x69, 0x41, 0xfd, 0xcd, 0xac,
-	0x3e, 0x65, 0x09, 0x84, 0x3b, 0x51, 0x36, 0x89, 0xe2, 0x29, 0x95, 0x15, 0xed, 0xaf, 0x93, 0x66,
-	0x67, 0x91, 0x9e, 0x44, 0xb1, 0x70, 0x82, 0x5d, 0xbd, 0xe0, 0x3d, 0xbd, 0x32, 0xbc, 0x3b, 0x9d,
-	0xc2, 0x4e, 0x82, 0x3f, 0x4b, 0x36, 0xed, 0x0b, 0xdf, 0xcd, 0x2a, 0x67, 0x1c, 0x74, 0xad, 0x90,
-	0x83, 0xae, 0xaf, 0x08, 0x25, 0x7a, 0x63, 0x25, 0x9f, 0xdf, 0x30, 0x4d, 0x84, 0x7f, 0x5e, 0x26,
-	0x57, 0x97, 0x9a, 0xc0, 0xd7, 0x59, 0xf0, 0x1a, 0x8a, 0xf8, 0x25, 0x78, 0x5e, 0xb5, 0x21, 0xab,
-	0x9b, 0x72, 0x02, 0xd0, 0xfd, 0xd8, 0x6d, 0x61, 0xd5, 0xa3, 0xec, 0x37, 0x6c, 0x37, 0x0d, 0xa3,
-	0xd6, 0xf5, 0x35, 0x2d, 0x02, 0x8b, 0x73, 0xba, 0x9c, 0x22, 0xe5, 0xbe, 0x8b, 0xf2, 0x58, 0x2c,
-	0x85, 0x5c, 0x1e, 0xf3, 0x7f, 0x8f, 0x3a, 0xc3, 0x1d, 0xe0, 0xca, 0x16, 0x15, 0x00, 0xac, 0x07,
-	0x63, 0x0a, 0x0c, 0xd9, 0xa2, 0xfc, 0xd1, 0xfe, 0x1c, 0xa9, 0x78, 0xfb, 0x1d, 0xe0, 0xc1, 0xe6,
-	0x56, 0x3b, 0xa3, 0xba, 0xb7, 0xdf, 0xa1, 0xbc, 0x04, 0x2a, 0xd0, 0x43, 0xb4, 0xc2, 0xf4, 0x0a,
-	0xf4, 0x90, 0xf2, 0x12, 0xfb, 0x36, 0x29, 0x0f, 0x3f, 0xc2, 0xdd, 0xd4, 0x56, 0x56, 0x3e, 0xfc,
-	0x88, 0x96, 0x87, 0x1f, 0x89, 0x4d, 0xcc, 0x71, 0xb2, 0xb9, 0x71, 0xb7, 0xc2, 0xdb, 0xce, 0x9f,
-	0x9d, 0xbf, 0x56, 0x22, 0x6b, 0xe2, 0x17, 0xbc, 0x99, 0x43, 0x45, 0xcb, 0x16, 0x15, 0x00, 0xc7,
-	0x52, 0xc0, 0x0a, 0x4d, 0x46, 0x00, 0x62, 0x49, 0x8d, 0x03, 0x5f, 0xc4, 0x3d, 0xc0, 0x92, 0xca,
-	0x21, 0x3e, 0x7c, 0x94, 0x1d, 0xc5, 0x2c, 0x39, 0x41, 0xa2, 0x4a, 0x10, 0xbe, 0xc3, 0xd2, 0xf8,
-	0x1c, 0x25, 0x8f, 0x00, 0xf8, 0x77, 0x76, 0x5e, 0xcc, 0x83, 0x98, 0xa1, 0x0e, 0x87, 0x10, 0xff,
-	0xce, 0x30, 0x08, 0x83, 0xb3, 0xc5, 0x19, 0xda, 0x4b, 0x12, 0x74, 0xa6, 0xa2, 0xbd, 0xf4, 0xd0,
-	0x88, 0x0d, 0x28, 0xe5, 0x62, 0x03, 0xf8, 0x12, 0xc8, 0x75, 0x75, 0x29, 0x47, 0x11, 0xe2, 0x24,
-	0xd0, 0x64, 0x28, 0x3c, 0x2b, 0x16, 0x42, 0x97, 0x37, 0x7f, 0x76, 0xbe, 0x41, 0x6a, 0x40, 0x37,
-	0xce, 0x0f, 0x6e, 0xcc, 0x8e, 0x58, 0x0c, 0xdb, 0x68, 0xb8, 0x38, 0x64, 0x18, 0xf5, 0x72, 0x39,
-	0xe3, 0x3f, 0xe7, 0x11, 0x69, 0x6a, 0xf3, 0xf9, 0x0f, 0xc7, 0xa2, 0xce, 0xef, 0x57, 0xc9, 0x5a,
-	0x6f, 0xaf, 0x7b, 0xb1, 0xe1, 0x66, 0x04, 0x86, 0x94, 0x0b, 0x02, 0x43, 0xf6, 0xfc, 0x78, 0xfa,
-	0xdc, 0x8f, 0xd9, 0x38, 0x73, 0x1e, 0x1a, 0x38, 0xbe, 0xfa, 0x4a, 0x78, 0xc0, 0x42, 0xb9, 0x13,
-	0xa8, 0xa1, 0xf4, 0xaf, 0xec, 0xcf, 0xd3, 0x04, 0xe7, 0x87, 0x81, 0xe3, 0x7c, 0xfd, 0x51, 0x30,
-	0xc5, 0xf1, 0xe4, 0x8f, 0xbc, 0xb3, 0x1e, 0x9b, 0x48, 0x87, 0x1b, 0x3c, 0x67, 0x66, 0x42, 0x5d,
-	0x37, 0x13, 0xb2, 0x40, 0x4a, 0xa9, 0x32, 0x2a, 0x98, 0xff, 0xfb, 0x3b, 0xd1, 0x22, 0x56, 0xe5,
-	0x42, 0x79, 0x34, 0x70, 0x22, 0x32, 0xf0, 0x45, 0x2a, 0x22, 0xc0, 0x94, 0x09, 0x6c, 0xe0, 0xc4,
-	0x8a, 0x30, 0xf3, 0xcf, 0x3b, 0xc7, 0xe2, 0x3b, 0xc2, 0x0d, 0x67, 0xe0, 0x78, 0x1d, 0xf1, 0xcd,
-	0xbd, 0x27, 0xdc, 0x14, 0x43, 0xa7, 0x9c, 0x81, 0xe3, 0x9c, 0x21, 0xbe, 0x09, 0x83, 0x2b, 0xdc,
-	0x73, 0x1a, 0x86, 0xf7, 0x7a, 0x37, 0x98, 0x31, 0xd0, 0xcb, 0x5a, 0x14, 0x9e, 0x75, 0xaf, 0x9d,
-	0x65, 0x78, 0xed, 0xf8, 0x08, 0xe7, 0x95, 0xa6, 0xbb, 0xa4, 0xb9, 0x1b, 0x84, 0xc7, 0x2c, 0x9e,
-	0xc7, 0x41, 0x98, 0x82, 0xc6, 0xd6, 0xa0, 0x3a, 0x2a, 0x13, 0xb9, 0x76, 0xa1, 0xc8, 0xbd, 0xb6,
-	0x42, 0xe4, 0x5e, 0x5f, 0x29, 0x72, 0xdf, 0x30, 0x45, 0xee, 0x80, 0x90, 0xac, 0x61, 0xaf, 0xb4,
-	0x39, 0x26, 0xc5, 0xa4, 0xb0, 0x6a, 0x85, 0xf9, 0xf3, 0x1f, 0xca, 0xc8, 0xc9, 0x97, 0xf0, 0xcb,
-	0x0d, 0x93, 0x63, 0xdd, 0xb9, 0x8c, 0x20, 0x1a, 0x9e, 0x62, 0x71, 0xad, 0x28, 0xc3, 0x53, 0xac,
-	0xae, 0xb7, 0x48, 0x5d, 0x6c, 0xfe, 0x4e, 0x63, 0x34, 0xea, 0x15, 0x0c, 0xa2, 0x82, 0x71, 0x1b,
-	0x77, 0x1a, 0xa3, 0x6d, 0xac, 0x60, 0xb0, 0xc4, 0xb9, 0xd9, 0xe8, 0x4f, 0x30, 0x02, 0x47, 0x88,
-	0x76, 0x13, 0xb9, 0xda, 0x9c, 0x14, 0x3d, 0xba, 0x60, 0xec, 0xea, 0x2f, 0x19, 0xbb, 0x8b, 0x4d,
-	0x23, 0x7d, 0xec, 0x9a, 0x2b, 0xc7, 0xae, 0x65, 0x8e, 0xdd, 0x88, 0xb4, 0xf4, 0xa6, 0xf1, 0x11,
-	0x01, 0x05, 0x08, 0x47, 0x0f, 0x14, 0x9f, 0x57, 0x19, 0xbd, 0xef, 0x95, 0x48, 0x65, 0x30, 0xe8,
-	0x5e, 0x1c, 0x0b, 0xd5, 0xf3, 0x3a, 0xae, 0xda, 0xc0, 0xf6, 0x3a, 0xb0, 0x1c, 0xf6, 0x1f, 0x4a,
-	0xc5, 0xaf, 0xff, 0x10, 0xc4, 0x81, 0xd7, 0x51, 0xb1, 0x34, 0x1e, 0xd6, 0xe9, 0x52, 0xa9, 0xf4,
-	0x75, 0xa9, 0xd8, 0x22, 0x17, 0x11, 0x14, 0x6b, 0x72, 0x8b, 0x5c, 0x44, 0xf6, 0xfc, 0x5e, 0x95,
-	0x54, 0x46, 0x17, 0x2a, 0xd2, 0x6f, 0x93, 0xf6, 0x80, 0xf9, 0x73, 0x8c, 0x11, 0x89, 0xa4, 0x8f,
-	0xd0, 0x44, 0xea, 0x0e, 0xe0, 0x8a, 0xe9, 0x00, 0xb6, 0x49, 0x75, 0x98, 0xa9, 0xa6, 0xf0, 0x0c,
-	0xa3, 0x90, 0xc6, 0x7e, 0xaa, 0x6c, 0x69, 0x09, 0x8a, 0x55, 0x65, 0x26, 0x9b, 0x0a, 0xcf, 0xbc,
-	0x7d, 0x6e, 0xcc, 0x26, 0x41, 0x22, 0x7d, 0x7e, 0x35, 0x9a, 0x21, 0xc0, 0xb5, 0x18, 0x45, 0x69,
-	0x8f, 0x0b, 0x1d, 0xe0, 0x8e, 0x36, 0xcd, 0x10, 0xc2, 0x5b, 0x12, 0xa5, 0xbd, 0x20, 0x99, 0x63,
-	0xf3, 0x1a, 0xc2, 0x69, 0x68, 0x62, 0x21, 0x94, 0x48, 0xae, 0x44, 0xfd, 0x1e, 0xf0, 0x4c, 0x9b,
-	0xea, 0x28, 0xfb, 0x3e, 0xb1, 0x15, 0x98, 0x91, 0x8b, 0x33, 0x51, 0x95, 0x16, 0x94, 0x70, 0x63,
-	0x62, 0x3f, 0x0e, 0x8e, 0x83, 0x30, 0xab, 0xdc, 0x82, 0xca, 0x79, 0xb4, 0x7d, 0x8f, 0x58, 0xb0,
-	0x73, 0xfc, 0x4c, 0xfb, 0x6e, 0x1b, 0xaa, 0x2e, 0xe1, 0xed, 0xf7, 0xc8, 0x55, 0x98, 0x4d, 0x67,
-	0x41, 0x9a, 0x55, 0xde, 0x80, 0xca, 0xcb, 0x05, 0xbc, 0xf7, 0x3b, 0x2f, 0x52, 0x16, 0xf2, 0x2e,
-	0x42, 0x60, 0x2f, 0x8a, 0xd0, 0x1c, 0x36, 0x9b, 0x41, 0x56, 0xe1, 0x0c, 0xba, 0xba, 0x62, 0x06,
-	0x5d, 0x7a, 0xdf, 0xe2, 0x57, 0xcb, 0xa4, 0xe2, 0xf5, 0xdd, 0xd7, 0xde, 0x44, 0xb8, 0x41, 0xd6,
-	0x86, 0x2c, 0x3d, 0x89, 0xa6, 0xc8, 0x5c, 0x08, 0xf1, 0x37, 0x84, 0x9b, 0x5a, 0x38, 0xf5, 0x1a,
-	0x54, 0x82, 0x7c, 0x49, 0xe9, 0x27, 0xd2, 0x34, 0xc1, 0xd9, 0xa0, 0x61, 0x96, 0x8c, 0x99, 0xb5,
-	0x02, 0x63, 0x86, 0xf3, 0x0e, 0xc2, 0x5e, 0xea, 0xa7, 0x0b, 0x19, 0x03, 0x9a, 0xc3, 0xbe, 0xd2,
-	0x66, 0x82, 0x46, 0x3d, 0xb2, 0x92, 0x7a, 0x4d, 0x93, 0x7a, 0x7f, 0xab, 0x4a, 0xaa, 0xfd, 0x87,
-	0x43, 0xf7, 0x35, 0x82, 0x27, 0xdf, 0x25, 0x57, 0x86, 0xfe, 0x0b, 0xd9, 0x5e, 0x70, 0x03, 0x56,
-	0x04, 0x13, 0xe6, 0xd0, 0x86, 0x45, 0x5b, 0xcd, 0x79, 0x34, 0x1c, 0xd2, 0x7a, 0x18, 0x47, 0x8b,
-	0xb9, 0x74, 0xb0, 0x0a, 0xb9, 0x6f, 0xe0, 0xec, 0xaf, 0x92, 0x9b, 0xde, 0x02, 0x02, 0xce, 0x84,
-	0x1f, 0xd2, 0x8d, 0xa3, 0x09, 0x4b, 0x92, 0x20, 0x3c, 0x46, 0x83, 0x73, 0x55, 0x31, 0x6f, 0x23,
-	0x8d, 0x9e, 0x2e, 0x92, 0x34, 0x64, 0x49, 0x22, 0xe2, 0x40, 0xc4, 0x24, 0xcf, 0xa3, 0x79, 0x3b,
-	0x60, 0xdf, 0xf5, 0x99, 0x3f, 0x83, 0xae, 0xd4, 0xa1, 0x2b, 0x06, 0x8e, 0x7f, 0x4d, 0x9c, 0x5d,
-	0xc1, 0x86, 0xb1, 0x64, 0xb3, 0x01, 0xac, 0x91, 0x47, 0xdb, 0x5b, 0xe4, 0xba, 0xd8, 0xbc, 0xdd,
-	0x3f, 0x82, 0x9e, 0x08, 0x33, 0x28, 0xc1, 0x71, 0x29, 0x2c, 0x83, 0xf8, 0x2d, 0xc4, 0x8b, 0xcf,
-	0x25, 0x38, 0x58, 0x79, 0xb4, 0xfd, 0x4d, 0xa4, 0x99, 0xfc, 0x6a, 0xcb, 0x30, 0x00, 0xf9, 0x70,
-	0x3e, 0x7b, 0xa0, 0x55, 0xa0, 0x46, 0x6d, 0x7d, 0x2a, 0xb4, 0xcd, 0xa9, 0xa0, 0x98, 0x6d, 0xa3,
-	0x90, 0xd9, 0xae, 0xe8, 0xde, 0x85, 0x5f, 0x2b, 0x91, 0xab, 0x4b, 0x7f, 0x2a, 0x54, 0x3e, 0xee,
-	0x10, 0xd2, 0x59, 0xbc, 0x40, 0xe3, 0x4c, 0xee, 0x02, 0x65, 0x98, 0xa2, 0x7e, 0x57, 0x8a, 0xfb,
-	0x7d, 0x8f, 0x58, 0xc3, 0xc5, 0x2c, 0x0d, 0x26, 0x7e, 0xa2, 0x1c, 0xf2, 0x42, 0x87, 0x58, 0xc2,
-	0x17, 0x8d, 0x55, 0xad, 0x70, 0xac, 0x9c, 0x9f, 0x2e, 0x89, 0x4d, 0x2d, 0xb5, 0x33, 0xf6, 0xf2,
-	0xa9, 0xf0, 0x20, 0x53, 0x31, 0xca, 0x46, 0x04, 0x89, 0xfe, 0x8d, 0x95, 0x7e, 0xeb, 0x4a, 0x21,
-	0x65, 0xab, 0x3a, 0x65, 0xff, 0x7d, 0x89, 0xd8, 0xcb, 0xdf, 0xfa, 0xbe, 0xf8, 0xbf, 0x1c, 0xd2,
-	0xea, 0x4c, 0xd2, 0x85, 0x3f, 0xc3, 0x3a, 0x68, 0x5e, 0xe8, 0xb8, 0x9c, 0x8f, 0xac, 0x9a, 0xf7,
-	0x91, 0xd9, 0x03, 0x72, 0x45, 0x40, 0x9d, 0x59, 0x70, 0x1c, 0xaa, 0x30, 0xc3, 0xe6, 0x96, 0xb3,
-	0x92, 0x0e, 0xaa, 0x26, 0xcd, 0xbf, 0xea, 0x74, 0xc8, 0x5b, 0x2f, 0xa9, 0x0f, 0x21, 0x0d, 0xa1,
-	0xec, 0x2d, 0x7f, 0x04, 0x5f, 0xc0, 0xf3, 0x08, 0x7b, 0xc7, 0x1f, 0x9d, 0x13, 0x52, 0xf5, 0x46,
-	0x9d, 0x8b, 0x24, 0xd8, 0x7d, 0x62, 0xef, 0xc7, 0xc7, 0x7e, 0x18, 0xfc, 0xa4, 0x2f, 0x5c, 0x21,
-	0x6a, 0x2f, 0xaa, 0x45, 0x0b, 0x4a, 0x14, 0x27, 0x57, 0xb4, 0x50, 0xf3, 0x9f, 0x2b, 0x11, 0x22,
-	0xb6, 0x14, 0x76, 0x26, 0x27, 0xd1, 0xc5, 0x9b, 0x9f, 0x5a, 0x3c, 0x3b, 0xb2, 0xbd, 0x16, 0xcb,
-	0x7e, 0x9b, 0x34, 0x84, 0x83, 0x3b, 0x0b, 0xf2, 0xca, 0x10, 0xaf, 0xb4, 0xf1, 0xf5, 0xab, 0x25,
-	0x72, 0xcb, 0xdc, 0xf8, 0xf2, 0x44, 0x08, 0xb0, 0xb0, 0x29, 0x2f, 0x54, 0xc1, 0xcc, 0x1d, 0xae,
-	0xf2, 0x05, 0x3b, 0x5c, 0x95, 0x57, 0xd9, 0xa6, 0xb9, 0x44, 0xeb, 0x7f, 0xb6, 0x44, 0x36, 0xf5,
-	0x1d, 0xae, 0x57, 0x68, 0xfb, 0x97, 0xf3, 0x53, 0xf1, 0x92, 0xad, 0xba, 0xc4, 0x24, 0xfc, 0x4d,
-	0x42, 0xaa, 0x7b, 0xe3, 0x0b, 0x15, 0x58, 0x75, 0x80, 0x00, 0x8f, 0xe0, 0xa9, 0x13, 0x68, 0x9a,
-	0x4a, 0xd1, 0x50, 0x2a, 0x85, 0x4d, 0xaa, 0x7b, 0x51, 0x92, 0xe2, 0x9f, 0xe0, 0x99, 0x7f, 0xff,
-	0x20, 0x61, 0x31, 0x98, 0xb4, 0x48, 0x98, 0x0c, 0x81, 0x8e, 0x1a, 0x16, 0xe3, 0xee, 0x59, 0x83,
-	0x4a, 0xd0, 0x7e, 0x9f, 0x10, 0xca, 0x3e, 0xe9, 0x46, 0xd1, 0x69, 0xc0, 0xa4, 0xb1, 0x23, 0xcd,
-	0x54, 0xde, 0x70, 0x51, 0x42, 0xb5, 0x4a, 0x42, 0x17, 0xfc, 0x04, 0xce, 0x14, 0x86, 0x29, 0x4a,
-	0x00, 0x61, 0xd7, 0x2f, 0xe1, 0xc5, 0x16, 0xc7, 0x00, 0xf5, 0x0b, 0xfe, 0x28, 0xde, 0x4e, 0xcc,
-	0xb7, 0x89, 0x7c, 0xdb, 0xc4, 0x43, 0xb0, 0xb2, 0x40, 0xc0, 0x1c, 0x12, 0xf6, 0xbd, 0x8e, 0x02,
-	0xb3, 0x1c, 0x34, 0x1c, 0x98, 0x86, 0xc2, 0x28, 0xd2, 0x30, 0xd9, 0x58, 0xb5, 0x0b, 0xc7, 0x6a,
-	0x43, 0xd7, 0x7b, 0x40, 0x7b, 0x96, 0xed, 0xdf, 0x09, 0x27, 0x10, 0x2b, 0x8e, 0xab, 0x55, 0x41,
-	0x89, 0xa8, 0x9f, 0xe4, 0xeb, 0x5b, 0xb2, 0x7e, 0xbe, 0x24, 0xe7, 0x42, 0x10, 0x0a, 0xab, 0xee,
-	0x42, 0x80, 0xa1, 0x48, 0xe4, 0x50, 0xd8, 0x2f, 0x19, 0x0a, 0x59, 0x09, 0xd5, 0x3f, 0x9d, 0x46,
-	0xd7, 0x94, 0xfa, 0xa7, 0x93, 0xe9, 0x36, 0x69, 0xf4, 0xa2, 0x90, 0x75, 0x8e, 0x52, 0x16, 0x83,
-	0x43, 0xa0, 0x42, 0x33, 0x04, 0x1c, 0xad, 0x19, 0x79, 0x59, 0x85, 0x37, 0xa0, 0x82, 0x81, 0x83,
-	0x28, 0x8a, 0x20, 0x4e, 0x52, 0xae, 0x8c, 0x8b, 0x5a, 0x37, 0xc4, 0x59, 0x4e, 0x13, 0x0b, 0xb1,
-	0x34, 0x03, 0xed, 0x5b, 0x37, 0xc5, 0xb7, 0x74, 0x1c, 0x44, 0xad, 0x67, 0x8d, 0xeb, 0xb1, 0x94,
-	0x4d, 0x52, 0x36, 0xc5, 0x9d, 0x9c, 0xa2, 0x22, 0xfb, 0x43, 0x72, 0xc3, 0xec, 0x91, 0x7a, 0x49,
-	0x6c, 0xf4, 0xac, 0x28, 0xb5, 0x7b, 0xa4, 0x4d, 0xd9, 0x27, 0x0b, 0x96, 0xc8, 0xe0, 0x91, 0x5b,
-	0x46, 0xdc, 0x25, 0xa7, 0xea, 0x7d, 0xa3, 0xc2, 0x4e, 0x98, 0xc6, 0xe7, 0xd4, 0x7c, 0xc9, 0x7e,
-	0x98, 0x29, 0xd9, 0xf8, 0x99, 0xb7, 0xe0, 0x33, 0x9f, 0x33, 0x3f, 0xa3, 0xd7, 0x10, 0xdf, 0xc9,
-	0xbd, 0x66, 0x7f, 0x83, 0x10, 0xd7, 0x8f, 0xfd, 0x33, 0x96, 0x72, 0x73, 0xe0, 0x36, 0x7c, 0xe4,
-	0x2d, 0xfd, 0x23, 0x59, 0xa9, 0xf8, 0x80, 0x56, 0x5d, 0x98, 0x7f, 0xd0, 0xac, 0xed, 0x68, 0x7a,
-	0x0e, 0xc7, 0xf5, 0x5a, 0x54, 0x47, 0xe9, 0x06, 0x03, 0x54, 0xb9, 0x03, 0x55, 0x0c, 0xdc, 0xad,
-	0x1f, 0x03, 0x26, 0xcf, 0x75, 0x98, 0x4f, 0xd3, 0x53, 0x76, 0x8e, 0x3e, 0x4b, 0xfe, 0xc8, 0xa7,
-	0xc8, 0x33, 0xd0, 0x73, 0x51, 0x22, 0x01, 0xf0, 0xf5, 0xf2, 0x57, 0x4b, 0xb7, 0x3a, 0xe4, 0x5a,
-	0x41, 0x5f, 0x5f, 0xe9, 0x13, 0xdf, 0x22, 0x57, 0x72, 0x3d, 0x7d, 0x95, 0xd7, 0x9d, 0x7f, 0x53,
-	0x22, 0x24, 0x9b, 0x10, 0x85, 0x1e, 0x57, 0x15, 0xae, 0x8d, 0x2f, 0xab, 0x80, 0x6f, 0xd7, 0x47,
-	0x7d, 0xa5, 0x41, 0xe1, 0x59, 0x44, 0x8b, 0x9e, 0xf9, 0x81, 0x8c, 0x34, 0x46, 0x88, 0x8b, 0x4c,
-	0xe1, 0x9d, 0x16, 0xb6, 0x44, 0x95, 0x4a, 0x10, 0xc4, 0xb2, 0xff, 0xa2, 0x73, 0x2c, 0x2d, 0x32,
-	0x84, 0x84, 0x97, 0x7c, 0xb2, 0x88, 0x99, 0x8c, 0x3b, 0x15, 0x10, 0xb8, 0xb1, 0xd2, 0x74, 0xae,
-	0x05, 0x9d, 0x2a, 0x98, 0x97, 0x79, 0xfe, 0x19, 0xf3, 0x82, 0x54, 0x9e, 0x51, 0x51, 0xb0, 0xf3,
-	0xdb, 0x6b, 0x64, 0x63, 0x3c, 0xf0, 0xd0, 0x0d, 0xc9, 0x66, 0xb3, 0xe8, 0x35, 0xac, 0xab, 0xd5,
-	0x4e, 0x8f, 0x3b, 0x84, 0xe0, 0x51, 0xf4, 0xcc, 0xfd, 0xab, 0x61, 0xe0, 0x48, 0xa3, 0x1f, 0x4e,
-	0x93, 0x13, 0xff, 0x94, 0x69, 0xa7, 0xe5, 0x4c, 0xa4, 0xf0, 0x11, 0x23, 0x82, 0x7f, 0x07, 0x83,
-	0x33, 0x74, 0x1c, 0x17, 0xf9, 0x0a, 0x96, 0x8d, 0x11, 0xe6, 0xd3, 0x12, 0x1e, 0x42, 0x7d, 0xfd,
-	0x70, 0x1a, 0x9d, 0xe1, 0x8e, 0x0a, 0x42, 0x70, 0xd4, 0x91, 0x1b, 0x63, 0x51, 0xd8, 0xef, 0xf1,
-	0xff, 0x08, 0x17, 0x89, 0x81, 0x13, 0xaa, 0x10, 0xc2, 0xb8, 0xd3, 0x92, 0x21, 0xb8, 0x04, 0xeb,
-	0x06, 0xf3, 0x13, 0x16, 0x7b, 0x8b, 0x20, 0x85, 0xb6, 0xe2, 0x01, 0x36, 0x13, 0x0b, 0xc7, 0x52,
-	0xa5, 0xeb, 0x81, 0xd7, 0x6a, 0xe1, 0xb1, 0x54, 0x0d, 0x27, 0x8e, 0xa4, 0xf4, 0x71, 0x51, 0xe1,
-	0x8f, 0x9c, 0xf6, 0xfb, 0x5e, 0xd7, 0xc5, 0x8d, 0x7a, 0x78, 0x06, 0xbf, 0x72, 0xf6, 0x6d, 0xb1,
-	0x09, 0x58, 0xa3, 0x06, 0x8e, 0xdb, 0x17, 0xf2, 0x14, 0x94, 0x58, 0xdd, 0x85, 0xaf, 0xb8, 0x46,
-	0xf3, 0x68, 0x3e, 0x1e, 0x5e, 0x70, 0x1c, 0xfa, 0xe9, 0x22, 0x66, 0x9d, 0xd9, 0xb1, 0xd8, 0xeb,
-	0xab, 0x51, 0x13, 0x09, 0xf6, 0xca, 0x62, 0x3e, 0x8f, 0xe2, 0x94, 0x4d, 0xc1, 0xa2, 0x12, 0x2b,
-	0x49, 0x8d, 0xe6, 0xd1, 0x46, 0x4d, 0x37, 0x0a, 0xc2, 0x34, 0xd9, 0xbc, 0x96, 0xab, 0x29, 0xd0,
-	0x7c, 0x32, 0x75, 0x06, 0xee, 0x48, 0xec, 0xfc, 0x37, 0xa8, 0x00, 0x38, 0x0d, 0xbe, 0xed, 0x3f,
-	0x80, 0xc5, 0xa2, 0x41, 0xf9, 0x63, 0xb6, 0xd8, 0xde, 0x28, 0x5c, 0x6c, 0x6f, 0xea, 0x8b, 0x6d,
-	0x76, 0x58, 0x78, 0x73, 0xc5, 0x61, 0xe1, 0x37, 0x8d, 0xc3, 0xc2, 0x9a, 0x53, 0xe2, 0xd6, 0x4a,
-	0xa7, 0xc4, 0x5b, 0xe6, 0x5e, 0xf9, 0x1d, 0x42, 0xd4, 0xa8, 0x09, 0x71, 0x5b, 0xa3, 0x1a, 0xc6,
-	0xf9, 0x95, 0x75, 0x98, 0x60, 0x62, 0x09, 0xbe, 0xcc, 0x04, 0x7b, 0xa9, 0xf7, 0x07, 0xd9, 0xb6,
-	0x62, 0xb0, 0xad, 0xc1, 0x92, 0xd5, 0x3c, 0x4b, 0x72, 0xfd, 0x26, 0x63, 0x06, 0x9c, 0x60, 0x3a,
-	0xca, 0x7e, 0x8f, 0x5c, 0x95, 0x7c, 0x10, 0x44, 0x21, 0x6a, 0x83, 0x42, 0xec, 0x2c, 0x17, 0xc8,
-	0x0d, 0x11, 0xd0, 0x1e, 0x47, 0xec, 0x18, 0xe5, 0x90, 0x81, 0x93, 0xc1, 0x94, 0x00, 0x27, 0x70,
-	0x0e, 0xa1, 0x41, 0x35, 0x0c, 0xd8, 0x7f, 0x5d, 0xcf, 0xf5, 0x52, 0x7f, 0x3e, 0xe3, 0xfa, 0x8c,
-	0x88, 0x69, 0x31, 0x70, 0x9c, 0x75, 0xc6, 0xc1, 0xe4, 0x94, 0xa5, 0x8a, 0x53, 0x30, 0xd0, 0x25,
-	0x8f, 0xb6, 0xb7, 0xc9, 0x6d, 0x21, 0x05, 0x29, 0x0b, 0xd9, 0x71, 0x94, 0x06, 0xe2, 0x34, 0x9a,
-	0x7a, 0x4d, 0x44, 0xc3, 0xbc, 0xb4, 0x0e, 0x57, 0x17, 0x0a, 0xca, 0x61, 0x5e, 0xb6, 0x68, 0x51,
-	0x11, 0xd8, 0xa7, 0xb3, 0x79, 0xa8, 0x02, 0xb6, 0x71, 0x43, 0x47, 0xc7, 0x41, 0xa8, 0xcd, 0x59,
-	0x22, 0x03, 0x6b, 0x76, 0xce, 0x12, 0xf0, 0x54, 0x4f, 0x52, 0x31, 0x4d, 0x5b, 0x14, 0x9e, 0xb9,
-	0xe8, 0x52, 0x0d, 0x91, 0x43, 0x2f, 0xc2, 0x6c, 0x96, 0xf0, 0xe0, 0x5e, 0x62, 0x33, 0x50, 0x3c,
-	0x84, 0x7d, 0x96, 0x9e, 0xbb, 0x31, 0x4b, 0x64, 0x94, 0x4d, 0x9d, 0xae, 0x2a, 0x86, 0xbf, 0xe4,
-	0x8a, 0xd0, 0x3d, 0xb9, 0x84, 0xe7, 0x9c, 0x26, 0xd6, 0x3d, 0xd0, 0xe3, 0x5a, 0x14, 0x21, 0x10,
-	0x0f, 0x58, 0x17, 0x26, 0x38, 0xee, 0xee, 0x98, 0xc8, 0xdc, 0x94, 0xb8, 0x91, 0x9f, 0x12, 0xd9,
-	0x14, 0xbe, 0x59, 0x38, 0x85, 0x37, 0x8b, 0xa7, 0xf0, 0x9b, 0x2b, 0xa6, 0xf0, 0xad, 0x55, 0x53,
-	0xf8, 0xad, 0x95, 0x53, 0xf8, 0xb6, 0x39, 0x85, 0x6d, 0x52, 0xfd, 0xb6, 0xff, 0x20, 0x01, 0x6d,
-	0xa7, 0x41, 0xe1, 0xd9, 0xf9, 0x07, 0x25, 0xb2, 0xde, 0x77, 0x3d, 0x36, 0xe9, 0xec, 0x5d, 0x1c,
-	0xb9, 0x28, 0x23, 0x78, 0x65, 0xe4, 0xa2, 0x84, 0x41, 0x84, 0xbb, 0xea, 0x04, 0xa0, 0xe7, 0xf6,
-	0x65, 0x0c, 0x6b, 0x35, 0x8b, 0x61, 0xbd, 0x4f, 0xec, 0xce, 0x22, 0x3d, 0xe1, 0x94, 0x17, 0x71,
-	0x35, 0xe0, 0xcb, 0xa8, 0x09, 0x63, 0x7f, 0xb9, 0xe4, 0x95, 0xc2, 0x6a, 0x7e, 0xbe, 0x44, 0xea,
-	0xd0, 0x8b, 0x1d, 0xef, 0x22, 0xeb, 0x10, 0x9b, 0x5a, 0x5e, 0x6a, 0x6a, 0x25, 0x6b, 0xaa, 0x43,
-	0x5a, 0x03, 0x16, 0xee, 0x84, 0x93, 0xf8, 0x7c, 0xce, 0x27, 0x16, 0x26, 0x53, 0xd0, 0x71, 0xaf,
-	0x14, 0x30, 0xfa, 0xa7, 0xca, 0x64, 0xed, 0x21, 0x0b, 0xd9, 0x33, 0xf6, 0xda, 0x32, 0xf1, 0x6d,
-	0xd2, 0x46, 0x93, 0xd9, 0x70, 0x13, 0x99, 0x48, 0xd8, 0xc8, 0xee, 0x0c, 0x45, 0xfa, 0x11, 0x3c,
-	0xf6, 0x93, 0x21, 0x60, 0xd1, 0x8e, 0x03, 0x4e, 0xe4, 0x99, 0x78, 0x0d, 0xfd, 0xe4, 0x39, 0xac,
-	0x71, 0x3c, 0x63, 0x2d, 0x77, 0x3c, 0xc3, 0x22, 0x95, 0xc3, 0x51, 0x1f, 0x23, 0x0b, 0xf8, 0xa3,
-	0x6e, 0xf0, 0xd7, 0x0d, 0x83, 0x5f, 0xf4, 0x38, 0x67, 0xf0, 0x3b, 0x3f, 0x49, 0x5a, 0x7a, 0x41,
-	0xb6, 0x75, 0x5f, 0xd2, 0xa3, 0x4b, 0x56, 0x6c, 0xf2, 0x17, 0x84, 0xc7, 0xae, 0x8a, 0xdf, 0x94,
-	0x1b, 0x71, 0x35, 0x2d, 0x8a, 0xf4, 0x3f, 0x95, 0x48, 0xed, 0xf0, 0xa3, 0x41, 0x67, 0x74, 0xc1,
-	0x30, 0xdc, 0x25, 0xcd, 0x43, 0x7f, 0x16, 0x4c, 0xfb, 0x3d, 0xfe, 0x0f, 0x79, 0xce, 0x5c, 0x43,
-	0x49, 0x32, 0x54, 0x32, 0x32, 0x38, 0xa4, 0xf5, 0x70, 0xdb, 0x55, 0xb3, 0x1f, 0xa9, 0x6f, 0xe0,
-	0xb0, 0x4e, 0x2f, 0xe2, 0x36, 0xb9, 0x1f, 0x4b, 0xf2, 0x1b, 0x38, 0x2e, 0x54, 0x1e, 0x6e, 0xbb,
-	0x90, 0x40, 0x87, 0x4d, 0xd1, 0x95, 0xae, 0x61, 0xb8, 0x78, 0x7b, 0xb8, 0xed, 0x82, 0x00, 0x12,
-	0x07, 0xec, 0xfb, 0x3d, 0xa9, 0xff, 0xe5, 0xf1, 0xce, 0x9f, 0xa8, 0x91, 0xca, 0x81, 0xb7, 0x7d,
-	0xe9, 0x68, 0xb3, 0x2a, 0x44, 0x9b, 0xdd, 0x26, 0x8d, 0x9d, 0x67, 0xd2, 0x04, 0x46, 0x27, 0x98,
-	0x42, 0xe0, 0xf9, 0x8e, 0x30, 0x39, 0x62, 0xb1, 0x9e, 0x68, 0x44, 0xc7, 0x81, 0x85, 0x1c, 0xc4,
-	0x22, 0x71, 0x91, 0x8c, 0xfe, 0x57, 0x08, 0xd8, 0xa4, 0x0a, 0xa7, 0x73, 0xae, 0x0e, 0xa1, 0xa7,
-	0x4d, 0x30, 0x59, 0x0e, 0xcb, 0x59, 0xbe, 0xc7, 0x9e, 0x05, 0xca, 0x2d, 0x8c, 0xdd, 0x34, 0x91,
-	0x9c, 0x2b, 0xb6, 0x17, 0x89, 0x3a, 0xae, 0x2e, 0x00, 0x68, 0xa5, 0xec, 0xa0, 0xc7, 0x26, 0xb0,
-	0xf0, 0x72, 0xcb, 0x59, 0xc3, 0x19, 0xb9, 0x78, 0x0e, 0x12, 0x36, 0x41, 0xcf, 0x89, 0x89, 0x84,
-	0x79, 0xce, 0xd2, 0xc5, 0x1c, 0x57, 0x57, 0x01, 0x28, 0xee, 0x12, 0xe1, 0xa6, 0x22, 0x96, 0x89,
-	0x8b, 0x70, 0xb1, 0x6d, 0x24, 0x5c, 0xf8, 0x08, 0x81, 0x37, 0x29, 0x7e, 0x8a, 0x4c, 0xba, 0x21,
-	0x36, 0x2c, 0x15, 0x82, 0xb7, 0xe2, 0x20, 0x7e, 0xaa, 0x05, 0x4e, 0x5d, 0x11, 0x61, 0xdb, 0x06,
-	0x92, 0x73, 0xe4, 0x41, 0xfc, 0x54, 0x6e, 0x7c, 0xc0, 0xaa, 0xd9, 0xa6, 0x3a, 0x0a, 0xbf, 0xe3,
-	0xa5, 0x7e, 0x9c, 0xee, 0xc6, 0xd2, 0x27, 0x22, 0xbe, 0x93, 0x21, 0xb9, 0xed, 0x7f, 0x10, 0x3f,
-	0xed, 0x46, 0xf3, 0xf3, 0xfd, 0x23, 0x39, 0x64, 0x62, 0x52, 0xd9, 0x50, 0x7d, 0x45, 0xa9, 0xd8,
-	0x5e, 0x8b, 0x46, 0x8b, 0xb3, 0x1e, 0x4b, 0x26, 0xb0, 0x9c, 0xb6, 0xa9, 0x86, 0xd1, 0x63, 0x4b,
-	0xaf, 0x1b, 0xb1, 0xa5, 0xce, 0xaf, 0x94, 0xc8, 0xf5, 0x03, 0x6f, 0x5b, 0x9a, 0xd6, 0xb3, 0x68,
-	0x72, 0x2a, 0x48, 0x78, 0xe1, 0x14, 0xc4, 0x57, 0x34, 0x39, 0xa0, 0xa3, 0x84, 0x1b, 0x0e, 0x40,
-	0x69, 0x8c, 0x21, 0x98, 0xd9, 0xab, 0x98, 0x2b, 0x44, 0xd8, 0xab, 0xd7, 0x49, 0xad, 0x1f, 0x4e,
-	0xd9, 0x0b, 0x64, 0x48, 0x01, 0x68, 0xe2, 0x63, 0x4d, 0x17, 0x1f, 0xce, 0x2f, 0x54, 0x48, 0x65,
-	0xd0, 0x1d, 0x5e, 0xec, 0x6a, 0x1c, 0xfa, 0xc7, 0xc1, 0x44, 0x1e, 0x50, 0x00, 0xa0, 0x20, 0x0b,
-	0x48, 0xa5, 0x30, 0x0b, 0x48, 0x2e, 0x64, 0xb7, 0xba, 0x1c, 0xb2, 0xbb, 0x7c, 0xdc, 0xa6, 0x56,
-	0x78, 0xdc, 0x66, 0x39, 0x9f, 0xc8, 0x5a, 0x61, 0x3e, 0x91, 0x77, 0xc8, 0x06, 0x64, 0xb9, 0xca,
-	0x4e, 0xde, 0x88, 0x39, 0x95, 0xc3, 0x82, 0x2e, 0x7d, 0xe2, 0x87, 0x21, 0x9b, 0x81, 0x33, 0x00,
-	0x63, 0x30, 0x34, 0x94, 0x3c, 0xf4, 0xc7, 0xab, 0xb3, 0x29, 0xea, 0xb5, 0x1a, 0xe6, 0x55, 0x0e,
-	0xd8, 0xe8, 0xba, 0x4c, 0x6b, 0xa5, 0x2e, 0xd3, 0x36, 0xf7, 0x48, 0xff, 0x5c, 0x89, 0x54, 0x87,
-	0xee, 0xc0, 0xbb, 0x78, 0x80, 0xc4, 0x29, 0x33, 0x1c, 0x20, 0x71, 0xc2, 0xec, 0x32, 0x67, 0xd4,
-	0xc4, 0x01, 0xd7, 0xc9, 0xe9, 0x76, 0x94, 0xa6, 0xd1, 0x19, 0x8a, 0x73, 0x1d, 0x25, 0x23, 0x20,
-	0x6b, 0xea, 0x5c, 0xa3, 0xf3, 0x5b, 0x65, 0xb2, 0x36, 0x8c, 0xa6, 0x4f, 0xc5, 0xa4, 0xbf, 0xc0,
-	0xc1, 0x6f, 0x04, 0xce, 0x60, 0x8c, 0x85, 0x19, 0x38, 0x03, 0x01, 0x74, 0x62, 0xdd, 0xc5, 0xcc,
-	0x02, 0x10, 0x40, 0x27, 0x31, 0x2b, 0x97, 0xbe, 0x1b, 0x64, 0xed, 0x20, 0x0c, 0x52, 0x95, 0x11,
-	0x07, 0x21, 0x7d, 0x92, 0xae, 0x99, 0x01, 0xe0, 0x5c, 0xe4, 0xbf, 0x98, 0xb0, 0xb9, 0x3a, 0x65,
-	0x55, 0xa7, 0x19, 0x82, 0x93, 0x4b, 0x1e, 0x85, 0x07, 0xcf, 0xb0, 0x90, 0xb4, 0x06, 0xee, 0x53,
-	0x8f, 0xc9, 0xf9, 0x6f, 0x15, 0xb2, 0xb6, 0xef, 0xb9, 0xbb, 0xcf, 0xb6, 0x5e, 0x5b, 0x85, 0x2a,
-	0xd8, 0x3d, 0xe2, 0x5d, 0x13, 0xca, 0x91, 0x41, 0x48, 0x03, 0x07, 0x8a, 0x2f, 0xec, 0x82, 0x20,
-	0x41, 0xdb, 0x54, 0xc1, 0x70, 0x0e, 0x22, 0x66, 0x3e, 0x86, 0x3e, 0xb5, 0x29, 0x42, 0xc6, 0xee,
-	0xfa, 0xfa, 0xf2, 0x79, 0x81, 0xce, 0x02, 0x5a, 0x22, 0x08, 0x89, 0x10, 0x64, 0x9d, 0x33, 0xd4,
-	0x60, 0x5c, 0xb5, 0x72, 0x58, 0xfb, 0x6d, 0x52, 0x1d, 0x78, 0x9d, 0x64, 0x93, 0x18, 0x47, 0x07,
-	0x06, 0x5e, 0xe7, 0x04, 0x3c, 0x88, 0x14, 0x4a, 0xed, 0xcf, 0x93, 0xca, 0xc0, 0x3b, 0xc0, 0x88,
-	0xd8, 0x2b, 0xaa, 0xd2, 0xc1, 0x7c, 0xea, 0xa7, 0x8c, 0xf2, 0x32, 0xfb, 0x0e, 0xaf, 0x42, 0x71,
-	0xa7, 0xba, 0xa5, 0xaa, 0x50, 0xf6, 0x09, 0x2f, 0xa7, 0xf6, 0xbb, 0x64, 0xad, 0xf7, 0x14, 0x04,
-	0x7e, 0xdb, 0xcc, 0xd0, 0x01, 0x48, 0xf7, 0xf4, 0x98, 0x62, 0xb9, 0xfd, 0x25, 0xb2, 0x0e, 0x26,
-	0xff, 0xe1, 0x16, 0xa6, 0x19, 0x52, 0xae, 0x76, 0x8e, 0x75, 0x4f, 0x8f, 0x0f, 0xb7, 0xa8, 0xac,
-	0x91, 0xb1, 0xca, 0x95, 0x42, 0x56, 0xb1, 0x74, 0xcd, 0xf9, 0xd7, 0xcb, 0xa4, 0x2e, 0xbf, 0x21,
-	0xd2, 0x57, 0xe2, 0x31, 0x6c, 0xcc, 0x4a, 0xd4, 0xa6, 0x3a, 0x0a, 0x56, 0x8d, 0x34, 0xce, 0xa5,
-	0xbd, 0xd2, 0x51, 0x9c, 0x3d, 0xb2, 0x4d, 0x33, 0x88, 0x8e, 0x95, 0x3b, 0x51, 0x6f, 0x93, 0x36,
-	0xfc, 0x49, 0x2d, 0xb2, 0x32, 0xeb, 0x98, 0x8e, 0x84, 0x7d, 0x0a, 0x18, 0xfc, 0x1e, 0xf3, 0xa7,
-	0xaa, 0xaa, 0x60, 0x8b, 0x82, 0x12, 0xc8, 0xee, 0xc5, 0x12, 0xf0, 0x2a, 0xb1, 0xa9, 0x62, 0x23,
-	0xc1, 0x2c, 0x05, 0x25, 0xf6, 0xd7, 0xc9, 0xe6, 0xb6, 0x3f, 0x39, 0x5d, 0xcc, 0x0b, 0xde, 0x12,
-	0x4a, 0xf7, 0xca, 0x72, 0xe1, 0x8d, 0x10, 0x9b, 0x8d, 0xa0, 0x0f, 0x55, 0xf8, 0x22, 0x9d, 0x61,
-	0x9c, 0xff, 0x5c, 0x26, 0x24, 0x1b, 0x90, 0xff, 0x47, 0xce, 0x3f, 0x1c, 0x39, 0x21, 0x6f, 0xa0,
-	0xc8, 0x9b, 0x39, 0xf4, 0x93, 0x53, 0x74, 0xa2, 0xea, 0x28, 0xe7, 0x97, 0x4b, 0xa4, 0xa1, 0x26,
-	0x8b, 0x4e, 0xab, 0x92, 0x49, 0x2b, 0x19, 0xe7, 0xc2, 0xc9, 0x3e, 0x1c, 0x1f, 0xc8, 0x30, 0x01,
-	0x1d, 0xb7, 0xc2, 0xfa, 0xb9, 0x4b, 0x9a, 0xbd, 0x5e, 0xb6, 0x65, 0x2d, 0x02, 0xc7, 0x75, 0x94,
-	0x7d, 0x8f, 0xac, 0x0f, 0xbc, 0x4e, 0x10, 0x1e, 0x45, 0x98, 0x5e, 0x66, 0x59, 0x60, 0xc8, 0x0a,
-	0xce, 0xbf, 0x95, 0x42, 0xf6, 0xc1, 0xff, 0xf5, 0x42, 0xf6, 0x16, 0xa9, 0xf7, 0xc3, 0x24, 0xf5,
-	0xc3, 0x89, 0x14, 0xb3, 0x0a, 0x36, 0x3c, 0x19, 0x8d, 0x9c, 0x27, 0xe3, 0x0b, 0xa4, 0x06, 0x1c,
-	0x0a, 0x2b, 0x56, 0x26, 0x38, 0xe5, 0xb4, 0xa1, 0xa2, 0x54, 0x13, 0x8d, 0xcd, 0x0b, 0x44, 0xe3,
-	0x45, 0x42, 0x16, 0xe5, 0x74, 0xfb, 0x25, 0x72, 0x5a, 0x0a, 0xfc, 0x8d, 0x97, 0x0a, 0xfc, 0x57,
-	0x11, 0xab, 0xff, 0xa5, 0x44, 0x1a, 0xea, 0x7d, 0x50, 0x92, 0xbc, 0xce, 0xb1, 0x0c, 0xe9, 0x10,
-	0x00, 0x68, 0x17, 0x9e, 0xa6, 0x7c, 0x23, 0xc4, 0x59, 0x6e, 0x10, 0x84, 0xa7, 0xdc, 0xb8, 0x61,
-	0xa8, 0x96, 0xb4, 0xa9, 0x8e, 0x82, 0x7c, 0x70, 0xd3, 0x67, 0x98, 0x64, 0x04, 0x8f, 0xf7, 0x2b,
-	0x04, 0xbc, 0xef, 0x65, 0x2c, 0x5b, 0xc3, 0xf7, 0x33, 0x14, 0x9f, 0x78, 0x03, 0x4f, 0x8d, 0x2c,
-	0x1e, 0x22, 0xcc, 0x30, 0x9a, 0xde, 0xb3, 0x6e, 0xe8, 0x3d, 0xb7, 0x79, 0xa7, 0x32, 0x5f, 0x04,
-	0x98, 0x9d, 0x0a, 0xe1, 0xfc, 0x62, 0x95, 0x53, 0xba, 0xc3, 0x87, 0x0e, 0x37, 0x1e, 0x4b, 0xc6,
-	0xd0, 0x65, 0xf4, 0x94, 0x89, 0x94, 0xef, 0x91, 0x35, 0x3a, 0xf0, 0x3a, 0x87, 0x5b, 0x98, 0xd5,
-	0x45, 0x9e, 0x38, 0xc2, 0x83, 0xb7, 0xbc, 0x84, 0x62, 0x0d, 0x7b, 0x8b, 0xd4, 0x3b, 0xde, 0x8e,
-	0xa8, 0x5d, 0x31, 0x52, 0xdf, 0x74, 0xbc, 0x9d, 0x17, 0x29, 0x8b, 0x43, 0x7f, 0x26, 0xde, 0x50,
-	0xf5, 0xf8, 0xb8, 0xf2, 0xb7, 0x31, 0xed, 0x9b, 0x95, 0xff, 0x3a, 0x85, 0x52, 0xfb, 0x0b, 0xa4,
-	0x3a, 0xe2, 0xb5, 0x6a, 0xc6, 0xc2, 0x8a, 0x62, 0x06, 0xaa, 0xf1, 0x62, 0xbb, 0x8b, 0xa9, 0x4b,
-	0x3a, 0x6e, 0xcc, 0x8e, 0x82, 0x17, 0xfc, 0x0d, 0x91, 0x82, 0x47, 0x85, 0x42, 0x41, 0x69, 0xcc,
-	0x7c, 0x55, 0x81, 0xe6, 0xdf, 0xb0, 0xbf, 0x41, 0x9a, 0xfd, 0x8e, 0x6a, 0x00, 0x90, 0xb7, 0xe0,
-	0x03, 0x59, 0x0b, 0xf5, 0xda, 0xf6, 0x7b, 0x64, 0x4d, 0x74, 0x0d, 0x68, 0x9f, 0x65, 0xcd, 0x32,
-	0x08, 0x40, 0xb1, 0x8e, 0xed, 0x90, 0xea, 0x80, 0xd7, 0x6d, 0x40, 0xdd, 0x0d, 0x3d, 0x79, 0x0f,
-	0xef, 0xd3, 0x20, 0xeb, 0x53, 0xec, 0x6b, 0x7d, 0x22, 0xf9, 0x26, 0xc5, 0xfe, 0x72, 0x9f, 0xf4,
-	0x37, 0xb2, 0x79, 0xd1, 0x2c, 0x9c, 0x17, 0x2d, 0x7d, 0x5e, 0x3c, 0xe6, 0x33, 0x81, 0xb2, 0x4f,
-	0x34, 0xe6, 0x2f, 0x19, 0xcc, 0x6f, 0xf3, 0xa9, 0x88, 0xfa, 0x7a, 0x9b, 0xc2, 0xb3, 0xc9, 0xee,
-	0x95, 0x1c, 0xbb, 0x3b, 0x7b, 0xa4, 0x2e, 0x67, 0x33, 0xaf, 0x39, 0x5a, 0x9c, 0xed, 0x1f, 0xc1,
-	0x6c, 0x16, 0x6b, 0x40, 0x86, 0xb0, 0xef, 0xe0, 0x34, 0x17, 0x61, 0x33, 0x24, 0x63, 0x4b, 0x31,
-	0xc1, 0x9d, 0xdf, 0x2c, 0x11, 0x7b, 0xb9, 0xc3, 0x7c, 0xa1, 0x85, 0x6f, 0x08, 0x0c, 0x93, 0x8e,
-	0x34, 0x13, 0x29, 0x12, 0x32, 0x1c, 0x19, 0x13, 0x3a, 0x43, 0x88, 0xd0, 0x87, 0xa3, 0xe5, 0x69,
-	0x9d, 0xc3, 0x8a, 0x4d, 0xf1, 0xa3, 0xfc, 0xe4, 0x36, 0x70, 0xf6, 0x7b, 0xa4, 0xae, 0x9a, 0xb2,
-	0xb4, 0xe2, 0x88, 0x12, 0xaa, 0x6a, 0x38, 0xff, 0xa4, 0x4c, 0xda, 0x06, 0x83, 0x64, 0x0b, 0x5d,
-	0x29, 0xe7, 0xe6, 0x1b, 0xb2, 0x34, 0x46, 0x53, 0xbb, 0x4d, 0x11, 0x82, 0xb5, 0x45, 0x90, 0xc2,
-	0x88, 0x9e, 0xd3, 0x71, 0x9c, 0x42, 0x02, 0xce, 0x12, 0x02, 0x00, 0x85, 0x0c, 0xa4, 0x49, 0xa1,
-	0x5a, 0x9e, 0x42, 0x6f, 0x93, 0x36, 0x7a, 0x9c, 0xc4, 0x5b, 0xf2, 0xa8, 0x83, 0x81, 0xb4, 0xdf,
-	0x23, 0x57, 0x77, 0xa3, 0xf8, 0xb9, 0x1f, 0x4f, 0x83, 0xf0, 0x58, 0x77, 0x5b, 0xb5, 0xe8, 0x72,
-	0x81, 0x7d, 0x8f, 0x58, 0xb2, 0xe3, 0x40, 0xbb, 0xb1, 0x7f, 0x8c, 0x01, 0xed, 0x4b, 0xf8, 0x82,
-	0x11, 0x6a, 0x14, 0x8d, 0x90, 0xf3, 0xf3, 0x82, 0x49, 0x72, 0x33, 0x5d, 0x23, 0x5f, 0xe9, 0xa5,
-	0xe4, 0x2b, 0x5f, 0x86, 0x7c, 0x95, 0x22, 0xf2, 0x2d, 0x11, 0xa8, 0x5a, 0x40, 0x20, 0xe7, 0x85,
-	0xd6, 0xba, 0x4c, 0x72, 0xac, 0xd6, 0x8c, 0x56, 0x0d, 0xfb, 0x57, 0xc8, 0xb5, 0x1e, 0x4b, 0xd2,
-	0x20, 0x04, 0x93, 0x48, 0x69, 0x0e, 0x82, 0x6b, 0x8b, 0x8a, 0x9c, 0x5f, 0x2b, 0x91, 0x2b, 0x39,
-	0x51, 0x9c, 0xd7, 0xe0, 0x4a, 0x4b, 0x1a, 0x1c, 0xaf, 0x21, 0x5f, 0xd9, 0x56, 0x19, 0x1b, 0x74,
-	0x94, 0xd6, 0xc2, 0x8a, 0xd1, 0xc2, 0x42, 0x56, 0x10, 0xf3, 0xe5, 0x92, 0xac, 0x50, 0x2b, 0x66,
-	0x05, 0x67, 0x4a, 0x1a, 0x19, 0xe9, 0x8a, 0x67, 0xcb, 0xa6, 0x1e, 0x84, 0x67, 0x10, 0xf4, 0x8b,
-	0x64, 0x5d, 0xbc, 0x2c, 0x83, 0x06, 0xdb, 0xc6, 0xb2, 0x43, 0x65, 0xa9, 0xf3, 0x2b, 0x25, 0x99,
-	0x27, 0x6c, 0xd5, 0xe9, 0x25, 0x6d, 0x60, 0x6a, 0xaa, 0xdb, 0x39, 0xa3, 0xa2, 0xb2, 0x6c, 0x54,
-	0x7c, 0x85, 0x5c, 0x53, 0x4a, 0xb4, 0x56, 0x53, 0x90, 0xa6, 0xa8, 0x88, 0x13, 0x47, 0xa2, 0x73,
-	0x3a, 0xe2, 0x12, 0xde, 0x99, 0x92, 0xa6, 0xb6, 0x3c, 0xaf, 0x20, 0x0f, 0x57, 0x78, 0x82, 0xf0,
-	0x54, 0xe5, 0x15, 0x01, 0xc0, 0xfe, 0xc1, 0x3c, 0x69, 0xae, 0x18, 0xa4, 0xe1, 0x26, 0xac, 0x24,
-	0xce, 0x4f, 0x48, 0x6d, 0xf5, 0x70, 0x6b, 0xe5, 0xd9, 0xae, 0x20, 0x3c, 0x55, 0x0b, 0x05, 0x42,
-	0xf2, 0xa0, 0x95, 0x3a, 0x21, 0xd4, 0xa6, 0x0a, 0xd6, 0x28, 0x5a, 0xd5, 0x19, 0xc9, 0x19, 0x71,
-	0x33, 0x44, 0x2e, 0xf6, 0x2f, 0x99, 0x2a, 0xef, 0x90, 0x8d, 0x4e, 0x9a, 0xfa, 0x93, 0x13, 0x69,
-	0xc2, 0xc0, 0x42, 0xd2, 0xa6, 0x39, 0xac, 0xf3, 0x0f, 0x4b, 0x64, 0x1d, 0x97, 0xd9, 0xbc, 0x81,
-	0x57, 0x7a, 0xa9, 0x81, 0x97, 0xe3, 0xa4, 0x7b, 0xc4, 0x82, 0xcf, 0x44, 0x13, 0x7f, 0xa6, 0x67,
-	0x62, 0x69, 0xd1, 0x25, 0xfc, 0xf2, 0x1a, 0x25, 0xba, 0x98, 0x5b, 0xa3, 0x5e, 0x6d, 0xe5, 0xf8,
-	0x59, 0xa1, 0xc3, 0xa2, 0xe4, 0xcd, 0x0b, 0xb2, 0xd2, 0x65, 0x04, 0x59, 0xb9, 0x48, 0x90, 0x99,
-	0x13, 0x3a, 0xe3, 0xec, 0xcb, 0x09, 0xb8, 0x9f, 0xad, 0x91, 0xca, 0xf6, 0x6e, 0xef, 0xb5, 0xed,
-	0xa7, 0x3b, 0x84, 0xf4, 0x02, 0xff, 0x38, 0x8c, 0x92, 0x54, 0xb5, 0x40, 0xc3, 0x80, 0x36, 0xc3,
-	0x45, 0xbd, 0xf4, 0x6d, 0x03, 0xa0, 0x4e, 0x51, 0x89, 0x0d, 0x25, 0x71, 0x8a, 0x8a, 0xb3, 0x7e,
-	0x10, 0xfa, 0x33, 0x99, 0xcf, 0x0f, 0x00, 0xfb, 0xab, 0xe4, 0x26, 0x1e, 0x07, 0x73, 0x67, 0x7e,
-	0xc8, 0xfa, 0xe1, 0x94, 0xcd, 0x59, 0x38, 0x65, 0x61, 0x8a, 0x7e, 0xbf, 0x55, 0xc5, 0x9c, 0x57,
-	0x3a, 0x8b, 0xf4, 0x44, 0xee, 0xc2, 0x63, 0xc6, 0x3f, 0x0d, 0x05, 0x7b, 0xd5, 0x0c, 0x72, 0xb3,
-	0x36, 0x30, 0x57, 0x20, 0x40, 0x10, 0x1c, 0xb5, 0x98, 0xa5, 0x01, 0x6c, 0xee, 0x60, 0x70, 0x83,
-	0x86, 0xe1, 0x9c, 0x24, 0x82, 0x0c, 0x05, 0x6e, 0x16, 0xa8, 0x7c, 0xd8, 0x4b, 0x78, 0x38, 0xe0,
-	0x72, 0xde, 0x0b, 0x92, 0x49, 0x1c, 0x9c, 0x71, 0x11, 0x1f, 0xc5, 0xe8, 0x29, 0xcc, 0xa3, 0xb9,
-	0x00, 0xfe, 0x4e, 0xb4, 0x88, 0xcd, 0xba, 0xc2, 0x8b, 0xbc, 0x5c, 0x60, 0x6f, 0x91, 0xeb, 0x3d,
-	0x96, 0x04, 0x31, 0x9b, 0x0e, 0x83, 0x70, 0xfc, 0x42, 0xb9, 0x22, 0x44, 0x1e, 0x82, 0xc2, 0x32,
-	0xfb, 0x03, 0xf2, 0x06, 0x65, 0x9f, 0x2c, 0xb0, 0x80, 0x66, 0x2f, 0x5d, 0x81, 0x97, 0x8a, 0x0b,
-	0xed, 0x6f, 0x92, 0x37, 0xb5, 0x82, 0x9d, 0xc9, 0x49, 0xa4, 0xbd, 0x29, 0xc2, 0x21, 0x56, 0x57,
-	0xb0, 0x3f, 0x20, 0x84, 0x93, 0x1c, 0x2d, 0x98, 0xab, 0x86, 0xa2, 0xbd, 0xbd, 0xdb, 0xcb, 0xca,
-	0xa8, 0x56, 0xcf, 0xf9, 0xe3, 0xa4, 0x6d, 0x14, 0x42, 0x12, 0xf3, 0x45, 0x7a, 0xa2, 0x09, 0x2e,
-	0x05, 0x73, 0xc6, 0x79, 0xc4, 0xce, 0x95, 0x53, 0x5a, 0x00, 0x97, 0xde, 0xd4, 0x28, 0xca, 0x82,
-	0xfa, 0x77, 0xab, 0xa4, 0xf2, 0x90, 0xee, 0x5c, 0x9c, 0xf2, 0x54, 0x9a, 0x78, 0x92, 0xc9, 0xc4,
-	0xce, 0x6b, 0x1e, 0x2d, 0x53, 0x22, 0x05, 0xe1, 0xb1, 0xac, 0x28, 0x8e, 0x48, 0xe6, 0xb0, 0x9c,
-	0xf1, 0x1e, 0x31, 0x15, 0x37, 0x22, 0x5c, 0xf8, 0x1a, 0x46, 0x04, 0x11, 0x7f, 0x22, 0xcb, 0xf1,
-	0xd0, 0x58, 0x86, 0xe1, 0x2c, 0xe4, 0xf1, 0xb9, 0x8f, 0xb7, 0xe3, 0x80, 0x00, 0xc5, 0xe9, 0xb4,
-	0x5c, 0x00, 0x67, 0x6a, 0x26, 0xa7, 0xf2, 0x6b, 0x62, 0x36, 0x69, 0x18, 0x3c, 0xf6, 0xb7, 0x80,
-	0x79, 0x2e, 0x4f, 0x68, 0xaa, 0x50, 0x6f, 0x13, 0x9f, 0xad, 0x5b, 0x8d, 0xdc, 0xb2, 0x2e, 0xc5,
-	0x06, 0x31, 0xc5, 0x86, 0xbe, 0x65, 0xdf, 0x7c, 0x49, 0x46, 0xc5, 0xd6, 0xb2, 0x2f, 0x1a, 0x37,
-	0x96, 0x70, 0xcf, 0x32, 0xcb, 0xd3, 0xf3, 0x88, 0x9d, 0xe3, 0x6e, 0x25, 0x7f, 0x94, 0x51, 0x12,
-	0x62, 0x77, 0x12, 0xa2, 0x24, 0x2c, 0x52, 0xe9, 0x4c, 0x4e, 0x71, 0x2f, 0x92, 0x3f, 0xda, 0x5f,
-	0x12, 0x2b, 0x68, 0x10, 0x1e, 0x23, 0x67, 0x4a, 0x6b, 0xf5, 0x21, 0xdd, 0xc1, 0x02, 0x2a, 0x6b,
-	0xbc, 0xca, 0x09, 0x6c, 0xbe, 0x66, 0x91, 0xec, 0x1b, 0x9a, 0x28, 0xde, 0xf5, 0xcf, 0x82, 0x99,
-	0x5c, 0xb8, 0x4c, 0x24, 0x84, 0x8b, 0xd1, 0x1d, 0xec, 0x9e, 0x4c, 0x11, 0x2c, 0x11, 0x58, 0x6a,
-	0x58, 0x0d, 0x19, 0x42, 0xfa, 0x25, 0x83, 0xf0, 0xb8, 0x1f, 0x1e, 0x45, 0xf1, 0x99, 0xaf, 0xd2,
-	0xe7, 0xb6, 0x68, 0x41, 0x09, 0x18, 0xe9, 0xec, 0x45, 0x9a, 0x33, 0xd2, 0xb5, 0x6e, 0x43, 0xb1,
-	0xf3, 0x73, 0x25, 0x52, 0xdd, 0xed, 0xf5, 0xfa, 0x17, 0xcc, 0x04, 0x87, 0xb4, 0x60, 0xbb, 0x56,
-	0x72, 0x09, 0x6a, 0xe5, 0x3a, 0xce, 0x48, 0xe1, 0x50, 0x59, 0x4e, 0xe1, 0x80, 0xc1, 0x44, 0xd5,
-	0x15, 0xc1, 0x44, 0x35, 0x3d, 0x98, 0xc8, 0xf9, 0x99, 0x12, 0xa9, 0xec, 0x74, 0x2e, 0x71, 0xde,
-	0x50, 0xcb, 0x15, 0x57, 0x95, 0x19, 0x67, 0xfa, 0xf2, 0x90, 0x66, 0xb9, 0x3f, 0x7d, 0x59, 0x34,
-	0x46, 0xfe, 0x92, 0x08, 0x99, 0x7f, 0x4e, 0xcb, 0x09, 0xa2, 0x60, 0xe7, 0x94, 0xd4, 0x76, 0x3a,
-	0xee, 0xfe, 0xe0, 0xfb, 0xea, 0x87, 0x5c, 0xd1, 0x38, 0xe7, 0x2f, 0xd4, 0x48, 0x1d, 0xfe, 0xc6,
-	0xf9, 0xfc, 0xe5, 0x3f, 0x7c, 0x8f, 0x5c, 0x7d, 0xc4, 0xce, 0x65, 0xf2, 0xe4, 0x48, 0xbf, 0xdb,
-	0x64, 0xb9, 0x80, 0x2f, 0x2a, 0x06, 0xd2, 0x0c, 0x1e, 0x2e, 0x2c, 0xe3, 0x5d, 0x7a, 0xc4, 0xce,
-	0xb5, 0xd0, 0x0a, 0x09, 0x72, 0x7a, 0x71, 0x51, 0xac, 0xed, 0x61, 0x2b, 0x98, 0xbf, 0x05, 0xee,
-	0xcd, 0x99, 0x5c, 0xee, 0x25, 0xc8, 0x3b, 0xfd, 0x88, 0x9d, 0x77, 0xba, 0x8f, 0x64, 0x20, 0xb5,
-	0x80, 0x10, 0x3f, 0xec, 0x77, 0x71, 0x25, 0x47, 0x48, 0x0b, 0xbc, 0x6e, 0xe4, 0x03, 0xaf, 0x87,
-	0xfd, 0xee, 0x4e, 0x1c, 0x47, 0x31, 0x2e, 0xe1, 0x0a, 0xd6, 0xb7, 0xe2, 0x45, 0x94, 0x84, 0xda,
-	0x8a, 0xff, 0x0a, 0xb9, 0xb6, 0xe7, 0x27, 0x2a, 0x6a, 0x8a, 0xf7, 0x38, 0x0b, 0x9b, 0x28, 0x2a,
-	0x02, 0x99, 0x3c, 0x7c, 0x84, 0xa1, 0xd3, 0x98, 0xbc, 0x4b, 0xc3, 0xf0, 0xf1, 0x79, 0xc4, 0xce,
-	0xb5, 0x68, 0x8a, 0x1a, 0xcd, 0x10, 0x22, 0x09, 0xde, 0x7c, 0xe6, 0x9f, 0x43, 0x62, 0x03, 0x16,
-	0x83, 0xbc, 0xaa, 0x52, 0x13, 0xc9, 0x85, 0xcc, 0x28, 0x0a, 0x27, 0x0c, 0x64, 0x57, 0x8b, 0x0a,
-	0x00, 0x78, 0xf9, 0x10, 0x04, 0x57, 0x8b, 0x96, 0xfb, 0x87, 0x22, 0x0f, 0x59, 0x17, 0xc4, 0x53,
-	0x95, 0xf2, 0x47, 0x8c, 0x94, 0xb9, 0xa6, 0x22, 0x65, 0x2c, 0x52, 0xe1, 0x04, 0x14, 0x11, 0x0f,
-	0xfc, 0x91, 0xff, 0x1f, 0x3b, 0x82, 0x2d, 0xc4, 0xc0, 0x41, 0x03, 0x09, 0xd6, 0x5e, 0x9e, 0x24,
-	0x37, 0x84, 0xea, 0x9c, 0xc7, 0x3b, 0xff, 0xa2, 0x4c, 0xd6, 0x0e, 0x29, 0x75, 0xbf, 0xff, 0x1b,
-	0x9f, 0x87, 0x41, 0x9c, 0x2e, 0xfc, 0x19, 0x4d, 0x63, 0x34, 0xbf, 0x6a, 0xd4, 0xc0, 0x19, 0x22,
-	0xa6, 0x96, 0x13, 0x31, 0x70, 0x9a, 0x68, 0x11, 0xa6, 0x7d, 0x17, 0x32, 0x43, 0xe0, 0x1d, 0x41,
-	0x1a, 0xca, 0x50, 0x31, 0xd6, 0x73, 0x2a, 0x06, 0xdc, 0xa1, 0xf2, 0x8c, 0x71, 0x2b, 0x4f, 0x7a,
-	0xe7, 0x25, 0x6c, 0x2c, 0x57, 0x8d, 0xdc, 0x72, 0x75, 0x9b, 0x34, 0xc4, 0xd7, 0xc5, 0x15, 0x39,
-	0x95, 0x77, 0x1b, 0x34, 0x43, 0xbc, 0x92, 0xa7, 0xef, 0x97, 0x4a, 0x64, 0xa3, 0x1b, 0x24, 0x93,
-	0xe8, 0xb2, 0xd7, 0x02, 0xbc, 0x34, 0xc3, 0xf2, 0x78, 0xac, 0x32, 0x2c, 0x63, 0x7e, 0xe3, 0x95,
-	0x67, 0xab, 0xb7, 0x72, 0xd9, 0xfe, 0x65, 0x8e, 0x75, 0xb3, 0x31, 0x66, 0xa6, 0xff, 0x27, 0xe4,
-	0x5a, 0x41, 0xf1, 0xf7, 0x21, 0xe5, 0xfe, 0x0f, 0x93, 0x2b, 0xdd, 0x9e, 0x7b, 0x38, 0xe8, 0x8c,
-	0x7a, 0x81, 0x3f, 0x8b, 0x8e, 0x17, 0x32, 0xe5, 0x7f, 0x49, 0xe5, 0x1e, 0xb3, 0x49, 0x15, 0xf2,
-	0x75, 0xa3, 0xd4, 0xe7, 0xcf, 0xce, 0xb7, 0x48, 0xb3, 0xdb, 0x73, 0xb9, 0x85, 0xb7, 0x32, 0xbb,
-	0x09, 0xb7, 0x74, 0xb1, 0x1c, 0x8f, 0x8d, 0x28, 0xd8, 0xa1, 0xc4, 0xea, 0xf6, 0x5c, 0x37, 0x7a,
-	0xce, 0xe2, 0x95, 0xbf, 0xe5, 0x56, 0xd8, 0xf1, 0x59, 0xaa, 0xb4, 0x50, 0x84, 0xe0, 0x9e, 0x0b,
-	0x41, 0xbe, 0x0a, 0x58, 0xb7, 0x92, 0x44, 0x3f, 0x53, 0x82, 0xae, 0x78, 0x73, 0x3f, 0x66, 0xae,
-	0x1f, 0xc4, 0x6e, 0xb4, 0x03, 0xf1, 0x35, 0xde, 0xce, 0x6e, 0xb4, 0x88, 0x9f, 0x04, 0x31, 0xc3,
-	0x8c, 0xea, 0x3a, 0x0a, 0xac, 0xc6, 0x5e, 0x27, 0x9e, 0x9c, 0x78, 0x27, 0x7e, 0x8c, 0x71, 0xad,
-	0x75, 0x6a, 0xe0, 0xe0, 0x2b, 0x3d, 0x94, 0x67, 0xfb, 0x21, 0x6a, 0x9a, 0x3a, 0x0a, 0x0e, 0x1c,
-	0x7a, 0x3b, 0xfb, 0x32, 0xe6, 0x4f, 0x00, 0xce, 0x3f, 0xab, 0x13, 0xdb, 0x1c, 0xb5, 0x4b, 0xa4,
-	0xfd, 0xff, 0x12, 0xa9, 0x77, 0x7b, 0xae, 0xd8, 0x81, 0x2a, 0x1b, 0x5b, 0x42, 0x12, 0x4d, 0x55,
-	0x05, 0xb8, 0x26, 0x0e, 0x62, 0xe1, 0xd0, 0xd1, 0xd2, 0xa0, 0x0a, 0x16, 0x4e, 0x69, 0x79, 0xc8,
-	0x5a, 0xe4, 0x4a, 0xc8, 0x10, 0x9c, 0x8a, 0x78, 0x5f, 0x05, 0x2a, 0x02, 0x78, 0x13, 0xc4, 0xd7,
-	0x49, 0xcb, 0xb8, 0x06, 0xc0, 0x4c, 0xe2, 0xdf, 0xcd, 0x25, 0xb3, 0x37, 0xea, 0xea, 0x13, 0x64,
-	0xdd, 0xbc, 0x19, 0x92, 0xcb, 0x91, 0x99, 0x9f, 0x72, 0x6d, 0x49, 0xde, 0xa6, 0x24, 0x61, 0xfb,
-	0x3d, 0x42, 0xfa, 0xae, 0xb2, 0xfa, 0x1b, 0xc6, 0x2e, 0x59, 0xdf, 0x1d, 0xb1, 0x94, 0x6a, 0xe5,
-	0xbc, 0x57, 0x87, 0x63, 0x17, 0x8f, 0x18, 0x89, 0x98, 0x92, 0x0c, 0x01, 0x1b, 0xb6, 0x7e, 0x1a,
-	0x3c, 0x63, 0xc0, 0xb0, 0x4d, 0x4c, 0x6d, 0xac, 0x30, 0x10, 0xb3, 0xb4, 0x98, 0xcd, 0x7a, 0x8b,
-	0xf9, 0x8c, 0xbd, 0xc0, 0x35, 0x48, 0xc3, 0xd8, 0x1f, 0x90, 0x06, 0xaf, 0x07, 0xb7, 0x45, 0xe0,
-	0x86, 0x9c, 0xd6, 0x75, 0x7d, 0x96, 0xd0, 0xac, 0xa2, 0x7c, 0xeb, 0xf1, 0x82, 0xc5, 0xe7, 0x18,
-	0xfd, 0xf0, 0xd2, 0xb7, 0xa0, 0x22, 0x5f, 0x02, 0x60, 0x02, 0x74, 0xa3, 0x30, 0x59, 0x9c, 0x89,
-	0xc0, 0x1b, 0x61, 0x36, 0x2e, 0xe1, 0x61, 0x99, 0x19, 0x1f, 0x48, 0x45, 0x7b, 0x38, 0x3e, 0xe0,
-	0xcb, 0x0c, 0x44, 0x95, 0x4e, 0xd9, 0x74, 0x1c, 0x2f, 0x92, 0x14, 0x73, 0x52, 0x9a, 0x48, 0xce,
-	0xdd, 0x07, 0x61, 0xca, 0x1f, 0xd9, 0xb4, 0xbb, 0xef, 0x61, 0xfa, 0x0e, 0x03, 0xa7, 0xdf, 0x1e,
-	0x71, 0xcd, 0xbc, 0x3d, 0x82, 0x2b, 0x02, 0xe7, 0xc9, 0x7e, 0xbf, 0x87, 0xf9, 0xe7, 0x10, 0x82,
-	0xe4, 0xcd, 0x59, 0x4a, 0x7e, 0x96, 0x6c, 0xbe, 0x01, 0xdc, 0x65, 0x22, 0xed, 0xfb, 0xda, 0xfc,
-	0xbf, 0x61, 0xec, 0x9e, 0x69, 0x92, 0x23, 0x93, 0x09, 0xf6, 0x37, 0x48, 0x0b, 0xfa, 0x2d, 0xf5,
-	0x88, 0x9b, 0xc6, 0x3d, 0x0a, 0x79, 0x71, 0x41, 0x8d, 0xca, 0xf6, 0x8f, 0x92, 0x0d, 0x80, 0x3b,
-	0xcf, 0xfc, 0x60, 0xe6, 0x3f, 0x9d, 0x31, 0x88, 0xad, 0x7f, 0xc9, 0xeb, 0xb9, 0xea, 0x9c, 0xef,
-	0x35, 0xc9, 0xc1, 0x20, 0x06, 0xdf, 0x18, 0x46, 0x5d, 0xae, 0x50, 0xa3, 0x2e, 0xb7, 0xc8, 0x77,
-	0x42, 0x16, 0x1f, 0x9f, 0x3f, 0x09, 0x12, 0x06, 0x51, 0xfa, 0x99, 0x45, 0xde, 0xed, 0xb9, 0x59,
-	0x19, 0xd5, 0xea, 0xd9, 0x1f, 0x64, 0xd7, 0x57, 0xbc, 0x75, 0xe1, 0x3a, 0xa0, 0xae, 0xae, 0xf8,
-	0x1f, 0xe5, 0x4c, 0x3e, 0xe8, 0x57, 0x0b, 0xb4, 0xc4, 0xd5, 0x02, 0x66, 0xc0, 0x58, 0x79, 0x29,
-	0x60, 0xec, 0x6d, 0xd2, 0xee, 0xce, 0xf8, 0xd0, 0xc7, 0x43, 0x3f, 0x91, 0xbb, 0x55, 0x0d, 0x6a,
-	0x22, 0xf9, 0x74, 0xc5, 0xff, 0xbd, 0x2f, 0xb3, 0x41, 0x49, 0x58, 0x9f, 0xe4, 0xb5, 0x25, 0xc7,
-	0x95, 0xb7, 0x78, 0x2a, 0x0b, 0x71, 0xd3, 0x36, 0xc3, 0x68, 0xd1, 0xb1, 0xeb, 0x46, 0x74, 0x6c,
-	0xf6, 0xb7, 0x2d, 0xa9, 0x0a, 0x48, 0x18, 0xee, 0x67, 0x15, 0x4d, 0xc3, 0x5b, 0x7e, 0x58, 0x8c,
-	0xf1, 0x65, 0x4b, 0x78, 0xb0, 0xe7, 0x9e, 0x07, 0xe9, 0xe4, 0x84, 0x9b, 0x37, 0x28, 0x1a, 0x14,
-	0x42, 0xfb, 0xcb, 0x03, 0x69, 0x1f, 0x4b, 0x18, 0x6e, 0x6f, 0xf4, 0x43, 0xff, 0x18, 0xd2, 0x37,
-	0x83, 0xe8, 0x68, 0xe1, 0xed, 0x8d, 0x06, 0xd6, 0xf9, 0x5e, 0x95, 0xb4, 0x8d, 0x01, 0x85, 0x69,
-	0x28, 0xf5, 0x35, 0x50, 0xe2, 0xc4, 0x58, 0x98, 0x48, 0x83, 0x9e, 0xc2, 0x87, 0x9a, 0xd1, 0xb3,
-	0xd8, 0xab, 0xd2, 0x2e, 0x0a, 0x15, 0x1d, 0x46, 0x53, 0x36, 0xd3, 0xe2, 0x3c, 0x1a, 0x54, 0x47,
-	0x19, 0x74, 0xac, 0xe5, 0xe8, 0x78, 0x87, 0x10, 0x99, 0x67, 0x0e, 0x83, 0x28, 0x1a, 0x54, 0xc3,
-	0x88, 0x83, 0x55, 0x71, 0xe0, 0xf3, 0x6f, 0xa1, 0xf0, 0xce, 0x10, 0x06, 0xed, 0xc4, 0x39, 0xc2,
-	0x8c, 0x76, 0x36, 0xa9, 0xd2, 0x68, 0xc6, 0x70, 0x54, 0xe0, 0x59, 0x3b, 0x04, 0x4a, 0x8c, 0x43,
-	0xa0, 0xf2, 0x68, 0x69, 0x53, 0x3b, 0x5a, 0x8a, 0xfa, 0xfa, 0xb9, 0x22, 0x90, 0x38, 0x88, 0x64,
-	0x22, 0xc5, 0xd6, 0xdc, 0x7c, 0x76, 0xae, 0x02, 0x41, 0x5b, 0x34, 0x43, 0x88, 0x4d, 0xc9, 0xf9,
-	0xec, 0x5c, 0xea, 0x85, 0x1b, 0xf2, 0xa4, 0x6e, 0x86, 0xcb, 0xff, 0x67, 0x0b, 0xf3, 0x22, 0x99,
-	0xc8, 0x7c, 0xad, 0x07, 0x68, 0x1f, 0x98, 0x48, 0xe7, 0x17, 0xca, 0xa0, 0x6a, 0x18, 0x8b, 0x1f,
-	0x57, 0x77, 0x1e, 0xa0, 0xdb, 0x5d, 0xe8, 0x19, 0x0a, 0x06, 0x3b, 0x77, 0x1b, 0xaf, 0x68, 0xc1,
-	0xcb, 0x5b, 0x24, 0x0c, 0x47, 0x56, 0x5d, 0xe3, 0xfa, 0x16, 0x05, 0xc3, 0x37, 0xb7, 0x04, 0x0b,
-	0xa3, 0x66, 0xa1, 0x60, 0x4e, 0xe3, 0x7e, 0x02, 0x79, 0x0b, 0xf0, 0x12, 0x17, 0x01, 0x41, 0x9c,
-	0xf6, 0xc3, 0xa1, 0xbb, 0x1b, 0xcc, 0x52, 0x0c, 0x02, 0xae, 0x53, 0x0d, 0x03, 0xa1, 0x15, 0xef,
-	0xab, 0xab, 0x64, 0xd0, 0x47, 0x95, 0x61, 0xc0, 0x8e, 0x4c, 0xc4, 0x35, 0x30, 0x75, 0xb4, 0x23,
-	0x05, 0x08, 0x59, 0x7b, 0xd8, 0x59, 0x94, 0xb2, 0xd9, 0xb9, 0x98, 0x17, 0xd2, 0xcb, 0x9b, 0x47,
-	0x3b, 0x3f, 0x44, 0x6a, 0xb0, 0x72, 0x63, 0x72, 0xcf, 0x92, 0x4a, 0xee, 0xc9, 0x1b, 0xed, 0xc2,
-	0x4e, 0x1b, 0xde, 0x69, 0x2a, 0x20, 0xe7, 0x7b, 0x65, 0x72, 0x65, 0x14, 0xc5, 0x29, 0x9b, 0x5d,
-	0x56, 0x19, 0x37, 0xec, 0x00, 0xbc, 0xe4, 0x38, 0xb3, 0x03, 0x80, 0x9d, 0x21, 0x10, 0x19, 0x15,
-	0x23, 0x38, 0x27, 0x88, 0x08, 0xc8, 0x8f, 0x26, 0xae, 0xcc, 0x92, 0x06, 0x36, 0x82, 0xfc, 0xbd,
-	0x6d, 0x7f, 0x72, 0x3a, 0x9f, 0xf9, 0xa1, 0xda, 0x01, 0x56, 0x88, 0xcc, 0xf3, 0xbe, 0xa6, 0x7b,
-	0xde, 0x6f, 0x91, 0xfa, 0x68, 0x71, 0x26, 0x76, 0x93, 0xd0, 0xca, 0x91, 0xb0, 0x74, 0xc3, 0xf8,
-	0x13, 0xd4, 0x7a, 0x10, 0x92, 0x6e, 0x18, 0x7f, 0x82, 0xd3, 0x06, 0x21, 0xe7, 0x9f, 0x96, 0x49,
-	0xa5, 0xdb, 0x77, 0x2f, 0x75, 0x0e, 0x4b, 0xe4, 0xb9, 0x52, 0x77, 0x01, 0x61, 0x96, 0x2b, 0x31,
-	0x91, 0x35, 0x95, 0x10, 0xf2, 0x97, 0x20, 0x02, 0x7a, 0x3e, 0xf3, 0x93, 0x44, 0xed, 0xb6, 0x49,
-	0x10, 0xd8, 0x06, 0xa3, 0xa3, 0xd4, 0xde, 0x9a, 0x86, 0xd1, 0x84, 0xf7, 0x9a, 0x21, 0xbc, 0xef,
-	0x11, 0x2b, 0xcb, 0x63, 0xab, 0xc4, 0x3b, 0xd7, 0xcb, 0x97, 0xf0, 0xca, 0x31, 0x5c, 0xd7, 0xd2,
-	0xbf, 0x7e, 0xda, 0x51, 0xc3, 0xff, 0xab, 0x4c, 0xaa, 0x3b, 0xa3, 0xcb, 0x24, 0x22, 0x93, 0xb7,
-	0xca, 0xe1, 0x26, 0x97, 0xbc, 0x55, 0x2e, 0x33, 0xa7, 0x70, 0x77, 0x37, 0xf3, 0x33, 0xe0, 0xc9,
-	0xd3, 0x3d, 0x3f, 0x9c, 0xce, 0x98, 0xdc, 0xd0, 0x32, 0x90, 0x1a, 0xd9, 0x30, 0x4b, 0x3a, 0x92,
-	0x02, 0xde, 0xe6, 0xab, 0x16, 0xde, 0x25, 0x2e, 0x83, 0x09, 0x0c, 0xa4, 0xbe, 0xf5, 0xb6, 0x6e,
-	0x6e, 0xbd, 0xed, 0xc1, 0x39, 0x67, 0xde, 0x40, 0x79, 0xd5, 0x10, 0x86, 0xdc, 0xc8, 0x5c, 0x0c,
-	0xbc, 0xcf, 0xb9, 0x1a, 0x9c, 0xde, 0x34, 0xff, 0xda, 0xa7, 0x3e, 0x00, 0x3f, 0x4a, 0x6e, 0xae,
-	0x68, 0x0b, 0x24, 0x63, 0x3f, 0x9b, 0xca, 0x9b, 0x91, 0xba, 0x67, 0xd3, 0xc2, 0xc4, 0xff, 0xbf,
-	0x57, 0x92, 0xa7, 0x80, 0xdc, 0x38, 0x3a, 0x0a, 0x66, 0x22, 0xbf, 0xad, 0x3f, 0x01, 0xaf, 0x83,
-	0x10, 0x2d, 0x12, 0x14, 0xc1, 0xa1, 0xbc, 0xea, 0xd0, 0x0f, 0x17, 0x47, 0xfe, 0x24, 0x5d, 0xc4,
-	0x98, 0xe5, 0xa7, 0x41, 0x0b, 0x4a, 0xe0, 0x98, 0x92, 0xb0, 0x97, 0x5c, 0x61, 0x4e, 0x36, 0x68,
-	0x86, 0x00, 0x23, 0x3e, 0x0a, 0x53, 0x7f, 0x92, 0x4a, 0x03, 0x4a, 0xc1, 0xb9, 0x8b, 0xbf, 0x6b,
-	0xc0, 0x4f, 0xfa, 0xc5, 0xdf, 0x06, 0xbb, 0xad, 0x15, 0x1c, 0x4a, 0x10, 0xc9, 0xf9, 0xd6, 0xc1,
-	0x93, 0x24, 0x00, 0xe7, 0x27, 0x44, 0x7e, 0x5d, 0x50, 0xe2, 0xa2, 0x58, 0x9e, 0xe3, 0x90, 0x69,
-	0x73, 0x15, 0xc6, 0x70, 0xf5, 0xa3, 0x65, 0xad, 0x5c, 0xfd, 0xef, 0x08, 0x19, 0x95, 0x60, 0x08,
-	0x9a, 0xdc, 0x3e, 0xe5, 0x6f, 0x03, 0x5e, 0x48, 0xad, 0xc4, 0xf9, 0x06, 0x69, 0x28, 0x9c, 0x38,
-	0x16, 0x20, 0x7a, 0x52, 0x12, 0xc9, 0x19, 0x64, 0x37, 0x54, 0x43, 0xcb, 0x7a, 0x43, 0x7f, 0x6a,
-	0x8d, 0x4b, 0x5f, 0x39, 0x1c, 0x36, 0xa9, 0x6a, 0x63, 0x51, 0x95, 0xf9, 0x5d, 0x35, 0xf2, 0x94,
-	0x97, 0xc8, 0x73, 0x97, 0x34, 0x1f, 0xb2, 0x68, 0x26, 0xed, 0x03, 0xa1, 0x85, 0xea, 0x28, 0x30,
-	0x6d, 0x47, 0x1e, 0x57, 0x11, 0x14, 0xf1, 0x25, 0x5c, 0x70, 0x13, 0x7e, 0xad, 0xf0, 0x26, 0xfc,
-	0xa5, 0xbb, 0xd6, 0xd7, 0x8a, 0xee, 0x5a, 0x77, 0x48, 0x4b, 0xbb, 0xad, 0x5e, 0x88, 0xaf, 0x06,
-	0x35, 0x70, 0xf6, 0xb7, 0x48, 0xe3, 0xdb, 0xfe, 0x83, 0x3d, 0x3f, 0x39, 0x61, 0xf2, 0x90, 0xe3,
-	0xe7, 0x94, 0x8d, 0x8a, 0x84, 0xb8, 0xaf, 0x6a, 0x88, 0x6c, 0x23, 0xd9, 0x1b, 0xfc, 0x75, 0x39,
-	0x42, 0xd2, 0xc4, 0x5d, 0x7e, 0x5d, 0xd5, 0xc0, 0xd7, 0x15, 0x9c, 0x8d, 0x02, 0xd1, 0x46, 0xc1,
-	0xbe, 0x4f, 0xaa, 0xde, 0xa8, 0x9f, 0x6c, 0x36, 0x0d, 0xeb, 0x21, 0xfb, 0x1e, 0x2f, 0x14, 0x9f,
-	0x82, 0x7a, 0xf6, 0x17, 0x49, 0x1d, 0xa7, 0xab, 0xcc, 0x4d, 0xd7, 0xd4, 0xb8, 0x83, 0xaa, 0x42,
-	0x5e, 0x11, 0x67, 0x6f, 0xb2, 0xd9, 0x2e, 0xa8, 0x28, 0x0b, 0xed, 0x07, 0x64, 0x03, 0x27, 0x04,
-	0x9b, 0x8a, 0xea, 0x1b, 0xcb, 0xd5, 0x73, 0x55, 0x6e, 0x7d, 0x93, 0x6c, 0x98, 0x84, 0x7a, 0xa5,
-	0x5c, 0x27, 0x43, 0xb2, 0x61, 0xd2, 0xa9, 0xe0, 0xed, 0x2f, 0xe8, 0x6f, 0x67, 0xfe, 0x13, 0xf9,
-	0x9e, 0xfe, 0xb9, 0x1f, 0x21, 0x0d, 0x45, 0xa6, 0x8b, 0xda, 0x51, 0xd1, 0x93, 0xa6, 0xfc, 0x58,
-	0x36, 0x07, 0x5f, 0x32, 0x7d, 0xb8, 0x04, 0xf1, 0x53, 0x76, 0x1c, 0xc5, 0xe7, 0x72, 0xa6, 0x4a,
-	0xd8, 0xf9, 0xef, 0x65, 0x91, 0xe3, 0xf8, 0xe2, 0x3d, 0x97, 0x7c, 0x8e, 0xec, 0xdc, 0x9a, 0x54,
-	0xd1, 0xf7, 0x58, 0x38, 0x5d, 0x55, 0x26, 0x2b, 0x3f, 0x39, 0x31, 0xdc, 0x70, 0x35, 0xd3, 0x0d,
-	0x07, 0x07, 0xe2, 0x60, 0xe3, 0x1f, 0xcf, 0x2a, 0x03, 0x00, 0x6b, 0x16, 0x6c, 0x6a, 0xa2, 0x21,
-	0x80, 0x50, 0x3e, 0x7d, 0x54, 0x7d, 0x39, 0x7d, 0x94, 0xcc, 0xa4, 0xd5, 0xd0, 0x32, 0x69, 0xad,
-	0xc8, 0x4e, 0x44, 0x56, 0x67, 0x27, 0x7a, 0x05, 0x27, 0xee, 0x6b, 0x5d, 0x97, 0x35, 0x25, 0x2d,
-	0x6f, 0x38, 0x76, 0x57, 0x26, 0x06, 0x2d, 0x15, 0x24, 0x06, 0xbd, 0x4d, 0x1a, 0x2a, 0xc5, 0x8e,
-	0x54, 0x37, 0x15, 0xa2, 0x30, 0xe5, 0xef, 0x13, 0xd2, 0x14, 0x7f, 0x11, 0x0e, 0x8a, 0xdc, 0xb5,
-	0xb5, 0x8d, 0x4c, 0xc1, 0xb8, 0x45, 0xea, 0x9d, 0xf8, 0x78, 0x71, 0x26, 0x77, 0xbb, 0x1b, 0x54,
-	0xc1, 0x85, 0x1f, 0xde, 0x11, 0x1f, 0x96, 0xaf, 0xaf, 0xbe, 0x0f, 0xf7, 0xa5, 0x6d, 0x76, 0xfe,
-	0x67, 0x89, 0x54, 0xf9, 0x77, 0x2e, 0x3e, 0x85, 0xd9, 0xcf, 0xb6, 0x68, 0xe4, 0x41, 0x68, 0x0d,
-	0x95, 0xcb, 0xbb, 0x5a, 0x59, 0xca, 0xbb, 0xfa, 0x0a, 0xa7, 0xf8, 0x5f, 0xeb, 0x22, 0x2f, 0xd0,
-	0x06, 0x82, 0x59, 0xbf, 0x27, 0xf7, 0x03, 0x24, 0x28, 0xd6, 0x6f, 0xa0, 0x85, 0x10, 0x92, 0xb0,
-	0x7e, 0x0b, 0xd8, 0xf9, 0xa9, 0x0a, 0xa9, 0xf7, 0x02, 0x1c, 0xbf, 0x57, 0xf2, 0xfb, 0xb7, 0x8d,
-	0xcc, 0x9c, 0xd9, 0x89, 0x8c, 0xb6, 0x76, 0x1b, 0x62, 0x2e, 0x13, 0x50, 0xdb, 0xc8, 0x04, 0x04,
-	0xf3, 0x08, 0x9a, 0x01, 0xec, 0x86, 0xe1, 0xef, 0x1a, 0x0a, 0x76, 0xb7, 0xb3, 0xd5, 0x47, 0x9d,
-	0x7a, 0x30, 0x91, 0x60, 0xd3, 0x63, 0x82, 0x46, 0x75, 0x96, 0x45, 0xc3, 0x40, 0x72, 0x8a, 0x70,
-	0x3a, 0x8e, 0x76, 0xc2, 0x29, 0x1e, 0x8e, 0x6e, 0x53, 0x0d, 0x63, 0xdf, 0x21, 0xd5, 0xce, 0xa1,
-	0x2b, 0xd7, 0x23, 0x19, 0x6d, 0xdc, 0x39, 0x74, 0x29, 0xe0, 0x3f, 0xf5, 0x03, 0x9c, 0x3f, 0x5d,
-	0x21, 0x95, 0xce, 0xa1, 0x0b, 0xbd, 0x4d, 0xd3, 0x38, 0x78, 0xba, 0x48, 0xb3, 0x09, 0xc8, 0x7b,
-	0xab, 0x23, 0x8d, 0x5a, 0x9a, 0x40, 0x34, 0x91, 0xdc, 0x46, 0x55, 0x88, 0x5d, 0xd8, 0x9b, 0xc7,
-	0xb9, 0x93, 0x47, 0x67, 0x63, 0x57, 0xd5, 0xc7, 0xee, 0x36, 0x69, 0x88, 0xf8, 0x18, 0x3e, 0x74,
-	0x62, 0x64, 0x32, 0x04, 0x5f, 0x20, 0xb2, 0xa4, 0x4c, 0xfc, 0x91, 0xd3, 0xf8, 0x90, 0x85, 0xd3,
-	0x28, 0x86, 0x86, 0xe3, 0x18, 0x64, 0x98, 0xac, 0x5c, 0x3b, 0x45, 0xab, 0x61, 0x38, 0x8b, 0x0a,
-	0x08, 0xc3, 0x79, 0x1b, 0x54, 0xc1, 0x90, 0x47, 0x8e, 0x4d, 0xa2, 0x29, 0x9b, 0x8a, 0x7d, 0x1b,
-	0xcc, 0xd9, 0xaf, 0xe3, 0xf4, 0x1b, 0x86, 0x9a, 0x82, 0x37, 0xe5, 0x0d, 0x43, 0x6a, 0xbb, 0xa7,
-	0xa5, 0x6d, 0xf7, 0xc0, 0xff, 0xf8, 0x03, 0xef, 0x46, 0x5b, 0x78, 0xa2, 0x24, 0xec, 0xfc, 0x56,
-	0x89, 0x54, 0xdd, 0x7d, 0xf7, 0xc1, 0xc5, 0xd6, 0xa7, 0xba, 0x46, 0xa0, 0x9c, 0xbb, 0x66, 0xe0,
-	0x16, 0xa9, 0xab, 0xeb, 0x03, 0x70, 0x3f, 0x42, 0x5d, 0x1d, 0x70, 0x9b, 0x34, 0x60, 0xf7, 0x2f,
-	0x3a, 0x65, 0x32, 0x39, 0x58, 0x86, 0xe0, 0x92, 0xee, 0x20, 0x61, 0x32, 0xc1, 0x3c, 0x3c, 0x8b,
-	0xfc, 0x62, 0x78, 0x91, 0x30, 0xe4, 0x17, 0x13, 0xf7, 0xbf, 0xca, 0xd9, 0xbe, 0xbe, 0x7a, 0xb6,
-	0xd7, 0x73, 0xb3, 0xfd, 0xf7, 0xaa, 0xa4, 0xca, 0xeb, 0x5d, 0x9c, 0x1c, 0x94, 0xb2, 0x74, 0x11,
-	0x87, 0x90, 0xd6, 0x4c, 0x74, 0x4e, 0xc3, 0xc0, 0xad, 0x04, 0x31, 0x26, 0x25, 0x6a, 0x50, 0x78,
-	0x86, 0x1b, 0x76, 0x22, 0xec, 0x4f, 0x79, 0x1c, 0x41, 0x32, 0x76, 0x19, 0x5d, 0x51, 0xee, 0x76,
-	0xf1, 0xb2, 0xd7, 0x9f, 0x60, 0x13, 0xb9, 0xca, 0x4a, 0x10, 0x85, 0xbb, 0x5c, 0x65, 0xe1, 0x99,
-	0xb7, 0x0f, 0x25, 0x05, 0x4e, 0xd9, 0x06, 0xcd, 0x10, 0xa2, 0x7d, 0x98, 0x76, 0x3c, 0x41, 0x7e,
-	0xd1, 0x30, 0xe0, 0x14, 0x09, 0xc1, 0x55, 0x35, 0x8e, 0xa4, 0x07, 0x54, 0x21, 0x44, 0x6e, 0x2c,
-	0x91, 0x0f, 0xd2, 0x0f, 0x8f, 0x17, 0xfe, 0xb1, 0x74, 0xc4, 0xe5, 0xd1, 0x5c, 0xbf, 0xde, 0xf3,
-	0x13, 0x11, 0x35, 0x2a, 0x0e, 0x89, 0x8b, 0xad, 0x92, 0x1c, 0x96, 0xd7, 0xfb, 0x48, 0xa4, 0x36,
-	0xf7, 0x21, 0x1c, 0x46, 0xe6, 0x85, 0xcc, 0x61, 0xf3, 0x9a, 0xc3, 0x46, 0x61, 0xe2, 0xc9, 0x9d,
-	0xf0, 0x19, 0x9b, 0x45, 0x73, 0x36, 0x8e, 0xf0, 0xfc, 0x92, 0x86, 0xb1, 0x7f, 0x80, 0x54, 0x21,
-	0x07, 0x9f, 0x65, 0x84, 0xe5, 0xf2, 0x21, 0x75, 0xfd, 0x38, 0xa5, 0x50, 0x68, 0x70, 0xe6, 0xd5,
-	0x97, 0x70, 0xa6, 0x9d, 0xe3, 0xcc, 0x6c, 0x53, 0xbf, 0x01, 0x3b, 0x8f, 0x30, 0xf1, 0x66, 0xc1,
-	0x33, 0x16, 0x9f, 0xc3, 0x00, 0x5d, 0x97, 0x13, 0x2f, 0xc3, 0x41, 0xd8, 0x14, 0xf4, 0x11, 0x33,
-	0x76, 0x21, 0xe4, 0xfc, 0xfd, 0x12, 0xa9, 0xcb, 0x66, 0x69, 0x5b, 0x9a, 0xe2, 0xc3, 0x0f, 0xd4,
-	0xc1, 0xa3, 0xb2, 0x91, 0xac, 0x50, 0xbe, 0x70, 0x5f, 0xcf, 0x76, 0x28, 0xcf, 0x20, 0x61, 0x36,
-	0x7f, 0x19, 0xe3, 0xd6, 0xa0, 0x12, 0x84, 0x0b, 0xcb, 0x83, 0x19, 0x0b, 0xe5, 0xfd, 0x2b, 0x0d,
-	0xaa, 0xe0, 0x5b, 0x5f, 0x23, 0xcd, 0xd7, 0x4c, 0x27, 0xe8, 0x74, 0x49, 0x93, 0x8b, 0x81, 0x3f,
-	0x94, 0xe6, 0xe2, 0x6c, 0x93, 0x96, 0xf8, 0x08, 0x6a, 0x01, 0xab, 0xbf, 0xc2, 0x67, 0x34, 0xc6,
-	0x7a, 0x94, 0xd1, 0x9a, 0x17, 0xa0, 0xf3, 0x1f, 0xcb, 0xa4, 0xee, 0x45, 0x47, 0xe9, 0x73, 0x3f,
-	0xbe, 0x44, 0xda, 0x1c, 0x37, 0x8e, 0xa6, 0x8b, 0x89, 0x6c, 0x89, 0x04, 0x61, 0xbb, 0x18, 0x24,
-	0xaa, 0xcc, 0xfa, 0x2a, 0x20, 0x7d, 0x55, 0xaf, 0x9a, 0x9b, 0x95, 0xef, 0x90, 0x0d, 0xc3, 0xdf,
-	0x20, 0x53, 0x54, 0xe7, 0xb0, 0xb0, 0xdf, 0x01, 0x9a, 0x31, 0xc8, 0x76, 0xf4, 0xa9, 0x67, 0x18,
-	0x08, 0xe4, 0x75, 0xfb, 0x94, 0x25, 0x8b, 0x59, 0x2a, 0xa5, 0x95, 0x86, 0x01, 0xc9, 0x20, 0x3c,
-	0x73, 0x38, 0xd3, 0x25, 0x28, 0xd6, 0xa6, 0xe8, 0xb9, 0xcc, 0x63, 0x2e, 0x80, 0xec, 0x7f, 0xa0,
-	0x12, 0x12, 0xfd, 0x7f, 0xd2, 0x95, 0x36, 0x8a, 0x52, 0xcc, 0x4f, 0xde, 0xa0, 0x02, 0xe0, 0x7f,
-	0x79, 0xc2, 0x9e, 0x26, 0x41, 0xca, 0x50, 0x73, 0x96, 0x20, 0xe7, 0xce, 0x7d, 0x0f, 0x67, 0x6c,
-	0x79, 0xdf, 0x73, 0xfe, 0xa0, 0xac, 0x1a, 0x74, 0x89, 0x7c, 0x31, 0x52, 0xf8, 0x97, 0xfb, 0xee,
-	0x85, 0x17, 0x03, 0x69, 0x76, 0xcb, 0xb6, 0x1f, 0x86, 0x4a, 0xcc, 0x23, 0xb4, 0x94, 0x6e, 0x48,
-	0x77, 0x68, 0x28, 0x5a, 0xac, 0xeb, 0xb4, 0xd0, 0xc6, 0xbb, 0xbe, 0x6a, 0xbc, 0x1b, 0xab, 0xc6,
-	0x9b, 0x98, 0xe3, 0x5d, 0x4c, 0xb7, 0xbb, 0xa4, 0x09, 0x66, 0xb6, 0x90, 0x12, 0xa8, 0xd5, 0xe8,
-	0x28, 0x55, 0x43, 0xc8, 0x18, 0xd4, 0x6e, 0x74, 0x94, 0xb8, 0x71, 0x25, 0x49, 0x43, 0x79, 0xc7,
-	0x4d, 0x83, 0x2a, 0x18, 0xa9, 0x7f, 0x45, 0x51, 0xff, 0x2f, 0x97, 0x48, 0xb3, 0x1b, 0x33, 0xc8,
-	0x4b, 0xe6, 0xcf, 0x92, 0x4b, 0xdc, 0x75, 0x87, 0xbc, 0x53, 0x36, 0x79, 0x87, 0xaf, 0x51, 0xb3,
-	0xe8, 0xb9, 0x5a, 0xa3, 0x66, 0xd1, 0x73, 0xb5, 0xb8, 0x56, 0xb5, 0xc5, 0x95, 0xd3, 0xdc, 0x4f,
-	0x92, 0xe7, 0x51, 0x3c, 0x55, 0xb7, 0xba, 0x20, 0x9c, 0x51, 0x64, 0x4d, 0xa3, 0x88, 0xf3, 0x37,
-	0x4b, 0xa4, 0xe2, 0x79, 0x7b, 0x17, 0xe7, 0xdb, 0xd8, 0xeb, 0x78, 0xde, 0x9e, 0x94, 0x2b, 0x00,
-	0x14, 0xb6, 0x4a, 0xfd, 0xa5, 0xaa, 0xd3, 0x5d, 0xd9, 0xa4, 0x35, 0xdd, 0x26, 0xbd, 0x43, 0x48,
-	0x67, 0x76, 0x1c, 0xc5, 0x41, 0x7a, 0x72, 0x26, 0x9b, 0xa5, 0x61, 0xe0, 0xb0, 0xaf, 0x1c, 0x08,
-	0xb1, 0xa7, 0xa1, 0x60, 0xe7, 0xcf, 0x97, 0x49, 0xfb, 0x70, 0x31, 0x0b, 0x59, 0x2c, 0x76, 0x6b,
-	0xce, 0x2f, 0x9d, 0x0d, 0x49, 0x48, 0xed, 0xbb, 0xa4, 0x29, 0x83, 0xf4, 0x34, 0x5f, 0x95, 0x86,
-	0x12, 0x8b, 0xcb, 0x33, 0x06, 0x61, 0x52, 0x55, 0xb9, 0xb8, 0x08, 0x18, 0xf8, 0x6e, 0xcb, 0x9b,
-	0x44, 0x31, 0xc3, 0x1e, 0x49, 0x50, 0xa4, 0x7d, 0x9f, 0xb0, 0x24, 0x39, 0x64, 0x93, 0x34, 0x92,
-	0xa9, 0xa4, 0x0d, 0x9c, 0xd0, 0x0f, 0xe3, 0x44, 0xf3, 0x4b, 0x29, 0x38, 0xa3, 0x5f, 0x5d, 0xa7,
-	0xdf, 0x97, 0x32, 0x99, 0x89, 0x27, 0x2b, 0xe5, 0x6a, 0x29, 0xd1, 0x54, 0x55, 0x70, 0xfe, 0x52,
-	0x19, 0xd2, 0xb2, 0xce, 0xa2, 0x20, 0xfd, 0xbe, 0x13, 0x45, 0x5e, 0xe1, 0x84, 0x4c, 0x07, 0xae,
-	0x0e, 0xd5, 0xe4, 0x9a, 0xde, 0x64, 0xa9, 0x08, 0xad, 0x69, 0x8a, 0x10, 0xa4, 0xc8, 0x48, 0x4f,
-	0xa2, 0x58, 0x3a, 0x21, 0x04, 0x04, 0xa1, 0x56, 0xe7, 0x73, 0xec, 0x32, 0x7f, 0x34, 0x62, 0x4b,
-	0x1a, 0xb9, 0xd8, 0x12, 0x29, 0x98, 0x08, 0x6a, 0x90, 0x5c, 0x30, 0xe9, 0x04, 0x6a, 0x5e, 0x44,
-	0xa0, 0xbf, 0x57, 0x26, 0xb5, 0xce, 0x8c, 0xc5, 0xe9, 0x6b, 0x78, 0x69, 0x2e, 0x26, 0x51, 0x71,
-	0x42, 0x76, 0xcd, 0x96, 0x42, 0x8e, 0x91, 0xb6, 0x54, 0x61, 0x6e, 0x39, 0xdd, 0xc2, 0xc2, 0xb0,
-	0x1b, 0xed, 0x8e, 0xeb, 0x61, 0x7f, 0x4c, 0x77, 0x24, 0x87, 0x00, 0x00, 0xb9, 0x06, 0x5c, 0xca,
-	0xe6, 0x8b, 0x34, 0xcb, 0x31, 0xd2, 0xa0, 0x06, 0x6e, 0xe5, 0x0e, 0x6e, 0x3e, 0xca, 0x3c, 0x27,
-	0xa9, 0xc5, 0xe0, 0xb6, 0xb4, 0xc1, 0xbd, 0xf7, 0xaf, 0x36, 0x44, 0x6c, 0x98, 0xdd, 0x26, 0x8d,
-	0x51, 0xf7, 0x63, 0xa1, 0x94, 0x58, 0x9f, 0xb1, 0x5b, 0xa4, 0x3e, 0xea, 0x7e, 0xbc, 0xed, 0xa7,
-	0x93, 0x13, 0xab, 0x64, 0x5f, 0x25, 0xed, 0x51, 0xf7, 0xe3, 0x6e, 0x14, 0x86, 0x22, 0x45, 0x98,
-	0x55, 0xb1, 0xaf, 0x90, 0xe6, 0xa8, 0xfb, 0xf1, 0x4e, 0x7a, 0xc2, 0xe2, 0x90, 0xa5, 0xd6, 0xba,
-	0x4d, 0xc8, 0xda, 0xa8, 0xfb, 0x71, 0x87, 0xba, 0x56, 0x1d, 0xdf, 0xee, 0x45, 0xe9, 0xfb, 0x8f,
-	0xad, 0x86, 0x06, 0xbd, 0x6f, 0x11, 0x7c, 0x11, 0xa0, 0xc7, 0xfb, 0x9e, 0xd5, 0xb4, 0xdf, 0x20,
-	0x57, 0x25, 0x62, 0x6f, 0x8c, 0xd1, 0xd3, 0x56, 0xcb, 0xde, 0x24, 0xd7, 0x97, 0xd0, 0x87, 0x7b,
-	0x63, 0xab, 0x6d, 0xdf, 0x24, 0xd7, 0x96, 0x4a, 0xf6, 0xc6, 0xd6, 0x46, 0xe1, 0x2b, 0xc3, 0xdd,
-	0x6d, 0xeb, 0x8a, 0x7d, 0x97, 0xdc, 0x96, 0x25, 0xe2, 0xe2, 0x2c, 0x7f, 0xee, 0xa7, 0x59, 0x38,
-	0xbf, 0x65, 0xd9, 0x16, 0x69, 0xc9, 0x1a, 0x1d, 0x6f, 0x67, 0x60, 0x5d, 0xb5, 0xdf, 0x24, 0x6f,
-	0x8c, 0xba, 0x1f, 0xc3, 0x51, 0x29, 0xff, 0x9c, 0xc5, 0x6a, 0xeb, 0xd3, 0xb2, 0xed, 0xeb, 0xc4,
-	0xe2, 0x45, 0x83, 0x9e, 0x8b, 0x5b, 0x93, 0xfd, 0x9e, 0x75, 0x0d, 0xa9, 0xc4, 0xb1, 0x22, 0x5a,
-	0xcb, 0xba, 0x6e, 0xdf, 0x21, 0xb7, 0x0a, 0xbf, 0x01, 0x56, 0x9d, 0xf5, 0x86, 0x6d, 0x93, 0x0d,
-	0x8d, 0x8a, 0xdd, 0xb1, 0x6b, 0xdd, 0xc0, 0xee, 0x69, 0x38, 0xb0, 0x10, 0xac, 0x9b, 0xf6, 0x67,
-	0xc9, 0x9b, 0x85, 0x1f, 0xeb, 0x87, 0x47, 0x91, 0xb5, 0x69, 0xdf, 0x22, 0x37, 0xf0, 0xf7, 0xde,
-	0x79, 0xa2, 0x6f, 0x7e, 0x5b, 0x6f, 0xe2, 0x37, 0xa1, 0xc1, 0x7a, 0xc1, 0x2d, 0xfb, 0x06, 0xb1,
-	0xb1, 0x40, 0x0b, 0x0f, 0xb2, 0xde, 0x92, 0x9d, 0x1f, 0xf4, 0xdc, 0xfd, 0xf8, 0x58, 0x6e, 0x0b,
-	0x8d, 0x07, 0x87, 0xd6, 0x6d, 0xbb, 0x49, 0xd6, 0x47, 0xdd, 0x8f, 0xfb, 0xee, 0xb3, 0x0f, 0xac,
-	0xcf, 0x62, 0x9f, 0x39, 0x20, 0xf6, 0xbe, 0xac, 0x3b, 0x59, 0xf9, 0x87, 0xd6, 0xe7, 0x90, 0xad,
-	0xc4, 0xed, 0xec, 0xd6, 0x5d, 0x1d, 0xfc, 0xd0, 0xfa, 0xbc, 0xed, 0x90, 0x3b, 0x0a, 0x2c, 0xbc,
-	0x7f, 0xdc, 0x72, 0x70, 0xe8, 0x56, 0x5e, 0xe7, 0x6d, 0xfd, 0x80, 0x7d, 0x8d, 0x5c, 0x51, 0x35,
-	0xb0, 0x15, 0x6f, 0x23, 0x3b, 0x1e, 0xf4, 0x5c, 0xeb, 0x0b, 0xf8, 0x3c, 0xee, 0xba, 0xd6, 0x3b,
-	0x38, 0xce, 0xea, 0x86, 0x5c, 0xeb, 0x8b, 0xd8, 0x5e, 0x8f, 0x13, 0xff, 0x5d, 0xac, 0xda, 0x1b,
-	0x79, 0xd6, 0x0f, 0x4a, 0x76, 0xca, 0xdf, 0xcb, 0x69, 0xdd, 0xc3, 0x6e, 0x88, 0xbb, 0x25, 0xad,
-	0x2f, 0x69, 0x20, 0x3d, 0xb4, 0xde, 0x93, 0xfc, 0x3e, 0xf2, 0x86, 0x1f, 0x59, 0x5f, 0xc6, 0x21,
-	0xd6, 0x2e, 0x4d, 0xb4, 0xee, 0xcb, 0x17, 0xe0, 0xea, 0x43, 0xeb, 0x87, 0x90, 0x88, 0xd9, 0x75,
-	0x74, 0xd6, 0x57, 0xf4, 0x1a, 0x1f, 0x5a, 0xef, 0x63, 0x17, 0xf5, 0x4b, 0xcf, 0xac, 0x2d, 0x6c,
-	0xeb, 0x60, 0xd0, 0xb5, 0x1e, 0xe0, 0xf3, 0x68, 0xec, 0x5a, 0x1f, 0xe0, 0xb3, 0xd7, 0x77, 0xad,
-	0x1f, 0x96, 0x83, 0xf1, 0x70, 0xe8, 0x5a, 0x1f, 0x62, 0x87, 0x96, 0x2e, 0xa0, 0xb1, 0x7e, 0x44,
-	0x92, 0x50, 0xbb, 0x54, 0xc4, 0xfa, 0x2a, 0xf2, 0xc0, 0xf2, 0x4d, 0x23, 0xd6, 0xd7, 0xe4, 0xc0,
-	0xad, 0xbe, 0x84, 0xc4, 0xfa, 0xba, 0xa4, 0xeb, 0xa8, 0xe3, 0x5a, 0xdf, 0x90, 0x7c, 0xa2, 0xee,
-	0x01, 0xb1, 0xbe, 0x69, 0x7f, 0x9e, 0x7c, 0x76, 0x69, 0xf0, 0xf5, 0x7b, 0x2c, 0xac, 0x6f, 0xd9,
-	0x9f, 0x23, 0x6f, 0xe5, 0xc6, 0xde, 0xa8, 0xf0, 0xff, 0xe1, 0x3f, 0xf6, 0xc6, 0x63, 0xd7, 0xfa,
-	0x51, 0x14, 0x24, 0x66, 0x12, 0x71, 0xeb, 0xc7, 0xec, 0x0d, 0x42, 0xa0, 0xad, 0x90, 0x43, 0xd5,
-	0xea, 0xa0, 0x00, 0x92, 0xd9, 0x48, 0xad, 0x6d, 0xa4, 0xb5, 0x48, 0x7a, 0x69, 0x75, 0x35, 0x5a,
-	0xc8, 0x74, 0x69, 0x56, 0x0f, 0xc7, 0x14, 0x72, 0x53, 0x5a, 0x3b, 0x92, 0xb9, 0xbc, 0x6d, 0x6b,
-	0x57, 0x8e, 0x42, 0x77, 0x68, 0x3d, 0xc4, 0xe6, 0x0c, 0xdd, 0x81, 0x67, 0xed, 0xe1, 0x67, 0x45,
-	0xba, 0x31, 0xab, 0x8f, 0xa0, 0x48, 0x91, 0x65, 0x7d, 0x5b, 0x07, 0x1f, 0x58, 0x8f, 0xf0, 0x2b,
-	0xdb, 0xbb, 0x3d, 0x6b, 0x80, 0xcf, 0x0f, 0xe9, 0x8e, 0x35, 0xc4, 0x2f, 0xee, 0xf6, 0x7a, 0x7d,
-	0x6b, 0x84, 0x05, 0x3b, 0x1d, 0xd7, 0xda, 0xc7, 0xf7, 0x45, 0xe0, 0xb9, 0xe5, 0x62, 0xfb, 0xe0,
-	0x90, 0x84, 0xf5, 0x58, 0x0a, 0x67, 0x3c, 0x32, 0x61, 0x51, 0x24, 0x8d, 0x19, 0xba, 0x66, 0x79,
-	0x38, 0xc2, 0xcb, 0x41, 0xb0, 0xd6, 0xd8, 0x7e, 0x8b, 0xdc, 0x14, 0x5d, 0x5c, 0x4a, 0x0c, 0x68,
-	0x1d, 0xa0, 0xd4, 0xc8, 0x85, 0x84, 0x58, 0x87, 0xd8, 0xc0, 0x6e, 0xdf, 0xb5, 0x9e, 0x60, 0xcb,
-	0x77, 0x46, 0x7d, 0xd7, 0xfa, 0x08, 0x05, 0xa6, 0x61, 0xa1, 0x59, 0xdf, 0x91, 0x9d, 0xe3, 0xc0,
-	0x77, 0x25, 0xbb, 0x0c, 0xc7, 0xae, 0xf5, 0xe3, 0x72, 0x91, 0x40, 0x0f, 0xb0, 0xf5, 0xff, 0x63,
-	0x29, 0xb7, 0x59, 0xad, 0x3f, 0x92, 0x0d, 0xb4, 0x96, 0xcc, 0xda, 0xfa, 0xa3, 0xf8, 0x92, 0x54,
-	0x0e, 0xac, 0x8f, 0x71, 0xe4, 0x51, 0xf5, 0xb6, 0xfe, 0x18, 0x4e, 0x45, 0x4d, 0x8d, 0xb7, 0x7c,
-	0x39, 0x59, 0xbc, 0x3d, 0xeb, 0x29, 0xb6, 0xd2, 0x50, 0x46, 0xad, 0x09, 0x7e, 0x05, 0xf5, 0x30,
-	0x6b, 0x8a, 0x12, 0x44, 0x6d, 0xe4, 0x59, 0x4c, 0x0e, 0xbb, 0x1f, 0xcc, 0xac, 0x23, 0x1c, 0x09,
-	0xd0, 0x4a, 0xac, 0xe3, 0xed, 0xaf, 0xfd, 0xe3, 0xdf, 0xb9, 0x53, 0xfa, 0x8d, 0xdf, 0xb9, 0x53,
-	0xfa, 0xd7, 0xbf, 0x73, 0xa7, 0xf4, 0x67, 0x7e, 0xf7, 0xce, 0x67, 0x7e, 0xe3, 0x77, 0xef, 0x7c,
-	0xe6, 0xb7, 0x7e, 0xf7, 0xce, 0x67, 0x48, 0x63, 0x12, 0x9d, 0x09, 0xcd, 0x66, 0xbb, 0x39, 0x62,
-	0xe9, 0xc4, 0x9f, 0xc3, 0x52, 0xed, 0x96, 0xbe, 0x5b, 0x03, 0xec, 0xd3, 0xb5, 0x39, 0x87, 0x1f,
-	0xfc, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x03, 0x48, 0x06, 0x19, 0x4f, 0x9e, 0x00, 0x00,
+	// 13014 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x7d, 0x8c, 0x24, 0x49,
+	0x76, 0xd7, 0xd5, 0x57, 0x77, 0x55, 0x74, 0x55, 0x77, 0x4e, 0xce, 0xec, 0x4c, 0xcd, 0xec, 0xdc,
+	0xec, 0x5c, 0x79, 0x6f, 0x6f, 0x6f, 0x6f, 0x6f, 0xef, 0xb6, 0x67, 0xbd, 0xbe, 0x4f, 0xec, 0xea,
+	0xaa, 0xee, 0xe9, 0xba, 0xad, 0xae, 0xae, 0x89, 0xac, 0xe9, 0xd9, 0x3b, 0x03, 0x4b, 0x4e, 0x55,
+	0x74, 0x77, 0x7a, 0xaa, 0x33, 0x6b, 0x33, 0xb3, 0x66, 0xa6, 0x2d, 0xf1, 0x07, 0x7f, 0x9c, 0x25,
+	0xfe, 0xb0, 0x0d, 0x36, 0x42, 0x08, 0xdb, 0x08, 0xf3, 0x21, 0x4b, 0xb6, 0x40, 0x80, 0x90, 0x10,
+	0x1f, 0x12, 0x48, 0x60, 0x1b, 0x21, 0x21, 0x19, 0x90, 0x10, 0x12, 0x42, 0xa0, 0xb3, 0x11, 0x1f,
+	0x12, 0x48, 0xfe, 0x03, 0x24, 0x83, 0xc0, 0xe8, 0xbd, 0x78, 0x91, 0x19, 0x91, 0x95, 0x55, 0xdd,
+	0xb3, 0xb7, 0x8b, 0x0e, 0x89, 0xbf, 0x2a, 0xdf, 0x2f, 0x22, 0xb3, 0xe2, 0xe3, 0xc5, 0x8b, 0x17,
+	0x2f, 0x5e, 0xbc, 0x60, 0x75, 0x5f, 0xc4, 0x63, 0x77, 0xf6, 0xd6, 0x2c, 0x0c, 0xe2, 0xc0, 0xae,
+	0xc4, 0xe7, 0x33, 0x11, 0xb5, 0xfe, 0x53, 0x81, 0xad, 0xed, 0x0b, 0x77, 0x22, 0x42, 0xbb, 0xc9,
+	0xd6, 0x3b, 0xa1, 0x70, 0x63, 0x31, 0x69, 0x16, 0xee, 0x16, 0x5e, 0x2f, 0x71, 0x45, 0xda, 0x77,
+	0xd9, 0x46, 0xcf, 0x9f, 0xcd, 0x63, 0x27, 0x98, 0x87, 0x63, 0xd1, 0x2c, 0xde, 0x2d, 0xbc, 0x5e,
+	0xe3, 0x3a, 0x64, 0xbf, 0xc2, 0xca, 0xa3, 0xf3, 0x99, 0x68, 0x96, 0xee, 0x16, 0x5e, 0xdf, 0xdc,
+	0xde, 0x78, 0x0b, 0x3f, 0xfe, 0x16, 0x40, 0x1c, 0x13, 0xe0, 0xe3, 0x47, 0x22, 0x8c, 0xbc, 0xc0,
+	0x6f, 0x96, 0xf1, 0x75, 0x45, 0xda, 0x6f, 0x30, 0xab, 0x13, 0xf8, 0xb1, 0xeb, 0xf9, 0xd1, 0xd0,
+	0x3d, 0x9f, 0x06, 0xee, 0x24, 0x6a, 0x56, 0xee, 0x16, 0x5e, 0xaf, 0xf2, 0x05, 0xdc, 0xbe, 0xce,
+	0xd6, 0x9c, 0xf1, 0xa9, 0x38, 0x73, 0x9b, 0x6b, 0x77, 0x0b, 0xaf, 0xd7, 0x39, 0x51, 0xf6, 0xab,
+	0xac, 0x21, 0x9f, 0x0e, 0x44, 0x14, 0xb9, 0x27, 0xa2, 0xb9, 0x8e, 0xff, 0x61, 0x82, 0xad, 0xbf,
+	0x56, 0x60, 0x95, 0x1d, 0x37, 0x1e, 0x9f, 0xda, 0xb7, 0x58, 0xb5, 0x33, 0xf5, 0x84, 0x1f, 0xf7,
+	0xba, 0x58, 0xd7, 0x1a, 0x4f, 0x68, 0xfb, 0x8b, 0x6c, 0x83, 0x5e, 0xc0, 0x1a, 0x15, 0x17, 0x6b,
+	0xa4, 0xa7, 0xdb, 0xb7, 0x59, 0x6d, 0x14, 0xc4, 0xee, 0xd4, 0xf1, 0x7e, 0x52, 0x56, 0xbf, 0xc2,
+	0x53, 0xc0, 0xb6, 0x59, 0xb9, 0xeb, 0xc6, 0x2e, 0xd6, 0xb9, 0xce, 0xf1, 0xf9, 0x45, 0x2a, 0xdc,
+	0xfa, 0xd3, 0x05, 0xd6, 0x18, 0xba, 0xe3, 0x27, 0x22, 0x86, 0x24, 0xf1, 0x3c, 0xb6, 0xaf, 0xb1,
+	0x8a, 0x13, 0x8e, 0x7b, 0x43, 0x2a, 0xb7, 0x24, 0x00, 0xed, 0x46, 0x71, 0x6f, 0x48, 0x7d, 0x23,
+	0x09, 0x68, 0x74, 0x27, 0x1c, 0x0f, 0x83, 0x30, 0xa6, 0x92, 0x29, 0x12, 0x52, 0xba, 0x51, 0x8c,
+	0x29, 0x65, 0x99, 0x42, 0xa4, 0xfd, 0x59, 0xb6, 0x36, 0x9a, 0xfb, 0xbe, 0x98, 0x62, 0x99, 0x36,
+	0xb6, 0x1b, 0xaa, 0xe6, 0x08, 0x72, 0x4a, 0x6c, 0xfd, 0xda, 0x15, 0xc6, 0x3a, 0x81, 0xef, 0x8b,
+	0x71, 0x0c, 0x9d, 0xf8, 0x1a, 0xdb, 0x1c, 0x79, 0x67, 0x22, 0x8a, 0xdd, 0xb3, 0xd9, 0x9e, 0x17,
+	0x46, 0x31, 0xb1, 0x50, 0x06, 0x85, 0xd6, 0xea, 0x7b, 0xfe, 0x93, 0x21, 0xb0, 0x20, 0x95, 0x35,
+	0x05, 0xec, 0x16, 0xab, 0x0f, 0x44, 0xfc, 0x2c, 0x08, 0x29, 0x43, 0x09, 0x33, 0x18, 0x18, 0xfe,
+	0x53, 0xe8, 0xfa, 0xd1, 0x2c, 0x08, 0x63, 0x99, 0x4b, 0xf2, 0x53, 0x06, 0x85, 0x56, 0x6e, 0xcf,
+	0x66, 0x53, 0x6f, 0xec, 0x42, 0x01, 0x65, 0xce, 0x0a, 0xe6, 0x5c, 0xc0, 0x91, 0xad, 0xc2, 0xf1,
+	0x41, 0xbb, 0x83, 0x6c, 0x55, 0xe3, 0x44, 0x01, 0xde, 0x8d, 0x62, 0xc0, 0x25, 0x3f, 0x11, 0x95,
+	0xf6, 0x41, 0x55, 0xef, 0x03, 0xad, 0xb5, 0x6b, 0x92, 0xc5, 0x89, 0x4c, 0x7b, 0x87, 0x65, 0x7a,
+	0x47, 0xf5, 0xc1, 0x86, 0xcc, 0x4f, 0xa4, 0xc9, 0x53, 0xf5, 0x2c, 0x4f, 0xbd, 0xc6, 0x36, 0xdb,
+	0xb3, 0x19, 0xb1, 0x08, 0x66, 0x69, 0x60, 0x96, 0x0c, 0x6a, 0xdf, 0x61, 0x6c, 0x30, 0x3f, 0x93,
+	0xdc, 0x13, 0x35, 0x37, 0x31, 0x8f, 0x86, 0xd8, 0x16, 0x2b, 0x3d, 0xec, 0x75, 0x9b, 0x5b, 0xf8,
+	0xdf, 0xf0, 0x08, 0xc3, 0x28, 0xe9, 0xaf, 0xbe, 0x1b, 0xc5, 0x4d, 0x0b, 0x3b, 0xd1, 0x04, 0x61,
+	0xf0, 0x74, 0xe7, 0x21, 0x36, 0x5f, 0xf3, 0x0a, 0x66, 0x48, 0x68, 0xfb, 0xcb, 0xec, 0xea, 0xce,
+	0x79, 0x2c, 0x22, 0x47, 0x84, 0x4f, 0x45, 0x38, 0x0a, 0xe4, 0xa8, 0x6a, 0xda, 0x98, 0x2d, 0x2f,
+	0x29, 0x79, 0x43, 0x92, 0xa3, 0x40, 0x26, 0x37, 0xaf, 0x6a, 0x6f, 0x98, 0x49, 0x20, 0x8d, 0x06,
+	0xf3, 0xb3, 0xbd, 0xde, 0x60, 0x6f, 0xea, 0x9e, 0x44, 0xcd, 0x6b, 0x58, 0x31, 0x1d, 0xa2, 0x1c,
+	0xdc, 0x19, 0xc9, 0x1c, 0x2f, 0x25, 0x39, 0x14, 0x44, 0x39, 0xda, 0x9d, 0xf7, 0x64, 0x8e, 0xeb,
+	0x49, 0x0e, 0x05, 0x51, 0x0e, 0xe7, 0xdb, 0xf4, 0x2f, 0x37, 0x92, 0x1c, 0x0a, 0xa2, 0x1c, 0x0f,
+	0xf9, 0x7d, 0x99, 0xa3, 0x99, 0xe4, 0x50, 0x10, 0xe5, 0xd8, 0xed, 0xec, 0xca, 0x1c, 0x37, 0x93,
+	0x1c, 0x0a, 0xa2, 0x1c, 0x43, 0x67, 0x5f, 0xe6, 0xb8, 0x95, 0xe4, 0x50, 0x10, 0xe5, 0xe8, 0x3c,
+	0xe2, 0x32, 0xc7, 0xcb, 0x49, 0x0e, 0x05, 0x51, 0x3f, 0x0f, 0x1c, 0x99, 0xe1, 0x76, 0xd2, 0xcf,
+	0x84, 0x00, 0xbf, 0x1c, 0x08, 0xd7, 0x7f, 0xe4, 0xf9, 0x93, 0xe0, 0x19, 0xf2, 0xcb, 0xa7, 0x25,
+	0xbf, 0x98, 0xa8, 0x36, 0xf2, 0xef, 0xac, 0x18, 0xf9, 0x50, 0xa0, 0x4e, 0x70, 0x76, 0x36, 0xf7,
+	0xbd, 0xf8, 0xbc, 0xd7, 0x6d, 0xbe, 0x22, 0x27, 0x03, 0x0d, 0x82, 0x61, 0xbc, 0xef, 0xfa, 0x93,
+	0xe8, 0xd4, 0x7d, 0x22, 0xf8, 0x68, 0xd4, 0xbc, 0x8b, 0x7d, 0x69, 0x60, 0x76, 0x97, 0x7d, 0x9a,
+	0x8b, 0x18, 0x86, 0xec, 0x99, 0x17, 0xc1, 0x3c, 0x90, 0x65, 0x80, 0xcf, 0x60, 0x19, 0x57, 0x67,
+	0xca, 0xf9, 0x4a, 0x86, 0xf1, 0x5a, 0xb9, 0x5f, 0xc9, 0xb0, 0xe0, 0x3b, 0xec, 0xa5, 0xee, 0x7c,
+	0xd6, 0xee, 0xbc, 0x97, 0x2d, 0xc3, 0x0f, 0xe1, 0xdb, 0xf9, 0x89, 0xda, 0x5b, 0x99, 0xff, 0x7c,
+	0xd5, 0x78, 0x2b, 0xf3, 0x5f, 0x5f, 0x63, 0xcd, 0xc3, 0x79, 0x7c, 0x78, 0x7c, 0x18, 0x4e, 0x44,
+	0x98, 0xf9, 0xbb, 0xcf, 0xe2, 0x8b, 0x4b, 0xd3, 0xcd, 0x77, 0x33, 0x7f, 0xfa, 0x5a, 0xf6, 0xdd,
+	0xcc, 0xff, 0x7e, 0x83, 0xdd, 0xfc, 0x8e, 0x08, 0x03, 0xd9, 0xdd, 0xd9, 0x7a, 0x7e, 0x0e, 0x5f,
+	0x5e, 0x9e, 0x21, 0xf3, 0x76, 0xe6, 0xaf, 0x5f, 0x5f, 0x78, 0x3b, 0xf3, 0xdf, 0x6f, 0xb2, 0x2b,
+	0xc4, 0x66, 0x63, 0x77, 0x2a, 0xe8, 0xad, 0xcf, 0xe3, 0x5b, 0x8b, 0x09, 0x99, 0xdc, 0x54, 0xc2,
+	0x37, 0x16, 0x72, 0x53, 0xc9, 0x6e, 0xb3, 0xda, 0x81, 0xe3, 0xd0, 0x37, 0xbf, 0x20, 0x45, 0x65,
+	0x02, 0x50, 0x2a, 0x7d, 0xe3, 0xcd, 0x24, 0x95, 0xde, 0x7d, 0x93, 0x5d, 0x19, 0x89, 0xf0, 0xcc,
+	0xf3, 0x51, 0x76, 0x71, 0xe1, 0x46, 0x81, 0xdf, 0xfc, 0x22, 0xf2, 0xf3, 0x62, 0x02, 0xf0, 0xbd,
+	0x33, 0xec, 0x8f, 0xfa, 0xc2, 0x3f, 0x89, 0x4f, 0xa3, 0xe6, 0x5b, 0x77, 0x4b, 0x30, 0x10, 0x35,
+	0x08, 0xb5, 0x90, 0x61, 0x7f, 0xd4, 0xf3, 0x63, 0x11, 0x3e, 0x75, 0xa7, 0x51, 0xf3, 0x4b, 0x77,
+	0x4b, 0x20, 0x3e, 0x0d, 0x10, 0x26, 0x26, 0x90, 0x6a, 0x5d, 0x2f, 0x8a, 0x43, 0xef, 0xf1, 0x1c,
+	0xc5, 0xe8, 0x97, 0xf1, 0x63, 0x0b, 0xb8, 0xfd, 0x2d, 0x76, 0x53, 0x4a, 0x6b, 0x18, 0xa0, 0xd9,
+	0x5e, 0x7b, 0x1b, 0x47, 0x69, 0x9d, 0x46, 0xa9, 0x13, 0xbb, 0x71, 0xc4, 0x97, 0x67, 0xcf, 0x7c,
+	0x2b, 0xd3, 0x87, 0xdb, 0x17, 0x7c, 0x2b, 0xd3, 0xa3, 0x47, 0xec, 0x15, 0xac, 0x50, 0x3b, 0x0c,
+	0xbd, 0xa7, 0xee, 0x14, 0xe7, 0x87, 0x4c, 0xe9, 0xee, 0xe5, 0x7c, 0xf1, 0xa2, 0x97, 0x72, 0xbf,
+	0x9b, 0x29, 0xe9, 0x3b, 0x97, 0xfa, 0xae, 0xf9, 0x52, 0xeb, 0x11, 0xab, 0x60, 0x4e, 0x98, 0xf3,
+	0x0e, 0x3c, 0x1f, 0x95, 0x93, 0x02, 0x87, 0x47, 0x44, 0xdc, 0xe7, 0xcd, 0x22, 0x21, 0xee, 0x73,
+	0xd0, 0xd9, 0x40, 0x32, 0xa2, 0xf6, 0x51, 0xe0, 0xf8, 0x8c, 0x1a, 0x42, 0x3c, 0xe9, 0x8a, 0xa7,
+	0xa8, 0x6d, 0x14, 0x38, 0x51, 0xad, 0xbf, 0x54, 0x50, 0x42, 0x13, 0x5e, 0x43, 0x85, 0x51, 0xea,
+	0x65, 0xf8, 0x0c, 0xa2, 0xf9, 0x70, 0x1e, 0x8b, 0x50, 0x6a, 0x0b, 0x52, 0xdf, 0xd1, 0x90, 0x24,
+	0x5d, 0x6a, 0x07, 0x25, 0x2d, 0x1d, 0x11, 0x28, 0xdc, 0xd1, 0xa0, 0x87, 0xff, 0xd9, 0xe0, 0xf0,
+	0x08, 0x05, 0xb9, 0xcf, 0x77, 0xdf, 0x13, 0xe7, 0xa8, 0xcc, 0x34, 0x38, 0x51, 0xc0, 0xe9, 0x8e,
+	0x40, 0xe9, 0xd6, 0xeb, 0xa2, 0x16, 0xd3, 0xe0, 0x29, 0xd0, 0xfa, 0x8d, 0x02, 0xab, 0xee, 0xc6,
+	0xa7, 0x22, 0xf4, 0x85, 0xd4, 0x2e, 0xd4, 0x84, 0x4e, 0x6a, 0x5a, 0x0a, 0x68, 0xba, 0x50, 0x71,
+	0x89, 0x2e, 0x54, 0x32, 0x74, 0xa1, 0x16, 0xab, 0xab, 0x2f, 0x63, 0xf5, 0xa5, 0x3a, 0x69, 0x60,
+	0x30, 0x03, 0x91, 0x62, 0xb2, 0xeb, 0xc7, 0x61, 0x30, 0x93, 0x85, 0x2f, 0xf0, 0x0c, 0x0a, 0x43,
+	0x4c, 0x57, 0x6b, 0xd6, 0xe4, 0x5c, 0xa7, 0x41, 0xad, 0xdf, 0x2b, 0xb2, 0x52, 0x9b, 0x0f, 0x2f,
+	0xa8, 0xc3, 0x2d, 0x56, 0x6d, 0x4f, 0x26, 0x61, 0xa2, 0xbf, 0x57, 0x78, 0x42, 0x43, 0x1a, 0x2a,
+	0x7d, 0xe3, 0x60, 0x4a, 0x4a, 0x71, 0x42, 0xc3, 0x00, 0xde, 0x7f, 0x06, 0x39, 0x45, 0x14, 0x61,
+	0x09, 0x64, 0x65, 0x4c, 0x10, 0x34, 0x16, 0xf5, 0x86, 0x9e, 0xb7, 0x82, 0x79, 0xf3, 0x92, 0xa0,
+	0xb4, 0x87, 0x33, 0x41, 0x2a, 0x93, 0xac, 0x55, 0x0a, 0x40, 0x0b, 0x3a, 0xe1, 0x38, 0xf9, 0x0f,
+	0xd2, 0x35, 0x0d, 0xcc, 0x7e, 0x8b, 0xd9, 0xa0, 0x4c, 0x9a, 0xdf, 0x26, 0xf5, 0x33, 0x27, 0x05,
+	0xbe, 0xd9, 0x8d, 0xe2, 0xf4, 0x9b, 0x52, 0x21, 0x35, 0x30, 0xf8, 0x26, 0x28, 0x9c, 0x99, 0x6f,
+	0x4a, 0x15, 0x35, 0x27, 0xa5, 0xf5, 0xcb, 0x05, 0x56, 0xe9, 0x06, 0xf1, 0xdb, 0x0f, 0x2e, 0x6e,
+	0xfd, 0x61, 0xe8, 0x05, 0xa1, 0x17, 0x9f, 0xab, 0xd6, 0x57, 0x34, 0x96, 0x2b, 0x0c, 0x66, 0xbb,
+	0x53, 0xef, 0xc4, 0x7b, 0x3c, 0x95, 0x0b, 0xa6, 0x2a, 0x37, 0x30, 0xe0, 0x96, 0xa3, 0x7e, 0x7b,
+	0xd0, 0x9b, 0x08, 0x3f, 0xf6, 0x8e, 0x3d, 0x11, 0x52, 0x37, 0x64, 0xd0, 0x64, 0xc0, 0xc9, 0x86,
+	0xc7, 0xe7, 0xd6, 0xdf, 0x2e, 0xc9, 0x32, 0xbe, 0x7d, 0x41, 0x19, 0xd5, 0xbb, 0xc5, 0xf4, 0x5d,
+	0xd0, 0xd2, 0xd3, 0x65, 0x47, 0x85, 0x4b, 0x02, 0x50, 0xa9, 0x58, 0xc9, 0x42, 0x54, 0x12, 0x9d,
+	0x4b, 0xe9, 0xbc, 0xbd, 0x2e, 0x95, 0x40, 0x43, 0x14, 0x07, 0x8a, 0x28, 0x7a, 0x9b, 0xd6, 0x14,
+	0x09, 0xad, 0xa5, 0x6d, 0x53, 0x5f, 0x27, 0xb4, 0x96, 0x76, 0x8f, 0x7a, 0x37, 0xa1, 0xb5, 0xb4,
+	0x77, 0xa8, 0x3f, 0x13, 0x1a, 0xda, 0xcc, 0x11, 0x1f, 0xce, 0x85, 0x3f, 0x16, 0x83, 0xf9, 0xd9,
+	0x63, 0x11, 0x62, 0x3f, 0x56, 0x78, 0x06, 0x85, 0x7c, 0x7b, 0xa1, 0x7b, 0x72, 0x26, 0xfc, 0x98,
+	0xf2, 0x6d, 0xc8, 0x7c, 0x26, 0x8a, 0x0b, 0xe4, 0x53, 0x31, 0x7e, 0x12, 0xcd, 0xcf, 0x70, 0x01,
+	0xd2, 0xe0, 0x09, 0x6d, 0x7f, 0x86, 0x95, 0x1e, 0x1c, 0x3a, 0xb8, 0xe8, 0xd8, 0xd8, 0xde, 0x22,
+	0x41, 0x8c, 0x8d, 0xfe, 0xe0, 0xd0, 0xe1, 0x90, 0x66, 0xdf, 0x63, 0xb5, 0xfd, 0x11, 0xac, 0x58,
+	0xc3, 0x60, 0x8a, 0x2b, 0x8f, 0x8d, 0xed, 0x97, 0xf4, 0x8c, 0x49, 0x22, 0x4f, 0xf3, 0xb5, 0x1e,
+	0xb3, 0xaa, 0xfa, 0x0a, 0x08, 0xbe, 0x11, 0xad, 0xcd, 0x2b, 0x1c, 0x1e, 0xa1, 0xc7, 0x76, 0x0f,
+	0x1d, 0x29, 0x44, 0xab, 0x1c, 0x9f, 0xa1, 0x8f, 0xdb, 0xe3, 0x27, 0xc3, 0x60, 0xea, 0x8d, 0xcf,
+	0xd5, 0xda, 0x3b, 0x01, 0xb0, 0x8f, 0xdf, 0x3f, 0x1c, 0x52, 0xc7, 0xe1, 0x73, 0xeb, 0xd7, 0x0a,
+	0x6c, 0xd3, 0x2c, 0x01, 0xb0, 0x64, 0xbb, 0xd3, 0x09, 0xfc, 0x28, 0x0e, 0x5d, 0xcf, 0x97, 0x0b,
+	0xd7, 0x2a, 0x37, 0x30, 0x10, 0x4c, 0xbc, 0x7b, 0xff, 0x20, 0x08, 0xc5, 0x70, 0xd8, 0x7d, 0x48,
+	0x65, 0xd0, 0x21, 0xfb, 0x0d, 0x56, 0x3a, 0xda, 0x1f, 0x61, 0x21, 0x36, 0xb6, 0x9b, 0xb9, 0x75,
+	0x3d, 0xda, 0x1f, 0x71, 0xc8, 0x64, 0x7f, 0x8e, 0x15, 0xf7, 0x47, 0x58, 0xac, 0x8d, 0xed, 0x1b,
+	0xb9, 0x59, 0xf7, 0x47, 0xbc, 0xb8, 0x3f, 0x6a, 0xfd, 0x66, 0x91, 0x5d, 0x59, 0xf8, 0x06, 0xce,
+	0x58, 0xfc, 0x01, 0x95, 0x13, 0x1e, 0xa1, 0x57, 0x1f, 0xfa, 0x11, 0xd4, 0xda, 0x8b, 0xc5, 0xe4,
+	0x60, 0x6f, 0x87, 0x4a, 0x98, 0x41, 0xf1, 0x4d, 0xa7, 0x47, 0x2d, 0x05, 0x8f, 0x50, 0x6c, 0xc8,
+	0x5e, 0x5e, 0x51, 0xec, 0x83, 0xbd, 0x1d, 0x0e, 0x99, 0x40, 0x3a, 0x76, 0x82, 0xb3, 0x19, 0x30,
+	0x9c, 0x98, 0xc0, 0x77, 0x24, 0xdb, 0x9b, 0x20, 0x72, 0xe2, 0x68, 0xa7, 0xd3, 0xf3, 0x27, 0xb4,
+	0xc4, 0x46, 0xfe, 0xaf, 0xf2, 0x0c, 0x8a, 0xb3, 0xec, 0x9e, 0xd3, 0xc3, 0x11, 0x50, 0xe1, 0xf8,
+	0x0c, 0xe5, 0xbb, 0xdf, 0xeb, 0x22, 0xe3, 0x57, 0x38, 0x3c, 0xc2, 0x38, 0xeb, 0x04, 0x13, 0xcf,
+	0x3f, 0xc1, 0xd1, 0x5a, 0xc3, 0x04, 0x0d, 0x41, 0x7e, 0x7e, 0x3c, 0x7a, 0x7f, 0x47, 0xb8, 0x67,
+	0xc7, 0x41, 0x78, 0x26, 0x26, 0xc8, 0xf7, 0x55, 0x9e, 0x41, 0x5b, 0xbf, 0x5a, 0x64, 0x56, 0xb6,
+	0x89, 0xed, 0x11, 0xbb, 0x06, 0xb6, 0x87, 0xf6, 0xc4, 0x9d, 0x61, 0x99, 0x28, 0x05, 0x5b, 0x76,
+	0x63, 0xfb, 0xae, 0xde, 0x1a, 0x79, 0xf9, 0x78, 0xee, 0xdb, 0x30, 0x3d, 0x74, 0xdc, 0xa9, 0xf7,
+	0x58, 0xca, 0x82, 0x61, 0x10, 0x79, 0xf0, 0x4b, 0x92, 0x26, 0x2f, 0x29, 0xf3, 0x86, 0x1a, 0xb1,
+	0xd4, 0x4d, 0x79, 0x49, 0xb8, 0x06, 0x73, 0x7a, 0x4e, 0x2c, 0x44, 0xe8, 0xf9, 0x27, 0xc4, 0xe1,
+	0x3a, 0x64, 0xbf, 0xce, 0xb6, 0x06, 0xdd, 0x61, 0xdb, 0xf7, 0x83, 0xb9, 0x3f, 0x16, 0x30, 0xb2,
+	0xc9, 0xc6, 0x94, 0x85, 0xa1, 0xd1, 0xbb, 0xbb, 0x3d, 0xea, 0x25, 0x78, 0x6c, 0x89, 0x2c, 0xd7,
+	0x41, 0xef, 0x5f, 0x67, 0x6b, 0xb0, 0xf8, 0x1d, 0x39, 0x34, 0x28, 0x89, 0x02, 0xfc, 0x68, 0x7f,
+	0x74, 0xd0, 0x71, 0xa8, 0x86, 0x44, 0xd9, 0x9b, 0xac, 0xb8, 0xf3, 0x88, 0xea, 0x50, 0xdc, 0x79,
+	0x04, 0x7f, 0xe3, 0x0c, 0x38, 0x15, 0x15, 0x1e, 0x5b, 0xbf, 0x54, 0x60, 0x37, 0x97, 0x36, 0x2e,
+	0x4a, 0x80, 0x94, 0xcb, 0x47, 0xfc, 0x81, 0xe2, 0xfb, 0x62, 0xca, 0xf7, 0x8b, 0xfc, 0xac, 0xb8,
+	0xaa, 0x6c, 0x72, 0x15, 0xf0, 0xf8, 0x1a, 0xe5, 0x42, 0x4e, 0x2e, 0xb7, 0x9d, 0xdd, 0x3e, 0x59,
+	0xb8, 0x2c, 0xbd, 0xa3, 0x01, 0xe7, 0x98, 0xda, 0xfa, 0x2a, 0xab, 0x25, 0x10, 0x1a, 0x47, 0x83,
+	0xb3, 0x33, 0xd7, 0x9f, 0x50, 0xfd, 0x15, 0x99, 0x98, 0xf8, 0x68, 0x2a, 0x81, 0xe7, 0xd6, 0xbf,
+	0x2e, 0x30, 0x1b, 0x6a, 0xd5, 0x77, 0xcf, 0x45, 0xd8, 0xf5, 0xa2, 0x71, 0xf0, 0x54, 0x84, 0xe7,
+	0x17, 0xcc, 0x49, 0xdb, 0xac, 0xd6, 0x39, 0x75, 0xa3, 0xc8, 0x8b, 0x7a, 0x5d, 0xfc, 0xda, 0xc6,
+	0xf6, 0x35, 0x2a, 0x5a, 0xbf, 0xdf, 0x1d, 0x26, 0x69, 0x3c, 0xcd, 0x66, 0x7f, 0x9e, 0xad, 0x81,
+	0xc5, 0xa8, 0xd7, 0x25, 0xc9, 0x73, 0x45, 0x7b, 0x41, 0x26, 0x70, 0xca, 0x80, 0x0d, 0x3a, 0xea,
+	0xab, 0x0e, 0x18, 0x8d, 0xfa, 0xf6, 0xbb, 0x6c, 0xed, 0xc8, 0x9d, 0xce, 0x05, 0x98, 0x1f, 0x4b,
+	0xaf, 0x6f, 0x6c, 0xdf, 0x51, 0x2f, 0x2f, 0x94, 0x1c, 0xb3, 0x71, 0xca, 0xdd, 0xfa, 0x2a, 0x6b,
+	0x18, 0x05, 0x42, 0xcb, 0xd7, 0xfc, 0x71, 0xac, 0xb4, 0xdf, 0x0a, 0x57, 0x24, 0x70, 0x01, 0x55,
+	0xa6, 0xce, 0x8b, 0xbd, 0x6e, 0xeb, 0x5d, 0xc6, 0xd2, 0xa2, 0xbd, 0xc0, 0x7b, 0x3f, 0xce, 0x6e,
+	0x2c, 0x29, 0x95, 0xa1, 0x77, 0xab, 0xa9, 0xfc, 0x3a, 0x5b, 0x93, 0x8b, 0x32, 0xc5, 0x94, 0x92,
+	0x82, 0xc9, 0x1c, 0x5f, 0xc2, 0xd6, 0xaa, 0x73, 0x49, 0xb4, 0x7a, 0x6c, 0x43, 0xa9, 0xab, 0x9d,
+	0xd1, 0x45, 0xba, 0x25, 0x28, 0xda, 0x4f, 0xbc, 0x59, 0x27, 0x98, 0xfb, 0x31, 0x7d, 0x3d, 0x05,
+	0x5a, 0x3f, 0x55, 0x60, 0x96, 0xf6, 0x2d, 0x2e, 0x66, 0xd3, 0xf3, 0x8b, 0xd5, 0xa5, 0xbd, 0xb9,
+	0x3f, 0xd6, 0x84, 0x44, 0x42, 0x83, 0xc8, 0xe5, 0x62, 0x2c, 0xbc, 0x99, 0x9a, 0xad, 0x25, 0xab,
+	0x9b, 0x60, 0x9e, 0x91, 0xb9, 0xf5, 0x27, 0x4b, 0xec, 0xfa, 0x62, 0x8b, 0xf5, 0xfc, 0xe3, 0xe0,
+	0x82, 0xe2, 0xbc, 0xce, 0xb6, 0xa0, 0x77, 0xba, 0x22, 0x1a, 0x87, 0xde, 0x2c, 0x29, 0x55, 0x8d,
+	0x67, 0x61, 0xec, 0xbd, 0xf3, 0x68, 0xe0, 0x9e, 0x09, 0x5a, 0x12, 0x28, 0x12, 0xe7, 0x80, 0xf3,
+	0x48, 0xff, 0x04, 0xd9, 0x68, 0x4d, 0xd4, 0xee, 0xb2, 0x2d, 0xe7, 0x3c, 0xea, 0xb8, 0x33, 0xf7,
+	0xb1, 0x37, 0xf5, 0x62, 0x4f, 0x44, 0x34, 0x24, 0x6f, 0x69, 0x6c, 0x9c, 0xc9, 0xc1, 0xb3, 0xaf,
+	0xd8, 0x5f, 0x61, 0x1b, 0x07, 0x27, 0x67, 0xb1, 0x52, 0x60, 0xd7, 0xf0, 0x0b, 0xd7, 0xb5, 0x2f,
+	0x68, 0xa9, 0x5c, 0xcf, 0x6a, 0xdf, 0x63, 0xeb, 0x87, 0xe1, 0xc9, 0xa8, 0x7f, 0x04, 0x4a, 0x37,
+	0x8c, 0x80, 0x9b, 0xda, 0x5b, 0x87, 0xe1, 0x89, 0x33, 0x13, 0x63, 0xef, 0xd8, 0x1b, 0x8f, 0xfa,
+	0x47, 0x5c, 0xe5, 0xb4, 0xbf, 0xc2, 0xd6, 0x1f, 0xfa, 0x4f, 0xfc, 0xe0, 0x99, 0xdf, 0xac, 0x5e,
+	0x6a, 0xd8, 0xa8, 0xec, 0xad, 0xef, 0x16, 0xd8, 0xd5, 0x9c, 0x1a, 0xd9, 0x3f, 0xcc, 0x6a, 0xce,
+	0x79, 0x14, 0x8b, 0xb3, 0x8e, 0x3b, 0x6b, 0x16, 0x0c, 0xb5, 0x00, 0xc7, 0x99, 0x5e, 0xfb, 0x34,
+	0xa7, 0xfd, 0x23, 0x8c, 0xed, 0xfa, 0xee, 0xe3, 0xa9, 0x98, 0xc0, 0x7b, 0xc5, 0xd5, 0xef, 0x69,
+	0x59, 0x5b, 0xbf, 0x58, 0x64, 0x56, 0x36, 0x03, 0x0c, 0x8d, 0x43, 0x60, 0x5c, 0x92, 0xb8, 0x92,
+	0x00, 0xe6, 0xe4, 0x62, 0x26, 0xdc, 0x58, 0x84, 0x24, 0x78, 0x13, 0x1a, 0x06, 0xd9, 0x4e, 0xe8,
+	0x4d, 0x4e, 0x94, 0x16, 0x4f, 0x14, 0xe0, 0x8f, 0xfa, 0xed, 0x41, 0x5b, 0x6a, 0x5e, 0x55, 0x4e,
+	0x14, 0xe0, 0x3c, 0x80, 0xb5, 0x2d, 0xcd, 0x44, 0x44, 0xa1, 0xde, 0x7d, 0x1a, 0xf8, 0x82, 0xa6,
+	0x20, 0x49, 0x40, 0xee, 0x6e, 0x30, 0x76, 0x3c, 0xb9, 0x1e, 0xaa, 0x72, 0xa2, 0xd0, 0x0c, 0x13,
+	0xe3, 0x4c, 0x71, 0xe8, 0x4f, 0xcf, 0x51, 0x57, 0xa8, 0x72, 0x1d, 0x82, 0xef, 0x75, 0x60, 0xa9,
+	0x80, 0xea, 0x42, 0x95, 0x4b, 0x02, 0x50, 0x07, 0x51, 0xa9, 0x20, 0x48, 0x02, 0x85, 0xc7, 0xc1,
+	0x90, 0xa3, 0x16, 0x5c, 0xe5, 0xf8, 0xdc, 0xfa, 0x2b, 0x05, 0xb6, 0x95, 0x61, 0x9b, 0x15, 0x92,
+	0xaa, 0xc9, 0xd6, 0x15, 0xe7, 0x49, 0x71, 0xa5, 0x48, 0x30, 0xf4, 0xa0, 0x5d, 0xe2, 0xd8, 0x1d,
+	0x0b, 0xf5, 0xb2, 0x1c, 0xbf, 0x0b, 0x38, 0x8c, 0xba, 0x04, 0xa3, 0xa1, 0x2e, 0x17, 0xfd, 0x59,
+	0x18, 0xc4, 0xf8, 0x21, 0x2d, 0x39, 0x6a, 0x1c, 0x1e, 0x5b, 0x23, 0x66, 0x2f, 0xf2, 0x2b, 0xe6,
+	0x7b, 0xd8, 0xc3, 0xd2, 0x36, 0x38, 0x3c, 0x52, 0x1d, 0xb4, 0x65, 0x8f, 0x22, 0xa1, 0x15, 0x40,
+	0x32, 0x90, 0x54, 0xc4, 0xe7, 0xd6, 0x5f, 0x28, 0xb3, 0x72, 0x6f, 0xf8, 0xf4, 0x9d, 0x0b, 0xc4,
+	0x85, 0xb6, 0xaf, 0x47, 0x1f, 0x25, 0x12, 0x0a, 0xd0, 0xdb, 0xef, 0xab, 0xc9, 0xb9, 0xb7, 0xdf,
+	0x07, 0x64, 0x74, 0xe8, 0x24, 0x33, 0xd0, 0xa1, 0xa3, 0xc9, 0xe9, 0x8a, 0x21, 0xa7, 0x41, 0xfc,
+	0x4f, 0x68, 0xc6, 0x2e, 0xf6, 0x26, 0xe9, 0x22, 0x6c, 0x3d, 0xb3, 0x08, 0x83, 0x65, 0xcb, 0xe1,
+	0xf1, 0x71, 0x24, 0x62, 0xd2, 0x1a, 0x35, 0x44, 0xcd, 0x78, 0xb5, 0x74, 0xc6, 0xd3, 0x17, 0xff,
+	0x2c, 0xb3, 0xf8, 0xd7, 0x97, 0x3c, 0x72, 0x51, 0x94, 0xd0, 0xe9, 0x86, 0x4f, 0x3d, 0x77, 0xd3,
+	0xad, 0x91, 0xd9, 0xd6, 0x19, 0xba, 0x13, 0xd0, 0x50, 0x71, 0xe5, 0x53, 0xe7, 0x8a, 0xb4, 0xbf,
+	0xc0, 0xd6, 0x0f, 0x51, 0xf0, 0x45, 0xcd, 0xad, 0xbb, 0x25, 0x6d, 0xb6, 0x86, 0x76, 0x96, 0x29,
+	0x5c, 0xe5, 0xc8, 0xb1, 0x99, 0x58, 0x97, 0xb1, 0x99, 0x5c, 0x59, 0xb0, 0x99, 0xe8, 0xfb, 0x52,
+	0xf6, 0xd2, 0x5d, 0xc0, 0xab, 0xcb, 0x76, 0x01, 0xaf, 0xad, 0xda, 0x05, 0x9c, 0x31, 0x96, 0x96,
+	0x1d, 0xad, 0x59, 0xf8, 0xa4, 0xcd, 0xc7, 0x1a, 0x02, 0x2b, 0x2d, 0x49, 0x19, 0x73, 0xb3, 0x81,
+	0xa5, 0xdf, 0xc0, 0x19, 0x4d, 0x32, 0xa4, 0x86, 0xb4, 0xfe, 0x65, 0x09, 0xd9, 0xf2, 0xdd, 0x8f,
+	0xcc, 0x96, 0x2d, 0x56, 0x1f, 0x85, 0xee, 0xf1, 0xb1, 0x37, 0xee, 0x4c, 0xdd, 0x28, 0x22, 0xfe,
+	0x34, 0x30, 0xf8, 0xf6, 0xde, 0x34, 0x78, 0xd6, 0x77, 0x1f, 0x8b, 0x29, 0x8d, 0xc3, 0x14, 0x58,
+	0xca, 0xb4, 0xb0, 0x0f, 0x23, 0x9e, 0xc7, 0x72, 0x37, 0x9d, 0x98, 0x57, 0x43, 0x80, 0xc1, 0xf6,
+	0x83, 0x59, 0xdf, 0x3b, 0xf3, 0x62, 0xe2, 0xe3, 0x84, 0x5e, 0xb2, 0xa3, 0x98, 0x30, 0x58, 0x4d,
+	0x67, 0xb0, 0x45, 0xce, 0x60, 0x97, 0xe1, 0x8c, 0x8d, 0x45, 0xce, 0xf8, 0x12, 0x96, 0x68, 0xe7,
+	0x7c, 0x3f, 0x98, 0x21, 0x67, 0x6f, 0x6c, 0x5f, 0x4d, 0x39, 0xf2, 0x5d, 0x95, 0xc4, 0x93, 0x4c,
+	0x3a, 0x2b, 0x35, 0x96, 0xb2, 0xd2, 0xe6, 0x32, 0x56, 0xda, 0x5a, 0xc5, 0x4a, 0xdf, 0x2d, 0xb1,
+	0x3a, 0xfc, 0xab, 0x32, 0x44, 0x5c, 0xd0, 0xc1, 0x66, 0x63, 0x17, 0x17, 0x1a, 0xfb, 0x36, 0xab,
+	0x71, 0x11, 0x81, 0x15, 0x78, 0xf2, 0xb6, 0x32, 0x0d, 0x24, 0x80, 0x6e, 0x06, 0x21, 0xe9, 0x51,
+	0x36, 0xcd, 0x20, 0x12, 0xd5, 0xbf, 0xb2, 0x4d, 0xbd, 0x9d, 0x02, 0xa0, 0x9d, 0xc1, 0xfa, 0x5f,
+	0xbd, 0x13, 0xd1, 0x04, 0x66, 0x82, 0xf0, 0x5f, 0xca, 0x68, 0x45, 0x0b, 0xe2, 0x75, 0xe4, 0xa8,
+	0x0c, 0xaa, 0xb7, 0x6d, 0x75, 0x69, 0xdb, 0xd6, 0xcc, 0xb6, 0x4d, 0xd8, 0x86, 0xe5, 0xb2, 0xcd,
+	0x86, 0xce, 0x36, 0x69, 0x3f, 0xd4, 0x57, 0xf5, 0xc3, 0xbf, 0x28, 0xb0, 0xb5, 0x5e, 0xe7, 0xe0,
+	0x62, 0xc9, 0x7f, 0x8b, 0x55, 0x61, 0x54, 0x77, 0x82, 0x49, 0x62, 0x64, 0x55, 0xb4, 0x21, 0x4b,
+	0x4b, 0x19, 0x59, 0x2a, 0x65, 0x7b, 0x39, 0x91, 0xed, 0xb0, 0x30, 0x14, 0x1f, 0x52, 0xeb, 0xc2,
+	0x63, 0x5a, 0xab, 0xb5, 0xdc, 0x5a, 0xad, 0xe7, 0xd7, 0xaa, 0xba, 0xaa, 0x56, 0x7f, 0x5d, 0xd5,
+	0xea, 0xdd, 0x4f, 0xa8, 0x56, 0x49, 0x99, 0xcb, 0xb9, 0x65, 0xae, 0xe4, 0x97, 0x79, 0x6d, 0x55,
+	0x99, 0x7f, 0xaf, 0xc0, 0x5e, 0x96, 0x65, 0x1e, 0x08, 0xef, 0xe4, 0xf4, 0x71, 0x10, 0xb6, 0x27,
+	0x4f, 0x45, 0x18, 0x7b, 0x91, 0xb8, 0xc4, 0x00, 0x49, 0xa6, 0xcc, 0xa2, 0x3e, 0x65, 0xc2, 0x0e,
+	0xbf, 0x1b, 0x9e, 0x88, 0x44, 0x5b, 0x96, 0x9a, 0xbb, 0x09, 0xda, 0x5f, 0x4c, 0x27, 0xaa, 0xf2,
+	0xdd, 0x92, 0x2e, 0x16, 0xb0, 0x38, 0xd9, 0xa9, 0x2a, 0xa9, 0x7b, 0x25, 0xb7, 0xee, 0x6b, 0xf9,
+	0x75, 0x5f, 0x5f, 0x55, 0xf7, 0x7f, 0x5b, 0x64, 0x37, 0xe5, 0x9f, 0x49, 0x25, 0xf1, 0x45, 0x6a,
+	0xae, 0xcb, 0xd9, 0xe2, 0xa2, 0x9c, 0x95, 0xad, 0x52, 0xd2, 0x5b, 0xe5, 0x35, 0xb6, 0x29, 0xff,
+	0xa6, 0xef, 0x1d, 0x8b, 0xd8, 0x3b, 0x53, 0x86, 0xff, 0x0c, 0x2a, 0x97, 0x63, 0xee, 0xf8, 0x14,
+	0x34, 0x69, 0xf8, 0x3f, 0xda, 0x83, 0x31, 0x41, 0x98, 0x61, 0x68, 0xbf, 0x19, 0xc8, 0x90, 0x76,
+	0x63, 0x0c, 0x4c, 0x6f, 0xe1, 0xf5, 0x17, 0x69, 0xe1, 0x4b, 0x4c, 0x0f, 0x69, 0x0b, 0xb3, 0x55,
+	0x2d, 0xfc, 0x2e, 0xab, 0xeb, 0xff, 0x95, 0xbb, 0x8c, 0xd6, 0x4d, 0x1b, 0x6a, 0x61, 0xf9, 0x3b,
+	0x45, 0x56, 0x7a, 0xd8, 0x1d, 0x5e, 0x3c, 0xff, 0x2a, 0x61, 0x56, 0x5c, 0x2a, 0xcc, 0x4a, 0xa6,
+	0x30, 0x4b, 0xe7, 0xd5, 0xb2, 0x31, 0xaf, 0xea, 0xc3, 0xae, 0x92, 0x19, 0x76, 0x8b, 0x73, 0xe1,
+	0xda, 0x65, 0xe6, 0xc2, 0xf5, 0x5c, 0x2d, 0x89, 0xc8, 0x66, 0x55, 0xa9, 0x6d, 0x48, 0xa6, 0x8d,
+	0x5f, 0xcb, 0x6d, 0x7c, 0xc3, 0xa7, 0x27, 0xe3, 0x1c, 0xb1, 0xb1, 0xe8, 0x1c, 0x71, 0x49, 0x31,
+	0xfc, 0x37, 0x2a, 0xac, 0x34, 0xea, 0x7c, 0x42, 0xcd, 0xec, 0x88, 0x0f, 0x07, 0xf3, 0x33, 0xd2,
+	0x6c, 0x88, 0x02, 0xbc, 0x3d, 0x7e, 0x32, 0xa0, 0x46, 0x6e, 0x70, 0xa2, 0x70, 0xab, 0xc3, 0x8d,
+	0x5d, 0x9a, 0x27, 0x49, 0xad, 0x49, 0x11, 0x90, 0xdf, 0x7b, 0xbd, 0x01, 0xad, 0xd2, 0xe0, 0x11,
+	0x10, 0xe7, 0xdb, 0x03, 0x5a, 0x9a, 0xc1, 0x23, 0x20, 0xdc, 0x19, 0xd1, 0x82, 0x0c, 0x1e, 0x01,
+	0x19, 0x3a, 0xfb, 0xb4, 0x18, 0x83, 0x47, 0x40, 0xda, 0x9d, 0xf7, 0x68, 0x25, 0x06, 0x8f, 0x80,
+	0x3c, 0xe4, 0xf7, 0xb1, 0x9d, 0xaa, 0x1c, 0x1e, 0x01, 0xd9, 0xed, 0xec, 0xa2, 0xee, 0x51, 0xe5,
+	0xf0, 0x08, 0x48, 0xe7, 0x11, 0x47, 0x9d, 0xa3, 0xca, 0xe1, 0x11, 0xe6, 0x97, 0x81, 0x83, 0xba,
+	0x46, 0x95, 0x17, 0x07, 0xb8, 0xc6, 0x90, 0x6e, 0x03, 0xa8, 0x40, 0x57, 0x38, 0x51, 0x06, 0x5b,
+	0x5d, 0xc9, 0xb0, 0xd5, 0x75, 0xb6, 0xf6, 0x30, 0x3c, 0x51, 0x9e, 0x4b, 0x15, 0x4e, 0x94, 0xae,
+	0xdb, 0x5f, 0x35, 0x75, 0xfb, 0x37, 0xd2, 0x01, 0x7d, 0xed, 0x6e, 0x49, 0xb3, 0x2a, 0x8e, 0x3a,
+	0xc3, 0x8b, 0x55, 0xfb, 0x97, 0x2e, 0xc3, 0xb4, 0xd7, 0x57, 0x32, 0xed, 0x8d, 0x25, 0x4c, 0xdb,
+	0xcc, 0x65, 0xda, 0x9b, 0x2b, 0x98, 0xf6, 0xd6, 0x2a, 0xa6, 0x7d, 0x79, 0x15, 0xd3, 0x06, 0xac,
+	0x96, 0x54, 0xf7, 0xff, 0xca, 0x6a, 0xe0, 0x77, 0x0b, 0xac, 0xec, 0x74, 0x46, 0x9f, 0xc4, 0x30,
+	0x79, 0x9d, 0x6d, 0x1d, 0x89, 0x30, 0x51, 0xcf, 0x46, 0xee, 0x89, 0x5a, 0x91, 0x67, 0xe0, 0x05,
+	0xf9, 0xd4, 0xc8, 0x53, 0x0b, 0x3e, 0x3e, 0x55, 0xe6, 0xa7, 0xd6, 0xd9, 0x16, 0xd4, 0xb9, 0x1d,
+	0x45, 0xc1, 0xd8, 0x73, 0x5f, 0xc8, 0xfd, 0x72, 0xc1, 0xc1, 0xaf, 0x78, 0x91, 0x83, 0x5f, 0x29,
+	0xe3, 0xe0, 0x47, 0x4e, 0x83, 0xe5, 0xd4, 0x69, 0x30, 0x7f, 0xf6, 0xd7, 0x9a, 0x7c, 0xcd, 0x6c,
+	0xf2, 0xfc, 0xca, 0x6b, 0x1d, 0x51, 0x35, 0x3b, 0x42, 0x6e, 0x7d, 0x67, 0xfb, 0xa2, 0x86, 0x0d,
+	0x9d, 0x93, 0x42, 0xdb, 0xda, 0xd9, 0xfc, 0x4c, 0xe6, 0x5f, 0x4c, 0xc9, 0xb8, 0x49, 0x6e, 0x2c,
+	0xb8, 0x49, 0xde, 0x66, 0x35, 0xf0, 0xb6, 0x3b, 0x9d, 0xfb, 0x4f, 0x22, 0xe5, 0x8c, 0x99, 0x00,
+	0xd0, 0xa2, 0x83, 0xf9, 0x19, 0x30, 0x23, 0xe5, 0x90, 0xeb, 0x22, 0x13, 0x44, 0xc7, 0xd6, 0xf9,
+	0x59, 0xba, 0x50, 0x90, 0x4b, 0x24, 0x03, 0x23, 0x47, 0x3f, 0x72, 0x2d, 0x8e, 0x9a, 0x5b, 0x89,
+	0xa3, 0x9f, 0x82, 0xa8, 0xa4, 0x4e, 0x1c, 0x0a, 0xf7, 0x2c, 0x22, 0x69, 0xa6, 0x21, 0x54, 0x96,
+	0xd4, 0x05, 0x8c, 0xc4, 0x9a, 0x09, 0xaa, 0x12, 0xcf, 0xa5, 0x0f, 0xac, 0x88, 0x48, 0xc4, 0x99,
+	0x20, 0xe5, 0xea, 0xf9, 0xe3, 0xe0, 0x6c, 0x36, 0x15, 0xb1, 0x20, 0x03, 0x81, 0x09, 0x2e, 0x73,
+	0xde, 0xbc, 0xb6, 0xdc, 0x79, 0x73, 0x89, 0x83, 0xe8, 0x4b, 0xcb, 0x1d, 0x44, 0xdf, 0x60, 0x16,
+	0x09, 0x35, 0x65, 0xaa, 0x01, 0x7f, 0xcd, 0x12, 0x38, 0xf2, 0x66, 0x71, 0x69, 0xc8, 0xf2, 0x62,
+	0x14, 0x86, 0x55, 0x8e, 0xcf, 0xc0, 0xcd, 0xce, 0xe9, 0x3c, 0x9e, 0x80, 0xc1, 0xb6, 0x89, 0x78,
+	0x42, 0x03, 0x2f, 0xb6, 0x1f, 0x03, 0xcf, 0xdd, 0xc4, 0x04, 0x49, 0x68, 0x03, 0xf1, 0xd6, 0xaa,
+	0x81, 0xf8, 0xf7, 0x2a, 0xac, 0xd4, 0x1d, 0x38, 0x17, 0xc8, 0x9e, 0x74, 0x27, 0x03, 0x96, 0x3b,
+	0x5d, 0xa0, 0x1f, 0x70, 0xb2, 0x98, 0x16, 0x1f, 0x70, 0x98, 0x6a, 0x0e, 0x67, 0xb8, 0xdc, 0x20,
+	0xad, 0x47, 0x52, 0x90, 0xaf, 0xdd, 0x26, 0x4b, 0x69, 0xb1, 0xdd, 0x06, 0x7a, 0xd4, 0xa1, 0x15,
+	0x66, 0x71, 0xd4, 0x01, 0x9a, 0x77, 0x69, 0xd6, 0x2d, 0x72, 0xfc, 0x2e, 0x6f, 0xd3, 0x9c, 0x5b,
+	0xe4, 0x6d, 0xbb, 0xce, 0x0a, 0xdf, 0xa1, 0xe5, 0x62, 0xe1, 0x3b, 0x52, 0x27, 0x8d, 0x66, 0x81,
+	0x1f, 0xc9, 0xa5, 0x8d, 0x34, 0x7e, 0x19, 0x18, 0x0c, 0xc1, 0x07, 0x5d, 0xb9, 0xaf, 0x21, 0x47,
+	0x81, 0x22, 0x21, 0xa5, 0x3d, 0x90, 0x29, 0x72, 0x00, 0x28, 0x12, 0x52, 0x06, 0x8e, 0x4c, 0x21,
+	0x83, 0xc0, 0xc0, 0x49, 0x52, 0xda, 0x5c, 0xa6, 0x90, 0x41, 0x80, 0x48, 0xfb, 0xcb, 0xac, 0xf6,
+	0x60, 0x2e, 0x22, 0xdd, 0x10, 0x66, 0xab, 0x2d, 0xb8, 0x81, 0xa3, 0x92, 0x78, 0x9a, 0xc9, 0xde,
+	0x66, 0xeb, 0x6d, 0x3f, 0x7a, 0x26, 0x42, 0xe0, 0xfa, 0x92, 0xbe, 0x53, 0x3d, 0x70, 0xb8, 0x88,
+	0xf0, 0x08, 0x02, 0x17, 0xe3, 0x20, 0x9c, 0x70, 0x95, 0xd1, 0xfe, 0x1a, 0xdb, 0x68, 0xcf, 0xe3,
+	0xd3, 0x20, 0x94, 0xfb, 0x0a, 0x57, 0x2e, 0x78, 0x4f, 0xcf, 0x8c, 0xef, 0x4e, 0x26, 0xb8, 0x39,
+	0x0b, 0x6e, 0x7c, 0xf6, 0x85, 0xef, 0xa6, 0x99, 0x53, 0x71, 0x78, 0x35, 0x57, 0xe2, 0x5f, 0x5b,
+	0xe2, 0x9f, 0xff, 0xd2, 0xd2, 0x79, 0xe9, 0xba, 0x29, 0x0e, 0x33, 0x93, 0xf5, 0x8d, 0x55, 0x93,
+	0x75, 0x73, 0xe5, 0x42, 0x1f, 0x9c, 0x0b, 0xb2, 0x75, 0x81, 0x11, 0x84, 0x3b, 0x3a, 0xe4, 0xc5,
+	0x06, 0xcf, 0xcb, 0x9c, 0x65, 0x74, 0xfb, 0x99, 0x24, 0xf4, 0x3d, 0xc6, 0x86, 0xb4, 0xb8, 0x92,
+	0xf6, 0x68, 0x18, 0xcc, 0x34, 0x24, 0x59, 0x62, 0xac, 0x69, 0x07, 0x24, 0x60, 0xc8, 0xa8, 0xe9,
+	0xa1, 0xd8, 0x1b, 0x92, 0x46, 0x27, 0xb5, 0x72, 0xd0, 0xe8, 0xe0, 0xbf, 0x07, 0xed, 0x83, 0x5d,
+	0x64, 0xef, 0x3a, 0x97, 0x04, 0x6a, 0x94, 0x23, 0x8e, 0x9c, 0x5d, 0xe7, 0xf0, 0x68, 0xbf, 0xc2,
+	0x4a, 0xce, 0x61, 0xbb, 0xb9, 0x61, 0xb4, 0x42, 0x77, 0xe0, 0x38, 0x87, 0x6d, 0x0e, 0x29, 0x98,
+	0x81, 0x1f, 0x35, 0xeb, 0x0b, 0x19, 0xf8, 0x11, 0x87, 0x14, 0xfb, 0x36, 0x2b, 0x1e, 0xbc, 0xdf,
+	0x6c, 0x18, 0x2e, 0x87, 0xdd, 0x81, 0x73, 0xf0, 0x3e, 0x2f, 0x1e, 0xbc, 0x2f, 0x1d, 0x4c, 0x46,
+	0x20, 0xcd, 0x4b, 0x50, 0x76, 0x78, 0x6e, 0xfd, 0xd5, 0x02, 0x5b, 0x93, 0x7f, 0x01, 0xc5, 0x3c,
+	0x48, 0xda, 0xb2, 0xce, 0x25, 0x01, 0x28, 0x47, 0x54, 0x2e, 0xaa, 0x24, 0x21, 0x95, 0xf2, 0xd0,
+	0x73, 0xa5, 0x4f, 0x5a, 0x83, 0x13, 0x05, 0x7c, 0xc0, 0xc5, 0x71, 0x28, 0xa2, 0x53, 0x6a, 0x54,
+	0x45, 0xe2, 0x77, 0x44, 0x1c, 0x2a, 0x3f, 0x40, 0x49, 0xc0, 0x77, 0x76, 0x9f, 0xcf, 0xbc, 0x50,
+	0xd0, 0xaa, 0x93, 0x28, 0xf8, 0xce, 0x81, 0xe7, 0x7b, 0x67, 0xf3, 0x33, 0xb2, 0x3e, 0x29, 0xb2,
+	0x35, 0x91, 0xe5, 0xe5, 0x47, 0x86, 0xdf, 0x56, 0x21, 0xe3, 0xb7, 0x05, 0x4a, 0x34, 0x18, 0x21,
+	0x94, 0x02, 0x45, 0x14, 0x34, 0x81, 0xa6, 0x3c, 0xe1, 0x73, 0xc2, 0x42, 0xb4, 0x1d, 0x09, 0xcf,
+	0xad, 0xaf, 0xb3, 0x0a, 0xb6, 0x1b, 0xf0, 0xc3, 0x30, 0x14, 0xc7, 0x22, 0x44, 0x17, 0x07, 0xf9,
+	0x37, 0x1a, 0x92, 0xbc, 0x5c, 0x4c, 0xf9, 0xaf, 0xf5, 0x1e, 0xdb, 0xd0, 0x04, 0xc3, 0xf7, 0xc7,
+	0xa2, 0xad, 0x3f, 0x5f, 0x61, 0x6b, 0xdd, 0xfd, 0xce, 0xc5, 0xf6, 0x2d, 0xc3, 0x69, 0xaf, 0x98,
+	0xe3, 0xb4, 0xb7, 0xef, 0x86, 0x93, 0x67, 0x6e, 0x28, 0x46, 0xe9, 0xc6, 0x8e, 0x81, 0xc1, 0x50,
+	0x55, 0x74, 0x5f, 0xf8, 0xca, 0x4b, 0x43, 0x83, 0xf4, 0xaf, 0x1c, 0xce, 0xe2, 0x88, 0xc6, 0x87,
+	0x81, 0x01, 0x5f, 0xbf, 0xef, 0x4d, 0xa8, 0x3f, 0xe1, 0x11, 0x2a, 0xeb, 0x88, 0xb1, 0xda, 0x0c,
+	0xc1, 0xe7, 0xd4, 0xb0, 0x51, 0xd5, 0x0d, 0x1b, 0xe9, 0x39, 0x27, 0xb5, 0x7a, 0x4d, 0x68, 0xf8,
+	0xef, 0x6f, 0x07, 0xf3, 0x30, 0x49, 0x97, 0xeb, 0x58, 0x03, 0x93, 0x07, 0x72, 0x9e, 0xc7, 0x72,
+	0x46, 0x4e, 0x0c, 0x8a, 0x06, 0x26, 0xa7, 0x96, 0xa9, 0x7b, 0xde, 0x3e, 0x91, 0xdf, 0x91, 0x5b,
+	0x24, 0x06, 0x06, 0x79, 0xe4, 0x37, 0xf7, 0x1f, 0x81, 0x8d, 0x89, 0x36, 0x4c, 0x0c, 0x0c, 0x38,
+	0x43, 0x7e, 0x13, 0x3b, 0x57, 0x6e, 0x9d, 0x68, 0x08, 0xd4, 0x7a, 0xcf, 0x9b, 0x0a, 0x54, 0x8c,
+	0xea, 0x1c, 0x9f, 0xf5, 0x1d, 0x15, 0xcb, 0xd8, 0x51, 0x81, 0x1e, 0xce, 0x2e, 0xbb, 0xee, 0xb2,
+	0x8d, 0x3d, 0xcf, 0x3f, 0x11, 0xe1, 0x2c, 0xf4, 0xfc, 0x18, 0x95, 0xa3, 0x1a, 0xd7, 0xa1, 0x54,
+	0x76, 0xdb, 0xb9, 0xb2, 0xfb, 0xea, 0x12, 0xd9, 0x7d, 0x6d, 0xa9, 0xec, 0x7e, 0x69, 0x99, 0x29,
+	0xfc, 0xfa, 0x2a, 0xc9, 0xdc, 0x67, 0x2c, 0x2d, 0xff, 0x0b, 0xf9, 0x37, 0x28, 0x69, 0x2a, 0xad,
+	0x7a, 0xf8, 0xdc, 0xfa, 0xb9, 0x12, 0x31, 0xfc, 0x25, 0xf6, 0x4c, 0x0e, 0xa2, 0x13, 0x7d, 0x7f,
+	0x90, 0x48, 0xb2, 0xa8, 0xc9, 0xc9, 0xbc, 0x94, 0x58, 0xd4, 0x90, 0x86, 0x34, 0xe9, 0xbf, 0x33,
+	0x09, 0x69, 0x55, 0x90, 0xd0, 0x90, 0x36, 0x14, 0x60, 0xbc, 0x9b, 0x84, 0xb4, 0x3a, 0x48, 0x68,
+	0x5c, 0x8a, 0x80, 0x3d, 0xcc, 0x1d, 0xc7, 0xa9, 0xd3, 0x72, 0x9d, 0x9b, 0xe0, 0x72, 0x3b, 0x99,
+	0xac, 0xd1, 0x05, 0x5d, 0x5c, 0x5d, 0xd1, 0xc5, 0x97, 0x30, 0xe6, 0x68, 0x5d, 0xbc, 0xb1, 0xb4,
+	0x8b, 0xeb, 0xcb, 0xba, 0xb8, 0xb1, 0xaa, 0x8b, 0x07, 0xac, 0xae, 0xd7, 0x00, 0x3a, 0x0e, 0xf5,
+	0x32, 0xea, 0x64, 0x78, 0x7e, 0xa1, 0x4e, 0xfe, 0x6e, 0x81, 0x95, 0xfa, 0xfd, 0xce, 0xc5, 0x5e,
+	0xaf, 0x5d, 0xa7, 0x3d, 0x4c, 0x5c, 0x95, 0x9c, 0x36, 0x4e, 0xae, 0xbd, 0xfb, 0x4a, 0x1f, 0xed,
+	0xdd, 0x47, 0xe1, 0xe2, 0xb4, 0x13, 0xaf, 0x49, 0x87, 0xf2, 0x74, 0xb8, 0xd2, 0x45, 0x3b, 0x5c,
+	0x3a, 0x43, 0x49, 0x5f, 0xb9, 0x35, 0xe5, 0x0c, 0x85, 0x64, 0xeb, 0x67, 0x2a, 0xac, 0x34, 0xb8,
+	0x70, 0x3d, 0xfe, 0x2a, 0x6b, 0xf4, 0x85, 0x3b, 0x23, 0x6f, 0xc0, 0x40, 0xed, 0xdf, 0x98, 0xa0,
+	0xbe, 0x87, 0x57, 0x32, 0xf7, 0xf0, 0xc0, 0xcb, 0x2b, 0xd5, 0x98, 0xf1, 0x19, 0x3b, 0x2b, 0x0e,
+	0xdd, 0x38, 0x31, 0x12, 0x2a, 0x52, 0xce, 0x51, 0x53, 0x55, 0x54, 0x7c, 0x86, 0xf2, 0x0d, 0x43,
+	0x31, 0xf6, 0x22, 0xb5, 0x1f, 0x53, 0xe1, 0x29, 0x00, 0xa9, 0x3c, 0x08, 0xe2, 0x2e, 0x88, 0x30,
+	0x64, 0xa2, 0x06, 0x4f, 0x01, 0x69, 0x2d, 0x0e, 0xe2, 0xae, 0x17, 0xcd, 0xa8, 0x78, 0x72, 0x31,
+	0x9a, 0x41, 0xd1, 0x69, 0x54, 0xcd, 0x6b, 0xbd, 0x2e, 0xad, 0x40, 0x75, 0x08, 0x96, 0xaa, 0x09,
+	0x99, 0x36, 0x17, 0xf0, 0x5a, 0x99, 0xe7, 0xa4, 0x80, 0x4d, 0xe2, 0x30, 0xf4, 0x4e, 0x3c, 0x3f,
+	0xcd, 0x5c, 0xc7, 0xcc, 0x59, 0x18, 0x16, 0x4d, 0xe8, 0x23, 0xf4, 0x54, 0xfb, 0x6e, 0x03, 0xb3,
+	0x2e, 0xe0, 0x78, 0x0c, 0x46, 0x9e, 0x8e, 0x8a, 0xd3, 0xcc, 0x9b, 0x98, 0x79, 0x31, 0x01, 0x6a,
+	0xbf, 0xfb, 0x3c, 0x16, 0x3e, 0x54, 0x11, 0x97, 0x6b, 0x24, 0x90, 0x33, 0x68, 0x3a, 0xd0, 0xac,
+	0xdc, 0x81, 0x76, 0x65, 0xc9, 0x40, 0xfb, 0xb8, 0x77, 0xa8, 0xbf, 0x57, 0x64, 0x25, 0xa7, 0x37,
+	0xfc, 0xc8, 0xdb, 0xc5, 0xd7, 0xd9, 0xda, 0x81, 0x88, 0x4f, 0x83, 0x09, 0xf1, 0x20, 0x51, 0xf0,
+	0x86, 0xdc, 0x69, 0x94, 0x5b, 0x24, 0x35, 0xae, 0x48, 0x98, 0xc7, 0x7a, 0x91, 0x5a, 0x58, 0xd1,
+	0xa0, 0xd1, 0x90, 0x85, 0xa5, 0xd8, 0x5a, 0xce, 0x52, 0x0c, 0x58, 0x8c, 0x68, 0x27, 0x76, 0xe3,
+	0xb9, 0x3a, 0x14, 0x90, 0x41, 0x5f, 0x68, 0x5f, 0x40, 0x6b, 0x64, 0xb6, 0xb4, 0x91, 0x37, 0x96,
+	0x35, 0xf2, 0x4a, 0x63, 0xf5, 0xbf, 0x01, 0x5f, 0x91, 0xfb, 0x07, 0xc3, 0x8f, 0xe0, 0x74, 0xff,
+	0x3a, 0xdb, 0x3a, 0x70, 0x9f, 0xab, 0x6a, 0x41, 0x5e, 0x6c, 0xe8, 0x32, 0xcf, 0xc2, 0x86, 0x99,
+	0xad, 0x9c, 0xb1, 0xd7, 0xb6, 0x58, 0xfd, 0x7e, 0x18, 0xcc, 0x67, 0x6a, 0x57, 0x4b, 0x4e, 0x36,
+	0x06, 0x66, 0x7f, 0x85, 0xdd, 0x70, 0xe6, 0xe8, 0xa8, 0x2c, 0x77, 0x75, 0x86, 0x61, 0x30, 0x16,
+	0x51, 0x04, 0xb6, 0x5c, 0xb9, 0xaa, 0x5e, 0x96, 0x0c, 0x65, 0xe4, 0xc1, 0xe3, 0x79, 0x14, 0xfb,
+	0x22, 0x8a, 0xa4, 0xff, 0xa0, 0x14, 0x19, 0x59, 0x18, 0xca, 0xa1, 0x0e, 0x7a, 0x61, 0x55, 0xaa,
+	0x58, 0x15, 0x03, 0x83, 0xaf, 0xc9, 0x43, 0xf3, 0x54, 0x30, 0x01, 0xa7, 0x33, 0x80, 0x83, 0xb2,
+	0xb0, 0xbd, 0xcd, 0xae, 0x49, 0xa7, 0x9f, 0xc3, 0x63, 0xac, 0x89, 0x5c, 0xa2, 0x45, 0xd4, 0x7d,
+	0xb9, 0x69, 0xf0, 0x75, 0x85, 0xcb, 0xcf, 0x29, 0x93, 0x56, 0x16, 0xb6, 0xbf, 0xc1, 0xea, 0xfa,
+	0x9b, 0xcd, 0xba, 0xb1, 0xca, 0x85, 0xee, 0x7c, 0x7a, 0x4f, 0xcb, 0xc0, 0x8d, 0xdc, 0xfa, 0x88,
+	0x69, 0x98, 0x23, 0x26, 0xe1, 0xc9, 0xcd, 0x5c, 0x9e, 0xdc, 0xca, 0x37, 0x79, 0x5a, 0xab, 0xf8,
+	0xeb, 0x37, 0x0b, 0xec, 0xca, 0x42, 0x81, 0x72, 0x15, 0xa3, 0x3b, 0x8c, 0xb5, 0xe7, 0xcf, 0x69,
+	0x7d, 0xa9, 0xdc, 0x02, 0x52, 0x24, 0xaf, 0x79, 0x4a, 0xf9, 0xcd, 0xf3, 0x06, 0xb3, 0x0e, 0xe6,
+	0xd3, 0xd8, 0x1b, 0xbb, 0x51, 0xb2, 0x59, 0x2a, 0xf5, 0x9b, 0x05, 0x3c, 0xaf, 0x4b, 0x2b, 0xb9,
+	0x5d, 0xda, 0xfa, 0x9b, 0x05, 0xe9, 0xe5, 0x90, 0x78, 0x54, 0xac, 0x1e, 0x31, 0xf7, 0x52, 0xf5,
+	0xa7, 0x68, 0x38, 0x28, 0xea, 0xdf, 0x58, 0xba, 0x59, 0x58, 0xca, 0xed, 0x80, 0x72, 0x7e, 0x07,
+	0xac, 0x3c, 0xed, 0xff, 0x9f, 0x0b, 0xcc, 0x5e, 0xfc, 0xcb, 0x8f, 0xc5, 0xc4, 0x0f, 0xc7, 0x2f,
+	0xc6, 0xf1, 0xdc, 0x9d, 0x52, 0x1e, 0x5a, 0x48, 0xe9, 0x58, 0x66, 0x1b, 0xa0, 0x9c, 0xdd, 0x06,
+	0xb0, 0xfb, 0x6c, 0x4b, 0x52, 0xed, 0xa9, 0x77, 0xe2, 0x27, 0xce, 0xee, 0x1b, 0xdb, 0xad, 0xa5,
+	0xcd, 0x95, 0xe4, 0xe4, 0xd9, 0x57, 0x5b, 0x6d, 0xf6, 0xf2, 0x8a, 0xfc, 0xe8, 0x58, 0xe7, 0xab,
+	0xda, 0xc2, 0x23, 0x20, 0xa3, 0x67, 0x01, 0xd5, 0x0e, 0x1e, 0x5b, 0xa7, 0xac, 0xec, 0x80, 0xcb,
+	0xe3, 0xea, 0xde, 0x7d, 0x8b, 0xd9, 0x87, 0xe1, 0x89, 0xeb, 0x7b, 0x3f, 0xe9, 0x4a, 0xeb, 0x51,
+	0xe2, 0x75, 0x50, 0xe7, 0x39, 0x29, 0x09, 0xc3, 0x97, 0xb4, 0x03, 0x4f, 0x7f, 0xb7, 0xc0, 0x98,
+	0xdc, 0xc7, 0xdd, 0x1d, 0x9f, 0x06, 0x17, 0x3b, 0xcd, 0x68, 0xa7, 0xaa, 0x68, 0x74, 0xa4, 0x88,
+	0x3c, 0x44, 0xf8, 0xa1, 0xe1, 0x6a, 0x9c, 0x02, 0x9f, 0x84, 0x8b, 0xc3, 0xf7, 0x0a, 0xec, 0x96,
+	0xe9, 0xe2, 0xe0, 0xc8, 0xf3, 0x2a, 0x72, 0x91, 0x7d, 0xa1, 0x16, 0x69, 0xfa, 0x32, 0x14, 0x2f,
+	0xf0, 0x65, 0x28, 0xbd, 0xc8, 0x4e, 0xfb, 0xc7, 0x57, 0xc9, 0xbf, 0x53, 0x60, 0x4d, 0xdd, 0x97,
+	0xe1, 0x05, 0xaa, 0xf8, 0xc5, 0xec, 0xf8, 0xbf, 0x64, 0xe1, 0x3f, 0xbe, 0x91, 0xff, 0x0b, 0x1b,
+	0xac, 0xbc, 0x3f, 0xba, 0x50, 0xa3, 0x4f, 0xce, 0xce, 0xc9, 0x3e, 0xa8, 0x24, 0x71, 0x35, 0x34,
+	0xe5, 0xa9, 0x96, 0x28, 0x4f, 0x36, 0x2b, 0xef, 0x07, 0x51, 0x4c, 0x05, 0xc2, 0x67, 0xf8, 0xfe,
+	0xc3, 0x48, 0x84, 0x68, 0x31, 0xa0, 0x66, 0x4e, 0x01, 0xb2, 0x83, 0x89, 0x90, 0xdc, 0x29, 0x6a,
+	0x5c, 0x91, 0xf6, 0xdb, 0x8c, 0x71, 0xf1, 0x61, 0x27, 0x08, 0x9e, 0x78, 0x42, 0x2d, 0x12, 0x95,
+	0x15, 0x00, 0x0a, 0x2e, 0x53, 0xb8, 0x96, 0x49, 0x2a, 0xc7, 0x1f, 0x62, 0x40, 0x15, 0x3f, 0x26,
+	0xb1, 0x23, 0xcd, 0x26, 0x0b, 0xb8, 0xdc, 0x83, 0xee, 0x93, 0x26, 0x05, 0x8f, 0xf2, 0xed, 0xc8,
+	0x7c, 0x9b, 0xa9, 0xb7, 0x4d, 0x5c, 0x1a, 0x6b, 0x11, 0xc0, 0x81, 0x9b, 0xb8, 0x03, 0x24, 0x10,
+	0x5a, 0x3d, 0x50, 0x97, 0xc3, 0xb1, 0x2f, 0x17, 0x93, 0x1a, 0x92, 0x76, 0x69, 0x23, 0xb7, 0x4b,
+	0x37, 0xf5, 0x2e, 0xc5, 0xe5, 0x84, 0x2a, 0xff, 0xae, 0x3f, 0xc6, 0x63, 0x52, 0x34, 0xe1, 0xe6,
+	0xa4, 0xc8, 0xfc, 0x51, 0x36, 0xbf, 0xa5, 0xf2, 0x67, 0x53, 0x32, 0x16, 0x1a, 0xa9, 0xc1, 0x6b,
+	0x88, 0xec, 0x8a, 0x48, 0x75, 0x85, 0xbd, 0xa2, 0x2b, 0x54, 0x26, 0x52, 0x74, 0xf5, 0x36, 0xba,
+	0x9a, 0x28, 0xba, 0x7a, 0x33, 0xdd, 0x86, 0xb3, 0x38, 0xbe, 0x68, 0x1f, 0xc7, 0xc9, 0xf6, 0x52,
+	0x0a, 0xe0, 0xa9, 0xd2, 0x81, 0x93, 0x66, 0x90, 0xbb, 0x49, 0x06, 0x86, 0x2e, 0x7f, 0xb0, 0x07,
+	0x0a, 0xab, 0x13, 0x99, 0xeb, 0xba, 0xdc, 0x22, 0x35, 0x51, 0xf8, 0xd6, 0xa8, 0xaf, 0x7d, 0xeb,
+	0x86, 0xfc, 0x96, 0x8e, 0xe1, 0x81, 0xad, 0xb4, 0x70, 0x5d, 0x11, 0x8b, 0x71, 0x2c, 0x26, 0xb4,
+	0xd5, 0x9e, 0x97, 0x64, 0xbf, 0xcb, 0xae, 0x9b, 0x35, 0x4a, 0x5e, 0x92, 0x3b, 0xf1, 0x4b, 0x52,
+	0xed, 0x2e, 0x78, 0x1c, 0x7d, 0x08, 0x96, 0x4f, 0xf2, 0x74, 0xbc, 0x65, 0x1c, 0x39, 0x80, 0x56,
+	0x7d, 0xcb, 0xc8, 0x00, 0xbe, 0x03, 0xe7, 0xdc, 0x7c, 0xc9, 0xbe, 0x9f, 0x2e, 0x27, 0xe8, 0x33,
+	0x2f, 0xe3, 0x67, 0x5e, 0x31, 0x3f, 0xa3, 0xe7, 0x90, 0xdf, 0xc9, 0xbc, 0x66, 0x7f, 0x9d, 0xb1,
+	0xa1, 0x1b, 0xba, 0x67, 0x22, 0x86, 0x85, 0xcf, 0x6d, 0xfc, 0xc8, 0xcb, 0xfa, 0x47, 0xd2, 0x54,
+	0xf9, 0x01, 0x2d, 0xbb, 0x5c, 0x0f, 0x63, 0xb1, 0x76, 0x82, 0xc9, 0x39, 0x06, 0x21, 0xa9, 0x73,
+	0x1d, 0xd2, 0x97, 0x46, 0x98, 0xe5, 0x0e, 0x66, 0x31, 0xb0, 0x4b, 0x84, 0x1f, 0x49, 0x25, 0xdb,
+	0xdd, 0x15, 0x92, 0xed, 0xd6, 0x8f, 0x31, 0x9b, 0xfe, 0x5b, 0xab, 0x31, 0x8c, 0xf7, 0x27, 0xe2,
+	0x9c, 0x6c, 0xcb, 0xf0, 0x08, 0x63, 0xed, 0x29, 0xea, 0xfc, 0x24, 0xda, 0x90, 0xf8, 0x5a, 0xf1,
+	0x2b, 0x85, 0x5b, 0x6d, 0x76, 0x35, 0xa7, 0xd1, 0x5e, 0xe8, 0x13, 0xdf, 0x64, 0x5b, 0x99, 0x26,
+	0x7b, 0x91, 0xd7, 0x5b, 0xbf, 0x53, 0x60, 0x2c, 0x1d, 0x59, 0xb9, 0x96, 0xf1, 0xe4, 0xc8, 0x13,
+	0xbd, 0x9c, 0x1c, 0x9a, 0x1a, 0xba, 0xa4, 0x6d, 0xd5, 0x38, 0x3e, 0xcb, 0x13, 0x17, 0x67, 0xae,
+	0xa7, 0x4e, 0xeb, 0x10, 0x05, 0xb2, 0x57, 0xee, 0x22, 0xc8, 0x75, 0x55, 0x99, 0x2b, 0x12, 0xe5,
+	0xbb, 0xfb, 0xbc, 0x7d, 0xa2, 0x16, 0xb1, 0x44, 0xc9, 0xdd, 0x8c, 0xf1, 0x3c, 0x14, 0xea, 0xec,
+	0x86, 0xa4, 0xd0, 0x8e, 0x18, 0xc7, 0x33, 0xed, 0xe0, 0x46, 0x42, 0x43, 0x9a, 0xe3, 0x9e, 0x09,
+	0xc7, 0x8b, 0xd5, 0x39, 0xcf, 0x84, 0x6e, 0xfd, 0xca, 0x3a, 0xdb, 0x1c, 0xf5, 0x29, 0xa8, 0xc7,
+	0xbe, 0x98, 0x4e, 0x83, 0x8f, 0xb0, 0xd2, 0x5c, 0x6e, 0x4e, 0xba, 0xc3, 0x18, 0xed, 0xb1, 0xa7,
+	0x66, 0x7a, 0x0d, 0xc1, 0xb0, 0x00, 0x2a, 0x76, 0x8d, 0x76, 0xe2, 0xdc, 0x04, 0x8d, 0xa8, 0x37,
+	0xf0, 0x1d, 0x72, 0xfb, 0xd3, 0x31, 0x98, 0x3b, 0x12, 0x5a, 0x15, 0x46, 0x2e, 0x25, 0x17, 0x70,
+	0x68, 0x44, 0xee, 0xfa, 0x93, 0xe0, 0x8c, 0x76, 0xbe, 0x88, 0x82, 0xff, 0x49, 0x02, 0x3b, 0xc0,
+	0xff, 0x48, 0xe3, 0x93, 0x81, 0x99, 0xd1, 0x20, 0xe4, 0x8e, 0x58, 0x0a, 0x80, 0x28, 0xec, 0x78,
+	0xb3, 0x53, 0x11, 0x3a, 0x73, 0x2f, 0xc6, 0xb2, 0xd2, 0x21, 0x70, 0x13, 0x85, 0x7f, 0x4a, 0x8c,
+	0x3a, 0x90, 0xab, 0x4e, 0xa1, 0x1d, 0x34, 0x4c, 0x1e, 0xeb, 0xec, 0xd1, 0xec, 0x04, 0x8f, 0xd0,
+	0xf6, 0x87, 0x4e, 0x67, 0x48, 0x2e, 0x59, 0xf8, 0x8c, 0xf6, 0xff, 0xf4, 0xdb, 0x72, 0xd7, 0xb7,
+	0xc2, 0x0d, 0x0c, 0x16, 0x51, 0xea, 0x24, 0xb1, 0x54, 0x13, 0xa4, 0x4d, 0xbf, 0xc2, 0xb3, 0x30,
+	0xf4, 0x87, 0xe3, 0x9d, 0xf8, 0x6e, 0x3c, 0x0f, 0x45, 0x7b, 0x7a, 0x22, 0x37, 0x77, 0x2b, 0xdc,
+	0x04, 0x71, 0x51, 0x36, 0x9f, 0xcd, 0x82, 0x30, 0x16, 0x13, 0x5c, 0x36, 0xca, 0x29, 0xa9, 0xc2,
+	0xb3, 0xb0, 0x91, 0x73, 0x18, 0x78, 0x7e, 0x1c, 0x35, 0xaf, 0x66, 0x72, 0x4a, 0x18, 0xfd, 0x05,
+	0xfa, 0xc3, 0x81, 0xf4, 0xf1, 0xaa, 0x71, 0x49, 0x40, 0x1b, 0x7c, 0xcb, 0xbd, 0x87, 0xb3, 0x4e,
+	0x8d, 0xc3, 0x63, 0x3a, 0x6b, 0x5f, 0xcf, 0x9d, 0xb5, 0x6f, 0xe8, 0xb3, 0x76, 0x1a, 0x70, 0xa3,
+	0xb9, 0x24, 0xe0, 0xc6, 0x4d, 0x23, 0xe0, 0x86, 0x66, 0xc7, 0xb9, 0xb5, 0xd4, 0x8e, 0xf3, 0xb2,
+	0x69, 0xc7, 0xb9, 0xc3, 0x58, 0xd2, 0x6b, 0x52, 0x6e, 0x57, 0xb8, 0x86, 0x64, 0x85, 0xea, 0xa7,
+	0x57, 0x09, 0xd5, 0x55, 0xc1, 0xa1, 0x5a, 0xff, 0x4b, 0x8e, 0x54, 0xa9, 0x14, 0x5c, 0x66, 0xa4,
+	0xae, 0xb4, 0xbc, 0x11, 0xff, 0x97, 0x0c, 0xfe, 0x37, 0x78, 0xbb, 0x9c, 0xe5, 0x6d, 0xa8, 0x49,
+	0xca, 0x55, 0x34, 0x52, 0x75, 0x08, 0xcc, 0x9d, 0x8a, 0xa1, 0xbc, 0xc0, 0x27, 0xfd, 0x54, 0xca,
+	0xaf, 0xc5, 0x04, 0xb5, 0x03, 0x86, 0xfa, 0xec, 0x40, 0x9c, 0x90, 0x40, 0x33, 0x30, 0x75, 0x16,
+	0x01, 0xe9, 0x08, 0x0f, 0x05, 0xd6, 0xb8, 0x86, 0xe0, 0x32, 0xb8, 0xe3, 0x0c, 0x9d, 0xd8, 0x9d,
+	0x4d, 0x41, 0xc3, 0x92, 0x6e, 0x90, 0x06, 0x06, 0x3c, 0x38, 0xf2, 0x30, 0xdc, 0x8e, 0x62, 0x39,
+	0xf2, 0x8d, 0xcc, 0xc2, 0xf6, 0x0e, 0xbb, 0x2d, 0xc5, 0x29, 0x17, 0xbe, 0x38, 0x09, 0x62, 0xe9,
+	0x02, 0x96, 0xbe, 0x26, 0x1d, 0x28, 0x57, 0xe6, 0x01, 0x05, 0x26, 0x27, 0x1d, 0x07, 0x78, 0x9d,
+	0xe7, 0x25, 0xe1, 0x32, 0x7d, 0x3a, 0xf3, 0x93, 0xd3, 0x53, 0xb4, 0x83, 0xa7, 0x63, 0xe8, 0x9d,
+	0x79, 0x16, 0x29, 0x5f, 0xcc, 0xdd, 0x33, 0xf4, 0xc7, 0x71, 0xc6, 0xb1, 0x1c, 0xef, 0x75, 0x8e,
+	0xcf, 0x20, 0x03, 0x93, 0x82, 0xa8, 0xae, 0x97, 0xbe, 0x4c, 0x0b, 0x38, 0xda, 0xec, 0xc4, 0x14,
+	0x55, 0x21, 0xb9, 0x4c, 0x8d, 0xcf, 0x87, 0xa1, 0x88, 0x94, 0x63, 0x66, 0x95, 0x2f, 0x4b, 0xc6,
+	0x7f, 0xc9, 0x24, 0x91, 0x05, 0x79, 0x01, 0x07, 0x4e, 0x93, 0x13, 0x28, 0x6a, 0x96, 0x75, 0x4e,
+	0x14, 0xca, 0x19, 0xca, 0x8b, 0x92, 0x82, 0xb6, 0xf3, 0x4c, 0x30, 0x33, 0xb6, 0xae, 0x2f, 0x8c,
+	0xad, 0x44, 0x16, 0xdc, 0xc8, 0x95, 0x05, 0xcd, 0x7c, 0x59, 0x70, 0x73, 0x89, 0x2c, 0xb8, 0xb5,
+	0x4c, 0x16, 0xbc, 0xbc, 0x54, 0x16, 0xdc, 0x36, 0x65, 0x81, 0xcd, 0xca, 0xdf, 0x72, 0xef, 0x45,
+	0x34, 0xc8, 0xf1, 0x39, 0x3b, 0xfe, 0xef, 0xac, 0x1a, 0xff, 0xaf, 0x5c, 0x60, 0x28, 0x5a, 0xef,
+	0x0d, 0x1d, 0x31, 0x6e, 0xef, 0x5f, 0xec, 0xa5, 0xaf, 0x4e, 0xd2, 0x28, 0x2f, 0x7d, 0x45, 0xe3,
+	0xa4, 0x32, 0x4c, 0xce, 0xf5, 0x3b, 0xc3, 0x9e, 0x3a, 0x24, 0x52, 0x4e, 0x0f, 0x89, 0xbc, 0xc5,
+	0x6c, 0x70, 0xd9, 0x81, 0x2e, 0x1c, 0xbb, 0xca, 0x12, 0x84, 0xe3, 0xbd, 0xce, 0x73, 0x52, 0x3e,
+	0x09, 0x4f, 0xcc, 0x7f, 0x58, 0x60, 0x55, 0xac, 0xec, 0xae, 0x73, 0xd1, 0xfa, 0x98, 0x6a, 0x54,
+	0x5c, 0xa8, 0x51, 0x29, 0xad, 0x51, 0x8b, 0xd5, 0xfb, 0xc2, 0xdf, 0xf5, 0xc7, 0xe1, 0xf9, 0x0c,
+	0x06, 0xb2, 0xac, 0xac, 0x81, 0x7d, 0x12, 0x47, 0x2d, 0xfe, 0x78, 0x91, 0xad, 0xdd, 0x17, 0xbe,
+	0x78, 0x2a, 0x3e, 0xb2, 0xa8, 0x7e, 0x95, 0x35, 0xc8, 0x04, 0x61, 0x18, 0xf1, 0x4c, 0x10, 0xbe,
+	0x7e, 0xd8, 0x3e, 0x90, 0x6e, 0x95, 0x74, 0x34, 0x38, 0x05, 0x50, 0x29, 0x09, 0x3d, 0xe8, 0xb2,
+	0xa9, 0x7c, 0x8d, 0xb6, 0x4e, 0x32, 0xa8, 0x71, 0x84, 0x73, 0x2d, 0x73, 0x84, 0x93, 0xc2, 0x65,
+	0xad, 0xa7, 0xe1, 0xb2, 0x34, 0x03, 0x4a, 0xd5, 0x30, 0xa0, 0xc8, 0x1a, 0x67, 0x0c, 0x28, 0xad,
+	0x9f, 0x64, 0x75, 0x3d, 0x21, 0x75, 0x21, 0x29, 0xe8, 0x5e, 0x4e, 0x4b, 0x9c, 0x4d, 0x72, 0x0e,
+	0x96, 0x2c, 0x3b, 0xd2, 0xa0, 0xb6, 0x70, 0x2b, 0xda, 0xc1, 0x8a, 0xff, 0x52, 0x60, 0x95, 0xa3,
+	0xf7, 0xe1, 0x50, 0xf2, 0xea, 0x6e, 0xb8, 0xcb, 0x36, 0x8e, 0xdc, 0xa9, 0x37, 0xe9, 0x75, 0xe1,
+	0x3f, 0x54, 0x2c, 0x1a, 0x0d, 0x52, 0xcd, 0x50, 0x4a, 0x9b, 0x01, 0xf6, 0x47, 0x76, 0x86, 0x89,
+	0x50, 0xa2, 0xd6, 0x37, 0x30, 0xca, 0xd3, 0x0d, 0xc0, 0x78, 0xe1, 0x86, 0xaa, 0xf9, 0x0d, 0x0c,
+	0x64, 0xdd, 0xfd, 0x9d, 0x21, 0xc6, 0x4f, 0x15, 0x13, 0xda, 0x36, 0xd1, 0x10, 0x90, 0xba, 0xf7,
+	0x77, 0x86, 0x28, 0x17, 0x65, 0x10, 0x9e, 0x5e, 0x57, 0xe9, 0xb7, 0x59, 0xbc, 0xf5, 0xc7, 0x2a,
+	0xac, 0xf4, 0xd0, 0xd9, 0xb9, 0xb4, 0xfb, 0x64, 0x19, 0xdd, 0x27, 0x6f, 0xb3, 0xda, 0xee, 0x53,
+	0x65, 0x2b, 0x20, 0x13, 0x65, 0x02, 0xd0, 0xe1, 0x4e, 0x3f, 0x3a, 0x16, 0xa1, 0x1e, 0x8c, 0x4c,
+	0xc7, 0xe0, 0x0b, 0x5d, 0x2f, 0x94, 0x71, 0x6b, 0xd5, 0x99, 0xbe, 0x04, 0xc0, 0xed, 0x4d, 0x7f,
+	0x32, 0x03, 0x75, 0x8f, 0xec, 0xa0, 0x92, 0xc9, 0x32, 0x28, 0xb0, 0x7c, 0x57, 0x3c, 0xf5, 0x12,
+	0xdb, 0x3e, 0x55, 0xd3, 0x04, 0x81, 0x2b, 0x76, 0xe6, 0x51, 0x12, 0xd2, 0x46, 0x12, 0x58, 0x4a,
+	0x55, 0x41, 0x47, 0x8c, 0x9b, 0x35, 0x32, 0x31, 0x68, 0x98, 0xe1, 0xa9, 0xfd, 0x30, 0x12, 0x63,
+	0x32, 0x31, 0x99, 0x20, 0x8a, 0x03, 0x11, 0xcf, 0x67, 0x34, 0xe9, 0x4b, 0x22, 0xe1, 0x2e, 0x79,
+	0x70, 0x02, 0x9f, 0x71, 0x66, 0x91, 0x3b, 0x89, 0x72, 0xbb, 0x86, 0x28, 0x34, 0xbb, 0x85, 0x8f,
+	0x89, 0x49, 0x37, 0xe5, 0x56, 0x77, 0x02, 0x40, 0x29, 0x1e, 0x86, 0x8f, 0x35, 0x07, 0xbe, 0x2d,
+	0xcc, 0x61, 0x82, 0xc0, 0x91, 0x0f, 0xc3, 0xc7, 0x6a, 0x93, 0x0b, 0x27, 0xf3, 0x06, 0xd7, 0x21,
+	0xfa, 0x8e, 0x13, 0xbb, 0x61, 0xbc, 0x17, 0x2a, 0xe3, 0x51, 0x83, 0x9b, 0x20, 0x18, 0x49, 0x1e,
+	0x86, 0x8f, 0x3b, 0xc1, 0xec, 0xfc, 0xf0, 0x58, 0x75, 0x99, 0x1c, 0x54, 0x36, 0x66, 0x5f, 0x92,
+	0x2a, 0x77, 0x5c, 0x03, 0xf0, 0x5f, 0x16, 0xd1, 0x18, 0x67, 0xf9, 0x06, 0xd7, 0x10, 0xfd, 0x94,
+	0xc4, 0x35, 0xe3, 0x94, 0x04, 0x6c, 0xc7, 0x5c, 0x7b, 0xe8, 0xec, 0x28, 0x1b, 0xc4, 0x34, 0x18,
+	0x3f, 0x91, 0x4d, 0x78, 0xe1, 0x10, 0xa4, 0x57, 0x34, 0x39, 0xa0, 0x43, 0xd2, 0x5e, 0x89, 0xa4,
+	0x5a, 0x6c, 0x12, 0x99, 0xae, 0xc7, 0x29, 0x9e, 0x18, 0x12, 0x80, 0xf6, 0xfc, 0x89, 0x78, 0x4e,
+	0x0c, 0x29, 0x09, 0x4d, 0x7c, 0xac, 0xe9, 0xe2, 0xa3, 0xf5, 0x8f, 0x4a, 0xac, 0xd4, 0xef, 0x1c,
+	0x5c, 0x6c, 0x93, 0x3d, 0x70, 0x4f, 0xbc, 0x31, 0x95, 0x4f, 0x12, 0x39, 0x91, 0xc2, 0x4a, 0xb9,
+	0x91, 0xc2, 0x32, 0x87, 0x4f, 0xca, 0x8b, 0x87, 0x4f, 0x16, 0x0f, 0xd1, 0x56, 0x72, 0x0f, 0xd1,
+	0x2e, 0xc6, 0x1c, 0x5b, 0xcb, 0x8d, 0x39, 0x06, 0x87, 0x18, 0x82, 0xd8, 0x9d, 0xa6, 0x6e, 0xf2,
+	0x72, 0x4c, 0x65, 0x50, 0x54, 0x56, 0x4e, 0x5d, 0x98, 0xbb, 0xd0, 0xd8, 0x41, 0x4e, 0x3e, 0x1a,
+	0xa4, 0x02, 0x03, 0x40, 0x76, 0x31, 0x21, 0x75, 0x5b, 0x43, 0x5e, 0xe8, 0xd8, 0xac, 0xa6, 0x62,
+	0xd5, 0x97, 0xaa, 0x58, 0x8d, 0x65, 0xdb, 0xe6, 0x9b, 0xab, 0x66, 0xde, 0x9f, 0x2b, 0xb0, 0xf2,
+	0xc1, 0xb0, 0xef, 0x5c, 0xdc, 0x8f, 0xf2, 0x24, 0x3a, 0xf5, 0x23, 0x12, 0x97, 0x3a, 0xc7, 0x2e,
+	0x63, 0x65, 0x8c, 0x9f, 0xec, 0x04, 0x71, 0x1c, 0x9c, 0x91, 0xd4, 0xd7, 0x21, 0xe5, 0xb0, 0x5b,
+	0x49, 0x42, 0x24, 0xb4, 0x7e, 0xbf, 0xc8, 0xd6, 0x0e, 0x82, 0xc9, 0x63, 0x29, 0x1b, 0x2e, 0xd8,
+	0x7e, 0x31, 0x1c, 0xb8, 0xc8, 0x89, 0xc7, 0x00, 0xa5, 0xbf, 0xa7, 0x9c, 0x9e, 0x29, 0x48, 0x51,
+	0x85, 0x6b, 0xc8, 0xd2, 0x19, 0x12, 0x4e, 0x60, 0xf9, 0x5e, 0x9c, 0x04, 0xd7, 0x23, 0x4a, 0x1f,
+	0xcb, 0x6b, 0xe6, 0x89, 0x27, 0x98, 0x19, 0x9e, 0x8f, 0xc5, 0x2c, 0x39, 0x62, 0x5d, 0xe5, 0x29,
+	0x00, 0xcd, 0xa5, 0xa2, 0xea, 0xa0, 0xa5, 0x5d, 0x0a, 0x64, 0x03, 0xfb, 0x41, 0xf1, 0x0d, 0xfb,
+	0x85, 0x32, 0x5b, 0x3b, 0x74, 0x86, 0x7b, 0x4f, 0xb7, 0x3f, 0xb2, 0x42, 0x96, 0xb3, 0x53, 0x08,
+	0x2d, 0x20, 0x55, 0x2d, 0xa3, 0xbd, 0x0d, 0x0c, 0x95, 0x72, 0xdc, 0xa3, 0xa2, 0x76, 0x6f, 0xf0,
+	0x84, 0xc6, 0xf3, 0x81, 0xa1, 0x70, 0x93, 0xf0, 0xa2, 0x44, 0x19, 0x7e, 0x19, 0xeb, 0x8b, 0xe7,
+	0xe8, 0xda, 0x73, 0x2c, 0x89, 0x6c, 0x6f, 0xa2, 0x30, 0x84, 0xb9, 0xa1, 0xa2, 0xd3, 0x1c, 0x98,
+	0x41, 0x21, 0x50, 0x57, 0xdf, 0x69, 0x83, 0xc7, 0x83, 0x7e, 0xa4, 0xae, 0xef, 0xb4, 0x4f, 0xd1,
+	0xde, 0xca, 0x31, 0x15, 0x02, 0x12, 0xf6, 0x9d, 0x87, 0xcd, 0x0d, 0x23, 0x20, 0x61, 0xdf, 0x79,
+	0x38, 0x9b, 0xb8, 0xb1, 0xe0, 0x90, 0x66, 0xdf, 0x81, 0x2c, 0x9c, 0x7c, 0x1c, 0xea, 0x49, 0x16,
+	0x2e, 0x3e, 0x84, 0x74, 0x6e, 0xbf, 0xce, 0xd6, 0xba, 0x8f, 0x71, 0xfa, 0x68, 0x98, 0x31, 0xc1,
+	0x10, 0x1c, 0x3e, 0x39, 0xe1, 0x94, 0x0e, 0x2e, 0xa7, 0x68, 0xd7, 0x38, 0xda, 0xa6, 0xc1, 0x9d,
+	0xec, 0x70, 0x00, 0x3a, 0x7c, 0x72, 0x72, 0xb4, 0xcd, 0x55, 0x8e, 0x94, 0xa3, 0xb6, 0x72, 0x39,
+	0xca, 0xca, 0x57, 0xd7, 0xaf, 0xac, 0xe2, 0x8e, 0x7f, 0x52, 0x64, 0x55, 0xf5, 0x57, 0xf2, 0x62,
+	0x06, 0x8a, 0x0f, 0x43, 0xe1, 0x12, 0x1b, 0x5c, 0x87, 0x20, 0x07, 0x8f, 0xc3, 0x4c, 0x3c, 0x4e,
+	0x1d, 0x02, 0x2e, 0x4a, 0x37, 0x48, 0xe1, 0x7d, 0x45, 0xa2, 0xdd, 0x13, 0xfe, 0x29, 0x99, 0xd9,
+	0x55, 0x38, 0x54, 0x1d, 0xc4, 0x5d, 0x24, 0xe4, 0x91, 0xae, 0x70, 0x27, 0x49, 0x56, 0xc9, 0x3d,
+	0x39, 0x29, 0x90, 0xbf, 0x2b, 0x22, 0x34, 0xd5, 0x89, 0x49, 0xc2, 0x6d, 0x92, 0xa7, 0x72, 0x52,
+	0x20, 0xea, 0xf5, 0x8e, 0x3b, 0x7e, 0x32, 0x9f, 0xe5, 0xbc, 0x25, 0x35, 0xfd, 0xa5, 0xe9, 0xd2,
+	0x32, 0x23, 0x37, 0x96, 0x51, 0x09, 0x2b, 0x81, 0x66, 0x90, 0x22, 0xad, 0xff, 0x5a, 0x64, 0x2c,
+	0xed, 0xb7, 0xff, 0xdf, 0x9c, 0xdf, 0x5f, 0x73, 0xe2, 0x11, 0x36, 0x79, 0x57, 0xc3, 0x81, 0x1b,
+	0x3d, 0x21, 0xcb, 0xb4, 0x0e, 0x41, 0x6c, 0xa5, 0x5a, 0x32, 0xa6, 0xf4, 0xb6, 0x2a, 0x98, 0x6d,
+	0xa5, 0x1c, 0xa9, 0xa0, 0xd9, 0x0f, 0x46, 0x0f, 0x95, 0xe7, 0x88, 0x8e, 0x2d, 0x59, 0x72, 0xdd,
+	0x65, 0x1b, 0xdd, 0x6e, 0xea, 0xc5, 0x20, 0x4f, 0x4d, 0xe8, 0x10, 0x1c, 0xd5, 0xed, 0x3b, 0x6d,
+	0x0f, 0x02, 0x1e, 0x55, 0x96, 0xc8, 0x15, 0x95, 0xa1, 0xf5, 0xd3, 0x4a, 0x16, 0xdf, 0xfb, 0x7f,
+	0x5e, 0x16, 0xdf, 0x62, 0xd5, 0x9e, 0x1f, 0xc5, 0xae, 0x3f, 0x56, 0xd2, 0x38, 0xa1, 0x0d, 0x63,
+	0x4c, 0x2d, 0x63, 0x8c, 0xf9, 0x2c, 0xab, 0x20, 0x87, 0x36, 0x99, 0x21, 0x5f, 0xd5, 0xb0, 0xe1,
+	0x32, 0x55, 0x93, 0xa0, 0x1b, 0x17, 0x48, 0xd0, 0x8b, 0x64, 0x31, 0x89, 0xf3, 0xc6, 0x0a, 0x71,
+	0xae, 0xe6, 0x85, 0xcd, 0x95, 0xf3, 0xc2, 0x27, 0x20, 0x7d, 0x7f, 0xb7, 0xc0, 0x6a, 0xc9, 0xdf,
+	0xa0, 0x66, 0xe6, 0xc0, 0xf6, 0x17, 0x99, 0x07, 0x90, 0x40, 0x95, 0xc6, 0xd1, 0x16, 0x06, 0x44,
+	0x01, 0x67, 0x82, 0x67, 0x3c, 0x2c, 0xbc, 0x04, 0xe9, 0x42, 0x0d, 0xae, 0x43, 0x18, 0xcf, 0x76,
+	0xf2, 0x54, 0xf6, 0xb2, 0x8a, 0x3b, 0x94, 0x00, 0xf8, 0xbe, 0x93, 0x72, 0x76, 0x85, 0xde, 0x4f,
+	0x21, 0x18, 0x9f, 0x7d, 0x27, 0x61, 0x00, 0x3a, 0xaa, 0x9f, 0x22, 0x9a, 0xb2, 0xb5, 0x6e, 0x28,
+	0x5b, 0x70, 0x2b, 0x8b, 0x93, 0xda, 0x49, 0x20, 0x29, 0x05, 0x5a, 0xbf, 0x5c, 0x86, 0x0e, 0x69,
+	0x43, 0x0f, 0xd3, 0xee, 0x71, 0xc1, 0xe8, 0xe1, 0xb4, 0xd9, 0x29, 0xdd, 0x7e, 0x83, 0xad, 0xf1,
+	0xbe, 0xd3, 0x3e, 0xda, 0xa6, 0xa8, 0x74, 0xea, 0x78, 0x9f, 0xac, 0x08, 0xa6, 0x70, 0xca, 0x61,
+	0x6f, 0xb3, 0x2a, 0x04, 0xd8, 0xc4, 0xdc, 0x25, 0x23, 0x74, 0x5f, 0xdb, 0x01, 0xe3, 0x44, 0xe8,
+	0xbb, 0x53, 0xf9, 0x46, 0x92, 0x0f, 0xba, 0x1f, 0xde, 0x6e, 0x96, 0x8d, 0x72, 0x24, 0x5f, 0xe7,
+	0x98, 0x6a, 0x7f, 0x96, 0x95, 0x07, 0x90, 0xab, 0x62, 0x4c, 0xd3, 0x24, 0x8d, 0x30, 0x1b, 0x24,
+	0xdb, 0x1d, 0x0a, 0xbd, 0xd6, 0x86, 0x53, 0x48, 0xde, 0x73, 0x78, 0x43, 0xba, 0xf3, 0x24, 0xbe,
+	0x76, 0x98, 0x1a, 0x0a, 0x37, 0xc9, 0xc0, 0xb3, 0x6f, 0xd8, 0x5f, 0x67, 0x1b, 0xbd, 0x76, 0x52,
+	0x80, 0xe6, 0x7a, 0xfe, 0x07, 0xd2, 0x12, 0xea, 0xb9, 0xed, 0x37, 0xd9, 0x9a, 0xac, 0x5a, 0xb3,
+	0x6a, 0x44, 0xfd, 0x34, 0x1a, 0x80, 0x53, 0x1e, 0xbb, 0xc5, 0xca, 0x7d, 0xc8, 0x5b, 0xc3, 0xbc,
+	0x9b, 0x7a, 0xf0, 0x41, 0xa8, 0x53, 0x3f, 0xad, 0x53, 0xe8, 0x6a, 0x75, 0x62, 0xd9, 0x22, 0x85,
+	0xee, 0x62, 0x9d, 0xf4, 0x37, 0xd2, 0xe1, 0xb3, 0x91, 0x3b, 0x7c, 0xea, 0xda, 0xf0, 0x69, 0x3d,
+	0x80, 0x91, 0xc0, 0xc5, 0x87, 0x1a, 0xf3, 0x17, 0x0c, 0xe6, 0xb7, 0x61, 0xc4, 0xd2, 0x22, 0xa1,
+	0xc1, 0xf1, 0xd9, 0x64, 0xf7, 0x52, 0x86, 0xdd, 0x5b, 0xfb, 0xac, 0xaa, 0x06, 0x3d, 0x9d, 0xc1,
+	0x3e, 0x3c, 0xc6, 0x41, 0x2f, 0xa7, 0x8a, 0x14, 0xb0, 0xef, 0x90, 0x34, 0x90, 0x2e, 0x52, 0x2c,
+	0x65, 0x4b, 0x29, 0x07, 0x5a, 0xff, 0x1c, 0xbc, 0x17, 0x17, 0x2a, 0xac, 0x8e, 0x4b, 0x1f, 0x4b,
+	0x44, 0x28, 0x23, 0x9f, 0x09, 0xca, 0x10, 0x50, 0xc7, 0xc6, 0x80, 0x4e, 0x01, 0xe9, 0xbf, 0x72,
+	0xbc, 0x38, 0xac, 0x33, 0xa8, 0xf4, 0x6c, 0x38, 0xce, 0x0e, 0x6e, 0x03, 0xb3, 0xdf, 0x64, 0x55,
+	0xf5, 0xaf, 0x8b, 0x13, 0x93, 0x4c, 0xe1, 0x49, 0x8e, 0xd6, 0x3f, 0x2d, 0xb2, 0x86, 0xc1, 0x20,
+	0xe9, 0x7c, 0x58, 0xc8, 0x98, 0x20, 0x0f, 0x44, 0x1c, 0x92, 0x19, 0xa0, 0xc1, 0x89, 0xc2, 0x29,
+	0x48, 0x36, 0x85, 0xe1, 0x77, 0xa9, 0x63, 0xd0, 0x42, 0x92, 0x4e, 0xa3, 0x01, 0x61, 0x0b, 0x19,
+	0xa0, 0xd9, 0x42, 0x95, 0x6c, 0x0b, 0xbd, 0xca, 0x1a, 0x64, 0x0d, 0x93, 0x6f, 0xa9, 0x73, 0x3e,
+	0x06, 0x08, 0x9b, 0x72, 0x7b, 0x41, 0xf8, 0xcc, 0x0d, 0xc1, 0xd1, 0x48, 0x37, 0xa9, 0xd5, 0xf9,
+	0x62, 0x02, 0x98, 0x19, 0x55, 0xc5, 0xb1, 0xed, 0xe0, 0x80, 0xbf, 0x3c, 0xa6, 0xb1, 0x80, 0xe7,
+	0xf4, 0x50, 0x2d, 0xaf, 0x87, 0x5a, 0xbf, 0x28, 0x99, 0x24, 0x33, 0xd2, 0xb5, 0xe6, 0x2b, 0xac,
+	0x6c, 0xbe, 0xe2, 0x65, 0x9a, 0xaf, 0x94, 0xd7, 0x7c, 0x0b, 0x0d, 0x54, 0xce, 0x69, 0xa0, 0xd6,
+	0x73, 0xad, 0x74, 0xa9, 0xe4, 0x58, 0xae, 0x40, 0x2d, 0xeb, 0xf6, 0x2f, 0xb3, 0xab, 0x5d, 0x11,
+	0xc5, 0xea, 0x6a, 0x13, 0xa5, 0x60, 0x48, 0xae, 0xcd, 0x4b, 0x02, 0xe7, 0xeb, 0xad, 0x8c, 0x28,
+	0xce, 0x2a, 0x7a, 0x85, 0x05, 0x45, 0x0f, 0x72, 0xa8, 0x57, 0x76, 0x92, 0x38, 0x4c, 0x3a, 0xa4,
+	0x95, 0xb0, 0x64, 0x94, 0x30, 0x97, 0x15, 0xe4, 0x78, 0xb9, 0x24, 0x2b, 0x54, 0xf2, 0x59, 0xa1,
+	0x35, 0x61, 0x35, 0x59, 0xab, 0xe5, 0xa3, 0xa5, 0xa9, 0x3b, 0x5c, 0x1a, 0x0d, 0xfa, 0x39, 0xb6,
+	0x2e, 0x5f, 0x56, 0x7e, 0xa4, 0x0d, 0x63, 0xda, 0xe1, 0x2a, 0x15, 0x6c, 0x8a, 0x2a, 0xb2, 0xe9,
+	0x92, 0xa3, 0x7b, 0x5a, 0xc7, 0x54, 0x92, 0x6a, 0x67, 0xd6, 0x1e, 0xa5, 0xc5, 0xb5, 0xc7, 0x97,
+	0xd9, 0xd5, 0x44, 0xd7, 0xd6, 0x72, 0xca, 0xa6, 0xc9, 0x4b, 0x82, 0xc6, 0x51, 0x70, 0x46, 0x95,
+	0x5c, 0xc0, 0x5b, 0x13, 0xb6, 0xa1, 0x4d, 0xcf, 0x4b, 0x9a, 0x07, 0x14, 0x1e, 0x0f, 0xa2, 0x5c,
+	0x28, 0x53, 0x14, 0x10, 0xf6, 0xe7, 0xb3, 0x4d, 0xb3, 0x65, 0x34, 0x0d, 0x2c, 0x88, 0x55, 0xe3,
+	0xfc, 0x84, 0x52, 0x6a, 0x8f, 0xb6, 0x97, 0x1e, 0x6c, 0xf4, 0xfc, 0x27, 0xc9, 0x44, 0x41, 0x94,
+	0x3a, 0x65, 0x98, 0x9c, 0x7b, 0x6b, 0xf0, 0x84, 0xd6, 0x5a, 0xb4, 0xac, 0x33, 0x52, 0x6b, 0xc0,
+	0x18, 0x71, 0xe4, 0xea, 0xa1, 0x02, 0xc6, 0x88, 0x38, 0x76, 0xc7, 0xa7, 0x6a, 0xa5, 0x83, 0x13,
+	0x49, 0x83, 0x67, 0xd0, 0xd6, 0xaf, 0x17, 0xd8, 0x3a, 0x4d, 0xb3, 0xd9, 0x75, 0x60, 0x61, 0xe5,
+	0x3a, 0x30, 0xc3, 0x49, 0x6f, 0x30, 0x0b, 0x3f, 0x13, 0x8c, 0xdd, 0xa9, 0x1e, 0x86, 0xad, 0xce,
+	0x17, 0xf0, 0xc5, 0x39, 0x4a, 0x56, 0xd1, 0x04, 0x5f, 0x70, 0xe6, 0xf8, 0x79, 0xa9, 0xc3, 0x4a,
+	0x7a, 0x41, 0x90, 0x15, 0x2e, 0x23, 0xc8, 0x8a, 0x79, 0x82, 0xcc, 0x1c, 0xd0, 0x29, 0x67, 0x5f,
+	0x4e, 0xc0, 0xfd, 0x7c, 0x85, 0x95, 0x76, 0xf6, 0xba, 0x1f, 0x79, 0x99, 0x05, 0x81, 0x06, 0x3c,
+	0xf7, 0xc4, 0x0f, 0xa2, 0x38, 0x29, 0x81, 0x86, 0xa0, 0x36, 0x03, 0xa2, 0x5e, 0xd9, 0xdd, 0x91,
+	0x48, 0xce, 0x06, 0xca, 0xcd, 0x2e, 0x7c, 0x46, 0xd6, 0xf7, 0x7c, 0x77, 0xaa, 0xe2, 0x11, 0x23,
+	0x01, 0xae, 0x08, 0x74, 0xc8, 0x71, 0x38, 0x75, 0x7d, 0x01, 0x06, 0xfa, 0x99, 0xf0, 0x27, 0xc2,
+	0x8f, 0xc9, 0xd8, 0xb8, 0x2c, 0x19, 0x78, 0x05, 0xcc, 0x5a, 0xca, 0x71, 0x81, 0x22, 0x16, 0x6b,
+	0x10, 0x6e, 0xef, 0x0b, 0x8c, 0x2d, 0x5f, 0xa3, 0x58, 0xc7, 0x48, 0xa1, 0x63, 0x1a, 0x9c, 0x35,
+	0xc1, 0x8d, 0x27, 0xf2, 0x07, 0xd1, 0x10, 0xe0, 0x24, 0xe9, 0x29, 0x2a, 0xb1, 0xa9, 0x97, 0xdc,
+	0xe7, 0xb1, 0x80, 0xe3, 0x41, 0xab, 0x73, 0x88, 0x4c, 0x1d, 0x7a, 0x78, 0xb1, 0x55, 0x10, 0x92,
+	0x79, 0x32, 0x0b, 0x83, 0x00, 0x86, 0x43, 0xe0, 0x66, 0x5e, 0x69, 0xe1, 0x5e, 0x4c, 0x80, 0x43,
+	0x4a, 0x60, 0x29, 0x08, 0xc5, 0xe4, 0xc0, 0xf3, 0x47, 0xcf, 0x13, 0x8b, 0x85, 0x0c, 0xfa, 0x91,
+	0x9b, 0x06, 0x57, 0xa7, 0xc1, 0x76, 0x08, 0x25, 0xf0, 0xf4, 0x25, 0x19, 0xf4, 0x26, 0x3f, 0x11,
+	0x2e, 0x21, 0xd3, 0x12, 0xe0, 0xb8, 0x83, 0xf6, 0xa6, 0xf4, 0x20, 0x59, 0x9e, 0xc1, 0x7e, 0x07,
+	0x4e, 0x06, 0xc5, 0xa7, 0xb4, 0x82, 0xb9, 0x62, 0x28, 0xda, 0x3b, 0x7b, 0xdd, 0x34, 0x8d, 0x6b,
+	0xf9, 0x5a, 0x7f, 0x94, 0x35, 0x8c, 0x44, 0xbc, 0x84, 0x65, 0x1e, 0x9f, 0x6a, 0x82, 0x2b, 0xa1,
+	0x81, 0x71, 0xde, 0x13, 0xe7, 0x89, 0x25, 0x5c, 0x12, 0x97, 0xde, 0x70, 0xc9, 0x8b, 0xe2, 0xfe,
+	0xef, 0xcb, 0xac, 0x74, 0x9f, 0xef, 0x5e, 0x1c, 0xb2, 0x5d, 0x2d, 0xf1, 0x14, 0x93, 0xc9, 0x5d,
+	0xe1, 0x2c, 0xac, 0x02, 0x1d, 0x7a, 0xfe, 0x89, 0xca, 0x28, 0x0f, 0xfe, 0x66, 0x50, 0x60, 0xbc,
+	0xf7, 0x44, 0xe2, 0x6a, 0x23, 0xf7, 0x0d, 0x34, 0x44, 0x7a, 0x82, 0x7f, 0xa8, 0xd2, 0xe9, 0x8c,
+	0x63, 0x8a, 0x00, 0x0b, 0x39, 0x30, 0xf6, 0xe9, 0x7a, 0x58, 0xf8, 0xba, 0x0a, 0xef, 0xbd, 0x98,
+	0x00, 0x5f, 0x83, 0x5b, 0x5b, 0xe8, 0x6b, 0x72, 0x34, 0x69, 0x08, 0x1d, 0x66, 0x9d, 0xe3, 0x38,
+	0x57, 0xe7, 0x8e, 0x13, 0x7f, 0x7d, 0x13, 0x4f, 0xe7, 0xad, 0x5a, 0x66, 0x5a, 0x57, 0x62, 0x83,
+	0x99, 0x62, 0x43, 0x77, 0x27, 0xd8, 0x58, 0x11, 0x11, 0xba, 0xbe, 0x68, 0xd9, 0xa6, 0x4d, 0x2f,
+	0xda, 0x4f, 0x4d, 0xa3, 0xe1, 0xc1, 0xe5, 0x5c, 0x72, 0x27, 0x15, 0x1e, 0x95, 0xa3, 0x87, 0xdc,
+	0x39, 0x85, 0x47, 0x40, 0xda, 0xe3, 0x27, 0xb4, 0x4f, 0x0a, 0x8f, 0x60, 0x54, 0xa6, 0x1e, 0x68,
+	0x5e, 0x31, 0x56, 0xab, 0xf7, 0xf9, 0x2e, 0x25, 0x70, 0x95, 0xe3, 0x85, 0xa2, 0x14, 0x5c, 0xf2,
+	0x94, 0xec, 0xaf, 0x17, 0x18, 0x4b, 0xff, 0x4a, 0x93, 0xd8, 0x7b, 0xee, 0x99, 0x37, 0x55, 0xf3,
+	0x9b, 0x09, 0xa2, 0x23, 0x1e, 0xdf, 0xa5, 0x56, 0x50, 0x37, 0x21, 0x28, 0x80, 0x52, 0x8d, 0xc5,
+	0x45, 0x0a, 0x28, 0x2b, 0xa7, 0xe7, 0x9f, 0x40, 0xb0, 0xf1, 0xf0, 0xcc, 0x4d, 0x6e, 0x09, 0xa8,
+	0xf3, 0x9c, 0x14, 0x5c, 0xcb, 0x8b, 0xe7, 0x71, 0x66, 0x2d, 0xaf, 0xb5, 0x0e, 0x26, 0xb7, 0xfe,
+	0x54, 0x81, 0x95, 0xf7, 0xba, 0xdd, 0xde, 0x05, 0x03, 0x06, 0x36, 0x83, 0x60, 0xc7, 0x59, 0x31,
+	0x13, 0x29, 0xef, 0x3a, 0x66, 0x44, 0x43, 0x29, 0x2d, 0x46, 0x43, 0x21, 0x37, 0xad, 0xf2, 0x12,
+	0x37, 0xad, 0x8a, 0xee, 0xa6, 0xd5, 0xfa, 0xd9, 0x02, 0x2b, 0xed, 0xb6, 0x2f, 0x71, 0x3c, 0x56,
+	0x0b, 0x3b, 0x5b, 0x56, 0x51, 0xa0, 0x7a, 0xea, 0xe8, 0x31, 0x04, 0xcb, 0x5d, 0xe1, 0x50, 0x92,
+	0xbd, 0x0b, 0x4b, 0x85, 0xb2, 0xd5, 0xc2, 0xeb, 0x24, 0x74, 0xeb, 0x09, 0xab, 0xec, 0xb6, 0x87,
+	0x87, 0xfd, 0x8f, 0xd5, 0xaa, 0xb9, 0xa4, 0x70, 0xad, 0x3f, 0x5b, 0x61, 0x55, 0xfc, 0x37, 0xba,
+	0xa8, 0x6e, 0xc5, 0x1f, 0xbe, 0xc9, 0xae, 0xbc, 0x27, 0xce, 0xd5, 0x1d, 0x11, 0x81, 0x7e, 0x85,
+	0xdb, 0x62, 0x02, 0xcc, 0x3d, 0x06, 0x68, 0xfa, 0x77, 0xe7, 0xa6, 0x41, 0x95, 0xde, 0x13, 0xe7,
+	0x9a, 0x77, 0x88, 0x22, 0xa1, 0xbd, 0x40, 0x62, 0x6b, 0xdb, 0xf0, 0x09, 0x0d, 0x6f, 0xa1, 0xb1,
+	0x74, 0xaa, 0xb4, 0x02, 0x45, 0x42, 0xa5, 0xdf, 0x13, 0xe7, 0x10, 0xb9, 0x92, 0x7c, 0xdd, 0x25,
+	0x45, 0xf8, 0x41, 0xaf, 0x43, 0x13, 0x3e, 0x51, 0x9a, 0x6f, 0x7c, 0x2d, 0xeb, 0x1b, 0x7f, 0xd0,
+	0xeb, 0xec, 0x86, 0x61, 0x10, 0xd2, 0x4c, 0x9f, 0xd0, 0xba, 0x37, 0x81, 0x74, 0xf4, 0x50, 0x24,
+	0xac, 0x09, 0xf6, 0xdd, 0x28, 0xf1, 0x0f, 0x83, 0x1a, 0xa7, 0x9e, 0x1f, 0x79, 0x49, 0x28, 0xba,
+	0x0f, 0xde, 0x53, 0xf7, 0x64, 0x37, 0x48, 0x74, 0x27, 0x08, 0xf4, 0xcf, 0x7b, 0xe2, 0x5c, 0x73,
+	0x08, 0xa9, 0xf0, 0x14, 0x90, 0x11, 0x70, 0x67, 0x53, 0xf7, 0x1c, 0x83, 0x7f, 0x88, 0x10, 0xc5,
+	0x5a, 0x99, 0x9b, 0x20, 0xc8, 0xa2, 0x41, 0x00, 0x76, 0x66, 0x4b, 0xc6, 0x38, 0x42, 0x02, 0x79,
+	0xf9, 0xa8, 0x79, 0x85, 0xee, 0x74, 0x39, 0x92, 0x41, 0x41, 0x3b, 0x28, 0xc5, 0xca, 0x10, 0x14,
+	0xb4, 0x43, 0xce, 0x3e, 0x57, 0x13, 0x67, 0x1f, 0xb8, 0xb9, 0xa7, 0xd7, 0x21, 0xa7, 0x0d, 0x78,
+	0x84, 0xff, 0xa7, 0x8a, 0x50, 0x09, 0xc9, 0x25, 0xd3, 0x00, 0x71, 0x51, 0x98, 0x6d, 0x92, 0xeb,
+	0x52, 0xc3, 0xce, 0xe2, 0xad, 0xff, 0x59, 0x64, 0x6b, 0x47, 0x9c, 0x0f, 0x3f, 0xfe, 0xdd, 0xd6,
+	0x23, 0x2f, 0x84, 0x33, 0xac, 0x3c, 0x0e, 0x69, 0x95, 0x56, 0xe1, 0x06, 0x66, 0x88, 0x98, 0x4a,
+	0x46, 0xc4, 0xa0, 0x47, 0xe6, 0x1c, 0x82, 0xe7, 0x60, 0xf4, 0x14, 0xba, 0x0a, 0x51, 0x83, 0x0c,
+	0x4d, 0x64, 0x3d, 0xa3, 0x89, 0x40, 0x1a, 0x44, 0x4c, 0xee, 0xf9, 0x2a, 0x9c, 0x62, 0x42, 0x1b,
+	0xb3, 0x5a, 0x2d, 0x33, 0xab, 0xdd, 0x66, 0xb5, 0xde, 0x50, 0xad, 0x49, 0x18, 0x3a, 0x32, 0xa7,
+	0xc0, 0x8b, 0x18, 0x04, 0x2f, 0xbb, 0xd7, 0xfd, 0xab, 0x05, 0x38, 0x8b, 0x10, 0x8d, 0x83, 0xcb,
+	0x5e, 0x92, 0xb4, 0xf2, 0xbe, 0x09, 0x70, 0x65, 0x28, 0x19, 0xb7, 0x3d, 0x2c, 0x8d, 0x18, 0xb0,
+	0x9d, 0xb9, 0xfb, 0x48, 0xdd, 0x38, 0x63, 0x16, 0xc6, 0xbc, 0xf7, 0xe8, 0x11, 0xbb, 0x9a, 0x93,
+	0xfc, 0x31, 0x5c, 0x40, 0xf4, 0xc3, 0x6c, 0xab, 0xd3, 0x1d, 0xc2, 0x85, 0x24, 0x5d, 0xcf, 0x9d,
+	0x06, 0x27, 0x73, 0x75, 0x01, 0x52, 0x21, 0x09, 0x1b, 0x68, 0xb3, 0x32, 0xa4, 0xab, 0xc9, 0x01,
+	0x9e, 0x5b, 0xdf, 0x64, 0x1b, 0x9d, 0xee, 0x10, 0xd6, 0x8b, 0x4b, 0x03, 0x05, 0xc1, 0xba, 0x99,
+	0xd2, 0xe9, 0x00, 0x50, 0x42, 0xb7, 0x38, 0xb3, 0x3a, 0x70, 0x15, 0xd3, 0x33, 0x11, 0x2e, 0xfd,
+	0x5b, 0x58, 0xd3, 0x9d, 0x9c, 0xc5, 0x89, 0x4e, 0x4b, 0x14, 0xe0, 0xd4, 0x7c, 0x25, 0x5c, 0x2b,
+	0xab, 0x26, 0xfa, 0xd9, 0x02, 0x56, 0xc5, 0x99, 0xb9, 0xa1, 0x18, 0xba, 0x5e, 0x38, 0x0c, 0x76,
+	0xd1, 0x93, 0xc8, 0xd9, 0xdd, 0x0b, 0xe6, 0xe1, 0x23, 0x2f, 0x14, 0x74, 0xbf, 0x8c, 0x0e, 0xe1,
+	0x1a, 0xb4, 0xdb, 0x0e, 0xc7, 0xa7, 0xce, 0xa9, 0x1b, 0x92, 0x3f, 0x70, 0x95, 0x1b, 0x18, 0x7e,
+	0xa5, 0x4b, 0x62, 0xef, 0xd0, 0x27, 0xbd, 0x55, 0x87, 0xf0, 0x0c, 0xaa, 0xb3, 0x7b, 0xa8, 0xbc,
+	0x1b, 0x25, 0xd1, 0xfa, 0x67, 0x55, 0x66, 0x9b, 0xbd, 0x76, 0x89, 0x4b, 0x90, 0xbe, 0xc0, 0xaa,
+	0x9d, 0xee, 0x50, 0x6e, 0x7b, 0x15, 0x8d, 0x7d, 0x28, 0x05, 0xf3, 0x24, 0x03, 0xb4, 0xb1, 0xf4,
+	0xfa, 0x23, 0xb3, 0x4d, 0x8d, 0x27, 0xb4, 0x34, 0x71, 0xab, 0x98, 0x00, 0x32, 0x50, 0x48, 0x0a,
+	0x40, 0x2b, 0xd2, 0xed, 0x5d, 0xa4, 0x2f, 0x48, 0xca, 0xfe, 0x1a, 0xab, 0x1b, 0x97, 0x22, 0x99,
+	0x57, 0x1a, 0x75, 0x32, 0x57, 0xfb, 0x18, 0x79, 0xf5, 0x01, 0x22, 0x7d, 0x9a, 0x0d, 0x55, 0x77,
+	0xea, 0xc6, 0xa0, 0x54, 0xa9, 0xbb, 0x25, 0x15, 0x6d, 0xbf, 0x09, 0x17, 0x79, 0x24, 0x36, 0x84,
+	0x9a, 0xb1, 0x35, 0xd7, 0x1b, 0x0e, 0x44, 0xcc, 0xb5, 0x74, 0xa8, 0xd5, 0xd1, 0x68, 0x48, 0x87,
+	0xc5, 0xa4, 0x5b, 0x4c, 0x0a, 0xe0, 0x2e, 0xb1, 0x1b, 0x7b, 0x4f, 0x05, 0x32, 0xac, 0x0a, 0xa8,
+	0x9a, 0x20, 0x90, 0xbe, 0x37, 0x9f, 0x4e, 0x21, 0xd8, 0xa8, 0x78, 0x4e, 0x53, 0x95, 0x86, 0xd8,
+	0xef, 0xb0, 0x1a, 0xe4, 0xc3, 0xbb, 0xb3, 0x9a, 0x8d, 0x6c, 0xd5, 0xf5, 0x51, 0xc2, 0xd3, 0x8c,
+	0xea, 0xad, 0x07, 0x73, 0x11, 0x9e, 0x37, 0x37, 0x2f, 0x7e, 0x0b, 0x33, 0x62, 0x70, 0x51, 0x18,
+	0x00, 0x70, 0xd7, 0xe3, 0xfc, 0x4c, 0xfa, 0x0e, 0xc9, 0x45, 0xe8, 0x02, 0x8e, 0xb3, 0xd1, 0xe8,
+	0xa1, 0x52, 0xdb, 0x61, 0x07, 0xfa, 0x55, 0xd6, 0x40, 0xff, 0xd9, 0x89, 0x98, 0x8c, 0xc2, 0x79,
+	0x14, 0xab, 0x80, 0xab, 0x06, 0x08, 0xdc, 0xfd, 0xd0, 0x8f, 0xe1, 0x51, 0x4c, 0x3a, 0x87, 0x0e,
+	0x85, 0xb8, 0x31, 0x30, 0xfd, 0x2e, 0xad, 0xab, 0xe6, 0x5d, 0x5a, 0xa0, 0x2f, 0x9c, 0x47, 0x70,
+	0xe5, 0xcf, 0x35, 0xd2, 0x35, 0x91, 0x82, 0xff, 0xd6, 0x2e, 0x28, 0x12, 0x70, 0xcb, 0x3d, 0x70,
+	0x97, 0x09, 0xda, 0x6f, 0x69, 0xe3, 0xff, 0xba, 0xb1, 0x17, 0xa7, 0x49, 0x8e, 0x54, 0x26, 0xd8,
+	0x5f, 0x67, 0x75, 0xac, 0xb7, 0x52, 0x37, 0x6e, 0x18, 0xb7, 0x4a, 0x65, 0xc5, 0x05, 0x37, 0x32,
+	0xdb, 0x3f, 0xca, 0x36, 0x91, 0x6e, 0x3f, 0x75, 0xbd, 0x29, 0x84, 0xc3, 0x6f, 0x36, 0x57, 0xbf,
+	0x9e, 0xc9, 0x0e, 0x7c, 0xaf, 0x49, 0x0e, 0xd1, 0xbc, 0x99, 0xed, 0x46, 0x5d, 0xae, 0x70, 0x23,
+	0x2f, 0xac, 0xef, 0x77, 0x7d, 0x11, 0x9e, 0x9c, 0x3f, 0xf2, 0x22, 0xd1, 0xbc, 0x65, 0xac, 0xef,
+	0x3b, 0xdd, 0x61, 0x9a, 0xc6, 0xb5, 0x7c, 0xf6, 0x3b, 0xe9, 0x65, 0x5e, 0x2f, 0x5f, 0x38, 0x0f,
+	0xa8, 0xac, 0xad, 0xff, 0x51, 0x4c, 0xe5, 0x83, 0x7e, 0xd1, 0x52, 0x5d, 0x5e, 0xb4, 0x64, 0xfa,
+	0xbc, 0x15, 0x17, 0x7c, 0xde, 0xe0, 0x22, 0xcd, 0x29, 0x74, 0x7d, 0x78, 0xe0, 0x46, 0x6a, 0xef,
+	0xab, 0xc6, 0x4d, 0x10, 0x86, 0x2b, 0xfd, 0xdf, 0xdb, 0x2a, 0xb0, 0x9a, 0xa2, 0xf5, 0x41, 0x5e,
+	0x59, 0x30, 0x83, 0x39, 0xf3, 0xc7, 0x2a, 0x91, 0xb6, 0x80, 0x53, 0x44, 0xf3, 0x03, 0x5e, 0x37,
+	0xfc, 0x80, 0xd3, 0x7f, 0xdb, 0x56, 0x1a, 0x83, 0xa2, 0x61, 0x90, 0x50, 0xd1, 0xe8, 0xce, 0x43,
+	0x11, 0x92, 0x8b, 0xdc, 0x02, 0x8e, 0xcb, 0xbe, 0x67, 0x5e, 0x3c, 0x3e, 0x85, 0x55, 0x10, 0x89,
+	0x86, 0x04, 0xd0, 0xfe, 0xe5, 0x9e, 0x5a, 0x6d, 0x2b, 0x1a, 0x6c, 0x13, 0x07, 0xae, 0xef, 0x9e,
+	0xe0, 0x15, 0x0f, 0x28, 0x3a, 0xe4, 0x9a, 0x3b, 0x83, 0xb6, 0xbe, 0x5b, 0x66, 0x0d, 0xa3, 0x43,
+	0x71, 0x18, 0x2a, 0xb5, 0x0e, 0x75, 0x3d, 0xd9, 0x17, 0x26, 0x68, 0xb4, 0xa7, 0xb4, 0xc8, 0xa6,
+	0xed, 0x99, 0x6f, 0xa3, 0x69, 0xe4, 0x39, 0xc5, 0x42, 0xb0, 0xb1, 0xa9, 0xe6, 0x5c, 0x52, 0xe3,
+	0x3a, 0x64, 0xb4, 0x63, 0x25, 0xd3, 0x8e, 0x77, 0x18, 0x53, 0x91, 0x1d, 0xc9, 0x73, 0xa3, 0xc6,
+	0x35, 0x04, 0xdb, 0x0e, 0xc3, 0x7e, 0x0e, 0xc8, 0x7d, 0xa3, 0xc6, 0x53, 0xc0, 0x68, 0x3b, 0x79,
+	0x22, 0x34, 0x6d, 0x3b, 0x9b, 0x95, 0x79, 0x30, 0x15, 0xd4, 0x2b, 0xf8, 0xac, 0x1d, 0xe7, 0x65,
+	0xc6, 0x71, 0x5e, 0x75, 0x48, 0x78, 0x43, 0x3b, 0x24, 0x4c, 0x6a, 0xfd, 0x79, 0xd2, 0x40, 0xf2,
+	0x24, 0x98, 0x09, 0xca, 0x8d, 0xbe, 0xd9, 0xf4, 0x3c, 0x71, 0x79, 0xad, 0xf3, 0x14, 0x90, 0x5b,
+	0x9c, 0xb3, 0xe9, 0xb9, 0x52, 0x1f, 0x37, 0xd5, 0xe1, 0xed, 0x14, 0xcb, 0xfe, 0xcf, 0x36, 0xc5,
+	0x0e, 0x33, 0xc1, 0x6c, 0xae, 0x7b, 0xb4, 0x8c, 0x30, 0xc1, 0xd6, 0x2f, 0x15, 0x51, 0xd5, 0x30,
+	0x26, 0x3f, 0x50, 0x77, 0xee, 0x91, 0x11, 0x5f, 0xea, 0x19, 0x09, 0x0d, 0x69, 0xa3, 0x1d, 0xba,
+	0xb0, 0x8e, 0xae, 0xb2, 0x53, 0x34, 0xa4, 0x39, 0x43, 0xe3, 0x32, 0xbb, 0x84, 0xc6, 0x6f, 0x6e,
+	0x4b, 0x16, 0x26, 0xcd, 0x22, 0xa1, 0xa1, 0x8d, 0x7b, 0x11, 0x86, 0xb2, 0xa0, 0x2b, 0xed, 0x24,
+	0x85, 0x1e, 0xe9, 0xf7, 0x0f, 0x86, 0x7b, 0xde, 0x34, 0x26, 0x77, 0xe7, 0x2a, 0xd7, 0x10, 0x48,
+	0xef, 0xbf, 0x9d, 0x5c, 0xac, 0x47, 0x16, 0xaf, 0x14, 0xc1, 0xe5, 0x66, 0x24, 0x2f, 0xc5, 0xab,
+	0xd2, 0x72, 0x53, 0x92, 0x18, 0x8b, 0x4a, 0x9c, 0x05, 0xb1, 0x98, 0x9e, 0xcb, 0x71, 0xa1, 0x6c,
+	0xc6, 0x59, 0xb8, 0xf5, 0x25, 0x56, 0xc1, 0x99, 0x9b, 0xc2, 0xe9, 0x16, 0x92, 0x70, 0xba, 0x50,
+	0xe8, 0x21, 0xee, 0xdb, 0xd1, 0x0d, 0xef, 0x92, 0x6a, 0x7d, 0xb7, 0xc8, 0xb6, 0x06, 0x41, 0x18,
+	0x8b, 0xe9, 0x65, 0x95, 0x71, 0x63, 0xb9, 0x20, 0x3f, 0x96, 0x02, 0x92, 0x9d, 0xd1, 0xe5, 0x9a,
+	0x14, 0xa3, 0x3a, 0x4f, 0x01, 0xa8, 0x22, 0x5d, 0x20, 0xaa, 0xd6, 0xe1, 0x44, 0xc2, 0x7b, 0xe0,
+	0x81, 0x36, 0x03, 0x3b, 0xba, 0xda, 0x4f, 0x4e, 0x80, 0xd4, 0x8e, 0xbf, 0xa6, 0xdb, 0xf1, 0x6f,
+	0xb1, 0xea, 0x60, 0x7e, 0x26, 0xf7, 0xa6, 0x68, 0x31, 0xa4, 0x68, 0x65, 0xad, 0x71, 0xc7, 0xa4,
+	0xf5, 0x10, 0xa5, 0xac, 0x35, 0xee, 0x98, 0x86, 0x0d, 0x51, 0xad, 0xff, 0x5e, 0x64, 0xa5, 0x4e,
+	0x6f, 0x78, 0xa9, 0xf3, 0x6b, 0x32, 0xc8, 0x5b, 0x72, 0x33, 0xa2, 0xa4, 0x69, 0x20, 0x6b, 0x2a,
+	0x61, 0x85, 0xa7, 0x00, 0xd6, 0x1c, 0xdc, 0xb3, 0x93, 0xbd, 0x3b, 0x45, 0x22, 0xdb, 0x90, 0x4b,
+	0x56, 0xb2, 0x53, 0xa7, 0x21, 0x9a, 0xf0, 0x5e, 0x33, 0x84, 0xf7, 0x1b, 0xcc, 0x4a, 0x43, 0x50,
+	0x27, 0xe2, 0x1d, 0xf4, 0xf2, 0x05, 0x3c, 0x31, 0x33, 0x57, 0xb5, 0x80, 0xcb, 0x3f, 0x20, 0x8e,
+	0xcf, 0x7f, 0xb9, 0xc4, 0xca, 0xbb, 0x83, 0xcb, 0x04, 0xeb, 0x53, 0x57, 0xf1, 0xd2, 0xce, 0x1a,
+	0x91, 0xda, 0xaa, 0x8b, 0xb6, 0x94, 0x53, 0xab, 0x05, 0x9d, 0x10, 0x86, 0x53, 0xf6, 0x53, 0xa1,
+	0x76, 0xd1, 0x0c, 0x50, 0x6b, 0x5d, 0xba, 0x00, 0x45, 0x52, 0xf2, 0x6d, 0x98, 0xdc, 0x30, 0x40,
+	0xc7, 0xf3, 0x58, 0x79, 0x30, 0x18, 0xa0, 0xbe, 0xdf, 0xb7, 0x6e, 0xee, 0xf7, 0xed, 0xb3, 0x2d,
+	0x2a, 0xa0, 0xba, 0x9f, 0x91, 0xfc, 0x7c, 0x54, 0x14, 0x0f, 0xa8, 0x73, 0x26, 0x07, 0x74, 0x0b,
+	0xcf, 0xbe, 0xf6, 0x83, 0xd2, 0x4f, 0x3f, 0xca, 0x6e, 0x2c, 0x29, 0x32, 0x5e, 0xc7, 0x72, 0x36,
+	0x51, 0xb7, 0x4e, 0x76, 0xce, 0x26, 0xb9, 0x77, 0x08, 0xfd, 0x87, 0x82, 0x3a, 0x3d, 0x35, 0x0c,
+	0x83, 0x63, 0x6f, 0x2a, 0xe3, 0x53, 0xbb, 0x63, 0x34, 0x75, 0x48, 0x41, 0xa5, 0x48, 0xe9, 0xdf,
+	0x0a, 0x59, 0x0f, 0x5c, 0x7f, 0x7e, 0xec, 0x8e, 0xe3, 0x79, 0x48, 0xb1, 0xab, 0x6a, 0x3c, 0x27,
+	0x05, 0x8f, 0x77, 0x21, 0xda, 0x1b, 0xca, 0xc5, 0x69, 0x8d, 0xa7, 0x00, 0x9a, 0x04, 0x02, 0x3f,
+	0x76, 0xc7, 0xb1, 0x5a, 0x8e, 0x25, 0x74, 0xe6, 0x22, 0x88, 0x0a, 0xb2, 0x5d, 0xe6, 0x22, 0x88,
+	0x94, 0x2b, 0xd7, 0x72, 0x4e, 0x69, 0xc8, 0x70, 0x98, 0xeb, 0x68, 0xbe, 0x92, 0x44, 0xeb, 0x27,
+	0x64, 0x7c, 0x6c, 0x54, 0x09, 0x83, 0x50, 0x9d, 0x7f, 0x51, 0x61, 0xaf, 0x13, 0xc4, 0xd8, 0x86,
+	0xa0, 0x75, 0xba, 0xa2, 0xed, 0xd7, 0xa4, 0xc4, 0x8b, 0xc8, 0x3d, 0x4e, 0x6d, 0xed, 0xc2, 0xdb,
+	0x88, 0x4b, 0x19, 0x18, 0xb5, 0xbe, 0xce, 0x6a, 0x09, 0x26, 0xcf, 0x49, 0xc8, 0x9a, 0x14, 0xb0,
+	0x40, 0x8a, 0x4c, 0x0b, 0x5a, 0xd4, 0x0b, 0xfa, 0x2b, 0x6b, 0x20, 0xcb, 0x55, 0x77, 0xd8, 0xac,
+	0xac, 0xf5, 0x45, 0x59, 0xc5, 0x67, 0xd6, 0x9a, 0xa7, 0xb8, 0xd0, 0x3c, 0x77, 0xd9, 0xc6, 0x7d,
+	0x11, 0x4c, 0xd5, 0x6a, 0x43, 0xea, 0xb4, 0x3a, 0x84, 0x0b, 0xe5, 0x81, 0x03, 0x0a, 0x47, 0xd2,
+	0xf8, 0x8a, 0xce, 0xb9, 0xc1, 0xa4, 0x72, 0xb9, 0x1b, 0x4c, 0xd6, 0xf2, 0x6e, 0x30, 0x81, 0xd3,
+	0xea, 0x33, 0x79, 0x97, 0x45, 0x12, 0x3b, 0xb8, 0xc6, 0x0d, 0xcc, 0xfe, 0x26, 0xab, 0x7d, 0xcb,
+	0xbd, 0xb7, 0xef, 0x46, 0xa7, 0x42, 0x1d, 0x0e, 0x7d, 0x25, 0x59, 0xf1, 0x52, 0x43, 0xbc, 0x95,
+	0xe4, 0x90, 0xe1, 0x6c, 0xd2, 0x37, 0xe0, 0xf5, 0xf4, 0x3e, 0x8a, 0xda, 0x92, 0xd7, 0x93, 0x1c,
+	0xf4, 0x7a, 0x42, 0xa7, 0xbd, 0xc0, 0xb4, 0x5e, 0xb0, 0xdf, 0x82, 0xb8, 0x71, 0x3d, 0x08, 0xd9,
+	0xa8, 0xaf, 0x45, 0xd2, 0xef, 0x41, 0xa2, 0xfc, 0x14, 0xe6, 0xb3, 0x3f, 0xc7, 0xaa, 0x34, 0xaa,
+	0x55, 0xfc, 0xc6, 0x0d, 0x8d, 0x3b, 0x78, 0x92, 0x08, 0x19, 0x69, 0x90, 0xc3, 0x01, 0xc0, 0xc5,
+	0x8c, 0x2a, 0xd1, 0xbe, 0xc7, 0x36, 0x69, 0x40, 0x88, 0x89, 0xcc, 0xbe, 0xb9, 0x98, 0x3d, 0x93,
+	0xe5, 0x92, 0x57, 0x3c, 0xde, 0xfa, 0x06, 0xdb, 0x34, 0xdb, 0xf3, 0x85, 0x42, 0xe5, 0x1c, 0xb0,
+	0x4d, 0xb3, 0x39, 0x73, 0xde, 0xfe, 0xac, 0xfe, 0x76, 0x6a, 0xb4, 0x51, 0xef, 0xe9, 0x9f, 0xfb,
+	0x11, 0x56, 0x4b, 0x5a, 0xf3, 0xa2, 0x72, 0x94, 0xb4, 0x17, 0x5b, 0x3f, 0x96, 0x0e, 0xd5, 0x15,
+	0xa3, 0x0c, 0x04, 0x8d, 0x1b, 0x8b, 0x93, 0x20, 0x3c, 0x57, 0x03, 0x5a, 0xd1, 0xad, 0xbf, 0x5f,
+	0x92, 0xa1, 0xcc, 0x2f, 0xde, 0x0f, 0xca, 0x86, 0xc2, 0xcf, 0xcc, 0x70, 0x25, 0x7d, 0xff, 0x07,
+	0xda, 0x35, 0x89, 0xa8, 0xe6, 0x46, 0xa7, 0x86, 0xed, 0xaf, 0x62, 0xda, 0xfe, 0xa0, 0x7a, 0x18,
+	0xfe, 0x40, 0x9d, 0x18, 0x47, 0x02, 0x67, 0x40, 0xdc, 0x97, 0xa5, 0xd5, 0x07, 0x51, 0xd9, 0x30,
+	0x66, 0xd5, 0xc5, 0x30, 0x66, 0x2a, 0xa2, 0x5b, 0x4d, 0x8b, 0xe8, 0xb6, 0x24, 0x4a, 0x16, 0x5b,
+	0x1e, 0x25, 0xeb, 0x45, 0x0c, 0xcc, 0x1f, 0xe5, 0x2a, 0xd2, 0x4c, 0x98, 0x83, 0xad, 0x55, 0x61,
+	0x0e, 0x56, 0x06, 0x24, 0x9d, 0xb0, 0xba, 0x73, 0x30, 0x1a, 0x26, 0x0a, 0x5f, 0x36, 0xa6, 0x6f,
+	0x21, 0x27, 0xa6, 0x2f, 0x84, 0x9c, 0x56, 0xa1, 0x9e, 0x94, 0xb2, 0x9c, 0x00, 0xb9, 0x41, 0xbd,
+	0x1f, 0xb1, 0x0d, 0xf9, 0x2f, 0xd2, 0xbc, 0xa2, 0xe9, 0x3d, 0x34, 0x47, 0x12, 0x89, 0xe6, 0xfe,
+	0xf0, 0x64, 0x7e, 0xa6, 0x76, 0xfe, 0x6b, 0x3c, 0xa1, 0x73, 0x3f, 0xbc, 0x2b, 0x3f, 0xac, 0x5e,
+	0xcf, 0x7c, 0xb8, 0x92, 0x7e, 0x78, 0x65, 0x99, 0x5b, 0x7f, 0xb1, 0xc8, 0xca, 0xf0, 0x9d, 0x8b,
+	0x4f, 0xcb, 0xf6, 0xd2, 0x7d, 0x28, 0x75, 0x60, 0x5d, 0x83, 0x32, 0x21, 0x93, 0x4b, 0x0b, 0x21,
+	0x93, 0x5f, 0x24, 0x76, 0xc3, 0x47, 0xb9, 0x46, 0x15, 0xb5, 0x0f, 0x6f, 0xda, 0xeb, 0xaa, 0x4d,
+	0x0f, 0x45, 0x4a, 0x7d, 0x01, 0xdb, 0x42, 0x0a, 0xe5, 0x1a, 0x4f, 0xe8, 0xcb, 0x06, 0x47, 0xfe,
+	0x95, 0x12, 0xab, 0x76, 0x3d, 0xea, 0xe6, 0x17, 0xda, 0xdc, 0x68, 0x18, 0x41, 0x75, 0xd3, 0xb3,
+	0x2e, 0x0d, 0xed, 0x02, 0xec, 0x4c, 0xe0, 0xaa, 0x86, 0x11, 0xb8, 0x8a, 0xf8, 0xdd, 0xf5, 0x27,
+	0xc8, 0x95, 0x74, 0x62, 0x40, 0x83, 0x70, 0xa7, 0x3f, 0x9d, 0x14, 0x93, 0xf3, 0x24, 0x26, 0x88,
+	0x86, 0x0b, 0x8a, 0x86, 0x9a, 0x9c, 0x12, 0xd2, 0x10, 0x48, 0xdf, 0xf5, 0x27, 0xa3, 0x60, 0xd7,
+	0x9f, 0xd0, 0x59, 0xf7, 0x06, 0xd7, 0x10, 0x70, 0xd0, 0x6e, 0x1f, 0x0d, 0xd5, 0x34, 0xa9, 0x1c,
+	0xb4, 0xdb, 0x47, 0x43, 0x8e, 0xf8, 0x0f, 0xca, 0x79, 0xdc, 0x9f, 0x2e, 0xb1, 0x52, 0xfb, 0x68,
+	0x88, 0x8d, 0x12, 0xc7, 0xa1, 0xf7, 0x78, 0x1e, 0xa7, 0xc3, 0xb9, 0xc1, 0x4d, 0xd0, 0xc8, 0xa5,
+	0xc9, 0x69, 0x13, 0x84, 0xf5, 0x7a, 0x02, 0xec, 0xa1, 0x3b, 0x03, 0x8d, 0xc4, 0x2c, 0x9c, 0x76,
+	0x71, 0x59, 0xef, 0xe2, 0xdb, 0xac, 0x26, 0x3d, 0x8f, 0xa0, 0x87, 0x65, 0x07, 0xa6, 0x00, 0xcc,
+	0x5b, 0x69, 0xa8, 0x31, 0x78, 0x84, 0xae, 0x38, 0x12, 0xfe, 0x24, 0x08, 0xb1, 0xe0, 0xd4, 0x55,
+	0x29, 0x92, 0xa6, 0x6b, 0x67, 0xa7, 0x35, 0x04, 0x18, 0x5e, 0x52, 0xe4, 0x28, 0x5d, 0xe3, 0x09,
+	0x8d, 0x61, 0x16, 0xc5, 0x38, 0x98, 0x88, 0x89, 0xdc, 0xc3, 0xa2, 0x1b, 0x43, 0x74, 0x4c, 0xbf,
+	0x21, 0x71, 0x43, 0xb2, 0x30, 0x91, 0xe9, 0xd6, 0x57, 0x5d, 0xdb, 0xfa, 0xc2, 0xff, 0x83, 0x07,
+	0xa8, 0x46, 0x03, 0x5f, 0x48, 0xe8, 0xd6, 0xef, 0x17, 0x58, 0x79, 0x78, 0x38, 0xbc, 0x77, 0xf1,
+	0x4a, 0x3c, 0xb9, 0xc4, 0xa4, 0x98, 0xb9, 0xe4, 0x04, 0x0c, 0x3b, 0xea, 0xf2, 0x12, 0xda, 0x9b,
+	0x51, 0x34, 0xee, 0xcd, 0xc0, 0x86, 0x69, 0xf0, 0x44, 0xa8, 0x90, 0x77, 0x29, 0x00, 0x72, 0x13,
+	0xc2, 0x8f, 0xd2, 0xcc, 0x89, 0xcf, 0x32, 0x6a, 0x5e, 0x14, 0x91, 0x18, 0xc2, 0x67, 0x5d, 0x76,
+	0xac, 0x2f, 0x97, 0x1d, 0xd5, 0xa5, 0xb2, 0xa3, 0xb6, 0x8a, 0x23, 0x7f, 0xa6, 0xc2, 0xca, 0xf0,
+	0xb9, 0x8b, 0xe3, 0xfa, 0x72, 0x11, 0xcf, 0x43, 0x1f, 0x63, 0xfa, 0xc9, 0x36, 0xd0, 0x10, 0xbc,
+	0x3a, 0x25, 0xa4, 0x40, 0x5a, 0x35, 0x8e, 0xcf, 0x78, 0x9f, 0x58, 0x40, 0xd5, 0x2e, 0x8e, 0x02,
+	0xa0, 0x3b, 0xca, 0x6f, 0xa5, 0xd8, 0x91, 0xa1, 0x85, 0xe6, 0x8f, 0x7f, 0x42, 0x8c, 0x95, 0x8e,
+	0xa0, 0x48, 0x9a, 0x51, 0x94, 0x8e, 0x80, 0xcf, 0x50, 0x3e, 0x92, 0x3b, 0x24, 0x00, 0x6a, 0x3c,
+	0x05, 0x64, 0xf9, 0xe8, 0x36, 0x83, 0x88, 0xd8, 0x4a, 0x43, 0xe0, 0xed, 0x9e, 0x8f, 0xd6, 0xbd,
+	0x51, 0xa0, 0x8c, 0xc6, 0x09, 0x20, 0x03, 0xc3, 0xc9, 0xa8, 0xaa, 0xae, 0x7f, 0x32, 0x07, 0xb7,
+	0x05, 0x29, 0x11, 0xb2, 0x30, 0x2c, 0x22, 0xf6, 0xdd, 0x48, 0xba, 0xed, 0xca, 0x08, 0x02, 0x72,
+	0x77, 0x29, 0x83, 0x42, 0xbe, 0xf7, 0xe5, 0x8d, 0x09, 0x2e, 0x3a, 0x1a, 0xa9, 0xe8, 0xaa, 0x19,
+	0x34, 0xab, 0xf7, 0x6c, 0xe6, 0x86, 0x6f, 0xdd, 0xf5, 0x9f, 0x8a, 0x69, 0x30, 0x13, 0xa3, 0x80,
+	0x14, 0x0a, 0x0d, 0xb1, 0x7f, 0x88, 0x95, 0x31, 0x92, 0xa5, 0x65, 0xf8, 0x45, 0x43, 0x97, 0x0e,
+	0xdd, 0x30, 0xe6, 0x98, 0x68, 0x30, 0xf0, 0x95, 0x15, 0x0c, 0x6c, 0x67, 0x18, 0x38, 0x75, 0x97,
+	0xa8, 0xf1, 0xa2, 0x1a, 0x9f, 0x53, 0x0f, 0x0c, 0x77, 0xd8, 0x41, 0xd7, 0xd4, 0xf8, 0x4c, 0x31,
+	0xf4, 0x5b, 0xc3, 0x3a, 0x52, 0xb8, 0x3a, 0xa2, 0x2e, 0x7b, 0x35, 0xcd, 0x3f, 0x28, 0xb0, 0xaa,
+	0x2a, 0xbd, 0xb6, 0x59, 0x2c, 0xff, 0xff, 0x5e, 0x72, 0x40, 0xac, 0x68, 0x44, 0x06, 0x55, 0x2f,
+	0xbc, 0xa5, 0x87, 0x16, 0xa5, 0xac, 0xea, 0x2e, 0x11, 0xe5, 0x8b, 0x58, 0xe3, 0x8a, 0x84, 0xaa,
+	0x83, 0x96, 0xec, 0xab, 0xbb, 0xa4, 0x6a, 0x3c, 0xa1, 0x6f, 0x7d, 0x95, 0x6d, 0x7c, 0xc4, 0x90,
+	0x9b, 0xad, 0x0e, 0xdb, 0x00, 0xa1, 0xf2, 0x7d, 0x69, 0x55, 0xad, 0x1d, 0x56, 0x97, 0x1f, 0x21,
+	0x0d, 0x65, 0xf9, 0x57, 0x40, 0x3e, 0x90, 0xb3, 0x8d, 0xfc, 0x88, 0x22, 0x5b, 0x7f, 0xab, 0xc4,
+	0xaa, 0x4e, 0x70, 0x1c, 0x83, 0xf5, 0xff, 0x62, 0xc5, 0x60, 0x18, 0x06, 0x93, 0xf9, 0x58, 0x95,
+	0x44, 0x91, 0xb8, 0x11, 0x8f, 0xf2, 0x59, 0x85, 0x58, 0x96, 0x94, 0xae, 0x4a, 0x94, 0xcd, 0x6d,
+	0xe0, 0xd7, 0xd8, 0xa6, 0x61, 0x7b, 0x51, 0xb1, 0xea, 0x33, 0x28, 0xee, 0x24, 0xa1, 0xfa, 0x8f,
+	0x33, 0x05, 0xed, 0x56, 0xa4, 0x08, 0xa4, 0x77, 0x87, 0x3d, 0x2e, 0xa2, 0xf9, 0x34, 0x56, 0xb2,
+	0x4f, 0x43, 0x50, 0x80, 0x48, 0x9b, 0x27, 0x09, 0x04, 0x45, 0xca, 0x99, 0x2e, 0x78, 0xa6, 0xee,
+	0x3d, 0x90, 0x44, 0xfa, 0x7f, 0xa8, 0xae, 0x32, 0xfd, 0xff, 0x94, 0x91, 0x72, 0x10, 0xc4, 0x74,
+	0x9f, 0x41, 0x8d, 0x4b, 0x02, 0xfe, 0xe5, 0x91, 0x78, 0x1c, 0x79, 0xb1, 0xa0, 0xe5, 0x81, 0x22,
+	0x81, 0x3b, 0x0f, 0x1d, 0x1a, 0xd8, 0xc5, 0x43, 0x07, 0x63, 0x50, 0xa6, 0x9a, 0xbe, 0x5c, 0xd7,
+	0xd6, 0xb8, 0x81, 0x5d, 0x72, 0x21, 0xdb, 0xfa, 0x8d, 0x52, 0x52, 0xb7, 0x4b, 0x84, 0x2f, 0x52,
+	0xb3, 0x12, 0xd8, 0xde, 0x2f, 0xba, 0x2f, 0x4d, 0x5b, 0xe7, 0xed, 0xb8, 0xbe, 0x9f, 0xcc, 0x3f,
+	0x44, 0x2d, 0x44, 0xbf, 0xd2, 0xed, 0x44, 0x49, 0xb3, 0xae, 0xeb, 0xcd, 0xaa, 0xb1, 0x4e, 0x75,
+	0x19, 0xeb, 0xd4, 0x96, 0xb1, 0x0e, 0x33, 0x59, 0x27, 0xbf, 0x0b, 0xee, 0xb2, 0x0d, 0xed, 0xde,
+	0x4e, 0xd2, 0xca, 0x74, 0x28, 0xc9, 0x41, 0x97, 0x7d, 0x36, 0xb4, 0x1c, 0x12, 0x92, 0x37, 0x4c,
+	0x45, 0xb1, 0xaf, 0xae, 0xfe, 0xaa, 0xf1, 0x84, 0xa6, 0x8e, 0xdc, 0x5a, 0xda, 0x91, 0xd6, 0xca,
+	0x8e, 0x5c, 0x79, 0x9c, 0xf7, 0x3f, 0x16, 0xd8, 0x46, 0x27, 0x14, 0x18, 0x08, 0x10, 0x2e, 0x6f,
+	0xbc, 0xf8, 0x1a, 0x61, 0xe2, 0xe8, 0xa2, 0xc9, 0xd1, 0x30, 0xc1, 0x4e, 0x83, 0x67, 0xc9, 0x04,
+	0x3b, 0x0d, 0x9e, 0x25, 0x0a, 0x44, 0x59, 0x53, 0x20, 0xa0, 0xfb, 0xdc, 0x28, 0x7a, 0x16, 0x84,
+	0x93, 0xe4, 0x42, 0x2c, 0xa2, 0xd3, 0xc6, 0x5d, 0xcb, 0x34, 0xae, 0xbe, 0x64, 0x5d, 0x5f, 0xb5,
+	0x64, 0x5d, 0x19, 0xac, 0xee, 0x7f, 0x17, 0x58, 0xc9, 0x71, 0xf6, 0x2f, 0x8e, 0x35, 0xb3, 0xdf,
+	0x76, 0x9c, 0x7d, 0x25, 0x36, 0x91, 0xc8, 0xad, 0x5e, 0x52, 0xdc, 0xb2, 0x5e, 0xdc, 0xc4, 0xae,
+	0x50, 0xd1, 0xed, 0x0a, 0xe0, 0xe0, 0x3d, 0x3d, 0x09, 0x42, 0x2f, 0x3e, 0x3d, 0x53, 0xf5, 0xd3,
+	0x10, 0x3c, 0x9a, 0xae, 0x98, 0x43, 0x6e, 0x86, 0x25, 0x74, 0xb6, 0x01, 0xaa, 0xab, 0x1a, 0x60,
+	0xa5, 0x2e, 0xf5, 0x67, 0x8a, 0xac, 0x71, 0x34, 0x9f, 0xfa, 0x22, 0x94, 0xfb, 0x85, 0xe7, 0x97,
+	0x8e, 0x3c, 0x26, 0x67, 0x37, 0x08, 0x2c, 0x40, 0xde, 0xa4, 0x9a, 0x7d, 0x53, 0x83, 0xe4, 0x5c,
+	0xfd, 0x54, 0xa0, 0x3f, 0x5f, 0x59, 0xcd, 0xd5, 0x92, 0xc6, 0x41, 0xb5, 0xed, 0x8c, 0x83, 0x50,
+	0x50, 0xd3, 0x28, 0x52, 0x5e, 0x80, 0x31, 0x86, 0x2b, 0x64, 0xc4, 0x38, 0x0e, 0x54, 0x7c, 0x7b,
+	0x03, 0x93, 0x5a, 0x79, 0x18, 0x69, 0xb6, 0xcc, 0x84, 0x4e, 0x3b, 0xa2, 0xaa, 0x77, 0xc4, 0x17,
+	0xd2, 0xb9, 0x85, 0x9a, 0x45, 0x29, 0x1f, 0x0a, 0xe6, 0x49, 0x86, 0xd6, 0x9f, 0x2b, 0x62, 0x88,
+	0xe7, 0x69, 0xe0, 0xc5, 0x1f, 0x7b, 0xa3, 0xa8, 0x6b, 0xfb, 0x68, 0x18, 0xc0, 0x73, 0x5a, 0xe4,
+	0x8a, 0x5e, 0x64, 0xa5, 0x57, 0xae, 0x69, 0x7a, 0x25, 0x06, 0x90, 0x81, 0x8b, 0x59, 0x95, 0x45,
+	0x4a, 0x52, 0xe8, 0xec, 0x77, 0x3e, 0xa3, 0x2a, 0xc3, 0xa3, 0xe1, 0xdd, 0x54, 0xcb, 0x78, 0x37,
+	0x29, 0xa9, 0xcb, 0x48, 0x6f, 0x07, 0xa9, 0xab, 0x37, 0xd0, 0xc6, 0x45, 0x0d, 0xf4, 0x4b, 0x25,
+	0x56, 0x69, 0x4f, 0x45, 0x18, 0x7f, 0x04, 0x93, 0xdd, 0xc5, 0x4d, 0x94, 0x7f, 0xe7, 0x84, 0xb6,
+	0xd0, 0x25, 0x8e, 0x59, 0xb8, 0x41, 0x7b, 0x6d, 0xc9, 0x0d, 0xda, 0xe4, 0xf8, 0x45, 0x24, 0xe4,
+	0x3f, 0xe8, 0x8d, 0xf8, 0xae, 0xe2, 0x10, 0x24, 0x30, 0xc4, 0xc6, 0x90, 0x8b, 0xd9, 0x3c, 0x4e,
+	0x23, 0xf0, 0xd4, 0xb8, 0x81, 0x2d, 0xf5, 0x21, 0xc8, 0x9e, 0x9a, 0xc8, 0x4c, 0x43, 0xb2, 0x73,
+	0xeb, 0x7a, 0xe7, 0x26, 0x57, 0x97, 0xb9, 0x53, 0x15, 0xbf, 0x49, 0x7a, 0x10, 0x64, 0x61, 0x70,
+	0xea, 0xe6, 0x22, 0xa6, 0x7b, 0xc7, 0xc0, 0xc4, 0x4c, 0xd9, 0xa5, 0x3f, 0x41, 0x6e, 0xda, 0x1b,
+	0xff, 0x6d, 0x53, 0xfa, 0x3e, 0xda, 0x0d, 0x56, 0x1b, 0x74, 0x3e, 0x90, 0xaa, 0xa1, 0xf5, 0x29,
+	0xbb, 0xce, 0xaa, 0x83, 0xce, 0x07, 0x3b, 0x6e, 0x3c, 0x3e, 0xb5, 0x0a, 0xf6, 0x15, 0xd6, 0x18,
+	0x74, 0x3e, 0xe8, 0x04, 0xbe, 0x2f, 0x83, 0xfd, 0x59, 0x25, 0x7b, 0x8b, 0x6d, 0x0c, 0x3a, 0x1f,
+	0xec, 0xc6, 0xa7, 0x22, 0xf4, 0x45, 0x6c, 0xad, 0xdb, 0x8c, 0xad, 0x0d, 0x3a, 0x1f, 0xb4, 0xf9,
+	0xd0, 0xaa, 0xd2, 0xdb, 0xdd, 0x20, 0x7e, 0xfb, 0x81, 0x55, 0xd3, 0xa8, 0xb7, 0x2d, 0x46, 0x2f,
+	0x22, 0xf5, 0xe0, 0xd0, 0xb1, 0x36, 0xec, 0x97, 0xd8, 0x15, 0x05, 0xec, 0x8f, 0xe8, 0x10, 0x81,
+	0x55, 0xb7, 0x9b, 0xec, 0xda, 0x02, 0x7c, 0xb4, 0x3f, 0xb2, 0x1a, 0xf6, 0x0d, 0x76, 0x75, 0x21,
+	0x65, 0x7f, 0x64, 0x6d, 0xe6, 0xbe, 0x72, 0xb0, 0xb7, 0x63, 0x6d, 0xd9, 0x77, 0xd9, 0x6d, 0x95,
+	0x22, 0xef, 0x58, 0x74, 0x67, 0x6e, 0x9c, 0x1e, 0x7e, 0xb1, 0x2c, 0xdb, 0x62, 0x75, 0x95, 0x03,
+	0xc2, 0x05, 0x58, 0x57, 0xec, 0x9b, 0xec, 0xa5, 0x41, 0xe7, 0x03, 0xc8, 0xde, 0x77, 0xcf, 0x45,
+	0x98, 0x6c, 0xed, 0x5b, 0xb6, 0x7d, 0x8d, 0x59, 0x90, 0xd4, 0xef, 0x0e, 0x69, 0xeb, 0xbd, 0xd7,
+	0xb5, 0xae, 0x52, 0x2b, 0x01, 0x2a, 0xbd, 0x11, 0xad, 0x6b, 0xf6, 0x1d, 0x76, 0x2b, 0xf7, 0x1b,
+	0xb8, 0x52, 0xb7, 0x5e, 0xb2, 0x6d, 0xb6, 0xa9, 0xb5, 0x62, 0x67, 0x34, 0xb4, 0xae, 0x53, 0xf5,
+	0x34, 0x0c, 0x97, 0x73, 0xd6, 0x0d, 0xfb, 0xd3, 0xec, 0x66, 0xee, 0xc7, 0xc0, 0x2d, 0xd3, 0x6a,
+	0xda, 0xb7, 0xd8, 0x75, 0xfa, 0x7b, 0xe7, 0x3c, 0xd2, 0x9d, 0x3b, 0xac, 0x9b, 0xf4, 0x4d, 0x2c,
+	0xb0, 0x9e, 0x70, 0xcb, 0xbe, 0xce, 0x6c, 0x4a, 0xd0, 0xdc, 0xdf, 0xac, 0x97, 0x55, 0xe5, 0xfb,
+	0xdd, 0xe1, 0x61, 0x78, 0xa2, 0x36, 0x2a, 0x47, 0xfd, 0x23, 0xeb, 0xb6, 0xbd, 0xc1, 0xd6, 0x07,
+	0x9d, 0x0f, 0x7a, 0xc3, 0xa7, 0xef, 0x58, 0x9f, 0xa6, 0x3a, 0x03, 0x21, 0x37, 0x6d, 0xad, 0x3b,
+	0x69, 0xfa, 0xbb, 0xd6, 0x2b, 0xc4, 0x56, 0x78, 0xe9, 0xca, 0x3b, 0xd6, 0x5d, 0x9d, 0x7c, 0xd7,
+	0xfa, 0x8c, 0xdd, 0x62, 0x77, 0x12, 0x52, 0x9d, 0xab, 0x45, 0x77, 0xeb, 0xd8, 0x8b, 0xd0, 0x6f,
+	0xc9, 0x6a, 0x51, 0xd7, 0xe9, 0xd7, 0xc0, 0x98, 0x39, 0x7e, 0xc8, 0xbe, 0xca, 0xb6, 0x92, 0x1c,
+	0x54, 0x8a, 0x57, 0x89, 0x1d, 0x1f, 0x76, 0x87, 0xd6, 0x67, 0xe9, 0x79, 0xd4, 0x19, 0x5a, 0xaf,
+	0x51, 0x3f, 0x8f, 0xd4, 0x25, 0xa1, 0xd6, 0xe7, 0xa8, 0xbc, 0x0e, 0x34, 0xfe, 0xeb, 0x94, 0xb5,
+	0x3b, 0x70, 0xac, 0xcf, 0x2b, 0x76, 0xca, 0xde, 0xf4, 0x6c, 0xbd, 0x41, 0xd5, 0x90, 0xb7, 0x15,
+	0x5b, 0x5f, 0xd0, 0x48, 0x7e, 0x64, 0xbd, 0xa9, 0xf8, 0x1d, 0x6e, 0xed, 0xb5, 0xbe, 0x48, 0x5d,
+	0xac, 0x5d, 0xc3, 0x6b, 0xbd, 0xa5, 0x5e, 0xc0, 0xcb, 0x74, 0xad, 0x2f, 0x51, 0x23, 0xa6, 0x37,
+	0x97, 0x5a, 0x5f, 0xd6, 0x73, 0xbc, 0x6b, 0xbd, 0x4d, 0x55, 0xd4, 0x2f, 0xbe, 0xb4, 0xb6, 0xa9,
+	0xac, 0xfd, 0x7e, 0xc7, 0xba, 0x47, 0xcf, 0x83, 0xd1, 0xd0, 0x7a, 0x87, 0x9e, 0x9d, 0xde, 0xd0,
+	0xfa, 0x61, 0xd5, 0x19, 0xf7, 0x0f, 0x86, 0xd6, 0xbb, 0x54, 0xa1, 0x85, 0xfb, 0xc0, 0xac, 0x1f,
+	0x51, 0x4d, 0xa8, 0x5d, 0xde, 0x64, 0x7d, 0x85, 0x78, 0x60, 0xf1, 0x46, 0x27, 0xeb, 0xab, 0xaa,
+	0xe3, 0x96, 0x5f, 0xf6, 0x64, 0x7d, 0x4d, 0xb5, 0xeb, 0xa0, 0x3d, 0xb4, 0xbe, 0xae, 0xf8, 0x24,
+	0xb9, 0x6f, 0xc9, 0xfa, 0x86, 0xfd, 0x19, 0xf6, 0xe9, 0x85, 0xce, 0xd7, 0x6f, 0xf8, 0xb1, 0xbe,
+	0x69, 0xbf, 0xc2, 0x5e, 0xce, 0xf4, 0xbd, 0x91, 0xe1, 0x0f, 0xd0, 0x7f, 0xc0, 0x45, 0x0e, 0xd6,
+	0x8f, 0x92, 0x20, 0x31, 0xaf, 0x3b, 0xb0, 0x7e, 0xcc, 0xde, 0x64, 0x0c, 0xcb, 0x8a, 0xb1, 0x95,
+	0xad, 0x36, 0x09, 0x20, 0x15, 0x7e, 0xd8, 0xda, 0xa1, 0xb6, 0x96, 0xe1, 0x6b, 0xad, 0x8e, 0xd6,
+	0x16, 0x2a, 0xf0, 0xa1, 0xd5, 0xa5, 0x3e, 0xc5, 0x28, 0xb3, 0xd6, 0xae, 0x62, 0x2e, 0x67, 0xc7,
+	0xda, 0x53, 0xbd, 0xd0, 0x39, 0xb0, 0xee, 0x53, 0x71, 0x20, 0x32, 0xa1, 0xb5, 0x4f, 0x9f, 0x95,
+	0x11, 0x01, 0xad, 0x1e, 0x91, 0x32, 0x3c, 0x9d, 0xf5, 0x2d, 0x9d, 0xbc, 0x67, 0xbd, 0x47, 0x5f,
+	0xd9, 0xd9, 0xeb, 0x5a, 0x7d, 0x7a, 0xbe, 0xcf, 0x77, 0xad, 0x03, 0xfa, 0x22, 0x9c, 0xcc, 0xb2,
+	0x06, 0x94, 0xb0, 0xdb, 0x1e, 0x5a, 0x87, 0xf4, 0xbe, 0x3c, 0x7f, 0x61, 0x0d, 0xa9, 0x7c, 0x78,
+	0x56, 0xc8, 0x7a, 0xa0, 0x84, 0x33, 0x9d, 0x1c, 0xb2, 0x38, 0x35, 0x8d, 0xe9, 0x9a, 0x69, 0x39,
+	0xd4, 0xc3, 0x8b, 0x4e, 0xde, 0xd6, 0xc8, 0x7e, 0x99, 0xdd, 0x90, 0x55, 0x5c, 0x08, 0xf1, 0x69,
+	0x3d, 0x24, 0xa9, 0x91, 0x71, 0x79, 0xb2, 0x8e, 0xa8, 0x80, 0x9d, 0xde, 0xd0, 0x7a, 0x44, 0x25,
+	0x07, 0x77, 0x07, 0xeb, 0x7d, 0x12, 0x98, 0xc6, 0x3a, 0xd9, 0xfa, 0xb6, 0xaa, 0x1c, 0x10, 0xdf,
+	0x21, 0x02, 0x76, 0x45, 0xac, 0x1f, 0x57, 0x93, 0x04, 0x19, 0xff, 0xad, 0x3f, 0x48, 0xa9, 0x60,
+	0x39, 0xb0, 0xfe, 0x50, 0xda, 0xd1, 0x5a, 0xb4, 0x7c, 0xeb, 0x0f, 0xd3, 0x4b, 0x4a, 0xf5, 0xb0,
+	0x3e, 0xa0, 0x9e, 0xa7, 0xa5, 0x86, 0xf5, 0x47, 0x68, 0x28, 0x6a, 0xcb, 0x16, 0xcb, 0x55, 0x83,
+	0xc5, 0xd9, 0xb7, 0x1e, 0x53, 0x29, 0x0d, 0x55, 0xd7, 0x1a, 0xd3, 0x57, 0x48, 0xcb, 0xb3, 0x26,
+	0x24, 0x41, 0x92, 0xad, 0x65, 0x4b, 0xa8, 0x6e, 0x77, 0xbd, 0xa9, 0x75, 0x4c, 0x3d, 0x81, 0x3a,
+	0x8f, 0x75, 0x42, 0x2d, 0x05, 0xc2, 0xa5, 0x1d, 0x45, 0xc1, 0x58, 0xc6, 0x84, 0xb7, 0x4e, 0x77,
+	0xbe, 0xfa, 0x8f, 0xbf, 0x77, 0xa7, 0xf0, 0x5b, 0xdf, 0xbb, 0x53, 0xf8, 0x77, 0xdf, 0xbb, 0x53,
+	0xf8, 0x13, 0xbf, 0x7d, 0xe7, 0x53, 0xbf, 0xf5, 0xdb, 0x77, 0x3e, 0xf5, 0xaf, 0x7e, 0xfb, 0xce,
+	0xa7, 0x58, 0x6d, 0x1c, 0x9c, 0x49, 0x7d, 0x6a, 0x07, 0xe2, 0x42, 0x8c, 0xdd, 0x19, 0x2a, 0x08,
+	0xc3, 0xc2, 0x77, 0x2a, 0x88, 0x3e, 0x5e, 0x9b, 0x01, 0x7d, 0xef, 0xff, 0x0c, 0x00, 0x14, 0x9f,
+	0x7f, 0x99, 0x96, 0xb0, 0x00, 0x00,
 }
 
 func (m *Header) Marshal() (dAtA []byte, err error) {
@@ -16105,6 +17281,20 @@ func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SchemaMessage) > 0 {
+		i -= len(m.SchemaMessage)
+		copy(dAtA[i:], m.SchemaMessage)
+		i = encodeVarintNetcap(dAtA, i, uint64(len(m.SchemaMessage)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintNetcap(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.ContainsPayloads {
 		i--
 		if m.ContainsPayloads {
@@ -16219,6 +17409,18 @@ func (m *PacketContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.DstPort != 0 {
 		i = encodeVarintNetcap(dAtA, i, uint64(m.DstPort))
 		i--
@@ -16266,6 +17468,248 @@ func (m *Connection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InterArrivalTimesServerToClient != nil {
+		{
+			size, err := m.InterArrivalTimesServerToClient.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.InterArrivalTimesClientToServer != nil {
+		{
+			size, err := m.InterArrivalTimesClientToServer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.PacketSizesServerToClient != nil {
+		{
+			size, err := m.PacketSizesServerToClient.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.PacketSizesClientToServer != nil {
+		{
+			size, err := m.PacketSizesClientToServer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.ByteDistribution) > 0 {
+		dAtA7 := make([]byte, len(m.ByteDistribution)*10)
+		var j6 int
+		for _, num1 := range m.ByteDistribution {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintNetcap(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.SPLTIntervals) > 0 {
+		dAtA9 := make([]byte, len(m.SPLTIntervals)*10)
+		var j8 int
+		for _, num1 := range m.SPLTIntervals {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintNetcap(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.SPLTLengths) > 0 {
+		dAtA11 := make([]byte, len(m.SPLTLengths)*10)
+		var j10 int
+		for _, num1 := range m.SPLTLengths {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintNetcap(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf2
+	}
+	if len(m.TerminationReason) > 0 {
+		i -= len(m.TerminationReason)
+		copy(dAtA[i:], m.TerminationReason)
+		i = encodeVarintNetcap(dAtA, i, uint64(len(m.TerminationReason)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.MSSServer != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.MSSServer))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.MSSClient != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.MSSClient))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.WindowScaleServer != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.WindowScaleServer))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.WindowScaleClient != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.WindowScaleClient))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.ZeroWindowsServerToClient != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.ZeroWindowsServerToClient))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.ZeroWindowsClientToServer != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.ZeroWindowsClientToServer))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.OutOfOrderServerToClient != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.OutOfOrderServerToClient))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.OutOfOrderClientToServer != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.OutOfOrderClientToServer))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.DupACKsServerToClient != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.DupACKsServerToClient))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.DupACKsClientToServer != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.DupACKsClientToServer))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.RetransmissionsServerToClient != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.RetransmissionsServerToClient))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.RetransmissionsClientToServer != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.RetransmissionsClientToServer))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.HandshakeRTT != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.HandshakeRTT))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.CommunityID) > 0 {
+		i -= len(m.CommunityID)
+		copy(dAtA[i:], m.CommunityID)
+		i = encodeVarintNetcap(dAtA, i, uint64(len(m.CommunityID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
 	if m.MeanWindowSize != 0 {
 		i = encodeVarintNetcap(dAtA, i, uint64(m.MeanWindowSize))
 		i--
@@ -16464,6 +17908,112 @@ func (m *Connection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Stats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Stats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Stats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StdDev != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.StdDev))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.Mean != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Mean))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.Max != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Max))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Min != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Min))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Tunnel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tunnel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tunnel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.SessionID))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GREKey != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.GREKey))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.VNI != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.VNI))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OuterDstIP) > 0 {
+		i -= len(m.OuterDstIP)
+		copy(dAtA[i:], m.OuterDstIP)
+		i = encodeVarintNetcap(dAtA, i, uint64(len(m.OuterDstIP)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OuterSrcIP) > 0 {
+		i -= len(m.OuterSrcIP)
+		copy(dAtA[i:], m.OuterSrcIP)
+		i = encodeVarintNetcap(dAtA, i, uint64(len(m.OuterSrcIP)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintNetcap(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Ethernet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -17854,6 +19404,20 @@ func (m *IPv4) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
 	if m.DstPort != 0 {
 		i = encodeVarintNetcap(dAtA, i, uint64(m.DstPort))
 		i--
@@ -18036,6 +19600,18 @@ func (m *IPv6) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.DstPort != 0 {
 		i = encodeVarintNetcap(dAtA, i, uint64(m.DstPort))
 		i--
@@ -18141,6 +19717,18 @@ func (m *IPv6Fragment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.DstIP) > 0 {
 		i -= len(m.DstIP)
 		copy(dAtA[i:], m.DstIP)
@@ -18228,6 +19816,18 @@ func (m *ICMPv4) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.DstIP) > 0 {
 		i -= len(m.DstIP)
 		copy(dAtA[i:], m.DstIP)
@@ -18290,6 +19890,18 @@ func (m *ICMPv6) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.DstIP) > 0 {
 		i -= len(m.DstIP)
 		copy(dAtA[i:], m.DstIP)
@@ -18342,6 +19954,18 @@ func (m *ICMPv6NeighborAdvertisement) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.DstIP) > 0 {
 		i -= len(m.DstIP)
 		copy(dAtA[i:], m.DstIP)
@@ -18410,6 +20034,18 @@ func (m *ICMPv6RouterAdvertisement) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.DstIP) > 0 {
 		i -= len(m.DstIP)
 		copy(dAtA[i:], m.DstIP)
@@ -18526,6 +20162,25 @@ func (m *UDP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.CommunityID) > 0 {
+		i -= len(m.CommunityID)
+		copy(dAtA[i:], m.CommunityID)
+		i = encodeVarintNetcap(dAtA, i, uint64(len(m.CommunityID)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.DstIP) > 0 {
 		i -= len(m.DstIP)
 		copy(dAtA[i:], m.DstIP)
@@ -18606,6 +20261,29 @@ func (m *TCP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.CommunityID) > 0 {
+		i -= len(m.CommunityID)
+		copy(dAtA[i:], m.CommunityID)
+		i = encodeVarintNetcap(dAtA, i, uint64(len(m.CommunityID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
 	if len(m.DstIP) > 0 {
 		i -= len(m.DstIP)
 		copy(dAtA[i:], m.DstIP)
@@ -18877,6 +20555,18 @@ func (m *SCTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.DstIP) > 0 {
 		i -= len(m.DstIP)
 		copy(dAtA[i:], m.DstIP)
@@ -18919,6 +20609,213 @@ func (m *SCTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SCTPAssociation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SCTPAssociation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SCTPAssociation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.Abort {
+		i--
+		if m.Abort {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.Shutdown {
+		i--
+		if m.Shutdown {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.Init {
+		i--
+		if m.Init {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.PayloadProtocols) > 0 {
+		for iNdEx := len(m.PayloadProtocols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PayloadProtocols[iNdEx])
+			copy(dAtA[i:], m.PayloadProtocols[iNdEx])
+			i = encodeVarintNetcap(dAtA, i, uint64(len(m.PayloadProtocols[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.BytesServerToClient != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.BytesServerToClient))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.BytesClientToServer != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.BytesClientToServer))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.NumIncomplete != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.NumIncomplete))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.NumDuplicates != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.NumDuplicates))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.NumOutOfOrder != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.NumOutOfOrder))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.NumStreams != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.NumStreams))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.NumMessages != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.NumMessages))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.NumFragments != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.NumFragments))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.NumDataChunks != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.NumDataChunks))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.NumChunks != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.NumChunks))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.NumPackets != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.NumPackets))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.DstVerificationTag != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.DstVerificationTag))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SrcVerificationTag != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.SrcVerificationTag))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DstPort != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.DstPort))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.DstIP) > 0 {
+		i -= len(m.DstIP)
+		copy(dAtA[i:], m.DstIP)
+		i = encodeVarintNetcap(dAtA, i, uint64(len(m.DstIP)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SrcPort != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.SrcPort))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SrcIP) > 0 {
+		i -= len(m.SrcIP)
+		copy(dAtA[i:], m.SrcIP)
+		i = encodeVarintNetcap(dAtA, i, uint64(len(m.SrcIP)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.UID) > 0 {
+		i -= len(m.UID)
+		copy(dAtA[i:], m.UID)
+		i = encodeVarintNetcap(dAtA, i, uint64(len(m.UID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Duration != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TimestampLast != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.TimestampLast))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TimestampFirst != 0 {
+		i = encodeVarintNetcap(dAtA, i, uint64(m.TimestampFirst))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DNS) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -18939,6 +20836,29 @@ func (m *DNS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.CommunityID) > 0 {
+		i -= len(m.CommunityID)
+		copy(dAtA[i:], m.CommunityID)
+		i = encodeVarintNetcap(dAtA, i, uint64(len(m.CommunityID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
 	if m.DstPort != 0 {
 		i = encodeVarintNetcap(dAtA, i, uint64(m.DstPort))
 		i--
@@ -19463,6 +21383,20 @@ func (m *DHCPv4) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
 	if m.DstPort != 0 {
 		i = encodeVarintNetcap(dAtA, i, uint64(m.DstPort))
 		i--
@@ -19672,6 +21606,18 @@ func (m *DHCPv6) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	if m.DstPort != 0 {
 		i = encodeVarintNetcap(dAtA, i, uint64(m.DstPort))
 		i--
@@ -19879,6 +21825,20 @@ func (m *NTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
 	if m.DstPort != 0 {
 		i = encodeVarintNetcap(dAtA, i, uint64(m.DstPort))
 		i--
@@ -20011,6 +21971,18 @@ func (m *SIP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.DstPort != 0 {
 		i = encodeVarintNetcap(dAtA, i, uint64(m.DstPort))
 		i--
@@ -20104,6 +22076,20 @@ func (m *IGMP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if len(m.DstIP) > 0 {
 		i -= len(m.DstIP)
 		copy(dAtA[i:], m.DstIP)
@@ -20280,6 +22266,18 @@ func (m *IPv6HopByHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.DstIP) > 0 {
 		i -= len(m.DstIP)
 		copy(dAtA[i:], m.DstIP)
@@ -20466,6 +22464,18 @@ func (m *ICMPv6Echo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.DstIP) > 0 {
 		i -= len(m.DstIP)
 		copy(dAtA[i:], m.DstIP)
@@ -20518,6 +22528,18 @@ func (m *ICMPv6NeighborSolicitation) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.DstIP) > 0 {
 		i -= len(m.DstIP)
 		copy(dAtA[i:], m.DstIP)
@@ -20581,6 +22603,18 @@ func (m *ICMPv6RouterSolicitation) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.DstIP) > 0 {
 		i -= len(m.DstIP)
 		copy(dAtA[i:], m.DstIP)
@@ -20637,6 +22671,29 @@ func (m *HTTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.CommunityID) > 0 {
+		i -= len(m.CommunityID)
+		copy(dAtA[i:], m.CommunityID)
+		i = encodeVarintNetcap(dAtA, i, uint64(len(m.CommunityID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
 	if len(m.ResponseBody) > 0 {
 		i -= len(m.ResponseBody)
 		copy(dAtA[i:], m.ResponseBody)
@@ -21020,22 +23077,45 @@ func (m *TLSClientHello) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if len(m.CommunityID) > 0 {
+		i -= len(m.CommunityID)
+		copy(dAtA[i:], m.CommunityID)
+		i = encodeVarintNetcap(dAtA, i, uint64(len(m.CommunityID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
 	if len(m.Extensions) > 0 {
-		dAtA20 := make([]byte, len(m.Extensions)*10)
-		var j19 int
+		dAtA55 := make([]byte, len(m.Extensions)*10)
+		var j54 int
 		for _, num1 := range m.Extensions {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA55[j54] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j19++
+				j54++
 			}
-			dAtA20[j19] = uint8(num)
-			j19++
+			dAtA55[j54] = uint8(num)
+			j54++
 		}
-		i -= j19
-		copy(dAtA[i:], dAtA20[:j19])
-		i = encodeVarintNetcap(dAtA, i, uint64(j19))
+		i -= j54
+		copy(dAtA[i:], dAtA55[:j54])
+		i = encodeVarintNetcap(dAtA, i, uint64(j54))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -21112,105 +23192,105 @@ func (m *TLSClientHello) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.SupportedPoints) > 0 {
-		dAtA22 := make([]byte, len(m.SupportedPoints)*10)
-		var j21 int
+		dAtA57 := make([]byte, len(m.SupportedPoints)*10)
+		var j56 int
 		for _, num1 := range m.SupportedPoints {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA57[j56] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j21++
+				j56++
 			}
-			dAtA22[j21] = uint8(num)
-			j21++
+			dAtA57[j56] = uint8(num)
+			j56++
 		}
-		i -= j21
-		copy(dAtA[i:], dAtA22[:j21])
-		i = encodeVarintNetcap(dAtA, i, uint64(j21))
+		i -= j56
+		copy(dAtA[i:], dAtA57[:j56])
+		i = encodeVarintNetcap(dAtA, i, uint64(j56))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0x9a
 	}
 	if len(m.SupportedGroups) > 0 {
-		dAtA24 := make([]byte, len(m.SupportedGroups)*10)
-		var j23 int
+		dAtA59 := make([]byte, len(m.SupportedGroups)*10)
+		var j58 int
 		for _, num1 := range m.SupportedGroups {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA59[j58] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j23++
+				j58++
 			}
-			dAtA24[j23] = uint8(num)
-			j23++
+			dAtA59[j58] = uint8(num)
+			j58++
 		}
-		i -= j23
-		copy(dAtA[i:], dAtA24[:j23])
-		i = encodeVarintNetcap(dAtA, i, uint64(j23))
+		i -= j58
+		copy(dAtA[i:], dAtA59[:j58])
+		i = encodeVarintNetcap(dAtA, i, uint64(j58))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0x92
 	}
 	if len(m.SignatureAlgs) > 0 {
-		dAtA26 := make([]byte, len(m.SignatureAlgs)*10)
-		var j25 int
+		dAtA61 := make([]byte, len(m.SignatureAlgs)*10)
+		var j60 int
 		for _, num1 := range m.SignatureAlgs {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA61[j60] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j25++
+				j60++
 			}
-			dAtA26[j25] = uint8(num)
-			j25++
+			dAtA61[j60] = uint8(num)
+			j60++
 		}
-		i -= j25
-		copy(dAtA[i:], dAtA26[:j25])
-		i = encodeVarintNetcap(dAtA, i, uint64(j25))
+		i -= j60
+		copy(dAtA[i:], dAtA61[:j60])
+		i = encodeVarintNetcap(dAtA, i, uint64(j60))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0x8a
 	}
 	if len(m.CompressMethods) > 0 {
-		dAtA28 := make([]byte, len(m.CompressMethods)*10)
-		var j27 int
+		dAtA63 := make([]byte, len(m.CompressMethods)*10)
+		var j62 int
 		for _, num1 := range m.CompressMethods {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA63[j62] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j27++
+				j62++
 			}
-			dAtA28[j27] = uint8(num)
-			j27++
+			dAtA63[j62] = uint8(num)
+			j62++
 		}
-		i -= j27
-		copy(dAtA[i:], dAtA28[:j27])
-		i = encodeVarintNetcap(dAtA, i, uint64(j27))
+		i -= j62
+		copy(dAtA[i:], dAtA63[:j62])
+		i = encodeVarintNetcap(dAtA, i, uint64(j62))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0x82
 	}
 	if len(m.CipherSuites) > 0 {
-		dAtA30 := make([]byte, len(m.CipherSuites)*10)
-		var j29 int
+		dAtA65 := make([]byte, len(m.CipherSuites)*10)
+		var j64 int
 		for _, num1 := range m.CipherSuites {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA65[j64] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j29++
+				j64++
 			}
-			dAtA30[j29] = uint8(num)
-			j29++
+			dAtA65[j64] = uint8(num)
+			j64++
 		}
-		i -= j29
-		copy(dAtA[i:], dAtA30[:j29])
-		i = encodeVarintNetcap(dAtA, i, uint64(j29))
+		i -= j64
+		copy(dAtA[i:], dAtA65[:j64])
+		i = encodeVarintNetcap(dAtA, i, uint64(j64))
 		i--
 		dAtA[i] = 0x7a
 	}
@@ -21318,6 +23398,29 @@ func (m *TLSServerHello) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.CommunityID) > 0 {
+		i -= len(m.CommunityID)
+		copy(dAtA[i:], m.CommunityID)
+		i = encodeVarintNetcap(dAtA, i, uint64(len(m.CommunityID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
 	if len(m.Ja3S) > 0 {
 		i -= len(m.Ja3S)
 		copy(dAtA[i:], m.Ja3S)
@@ -21378,21 +23481,21 @@ func (m *TLSServerHello) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xba
 	}
 	if len(m.Extensions) > 0 {
-		dAtA32 := make([]byte, len(m.Extensions)*10)
-		var j31 int
+		dAtA68 := make([]byte, len(m.Extensions)*10)
+		var j67 int
 		for _, num1 := range m.Extensions {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA68[j67] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j31++
+				j67++
 			}
-			dAtA32[j31] = uint8(num)
-			j31++
+			dAtA68[j67] = uint8(num)
+			j67++
 		}
-		i -= j31
-		copy(dAtA[i:], dAtA32[:j31])
-		i = encodeVarintNetcap(dAtA, i, uint64(j31))
+		i -= j67
+		copy(dAtA[i:], dAtA68[:j67])
+		i = encodeVarintNetcap(dAtA, i, uint64(j67))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -21579,6 +23682,18 @@ func (m *IPSecAH) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.DstIP) > 0 {
 		i -= len(m.DstIP)
 		copy(dAtA[i:], m.DstIP)
@@ -21643,6 +23758,18 @@ func (m *IPSecESP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.DstIP) > 0 {
 		i -= len(m.DstIP)
 		copy(dAtA[i:], m.DstIP)
@@ -22108,6 +24235,18 @@ func (m *LCM) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.DstPort != 0 {
 		i = encodeVarintNetcap(dAtA, i, uint64(m.DstPort))
 		i--
@@ -22260,6 +24399,18 @@ func (m *Modbus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	if m.DstPort != 0 {
 		i = encodeVarintNetcap(dAtA, i, uint64(m.DstPort))
 		i--
@@ -22354,6 +24505,20 @@ func (m *OSPFv2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if len(m.DstIP) > 0 {
 		i -= len(m.DstIP)
 		copy(dAtA[i:], m.DstIP)
@@ -22503,20 +24668,20 @@ func (m *HelloPkg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.NeighborID) > 0 {
-		dAtA37 := make([]byte, len(m.NeighborID)*10)
-		var j36 int
+		dAtA78 := make([]byte, len(m.NeighborID)*10)
+		var j77 int
 		for _, num := range m.NeighborID {
 			for num >= 1<<7 {
-				dAtA37[j36] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA78[j77] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j36++
+				j77++
 			}
-			dAtA37[j36] = uint8(num)
-			j36++
+			dAtA78[j77] = uint8(num)
+			j77++
 		}
-		i -= j36
-		copy(dAtA[i:], dAtA37[:j36])
-		i = encodeVarintNetcap(dAtA, i, uint64(j36))
+		i -= j77
+		copy(dAtA[i:], dAtA78[:j77])
+		i = encodeVarintNetcap(dAtA, i, uint64(j77))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -22584,20 +24749,20 @@ func (m *HelloPkgV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x48
 	}
 	if len(m.NeighborID) > 0 {
-		dAtA39 := make([]byte, len(m.NeighborID)*10)
-		var j38 int
+		dAtA80 := make([]byte, len(m.NeighborID)*10)
+		var j79 int
 		for _, num := range m.NeighborID {
 			for num >= 1<<7 {
-				dAtA39[j38] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA80[j79] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j38++
+				j79++
 			}
-			dAtA39[j38] = uint8(num)
-			j38++
+			dAtA80[j79] = uint8(num)
+			j79++
 		}
-		i -= j38
-		copy(dAtA[i:], dAtA39[:j38])
-		i = encodeVarintNetcap(dAtA, i, uint64(j38))
+		i -= j79
+		copy(dAtA[i:], dAtA80[:j79])
+		i = encodeVarintNetcap(dAtA, i, uint64(j79))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -22716,6 +24881,20 @@ func (m *OSPFv3) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if len(m.DstIP) > 0 {
 		i -= len(m.DstIP)
 		copy(dAtA[i:], m.DstIP)
@@ -23610,20 +25789,20 @@ func (m *NetworkLSA) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.AttachedRouter) > 0 {
-		dAtA54 := make([]byte, len(m.AttachedRouter)*10)
-		var j53 int
+		dAtA96 := make([]byte, len(m.AttachedRouter)*10)
+		var j95 int
 		for _, num := range m.AttachedRouter {
 			for num >= 1<<7 {
-				dAtA54[j53] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA96[j95] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j53++
+				j95++
 			}
-			dAtA54[j53] = uint8(num)
-			j53++
+			dAtA96[j95] = uint8(num)
+			j95++
 		}
-		i -= j53
-		copy(dAtA[i:], dAtA54[:j53])
-		i = encodeVarintNetcap(dAtA, i, uint64(j53))
+		i -= j95
+		copy(dAtA[i:], dAtA96[:j95])
+		i = encodeVarintNetcap(dAtA, i, uint64(j95))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -23953,6 +26132,20 @@ func (m *GRE) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
 	if len(m.DstIP) > 0 {
 		i -= len(m.DstIP)
 		copy(dAtA[i:], m.DstIP)
@@ -24522,6 +26715,18 @@ func (m *VRRPv2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.DstIP) > 0 {
 		i -= len(m.DstIP)
 		copy(dAtA[i:], m.DstIP)
@@ -24779,20 +26984,20 @@ func (m *CDPPowerDialogue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.Values) > 0 {
-		dAtA59 := make([]byte, len(m.Values)*10)
-		var j58 int
+		dAtA103 := make([]byte, len(m.Values)*10)
+		var j102 int
 		for _, num := range m.Values {
 			for num >= 1<<7 {
-				dAtA59[j58] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA103[j102] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j58++
+				j102++
 			}
-			dAtA59[j58] = uint8(num)
-			j58++
+			dAtA103[j102] = uint8(num)
+			j102++
 		}
-		i -= j58
-		copy(dAtA[i:], dAtA59[:j58])
-		i = encodeVarintNetcap(dAtA, i, uint64(j58))
+		i -= j102
+		copy(dAtA[i:], dAtA103[:j102])
+		i = encodeVarintNetcap(dAtA, i, uint64(j102))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -25632,6 +27837,18 @@ func (m *CIP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	if m.DstPort != 0 {
 		i = encodeVarintNetcap(dAtA, i, uint64(m.DstPort))
 		i--
@@ -25664,20 +27881,20 @@ func (m *CIP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if len(m.AdditionalStatus) > 0 {
-		dAtA70 := make([]byte, len(m.AdditionalStatus)*10)
-		var j69 int
+		dAtA115 := make([]byte, len(m.AdditionalStatus)*10)
+		var j114 int
 		for _, num := range m.AdditionalStatus {
 			for num >= 1<<7 {
-				dAtA70[j69] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA115[j114] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j69++
+				j114++
 			}
-			dAtA70[j69] = uint8(num)
-			j69++
+			dAtA115[j114] = uint8(num)
+			j114++
 		}
-		i -= j69
-		copy(dAtA[i:], dAtA70[:j69])
-		i = encodeVarintNetcap(dAtA, i, uint64(j69))
+		i -= j114
+		copy(dAtA[i:], dAtA115[:j114])
+		i = encodeVarintNetcap(dAtA, i, uint64(j114))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -25739,6 +27956,18 @@ func (m *ENIP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	if m.DstPort != 0 {
 		i = encodeVarintNetcap(dAtA, i, uint64(m.DstPort))
 		i--
@@ -26020,6 +28249,18 @@ func (m *IPProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	if len(m.ContactedPorts) > 0 {
 		for iNdEx := len(m.ContactedPorts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -26234,6 +28475,27 @@ func (m *File) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.CommunityID) > 0 {
+		i -= len(m.CommunityID)
+		copy(dAtA[i:], m.CommunityID)
+		i = encodeVarintNetcap(dAtA, i, uint64(len(m.CommunityID)))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.DstPort != 0 {
 		i = encodeVarintNetcap(dAtA, i, uint64(m.DstPort))
 		i--
@@ -26468,6 +28730,18 @@ func (m *SMTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.Commands) > 0 {
 		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Commands[iNdEx])
@@ -26558,6 +28832,18 @@ func (m *Diameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.DstPort != 0 {
 		i = encodeVarintNetcap(dAtA, i, uint64(m.DstPort))
 		i--
@@ -26759,6 +29045,18 @@ func (m *POP3) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Commands) > 0 {
 		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Commands[iNdEx])
@@ -26840,6 +29138,20 @@ func (m *Mail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
 	if len(m.Origin) > 0 {
 		i -= len(m.Origin)
 		copy(dAtA[i:], m.Origin)
@@ -27167,6 +29479,27 @@ func (m *Software) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.CommunityIDs) > 0 {
+		for iNdEx := len(m.CommunityIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CommunityIDs[iNdEx])
+			copy(dAtA[i:], m.CommunityIDs[iNdEx])
+			i = encodeVarintNetcap(dAtA, i, uint64(len(m.CommunityIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	if len(m.OS) > 0 {
 		i -= len(m.OS)
 		copy(dAtA[i:], m.OS)
@@ -27285,6 +29618,31 @@ func (m *Service) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.CommunityIDs) > 0 {
+		for iNdEx := len(m.CommunityIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CommunityIDs[iNdEx])
+			copy(dAtA[i:], m.CommunityIDs[iNdEx])
+			i = encodeVarintNetcap(dAtA, i, uint64(len(m.CommunityIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	if len(m.OS) > 0 {
 		i -= len(m.OS)
 		copy(dAtA[i:], m.OS)
@@ -27407,6 +29765,25 @@ func (m *Credentials) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CommunityID) > 0 {
+		i -= len(m.CommunityID)
+		copy(dAtA[i:], m.CommunityID)
+		i = encodeVarintNetcap(dAtA, i, uint64(len(m.CommunityID)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Notes) > 0 {
 		i -= len(m.Notes)
 		copy(dAtA[i:], m.Notes)
@@ -27470,6 +29847,25 @@ func (m *SSH) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tunnel != nil {
+		{
+			size, err := m.Tunnel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetcap(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.CommunityID) > 0 {
+		i -= len(m.CommunityID)
+		copy(dAtA[i:], m.CommunityID)
+		i = encodeVarintNetcap(dAtA, i, uint64(len(m.CommunityID)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.IsClient {
 		i--
 		if m.IsClient {
@@ -27737,6 +30133,20 @@ func (m *Alert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RetransmittedPayload) > 0 {
+		i -= len(m.RetransmittedPayload)
+		copy(dAtA[i:], m.RetransmittedPayload)
+		i = encodeVarintNetcap(dAtA, i, uint64(len(m.RetransmittedPayload)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.OriginalPayload) > 0 {
+		i -= len(m.OriginalPayload)
+		copy(dAtA[i:], m.OriginalPayload)
+		i = encodeVarintNetcap(dAtA, i, uint64(len(m.OriginalPayload)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.Notes) > 0 {
 		i -= len(m.Notes)
 		copy(dAtA[i:], m.Notes)
@@ -27856,6 +30266,14 @@ func (m *Header) Size() (n int) {
 	if m.ContainsPayloads {
 		n += 2
 	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovNetcap(uint64(l))
+	}
+	l = len(m.SchemaMessage)
+	if l > 0 {
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -27905,6 +30323,10 @@ func (m *PacketContext) Size() (n int) {
 	if m.DstPort != 0 {
 		n += 1 + sovNetcap(uint64(m.DstPort))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -28012,6 +30434,145 @@ func (m *Connection) Size() (n int) {
 	if m.MeanWindowSize != 0 {
 		n += 2 + sovNetcap(uint64(m.MeanWindowSize))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 2 + l + sovNetcap(uint64(l))
+	}
+	l = len(m.CommunityID)
+	if l > 0 {
+		n += 2 + l + sovNetcap(uint64(l))
+	}
+	if m.HandshakeRTT != 0 {
+		n += 2 + sovNetcap(uint64(m.HandshakeRTT))
+	}
+	if m.RetransmissionsClientToServer != 0 {
+		n += 2 + sovNetcap(uint64(m.RetransmissionsClientToServer))
+	}
+	if m.RetransmissionsServerToClient != 0 {
+		n += 2 + sovNetcap(uint64(m.RetransmissionsServerToClient))
+	}
+	if m.DupACKsClientToServer != 0 {
+		n += 2 + sovNetcap(uint64(m.DupACKsClientToServer))
+	}
+	if m.DupACKsServerToClient != 0 {
+		n += 2 + sovNetcap(uint64(m.DupACKsServerToClient))
+	}
+	if m.OutOfOrderClientToServer != 0 {
+		n += 2 + sovNetcap(uint64(m.OutOfOrderClientToServer))
+	}
+	if m.OutOfOrderServerToClient != 0 {
+		n += 2 + sovNetcap(uint64(m.OutOfOrderServerToClient))
+	}
+	if m.ZeroWindowsClientToServer != 0 {
+		n += 2 + sovNetcap(uint64(m.ZeroWindowsClientToServer))
+	}
+	if m.ZeroWindowsServerToClient != 0 {
+		n += 2 + sovNetcap(uint64(m.ZeroWindowsServerToClient))
+	}
+	if m.WindowScaleClient != 0 {
+		n += 2 + sovNetcap(uint64(m.WindowScaleClient))
+	}
+	if m.WindowScaleServer != 0 {
+		n += 2 + sovNetcap(uint64(m.WindowScaleServer))
+	}
+	if m.MSSClient != 0 {
+		n += 2 + sovNetcap(uint64(m.MSSClient))
+	}
+	if m.MSSServer != 0 {
+		n += 2 + sovNetcap(uint64(m.MSSServer))
+	}
+	l = len(m.TerminationReason)
+	if l > 0 {
+		n += 2 + l + sovNetcap(uint64(l))
+	}
+	if len(m.SPLTLengths) > 0 {
+		l = 0
+		for _, e := range m.SPLTLengths {
+			l += sovNetcap(uint64(e))
+		}
+		n += 2 + sovNetcap(uint64(l)) + l
+	}
+	if len(m.SPLTIntervals) > 0 {
+		l = 0
+		for _, e := range m.SPLTIntervals {
+			l += sovNetcap(uint64(e))
+		}
+		n += 2 + sovNetcap(uint64(l)) + l
+	}
+	if len(m.ByteDistribution) > 0 {
+		l = 0
+		for _, e := range m.ByteDistribution {
+			l += sovNetcap(uint64(e))
+		}
+		n += 2 + sovNetcap(uint64(l)) + l
+	}
+	if m.PacketSizesClientToServer != nil {
+		l = m.PacketSizesClientToServer.Size()
+		n += 2 + l + sovNetcap(uint64(l))
+	}
+	if m.PacketSizesServerToClient != nil {
+		l = m.PacketSizesServerToClient.Size()
+		n += 2 + l + sovNetcap(uint64(l))
+	}
+	if m.InterArrivalTimesClientToServer != nil {
+		l = m.InterArrivalTimesClientToServer.Size()
+		n += 2 + l + sovNetcap(uint64(l))
+	}
+	if m.InterArrivalTimesServerToClient != nil {
+		l = m.InterArrivalTimesServerToClient.Size()
+		n += 2 + l + sovNetcap(uint64(l))
+	}
+	return n
+}
+
+func (m *Stats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Min != 0 {
+		n += 9
+	}
+	if m.Max != 0 {
+		n += 9
+	}
+	if m.Mean != 0 {
+		n += 9
+	}
+	if m.StdDev != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *Tunnel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovNetcap(uint64(l))
+	}
+	l = len(m.OuterSrcIP)
+	if l > 0 {
+		n += 1 + l + sovNetcap(uint64(l))
+	}
+	l = len(m.OuterDstIP)
+	if l > 0 {
+		n += 1 + l + sovNetcap(uint64(l))
+	}
+	if m.VNI != 0 {
+		n += 1 + sovNetcap(uint64(m.VNI))
+	}
+	if m.GREKey != 0 {
+		n += 1 + sovNetcap(uint64(m.GREKey))
+	}
+	if m.SessionID != 0 {
+		n += 1 + sovNetcap(uint64(m.SessionID))
+	}
 	return n
 }
 
@@ -28678,6 +31239,10 @@ func (m *IPv4) Size() (n int) {
 	if m.DstPort != 0 {
 		n += 2 + sovNetcap(uint64(m.DstPort))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 2 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -28751,6 +31316,10 @@ func (m *IPv6) Size() (n int) {
 	if m.DstPort != 0 {
 		n += 1 + sovNetcap(uint64(m.DstPort))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -28795,6 +31364,10 @@ func (m *IPv6Fragment) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNetcap(uint64(l))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -28827,6 +31400,10 @@ func (m *ICMPv4) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNetcap(uint64(l))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -28853,6 +31430,10 @@ func (m *ICMPv6) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNetcap(uint64(l))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -28886,6 +31467,10 @@ func (m *ICMPv6NeighborAdvertisement) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNetcap(uint64(l))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -28927,6 +31512,10 @@ func (m *ICMPv6RouterAdvertisement) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNetcap(uint64(l))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -28985,6 +31574,14 @@ func (m *UDP) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNetcap(uint64(l))
 	}
+	l = len(m.CommunityID)
+	if l > 0 {
+		n += 1 + l + sovNetcap(uint64(l))
+	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -29076,6 +31673,14 @@ func (m *TCP) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovNetcap(uint64(l))
 	}
+	l = len(m.CommunityID)
+	if l > 0 {
+		n += 2 + l + sovNetcap(uint64(l))
+	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 2 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -29127,6 +31732,104 @@ func (m *SCTP) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNetcap(uint64(l))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
+	return n
+}
+
+func (m *SCTPAssociation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TimestampFirst != 0 {
+		n += 1 + sovNetcap(uint64(m.TimestampFirst))
+	}
+	if m.TimestampLast != 0 {
+		n += 1 + sovNetcap(uint64(m.TimestampLast))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovNetcap(uint64(m.Duration))
+	}
+	l = len(m.UID)
+	if l > 0 {
+		n += 1 + l + sovNetcap(uint64(l))
+	}
+	l = len(m.SrcIP)
+	if l > 0 {
+		n += 1 + l + sovNetcap(uint64(l))
+	}
+	if m.SrcPort != 0 {
+		n += 1 + sovNetcap(uint64(m.SrcPort))
+	}
+	l = len(m.DstIP)
+	if l > 0 {
+		n += 1 + l + sovNetcap(uint64(l))
+	}
+	if m.DstPort != 0 {
+		n += 1 + sovNetcap(uint64(m.DstPort))
+	}
+	if m.SrcVerificationTag != 0 {
+		n += 1 + sovNetcap(uint64(m.SrcVerificationTag))
+	}
+	if m.DstVerificationTag != 0 {
+		n += 1 + sovNetcap(uint64(m.DstVerificationTag))
+	}
+	if m.NumPackets != 0 {
+		n += 1 + sovNetcap(uint64(m.NumPackets))
+	}
+	if m.NumChunks != 0 {
+		n += 1 + sovNetcap(uint64(m.NumChunks))
+	}
+	if m.NumDataChunks != 0 {
+		n += 1 + sovNetcap(uint64(m.NumDataChunks))
+	}
+	if m.NumFragments != 0 {
+		n += 1 + sovNetcap(uint64(m.NumFragments))
+	}
+	if m.NumMessages != 0 {
+		n += 1 + sovNetcap(uint64(m.NumMessages))
+	}
+	if m.NumStreams != 0 {
+		n += 2 + sovNetcap(uint64(m.NumStreams))
+	}
+	if m.NumOutOfOrder != 0 {
+		n += 2 + sovNetcap(uint64(m.NumOutOfOrder))
+	}
+	if m.NumDuplicates != 0 {
+		n += 2 + sovNetcap(uint64(m.NumDuplicates))
+	}
+	if m.NumIncomplete != 0 {
+		n += 2 + sovNetcap(uint64(m.NumIncomplete))
+	}
+	if m.BytesClientToServer != 0 {
+		n += 2 + sovNetcap(uint64(m.BytesClientToServer))
+	}
+	if m.BytesServerToClient != 0 {
+		n += 2 + sovNetcap(uint64(m.BytesServerToClient))
+	}
+	if len(m.PayloadProtocols) > 0 {
+		for _, s := range m.PayloadProtocols {
+			l = len(s)
+			n += 2 + l + sovNetcap(uint64(l))
+		}
+	}
+	if m.Init {
+		n += 3
+	}
+	if m.Shutdown {
+		n += 3
+	}
+	if m.Abort {
+		n += 3
+	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 2 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -29216,6 +31919,14 @@ func (m *DNS) Size() (n int) {
 	if m.DstPort != 0 {
 		n += 2 + sovNetcap(uint64(m.DstPort))
 	}
+	l = len(m.CommunityID)
+	if l > 0 {
+		n += 2 + l + sovNetcap(uint64(l))
+	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 2 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -29453,6 +32164,10 @@ func (m *DHCPv4) Size() (n int) {
 	if m.DstPort != 0 {
 		n += 2 + sovNetcap(uint64(m.DstPort))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 2 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -29526,6 +32241,10 @@ func (m *DHCPv6) Size() (n int) {
 	if m.DstPort != 0 {
 		n += 1 + sovNetcap(uint64(m.DstPort))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -29641,6 +32360,10 @@ func (m *NTP) Size() (n int) {
 	if m.DstPort != 0 {
 		n += 2 + sovNetcap(uint64(m.DstPort))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 2 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -29689,6 +32412,10 @@ func (m *SIP) Size() (n int) {
 	if m.DstPort != 0 {
 		n += 1 + sovNetcap(uint64(m.DstPort))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -29752,6 +32479,10 @@ func (m *IGMP) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNetcap(uint64(l))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 2 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -29806,6 +32537,10 @@ func (m *IPv6HopByHop) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNetcap(uint64(l))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -29892,6 +32627,10 @@ func (m *ICMPv6Echo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNetcap(uint64(l))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -29922,6 +32661,10 @@ func (m *ICMPv6NeighborSolicitation) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNetcap(uint64(l))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -29948,6 +32691,10 @@ func (m *ICMPv6RouterSolicitation) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNetcap(uint64(l))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -30085,6 +32832,14 @@ func (m *HTTP) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovNetcap(uint64(l))
 	}
+	l = len(m.CommunityID)
+	if l > 0 {
+		n += 2 + l + sovNetcap(uint64(l))
+	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 2 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -30253,6 +33008,14 @@ func (m *TLSClientHello) Size() (n int) {
 		}
 		n += 2 + sovNetcap(uint64(l)) + l
 	}
+	l = len(m.CommunityID)
+	if l > 0 {
+		n += 2 + l + sovNetcap(uint64(l))
+	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 2 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -30366,6 +33129,14 @@ func (m *TLSServerHello) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovNetcap(uint64(l))
 	}
+	l = len(m.CommunityID)
+	if l > 0 {
+		n += 2 + l + sovNetcap(uint64(l))
+	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 2 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -30399,6 +33170,10 @@ func (m *IPSecAH) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNetcap(uint64(l))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -30428,6 +33203,10 @@ func (m *IPSecESP) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNetcap(uint64(l))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -30667,6 +33446,10 @@ func (m *LCM) Size() (n int) {
 	if m.DstPort != 0 {
 		n += 1 + sovNetcap(uint64(m.DstPort))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -30739,6 +33522,10 @@ func (m *Modbus) Size() (n int) {
 	if m.DstPort != 0 {
 		n += 1 + sovNetcap(uint64(m.DstPort))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -30807,6 +33594,10 @@ func (m *OSPFv2) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovNetcap(uint64(l))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 2 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -30979,6 +33770,10 @@ func (m *OSPFv3) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovNetcap(uint64(l))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 2 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -31542,6 +34337,10 @@ func (m *GRE) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovNetcap(uint64(l))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 2 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -31772,6 +34571,10 @@ func (m *VRRPv2) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNetcap(uint64(l))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -32275,6 +35078,10 @@ func (m *CIP) Size() (n int) {
 	if m.DstPort != 0 {
 		n += 1 + sovNetcap(uint64(m.DstPort))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -32324,6 +35131,10 @@ func (m *ENIP) Size() (n int) {
 	if m.DstPort != 0 {
 		n += 1 + sovNetcap(uint64(m.DstPort))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -32501,6 +35312,10 @@ func (m *IPProfile) Size() (n int) {
 			n += 1 + l + sovNetcap(uint64(l))
 		}
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -32578,6 +35393,14 @@ func (m *File) Size() (n int) {
 	if m.DstPort != 0 {
 		n += 1 + sovNetcap(uint64(m.DstPort))
 	}
+	l = len(m.CommunityID)
+	if l > 0 {
+		n += 1 + l + sovNetcap(uint64(l))
+	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 2 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -32679,6 +35502,10 @@ func (m *SMTP) Size() (n int) {
 			n += 1 + l + sovNetcap(uint64(l))
 		}
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -32732,6 +35559,10 @@ func (m *Diameter) Size() (n int) {
 	if m.DstPort != 0 {
 		n += 1 + sovNetcap(uint64(m.DstPort))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -32830,6 +35661,10 @@ func (m *POP3) Size() (n int) {
 			n += 1 + l + sovNetcap(uint64(l))
 		}
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -32923,6 +35758,10 @@ func (m *Mail) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovNetcap(uint64(l))
 	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 2 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -33052,6 +35891,16 @@ func (m *Software) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNetcap(uint64(l))
 	}
+	if len(m.CommunityIDs) > 0 {
+		for _, s := range m.CommunityIDs {
+			l = len(s)
+			n += 1 + l + sovNetcap(uint64(l))
+		}
+	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -33119,6 +35968,16 @@ func (m *Service) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNetcap(uint64(l))
 	}
+	if len(m.CommunityIDs) > 0 {
+		for _, s := range m.CommunityIDs {
+			l = len(s)
+			n += 2 + l + sovNetcap(uint64(l))
+		}
+	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 2 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -33151,6 +36010,14 @@ func (m *Credentials) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNetcap(uint64(l))
 	}
+	l = len(m.CommunityID)
+	if l > 0 {
+		n += 1 + l + sovNetcap(uint64(l))
+	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -33186,6 +36053,14 @@ func (m *SSH) Size() (n int) {
 	if m.IsClient {
 		n += 2
 	}
+	l = len(m.CommunityID)
+	if l > 0 {
+		n += 1 + l + sovNetcap(uint64(l))
+	}
+	if m.Tunnel != nil {
+		l = m.Tunnel.Size()
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -33340,6 +36215,14 @@ func (m *Alert) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNetcap(uint64(l))
 	}
+	l = len(m.OriginalPayload)
+	if l > 0 {
+		n += 1 + l + sovNetcap(uint64(l))
+	}
+	l = len(m.RetransmittedPayload)
+	if l > 0 {
+		n += 1 + l + sovNetcap(uint64(l))
+	}
 	return n
 }
 
@@ -33500,16 +36383,79 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ContainsPayloads = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = append(m.Schema[:0], dAtA[iNdEx:postIndex]...)
+			if m.Schema == nil {
+				m.Schema = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -33683,10 +36629,7 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -33832,16 +36775,49 @@ func (m *PacketContext) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -34579,64 +37555,11 @@ func (m *Connection) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNetcap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Ethernet) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNetcap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Ethernet: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Ethernet: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
 			}
-			m.Timestamp = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -34646,14 +37569,1080 @@ func (m *Ethernet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcMAC", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandshakeRTT", wireType)
+			}
+			m.HandshakeRTT = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HandshakeRTT |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetransmissionsClientToServer", wireType)
+			}
+			m.RetransmissionsClientToServer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetransmissionsClientToServer |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetransmissionsServerToClient", wireType)
+			}
+			m.RetransmissionsServerToClient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetransmissionsServerToClient |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DupACKsClientToServer", wireType)
+			}
+			m.DupACKsClientToServer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DupACKsClientToServer |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DupACKsServerToClient", wireType)
+			}
+			m.DupACKsServerToClient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DupACKsServerToClient |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutOfOrderClientToServer", wireType)
+			}
+			m.OutOfOrderClientToServer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutOfOrderClientToServer |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutOfOrderServerToClient", wireType)
+			}
+			m.OutOfOrderServerToClient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutOfOrderServerToClient |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZeroWindowsClientToServer", wireType)
+			}
+			m.ZeroWindowsClientToServer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ZeroWindowsClientToServer |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZeroWindowsServerToClient", wireType)
+			}
+			m.ZeroWindowsServerToClient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ZeroWindowsServerToClient |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowScaleClient", wireType)
+			}
+			m.WindowScaleClient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowScaleClient |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowScaleServer", wireType)
+			}
+			m.WindowScaleServer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowScaleServer |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 43:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MSSClient", wireType)
+			}
+			m.MSSClient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MSSClient |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MSSServer", wireType)
+			}
+			m.MSSServer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MSSServer |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminationReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TerminationReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 46:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNetcap
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SPLTLengths = append(m.SPLTLengths, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNetcap
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthNetcap
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthNetcap
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SPLTLengths) == 0 {
+					m.SPLTLengths = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNetcap
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SPLTLengths = append(m.SPLTLengths, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SPLTLengths", wireType)
+			}
+		case 47:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNetcap
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SPLTIntervals = append(m.SPLTIntervals, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNetcap
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthNetcap
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthNetcap
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SPLTIntervals) == 0 {
+					m.SPLTIntervals = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNetcap
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SPLTIntervals = append(m.SPLTIntervals, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SPLTIntervals", wireType)
+			}
+		case 48:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNetcap
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ByteDistribution = append(m.ByteDistribution, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNetcap
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthNetcap
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthNetcap
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ByteDistribution) == 0 {
+					m.ByteDistribution = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNetcap
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ByteDistribution = append(m.ByteDistribution, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteDistribution", wireType)
+			}
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSizesClientToServer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PacketSizesClientToServer == nil {
+				m.PacketSizesClientToServer = &Stats{}
+			}
+			if err := m.PacketSizesClientToServer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 50:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSizesServerToClient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PacketSizesServerToClient == nil {
+				m.PacketSizesServerToClient = &Stats{}
+			}
+			if err := m.PacketSizesServerToClient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterArrivalTimesClientToServer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InterArrivalTimesClientToServer == nil {
+				m.InterArrivalTimesClientToServer = &Stats{}
+			}
+			if err := m.InterArrivalTimesClientToServer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterArrivalTimesServerToClient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InterArrivalTimesServerToClient == nil {
+				m.InterArrivalTimesServerToClient = &Stats{}
+			}
+			if err := m.InterArrivalTimesServerToClient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetcap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Stats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetcap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Stats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Stats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Min = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Max = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Mean = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StdDev", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.StdDev = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetcap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tunnel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetcap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tunnel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tunnel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OuterSrcIP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OuterSrcIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OuterDstIP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OuterDstIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VNI", wireType)
+			}
+			m.VNI = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VNI |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GREKey", wireType)
+			}
+			m.GREKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GREKey |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetcap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Ethernet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetcap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Ethernet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Ethernet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcMAC", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34685,9 +38674,1107 @@ func (m *Ethernet) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstMAC", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstMAC", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstMAC = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthernetType", wireType)
+			}
+			m.EthernetType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthernetType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadEntropy", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.PayloadEntropy = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetcap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ARP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetcap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ARP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ARP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddrType", wireType)
+			}
+			m.AddrType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddrType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			m.Protocol = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Protocol |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HwAddressSize", wireType)
+			}
+			m.HwAddressSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HwAddressSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolAddressSize", wireType)
+			}
+			m.ProtocolAddressSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolAddressSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			m.Operation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operation |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcHwAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcHwAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcProtocolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcProtocolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstHwAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstHwAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstProtocolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstProtocolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetcap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Dot1Q) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetcap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Dot1Q: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Dot1Q: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropEligible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DropEligible = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VLANIdentifier", wireType)
+			}
+			m.VLANIdentifier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VLANIdentifier |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetcap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Dot11) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetcap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Dot11: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Dot11: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proto", wireType)
+			}
+			m.Proto = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Proto |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			m.Flags = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Flags |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationID", wireType)
+			}
+			m.DurationID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationID |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address2", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address2 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address3", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address3 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address4", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address4 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+			}
+			m.SequenceNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FragmentNumber", wireType)
+			}
+			m.FragmentNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FragmentNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			m.Checksum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Checksum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QOS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QOS == nil {
+				m.QOS = &Dot11QOS{}
+			}
+			if err := m.QOS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTControl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HTControl == nil {
+				m.HTControl = &Dot11HTControl{}
+			}
+			if err := m.HTControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetcap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Dot11QOS) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetcap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Dot11QOS: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Dot11QOS: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TID", wireType)
+			}
+			m.TID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TID |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EOSP", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EOSP = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckPolicy", wireType)
+			}
+			m.AckPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckPolicy |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TXOP", wireType)
+			}
+			m.TXOP = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TXOP |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetcap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Dot11HTControl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetcap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Dot11HTControl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Dot11HTControl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ACConstraint", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -34697,29 +39784,37 @@ func (m *Ethernet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
+			m.ACConstraint = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RDGMorePPDU", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.DstMAC = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthernetType", wireType)
+			m.RDGMorePPDU = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VHT", wireType)
 			}
-			m.EthernetType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -34729,27 +39824,33 @@ func (m *Ethernet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthernetType |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadEntropy", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.PayloadEntropy = float64(math.Float64frombits(v))
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			if m.VHT == nil {
+				m.VHT = &Dot11HTControlVHT{}
 			}
-			m.PayloadSize = 0
+			if err := m.VHT.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HT", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -34759,21 +39860,35 @@ func (m *Ethernet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PayloadSize |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HT == nil {
+				m.HT = &Dot11HTControlHT{}
+			}
+			if err := m.HT.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -34788,7 +39903,7 @@ func (m *Ethernet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ARP) Unmarshal(dAtA []byte) error {
+func (m *Dot11HTControlVHT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34811,17 +39926,17 @@ func (m *ARP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ARP: wiretype end group for non-group")
+			return fmt.Errorf("proto: Dot11HTControlVHT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ARP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Dot11HTControlVHT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MRQ", wireType)
 			}
-			m.Timestamp = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -34831,16 +39946,17 @@ func (m *ARP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.MRQ = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddrType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnsolicitedMFB", wireType)
 			}
-			m.AddrType = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -34850,16 +39966,17 @@ func (m *ARP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AddrType |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.UnsolicitedMFB = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MSI", wireType)
 			}
-			m.Protocol = 0
+			m.MSI = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -34869,16 +39986,16 @@ func (m *ARP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Protocol |= int32(b&0x7F) << shift
+				m.MSI |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HwAddressSize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MFB", wireType)
 			}
-			m.HwAddressSize = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -34888,16 +40005,33 @@ func (m *ARP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HwAddressSize |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MFB == nil {
+				m.MFB = &Dot11HTControlMFB{}
+			}
+			if err := m.MFB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolAddressSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressedMSI", wireType)
 			}
-			m.ProtocolAddressSize = 0
+			m.CompressedMSI = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -34907,16 +40041,16 @@ func (m *ARP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProtocolAddressSize |= int32(b&0x7F) << shift
+				m.CompressedMSI |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field STBCIndication", wireType)
 			}
-			m.Operation = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -34926,16 +40060,17 @@ func (m *ARP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Operation |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.STBCIndication = bool(v != 0)
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcHwAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MFSI", wireType)
 			}
-			var stringLen uint64
+			m.MFSI = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -34945,29 +40080,16 @@ func (m *ARP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MFSI |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SrcHwAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcProtocolAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GID", wireType)
 			}
-			var stringLen uint64
+			m.GID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -34977,29 +40099,16 @@ func (m *ARP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GID |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SrcProtocolAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstHwAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodingType", wireType)
 			}
-			var stringLen uint64
+			m.CodingType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -35009,29 +40118,16 @@ func (m *ARP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CodingType |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DstHwAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstProtocolAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FbTXBeamformed", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -35041,34 +40137,19 @@ func (m *ARP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DstProtocolAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.FbTXBeamformed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -35083,7 +40164,7 @@ func (m *ARP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Dot1Q) Unmarshal(dAtA []byte) error {
+func (m *Dot11HTControlHT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35106,17 +40187,17 @@ func (m *Dot1Q) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Dot1Q: wiretype end group for non-group")
+			return fmt.Errorf("proto: Dot11HTControlHT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Dot1Q: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Dot11HTControlHT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkAdapationControl", wireType)
 			}
-			m.Timestamp = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -35126,16 +40207,33 @@ func (m *Dot1Q) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinkAdapationControl == nil {
+				m.LinkAdapationControl = &Dot11LinkAdapationControl{}
+			}
+			if err := m.LinkAdapationControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CalibrationPosition", wireType)
 			}
-			m.Priority = 0
+			m.CalibrationPosition = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -35145,16 +40243,16 @@ func (m *Dot1Q) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Priority |= int32(b&0x7F) << shift
+				m.CalibrationPosition |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropEligible", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CalibrationSequence", wireType)
 			}
-			var v int
+			m.CalibrationSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -35164,17 +40262,16 @@ func (m *Dot1Q) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.CalibrationSequence |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DropEligible = bool(v != 0)
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VLANIdentifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CSISteering", wireType)
 			}
-			m.VLANIdentifier = 0
+			m.CSISteering = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -35184,16 +40281,16 @@ func (m *Dot1Q) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VLANIdentifier |= int32(b&0x7F) << shift
+				m.CSISteering |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NDPAnnouncement", wireType)
 			}
-			m.Type = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -35203,21 +40300,39 @@ func (m *Dot1Q) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NDPAnnouncement = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DEI", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.DEI = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -35232,7 +40347,7 @@ func (m *Dot1Q) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Dot11) Unmarshal(dAtA []byte) error {
+func (m *Dot11HTControlMFB) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35255,17 +40370,17 @@ func (m *Dot11) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Dot11: wiretype end group for non-group")
+			return fmt.Errorf("proto: Dot11HTControlMFB: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Dot11: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Dot11HTControlMFB: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSTS", wireType)
 			}
-			m.Timestamp = 0
+			m.NumSTS = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -35275,16 +40390,16 @@ func (m *Dot11) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				m.NumSTS |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VHTMCS", wireType)
 			}
-			m.Type = 0
+			m.VHTMCS = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -35294,16 +40409,16 @@ func (m *Dot11) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= int32(b&0x7F) << shift
+				m.VHTMCS |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proto", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BW", wireType)
 			}
-			m.Proto = 0
+			m.BW = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -35313,54 +40428,16 @@ func (m *Dot11) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Proto |= int32(b&0x7F) << shift
+				m.BW |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
-			}
-			m.Flags = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Flags |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DurationID", wireType)
-			}
-			m.DurationID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DurationID |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SNR", wireType)
 			}
-			var stringLen uint64
+			m.SNR = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -35370,93 +40447,66 @@ func (m *Dot11) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SNR |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNetcap
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetcap(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address1 = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address2", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Dot11LinkAdapationControl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetcap
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address2 = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address3", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Address3 = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address4", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Dot11LinkAdapationControl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Dot11LinkAdapationControl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TRQ", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -35466,29 +40516,17 @@ func (m *Dot11) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address4 = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+			m.TRQ = bool(v != 0)
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MRQ", wireType)
 			}
-			m.SequenceNumber = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -35498,16 +40536,17 @@ func (m *Dot11) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SequenceNumber |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			m.MRQ = bool(v != 0)
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FragmentNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MSI", wireType)
 			}
-			m.FragmentNumber = 0
+			m.MSI = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -35517,16 +40556,16 @@ func (m *Dot11) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FragmentNumber |= int32(b&0x7F) << shift
+				m.MSI |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MFSI", wireType)
 			}
-			m.Checksum = 0
+			m.MFSI = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -35536,14 +40575,14 @@ func (m *Dot11) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Checksum |= uint32(b&0x7F) << shift
+				m.MFSI |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QOS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ASEL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35570,18 +40609,18 @@ func (m *Dot11) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QOS == nil {
-				m.QOS = &Dot11QOS{}
+			if m.ASEL == nil {
+				m.ASEL = &Dot11ASEL{}
 			}
-			if err := m.QOS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ASEL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTControl", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MFB", wireType)
 			}
-			var msglen int
+			m.MFB = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -35591,38 +40630,18 @@ func (m *Dot11) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MFB |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.HTControl == nil {
-				m.HTControl = &Dot11HTControl{}
-			}
-			if err := m.HTControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -35637,7 +40656,7 @@ func (m *Dot11) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Dot11QOS) Unmarshal(dAtA []byte) error {
+func (m *Dot11ASEL) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35660,17 +40679,17 @@ func (m *Dot11QOS) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Dot11QOS: wiretype end group for non-group")
+			return fmt.Errorf("proto: Dot11ASEL: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Dot11QOS: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Dot11ASEL: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
-			m.TID = 0
+			m.Command = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -35680,55 +40699,16 @@ func (m *Dot11QOS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TID |= int32(b&0x7F) << shift
+				m.Command |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EOSP", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EOSP = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AckPolicy", wireType)
-			}
-			m.AckPolicy = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AckPolicy |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TXOP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.TXOP = 0
+			m.Data = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -35738,7 +40718,7 @@ func (m *Dot11QOS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TXOP |= int32(b&0x7F) << shift
+				m.Data |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -35749,10 +40729,7 @@ func (m *Dot11QOS) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -35767,7 +40744,7 @@ func (m *Dot11QOS) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Dot11HTControl) Unmarshal(dAtA []byte) error {
+func (m *LinkLayerDiscovery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35790,17 +40767,17 @@ func (m *Dot11HTControl) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Dot11HTControl: wiretype end group for non-group")
+			return fmt.Errorf("proto: LinkLayerDiscovery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Dot11HTControl: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LinkLayerDiscovery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ACConstraint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var v int
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -35810,17 +40787,16 @@ func (m *Dot11HTControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ACConstraint = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RDGMorePPDU", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChassisID", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -35830,15 +40806,31 @@ func (m *Dot11HTControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RDGMorePPDU = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChassisID == nil {
+				m.ChassisID = &LLDPChassisID{}
+			}
+			if err := m.ChassisID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VHT", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortID", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35865,16 +40857,35 @@ func (m *Dot11HTControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VHT == nil {
-				m.VHT = &Dot11HTControlVHT{}
+			if m.PortID == nil {
+				m.PortID = &LLDPPortID{}
 			}
-			if err := m.VHT.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PortID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			}
+			m.TTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TTL |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HT", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35901,10 +40912,8 @@ func (m *Dot11HTControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HT == nil {
-				m.HT = &Dot11HTControlHT{}
-			}
-			if err := m.HT.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Values = append(m.Values, &LinkLayerDiscoveryValue{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -35914,10 +40923,7 @@ func (m *Dot11HTControl) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -35932,7 +40938,7 @@ func (m *Dot11HTControl) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Dot11HTControlVHT) Unmarshal(dAtA []byte) error {
+func (m *LLDPChassisID) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35955,17 +40961,17 @@ func (m *Dot11HTControlVHT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Dot11HTControlVHT: wiretype end group for non-group")
+			return fmt.Errorf("proto: LLDPChassisID: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Dot11HTControlVHT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LLDPChassisID: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MRQ", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subtype", wireType)
 			}
-			var v int
+			m.Subtype = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -35975,56 +40981,16 @@ func (m *Dot11HTControlVHT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Subtype |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MRQ = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnsolicitedMFB", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UnsolicitedMFB = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MSI", wireType)
-			}
-			m.MSI = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MSI |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MFB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36034,72 +41000,81 @@ func (m *Dot11HTControlVHT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MFB == nil {
-				m.MFB = &Dot11HTControlMFB{}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
 			}
-			if err := m.MFB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetcap(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompressedMSI", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNetcap
 			}
-			m.CompressedMSI = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CompressedMSI |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field STBCIndication", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LLDPPortID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetcap
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.STBCIndication = bool(v != 0)
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LLDPPortID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LLDPPortID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MFSI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subtype", wireType)
 			}
-			m.MFSI = 0
+			m.Subtype = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36109,16 +41084,16 @@ func (m *Dot11HTControlVHT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MFSI |= int32(b&0x7F) << shift
+				m.Subtype |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GID", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.GID = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36128,60 +41103,33 @@ func (m *Dot11HTControlVHT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GID |= int32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodingType", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthNetcap
 			}
-			m.CodingType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CodingType |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FbTXBeamformed", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
 			}
-			m.FbTXBeamformed = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -36196,7 +41144,7 @@ func (m *Dot11HTControlVHT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Dot11HTControlHT) Unmarshal(dAtA []byte) error {
+func (m *LinkLayerDiscoveryValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36219,17 +41167,17 @@ func (m *Dot11HTControlHT) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Dot11HTControlHT: wiretype end group for non-group")
+			return fmt.Errorf("proto: LinkLayerDiscoveryValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Dot11HTControlHT: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LinkLayerDiscoveryValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkAdapationControl", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36239,33 +41187,16 @@ func (m *Dot11HTControlHT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Type |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LinkAdapationControl == nil {
-				m.LinkAdapationControl = &Dot11LinkAdapationControl{}
-			}
-			if err := m.LinkAdapationControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CalibrationPosition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
 			}
-			m.CalibrationPosition = 0
+			m.Length = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36275,16 +41206,16 @@ func (m *Dot11HTControlHT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CalibrationPosition |= int32(b&0x7F) << shift
+				m.Length |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CalibrationSequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.CalibrationSequence = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36294,35 +41225,81 @@ func (m *Dot11HTControlHT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CalibrationSequence |= int32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CSISteering", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetcap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthernetCTP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetcap
 			}
-			m.CSISteering = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CSISteering |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthernetCTP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthernetCTP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NDPAnnouncement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var v int
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36332,17 +41309,16 @@ func (m *Dot11HTControlHT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NDPAnnouncement = bool(v != 0)
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DEI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipCount", wireType)
 			}
-			var v int
+			m.SkipCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36352,22 +41328,18 @@ func (m *Dot11HTControlHT) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.SkipCount |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DEI = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -36382,7 +41354,7 @@ func (m *Dot11HTControlHT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Dot11HTControlMFB) Unmarshal(dAtA []byte) error {
+func (m *EthernetCTPReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36405,17 +41377,17 @@ func (m *Dot11HTControlMFB) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Dot11HTControlMFB: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthernetCTPReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Dot11HTControlMFB: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthernetCTPReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumSTS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.NumSTS = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36425,16 +41397,16 @@ func (m *Dot11HTControlMFB) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumSTS |= int32(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VHTMCS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
 			}
-			m.VHTMCS = 0
+			m.Function = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36444,16 +41416,16 @@ func (m *Dot11HTControlMFB) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VHTMCS |= int32(b&0x7F) << shift
+				m.Function |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BW", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptNumber", wireType)
 			}
-			m.BW = 0
+			m.ReceiptNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36463,16 +41435,16 @@ func (m *Dot11HTControlMFB) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BW |= int32(b&0x7F) << shift
+				m.ReceiptNumber |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SNR", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.SNR = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36482,21 +41454,33 @@ func (m *Dot11HTControlMFB) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SNR |= int32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -36511,7 +41495,7 @@ func (m *Dot11HTControlMFB) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Dot11LinkAdapationControl) Unmarshal(dAtA []byte) error {
+func (m *LinkLayerDiscoveryInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36534,17 +41518,17 @@ func (m *Dot11LinkAdapationControl) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Dot11LinkAdapationControl: wiretype end group for non-group")
+			return fmt.Errorf("proto: LinkLayerDiscoveryInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Dot11LinkAdapationControl: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LinkLayerDiscoveryInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TRQ", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var v int
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36554,17 +41538,16 @@ func (m *Dot11LinkAdapationControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TRQ = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MRQ", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortDescription", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36574,17 +41557,29 @@ func (m *Dot11LinkAdapationControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MRQ = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortDescription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MSI", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SysName", wireType)
 			}
-			m.MSI = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36594,16 +41589,29 @@ func (m *Dot11LinkAdapationControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MSI |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SysName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MFSI", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SysDescription", wireType)
 			}
-			m.MFSI = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36613,14 +41621,27 @@ func (m *Dot11LinkAdapationControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MFSI |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SysDescription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ASEL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SysCapabilities", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36647,18 +41668,18 @@ func (m *Dot11LinkAdapationControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ASEL == nil {
-				m.ASEL = &Dot11ASEL{}
+			if m.SysCapabilities == nil {
+				m.SysCapabilities = &LLDPSysCapabilities{}
 			}
-			if err := m.ASEL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SysCapabilities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MFB", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MgmtAddress", wireType)
 			}
-			m.MFB = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36668,21 +41689,103 @@ func (m *Dot11LinkAdapationControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MFB |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MgmtAddress == nil {
+				m.MgmtAddress = &LLDPMgmtAddress{}
+			}
+			if err := m.MgmtAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrgTLVs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrgTLVs = append(m.OrgTLVs, &LLDPOrgSpecificTLV{})
+			if err := m.OrgTLVs[len(m.OrgTLVs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unknown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unknown = append(m.Unknown, &LinkLayerDiscoveryValue{})
+			if err := m.Unknown[len(m.Unknown)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -36697,7 +41800,7 @@ func (m *Dot11LinkAdapationControl) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Dot11ASEL) Unmarshal(dAtA []byte) error {
+func (m *LLDPSysCapabilities) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36720,17 +41823,17 @@ func (m *Dot11ASEL) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Dot11ASEL: wiretype end group for non-group")
+			return fmt.Errorf("proto: LLDPSysCapabilities: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Dot11ASEL: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LLDPSysCapabilities: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemCap", wireType)
 			}
-			m.Command = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36740,16 +41843,33 @@ func (m *Dot11ASEL) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Command |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SystemCap == nil {
+				m.SystemCap = &LLDPCapabilities{}
+			}
+			if err := m.SystemCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledCap", wireType)
 			}
-			m.Data = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36759,21 +41879,35 @@ func (m *Dot11ASEL) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Data |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EnabledCap == nil {
+				m.EnabledCap = &LLDPCapabilities{}
+			}
+			if err := m.EnabledCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -36788,7 +41922,7 @@ func (m *Dot11ASEL) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LinkLayerDiscovery) Unmarshal(dAtA []byte) error {
+func (m *LLDPCapabilities) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36811,17 +41945,17 @@ func (m *LinkLayerDiscovery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LinkLayerDiscovery: wiretype end group for non-group")
+			return fmt.Errorf("proto: LLDPCapabilities: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinkLayerDiscovery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LLDPCapabilities: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Other", wireType)
 			}
-			m.Timestamp = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36831,16 +41965,17 @@ func (m *LinkLayerDiscovery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Other = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChassisID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repeater", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36850,33 +41985,137 @@ func (m *LinkLayerDiscovery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthNetcap
+			m.Repeater = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bridge", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Bridge = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WLANAP", wireType)
 			}
-			if m.ChassisID == nil {
-				m.ChassisID = &LLDPChassisID{}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.ChassisID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.WLANAP = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Router", wireType)
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortID", wireType)
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Router = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Phone = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocSis", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DocSis = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StationOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StationOnly = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CVLAN", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36886,33 +42125,17 @@ func (m *LinkLayerDiscovery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PortID == nil {
-				m.PortID = &LLDPPortID{}
-			}
-			if err := m.PortID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+			m.CVLAN = bool(v != 0)
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SVLAN", wireType)
 			}
-			m.TTL = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36922,16 +42145,17 @@ func (m *LinkLayerDiscovery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TTL |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			m.SVLAN = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TMPR", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -36941,36 +42165,19 @@ func (m *LinkLayerDiscovery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Values = append(m.Values, &LinkLayerDiscoveryValue{})
-			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.TMPR = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -36985,7 +42192,7 @@ func (m *LinkLayerDiscovery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LLDPChassisID) Unmarshal(dAtA []byte) error {
+func (m *LLDPMgmtAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37008,10 +42215,10 @@ func (m *LLDPChassisID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LLDPChassisID: wiretype end group for non-group")
+			return fmt.Errorf("proto: LLDPMgmtAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LLDPChassisID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LLDPMgmtAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -37035,7 +42242,7 @@ func (m *LLDPChassisID) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -37062,69 +42269,35 @@ func (m *LLDPChassisID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ID == nil {
-				m.ID = []byte{}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNetcap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LLDPPortID) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNetcap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceSubtype", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.InterfaceSubtype = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InterfaceSubtype |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LLDPPortID: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LLDPPortID: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subtype", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceNumber", wireType)
 			}
-			m.Subtype = 0
+			m.InterfaceNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -37134,16 +42307,16 @@ func (m *LLDPPortID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Subtype |= int32(b&0x7F) << shift
+				m.InterfaceNumber |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -37153,25 +42326,23 @@ func (m *LLDPPortID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ID == nil {
-				m.ID = []byte{}
-			}
+			m.OID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37179,10 +42350,7 @@ func (m *LLDPPortID) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -37197,7 +42365,7 @@ func (m *LLDPPortID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LinkLayerDiscoveryValue) Unmarshal(dAtA []byte) error {
+func (m *LLDPOrgSpecificTLV) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37220,17 +42388,17 @@ func (m *LinkLayerDiscoveryValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LinkLayerDiscoveryValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: LLDPOrgSpecificTLV: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinkLayerDiscoveryValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LLDPOrgSpecificTLV: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OUI", wireType)
 			}
-			m.Type = 0
+			m.OUI = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -37240,16 +42408,16 @@ func (m *LinkLayerDiscoveryValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= int32(b&0x7F) << shift
+				m.OUI |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubType", wireType)
 			}
-			m.Length = 0
+			m.SubType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -37259,14 +42427,14 @@ func (m *LinkLayerDiscoveryValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Length |= int32(b&0x7F) << shift
+				m.SubType |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -37293,9 +42461,9 @@ func (m *LinkLayerDiscoveryValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			m.Info = append(m.Info[:0], dAtA[iNdEx:postIndex]...)
+			if m.Info == nil {
+				m.Info = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -37304,10 +42472,7 @@ func (m *LinkLayerDiscoveryValue) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -37322,7 +42487,7 @@ func (m *LinkLayerDiscoveryValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EthernetCTP) Unmarshal(dAtA []byte) error {
+func (m *IPv4) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37345,10 +42510,10 @@ func (m *EthernetCTP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EthernetCTP: wiretype end group for non-group")
+			return fmt.Errorf("proto: IPv4: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthernetCTP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IPv4: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -37372,9 +42537,9 @@ func (m *EthernetCTP) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkipCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.SkipCount = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -37384,69 +42549,16 @@ func (m *EthernetCTP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SkipCount |= int32(b&0x7F) << shift
+				m.Version |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNetcap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EthernetCTPReply) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNetcap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EthernetCTPReply: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthernetCTPReply: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IHL", wireType)
 			}
-			m.Timestamp = 0
+			m.IHL = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -37456,16 +42568,16 @@ func (m *EthernetCTPReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				m.IHL |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TOS", wireType)
 			}
-			m.Function = 0
+			m.TOS = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -37475,16 +42587,16 @@ func (m *EthernetCTPReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Function |= int32(b&0x7F) << shift
+				m.TOS |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
 			}
-			m.ReceiptNumber = 0
+			m.Length = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -37494,16 +42606,16 @@ func (m *EthernetCTPReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReceiptNumber |= int32(b&0x7F) << shift
+				m.Length |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var byteLen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -37513,84 +42625,54 @@ func (m *EthernetCTPReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Id |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNetcap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LinkLayerDiscoveryInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNetcap
+			m.Flags = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Flags |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FragOffset", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.FragOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FragOffset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LinkLayerDiscoveryInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinkLayerDiscoveryInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
 			}
-			m.Timestamp = 0
+			m.TTL = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -37600,16 +42682,16 @@ func (m *LinkLayerDiscoveryInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				m.TTL |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortDescription", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
 			}
-			var stringLen uint64
+			m.Protocol = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -37619,27 +42701,33 @@ func (m *LinkLayerDiscoveryInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Protocol |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Checksum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Checksum |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.PortDescription = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SysName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcIP", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37667,11 +42755,11 @@ func (m *LinkLayerDiscoveryInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SysName = string(dAtA[iNdEx:postIndex])
+			m.SrcIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SysDescription", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37699,13 +42787,13 @@ func (m *LinkLayerDiscoveryInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SysDescription = string(dAtA[iNdEx:postIndex])
+			m.DstIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SysCapabilities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Padding", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -37715,31 +42803,29 @@ func (m *LinkLayerDiscoveryInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SysCapabilities == nil {
-				m.SysCapabilities = &LLDPSysCapabilities{}
-			}
-			if err := m.SysCapabilities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Padding = append(m.Padding[:0], dAtA[iNdEx:postIndex]...)
+			if m.Padding == nil {
+				m.Padding = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MgmtAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37766,18 +42852,46 @@ func (m *LinkLayerDiscoveryInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MgmtAddress == nil {
-				m.MgmtAddress = &LLDPMgmtAddress{}
-			}
-			if err := m.MgmtAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Options = append(m.Options, &IPv4Option{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrgTLVs", wireType)
+		case 16:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadEntropy", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.PayloadEntropy = float64(math.Float64frombits(v))
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcPort", wireType)
+			}
+			m.SrcPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -37787,29 +42901,33 @@ func (m *LinkLayerDiscoveryInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SrcPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPort", wireType)
 			}
-			m.OrgTLVs = append(m.OrgTLVs, &LLDPOrgSpecificTLV{})
-			if err := m.OrgTLVs[len(m.OrgTLVs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DstPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 8:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unknown", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37836,8 +42954,10 @@ func (m *LinkLayerDiscoveryInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unknown = append(m.Unknown, &LinkLayerDiscoveryValue{})
-			if err := m.Unknown[len(m.Unknown)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -37847,10 +42967,7 @@ func (m *LinkLayerDiscoveryInfo) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -37865,7 +42982,7 @@ func (m *LinkLayerDiscoveryInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LLDPSysCapabilities) Unmarshal(dAtA []byte) error {
+func (m *IPv4Option) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37888,17 +43005,17 @@ func (m *LLDPSysCapabilities) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LLDPSysCapabilities: wiretype end group for non-group")
+			return fmt.Errorf("proto: IPv4Option: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LLDPSysCapabilities: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IPv4Option: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemCap", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionType", wireType)
 			}
-			var msglen int
+			m.OptionType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -37908,33 +43025,35 @@ func (m *LLDPSysCapabilities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OptionType |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SystemCap == nil {
-				m.SystemCap = &LLDPCapabilities{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionLength", wireType)
 			}
-			if err := m.SystemCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.OptionLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OptionLength |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnabledCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionData", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -37944,26 +43063,24 @@ func (m *LLDPSysCapabilities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EnabledCap == nil {
-				m.EnabledCap = &LLDPCapabilities{}
-			}
-			if err := m.EnabledCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.OptionData = append(m.OptionData[:0], dAtA[iNdEx:postIndex]...)
+			if m.OptionData == nil {
+				m.OptionData = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -37972,10 +43089,7 @@ func (m *LLDPSysCapabilities) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -37990,7 +43104,7 @@ func (m *LLDPSysCapabilities) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LLDPCapabilities) Unmarshal(dAtA []byte) error {
+func (m *IPv6) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38013,17 +43127,17 @@ func (m *LLDPCapabilities) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LLDPCapabilities: wiretype end group for non-group")
+			return fmt.Errorf("proto: IPv6: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LLDPCapabilities: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IPv6: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Other", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var v int
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38033,17 +43147,16 @@ func (m *LLDPCapabilities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Other = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repeater", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var v int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38053,17 +43166,16 @@ func (m *LLDPCapabilities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Version |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Repeater = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bridge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficClass", wireType)
 			}
-			var v int
+			m.TrafficClass = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38073,17 +43185,16 @@ func (m *LLDPCapabilities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TrafficClass |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Bridge = bool(v != 0)
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WLANAP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowLabel", wireType)
 			}
-			var v int
+			m.FlowLabel = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38093,17 +43204,16 @@ func (m *LLDPCapabilities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.FlowLabel |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.WLANAP = bool(v != 0)
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Router", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
 			}
-			var v int
+			m.Length = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38113,17 +43223,16 @@ func (m *LLDPCapabilities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Length |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Router = bool(v != 0)
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeader", wireType)
 			}
-			var v int
+			m.NextHeader = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38133,17 +43242,16 @@ func (m *LLDPCapabilities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.NextHeader |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Phone = bool(v != 0)
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocSis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HopLimit", wireType)
 			}
-			var v int
+			m.HopLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38153,77 +43261,16 @@ func (m *LLDPCapabilities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.HopLimit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DocSis = bool(v != 0)
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StationOnly", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.StationOnly = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CVLAN", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.CVLAN = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SVLAN", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SVLAN = bool(v != 0)
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TMPR", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcIP", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38233,89 +43280,29 @@ func (m *LLDPCapabilities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TMPR = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNetcap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LLDPMgmtAddress) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNetcap
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LLDPMgmtAddress: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LLDPMgmtAddress: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subtype", wireType)
-			}
-			m.Subtype = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Subtype |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+			m.SrcIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38325,50 +43312,40 @@ func (m *LLDPMgmtAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
-			}
+			m.DstIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceSubtype", wireType)
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadEntropy", wireType)
 			}
-			m.InterfaceSubtype = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InterfaceSubtype |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.PayloadEntropy = float64(math.Float64frombits(v))
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
 			}
-			m.InterfaceNumber = 0
+			m.PayloadSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38378,16 +43355,16 @@ func (m *LLDPMgmtAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InterfaceNumber |= uint32(b&0x7F) << shift
+				m.PayloadSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HopByHop", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38397,82 +43374,33 @@ func (m *LLDPMgmtAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNetcap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LLDPOrgSpecificTLV) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNetcap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.HopByHop == nil {
+				m.HopByHop = &IPv6HopByHop{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.HopByHop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LLDPOrgSpecificTLV: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LLDPOrgSpecificTLV: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OUI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcPort", wireType)
 			}
-			m.OUI = 0
+			m.SrcPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38482,16 +43410,16 @@ func (m *LLDPOrgSpecificTLV) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OUI |= uint32(b&0x7F) << shift
+				m.SrcPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPort", wireType)
 			}
-			m.SubType = 0
+			m.DstPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38501,16 +43429,16 @@ func (m *LLDPOrgSpecificTLV) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubType |= int32(b&0x7F) << shift
+				m.DstPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38520,24 +43448,26 @@ func (m *LLDPOrgSpecificTLV) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info[:0], dAtA[iNdEx:postIndex]...)
-			if m.Info == nil {
-				m.Info = []byte{}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -38546,10 +43476,7 @@ func (m *LLDPOrgSpecificTLV) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -38564,7 +43491,7 @@ func (m *LLDPOrgSpecificTLV) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IPv4) Unmarshal(dAtA []byte) error {
+func (m *IPv6Fragment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38587,55 +43514,17 @@ func (m *IPv4) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IPv4: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPv4: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+			return fmt.Errorf("proto: IPv6Fragment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IPv6Fragment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IHL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.IHL = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38645,16 +43534,16 @@ func (m *IPv4) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IHL |= int32(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TOS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeader", wireType)
 			}
-			m.TOS = 0
+			m.NextHeader = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38664,16 +43553,16 @@ func (m *IPv4) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TOS |= int32(b&0x7F) << shift
+				m.NextHeader |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserved1", wireType)
 			}
-			m.Length = 0
+			m.Reserved1 = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38683,16 +43572,16 @@ func (m *IPv4) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Length |= int32(b&0x7F) << shift
+				m.Reserved1 |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FragmentOffset", wireType)
 			}
-			m.Id = 0
+			m.FragmentOffset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38702,16 +43591,16 @@ func (m *IPv4) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int32(b&0x7F) << shift
+				m.FragmentOffset |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserved2", wireType)
 			}
-			m.Flags = 0
+			m.Reserved2 = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38721,16 +43610,16 @@ func (m *IPv4) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Flags |= int32(b&0x7F) << shift
+				m.Reserved2 |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FragOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MoreFragments", wireType)
 			}
-			m.FragOffset = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38740,16 +43629,17 @@ func (m *IPv4) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FragOffset |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			m.MoreFragments = bool(v != 0)
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identification", wireType)
 			}
-			m.TTL = 0
+			m.Identification = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38759,16 +43649,16 @@ func (m *IPv4) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TTL |= int32(b&0x7F) << shift
+				m.Identification |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcPort", wireType)
 			}
-			m.Protocol = 0
+			m.SrcPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38778,16 +43668,16 @@ func (m *IPv4) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Protocol |= int32(b&0x7F) << shift
+				m.SrcPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPort", wireType)
 			}
-			m.Checksum = 0
+			m.DstPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38797,12 +43687,12 @@ func (m *IPv4) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Checksum |= int32(b&0x7F) << shift
+				m.DstPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SrcIP", wireType)
 			}
@@ -38834,7 +43724,7 @@ func (m *IPv4) Unmarshal(dAtA []byte) error {
 			}
 			m.SrcIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
 			}
@@ -38866,11 +43756,11 @@ func (m *IPv4) Unmarshal(dAtA []byte) error {
 			}
 			m.DstIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Padding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38880,31 +43770,83 @@ func (m *IPv4) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Padding = append(m.Padding[:0], dAtA[iNdEx:postIndex]...)
-			if m.Padding == nil {
-				m.Padding = []byte{}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetcap(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ICMPv4) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetcap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICMPv4: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICMPv4: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38914,42 +43856,73 @@ func (m *IPv4) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthNetcap
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeCode", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
+			m.TypeCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TypeCode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
-			m.Options = append(m.Options, &IPv4Option{})
-			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Checksum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Checksum |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 16:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadEntropy", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.PayloadEntropy = float64(math.Float64frombits(v))
-		case 17:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
 			}
-			m.PayloadSize = 0
+			m.Seq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38959,16 +43932,16 @@ func (m *IPv4) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PayloadSize |= int32(b&0x7F) << shift
+				m.Seq |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcPort", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcIP", wireType)
 			}
-			m.SrcPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38978,16 +43951,29 @@ func (m *IPv4) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SrcPort |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstPort", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
 			}
-			m.DstPort = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -38997,21 +43983,67 @@ func (m *IPv4) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DstPort |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -39026,7 +44058,7 @@ func (m *IPv4) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IPv4Option) Unmarshal(dAtA []byte) error {
+func (m *ICMPv6) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39049,17 +44081,17 @@ func (m *IPv4Option) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IPv4Option: wiretype end group for non-group")
+			return fmt.Errorf("proto: ICMPv6: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPv4Option: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ICMPv6: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.OptionType = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -39069,16 +44101,16 @@ func (m *IPv4Option) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OptionType |= int32(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeCode", wireType)
 			}
-			m.OptionLength = 0
+			m.TypeCode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -39088,16 +44120,35 @@ func (m *IPv4Option) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OptionLength |= int32(b&0x7F) << shift
+				m.TypeCode |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			m.Checksum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Checksum |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcIP", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -39107,24 +44158,90 @@ func (m *IPv4Option) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OptionData = append(m.OptionData[:0], dAtA[iNdEx:postIndex]...)
-			if m.OptionData == nil {
-				m.OptionData = []byte{}
+			m.SrcIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -39133,10 +44250,7 @@ func (m *IPv4Option) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -39151,7 +44265,7 @@ func (m *IPv4Option) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IPv6) Unmarshal(dAtA []byte) error {
+func (m *ICMPv6NeighborAdvertisement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39174,10 +44288,10 @@ func (m *IPv6) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IPv6: wiretype end group for non-group")
+			return fmt.Errorf("proto: ICMPv6NeighborAdvertisement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPv6: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ICMPv6NeighborAdvertisement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -39201,9 +44315,9 @@ func (m *IPv6) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
 			}
-			m.Version = 0
+			m.Flags = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -39213,35 +44327,16 @@ func (m *IPv6) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int32(b&0x7F) << shift
+				m.Flags |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrafficClass", wireType)
-			}
-			m.TrafficClass = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TrafficClass |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlowLabel", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
 			}
-			m.FlowLabel = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -39251,54 +44346,29 @@ func (m *IPv6) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FlowLabel |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
-			}
-			m.Length = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Length |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextHeader", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
 			}
-			m.NextHeader = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextHeader |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HopLimit", wireType)
+			m.TargetAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			m.HopLimit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -39308,12 +44378,27 @@ func (m *IPv6) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HopLimit |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &ICMPv6Option{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SrcIP", wireType)
 			}
@@ -39345,7 +44430,7 @@ func (m *IPv6) Unmarshal(dAtA []byte) error {
 			}
 			m.SrcIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
 			}
@@ -39377,39 +44462,9 @@ func (m *IPv6) Unmarshal(dAtA []byte) error {
 			}
 			m.DstIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadEntropy", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.PayloadEntropy = float64(math.Float64frombits(v))
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
-			}
-			m.PayloadSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PayloadSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HopByHop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39436,61 +44491,20 @@ func (m *IPv6) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HopByHop == nil {
-				m.HopByHop = &IPv6HopByHop{}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
 			}
-			if err := m.HopByHop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcPort", wireType)
-			}
-			m.SrcPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SrcPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstPort", wireType)
-			}
-			m.DstPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DstPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -39505,7 +44519,7 @@ func (m *IPv6) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IPv6Fragment) Unmarshal(dAtA []byte) error {
+func (m *ICMPv6RouterAdvertisement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39528,10 +44542,10 @@ func (m *IPv6Fragment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IPv6Fragment: wiretype end group for non-group")
+			return fmt.Errorf("proto: ICMPv6RouterAdvertisement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPv6Fragment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ICMPv6RouterAdvertisement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -39555,9 +44569,9 @@ func (m *IPv6Fragment) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HopLimit", wireType)
 			}
-			m.NextHeader = 0
+			m.HopLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -39567,16 +44581,16 @@ func (m *IPv6Fragment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextHeader |= int32(b&0x7F) << shift
+				m.HopLimit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reserved1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
 			}
-			m.Reserved1 = 0
+			m.Flags = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -39586,16 +44600,16 @@ func (m *IPv6Fragment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Reserved1 |= int32(b&0x7F) << shift
+				m.Flags |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FragmentOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RouterLifetime", wireType)
 			}
-			m.FragmentOffset = 0
+			m.RouterLifetime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -39605,16 +44619,16 @@ func (m *IPv6Fragment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FragmentOffset |= int32(b&0x7F) << shift
+				m.RouterLifetime |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reserved2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReachableTime", wireType)
 			}
-			m.Reserved2 = 0
+			m.ReachableTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -39624,16 +44638,16 @@ func (m *IPv6Fragment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Reserved2 |= int32(b&0x7F) << shift
+				m.ReachableTime |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MoreFragments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetransTimer", wireType)
 			}
-			var v int
+			m.RetransTimer = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -39643,17 +44657,16 @@ func (m *IPv6Fragment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.RetransTimer |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MoreFragments = bool(v != 0)
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identification", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			m.Identification = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -39663,16 +44676,31 @@ func (m *IPv6Fragment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Identification |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &ICMPv6Option{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcPort", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcIP", wireType)
 			}
-			m.SrcPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -39682,16 +44710,29 @@ func (m *IPv6Fragment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SrcPort |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstPort", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
 			}
-			m.DstPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -39701,16 +44742,29 @@ func (m *IPv6Fragment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DstPort |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcIP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -39720,29 +44774,102 @@ func (m *IPv6Fragment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcIP = string(dAtA[iNdEx:postIndex])
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetcap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ICMPv6Option) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetcap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICMPv6Option: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICMPv6Option: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -39752,23 +44879,25 @@ func (m *IPv6Fragment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstIP = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39776,10 +44905,7 @@ func (m *IPv6Fragment) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -39794,7 +44920,7 @@ func (m *IPv6Fragment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ICMPv4) Unmarshal(dAtA []byte) error {
+func (m *UDP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39817,10 +44943,10 @@ func (m *ICMPv4) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ICMPv4: wiretype end group for non-group")
+			return fmt.Errorf("proto: UDP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ICMPv4: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UDP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -39844,9 +44970,9 @@ func (m *ICMPv4) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TypeCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcPort", wireType)
 			}
-			m.TypeCode = 0
+			m.SrcPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -39856,16 +44982,16 @@ func (m *ICMPv4) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TypeCode |= int32(b&0x7F) << shift
+				m.SrcPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPort", wireType)
 			}
-			m.Checksum = 0
+			m.DstPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -39875,16 +45001,16 @@ func (m *ICMPv4) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Checksum |= int32(b&0x7F) << shift
+				m.DstPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
 			}
-			m.Id = 0
+			m.Length = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -39894,16 +45020,16 @@ func (m *ICMPv4) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int32(b&0x7F) << shift
+				m.Length |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
-			m.Seq = 0
+			m.Checksum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -39913,16 +45039,46 @@ func (m *ICMPv4) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Seq |= int32(b&0x7F) << shift
+				m.Checksum |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadEntropy", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.PayloadEntropy = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcIP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -39932,27 +45088,29 @@ func (m *ICMPv4) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcIP = string(dAtA[iNdEx:postIndex])
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcIP", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39980,66 +45138,13 @@ func (m *ICMPv4) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstIP = string(dAtA[iNdEx:postIndex])
+			m.SrcIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNetcap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ICMPv6) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNetcap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ICMPv6: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ICMPv6: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
 			}
-			m.Timestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -40049,52 +45154,27 @@ func (m *ICMPv6) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TypeCode", wireType)
-			}
-			m.TypeCode = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TypeCode |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
 			}
-			m.Checksum = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Checksum |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
+			m.DstIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcIP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40122,13 +45202,13 @@ func (m *ICMPv6) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcIP = string(dAtA[iNdEx:postIndex])
+			m.CommunityID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -40138,23 +45218,27 @@ func (m *ICMPv6) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstIP = string(dAtA[iNdEx:postIndex])
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -40162,10 +45246,7 @@ func (m *ICMPv6) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -40180,7 +45261,7 @@ func (m *ICMPv6) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ICMPv6NeighborAdvertisement) Unmarshal(dAtA []byte) error {
+func (m *TCP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40203,10 +45284,10 @@ func (m *ICMPv6NeighborAdvertisement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ICMPv6NeighborAdvertisement: wiretype end group for non-group")
+			return fmt.Errorf("proto: TCP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ICMPv6NeighborAdvertisement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TCP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -40230,9 +45311,9 @@ func (m *ICMPv6NeighborAdvertisement) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcPort", wireType)
 			}
-			m.Flags = 0
+			m.SrcPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -40242,16 +45323,16 @@ func (m *ICMPv6NeighborAdvertisement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Flags |= int32(b&0x7F) << shift
+				m.SrcPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPort", wireType)
 			}
-			var stringLen uint64
+			m.DstPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -40261,29 +45342,16 @@ func (m *ICMPv6NeighborAdvertisement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DstPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TargetAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeqNum", wireType)
 			}
-			var msglen int
+			m.SeqNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -40293,31 +45361,16 @@ func (m *ICMPv6NeighborAdvertisement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SeqNum |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Options = append(m.Options, &ICMPv6Option{})
-			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcIP", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckNum", wireType)
 			}
-			var stringLen uint64
+			m.AckNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -40327,29 +45380,35 @@ func (m *ICMPv6NeighborAdvertisement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AckNum |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataOffset", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.DataOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataOffset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.SrcIP = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FIN", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -40359,82 +45418,117 @@ func (m *ICMPv6NeighborAdvertisement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNetcap
+			m.FIN = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SYN", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SYN = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RST", wireType)
 			}
-			m.DstIP = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNetcap(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
+			m.RST = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PSH", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthNetcap
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.PSH = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ACK", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ICMPv6RouterAdvertisement) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNetcap
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.ACK = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URG", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ICMPv6RouterAdvertisement: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ICMPv6RouterAdvertisement: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.URG = bool(v != 0)
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ECE", wireType)
 			}
-			m.Timestamp = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -40444,16 +45538,17 @@ func (m *ICMPv6RouterAdvertisement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.ECE = bool(v != 0)
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HopLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CWR", wireType)
 			}
-			m.HopLimit = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -40463,16 +45558,17 @@ func (m *ICMPv6RouterAdvertisement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HopLimit |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.CWR = bool(v != 0)
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NS", wireType)
 			}
-			m.Flags = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -40482,16 +45578,17 @@ func (m *ICMPv6RouterAdvertisement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Flags |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			m.NS = bool(v != 0)
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RouterLifetime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			m.RouterLifetime = 0
+			m.Window = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -40501,16 +45598,16 @@ func (m *ICMPv6RouterAdvertisement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RouterLifetime |= int32(b&0x7F) << shift
+				m.Window |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 17:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReachableTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
-			m.ReachableTime = 0
+			m.Checksum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -40520,16 +45617,16 @@ func (m *ICMPv6RouterAdvertisement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReachableTime |= uint32(b&0x7F) << shift
+				m.Checksum |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetransTimer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Urgent", wireType)
 			}
-			m.RetransTimer = 0
+			m.Urgent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -40539,12 +45636,46 @@ func (m *ICMPv6RouterAdvertisement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RetransTimer |= uint32(b&0x7F) << shift
+				m.Urgent |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Padding", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Padding = append(m.Padding[:0], dAtA[iNdEx:postIndex]...)
+			if m.Padding == nil {
+				m.Padding = []byte{}
+			}
+			iNdEx = postIndex
+		case 20:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
@@ -40573,12 +45704,76 @@ func (m *ICMPv6RouterAdvertisement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Options = append(m.Options, &ICMPv6Option{})
+			m.Options = append(m.Options, &TCPOption{})
 			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 21:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadEntropy", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.PayloadEntropy = float64(math.Float64frombits(v))
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 24:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SrcIP", wireType)
 			}
@@ -40610,7 +45805,7 @@ func (m *ICMPv6RouterAdvertisement) Unmarshal(dAtA []byte) error {
 			}
 			m.SrcIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 25:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
 			}
@@ -40642,16 +45837,81 @@ func (m *ICMPv6RouterAdvertisement) Unmarshal(dAtA []byte) error {
 			}
 			m.DstIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -40666,7 +45926,7 @@ func (m *ICMPv6RouterAdvertisement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ICMPv6Option) Unmarshal(dAtA []byte) error {
+func (m *TCPOption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40689,17 +45949,17 @@ func (m *ICMPv6Option) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ICMPv6Option: wiretype end group for non-group")
+			return fmt.Errorf("proto: TCPOption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ICMPv6Option: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TCPOption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionType", wireType)
 			}
-			m.Type = 0
+			m.OptionType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -40709,14 +45969,33 @@ func (m *ICMPv6Option) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= int32(b&0x7F) << shift
+				m.OptionType |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionLength", wireType)
+			}
+			m.OptionLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OptionLength |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionData", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -40743,9 +46022,9 @@ func (m *ICMPv6Option) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.OptionData = append(m.OptionData[:0], dAtA[iNdEx:postIndex]...)
+			if m.OptionData == nil {
+				m.OptionData = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -40754,10 +46033,7 @@ func (m *ICMPv6Option) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -40772,7 +46048,7 @@ func (m *ICMPv6Option) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UDP) Unmarshal(dAtA []byte) error {
+func (m *SCTP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40795,10 +46071,10 @@ func (m *UDP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UDP: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCTP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UDP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCTP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -40860,9 +46136,9 @@ func (m *UDP) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationTag", wireType)
 			}
-			m.Length = 0
+			m.VerificationTag = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -40872,7 +46148,7 @@ func (m *UDP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Length |= int32(b&0x7F) << shift
+				m.VerificationTag |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -40891,46 +46167,16 @@ func (m *UDP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Checksum |= int32(b&0x7F) << shift
+				m.Checksum |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadEntropy", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.PayloadEntropy = float64(math.Float64frombits(v))
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
-			}
-			m.PayloadSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PayloadSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcIP", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -40940,29 +46186,27 @@ func (m *UDP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
+			m.SrcIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcIP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40990,13 +46234,13 @@ func (m *UDP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcIP = string(dAtA[iNdEx:postIndex])
+			m.DstIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41006,23 +46250,27 @@ func (m *UDP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstIP = string(dAtA[iNdEx:postIndex])
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -41030,10 +46278,7 @@ func (m *UDP) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -41048,7 +46293,7 @@ func (m *UDP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TCP) Unmarshal(dAtA []byte) error {
+func (m *SCTPAssociation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -41071,17 +46316,17 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TCP: wiretype end group for non-group")
+			return fmt.Errorf("proto: SCTPAssociation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TCP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SCTPAssociation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampFirst", wireType)
 			}
-			m.Timestamp = 0
+			m.TimestampFirst = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41091,16 +46336,16 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				m.TimestampFirst |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampLast", wireType)
 			}
-			m.SrcPort = 0
+			m.TimestampLast = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41110,16 +46355,16 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SrcPort |= int32(b&0x7F) << shift
+				m.TimestampLast |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			m.DstPort = 0
+			m.Duration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41129,16 +46374,16 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DstPort |= int32(b&0x7F) << shift
+				m.Duration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeqNum", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
 			}
-			m.SeqNum = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41148,16 +46393,29 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SeqNum |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AckNum", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcIP", wireType)
 			}
-			m.AckNum = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41167,16 +46425,29 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AckNum |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcPort", wireType)
 			}
-			m.DataOffset = 0
+			m.SrcPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41186,16 +46457,16 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataOffset |= int32(b&0x7F) << shift
+				m.SrcPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FIN", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41205,17 +46476,29 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FIN = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SYN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPort", wireType)
 			}
-			var v int
+			m.DstPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41225,17 +46508,16 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DstPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SYN = bool(v != 0)
 		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RST", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcVerificationTag", wireType)
 			}
-			var v int
+			m.SrcVerificationTag = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41245,17 +46527,16 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.SrcVerificationTag |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RST = bool(v != 0)
 		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PSH", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstVerificationTag", wireType)
 			}
-			var v int
+			m.DstVerificationTag = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41265,17 +46546,16 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DstVerificationTag |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.PSH = bool(v != 0)
 		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ACK", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPackets", wireType)
 			}
-			var v int
+			m.NumPackets = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41285,17 +46565,16 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.NumPackets |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ACK = bool(v != 0)
 		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URG", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumChunks", wireType)
 			}
-			var v int
+			m.NumChunks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41305,17 +46584,16 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.NumChunks |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.URG = bool(v != 0)
 		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ECE", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumDataChunks", wireType)
 			}
-			var v int
+			m.NumDataChunks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41325,17 +46603,16 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.NumDataChunks |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ECE = bool(v != 0)
 		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CWR", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumFragments", wireType)
 			}
-			var v int
+			m.NumFragments = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41345,17 +46622,16 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.NumFragments |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CWR = bool(v != 0)
 		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumMessages", wireType)
 			}
-			var v int
+			m.NumMessages = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41365,17 +46641,16 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.NumMessages |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NS = bool(v != 0)
 		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumStreams", wireType)
 			}
-			m.Window = 0
+			m.NumStreams = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41385,16 +46660,16 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Window |= int32(b&0x7F) << shift
+				m.NumStreams |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 17:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOutOfOrder", wireType)
 			}
-			m.Checksum = 0
+			m.NumOutOfOrder = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41404,16 +46679,16 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Checksum |= int32(b&0x7F) << shift
+				m.NumOutOfOrder |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Urgent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumDuplicates", wireType)
 			}
-			m.Urgent = 0
+			m.NumDuplicates = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41423,16 +46698,16 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Urgent |= int32(b&0x7F) << shift
+				m.NumDuplicates |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Padding", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumIncomplete", wireType)
 			}
-			var byteLen int
+			m.NumIncomplete = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41442,31 +46717,16 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.NumIncomplete |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Padding = append(m.Padding[:0], dAtA[iNdEx:postIndex]...)
-			if m.Padding == nil {
-				m.Padding = []byte{}
-			}
-			iNdEx = postIndex
 		case 20:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesClientToServer", wireType)
 			}
-			var msglen int
+			m.BytesClientToServer = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41476,95 +46736,16 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BytesClientToServer |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Options = append(m.Options, &TCPOption{})
-			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 21:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadEntropy", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.PayloadEntropy = float64(math.Float64frombits(v))
-		case 22:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
-			}
-			m.PayloadSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PayloadSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 23:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
-			iNdEx = postIndex
-		case 24:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcIP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesServerToClient", wireType)
 			}
-			var stringLen uint64
+			m.BytesServerToClient = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41574,27 +46755,14 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BytesServerToClient |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SrcIP = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 25:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadProtocols", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41622,229 +46790,13 @@ func (m *TCP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstIP = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNetcap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TCPOption) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNetcap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TCPOption: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TCPOption: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionType", wireType)
-			}
-			m.OptionType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OptionType |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionLength", wireType)
-			}
-			m.OptionLength = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OptionLength |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionData", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OptionData = append(m.OptionData[:0], dAtA[iNdEx:postIndex]...)
-			if m.OptionData == nil {
-				m.OptionData = []byte{}
-			}
+			m.PayloadProtocols = append(m.PayloadProtocols, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNetcap(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SCTP) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNetcap
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SCTP: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SCTP: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			m.Timestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcPort", wireType)
-			}
-			m.SrcPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SrcPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		case 23:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Init", wireType)
 			}
-			m.DstPort = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41854,16 +46806,17 @@ func (m *SCTP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DstPort |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			m.Init = bool(v != 0)
+		case 24:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationTag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shutdown", wireType)
 			}
-			m.VerificationTag = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41873,16 +46826,17 @@ func (m *SCTP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VerificationTag |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			m.Shutdown = bool(v != 0)
+		case 25:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Abort", wireType)
 			}
-			m.Checksum = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41892,16 +46846,17 @@ func (m *SCTP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Checksum |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			m.Abort = bool(v != 0)
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcIP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -41911,55 +46866,27 @@ func (m *SCTP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcIP = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.DstIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -41967,10 +46894,7 @@ func (m *SCTP) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -42523,16 +47447,81 @@ func (m *DNS) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -42998,10 +47987,7 @@ func (m *DNSResourceRecord) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -43214,10 +48200,7 @@ func (m *DNSSOA) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -43358,10 +48341,7 @@ func (m *DNSSRV) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -43462,10 +48442,7 @@ func (m *DNSMX) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -43585,10 +48562,7 @@ func (m *DNSQuestion) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -44180,16 +49154,49 @@ func (m *DHCPv4) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -44309,10 +49316,7 @@ func (m *DHCPOption) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -44679,16 +49683,49 @@ func (m *DHCPv6) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -44808,10 +49845,7 @@ func (m *DHCPv6Option) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -44977,10 +50011,7 @@ func (m *LLC) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -45426,16 +50457,49 @@ func (m *NTP) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -45741,16 +50805,49 @@ func (m *SIP) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -46147,16 +51244,49 @@ func (m *IGMP) Unmarshal(dAtA []byte) error {
 			}
 			m.DstIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -46327,10 +51457,7 @@ func (m *IGMPv3GroupRecord) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -46491,16 +51618,49 @@ func (m *IPv6HopByHop) Unmarshal(dAtA []byte) error {
 			}
 			m.DstIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -46677,10 +51837,7 @@ func (m *IPv6HopByHopOption) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -46768,10 +51925,7 @@ func (m *IPv6HopByHopOptionAlignment) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -46893,10 +52047,7 @@ func (m *SNAP) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -47061,16 +52212,49 @@ func (m *ICMPv6Echo) Unmarshal(dAtA []byte) error {
 			}
 			m.DstIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -47263,16 +52447,49 @@ func (m *ICMPv6NeighborSolicitation) Unmarshal(dAtA []byte) error {
 			}
 			m.DstIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -47433,16 +52650,49 @@ func (m *ICMPv6RouterSolicitation) Unmarshal(dAtA []byte) error {
 			}
 			m.DstIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -48302,7 +53552,7 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 					if err != nil {
 						return err
 					}
-					if skippy < 0 {
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
 						return ErrInvalidLengthNetcap
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -48429,7 +53679,7 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 					if err != nil {
 						return err
 					}
-					if skippy < 0 {
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
 						return ErrInvalidLengthNetcap
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -48556,7 +53806,7 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 					if err != nil {
 						return err
 					}
-					if skippy < 0 {
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
 						return ErrInvalidLengthNetcap
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -48635,16 +53885,81 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 				m.ResponseBody = []byte{}
 			}
 			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -48919,10 +54234,7 @@ func (m *HTTPCookie) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -49962,16 +55274,81 @@ func (m *TLSClientHello) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
 			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -50702,13 +56079,83 @@ func (m *TLSServerHello) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstMAC = string(dAtA[iNdEx:postIndex])
+			m.DstMAC = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcPort", wireType)
+			}
+			m.SrcPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPort", wireType)
+			}
+			m.DstPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ja3S", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ja3S = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 27:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcPort", wireType)
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityID", wireType)
 			}
-			m.SrcPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -50718,35 +56165,29 @@ func (m *TLSServerHello) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SrcPort |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 28:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstPort", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
 			}
-			m.DstPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DstPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
 			}
-		case 29:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ja3S", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -50756,23 +56197,27 @@ func (m *TLSServerHello) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ja3S = string(dAtA[iNdEx:postIndex])
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -50780,10 +56225,7 @@ func (m *TLSServerHello) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -51001,16 +56443,49 @@ func (m *IPSecAH) Unmarshal(dAtA []byte) error {
 			}
 			m.DstIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -51194,16 +56669,49 @@ func (m *IPSecESP) Unmarshal(dAtA []byte) error {
 			}
 			m.DstIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -51422,10 +56930,7 @@ func (m *Geneve) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -51585,10 +57090,7 @@ func (m *GeneveOption) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -51775,10 +57277,7 @@ func (m *VXLAN) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -52225,10 +57724,7 @@ func (m *USB) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -52392,10 +57888,7 @@ func (m *USBRequestBlockSetup) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -52726,16 +58219,49 @@ func (m *LCM) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -52881,10 +58407,7 @@ func (m *MPLS) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -53198,16 +58721,49 @@ func (m *Modbus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -53662,16 +59218,49 @@ func (m *OSPFv2) Unmarshal(dAtA []byte) error {
 			}
 			m.DstIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -53930,10 +59519,7 @@ func (m *HelloPkg) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -54211,10 +59797,7 @@ func (m *HelloPkgV2) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -54374,10 +59957,7 @@ func (m *DbDescPkg) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -54693,14 +60273,50 @@ func (m *OSPFv3) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LSR = append(m.LSR, &LSReq{})
-			if err := m.LSR[len(m.LSR)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LSR = append(m.LSR, &LSReq{})
+			if err := m.LSR[len(m.LSR)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LSU", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LSU == nil {
+				m.LSU = &LSUpdate{}
+			}
+			if err := m.LSU.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LSU", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LSAs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -54727,18 +60343,16 @@ func (m *OSPFv3) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LSU == nil {
-				m.LSU = &LSUpdate{}
-			}
-			if err := m.LSU.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LSAs = append(m.LSAs, &LSAheader{})
+			if err := m.LSAs[len(m.LSAs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LSAs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcIP", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -54748,29 +60362,27 @@ func (m *OSPFv3) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LSAs = append(m.LSAs, &LSAheader{})
-			if err := m.LSAs[len(m.LSAs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SrcIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcIP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -54798,13 +60410,13 @@ func (m *OSPFv3) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcIP = string(dAtA[iNdEx:postIndex])
+			m.DstIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -54814,23 +60426,27 @@ func (m *OSPFv3) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstIP = string(dAtA[iNdEx:postIndex])
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -54838,10 +60454,7 @@ func (m *OSPFv3) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -55043,10 +60656,7 @@ func (m *LSAheader) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -55520,10 +61130,7 @@ func (m *LSA) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -55630,10 +61237,7 @@ func (m *LSReq) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -55736,10 +61340,7 @@ func (m *LSUpdate) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -55899,10 +61500,7 @@ func (m *IntraAreaPrefixLSA) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -56153,10 +61751,7 @@ func (m *ASExternalLSA) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -56297,10 +61892,7 @@ func (m *InterAreaPrefixLSA) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -56407,10 +61999,7 @@ func (m *InterAreaRouterLSA) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -56555,10 +62144,7 @@ func (m *ASExternalLSAV2) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -56680,10 +62266,7 @@ func (m *RouterLSA) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -56828,10 +62411,7 @@ func (m *Router) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -56953,10 +62533,7 @@ func (m *RouterLSAV2) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -57082,10 +62659,7 @@ func (m *RouterV2) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -57230,10 +62804,7 @@ func (m *NetworkLSA) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -57408,10 +62979,7 @@ func (m *LinkLSA) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -57552,10 +63120,7 @@ func (m *LSAPrefix) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -57951,10 +63516,7 @@ func (m *BFD) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -58095,10 +63657,7 @@ func (m *BFDAuthHeader) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -58552,16 +64111,49 @@ func (m *GRE) Unmarshal(dAtA []byte) error {
 			}
 			m.DstIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -58738,10 +64330,7 @@ func (m *GRERouting) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -58912,10 +64501,7 @@ func (m *FDDI) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -59094,10 +64680,7 @@ func (m *EAP) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -59223,10 +64806,7 @@ func (m *EAPOL) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -59762,10 +65342,7 @@ func (m *EAPOLKey) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -60076,16 +65653,49 @@ func (m *VRRPv2) Unmarshal(dAtA []byte) error {
 			}
 			m.DstIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -60245,10 +65855,7 @@ func (m *CiscoDiscovery) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -60370,10 +65977,7 @@ func (m *CiscoDiscoveryValue) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -60461,10 +66065,7 @@ func (m *CDPVLANDialogue) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -60565,10 +66166,7 @@ func (m *CDPLocation) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -60732,10 +66330,7 @@ func (m *CDPPowerDialogue) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -60865,10 +66460,7 @@ func (m *CDPSparePairPoE) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -61732,10 +67324,7 @@ func (m *CiscoDiscoveryInfo) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -62080,10 +67669,7 @@ func (m *CDPHello) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -62620,10 +68206,7 @@ func (m *CDPEnergyWise) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -62853,10 +68436,7 @@ func (m *CDPCapabilities) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -62970,10 +68550,7 @@ func (m *IPNet) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -63248,10 +68825,7 @@ func (m *NortelDiscovery) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -63622,16 +69196,49 @@ func (m *CIP) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -63889,13 +69496,45 @@ func (m *ENIP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcIP = string(dAtA[iNdEx:postIndex])
+			m.SrcIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcPort", wireType)
 			}
-			var stringLen uint64
+			m.SrcPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -63905,29 +69544,16 @@ func (m *ENIP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SrcPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DstIP = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPort", wireType)
 			}
-			m.SrcPort = 0
+			m.DstPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -63937,16 +69563,16 @@ func (m *ENIP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SrcPort |= int32(b&0x7F) << shift
+				m.DstPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstPort", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
 			}
-			m.DstPort = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -63956,21 +69582,35 @@ func (m *ENIP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DstPort |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -64073,10 +69713,7 @@ func (m *ENIPCommandSpecificData) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -64311,10 +69948,7 @@ func (m *DeviceProfile) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -64451,10 +70085,7 @@ func (m *Port) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -64542,10 +70173,7 @@ func (m *PortStats) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -64890,7 +70518,7 @@ func (m *IPProfile) Unmarshal(dAtA []byte) error {
 					if err != nil {
 						return err
 					}
-					if skippy < 0 {
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
 						return ErrInvalidLengthNetcap
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -65019,7 +70647,7 @@ func (m *IPProfile) Unmarshal(dAtA []byte) error {
 					if err != nil {
 						return err
 					}
-					if skippy < 0 {
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
 						return ErrInvalidLengthNetcap
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -65151,7 +70779,7 @@ func (m *IPProfile) Unmarshal(dAtA []byte) error {
 					if err != nil {
 						return err
 					}
-					if skippy < 0 {
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
 						return ErrInvalidLengthNetcap
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -65264,16 +70892,49 @@ func (m *IPProfile) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -65374,10 +71035,7 @@ func (m *Protocol) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -65521,11 +71179,171 @@ func (m *File) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Location = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ident", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ident = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentTypeDetected", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -65553,11 +71371,11 @@ func (m *File) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Location = string(dAtA[iNdEx:postIndex])
+			m.ContentTypeDetected = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ident", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcIP", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -65585,11 +71403,11 @@ func (m *File) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ident = string(dAtA[iNdEx:postIndex])
+			m.SrcIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -65617,13 +71435,13 @@ func (m *File) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.DstIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcPort", wireType)
 			}
-			var stringLen uint64
+			m.SrcPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -65633,29 +71451,16 @@ func (m *File) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SrcPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPort", wireType)
 			}
-			var stringLen uint64
+			m.DstPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -65665,27 +71470,14 @@ func (m *File) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DstPort |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Host = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentTypeDetected", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -65713,13 +71505,13 @@ func (m *File) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContentTypeDetected = string(dAtA[iNdEx:postIndex])
+			m.CommunityID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcIP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -65729,104 +71521,35 @@ func (m *File) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcIP = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstIP", wireType)
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.DstIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcPort", wireType)
-			}
-			m.SrcPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SrcPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstPort", wireType)
-			}
-			m.DstPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DstPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -65959,10 +71682,7 @@ func (m *SMTPResponse) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -66108,10 +71828,7 @@ func (m *SMTPRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -66212,10 +71929,7 @@ func (m *SMTPCommand) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -66380,51 +72094,115 @@ func (m *SMTP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstIP = string(dAtA[iNdEx:postIndex])
+			m.DstIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcPort", wireType)
+			}
+			m.SrcPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPort", wireType)
+			}
+			m.DstPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MailIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MailIDs = append(m.MailIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commands", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcPort", wireType)
-			}
-			m.SrcPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SrcPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstPort", wireType)
-			}
-			m.DstPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DstPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MailIDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetcap
@@ -66434,55 +72212,27 @@ func (m *SMTP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNetcap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MailIDs = append(m.MailIDs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commands", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowNetcap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNetcap
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -66490,10 +72240,7 @@ func (m *SMTP) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -66825,16 +72572,49 @@ func (m *Diameter) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -67211,10 +72991,7 @@ func (m *AVP) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -67501,16 +73278,49 @@ func (m *POP3) Unmarshal(dAtA []byte) error {
 			}
 			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -68203,16 +74013,49 @@ func (m *Mail) Unmarshal(dAtA []byte) error {
 			}
 			m.Origin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -68404,7 +74247,7 @@ func (m *MailPart) Unmarshal(dAtA []byte) error {
 					if err != nil {
 						return err
 					}
-					if skippy < 0 {
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
 						return ErrInvalidLengthNetcap
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -68485,10 +74328,7 @@ func (m *MailPart) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -68602,10 +74442,7 @@ func (m *POP3Request) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -68719,10 +74556,7 @@ func (m *POP3Response) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -69169,16 +75003,81 @@ func (m *Software) Unmarshal(dAtA []byte) error {
 			}
 			m.OS = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityIDs = append(m.CommunityIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -69650,16 +75549,81 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			}
 			m.OS = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityIDs = append(m.CommunityIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -69882,16 +75846,81 @@ func (m *Credentials) Unmarshal(dAtA []byte) error {
 			}
 			m.Notes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -70134,16 +76163,81 @@ func (m *SSH) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsClient = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tunnel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tunnel == nil {
+				m.Tunnel = &Tunnel{}
+			}
+			if err := m.Tunnel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -70472,10 +76566,7 @@ func (m *Vulnerability) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -70868,10 +76959,7 @@ func (m *Exploit) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -71286,16 +77374,81 @@ func (m *Alert) Unmarshal(dAtA []byte) error {
 			}
 			m.Notes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPayload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalPayload = append(m.OriginalPayload[:0], dAtA[iNdEx:postIndex]...)
+			if m.OriginalPayload == nil {
+				m.OriginalPayload = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetransmittedPayload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetcap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetcap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetransmittedPayload = append(m.RetransmittedPayload[:0], dAtA[iNdEx:postIndex]...)
+			if m.RetransmittedPayload == nil {
+				m.RetransmittedPayload = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetcap(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthNetcap
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthNetcap
 			}
 			if (iNdEx + skippy) > l {
@@ -58,6 +58,7 @@ var fieldsNTP = []string{
 	fieldDstIP,
 	fieldSrcPort,
 	fieldDstPort,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -87,6 +88,7 @@ func (n *NTP) CSVRecord() []string {
 		n.DstIP,
 		formatInt32(n.SrcPort),
 		formatInt32(n.DstPort),
+		n.Tunnel.toString(),
 	})
 }
 
@@ -123,6 +125,7 @@ func (n *NTP) SetPacketContext(ctx *PacketContext) {
 	n.DstIP = ctx.DstIP
 	n.SrcPort = ctx.SrcPort
 	n.DstPort = ctx.DstPort
+	n.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -159,6 +162,7 @@ func (n *NTP) Encode() []string {
 		ntpEncoder.String(fieldDstIP, n.DstIP),
 		ntpEncoder.Int32(fieldSrcPort, n.SrcPort),
 		ntpEncoder.Int32(fieldDstPort, n.DstPort),
+		ntpEncoder.String(fieldTunnel, n.Tunnel.toString()),
 	})
 }
 
@@ -38,6 +38,7 @@ var fieldsPOP3 = []string{
 	fieldUser,      // string
 	fieldPass,      // string
 	fieldNumMails,  // []*Mail
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -55,6 +56,7 @@ func (a *POP3) CSVRecord() []string {
 		a.User,                       // string
 		a.Pass,                       // string
 		strconv.Itoa(len(a.MailIDs)), // []*Mail
+		a.Tunnel.toString(),
 	})
 }
 
@@ -109,6 +111,7 @@ func (a *POP3) Encode() []string {
 		pop3Encoder.String(fieldUser, a.User),           // string
 		pop3Encoder.String(fieldPass, a.Pass),           // string
 		pop3Encoder.Int(fieldNumMails, len(a.MailIDs)),  // []*Mail
+		pop3Encoder.String(fieldTunnel, a.Tunnel.toString()),
 	})
 }
 
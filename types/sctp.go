@@ -32,6 +32,7 @@ var fieldsSCTP = []string{
 	fieldChecksum,
 	fieldSrcIP,
 	fieldDstIP,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -49,6 +50,7 @@ func (s *SCTP) CSVRecord() []string {
 		strconv.FormatUint(uint64(s.Checksum), 10),
 		s.SrcIP,
 		s.DstIP,
+		s.Tunnel.toString(),
 	})
 }
 
@@ -82,6 +84,7 @@ func (s *SCTP) Inc() {
 func (s *SCTP) SetPacketContext(ctx *PacketContext) {
 	s.SrcIP = ctx.SrcIP
 	s.DstIP = ctx.DstIP
+	s.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -106,6 +109,7 @@ func (s *SCTP) Encode() []string {
 		sctpEncoder.Uint32(fieldChecksum, s.Checksum),
 		sctpEncoder.String(fieldSrcIP, s.SrcIP),
 		sctpEncoder.String(fieldDstIP, s.DstIP),
+		sctpEncoder.String(fieldTunnel, s.Tunnel.toString()),
 	})
 }
 
@@ -0,0 +1,186 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package types
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/dreadl0ck/netcap/encoder"
+)
+
+const (
+	fieldSrcVerificationTag = "SrcVerificationTag"
+	fieldDstVerificationTag = "DstVerificationTag"
+	fieldNumChunks          = "NumChunks"
+	fieldNumDataChunks      = "NumDataChunks"
+	fieldNumFragments       = "NumFragments"
+	fieldNumMessages        = "NumMessages"
+	fieldNumStreams         = "NumStreams"
+	fieldNumOutOfOrder      = "NumOutOfOrder"
+	fieldNumDuplicates      = "NumDuplicates"
+	fieldNumIncomplete      = "NumIncomplete"
+	fieldPayloadProtocols   = "PayloadProtocols"
+	fieldInit               = "Init"
+	fieldShutdown           = "Shutdown"
+	fieldAbort              = "Abort"
+)
+
+var fieldsSCTPAssociation = []string{
+	fieldTimestampFirst,
+	fieldTimestampLast,
+	fieldDuration,
+	fieldSrcIP,
+	fieldSrcPort,
+	fieldDstIP,
+	fieldDstPort,
+	fieldSrcVerificationTag,
+	fieldDstVerificationTag,
+	fieldNumPackets,
+	fieldNumChunks,
+	fieldNumDataChunks,
+	fieldNumFragments,
+	fieldNumMessages,
+	fieldNumStreams,
+	fieldNumOutOfOrder,
+	fieldNumDuplicates,
+	fieldNumIncomplete,
+	fieldBytesClientToServer,
+	fieldBytesServerToClient,
+	fieldPayloadProtocols,
+	fieldInit,
+	fieldShutdown,
+	fieldAbort,
+	fieldTunnel,
+}
+
+// CSVHeader returns the CSV header for the audit record.
+func (a *SCTPAssociation) CSVHeader() []string {
+	return filter(fieldsSCTPAssociation)
+}
+
+// CSVRecord returns the CSV record for the audit record.
+func (a *SCTPAssociation) CSVRecord() []string {
+	return filter([]string{
+		formatTimestamp(a.TimestampFirst),
+		formatTimestamp(a.TimestampLast),
+		formatInt64(a.Duration),
+		a.SrcIP,
+		formatInt32(a.SrcPort),
+		a.DstIP,
+		formatInt32(a.DstPort),
+		formatUint32(a.SrcVerificationTag),
+		formatUint32(a.DstVerificationTag),
+		formatInt32(a.NumPackets),
+		formatInt32(a.NumChunks),
+		formatInt32(a.NumDataChunks),
+		formatInt32(a.NumFragments),
+		formatInt32(a.NumMessages),
+		formatInt32(a.NumStreams),
+		formatInt32(a.NumOutOfOrder),
+		formatInt32(a.NumDuplicates),
+		formatInt32(a.NumIncomplete),
+		formatInt64(a.BytesClientToServer),
+		formatInt64(a.BytesServerToClient),
+		join(a.PayloadProtocols...),
+		strconv.FormatBool(a.Init),
+		strconv.FormatBool(a.Shutdown),
+		strconv.FormatBool(a.Abort),
+		a.Tunnel.toString(),
+	})
+}
+
+// Time returns the timestamp associated with the audit record.
+func (a *SCTPAssociation) Time() int64 {
+	return a.TimestampFirst
+}
+
+// JSON returns the JSON representation of the audit record.
+func (a *SCTPAssociation) JSON() (string, error) {
+	// convert unix timestamp from nano to millisecond precision for elastic
+	a.TimestampFirst /= int64(time.Millisecond)
+	a.TimestampLast /= int64(time.Millisecond)
+
+	return jsonMarshaler.MarshalToString(a)
+}
+
+var sctpAssociationMetric = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: strings.ToLower(Type_NC_SCTPAssociation.String()),
+		Help: Type_NC_SCTPAssociation.String() + " audit records",
+	},
+	[]string{fieldSrcIP, fieldSrcPort, fieldDstIP, fieldDstPort},
+)
+
+// Inc increments the metrics for the audit record.
+func (a *SCTPAssociation) Inc() {
+	sctpAssociationMetric.WithLabelValues(a.SrcIP, formatInt32(a.SrcPort), a.DstIP, formatInt32(a.DstPort)).Inc()
+}
+
+// SetPacketContext sets the associated packet context for the audit record.
+func (a *SCTPAssociation) SetPacketContext(*PacketContext) {}
+
+// Src returns the source address of the audit record.
+func (a *SCTPAssociation) Src() string {
+	return a.SrcIP
+}
+
+// Dst returns the destination address of the audit record.
+func (a *SCTPAssociation) Dst() string {
+	return a.DstIP
+}
+
+var sctpAssociationEncoder = encoder.NewValueEncoder()
+
+// Encode will encode categorical values and normalize according to configuration
+func (a *SCTPAssociation) Encode() []string {
+	return filter([]string{
+		sctpAssociationEncoder.Int64(fieldTimestampFirst, a.TimestampFirst),
+		sctpAssociationEncoder.Int64(fieldTimestampLast, a.TimestampLast),
+		sctpAssociationEncoder.Int64(fieldDuration, a.Duration),
+		sctpAssociationEncoder.Int64(fieldSrcIP, ipToInt64(a.SrcIP)),
+		sctpAssociationEncoder.Int32(fieldSrcPort, a.SrcPort),
+		sctpAssociationEncoder.Int64(fieldDstIP, ipToInt64(a.DstIP)),
+		sctpAssociationEncoder.Int32(fieldDstPort, a.DstPort),
+		sctpAssociationEncoder.Uint32(fieldSrcVerificationTag, a.SrcVerificationTag),
+		sctpAssociationEncoder.Uint32(fieldDstVerificationTag, a.DstVerificationTag),
+		sctpAssociationEncoder.Int32(fieldNumPackets, a.NumPackets),
+		sctpAssociationEncoder.Int32(fieldNumChunks, a.NumChunks),
+		sctpAssociationEncoder.Int32(fieldNumDataChunks, a.NumDataChunks),
+		sctpAssociationEncoder.Int32(fieldNumFragments, a.NumFragments),
+		sctpAssociationEncoder.Int32(fieldNumMessages, a.NumMessages),
+		sctpAssociationEncoder.Int32(fieldNumStreams, a.NumStreams),
+		sctpAssociationEncoder.Int32(fieldNumOutOfOrder, a.NumOutOfOrder),
+		sctpAssociationEncoder.Int32(fieldNumDuplicates, a.NumDuplicates),
+		sctpAssociationEncoder.Int32(fieldNumIncomplete, a.NumIncomplete),
+		sctpAssociationEncoder.Int64(fieldBytesClientToServer, a.BytesClientToServer),
+		sctpAssociationEncoder.Int64(fieldBytesServerToClient, a.BytesServerToClient),
+		sctpAssociationEncoder.String(fieldPayloadProtocols, join(a.PayloadProtocols...)),
+		sctpAssociationEncoder.Bool(a.Init),
+		sctpAssociationEncoder.Bool(a.Shutdown),
+		sctpAssociationEncoder.Bool(a.Abort),
+		sctpAssociationEncoder.String(fieldTunnel, a.Tunnel.toString()),
+	})
+}
+
+// Analyze will invoke the configured analyzer for the audit record and return a score.
+func (a *SCTPAssociation) Analyze() {}
+
+// NetcapType returns the type of the current audit record
+func (a *SCTPAssociation) NetcapType() Type {
+	return Type_NC_SCTPAssociation
+}
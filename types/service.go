@@ -51,6 +51,8 @@ var fieldsService = []string{
 	fieldBytesClient, // int32
 	fieldHostname,    // string
 	fieldOS,          // string
+	fieldCommunityIDs,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -75,6 +77,8 @@ func (a *Service) CSVRecord() []string {
 		formatInt32(a.BytesClient),          // int32
 		a.Hostname,                          // string
 		a.OS,                                // string
+		join(a.CommunityIDs...),
+		a.Tunnel.toString(),
 	})
 }
 
@@ -168,6 +172,8 @@ func (a *Service) Encode() []string {
 		serviceEncoder.Int32(fieldBytesClient, a.BytesClient), // int32
 		serviceEncoder.String(fieldHostname, a.Hostname),      // string
 		serviceEncoder.String(fieldOS, a.OS),                  // string
+		serviceEncoder.String(fieldCommunityIDs, join(a.CommunityIDs...)),
+		serviceEncoder.String(fieldTunnel, a.Tunnel.toString()),
 	})
 }
 
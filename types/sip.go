@@ -40,6 +40,7 @@ var fieldsSIP = []string{
 	fieldDstIP,
 	fieldSrcPort,
 	fieldDstPort,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -61,6 +62,7 @@ func (s *SIP) CSVRecord() []string {
 		s.DstIP,
 		formatInt32(s.SrcPort),
 		formatInt32(s.DstPort),
+		s.Tunnel.toString(),
 	})
 }
 
@@ -96,6 +98,7 @@ func (s *SIP) SetPacketContext(ctx *PacketContext) {
 	s.DstIP = ctx.DstIP
 	s.SrcPort = ctx.SrcPort
 	s.DstPort = ctx.DstPort
+	s.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -124,6 +127,7 @@ func (s *SIP) Encode() []string {
 		sipEncoder.String(fieldDstIP, s.DstIP),
 		sipEncoder.Int32(fieldSrcPort, s.SrcPort),
 		sipEncoder.Int32(fieldDstPort, s.DstPort),
+		sipEncoder.String(fieldTunnel, s.Tunnel.toString()),
 	})
 }
 
@@ -38,6 +38,7 @@ var fieldsSMTP = []string{
 	fieldDstIP,
 	fieldSrcPort,
 	fieldDstPort,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -57,6 +58,7 @@ func (a *SMTP) CSVRecord() []string {
 		a.DstIP,
 		formatInt32(a.SrcPort),
 		formatInt32(a.DstPort),
+		a.Tunnel.toString(),
 	})
 }
 
@@ -112,6 +114,7 @@ func (a *SMTP) SetPacketContext(ctx *PacketContext) {
 	a.DstIP = ctx.DstIP
 	a.SrcPort = ctx.SrcPort
 	a.DstPort = ctx.DstPort
+	a.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -138,6 +141,7 @@ func (a *SMTP) Encode() []string {
 		smtpEncoder.String(fieldDstIP, a.DstIP),
 		smtpEncoder.Int32(fieldSrcPort, a.SrcPort),
 		smtpEncoder.Int32(fieldDstPort, a.DstPort),
+		smtpEncoder.String(fieldTunnel, a.Tunnel.toString()),
 	})
 }
 
@@ -42,6 +42,8 @@ var fieldsSoftware = []string{
 	fieldFlows,
 	fieldSourceData,
 	fieldNotes,
+	fieldCommunityIDs,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -63,6 +65,8 @@ func (a *Software) CSVRecord() []string {
 		join(a.Flows...),
 		a.SourceData,
 		a.Notes,
+		join(a.CommunityIDs...),
+		a.Tunnel.toString(),
 	})
 }
 
@@ -145,6 +149,8 @@ func (a *Software) Encode() []string {
 		softwareEncoder.String(fieldFlows, join(a.Flows...)),
 		softwareEncoder.String(fieldSourceData, a.SourceData),
 		softwareEncoder.String(fieldNotes, a.Notes),
+		softwareEncoder.String(fieldCommunityIDs, join(a.CommunityIDs...)),
+		softwareEncoder.String(fieldTunnel, a.Tunnel.toString()),
 	})
 }
 
@@ -28,6 +28,8 @@ var fieldsSSH = []string{
 	fieldHASSH,
 	fieldFlow,
 	fieldNotes,
+	fieldCommunityID,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -42,6 +44,8 @@ func (a *SSH) CSVRecord() []string {
 		a.HASSH,
 		a.Flow,
 		a.Notes,
+		a.CommunityID,
+		a.Tunnel.toString(),
 	})
 }
 
@@ -107,6 +111,8 @@ func (a *SSH) Encode() []string {
 		sshEncoder.String(fieldHASSH, a.HASSH),
 		sshEncoder.String(fieldFlow, a.Flow),
 		sshEncoder.String(fieldNotes, a.Notes),
+		sshEncoder.String(fieldCommunityID, a.CommunityID),
+		sshEncoder.String(fieldTunnel, a.Tunnel.toString()),
 	})
 }
 
@@ -65,6 +65,8 @@ var fieldsTCP = []string{
 	fieldPayloadSize,
 	fieldSrcIP,
 	fieldDstIP,
+	fieldCommunityID,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -98,6 +100,8 @@ func (t *TCP) CSVRecord() []string {
 		formatInt32(t.PayloadSize),                        // int32
 		t.SrcIP,
 		t.DstIP,
+		t.CommunityID,
+		t.Tunnel.toString(),
 	})
 }
 
@@ -211,6 +215,7 @@ func (t *TCP) Inc() {
 func (t *TCP) SetPacketContext(ctx *PacketContext) {
 	t.SrcIP = ctx.SrcIP
 	t.DstIP = ctx.DstIP
+	t.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -251,6 +256,8 @@ func (t *TCP) Encode() []string {
 		tcpEncoder.Int32(fieldPayloadSize, t.PayloadSize),         // int32
 		tcpEncoder.Int64(fieldSrcIP, ipToInt64(t.SrcIP)),
 		tcpEncoder.Int64(fieldDstIP, ipToInt64(t.DstIP)),
+		tcpEncoder.String(fieldCommunityID, t.CommunityID),
+		tcpEncoder.String(fieldTunnel, t.Tunnel.toString()),
 	})
 }
 
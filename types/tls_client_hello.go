@@ -67,6 +67,8 @@ var fieldsTLSClientHello = []string{
 	fieldDstMAC,
 	fieldSrcPort,
 	fieldDstPort,
+	fieldCommunityID,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -104,6 +106,8 @@ func (t *TLSClientHello) CSVRecord() []string {
 		t.DstMAC,
 		formatInt32(t.SrcPort),
 		formatInt32(t.DstPort),
+		t.CommunityID,
+		t.Tunnel.toString(),
 	})
 }
 
@@ -177,6 +181,8 @@ func (t *TLSClientHello) Encode() []string {
 		tlsClientHelloEncoder.String(fieldDstMAC, t.DstMAC),
 		tlsClientHelloEncoder.Int32(fieldSrcPort, t.SrcPort),
 		tlsClientHelloEncoder.Int32(fieldDstPort, t.DstPort),
+		tlsClientHelloEncoder.String(fieldCommunityID, t.CommunityID),
+		tlsClientHelloEncoder.String(fieldTunnel, t.Tunnel.toString()),
 	})
 }
 
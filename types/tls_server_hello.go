@@ -71,6 +71,8 @@ var fieldsTLSServerHello = []string{
 	fieldSrcPort,
 	fieldDstPort,
 	fieldJa3S,
+	fieldCommunityID,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -108,6 +110,8 @@ func (t *TLSServerHello) CSVRecord() []string {
 		formatInt32(t.SrcPort),
 		formatInt32(t.DstPort),
 		t.Ja3S,
+		t.CommunityID,
+		t.Tunnel.toString(),
 	})
 }
 
@@ -181,6 +185,8 @@ func (t *TLSServerHello) Encode() []string {
 		tlsServerHelloEncoder.Int32(fieldSrcPort, t.SrcPort),
 		tlsServerHelloEncoder.Int32(fieldDstPort, t.DstPort),
 		tlsServerHelloEncoder.String(fieldJa3S, t.Ja3S),
+		tlsServerHelloEncoder.String(fieldCommunityID, t.CommunityID),
+		tlsServerHelloEncoder.String(fieldTunnel, t.Tunnel.toString()),
 	})
 }
 
@@ -33,6 +33,8 @@ var fieldsUDP = []string{
 	//fieldPayload,
 	fieldSrcIP,
 	fieldDstIP,
+	fieldCommunityID,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -52,6 +54,8 @@ func (u *UDP) CSVRecord() []string {
 		formatInt32(u.PayloadSize),                        // int32
 		u.SrcIP,
 		u.DstIP,
+		u.CommunityID,
+		u.Tunnel.toString(),
 	})
 }
 
@@ -119,6 +123,7 @@ func (u *UDP) Inc() {
 func (u *UDP) SetPacketContext(ctx *PacketContext) {
 	u.SrcIP = ctx.SrcIP
 	u.DstIP = ctx.DstIP
+	u.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -145,6 +150,8 @@ func (u *UDP) Encode() []string {
 		udpEncoder.Int32(fieldPayloadSize, u.PayloadSize),         // int32
 		udpEncoder.String(fieldSrcIP, u.SrcIP),
 		udpEncoder.String(fieldDstIP, u.DstIP),
+		udpEncoder.String(fieldCommunityID, u.CommunityID),
+		udpEncoder.String(fieldTunnel, u.Tunnel.toString()),
 	})
 }
 
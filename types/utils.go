@@ -108,6 +108,27 @@ func joinUints(a []uint32) string {
 	return b.String()
 }
 
+func joinInt64s(a []int64) string {
+	var (
+		b         strings.Builder
+		lastIndex = len(a) - 1
+	)
+
+	b.WriteString(StructureBegin)
+
+	for i, num := range a {
+		b.WriteString(formatInt64(num))
+
+		if i != lastIndex {
+			b.WriteString(FieldSeparator)
+		}
+	}
+
+	b.WriteString(StructureEnd)
+
+	return b.String()
+}
+
 func join(a ...string) string {
 	var (
 		b         strings.Builder
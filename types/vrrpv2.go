@@ -42,6 +42,7 @@ var fieldsVRRPv2 = []string{
 	fieldIPAddresses,  // []string
 	fieldSrcIP,
 	fieldDstIP,
+	fieldTunnel,
 }
 
 // CSVHeader returns the CSV header for the audit record.
@@ -64,6 +65,7 @@ func (a *VRRPv2) CSVRecord() []string {
 		join(a.IPAddress...),        // []string
 		a.SrcIP,
 		a.DstIP,
+		a.Tunnel.toString(),
 	})
 }
 
@@ -97,6 +99,7 @@ func (a *VRRPv2) Inc() {
 func (a *VRRPv2) SetPacketContext(ctx *PacketContext) {
 	a.SrcIP = ctx.SrcIP
 	a.DstIP = ctx.DstIP
+	a.Tunnel = ctx.Tunnel
 }
 
 // Src returns the source address of the audit record.
@@ -126,6 +129,7 @@ func (a *VRRPv2) Encode() []string {
 		vrrpv2Encoder.String(fieldIPAddresses, join(a.IPAddress...)), // []string
 		vrrpv2Encoder.String(fieldSrcIP, a.SrcIP),
 		vrrpv2Encoder.String(fieldDstIP, a.DstIP),
+		vrrpv2Encoder.String(fieldTunnel, a.Tunnel.toString()),
 	})
 }
 
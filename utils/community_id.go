@@ -0,0 +1,135 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/dreadl0ck/gopacket"
+)
+
+// IP protocol numbers for the Community ID.
+const (
+	ProtocolTCP  uint8 = 6
+	ProtocolUDP  uint8 = 17
+	ProtocolSCTP uint8 = 132
+)
+
+const communityIDVersion = "1:"
+
+// CommunityID returns the Community ID v1 flow hash for the 5-tuple,
+// as specified in https://github.com/corelight/community-id-spec.
+// The hash is identical for both directions of a flow.
+// Only TCP, UDP and SCTP are supported, an empty string is returned for other protocols or invalid addresses.
+func CommunityID(seed uint16, srcIP, dstIP net.IP, srcPort, dstPort uint16, proto uint8) string {
+	if proto != ProtocolTCP && proto != ProtocolUDP && proto != ProtocolSCTP {
+		return ""
+	}
+
+	if src4, dst4 := srcIP.To4(), dstIP.To4(); src4 != nil && dst4 != nil {
+		srcIP, dstIP = src4, dst4
+	} else if srcIP.To16() == nil || dstIP.To16() == nil || src4 != nil || dst4 != nil {
+		return ""
+	}
+
+	// the endpoint with the lower address, or the lower port for identical addresses, comes first
+	if c := bytes.Compare(srcIP, dstIP); c > 0 || (c == 0 && srcPort > dstPort) {
+		srcIP, dstIP = dstIP, srcIP
+		srcPort, dstPort = dstPort, srcPort
+	}
+
+	var (
+		h   = sha1.New()
+		buf [2]byte
+	)
+
+	binary.BigEndian.PutUint16(buf[:], seed)
+	h.Write(buf[:])
+	h.Write(srcIP)
+	h.Write(dstIP)
+	h.Write([]byte{proto, 0})
+	binary.BigEndian.PutUint16(buf[:], srcPort)
+	h.Write(buf[:])
+	binary.BigEndian.PutUint16(buf[:], dstPort)
+	h.Write(buf[:])
+
+	return communityIDVersion + base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+// CommunityIDFromLayerFlows returns the Community ID for the network and transport flows of a packet.
+func CommunityIDFromLayerFlows(seed uint16, network, trans gopacket.Flow, proto uint8) string {
+	srcPort, dstPort := trans.Endpoints()
+	if len(srcPort.Raw()) != 2 || len(dstPort.Raw()) != 2 {
+		return ""
+	}
+
+	return CommunityID(
+		seed,
+		network.Src().Raw(),
+		network.Dst().Raw(),
+		binary.BigEndian.Uint16(srcPort.Raw()),
+		binary.BigEndian.Uint16(dstPort.Raw()),
+		proto,
+	)
+}
+
+// CommunityIDFromStrings returns the Community ID for the string representations of the addresses and ports.
+func CommunityIDFromStrings(seed uint16, srcIP, srcPort, dstIP, dstPort string, proto uint8) string {
+	sp, err := strconv.ParseUint(srcPort, 10, 16)
+	if err != nil {
+		return ""
+	}
+
+	dp, err := strconv.ParseUint(dstPort, 10, 16)
+	if err != nil {
+		return ""
+	}
+
+	return CommunityID(seed, net.ParseIP(srcIP), net.ParseIP(dstIP), uint16(sp), uint16(dp), proto)
+}
+
+// CommunityIDFromFlowIdent returns the Community ID for a flow identifier.
+// format: srcIP:srcPort->dstIP:dstPort
+// IPv6 addresses are supported, the port is separated by the last colon.
+func CommunityIDFromFlowIdent(seed uint16, ident string, proto uint8) string {
+	arr := strings.Split(ident, "->")
+	if len(arr) != 2 {
+		return ""
+	}
+
+	src := strings.LastIndex(arr[0], ":")
+	dst := strings.LastIndex(arr[1], ":")
+
+	if src == -1 || dst == -1 {
+		return ""
+	}
+
+	return CommunityIDFromStrings(seed, arr[0][:src], arr[0][src+1:], arr[1][:dst], arr[1][dst+1:], proto)
+}
+
+// CommunityIDsFromFlowIdents returns the Community IDs for the flow identifiers, in the same order.
+func CommunityIDsFromFlowIdents(seed uint16, idents []string, proto uint8) []string {
+	ids := make([]string, len(idents))
+	for i, ident := range idents {
+		ids[i] = CommunityIDFromFlowIdent(seed, ident, proto)
+	}
+
+	return ids
+}
@@ -0,0 +1,72 @@
+/*
+ * NETCAP - Traffic Analysis Framework
+ * Copyright (c) 2017-2020 Philipp Mieden <dreadl0ck [at] protonmail [dot] ch>
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package utils
+
+import (
+	"net"
+	"testing"
+
+	"github.com/dreadl0ck/gopacket"
+	"github.com/dreadl0ck/gopacket/layers"
+)
+
+// test vectors from the community-id-spec baseline.
+func TestCommunityID(t *testing.T) {
+	for _, c := range []struct {
+		srcIP, dstIP     string
+		srcPort, dstPort uint16
+		proto            uint8
+		expected         string
+	}{
+		{"128.232.110.120", "66.35.250.204", 34855, 80, ProtocolTCP, "1:LQU9qZlK+B5F3KDmev6m5PMibrg="},
+		{"66.35.250.204", "128.232.110.120", 80, 34855, ProtocolTCP, "1:LQU9qZlK+B5F3KDmev6m5PMibrg="},
+		{"192.168.1.52", "8.8.8.8", 54585, 53, ProtocolUDP, "1:d/FP5EW3wiY1vCndhwleRRKHowQ="},
+		{"192.168.1.52", "8.8.8.8", 54585, 53, 1, ""},
+		{"192.168.1.52", "fe80::1", 54585, 53, ProtocolUDP, ""},
+	} {
+		if id := CommunityID(0, net.ParseIP(c.srcIP), net.ParseIP(c.dstIP), c.srcPort, c.dstPort, c.proto); id != c.expected {
+			t.Error("got", id, "for", c.srcIP, c.srcPort, c.dstIP, c.dstPort, "expected", c.expected)
+		}
+	}
+
+	if CommunityID(1, net.ParseIP("128.232.110.120"), net.ParseIP("66.35.250.204"), 34855, 80, ProtocolTCP) == "1:LQU9qZlK+B5F3KDmev6m5PMibrg=" {
+		t.Error("expected a different hash for a different seed")
+	}
+}
+
+func TestCommunityIDFromFlowIdent(t *testing.T) {
+	if id := CommunityIDFromFlowIdent(0, "66.35.250.204:80->128.232.110.120:34855", ProtocolTCP); id != "1:LQU9qZlK+B5F3KDmev6m5PMibrg=" {
+		t.Error("unexpected community id", id)
+	}
+
+	ipv6 := CommunityIDFromFlowIdent(0, "2001:db8::1:443->2001:db8::2:51234", ProtocolTCP)
+	if ipv6 == "" || ipv6 != CommunityID(0, net.ParseIP("2001:db8::2"), net.ParseIP("2001:db8::1"), 51234, 443, ProtocolTCP) {
+		t.Error("unexpected community id for IPv6", ipv6)
+	}
+
+	if id := CommunityIDFromFlowIdent(0, "invalid", ProtocolTCP); id != "" {
+		t.Error("expected no community id for an invalid ident, got", id)
+	}
+}
+
+func TestCommunityIDFromLayerFlows(t *testing.T) {
+	var (
+		network = gopacket.NewFlow(layers.EndpointIPv4, []byte{128, 232, 110, 120}, []byte{66, 35, 250, 204})
+		trans   = gopacket.NewFlow(layers.EndpointTCPPort, []byte{0x88, 0x27}, []byte{0, 80})
+	)
+
+	if id := CommunityIDFromLayerFlows(0, network, trans, ProtocolTCP); id != "1:LQU9qZlK+B5F3KDmev6m5PMibrg=" {
+		t.Error("unexpected community id", id)
+	}
+}
@@ -247,7 +247,7 @@ func Progress(current, total int64) string {
 
 // TrimFileExtension returns the netcap file name without file extension.
 func TrimFileExtension(file string) string {
-	return strings.TrimSuffix(strings.TrimSuffix(file, ".gz"), defaults.FileExtension)
+	return strings.TrimSuffix(strings.TrimSuffix(strings.TrimSuffix(file, ".gz"), ".zst"), defaults.FileExtension)
 }
 
 // TimeToUTC returns a time string in netcap format to a UTC string.
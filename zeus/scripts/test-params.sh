@@ -110,6 +110,19 @@ ${NETCAP_BIN} capture -read "$f" \
 # -close-pending-timeout    close connections that have pending bytes
 # -close-inactive-timeout   close connections that are inactive
 # -ip4defrag                Defragment IPv4 packets
+#	-ip6defrag                Defragment IPv6 packets
+#	-ip6defrag-timeout        discard incomplete IPv6 datagrams after this duration
+#	-ip6defrag-buffer         limit for the fragment data buffered for incomplete IPv6 datagrams in bytes
+#	-overlap-policy           TCP overlap policy per destination host or subnet (first, last, bsd, linux, windows)
+#	-reassembly-page-budget   limit the memory for out-of-order TCP data and connection state in bytes
+#	-reassembly-eviction      connections to close first when the memory budget is exceeded (oldest, largest, lru)
+#	-incremental-decoding     decode supported protocols while TCP connections are reassembled
+#	-incremental-buffer       limit for buffered data per connection direction for incremental decoding
+#	-udp-idle-timeout         process UDP streams that received no packets for this duration
+#	-udp-max-age              process UDP streams older than this duration
+#	-udp-mem-budget           limit the memory for buffered UDP stream data in bytes
+#	-stream-ports             ports and port ranges to try each stream decoder on first
+#	-stream-force             use a stream decoder for all conversations with a server IP:port
 #	-checksum                 check TCP checksum
 #	-nooptcheck               do not check TCP options (useful to ignore MSS on captures with TSO)
 #	-ignorefsmerr             ignore TCP FSM errors